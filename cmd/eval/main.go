@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/common"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm/openai"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/ocr"
+	repo "github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/eval"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/ingest"
+)
+
+// printError prints an error message to stderr, falling back to stdout if stderr fails
+func printError(format string, args ...interface{}) {
+	if _, err := fmt.Fprintf(os.Stderr, format, args...); err != nil {
+		fmt.Printf(format, args...)
+	}
+}
+
+func main() {
+	var (
+		dir              = flag.String("dir", "", "labeled corpus directory; each receipt needs a <file>.json ground-truth sidecar (required)")
+		visionDirect     = flag.Bool("vision-direct", false, "skip OCR and send files directly to LLM as vision input")
+		label            = flag.String("label", "", "free-form label stored in the report (e.g. prompt version)")
+		reportPath       = flag.String("report", "", "write the full JSON report to this path")
+		baselinePath     = flag.String("baseline", "", "compare against a previously saved report")
+		saveBaseline     = flag.String("save-baseline", "", "save this run as the new baseline")
+		failOnRegression = flag.Bool("fail-on-regression", false, "exit non-zero when any field regresses against --baseline")
+		noCache          = flag.Bool("no-llm-cache", false, "disable the on-disk LLM response cache")
+	)
+	flag.Parse()
+
+	if *dir == "" {
+		printError("Error: --dir is required\n")
+		os.Exit(1)
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+	slog.SetDefault(logger)
+
+	ctx := context.Background()
+	cfg := common.LoadConfig()
+	if cfg.LLM.APIKey == "" {
+		printError("Error: OPENAI_API_KEY is required for evaluation\n")
+		os.Exit(1)
+	}
+
+	files, err := labeledFiles(*dir)
+	if err != nil {
+		printError("Error: scan corpus: %v\n", err)
+		os.Exit(1)
+	}
+	if len(files) == 0 {
+		printError("Error: no labeled receipts found under %s\n", *dir)
+		os.Exit(1)
+	}
+
+	// Always evaluate against a throwaway in-memory database.
+	dbResult, err := common.InitDatabase(ctx, cfg, true, logger)
+	if err != nil {
+		logger.Error("failed to initialize database", "error", err)
+		os.Exit(1)
+	}
+	defer dbResult.Cleanup()
+	entc := dbResult.Client
+
+	profilesRepo := repo.NewProfileRepository(entc, logger)
+	receiptsRepo := repo.NewReceiptRepository(entc, logger)
+	filesRepo := repo.NewReceiptFileRepository(entc, logger)
+	jobsRepo := repo.NewExtractJobRepository(entc, logger)
+
+	profile, err := profilesRepo.GetOrCreateByName(ctx, "Evaluation", constants.DefaultCurrency)
+	if err != nil {
+		logger.Error("failed to get or create profile", "error", err)
+		os.Exit(1)
+	}
+
+	extractor := ocr.NewExtractor(ocr.Config{
+		HeicConverter:    cfg.OCR.HeicConverter,
+		TessdataDir:      cfg.OCR.TessdataDir,
+		ArtifactCacheDir: cfg.OCR.ArtifactCacheDir,
+	}, logger)
+	prices, err := llm.LoadPriceTable(cfg.LLM.PriceTable)
+	if err != nil {
+		logger.Error("failed to load LLM price table", "path", cfg.LLM.PriceTable, "error", err)
+		os.Exit(1)
+	}
+	openaiClient := openai.NewClient(openai.Config{
+		Model:          cfg.LLM.Model,
+		APIKey:         cfg.LLM.APIKey,
+		Temperature:    cfg.LLM.Temperature,
+		Timeout:        cfg.LLM.Timeout,
+		Prices:         prices,
+		ResponseFormat: cfg.LLM.ResponseFormat,
+		MaxRetries:     cfg.LLM.Retries,
+	}, logger)
+	cacheCfg := llm.CacheConfig{
+		Dir:            cfg.LLM.CacheDir,
+		TTL:            cfg.LLM.CacheTTL,
+		Bypass:         cfg.LLM.CacheBypass,
+		Model:          cfg.LLM.Model,
+		Temperature:    cfg.LLM.Temperature,
+		ResponseFormat: cfg.LLM.ResponseFormat,
+	}
+	if *noCache {
+		cacheCfg.Dir = ""
+	}
+	llmExtractor := llm.NewCachingExtractor(openaiClient, cacheCfg, logger)
+	processor := core.NewProcessor(logger, extractor, llmExtractor, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, cfg.OCR.ArtifactCacheDir, *visionDirect,
+		core.WithMaxRepairAttempts(cfg.LLM.MaxRepairs))
+	ingestor := ingest.NewFSIngestor(profilesRepo, filesRepo, logger)
+
+	results := make([]eval.FileResult, 0, len(files))
+	for i, path := range files {
+		rel, _ := filepath.Rel(*dir, path)
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s\n", i+1, len(files), rel)
+
+		truth, _, err := eval.LoadTruth(path)
+		fr := eval.FileResult{Path: filepath.ToSlash(rel), Truth: truth}
+		if err != nil {
+			fr.Error = err.Error()
+			results = append(results, fr)
+			continue
+		}
+
+		pred, err := run(ctx, ingestor, processor, receiptsRepo, profile.ID, path)
+		if err != nil {
+			fr.Error = err.Error()
+		}
+		fr.Prediction = pred
+		fr.Matches = eval.Compare(truth, pred)
+		results = append(results, fr)
+	}
+
+	report := eval.BuildReport(*label, results)
+	printReport(report)
+
+	if *reportPath != "" {
+		if err := eval.SaveReport(*reportPath, report); err != nil {
+			printError("Error: write report: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	regressed := false
+	if *baselinePath != "" {
+		baseline, err := eval.LoadReport(*baselinePath)
+		if err != nil {
+			printError("Error: load baseline: %v\n", err)
+			os.Exit(1)
+		}
+		cmp := eval.Diff(baseline, report)
+		printComparison(baseline, cmp)
+		regressed = len(cmp.Regressions) > 0
+	}
+
+	if *saveBaseline != "" {
+		if err := eval.SaveReport(*saveBaseline, report); err != nil {
+			printError("Error: save baseline: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("\nBaseline saved to %s\n", *saveBaseline)
+	}
+
+	if regressed && *failOnRegression {
+		os.Exit(3)
+	}
+}
+
+// run ingests and processes one file, then reads back the stored receipt.
+func run(ctx context.Context, ingestor *ingest.FSIngestor, processor *core.Processor, receipts repo.ReceiptRepository, profileID uuid.UUID, path string) (eval.Prediction, error) {
+	res, err := ingestor.IngestPath(ctx, profileID, path)
+	if err != nil {
+		return eval.Prediction{}, fmt.Errorf("ingest: %w", err)
+	}
+	fileID, err := uuid.Parse(res.FileID)
+	if err != nil {
+		return eval.Prediction{}, fmt.Errorf("ingest: %w", err)
+	}
+	if _, err := processor.ProcessFile(ctx, fileID); err != nil {
+		return eval.Prediction{}, fmt.Errorf("process: %w", err)
+	}
+	rec, err := receipts.GetCurrentByFileID(ctx, fileID)
+	if err != nil {
+		return eval.Prediction{}, fmt.Errorf("load receipt: %w", err)
+	}
+	pred := eval.Prediction{
+		MerchantName: rec.MerchantName,
+		TxDate:       rec.TxDate.Format("2006-01-02"),
+		Total:        rec.Total.String(),
+		Category:     rec.CategoryName,
+	}
+	if rec.Tax != nil {
+		pred.Tax = rec.Tax.String()
+	}
+	return pred, nil
+}
+
+// labeledFiles returns supported receipt files under root that have a truth sidecar.
+func labeledFiles(root string) ([]string, error) {
+	var out []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ingest.IsHidden(path) && path != root {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !ingest.AllowedExt(filepath.Ext(path)) {
+			return nil
+		}
+		if _, err := os.Stat(path + eval.TruthSuffix); err == nil {
+			out = append(out, path)
+		}
+		return nil
+	})
+	sort.Strings(out)
+	return out, err
+}
+
+func printReport(r *eval.Report) {
+	fmt.Printf("\nEvaluated %d labeled receipts\n\n", len(r.Files))
+	fmt.Printf("%-10s %8s %8s %9s\n", "field", "correct", "labeled", "accuracy")
+	for _, f := range []string{eval.FieldMerchant, eval.FieldDate, eval.FieldTotal, eval.FieldTax, eval.FieldCategory} {
+		s := r.Fields[f]
+		fmt.Printf("%-10s %8d %8d %8.1f%%\n", f, s.Correct, s.Labeled, 100*s.Accuracy)
+	}
+	fmt.Printf("\nCategory confusion rate: %.1f%%\n", 100*r.CategoryConfusionRate)
+
+	truths := make([]string, 0, len(r.CategoryConfusion))
+	for t := range r.CategoryConfusion {
+		truths = append(truths, t)
+	}
+	sort.Strings(truths)
+	for _, t := range truths {
+		var confusions []string
+		for pred, n := range r.CategoryConfusion[t] {
+			if !strings.EqualFold(pred, t) {
+				confusions = append(confusions, fmt.Sprintf("%s×%d", pred, n))
+			}
+		}
+		if len(confusions) > 0 {
+			sort.Strings(confusions)
+			fmt.Printf("  %s -> %s\n", t, strings.Join(confusions, ", "))
+		}
+	}
+
+	failed := 0
+	for _, fr := range r.Files {
+		if fr.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		fmt.Printf("\n%d file(s) failed to process\n", failed)
+	}
+}
+
+func printComparison(baseline *eval.Report, c eval.Comparison) {
+	fmt.Printf("\nAgainst baseline %q (%s)\n\n", baseline.Label, baseline.GeneratedAt.Format("2006-01-02 15:04"))
+	fmt.Printf("%-10s %9s %9s %8s\n", "field", "baseline", "current", "delta")
+	for _, d := range c.Fields {
+		fmt.Printf("%-10s %8.1f%% %8.1f%% %+7.1f\n", d.Field, 100*d.Baseline, 100*d.Current, 100*d.Delta)
+	}
+	fmt.Printf("\nFixes: %d  Regressions: %d\n", c.Fixes, len(c.Regressions))
+	for _, r := range c.Regressions {
+		fmt.Printf("  %s [%s] expected %q, got %q\n", r.Path, r.Field, r.Expected, r.Got)
+	}
+}
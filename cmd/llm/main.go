@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm/openai"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/ocr"
 
@@ -89,16 +90,42 @@ func main() {
 	}
 	ocrExtractor := ocr.NewExtractor(ocrCfg, logger)
 
+	model := getenv("OPENAI_MODEL", "gpt-4o-mini")
+	prices, err := llm.LoadPriceTable(os.Getenv("LLM_PRICE_TABLE"))
+	if err != nil {
+		logger.Error("failed to load LLM price table", "error", err)
+		os.Exit(1)
+	}
+	responseFormat := getenv("OPENAI_RESPONSE_FORMAT", "auto")
 	openaiClient := openai.NewClient(openai.Config{
-		Model:           getenv("OPENAI_MODEL", "gpt-4o-mini"),
+		Model:           model,
 		APIKey:          os.Getenv("OPENAI_API_KEY"),
 		Temperature:     0.0,
 		Timeout:         45 * time.Second,
 		LenientOptional: true,
 		MaxVisionMB:     10,
+		Prices:          prices,
+		ResponseFormat:  responseFormat,
+	}, logger)
+
+	// Repeated runs over the same file hit the response cache unless LLM_CACHE_BYPASS is set.
+	cacheTTL, _ := time.ParseDuration(getenv("LLM_CACHE_TTL", "720h"))
+	bypass, _ := strconv.ParseBool(getenv("LLM_CACHE_BYPASS", "false"))
+	maxRepairs, err := strconv.Atoi(getenv("LLM_MAX_REPAIRS", "2"))
+	if err != nil {
+		maxRepairs = 2
+	}
+	llmExtractor := llm.NewCachingExtractor(openaiClient, llm.CacheConfig{
+		Dir:            getenv("LLM_CACHE_DIR", filepath.Join(cacheDir, "llm-cache")),
+		TTL:            cacheTTL,
+		Bypass:         bypass,
+		Model:          model,
+		Temperature:    0.0,
+		ResponseFormat: responseFormat,
 	}, logger)
 
-	processor := core.NewProcessor(logger, ocrExtractor, openaiClient, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, cacheDir, false)
+	processor := core.NewProcessor(logger, ocrExtractor, llmExtractor, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, cacheDir, false,
+		core.WithMaxRepairAttempts(maxRepairs))
 
 	// --- Loop N times on the SAME file_id
 	base := filepath.Base(fileRow.SourcePath)
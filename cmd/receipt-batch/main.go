@@ -2,18 +2,26 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/internal/common"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/anomaly"
+	corebudget "github.com/joseph-ayodele/receipts-tracker/internal/core/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm/openai"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/ocr"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
 	repo "github.com/joseph-ayodele/receipts-tracker/internal/repository"
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/export"
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/ingest"
@@ -35,7 +43,23 @@ func main() {
 		fromStr      = flag.String("from", "", "from date YYYY-MM-DD")
 		toStr        = flag.String("to", "", "to date YYYY-MM-DD")
 		visionDirect = flag.Bool("vision-direct", false, "skip OCR and send files directly to LLM as vision input")
+		noCache      = flag.Bool("no-llm-cache", false, "disable the on-disk LLM response cache")
+		refreshCache = flag.Bool("refresh-llm-cache", false, "ignore cached LLM responses but store fresh ones")
+		fxRates      = flag.String("fx-rates", "", "ECB XML or CSV file of daily FX rates (defaults to FX_RATES_FILE)")
+		format       = flag.String("format", "receipts", "export format: receipts (flat list), schedule-c (per-year tax summary), bundle (ZIP of the spreadsheet and renamed receipt files), pdf (reimbursement report), qif, ofx, ledger or beancount (accounting transactions), or json or ndjson (receipts with extraction history)")
+		templateFile = flag.String("template", "", "receipts and bundle formats: JSON file with the spreadsheet columns, {\"columns\": [{\"header\", \"field\", \"format\", \"width\"}]}")
+		includeOCR   = flag.Bool("include-ocr-text", false, "json and ndjson formats: include the OCR text of every extract job")
+		paymentAcct  = flag.String("payment-account", "", "accounting formats: account receipts are paid from (default "+export.DefaultPaymentAccount+")")
+		accounts     = map[string]string{}
 	)
+	flag.Func("account", "accounting formats: map a category to an account, e.g. \"Meals=Expenses:Business:Meals\" (repeatable)", func(v string) error {
+		category, account, ok := strings.Cut(v, "=")
+		if !ok || strings.TrimSpace(category) == "" || strings.TrimSpace(account) == "" {
+			return fmt.Errorf("want Category=Account, got %q", v)
+		}
+		accounts[strings.TrimSpace(category)] = strings.TrimSpace(account)
+		return nil
+	})
 	flag.Parse()
 
 	// Validate required flags
@@ -43,12 +67,25 @@ func main() {
 		printError("Error: --dir is required\n")
 		os.Exit(1)
 	}
+	switch *format {
+	case "receipts", "schedule-c", "bundle", "pdf", "qif", "ofx", "ledger", "beancount", "json", "ndjson":
+	default:
+		printError("Error: --format must be receipts, schedule-c, bundle, pdf, qif, ofx, ledger, beancount, json or ndjson\n")
+		os.Exit(1)
+	}
 
 	// If output file not specified, use parent directory with timestamped default filename
 	if *out == "" {
 		parentDir := filepath.Dir(*dir)
 		ts := time.Now().Format("20060102-150405")
-		*out = filepath.Join(parentDir, "receipts-"+ts+".xlsx")
+		ext := ".xlsx"
+		switch *format {
+		case "bundle":
+			ext = ".zip"
+		case "pdf", "qif", "ofx", "ledger", "beancount", "json", "ndjson":
+			ext = "." + *format
+		}
+		*out = filepath.Join(parentDir, "receipts-"+ts+ext)
 	}
 
 	// Parse date filters
@@ -70,6 +107,15 @@ func main() {
 		}
 	}
 
+	var columns []entity.ExportColumn
+	if *templateFile != "" {
+		var err error
+		if columns, err = readTemplate(*templateFile); err != nil {
+			printError("Error: invalid --template: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Setup logger
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
@@ -122,17 +168,78 @@ func main() {
 	}
 
 	// Setup OpenAI client
+	prices, err := llm.LoadPriceTable(cfg.LLM.PriceTable)
+	if err != nil {
+		logger.Error("failed to load LLM price table", "path", cfg.LLM.PriceTable, "error", err)
+		os.Exit(1)
+	}
 	openaiClient := openai.NewClient(openai.Config{
-		Model:       cfg.LLM.Model,
-		APIKey:      cfg.LLM.APIKey,
-		Temperature: cfg.LLM.Temperature,
-		Timeout:     cfg.LLM.Timeout,
-		MaxRetries:  cfg.LLM.Retries,
+		Model:          cfg.LLM.Model,
+		APIKey:         cfg.LLM.APIKey,
+		Temperature:    cfg.LLM.Temperature,
+		Timeout:        cfg.LLM.Timeout,
+		Prices:         prices,
+		ResponseFormat: cfg.LLM.ResponseFormat,
+		MaxRetries:     cfg.LLM.Retries,
 	}, logger)
 	logger.Info("OpenAI client initialized", "model", cfg.LLM.Model)
 
+	cacheCfg := llm.CacheConfig{
+		Dir:            cfg.LLM.CacheDir,
+		TTL:            cfg.LLM.CacheTTL,
+		Bypass:         cfg.LLM.CacheBypass || *refreshCache,
+		Model:          cfg.LLM.Model,
+		Temperature:    cfg.LLM.Temperature,
+		ResponseFormat: cfg.LLM.ResponseFormat,
+	}
+	if *noCache {
+		cacheCfg.Dir = ""
+	}
+	llmExtractor := llm.NewCachingExtractor(openaiClient, cacheCfg, logger)
+
+	// Load FX rates so foreign-currency totals can be converted into the profile currency
+	fxRepo := repo.NewFxRateRepository(entc, logger)
+	ratesFile := *fxRates
+	if ratesFile == "" {
+		ratesFile = cfg.FX.RatesFile
+	}
+	if ratesFile != "" {
+		n, err := fx.ImportFile(ctx, ratesFile, fxRepo)
+		if err != nil {
+			logger.Error("failed to import FX rates", "path", ratesFile, "error", err)
+			os.Exit(1)
+		}
+		logger.Info("fx rates imported", "path", ratesFile, "rows", n)
+	}
+
+	// Budget alerts, checked after each receipt is stored
+	notifiers := corebudget.Notifiers{corebudget.LogNotifier{Logger: logger}}
+	if cfg.Notify.WebhookURL != "" {
+		notifiers = append(notifiers, corebudget.WebhookNotifier{URL: cfg.Notify.WebhookURL})
+	}
+	if cfg.Notify.SMTPAddr != "" && len(cfg.Notify.SMTPTo) > 0 {
+		notifiers = append(notifiers, corebudget.SMTPNotifier{
+			Addr:     cfg.Notify.SMTPAddr,
+			From:     cfg.Notify.SMTPFrom,
+			To:       cfg.Notify.SMTPTo,
+			Username: cfg.Notify.SMTPUsername,
+			Password: cfg.Notify.SMTPPassword,
+		})
+	}
+	budgetChecker := corebudget.NewChecker(repo.NewBudgetRepository(entc, logger), repo.NewAnalyticsRepository(entc, logger), notifiers, logger)
+
+	// Outlier checks against the profile's history
+	anomalyChecker := anomaly.NewChecker(receiptsRepo, profilesRepo, repo.NewAnomalyRepository(entc, logger), anomaly.Options{}, logger)
+
 	// Setup processor
-	processor := core.NewProcessor(logger, extractor, openaiClient, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, "./tmp", *visionDirect)
+	processor := core.NewProcessor(logger, extractor, llmExtractor, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, "./tmp", *visionDirect,
+		core.WithMaxRepairAttempts(cfg.LLM.MaxRepairs),
+		core.WithFXConverter(fx.NewConverter(fxRepo, logger)),
+		core.WithCategoryRepository(repo.NewCategoryRepository(entc, logger)),
+		core.WithRuleRepository(repo.NewCategoryRuleRepository(entc, logger)),
+		core.WithCorrectionRepository(repo.NewCategoryCorrectionRepository(entc, logger)),
+		core.WithBudgetChecker(budgetChecker),
+		core.WithAnomalyChecker(anomalyChecker))
 
 	// Setup ingestor
 	ingestor := ingest.NewFSIngestor(profilesRepo, filesRepo, logger)
@@ -180,20 +287,37 @@ func main() {
 		}
 	}
 
-	// Export to XLSX
-	logger.Info("exporting to XLSX", "output", *out)
-	exportService := export.NewService(entc, receiptsRepo, filesRepo, logger)
+	// Export
+	logger.Info("exporting receipts", "output", *out, "format", *format)
+	exportService := export.NewService(entc, receiptsRepo, filesRepo, repo.NewCategoryRepository(entc, logger), repo.NewExportTemplateRepository(entc, logger), extractor, logger)
 
-	xlsxBytes, err := exportService.ExportReceiptsXLSX(ctx, profile.ID, from, to)
-	if err != nil {
-		logger.Error("failed to export receipts", "error", err)
-		os.Exit(1)
+	switch *format {
+	case "bundle":
+		err = writeExport(*out, func(w io.Writer) error { return exportService.WriteBundle(ctx, w, profile.ID, from, to, columns) })
+	case "pdf":
+		err = writeExport(*out, func(w io.Writer) error { return exportService.WritePDFReport(ctx, w, profile.ID, from, to) })
+	case "qif", "ofx", "ledger", "beancount":
+		opts := export.LedgerOptions{Accounts: accounts, PaymentAccount: *paymentAcct}
+		err = writeExport(*out, func(w io.Writer) error {
+			return exportService.WriteLedger(ctx, w, export.LedgerFormat(*format), profile.ID, from, to, opts)
+		})
+	case "json", "ndjson":
+		opts := export.ProvenanceOptions{IncludeOCRText: *includeOCR}
+		err = writeExport(*out, func(w io.Writer) error {
+			return exportService.WriteProvenance(ctx, w, export.ProvenanceFormat(*format), profile.ID, from, to, opts)
+		})
+	case "schedule-c":
+		var xlsxBytes []byte
+		if xlsxBytes, err = exportService.ExportScheduleCXLSX(ctx, profile.ID, from, to); err == nil {
+			err = os.WriteFile(*out, xlsxBytes, 0644)
+		}
+	default:
+		err = writeExport(*out, func(w io.Writer) error {
+			return exportService.WriteReceiptsXLSX(ctx, w, profile.ID, from, to, columns)
+		})
 	}
-
-	// Write to file
-	err = os.WriteFile(*out, xlsxBytes, 0644)
 	if err != nil {
-		logger.Error("failed to write output file", "error", err)
+		logger.Error("failed to export receipts", "error", err)
 		os.Exit(1)
 	}
 
@@ -210,3 +334,34 @@ func main() {
 	fmt.Printf("- Failures: %d\n", failures)
 	fmt.Printf("- Output: %s\n", *out)
 }
+
+// readTemplate loads the spreadsheet columns of an export template from a JSON file.
+func readTemplate(path string) ([]entity.ExportColumn, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t struct {
+		Columns []entity.ExportColumn `json:"columns"`
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, err
+	}
+	if err := export.ValidateColumns(t.Columns); err != nil {
+		return nil, err
+	}
+	return t.Columns, nil
+}
+
+// writeExport streams an export to a new file at out.
+func writeExport(out string, write func(io.Writer) error) error {
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
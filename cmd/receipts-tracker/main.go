@@ -12,13 +12,23 @@ import (
 
 	"github.com/joseph-ayodele/receipts-tracker/internal/common"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/anomaly"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/async"
+	corebudget "github.com/joseph-ayodele/receipts-tracker/internal/core/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm/openai"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/ocr"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/analytics"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/category"
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/export"
 	ingest2 "github.com/joseph-ayodele/receipts-tracker/internal/services/ingest"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/profile"
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/statement"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/usage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -81,6 +91,9 @@ func main() {
 	receiptsRepo := repo.NewReceiptRepository(entc, logger)
 	filesRepo := repo.NewReceiptFileRepository(entc, logger)
 	jobsRepo := repo.NewExtractJobRepository(entc, logger)
+	categoriesRepo := repo.NewCategoryRepository(entc, logger)
+	rulesRepo := repo.NewCategoryRuleRepository(entc, logger)
+	correctionsRepo := repo.NewCategoryCorrectionRepository(entc, logger)
 
 	// OCR text pipeline
 	ocrCfg := ocr.Config{
@@ -91,19 +104,86 @@ func main() {
 	extractor := ocr.NewExtractor(ocrCfg, logger)
 
 	// LLM parse pipeline
+	prices, err := llm.LoadPriceTable(cfg.LLM.PriceTable)
+	if err != nil {
+		logger.Error("failed to load LLM price table", "path", cfg.LLM.PriceTable, "error", err)
+		os.Exit(1)
+	}
 	openaiClient := openai.NewClient(openai.Config{
-		Model:       cfg.LLM.Model,
-		APIKey:      cfg.LLM.APIKey,
-		Temperature: cfg.LLM.Temperature,
-		Timeout:     cfg.LLM.Timeout,
+		Model:          cfg.LLM.Model,
+		APIKey:         cfg.LLM.APIKey,
+		Temperature:    cfg.LLM.Temperature,
+		Timeout:        cfg.LLM.Timeout,
+		Prices:         prices,
+		ResponseFormat: cfg.LLM.ResponseFormat,
 	}, logger)
+	llmExtractor := llm.NewCachingExtractor(openaiClient, llm.CacheConfig{
+		Dir:            cfg.LLM.CacheDir,
+		TTL:            cfg.LLM.CacheTTL,
+		Bypass:         cfg.LLM.CacheBypass,
+		Model:          cfg.LLM.Model,
+		Temperature:    cfg.LLM.Temperature,
+		ResponseFormat: cfg.LLM.ResponseFormat,
+	}, logger)
+
+	// FX rates for converting totals into the profile currency
+	fxRepo := repo.NewFxRateRepository(entc, logger)
+	if cfg.FX.RatesFile != "" {
+		n, err := fx.ImportFile(ctx, cfg.FX.RatesFile, fxRepo)
+		if err != nil {
+			logger.Error("failed to import FX rates", "path", cfg.FX.RatesFile, "error", err)
+			os.Exit(1)
+		}
+		logger.Info("fx rates imported", "path", cfg.FX.RatesFile, "rows", n)
+	}
+
+	// Link receipts stored before the merchant directory existed
+	merchantsRepo := repo.NewMerchantRepository(entc, logger)
+	if profiles, err := profilesRepo.ListProfiles(ctx); err != nil {
+		logger.Error("failed to list profiles for merchant backfill", "error", err)
+	} else {
+		for _, p := range profiles {
+			if _, err := merchantsRepo.Backfill(ctx, p.ID); err != nil {
+				logger.Error("merchant backfill failed", "profile_id", p.ID, "error", err)
+			}
+		}
+	}
+
+	// Budget alerts, checked after each receipt is stored
+	notifiers := corebudget.Notifiers{corebudget.LogNotifier{Logger: logger}}
+	if cfg.Notify.WebhookURL != "" {
+		notifiers = append(notifiers, corebudget.WebhookNotifier{URL: cfg.Notify.WebhookURL})
+	}
+	if cfg.Notify.SMTPAddr != "" && len(cfg.Notify.SMTPTo) > 0 {
+		notifiers = append(notifiers, corebudget.SMTPNotifier{
+			Addr:     cfg.Notify.SMTPAddr,
+			From:     cfg.Notify.SMTPFrom,
+			To:       cfg.Notify.SMTPTo,
+			Username: cfg.Notify.SMTPUsername,
+			Password: cfg.Notify.SMTPPassword,
+		})
+	}
+	analyticsRepo := repo.NewAnalyticsRepository(entc, logger)
+	budgetsRepo := repo.NewBudgetRepository(entc, logger)
+	budgetChecker := corebudget.NewChecker(budgetsRepo, analyticsRepo, notifiers, logger)
+
+	// Outlier checks against each profile's history
+	anomaliesRepo := repo.NewAnomalyRepository(entc, logger)
+	anomalyChecker := anomaly.NewChecker(receiptsRepo, profilesRepo, anomaliesRepo, anomaly.Options{}, logger)
 
 	// Orchestrator
-	processor := core.NewProcessor(logger, extractor, openaiClient, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, "./tmp", *visionDirect)
+	processor := core.NewProcessor(logger, extractor, llmExtractor, filesRepo, jobsRepo, profilesRepo, receiptsRepo, jobsRepo, 0.60, "./tmp", *visionDirect,
+		core.WithMaxRepairAttempts(cfg.LLM.MaxRepairs),
+		core.WithFXConverter(fx.NewConverter(fxRepo, logger)),
+		core.WithCategoryRepository(categoriesRepo),
+		core.WithRuleRepository(rulesRepo),
+		core.WithCorrectionRepository(correctionsRepo),
+		core.WithBudgetChecker(budgetChecker),
+		core.WithAnomalyChecker(anomalyChecker))
 
 	// Create service layers (business logic)
 	profilesServiceLayer := profile.NewService(profilesRepo, logger)
-	receiptsServiceLayer := receipt.NewService(receiptsRepo, logger)
+	receiptsServiceLayer := receipt.NewService(receiptsRepo, correctionsRepo, categoriesRepo, anomaliesRepo, logger)
 
 	queue := async.NewProcessorQueue(processor, logger,
 		async.WithWorkers(6),
@@ -119,14 +199,31 @@ func main() {
 	v1.RegisterProfilesServiceServer(grpcServer, profilesServer)
 	receiptsServer := svc.NewReceiptServer(receiptsServiceLayer, logger)
 	v1.RegisterReceiptsServiceServer(grpcServer, receiptsServer)
+	categoriesServer := svc.NewCategoryServer(category.NewService(categoriesRepo, rulesRepo, profilesRepo, logger), logger)
+	v1.RegisterCategoriesServiceServer(grpcServer, categoriesServer)
+	merchantsServer := svc.NewMerchantServer(merchant.NewService(merchantsRepo, categoriesRepo, profilesRepo, logger), logger)
+	v1.RegisterMerchantsServiceServer(grpcServer, merchantsServer)
 
 	ingestionServer := svc.NewIngestionServer(ingestionServiceLayer, logger)
 	v1.RegisterIngestionServiceServer(grpcServer, ingestionServer)
 
-	exportService := export.NewService(entc, receiptsRepo, filesRepo, logger)
+	exportService := export.NewService(entc, receiptsRepo, filesRepo, categoriesRepo, repo.NewExportTemplateRepository(entc, logger), extractor, logger)
 	exportServer := svc.NewExportServer(exportService, logger)
 	v1.RegisterExportServiceServer(grpcServer, exportServer)
 
+	statementService := statement.NewService(repo.NewStatementRepository(entc, logger), receiptsRepo, profilesRepo, logger)
+	statementsServer := svc.NewStatementServer(statementService, logger)
+	v1.RegisterStatementsServiceServer(grpcServer, statementsServer)
+
+	analyticsServer := svc.NewAnalyticsServer(analytics.NewService(analyticsRepo, profilesRepo, logger), logger)
+	v1.RegisterAnalyticsServiceServer(grpcServer, analyticsServer)
+
+	budgetsServer := svc.NewBudgetsServer(budget.NewService(budgetsRepo, categoriesRepo, profilesRepo, budgetChecker, logger), logger)
+	v1.RegisterBudgetsServiceServer(grpcServer, budgetsServer)
+
+	usageServer := svc.NewUsageServer(usage.NewService(jobsRepo, logger), logger)
+	v1.RegisterUsageServiceServer(grpcServer, usageServer)
+
 	// Register gRPC health service
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
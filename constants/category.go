@@ -42,36 +42,70 @@ func AsStringSlice() []string {
 	return result
 }
 
-func Canonicalize(input string) (Category, bool) {
-	if input == "" {
-		return Other, false
+// CategoryDef describes a category a profile can file receipts under.
+type CategoryDef struct {
+	Name        string
+	Description string
+	Synonyms    []string
+	TaxLine     string // Schedule C line, e.g. "18" or "24b"
+}
+
+// DefaultCategoryDefs returns the built-in categories, used for profiles that have not
+// defined their own.
+func DefaultCategoryDefs() []CategoryDef {
+	return []CategoryDef{
+		{Name: string(CellPhoneService), Description: "Mobile plan charges; physical accessories go to Supplies or Equipment.",
+			Synonyms: []string{"cell phone", "mobile plan"}, TaxLine: "25"},
+		{Name: string(HomeOffice), Description: "Furniture/fixtures or workspace improvements for a home office (desk, chair, lamp, shelving). Not consumables.",
+			TaxLine: "30"},
+		{Name: string(Internet), Description: "ISP service fees and modem/router rental; purchasing a router is Equipment.",
+			TaxLine: "25"},
+		{Name: string(Meals), Description: "Food or drink purchases tied to business purposes.",
+			TaxLine: "24b"},
+		{Name: string(OfficeEquipment), Description: "Durable hardware/devices or peripherals (printers, monitors, keyboards, headsets, routers).",
+			TaxLine: "13"},
+		{Name: string(OfficeSupplies), Description: "Consumables & small accessories used up in daily work (pens, paper, cleaning wipes, duster, cables/chargers, batteries).",
+			TaxLine: "18"},
+		{Name: string(ProfessionalDevelopment), Description: "Courses, training, certifications, conference fees (not travel/lodging/meals).",
+			TaxLine: "27a"},
+		{Name: string(ShippingExpenses), Description: "Postage, courier, shipping labels, packaging for shipments.",
+			TaxLine: "18"},
+		{Name: string(SoftwareSubscription), Description: "Recurring SaaS/apps/licenses (monthly/annual software fees).",
+			Synonyms: []string{"saas", "subscription"}, TaxLine: "18"},
+		{Name: string(TravelExpenses), Description: "Transportation, lodging, baggage, tolls, parking, ride-share, airfare.",
+			Synonyms: []string{"uber", "lyft", "airline", "hotel", "taxi"}, TaxLine: "24a"},
+		{Name: string(Other), Description: "Use only when nothing else applies unambiguously.",
+			TaxLine: "27a"},
 	}
+}
 
-	normalized := strings.ToLower(strings.TrimSpace(input))
+func Canonicalize(input string) (Category, bool) {
+	name, ok := Match(input, DefaultCategoryDefs())
+	return Category(name), ok
+}
 
-	// synonyms map
-	synonyms := map[string]Category{
-		"cell phone":   CellPhoneService,
-		"mobile plan":  CellPhoneService,
-		"saas":         SoftwareSubscription,
-		"subscription": SoftwareSubscription,
-		"uber":         TravelExpenses,
-		"lyft":         TravelExpenses,
-		"airline":      TravelExpenses,
-		"hotel":        TravelExpenses,
-		"taxi":         TravelExpenses,
+// Match resolves a free-form label to one of defs by synonym or case-insensitive name.
+// It returns "Other" and false when nothing matches.
+func Match(input string, defs []CategoryDef) (string, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(input))
+	if normalized == "" {
+		return string(Other), false
 	}
 
-	if cat, ok := synonyms[normalized]; ok {
-		return cat, true
+	for _, d := range defs {
+		for _, syn := range d.Synonyms {
+			if normalized == strings.ToLower(strings.TrimSpace(syn)) {
+				return d.Name, true
+			}
+		}
 	}
 
 	// check if it matches any category string
-	for _, cat := range allCategories {
-		if normalized == strings.ToLower(string(cat)) {
-			return cat, true
+	for _, d := range defs {
+		if normalized == strings.ToLower(d.Name) {
+			return d.Name, true
 		}
 	}
 
-	return Other, false
+	return string(Other), false
 }
@@ -0,0 +1,37 @@
+package constants
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	profile := []CategoryDef{
+		{Name: "Rent", Synonyms: []string{"Coworking", "desk rental"}},
+		{Name: "Meals", Synonyms: []string{"coffee"}},
+		{Name: "Coffee Beans"},
+		{Name: "Other"},
+	}
+	tests := []struct {
+		name     string
+		input    string
+		defs     []CategoryDef
+		expected string
+		ok       bool
+	}{
+		{name: "Profile name", input: "rent", defs: profile, expected: "Rent", ok: true},
+		{name: "Profile synonym", input: " coworking ", defs: profile, expected: "Rent", ok: true},
+		{name: "Multi-word synonym", input: "Desk Rental", defs: profile, expected: "Rent", ok: true},
+		{name: "Synonym before name", input: "Coffee", defs: profile, expected: "Meals", ok: true},
+		{name: "Built-in name not in profile", input: "Internet", defs: profile, expected: "Other", ok: false},
+		{name: "Empty", input: "  ", defs: profile, expected: "Other", ok: false},
+		{name: "Built-in synonym", input: "Uber", defs: DefaultCategoryDefs(), expected: string(TravelExpenses), ok: true},
+		{name: "Built-in name", input: "office supplies", defs: DefaultCategoryDefs(), expected: string(OfficeSupplies), ok: true},
+		{name: "Profile synonym not built in", input: "coworking", defs: DefaultCategoryDefs(), expected: "Other", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Match(tt.input, tt.defs)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %q (%v), got %q (%v)", tt.expected, tt.ok, got, ok)
+			}
+		})
+	}
+}
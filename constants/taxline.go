@@ -0,0 +1,55 @@
+package constants
+
+import "strings"
+
+// TaxLine is a line of IRS Schedule C (Form 1040) that expense categories roll up to.
+type TaxLine struct {
+	Line              string
+	Label             string
+	DeductiblePercent int // share of the expense that is deductible
+}
+
+// scheduleCLines are the Schedule C Part II expense lines.
+var scheduleCLines = []TaxLine{
+	{Line: "8", Label: "Advertising", DeductiblePercent: 100},
+	{Line: "9", Label: "Car and truck expenses", DeductiblePercent: 100},
+	{Line: "10", Label: "Commissions and fees", DeductiblePercent: 100},
+	{Line: "11", Label: "Contract labor", DeductiblePercent: 100},
+	{Line: "12", Label: "Depletion", DeductiblePercent: 100},
+	{Line: "13", Label: "Depreciation and section 179 expense", DeductiblePercent: 100},
+	{Line: "14", Label: "Employee benefit programs", DeductiblePercent: 100},
+	{Line: "15", Label: "Insurance (other than health)", DeductiblePercent: 100},
+	{Line: "16a", Label: "Interest: mortgage", DeductiblePercent: 100},
+	{Line: "16b", Label: "Interest: other", DeductiblePercent: 100},
+	{Line: "17", Label: "Legal and professional services", DeductiblePercent: 100},
+	{Line: "18", Label: "Office expense", DeductiblePercent: 100},
+	{Line: "19", Label: "Pension and profit-sharing plans", DeductiblePercent: 100},
+	{Line: "20a", Label: "Rent or lease: vehicles, machinery, equipment", DeductiblePercent: 100},
+	{Line: "20b", Label: "Rent or lease: other business property", DeductiblePercent: 100},
+	{Line: "21", Label: "Repairs and maintenance", DeductiblePercent: 100},
+	{Line: "22", Label: "Supplies", DeductiblePercent: 100},
+	{Line: "23", Label: "Taxes and licenses", DeductiblePercent: 100},
+	{Line: "24a", Label: "Travel", DeductiblePercent: 100},
+	{Line: "24b", Label: "Deductible meals", DeductiblePercent: 50},
+	{Line: "25", Label: "Utilities", DeductiblePercent: 100},
+	{Line: "26", Label: "Wages", DeductiblePercent: 100},
+	{Line: "27a", Label: "Other expenses", DeductiblePercent: 100},
+	{Line: "30", Label: "Business use of home", DeductiblePercent: 100},
+}
+
+// ScheduleCLines returns the Schedule C expense lines in form order.
+func ScheduleCLines() []TaxLine {
+	return append([]TaxLine(nil), scheduleCLines...)
+}
+
+// LookupTaxLine finds a Schedule C line by number, ignoring case and a "line " prefix.
+func LookupTaxLine(line string) (TaxLine, bool) {
+	line = strings.ToLower(strings.TrimSpace(line))
+	line = strings.TrimSpace(strings.TrimPrefix(line, "line"))
+	for _, l := range scheduleCLines {
+		if l.Line == line {
+			return l, true
+		}
+	}
+	return TaxLine{}, false
+}
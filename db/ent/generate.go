@@ -1,4 +1,4 @@
 package ent
 
 // Generate ent code into gen/ent (matches your imports).
-//go:generate go run entgo.io/ent/cmd/ent generate --target ../../gen/ent ./schema
+//go:generate go run entgo.io/ent/cmd/ent generate --feature sql/upsert,sql/modifier,sql/execquery --target ../../gen/ent ./schema
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Budget caps a profile's spending in one category per month or per year.
+type Budget struct{ ent.Schema }
+
+func (Budget) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "budgets"},
+	}
+}
+
+func (Budget) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("category_name").NotEmpty(),
+		field.String("period").NotEmpty(), // monthly | annual
+		field.Other("amount", money.Amount(0)).
+			SchemaType(moneyColumn), // in the profile currency
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Budget) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY budgets -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("budgets").
+			Field("profile_id").
+			Required().
+			Unique(),
+		edge.To("alerts", BudgetAlert.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Budget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "category_name", "period").Unique(),
+	}
+}
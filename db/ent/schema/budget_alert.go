@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// BudgetAlert records that spending in a budget period crossed a threshold, so each
+// threshold is announced once per period.
+type BudgetAlert struct{ ent.Schema }
+
+func (BudgetAlert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "budget_alerts"},
+	}
+}
+
+func (BudgetAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("budget_id", uuid.UUID{}),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.Time("period_start").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Int("threshold"), // percent of the budget: 80 or 100
+		field.Other("spent", money.Amount(0)).
+			SchemaType(moneyColumn), // when the alert fired
+		field.Other("budget_amount", money.Amount(0)).
+			SchemaType(moneyColumn),
+		field.UUID("receipt_id", uuid.UUID{}).Optional().Nillable(), // the receipt that crossed it
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// set once a notifier accepted the alert; until then it is resent on each check
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+func (BudgetAlert) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY alerts -> ONE budget
+		edge.From("budget", Budget.Type).
+			Ref("alerts").
+			Field("budget_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (BudgetAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("budget_id", "period_start", "threshold").Unique(),
+		index.Fields("profile_id", "created_at"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// Category is an expense bucket a profile files receipts under.
+type Category struct{ ent.Schema }
+
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "categories"},
+	}
+}
+
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("name").NotEmpty(),
+		// shown to the LLM as the rubric entry for this category
+		field.String("description").Optional().Nillable(),
+		// lower-cased labels that map onto this category (e.g. "saas", "uber")
+		field.Strings("synonyms").Optional(),
+		// tax form line the category rolls up to, e.g. "18" or "24b" on Schedule C
+		field.String("tax_line").Optional().Nillable(),
+		// accounting account the category posts to, e.g. "Expenses:Office:Supplies"
+		field.String("account").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY categories -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("categories").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "name").Unique(),
+	}
+}
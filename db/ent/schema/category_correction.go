@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// CategoryCorrection records a reviewer moving a receipt from one category to another.
+// Merchant and item names are copied so corrections stay usable as few-shot examples
+// after the receipt is re-parsed.
+type CategoryCorrection struct{ ent.Schema }
+
+func (CategoryCorrection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "category_corrections"},
+	}
+}
+
+func (CategoryCorrection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.UUID("receipt_id", uuid.UUID{}),
+		field.String("merchant_name"),
+		field.String("description").Optional().Nillable(),
+		field.String("from_category").NotEmpty(),
+		field.String("to_category").NotEmpty(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (CategoryCorrection) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY corrections -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("corrections").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (CategoryCorrection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "created_at"),
+		index.Fields("receipt_id"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// CategoryRule deterministically categorizes receipts of one profile. All set conditions
+// must match; unset conditions are ignored.
+type CategoryRule struct{ ent.Schema }
+
+func (CategoryRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "category_rules"},
+	}
+}
+
+func (CategoryRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("name").NotEmpty(),
+		field.Int("priority").Default(100), // lower runs first
+		field.Bool("enabled").Default(true),
+
+		// conditions
+		field.String("merchant_pattern").Optional().Nillable(), // case-insensitive regex
+		field.String("path_pattern").Optional().Nillable(),     // case-insensitive regex on the source file path
+		field.Strings("keywords").Optional(),                   // any of, in merchant or description
+		field.Other("min_total", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+		field.Other("max_total", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+
+		// actions
+		field.String("set_category").Optional().Nillable(),
+		field.Bool("force_review").Default(false),
+		field.String("set_description").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (CategoryRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY rules -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("rules").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (CategoryRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "name").Unique(),
+		index.Fields("profile_id", "priority"),
+	}
+}
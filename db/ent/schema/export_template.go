@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// ExportTemplate is a named column layout for the receipts spreadsheet of one profile.
+type ExportTemplate struct{ ent.Schema }
+
+func (ExportTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "export_templates"},
+	}
+}
+
+func (ExportTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("name").NotEmpty(),
+		field.JSON("columns", []entity.ExportColumn{}), // in sheet order
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (ExportTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY templates -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("export_templates").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ExportTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "name").Unique(),
+	}
+}
@@ -43,6 +43,16 @@ func (ExtractJob) Fields() []ent.Field {
 		field.String("model_name").Optional().Nillable(),
 		field.JSON("model_params", json.RawMessage{}).
 			Optional(),
+		// LLM usage/cost accounting (parse stage only)
+		field.Int("prompt_tokens").Optional().Nillable(),
+		field.Int("completion_tokens").Optional().Nillable(),
+		field.Int("image_tokens").Optional().Nillable(),
+		field.Float("cost_usd").Optional().Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "numeric(12,6)"}),
+		field.Bool("llm_cache_hit").Default(false),
+		// one entry per LLM call, including self-correction retries
+		field.JSON("parse_attempts", json.RawMessage{}).
+			Optional(),
 	}
 }
 
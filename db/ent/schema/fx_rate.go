@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// FxRate is one daily exchange rate: 1 unit of base = rate units of quote.
+type FxRate struct{ ent.Schema }
+
+func (FxRate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "fx_rates"},
+	}
+}
+
+func (FxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("base").NotEmpty().MinLen(3).MaxLen(3).
+			SchemaType(map[string]string{dialect.Postgres: "char(3)"}),
+		field.String("quote").NotEmpty().MinLen(3).MaxLen(3).
+			SchemaType(map[string]string{dialect.Postgres: "char(3)"}),
+		field.Time("rate_date").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.Float("rate").
+			Positive().
+			SchemaType(map[string]string{dialect.Postgres: "numeric(18,8)"}),
+		field.String("source").NotEmpty(), // e.g. "ecb", "csv:rates.csv"
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (FxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("base", "quote", "rate_date").Unique(),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// Merchant is a vendor in a profile's merchant directory. Receipts keep the raw extracted
+// name and point at the merchant it was matched to.
+type Merchant struct{ ent.Schema }
+
+func (Merchant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "merchants"},
+	}
+}
+
+func (Merchant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		// canonical display name, e.g. "Amazon"
+		field.String("name").NotEmpty(),
+		// raw names seen on receipts that resolve to this merchant, e.g. "AMZN Mktp US"
+		field.Strings("aliases").Optional(),
+		// normalized keys of the name and aliases, for exact lookups before fuzzy matching
+		field.Strings("keys").Optional(),
+		// category used for this merchant's receipts when the model's category is unknown
+		field.String("default_category").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Merchant) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY merchants -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("merchants").
+			Field("profile_id").
+			Required().
+			Unique(),
+		// ONE merchant -> MANY receipts
+		edge.To("receipts", Receipt.Type),
+	}
+}
+
+func (Merchant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("profile_id", "name").Unique(),
+	}
+}
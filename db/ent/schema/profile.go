@@ -39,5 +39,12 @@ func (Profile) Edges() []ent.Edge {
 		edge.To("receipts", Receipt.Type),
 		edge.To("files", ReceiptFile.Type),
 		edge.To("jobs", ExtractJob.Type),
+		edge.To("categories", Category.Type),
+		edge.To("rules", CategoryRule.Type),
+		edge.To("corrections", CategoryCorrection.Type),
+		edge.To("merchants", Merchant.Type),
+		edge.To("export_templates", ExportTemplate.Type),
+		edge.To("statement_transactions", StatementTransaction.Type),
+		edge.To("budgets", Budget.Type),
 	}
 }
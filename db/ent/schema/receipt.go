@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"encoding/json"
 	"time"
 
 	"entgo.io/ent"
@@ -12,8 +13,16 @@ import (
 	"entgo.io/ent/schema/index"
 
 	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
+// moneyColumn stores money.Amount exactly: numeric(12,2) on Postgres, NUMERIC affinity on SQLite.
+var moneyColumn = map[string]string{
+	dialect.Postgres: "numeric(12,2)",
+	dialect.SQLite:   "numeric",
+}
+
 type Receipt struct{ ent.Schema }
 
 func (Receipt) Annotations() []schema.Annotation {
@@ -28,21 +37,46 @@ func (Receipt) Fields() []ent.Field {
 		field.UUID("profile_id", uuid.UUID{}),
 		field.UUID("file_id", uuid.UUID{}).Optional().Nillable(),
 
-		field.String("merchant_name").NotEmpty(),
+		field.String("merchant_name").NotEmpty(), // as extracted
+		field.UUID("merchant_id", uuid.UUID{}).Optional().Nillable(),
 		field.Time("tx_date").
 			SchemaType(map[string]string{dialect.Postgres: "date"}).
 			Immutable(),
-		field.Float("subtotal").
+		field.Other("subtotal", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+		field.Other("tax", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+		field.Other("discount", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+		field.Other("other_fees", money.Amount(0)).
 			Optional().Nillable().
-			SchemaType(map[string]string{dialect.Postgres: "numeric(12,2)"}),
-		field.Float("tax").
+			SchemaType(moneyColumn),
+		field.Other("tip", money.Amount(0)).
 			Optional().Nillable().
-			SchemaType(map[string]string{dialect.Postgres: "numeric(12,2)"}),
-		field.Float("total").
-			SchemaType(map[string]string{dialect.Postgres: "numeric(12,2)"}),
+			SchemaType(moneyColumn),
+		// itemized fee lines: [{"name": "...", "amount": "12.50"}]
+		field.JSON("fees", json.RawMessage{}).
+			Optional(),
+		field.Other("total", money.Amount(0)).
+			SchemaType(moneyColumn),
 		field.String("currency_code").NotEmpty().MinLen(3).MaxLen(3).
 			SchemaType(map[string]string{dialect.Postgres: "char(3)"}),
 
+		// total converted to the profile's default currency at the tx_date rate
+		field.Other("converted_total", money.Amount(0)).
+			Optional().Nillable().
+			SchemaType(moneyColumn),
+		field.String("converted_currency").Optional().Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "char(3)"}),
+		field.Float("fx_rate").Optional().Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "numeric(18,8)"}),
+		field.Time("fx_rate_date").Optional().Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.String("fx_source").Optional().Nillable(),
+
 		field.String("category_name").NotEmpty(),
 		field.String("description"),
 		field.String("file_path").Optional().Nillable(),
@@ -60,10 +94,18 @@ func (Receipt) Edges() []ent.Edge {
 			Field("profile_id").
 			Required().
 			Unique(),
+		// MANY receipts -> ONE merchant (FK: receipts.merchant_id)
+		edge.From("merchant", Merchant.Type).
+			Ref("receipts").
+			Field("merchant_id").
+			Unique(),
 		// ONE receipt -> MANY files
 		edge.To("files", ReceiptFile.Type),
 		// ONE receipt -> MANY jobs
 		edge.To("jobs", ExtractJob.Type),
+		// ONE receipt -> MANY anomalies
+		edge.To("anomalies", ReceiptAnomaly.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -73,5 +115,6 @@ func (Receipt) Indexes() []ent.Index {
 		index.Fields("profile_id", "tx_date"),
 		index.Fields("profile_id", "category_name"),
 		index.Fields("profile_id", "merchant_name"),
+		index.Fields("profile_id", "merchant_id"),
 	}
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// ReceiptAnomaly records why a parsed receipt looks wrong: an amount far above the
+// profile's history for the merchant or category, or an implausible date.
+type ReceiptAnomaly struct{ ent.Schema }
+
+func (ReceiptAnomaly) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "receipt_anomalies"},
+	}
+}
+
+func (ReceiptAnomaly) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("receipt_id", uuid.UUID{}),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("kind").NotEmpty(), // merchant_amount | category_amount | future_date | before_profile
+		field.String("reason").NotEmpty(),
+		field.Float("score"), // times the usual amount, or days off for dates
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (ReceiptAnomaly) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY anomalies -> ONE receipt version
+		edge.From("receipt", Receipt.Type).
+			Ref("anomalies").
+			Field("receipt_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ReceiptAnomaly) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receipt_id", "kind").Unique(),
+		index.Fields("profile_id", "created_at"),
+	}
+}
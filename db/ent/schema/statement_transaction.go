@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// StatementTransaction is one line of an imported bank or credit-card statement, reconciled
+// against receipts. Charges are positive; payments and refunds are negative.
+type StatementTransaction struct{ ent.Schema }
+
+func (StatementTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "statement_transactions"},
+	}
+}
+
+func (StatementTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("profile_id", uuid.UUID{}),
+		field.String("account").Default(""), // card or account name; "" when not given
+		field.Time("posted_date").
+			SchemaType(map[string]string{dialect.Postgres: "date"}),
+		field.String("description").Default(""), // as printed on the statement
+		field.Other("amount", money.Amount(0)).
+			SchemaType(moneyColumn),
+		field.String("currency_code").NotEmpty().MinLen(3).MaxLen(3).
+			SchemaType(map[string]string{dialect.Postgres: "char(3)"}),
+		field.String("external_id").NotEmpty(), // OFX FITID or a hash of the CSV line
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (StatementTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		// MANY statement lines -> ONE profile
+		edge.From("profile", Profile.Type).
+			Ref("statement_transactions").
+			Field("profile_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (StatementTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		// re-importing a statement skips the lines already stored
+		index.Fields("profile_id", "account", "external_id").Unique(),
+		index.Fields("profile_id", "posted_date"),
+	}
+}
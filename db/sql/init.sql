@@ -19,6 +19,151 @@ CREATE TABLE IF NOT EXISTS profiles
     updated_at       timestamptz NOT NULL DEFAULT now()
 );
 
+-- =========================
+-- categories (per-profile expense buckets)
+-- =========================
+CREATE TABLE IF NOT EXISTS categories
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    profile_id  uuid        NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    name        text        NOT NULL,
+    description text,                -- rubric text shown to the LLM
+    synonyms    jsonb,               -- ["saas", "subscription"]
+    tax_line    text,                -- e.g. Schedule C '18', '24b'
+    account     text,                -- e.g. 'Expenses:Office:Supplies'
+    created_at  timestamptz NOT NULL DEFAULT now(),
+    updated_at  timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, name)
+);
+
+-- =========================
+-- category_rules (deterministic per-profile categorization)
+-- =========================
+CREATE TABLE IF NOT EXISTS category_rules
+(
+    id               uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    profile_id       uuid        NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    name             text        NOT NULL,
+    priority         integer     NOT NULL DEFAULT 100, -- lower runs first
+    enabled          boolean     NOT NULL DEFAULT true,
+    merchant_pattern text,                             -- case-insensitive regex
+    path_pattern     text,                             -- case-insensitive regex on source path
+    keywords         jsonb,                            -- any of, in merchant or description
+    min_total        numeric(12, 2),
+    max_total        numeric(12, 2),
+    set_category     text,
+    force_review     boolean     NOT NULL DEFAULT false,
+    set_description  text,
+    created_at       timestamptz NOT NULL DEFAULT now(),
+    updated_at       timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_rules_priority ON category_rules (profile_id, priority);
+
+-- =========================
+-- export_templates (per-profile receipts spreadsheet layouts)
+-- =========================
+CREATE TABLE IF NOT EXISTS export_templates
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    profile_id uuid        NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    name       text        NOT NULL,
+    columns    jsonb       NOT NULL, -- [{header, field, format, width}] in sheet order
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, name)
+);
+
+-- =========================
+-- statement_transactions (imported bank / card statement lines for reconciliation)
+-- =========================
+CREATE TABLE IF NOT EXISTS statement_transactions
+(
+    id            uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    profile_id    uuid           NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    account       text           NOT NULL DEFAULT '', -- card or account name
+    posted_date   date           NOT NULL,
+    description   text           NOT NULL DEFAULT '', -- as printed on the statement
+    amount        numeric(12, 2) NOT NULL,            -- charges positive, credits negative
+    currency_code char(3)        NOT NULL,
+    external_id   text           NOT NULL,            -- OFX FITID or a hash of the CSV line
+    created_at    timestamptz    NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, account, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_statement_transactions_date ON statement_transactions (profile_id, posted_date);
+
+-- =========================
+-- budgets (monthly or annual spending caps per category)
+-- =========================
+CREATE TABLE IF NOT EXISTS budgets
+(
+    id            uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    profile_id    uuid           NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    category_name text           NOT NULL,
+    period        text           NOT NULL CHECK (period IN ('monthly', 'annual')),
+    amount        numeric(12, 2) NOT NULL, -- in the profile currency
+    created_at    timestamptz    NOT NULL DEFAULT now(),
+    updated_at    timestamptz    NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, category_name, period)
+);
+
+-- =========================
+-- budget_alerts (thresholds crossed, one per budget period and threshold)
+-- =========================
+CREATE TABLE IF NOT EXISTS budget_alerts
+(
+    id            uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    budget_id     uuid           NOT NULL REFERENCES budgets (id) ON DELETE CASCADE,
+    profile_id    uuid           NOT NULL,
+    period_start  date           NOT NULL,
+    threshold     integer        NOT NULL, -- percent of the budget
+    spent         numeric(12, 2) NOT NULL,
+    budget_amount numeric(12, 2) NOT NULL,
+    receipt_id    uuid,                    -- the receipt that crossed the threshold
+    created_at    timestamptz    NOT NULL DEFAULT now(),
+    delivered_at  timestamptz,             -- NULL until a notifier accepted it
+    UNIQUE (budget_id, period_start, threshold)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budget_alerts_profile ON budget_alerts (profile_id, created_at);
+
+-- =========================
+-- category_corrections (reviewer re-categorizations; few-shot examples)
+-- =========================
+CREATE TABLE IF NOT EXISTS category_corrections
+(
+    id            uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    profile_id    uuid        NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    receipt_id    uuid        NOT NULL, -- receipt version that was corrected
+    merchant_name text        NOT NULL,
+    description   text,
+    from_category text        NOT NULL,
+    to_category   text        NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_corrections_profile ON category_corrections (profile_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_category_corrections_receipt ON category_corrections (receipt_id);
+
+-- =========================
+-- merchants (per-profile merchant directory)
+-- =========================
+CREATE TABLE IF NOT EXISTS merchants
+(
+    id               uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    profile_id       uuid        NOT NULL REFERENCES profiles (id) ON DELETE CASCADE,
+    name             text        NOT NULL, -- canonical display name
+    aliases          jsonb,                -- raw receipt names: ["AMZN Mktp US", "Amazon.com"]
+    keys             jsonb,                -- normalized name and aliases: ["amazon"]
+    default_category text,
+    created_at       timestamptz NOT NULL DEFAULT now(),
+    updated_at       timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (profile_id, name)
+);
+CREATE INDEX IF NOT EXISTS idx_merchants_keys ON merchants USING GIN (keys);
+
 -- ==================================== -- receipt_files (ingested file artifact) -- ====================================
 CREATE TABLE IF NOT EXISTS receipt_files
 (
@@ -40,11 +185,16 @@ CREATE INDEX IF NOT EXISTS idx_files_uploaded_at ON receipt_files (profile_id, u
 -- =========================
 CREATE TABLE IF NOT EXISTS receipts
 (
-    merchant_name text           NOT NULL,
+    merchant_name text           NOT NULL, -- as extracted
+    merchant_id   uuid REFERENCES merchants (id) ON DELETE SET NULL,
     tx_date       date           NOT NULL,
     total         numeric(12, 2) NOT NULL,
     subtotal      numeric(12, 2),
     tax           numeric(12, 2),
+    discount      numeric(12, 2),
+    other_fees    numeric(12, 2),
+    tip           numeric(12, 2),
+    fees          jsonb,          -- itemized fee lines: [{"name": "...", "amount": "12.50"}]
     category_name text           NOT NULL,
     description   text,
     file_path     text,
@@ -52,15 +202,67 @@ CREATE TABLE IF NOT EXISTS receipts
     profile_id    uuid           NOT NULL REFERENCES profiles (id) ON DELETE RESTRICT,
     file_id       uuid REFERENCES receipt_files (id) ON DELETE RESTRICT,
     currency_code char(3)        NOT NULL,
+    converted_total    numeric(12, 2), -- total in the profile default currency
+    converted_currency char(3),
+    fx_rate            numeric(18, 8),
+    fx_rate_date       date,
+    fx_source          text,
     created_at    timestamptz    NOT NULL DEFAULT now(),
     updated_at    timestamptz    NOT NULL DEFAULT now(),
-    is_current    boolean        NOT NULL DEFAULT true
+    is_current    boolean        NOT NULL DEFAULT true,
+    search_vector tsvector        -- maintained by trg_receipts_search
 );
+-- tables created before the fee breakdown
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS discount numeric(12, 2);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS other_fees numeric(12, 2);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS tip numeric(12, 2);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS fees jsonb;
+-- tables created before FX conversion
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS converted_total numeric(12, 2);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS converted_currency char(3);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS fx_rate numeric(18, 8);
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS fx_rate_date date;
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS fx_source text;
+-- tables created before the merchant directory
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS merchant_id uuid REFERENCES merchants (id) ON DELETE SET NULL;
 
 -- Helpful lookups
 CREATE INDEX IF NOT EXISTS idx_receipts_profile_date ON receipts (profile_id, tx_date);
 CREATE INDEX IF NOT EXISTS idx_receipts_category_name ON receipts (profile_id, category_name);
 CREATE INDEX IF NOT EXISTS idx_receipts_merchant ON receipts (merchant_name);
+CREATE INDEX IF NOT EXISTS idx_receipts_merchant_id ON receipts (profile_id, merchant_id);
+
+-- =========================
+-- receipt_anomalies (parsed receipts that look wrong against the profile's history)
+-- =========================
+CREATE TABLE IF NOT EXISTS receipt_anomalies
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    receipt_id uuid        NOT NULL REFERENCES receipts (id) ON DELETE CASCADE,
+    profile_id uuid        NOT NULL,
+    kind       text        NOT NULL, -- merchant_amount | category_amount | future_date | before_profile
+    reason     text        NOT NULL,
+    score      double precision NOT NULL, -- times the usual amount, or days off for dates
+    created_at timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (receipt_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_receipt_anomalies_profile ON receipt_anomalies (profile_id, created_at DESC);
+
+-- ==============================
+-- fx_rates (daily exchange rates; 1 base = rate quote)
+-- ==============================
+CREATE TABLE IF NOT EXISTS fx_rates
+(
+    id         uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    base       char(3)        NOT NULL,
+    quote      char(3)        NOT NULL,
+    rate_date  date           NOT NULL,
+    rate       numeric(18, 8) NOT NULL CHECK (rate > 0),
+    source     text           NOT NULL,
+    created_at timestamptz    NOT NULL DEFAULT now(),
+    UNIQUE (base, quote, rate_date)
+);
 
 -- ==============================
 -- extract_job (processing runs)
@@ -87,11 +289,76 @@ CREATE TABLE IF NOT EXISTS extract_job
     ocr_text              text,
     extracted_json        jsonb,
     model_name            text,
-    model_params          jsonb
+    model_params          jsonb,
+
+    -- LLM usage/cost accounting
+    prompt_tokens         integer,
+    completion_tokens     integer,
+    image_tokens          integer,
+    cost_usd              numeric(12, 6),
+    llm_cache_hit         boolean     NOT NULL DEFAULT false,
+    parse_attempts        jsonb -- one entry per LLM call, including self-correction retries
 );
+-- tables created before usage accounting
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS prompt_tokens integer;
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS completion_tokens integer;
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS image_tokens integer;
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS cost_usd numeric(12, 6);
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS llm_cache_hit boolean NOT NULL DEFAULT false;
+ALTER TABLE extract_job ADD COLUMN IF NOT EXISTS parse_attempts jsonb; -- tables created before self-correction
 
 CREATE INDEX IF NOT EXISTS idx_job_profile_status_started ON extract_job (profile_id, status, started_at DESC);
 CREATE INDEX IF NOT EXISTS idx_job_file ON extract_job (file_id);
 CREATE INDEX IF NOT EXISTS idx_job_receipt ON extract_job (receipt_id);
 
+-- ==============================
+-- full-text search over receipts: merchant (A), description (B) and the OCR text of the
+-- receipt's file (C)
+-- ==============================
+ALTER TABLE receipts ADD COLUMN IF NOT EXISTS search_vector tsvector;
+CREATE INDEX IF NOT EXISTS idx_receipts_search ON receipts USING GIN (search_vector);
+
+CREATE OR REPLACE FUNCTION receipts_search_vector() RETURNS trigger AS
+$$
+BEGIN
+    NEW.search_vector :=
+            setweight(to_tsvector('english', coalesce(NEW.merchant_name, '')), 'A') ||
+            setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B') ||
+            setweight(to_tsvector('english', coalesce((SELECT j.ocr_text
+                                                      FROM extract_job j
+                                                      WHERE j.file_id = NEW.file_id
+                                                        AND j.ocr_text IS NOT NULL
+                                                      ORDER BY j.started_at DESC
+                                                      LIMIT 1), '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_receipts_search ON receipts;
+CREATE TRIGGER trg_receipts_search
+    BEFORE INSERT OR UPDATE OF merchant_name, description, file_id
+    ON receipts
+    FOR EACH ROW
+EXECUTE FUNCTION receipts_search_vector();
+
+-- Re-index the file's receipts when its OCR text changes.
+CREATE OR REPLACE FUNCTION extract_job_search_refresh() RETURNS trigger AS
+$$
+BEGIN
+    UPDATE receipts SET file_id = file_id WHERE file_id = NEW.file_id;
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_extract_job_search ON extract_job;
+CREATE TRIGGER trg_extract_job_search
+    AFTER UPDATE OF ocr_text
+    ON extract_job
+    FOR EACH ROW
+    WHEN (NEW.ocr_text IS DISTINCT FROM OLD.ocr_text)
+EXECUTE FUNCTION extract_job_search_refresh();
+
+-- Backfill receipts stored before the index existed.
+UPDATE receipts SET file_id = file_id WHERE search_vector IS NULL;
+
 COMMIT;
@@ -0,0 +1,863 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// BudgetCreate is the builder for creating a Budget entity.
+type BudgetCreate struct {
+	config
+	mutation *BudgetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *BudgetCreate) SetProfileID(v uuid.UUID) *BudgetCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetCategoryName sets the "category_name" field.
+func (_c *BudgetCreate) SetCategoryName(v string) *BudgetCreate {
+	_c.mutation.SetCategoryName(v)
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *BudgetCreate) SetPeriod(v string) *BudgetCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BudgetCreate) SetAmount(v money.Amount) *BudgetCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetCreate) SetCreatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableCreatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BudgetCreate) SetUpdatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableUpdatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BudgetCreate) SetID(v uuid.UUID) *BudgetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableID(v *uuid.UUID) *BudgetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *BudgetCreate) SetProfile(v *Profile) *BudgetCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// AddAlertIDs adds the "alerts" edge to the BudgetAlert entity by IDs.
+func (_c *BudgetCreate) AddAlertIDs(ids ...uuid.UUID) *BudgetCreate {
+	_c.mutation.AddAlertIDs(ids...)
+	return _c
+}
+
+// AddAlerts adds the "alerts" edges to the BudgetAlert entity.
+func (_c *BudgetCreate) AddAlerts(v ...*BudgetAlert) *BudgetCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAlertIDs(ids...)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_c *BudgetCreate) Mutation() *BudgetMutation {
+	return _c.mutation
+}
+
+// Save creates the Budget in the database.
+func (_c *BudgetCreate) Save(ctx context.Context) (*Budget, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetCreate) SaveX(ctx context.Context) *Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := budget.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := budget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := budget.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "Budget.profile_id"`)}
+	}
+	if _, ok := _c.mutation.CategoryName(); !ok {
+		return &ValidationError{Name: "category_name", err: errors.New(`ent: missing required field "Budget.category_name"`)}
+	}
+	if v, ok := _c.mutation.CategoryName(); ok {
+		if err := budget.CategoryNameValidator(v); err != nil {
+			return &ValidationError{Name: "category_name", err: fmt.Errorf(`ent: validator failed for field "Budget.category_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "Budget.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Budget.amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Budget.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Budget.updated_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "Budget.profile"`)}
+	}
+	return nil
+}
+
+func (_c *BudgetCreate) sqlSave(ctx context.Context) (*Budget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetCreate) createSpec() (*Budget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Budget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budget.Table, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CategoryName(); ok {
+		_spec.SetField(budget.FieldCategoryName, field.TypeString, value)
+		_node.CategoryName = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budget.ProfileTable,
+			Columns: []string{budget.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.AlertsTable,
+			Columns: []string{budget.AlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertOne {
+	_c.conflict = opts
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflictColumns(columns ...string) *BudgetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetUpsertOne is the builder for "upsert"-ing
+	//  one Budget node.
+	BudgetUpsertOne struct {
+		create *BudgetCreate
+	}
+
+	// BudgetUpsert is the "OnConflict" setter.
+	BudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetUpsert) SetProfileID(v uuid.UUID) *BudgetUpsert {
+	u.Set(budget.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateProfileID() *BudgetUpsert {
+	u.SetExcluded(budget.FieldProfileID)
+	return u
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *BudgetUpsert) SetCategoryName(v string) *BudgetUpsert {
+	u.Set(budget.FieldCategoryName, v)
+	return u
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateCategoryName() *BudgetUpsert {
+	u.SetExcluded(budget.FieldCategoryName)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsert) SetPeriod(v string) *BudgetUpsert {
+	u.Set(budget.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdatePeriod() *BudgetUpsert {
+	u.SetExcluded(budget.FieldPeriod)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsert) SetAmount(v money.Amount) *BudgetUpsert {
+	u.Set(budget.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAmount() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAmount)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetUpsert) SetCreatedAt(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateCreatedAt() *BudgetUpsert {
+	u.SetExcluded(budget.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsert) SetUpdatedAt(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateUpdatedAt() *BudgetUpsert {
+	u.SetExcluded(budget.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertOne) UpdateNewValues() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budget.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetUpsertOne) Ignore() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertOne) DoNothing() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreate.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertOne) Update(set func(*BudgetUpsert)) *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetUpsertOne) SetProfileID(v uuid.UUID) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateProfileID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *BudgetUpsertOne) SetCategoryName(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateCategoryName() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertOne) SetPeriod(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdatePeriod() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertOne) SetAmount(v money.Amount) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAmount() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetUpsertOne) SetCreatedAt(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateCreatedAt() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertOne) SetUpdatedAt(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateUpdatedAt() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BudgetUpsertOne.ID is not supported by MySQL driver. Use BudgetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetCreateBulk is the builder for creating many Budget entities in bulk.
+type BudgetCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Budget entities in the database.
+func (_c *BudgetCreateBulk) Save(ctx context.Context) ([]*Budget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Budget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetCreateBulk) SaveX(ctx context.Context) []*Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertBulk {
+	_c.conflict = opts
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflictColumns(columns ...string) *BudgetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Budget nodes.
+type BudgetUpsertBulk struct {
+	create *BudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) UpdateNewValues() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budget.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) Ignore() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertBulk) DoNothing() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertBulk) Update(set func(*BudgetUpsert)) *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetUpsertBulk) SetProfileID(v uuid.UUID) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateProfileID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *BudgetUpsertBulk) SetCategoryName(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateCategoryName() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertBulk) SetPeriod(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdatePeriod() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertBulk) SetAmount(v money.Amount) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAmount() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetUpsertBulk) SetCreatedAt(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateCreatedAt() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertBulk) SetUpdatedAt(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateUpdatedAt() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// BudgetAlert is the model entity for the BudgetAlert schema.
+type BudgetAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// BudgetID holds the value of the "budget_id" field.
+	BudgetID uuid.UUID `json:"budget_id,omitempty"`
+	// ProfileID holds the value of the "profile_id" field.
+	ProfileID uuid.UUID `json:"profile_id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold int `json:"threshold,omitempty"`
+	// Spent holds the value of the "spent" field.
+	Spent money.Amount `json:"spent,omitempty"`
+	// BudgetAmount holds the value of the "budget_amount" field.
+	BudgetAmount money.Amount `json:"budget_amount,omitempty"`
+	// ReceiptID holds the value of the "receipt_id" field.
+	ReceiptID *uuid.UUID `json:"receipt_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetAlertQuery when eager-loading is set.
+	Edges        BudgetAlertEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BudgetAlertEdges holds the relations/edges for other nodes in the graph.
+type BudgetAlertEdges struct {
+	// Budget holds the value of the budget edge.
+	Budget *Budget `json:"budget,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BudgetOrErr returns the Budget value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetAlertEdges) BudgetOrErr() (*Budget, error) {
+	if e.Budget != nil {
+		return e.Budget, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: budget.Label}
+	}
+	return nil, &NotLoadedError{edge: "budget"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BudgetAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldReceiptID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case budgetalert.FieldSpent, budgetalert.FieldBudgetAmount:
+			values[i] = new(money.Amount)
+		case budgetalert.FieldThreshold:
+			values[i] = new(sql.NullInt64)
+		case budgetalert.FieldPeriodStart, budgetalert.FieldCreatedAt, budgetalert.FieldDeliveredAt:
+			values[i] = new(sql.NullTime)
+		case budgetalert.FieldID, budgetalert.FieldBudgetID, budgetalert.FieldProfileID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BudgetAlert fields.
+func (_m *BudgetAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case budgetalert.FieldBudgetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_id", values[i])
+			} else if value != nil {
+				_m.BudgetID = *value
+			}
+		case budgetalert.FieldProfileID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_id", values[i])
+			} else if value != nil {
+				_m.ProfileID = *value
+			}
+		case budgetalert.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case budgetalert.FieldThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = int(value.Int64)
+			}
+		case budgetalert.FieldSpent:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field spent", values[i])
+			} else if value != nil {
+				_m.Spent = *value
+			}
+		case budgetalert.FieldBudgetAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_amount", values[i])
+			} else if value != nil {
+				_m.BudgetAmount = *value
+			}
+		case budgetalert.FieldReceiptID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value.Valid {
+				_m.ReceiptID = new(uuid.UUID)
+				*_m.ReceiptID = *value.S.(*uuid.UUID)
+			}
+		case budgetalert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case budgetalert.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = new(time.Time)
+				*_m.DeliveredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BudgetAlert.
+// This includes values selected through modifiers, order, etc.
+func (_m *BudgetAlert) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBudget queries the "budget" edge of the BudgetAlert entity.
+func (_m *BudgetAlert) QueryBudget() *BudgetQuery {
+	return NewBudgetAlertClient(_m.config).QueryBudget(_m)
+}
+
+// Update returns a builder for updating this BudgetAlert.
+// Note that you need to call BudgetAlert.Unwrap() before calling this method if this BudgetAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BudgetAlert) Update() *BudgetAlertUpdateOne {
+	return NewBudgetAlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BudgetAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BudgetAlert) Unwrap() *BudgetAlert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BudgetAlert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BudgetAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("BudgetAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("budget_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BudgetID))
+	builder.WriteString(", ")
+	builder.WriteString("profile_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfileID))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("spent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Spent))
+	builder.WriteString(", ")
+	builder.WriteString("budget_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BudgetAmount))
+	builder.WriteString(", ")
+	if v := _m.ReceiptID; v != nil {
+		builder.WriteString("receipt_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BudgetAlerts is a parsable slice of BudgetAlert.
+type BudgetAlerts []*BudgetAlert
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetalert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the budgetalert type in the database.
+	Label = "budget_alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBudgetID holds the string denoting the budget_id field in the database.
+	FieldBudgetID = "budget_id"
+	// FieldProfileID holds the string denoting the profile_id field in the database.
+	FieldProfileID = "profile_id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldSpent holds the string denoting the spent field in the database.
+	FieldSpent = "spent"
+	// FieldBudgetAmount holds the string denoting the budget_amount field in the database.
+	FieldBudgetAmount = "budget_amount"
+	// FieldReceiptID holds the string denoting the receipt_id field in the database.
+	FieldReceiptID = "receipt_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// EdgeBudget holds the string denoting the budget edge name in mutations.
+	EdgeBudget = "budget"
+	// Table holds the table name of the budgetalert in the database.
+	Table = "budget_alerts"
+	// BudgetTable is the table that holds the budget relation/edge.
+	BudgetTable = "budget_alerts"
+	// BudgetInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetInverseTable = "budgets"
+	// BudgetColumn is the table column denoting the budget relation/edge.
+	BudgetColumn = "budget_id"
+)
+
+// Columns holds all SQL columns for budgetalert fields.
+var Columns = []string{
+	FieldID,
+	FieldBudgetID,
+	FieldProfileID,
+	FieldPeriodStart,
+	FieldThreshold,
+	FieldSpent,
+	FieldBudgetAmount,
+	FieldReceiptID,
+	FieldCreatedAt,
+	FieldDeliveredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BudgetAlert queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBudgetID orders the results by the budget_id field.
+func ByBudgetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetID, opts...).ToFunc()
+}
+
+// ByProfileID orders the results by the profile_id field.
+func ByProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfileID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByThreshold orders the results by the threshold field.
+func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
+}
+
+// BySpent orders the results by the spent field.
+func BySpent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpent, opts...).ToFunc()
+}
+
+// ByBudgetAmount orders the results by the budget_amount field.
+func ByBudgetAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetAmount, opts...).ToFunc()
+}
+
+// ByReceiptID orders the results by the receipt_id field.
+func ByReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDeliveredAt orders the results by the delivered_at field.
+func ByDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredAt, opts...).ToFunc()
+}
+
+// ByBudgetField orders the results by budget field.
+func ByBudgetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBudgetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+	)
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetalert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldID, id))
+}
+
+// BudgetID applies equality check predicate on the "budget_id" field. It's identical to BudgetIDEQ.
+func BudgetID(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// ProfileID applies equality check predicate on the "profile_id" field. It's identical to ProfileIDEQ.
+func ProfileID(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldProfileID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// Spent applies equality check predicate on the "spent" field. It's identical to SpentEQ.
+func Spent(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSpent, v))
+}
+
+// BudgetAmount applies equality check predicate on the "budget_amount" field. It's identical to BudgetAmountEQ.
+func BudgetAmount(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudgetAmount, v))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// BudgetIDEQ applies the EQ predicate on the "budget_id" field.
+func BudgetIDEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// BudgetIDNEQ applies the NEQ predicate on the "budget_id" field.
+func BudgetIDNEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldBudgetID, v))
+}
+
+// BudgetIDIn applies the In predicate on the "budget_id" field.
+func BudgetIDIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDNotIn applies the NotIn predicate on the "budget_id" field.
+func BudgetIDNotIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldBudgetID, vs...))
+}
+
+// ProfileIDEQ applies the EQ predicate on the "profile_id" field.
+func ProfileIDEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ProfileIDNEQ applies the NEQ predicate on the "profile_id" field.
+func ProfileIDNEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldProfileID, v))
+}
+
+// ProfileIDIn applies the In predicate on the "profile_id" field.
+func ProfileIDIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldProfileID, vs...))
+}
+
+// ProfileIDNotIn applies the NotIn predicate on the "profile_id" field.
+func ProfileIDNotIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldProfileID, vs...))
+}
+
+// ProfileIDGT applies the GT predicate on the "profile_id" field.
+func ProfileIDGT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldProfileID, v))
+}
+
+// ProfileIDGTE applies the GTE predicate on the "profile_id" field.
+func ProfileIDGTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldProfileID, v))
+}
+
+// ProfileIDLT applies the LT predicate on the "profile_id" field.
+func ProfileIDLT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldProfileID, v))
+}
+
+// ProfileIDLTE applies the LTE predicate on the "profile_id" field.
+func ProfileIDLTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldProfileID, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldThreshold, v))
+}
+
+// SpentEQ applies the EQ predicate on the "spent" field.
+func SpentEQ(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSpent, v))
+}
+
+// SpentNEQ applies the NEQ predicate on the "spent" field.
+func SpentNEQ(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldSpent, v))
+}
+
+// SpentIn applies the In predicate on the "spent" field.
+func SpentIn(vs ...money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldSpent, vs...))
+}
+
+// SpentNotIn applies the NotIn predicate on the "spent" field.
+func SpentNotIn(vs ...money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldSpent, vs...))
+}
+
+// SpentGT applies the GT predicate on the "spent" field.
+func SpentGT(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldSpent, v))
+}
+
+// SpentGTE applies the GTE predicate on the "spent" field.
+func SpentGTE(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldSpent, v))
+}
+
+// SpentLT applies the LT predicate on the "spent" field.
+func SpentLT(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldSpent, v))
+}
+
+// SpentLTE applies the LTE predicate on the "spent" field.
+func SpentLTE(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldSpent, v))
+}
+
+// BudgetAmountEQ applies the EQ predicate on the "budget_amount" field.
+func BudgetAmountEQ(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudgetAmount, v))
+}
+
+// BudgetAmountNEQ applies the NEQ predicate on the "budget_amount" field.
+func BudgetAmountNEQ(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldBudgetAmount, v))
+}
+
+// BudgetAmountIn applies the In predicate on the "budget_amount" field.
+func BudgetAmountIn(vs ...money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldBudgetAmount, vs...))
+}
+
+// BudgetAmountNotIn applies the NotIn predicate on the "budget_amount" field.
+func BudgetAmountNotIn(vs ...money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldBudgetAmount, vs...))
+}
+
+// BudgetAmountGT applies the GT predicate on the "budget_amount" field.
+func BudgetAmountGT(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldBudgetAmount, v))
+}
+
+// BudgetAmountGTE applies the GTE predicate on the "budget_amount" field.
+func BudgetAmountGTE(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldBudgetAmount, v))
+}
+
+// BudgetAmountLT applies the LT predicate on the "budget_amount" field.
+func BudgetAmountLT(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldBudgetAmount, v))
+}
+
+// BudgetAmountLTE applies the LTE predicate on the "budget_amount" field.
+func BudgetAmountLTE(v money.Amount) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldBudgetAmount, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDGT applies the GT predicate on the "receipt_id" field.
+func ReceiptIDGT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldReceiptID, v))
+}
+
+// ReceiptIDGTE applies the GTE predicate on the "receipt_id" field.
+func ReceiptIDGTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldReceiptID, v))
+}
+
+// ReceiptIDLT applies the LT predicate on the "receipt_id" field.
+func ReceiptIDLT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldReceiptID, v))
+}
+
+// ReceiptIDLTE applies the LTE predicate on the "receipt_id" field.
+func ReceiptIDLTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldReceiptID, v))
+}
+
+// ReceiptIDIsNil applies the IsNil predicate on the "receipt_id" field.
+func ReceiptIDIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldReceiptID))
+}
+
+// ReceiptIDNotNil applies the NotNil predicate on the "receipt_id" field.
+func ReceiptIDNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldReceiptID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// HasBudget applies the HasEdge predicate on the "budget" edge.
+func HasBudget() predicate.BudgetAlert {
+	return predicate.BudgetAlert(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetWith applies the HasEdge predicate on the "budget" edge with a given conditions (other predicates).
+func HasBudgetWith(preds ...predicate.Budget) predicate.BudgetAlert {
+	return predicate.BudgetAlert(func(s *sql.Selector) {
+		step := newBudgetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.NotPredicates(p))
+}
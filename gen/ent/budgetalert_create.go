@@ -0,0 +1,1004 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// BudgetAlertCreate is the builder for creating a BudgetAlert entity.
+type BudgetAlertCreate struct {
+	config
+	mutation *BudgetAlertMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_c *BudgetAlertCreate) SetBudgetID(v uuid.UUID) *BudgetAlertCreate {
+	_c.mutation.SetBudgetID(v)
+	return _c
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *BudgetAlertCreate) SetProfileID(v uuid.UUID) *BudgetAlertCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *BudgetAlertCreate) SetPeriodStart(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetThreshold sets the "threshold" field.
+func (_c *BudgetAlertCreate) SetThreshold(v int) *BudgetAlertCreate {
+	_c.mutation.SetThreshold(v)
+	return _c
+}
+
+// SetSpent sets the "spent" field.
+func (_c *BudgetAlertCreate) SetSpent(v money.Amount) *BudgetAlertCreate {
+	_c.mutation.SetSpent(v)
+	return _c
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (_c *BudgetAlertCreate) SetBudgetAmount(v money.Amount) *BudgetAlertCreate {
+	_c.mutation.SetBudgetAmount(v)
+	return _c
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *BudgetAlertCreate) SetReceiptID(v uuid.UUID) *BudgetAlertCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableReceiptID(v *uuid.UUID) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetReceiptID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetAlertCreate) SetCreatedAt(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableCreatedAt(v *time.Time) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *BudgetAlertCreate) SetDeliveredAt(v time.Time) *BudgetAlertCreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableDeliveredAt(v *time.Time) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BudgetAlertCreate) SetID(v uuid.UUID) *BudgetAlertCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BudgetAlertCreate) SetNillableID(v *uuid.UUID) *BudgetAlertCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_c *BudgetAlertCreate) SetBudget(v *Budget) *BudgetAlertCreate {
+	return _c.SetBudgetID(v.ID)
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_c *BudgetAlertCreate) Mutation() *BudgetAlertMutation {
+	return _c.mutation
+}
+
+// Save creates the BudgetAlert in the database.
+func (_c *BudgetAlertCreate) Save(ctx context.Context) (*BudgetAlert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetAlertCreate) SaveX(ctx context.Context) *BudgetAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetAlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetAlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetAlertCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := budgetalert.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := budgetalert.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetAlertCreate) check() error {
+	if _, ok := _c.mutation.BudgetID(); !ok {
+		return &ValidationError{Name: "budget_id", err: errors.New(`ent: missing required field "BudgetAlert.budget_id"`)}
+	}
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "BudgetAlert.profile_id"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "BudgetAlert.period_start"`)}
+	}
+	if _, ok := _c.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "BudgetAlert.threshold"`)}
+	}
+	if _, ok := _c.mutation.Spent(); !ok {
+		return &ValidationError{Name: "spent", err: errors.New(`ent: missing required field "BudgetAlert.spent"`)}
+	}
+	if _, ok := _c.mutation.BudgetAmount(); !ok {
+		return &ValidationError{Name: "budget_amount", err: errors.New(`ent: missing required field "BudgetAlert.budget_amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BudgetAlert.created_at"`)}
+	}
+	if len(_c.mutation.BudgetIDs()) == 0 {
+		return &ValidationError{Name: "budget", err: errors.New(`ent: missing required edge "BudgetAlert.budget"`)}
+	}
+	return nil
+}
+
+func (_c *BudgetAlertCreate) sqlSave(ctx context.Context) (*BudgetAlert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetAlertCreate) createSpec() (*BudgetAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BudgetAlert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budgetalert.Table, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ProfileID(); ok {
+		_spec.SetField(budgetalert.FieldProfileID, field.TypeUUID, value)
+		_node.ProfileID = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(budgetalert.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = value
+	}
+	if value, ok := _c.mutation.Spent(); ok {
+		_spec.SetField(budgetalert.FieldSpent, field.TypeOther, value)
+		_node.Spent = value
+	}
+	if value, ok := _c.mutation.BudgetAmount(); ok {
+		_spec.SetField(budgetalert.FieldBudgetAmount, field.TypeOther, value)
+		_node.BudgetAmount = value
+	}
+	if value, ok := _c.mutation.ReceiptID(); ok {
+		_spec.SetField(budgetalert.FieldReceiptID, field.TypeUUID, value)
+		_node.ReceiptID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetalert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(budgetalert.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if nodes := _c.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetalert.BudgetTable,
+			Columns: []string{budgetalert.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BudgetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.Create().
+//		SetBudgetID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetBudgetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetAlertCreate) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertOne {
+	_c.conflict = opts
+	return &BudgetAlertUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetAlertCreate) OnConflictColumns(columns ...string) *BudgetAlertUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetAlertUpsertOne is the builder for "upsert"-ing
+	//  one BudgetAlert node.
+	BudgetAlertUpsertOne struct {
+		create *BudgetAlertCreate
+	}
+
+	// BudgetAlertUpsert is the "OnConflict" setter.
+	BudgetAlertUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBudgetID sets the "budget_id" field.
+func (u *BudgetAlertUpsert) SetBudgetID(v uuid.UUID) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldBudgetID, v)
+	return u
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateBudgetID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldBudgetID)
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetAlertUpsert) SetProfileID(v uuid.UUID) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateProfileID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldProfileID)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BudgetAlertUpsert) SetPeriodStart(v time.Time) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdatePeriodStart() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldPeriodStart)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsert) SetThreshold(v int) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateThreshold() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsert) AddThreshold(v int) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// SetSpent sets the "spent" field.
+func (u *BudgetAlertUpsert) SetSpent(v money.Amount) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldSpent, v)
+	return u
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateSpent() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldSpent)
+	return u
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (u *BudgetAlertUpsert) SetBudgetAmount(v money.Amount) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldBudgetAmount, v)
+	return u
+}
+
+// UpdateBudgetAmount sets the "budget_amount" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateBudgetAmount() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldBudgetAmount)
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *BudgetAlertUpsert) SetReceiptID(v uuid.UUID) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateReceiptID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldReceiptID)
+	return u
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *BudgetAlertUpsert) ClearReceiptID() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldReceiptID)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *BudgetAlertUpsert) SetDeliveredAt(v time.Time) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateDeliveredAt() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *BudgetAlertUpsert) ClearDeliveredAt() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldDeliveredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetalert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertOne) UpdateNewValues() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budgetalert.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(budgetalert.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetAlertUpsertOne) Ignore() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertOne) DoNothing() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreate.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertOne) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (u *BudgetAlertUpsertOne) SetBudgetID(v uuid.UUID) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudgetID(v)
+	})
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateBudgetID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudgetID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetAlertUpsertOne) SetProfileID(v uuid.UUID) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateProfileID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BudgetAlertUpsertOne) SetPeriodStart(v time.Time) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdatePeriodStart() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertOne) SetThreshold(v int) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertOne) AddThreshold(v int) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateThreshold() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *BudgetAlertUpsertOne) SetSpent(v money.Amount) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateSpent() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSpent()
+	})
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (u *BudgetAlertUpsertOne) SetBudgetAmount(v money.Amount) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudgetAmount(v)
+	})
+}
+
+// UpdateBudgetAmount sets the "budget_amount" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateBudgetAmount() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudgetAmount()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *BudgetAlertUpsertOne) SetReceiptID(v uuid.UUID) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateReceiptID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *BudgetAlertUpsertOne) ClearReceiptID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *BudgetAlertUpsertOne) SetDeliveredAt(v time.Time) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateDeliveredAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *BudgetAlertUpsertOne) ClearDeliveredAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetAlertCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetAlertUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BudgetAlertUpsertOne.ID is not supported by MySQL driver. Use BudgetAlertUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetAlertCreateBulk is the builder for creating many BudgetAlert entities in bulk.
+type BudgetAlertCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetAlertCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BudgetAlert entities in the database.
+func (_c *BudgetAlertCreateBulk) Save(ctx context.Context) ([]*BudgetAlert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BudgetAlert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetAlertCreateBulk) SaveX(ctx context.Context) []*BudgetAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetBudgetID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetAlertCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertBulk {
+	_c.conflict = opts
+	return &BudgetAlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetAlertCreateBulk) OnConflictColumns(columns ...string) *BudgetAlertUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetAlertUpsertBulk is the builder for "upsert"-ing
+// a bulk of BudgetAlert nodes.
+type BudgetAlertUpsertBulk struct {
+	create *BudgetAlertCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetalert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) UpdateNewValues() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budgetalert.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(budgetalert.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) Ignore() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertBulk) DoNothing() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertBulk) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (u *BudgetAlertUpsertBulk) SetBudgetID(v uuid.UUID) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudgetID(v)
+	})
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateBudgetID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudgetID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *BudgetAlertUpsertBulk) SetProfileID(v uuid.UUID) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateProfileID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BudgetAlertUpsertBulk) SetPeriodStart(v time.Time) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdatePeriodStart() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertBulk) SetThreshold(v int) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertBulk) AddThreshold(v int) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateThreshold() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetSpent sets the "spent" field.
+func (u *BudgetAlertUpsertBulk) SetSpent(v money.Amount) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSpent(v)
+	})
+}
+
+// UpdateSpent sets the "spent" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateSpent() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSpent()
+	})
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (u *BudgetAlertUpsertBulk) SetBudgetAmount(v money.Amount) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudgetAmount(v)
+	})
+}
+
+// UpdateBudgetAmount sets the "budget_amount" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateBudgetAmount() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudgetAmount()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *BudgetAlertUpsertBulk) SetReceiptID(v uuid.UUID) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateReceiptID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *BudgetAlertUpsertBulk) ClearReceiptID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *BudgetAlertUpsertBulk) SetDeliveredAt(v time.Time) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateDeliveredAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *BudgetAlertUpsertBulk) ClearDeliveredAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetAlertCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetAlertCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
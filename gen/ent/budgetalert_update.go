@@ -0,0 +1,619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// BudgetAlertUpdate is the builder for updating BudgetAlert entities.
+type BudgetAlertUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BudgetAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (_u *BudgetAlertUpdate) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_u *BudgetAlertUpdate) SetBudgetID(v uuid.UUID) *BudgetAlertUpdate {
+	_u.mutation.SetBudgetID(v)
+	return _u
+}
+
+// SetNillableBudgetID sets the "budget_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableBudgetID(v *uuid.UUID) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetBudgetID(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *BudgetAlertUpdate) SetProfileID(v uuid.UUID) *BudgetAlertUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableProfileID(v *uuid.UUID) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BudgetAlertUpdate) SetPeriodStart(v time.Time) *BudgetAlertUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillablePeriodStart(v *time.Time) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *BudgetAlertUpdate) SetThreshold(v int) *BudgetAlertUpdate {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableThreshold(v *int) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *BudgetAlertUpdate) AddThreshold(v int) *BudgetAlertUpdate {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetSpent sets the "spent" field.
+func (_u *BudgetAlertUpdate) SetSpent(v money.Amount) *BudgetAlertUpdate {
+	_u.mutation.SetSpent(v)
+	return _u
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableSpent(v *money.Amount) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetSpent(*v)
+	}
+	return _u
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (_u *BudgetAlertUpdate) SetBudgetAmount(v money.Amount) *BudgetAlertUpdate {
+	_u.mutation.SetBudgetAmount(v)
+	return _u
+}
+
+// SetNillableBudgetAmount sets the "budget_amount" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableBudgetAmount(v *money.Amount) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetBudgetAmount(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *BudgetAlertUpdate) SetReceiptID(v uuid.UUID) *BudgetAlertUpdate {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableReceiptID(v *uuid.UUID) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (_u *BudgetAlertUpdate) ClearReceiptID() *BudgetAlertUpdate {
+	_u.mutation.ClearReceiptID()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *BudgetAlertUpdate) SetDeliveredAt(v time.Time) *BudgetAlertUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *BudgetAlertUpdate) SetNillableDeliveredAt(v *time.Time) *BudgetAlertUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *BudgetAlertUpdate) ClearDeliveredAt() *BudgetAlertUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_u *BudgetAlertUpdate) SetBudget(v *Budget) *BudgetAlertUpdate {
+	return _u.SetBudgetID(v.ID)
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_u *BudgetAlertUpdate) Mutation() *BudgetAlertMutation {
+	return _u.mutation
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (_u *BudgetAlertUpdate) ClearBudget() *BudgetAlertUpdate {
+	_u.mutation.ClearBudget()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BudgetAlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BudgetAlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetAlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetAlertUpdate) check() error {
+	if _u.mutation.BudgetCleared() && len(_u.mutation.BudgetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BudgetAlert.budget"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BudgetAlertUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetAlertUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BudgetAlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProfileID(); ok {
+		_spec.SetField(budgetalert.FieldProfileID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(budgetalert.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Spent(); ok {
+		_spec.SetField(budgetalert.FieldSpent, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.BudgetAmount(); ok {
+		_spec.SetField(budgetalert.FieldBudgetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(budgetalert.FieldReceiptID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReceiptIDCleared() {
+		_spec.ClearField(budgetalert.FieldReceiptID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(budgetalert.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(budgetalert.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.BudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetalert.BudgetTable,
+			Columns: []string{budgetalert.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetalert.BudgetTable,
+			Columns: []string{budgetalert.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BudgetAlertUpdateOne is the builder for updating a single BudgetAlert entity.
+type BudgetAlertUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BudgetAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_u *BudgetAlertUpdateOne) SetBudgetID(v uuid.UUID) *BudgetAlertUpdateOne {
+	_u.mutation.SetBudgetID(v)
+	return _u
+}
+
+// SetNillableBudgetID sets the "budget_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableBudgetID(v *uuid.UUID) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetBudgetID(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *BudgetAlertUpdateOne) SetProfileID(v uuid.UUID) *BudgetAlertUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableProfileID(v *uuid.UUID) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BudgetAlertUpdateOne) SetPeriodStart(v time.Time) *BudgetAlertUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillablePeriodStart(v *time.Time) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetThreshold sets the "threshold" field.
+func (_u *BudgetAlertUpdateOne) SetThreshold(v int) *BudgetAlertUpdateOne {
+	_u.mutation.ResetThreshold()
+	_u.mutation.SetThreshold(v)
+	return _u
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableThreshold(v *int) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetThreshold(*v)
+	}
+	return _u
+}
+
+// AddThreshold adds value to the "threshold" field.
+func (_u *BudgetAlertUpdateOne) AddThreshold(v int) *BudgetAlertUpdateOne {
+	_u.mutation.AddThreshold(v)
+	return _u
+}
+
+// SetSpent sets the "spent" field.
+func (_u *BudgetAlertUpdateOne) SetSpent(v money.Amount) *BudgetAlertUpdateOne {
+	_u.mutation.SetSpent(v)
+	return _u
+}
+
+// SetNillableSpent sets the "spent" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableSpent(v *money.Amount) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetSpent(*v)
+	}
+	return _u
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (_u *BudgetAlertUpdateOne) SetBudgetAmount(v money.Amount) *BudgetAlertUpdateOne {
+	_u.mutation.SetBudgetAmount(v)
+	return _u
+}
+
+// SetNillableBudgetAmount sets the "budget_amount" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableBudgetAmount(v *money.Amount) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetBudgetAmount(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *BudgetAlertUpdateOne) SetReceiptID(v uuid.UUID) *BudgetAlertUpdateOne {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableReceiptID(v *uuid.UUID) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (_u *BudgetAlertUpdateOne) ClearReceiptID() *BudgetAlertUpdateOne {
+	_u.mutation.ClearReceiptID()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *BudgetAlertUpdateOne) SetDeliveredAt(v time.Time) *BudgetAlertUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *BudgetAlertUpdateOne) SetNillableDeliveredAt(v *time.Time) *BudgetAlertUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *BudgetAlertUpdateOne) ClearDeliveredAt() *BudgetAlertUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_u *BudgetAlertUpdateOne) SetBudget(v *Budget) *BudgetAlertUpdateOne {
+	return _u.SetBudgetID(v.ID)
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (_u *BudgetAlertUpdateOne) Mutation() *BudgetAlertMutation {
+	return _u.mutation
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (_u *BudgetAlertUpdateOne) ClearBudget() *BudgetAlertUpdateOne {
+	_u.mutation.ClearBudget()
+	return _u
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (_u *BudgetAlertUpdateOne) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BudgetAlertUpdateOne) Select(field string, fields ...string) *BudgetAlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BudgetAlert entity.
+func (_u *BudgetAlertUpdateOne) Save(ctx context.Context) (*BudgetAlert, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetAlertUpdateOne) SaveX(ctx context.Context) *BudgetAlert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BudgetAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetAlertUpdateOne) check() error {
+	if _u.mutation.BudgetCleared() && len(_u.mutation.BudgetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BudgetAlert.budget"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BudgetAlertUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetAlertUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BudgetAlertUpdateOne) sqlSave(ctx context.Context) (_node *BudgetAlert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BudgetAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetalert.FieldID)
+		for _, f := range fields {
+			if !budgetalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != budgetalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProfileID(); ok {
+		_spec.SetField(budgetalert.FieldProfileID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(budgetalert.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Spent(); ok {
+		_spec.SetField(budgetalert.FieldSpent, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.BudgetAmount(); ok {
+		_spec.SetField(budgetalert.FieldBudgetAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(budgetalert.FieldReceiptID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReceiptIDCleared() {
+		_spec.ClearField(budgetalert.FieldReceiptID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(budgetalert.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(budgetalert.FieldDeliveredAt, field.TypeTime)
+	}
+	if _u.mutation.BudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetalert.BudgetTable,
+			Columns: []string{budgetalert.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetalert.BudgetTable,
+			Columns: []string{budgetalert.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BudgetAlert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+)
+
+// CategoryCorrection is the model entity for the CategoryCorrection schema.
+type CategoryCorrection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ProfileID holds the value of the "profile_id" field.
+	ProfileID uuid.UUID `json:"profile_id,omitempty"`
+	// ReceiptID holds the value of the "receipt_id" field.
+	ReceiptID uuid.UUID `json:"receipt_id,omitempty"`
+	// MerchantName holds the value of the "merchant_name" field.
+	MerchantName string `json:"merchant_name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// FromCategory holds the value of the "from_category" field.
+	FromCategory string `json:"from_category,omitempty"`
+	// ToCategory holds the value of the "to_category" field.
+	ToCategory string `json:"to_category,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryCorrectionQuery when eager-loading is set.
+	Edges        CategoryCorrectionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryCorrectionEdges holds the relations/edges for other nodes in the graph.
+type CategoryCorrectionEdges struct {
+	// Profile holds the value of the profile edge.
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryCorrectionEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryCorrection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorycorrection.FieldMerchantName, categorycorrection.FieldDescription, categorycorrection.FieldFromCategory, categorycorrection.FieldToCategory:
+			values[i] = new(sql.NullString)
+		case categorycorrection.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case categorycorrection.FieldID, categorycorrection.FieldProfileID, categorycorrection.FieldReceiptID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryCorrection fields.
+func (_m *CategoryCorrection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorycorrection.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case categorycorrection.FieldProfileID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_id", values[i])
+			} else if value != nil {
+				_m.ProfileID = *value
+			}
+		case categorycorrection.FieldReceiptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value != nil {
+				_m.ReceiptID = *value
+			}
+		case categorycorrection.FieldMerchantName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_name", values[i])
+			} else if value.Valid {
+				_m.MerchantName = value.String
+			}
+		case categorycorrection.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case categorycorrection.FieldFromCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_category", values[i])
+			} else if value.Valid {
+				_m.FromCategory = value.String
+			}
+		case categorycorrection.FieldToCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_category", values[i])
+			} else if value.Valid {
+				_m.ToCategory = value.String
+			}
+		case categorycorrection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryCorrection.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryCorrection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the CategoryCorrection entity.
+func (_m *CategoryCorrection) QueryProfile() *ProfileQuery {
+	return NewCategoryCorrectionClient(_m.config).QueryProfile(_m)
+}
+
+// Update returns a builder for updating this CategoryCorrection.
+// Note that you need to call CategoryCorrection.Unwrap() before calling this method if this CategoryCorrection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryCorrection) Update() *CategoryCorrectionUpdateOne {
+	return NewCategoryCorrectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryCorrection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryCorrection) Unwrap() *CategoryCorrection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryCorrection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryCorrection) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryCorrection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("profile_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfileID))
+	builder.WriteString(", ")
+	builder.WriteString("receipt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiptID))
+	builder.WriteString(", ")
+	builder.WriteString("merchant_name=")
+	builder.WriteString(_m.MerchantName)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("from_category=")
+	builder.WriteString(_m.FromCategory)
+	builder.WriteString(", ")
+	builder.WriteString("to_category=")
+	builder.WriteString(_m.ToCategory)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryCorrections is a parsable slice of CategoryCorrection.
+type CategoryCorrections []*CategoryCorrection
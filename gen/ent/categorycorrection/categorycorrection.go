@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorycorrection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the categorycorrection type in the database.
+	Label = "category_correction"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProfileID holds the string denoting the profile_id field in the database.
+	FieldProfileID = "profile_id"
+	// FieldReceiptID holds the string denoting the receipt_id field in the database.
+	FieldReceiptID = "receipt_id"
+	// FieldMerchantName holds the string denoting the merchant_name field in the database.
+	FieldMerchantName = "merchant_name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldFromCategory holds the string denoting the from_category field in the database.
+	FieldFromCategory = "from_category"
+	// FieldToCategory holds the string denoting the to_category field in the database.
+	FieldToCategory = "to_category"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the categorycorrection in the database.
+	Table = "category_corrections"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "category_corrections"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_id"
+)
+
+// Columns holds all SQL columns for categorycorrection fields.
+var Columns = []string{
+	FieldID,
+	FieldProfileID,
+	FieldReceiptID,
+	FieldMerchantName,
+	FieldDescription,
+	FieldFromCategory,
+	FieldToCategory,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FromCategoryValidator is a validator for the "from_category" field. It is called by the builders before save.
+	FromCategoryValidator func(string) error
+	// ToCategoryValidator is a validator for the "to_category" field. It is called by the builders before save.
+	ToCategoryValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CategoryCorrection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProfileID orders the results by the profile_id field.
+func ByProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfileID, opts...).ToFunc()
+}
+
+// ByReceiptID orders the results by the receipt_id field.
+func ByReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptID, opts...).ToFunc()
+}
+
+// ByMerchantName orders the results by the merchant_name field.
+func ByMerchantName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByFromCategory orders the results by the from_category field.
+func ByFromCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromCategory, opts...).ToFunc()
+}
+
+// ByToCategory orders the results by the to_category field.
+func ByToCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToCategory, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
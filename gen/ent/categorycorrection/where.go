@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorycorrection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldID, id))
+}
+
+// ProfileID applies equality check predicate on the "profile_id" field. It's identical to ProfileIDEQ.
+func ProfileID(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// MerchantName applies equality check predicate on the "merchant_name" field. It's identical to MerchantNameEQ.
+func MerchantName(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldDescription, v))
+}
+
+// FromCategory applies equality check predicate on the "from_category" field. It's identical to FromCategoryEQ.
+func FromCategory(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldFromCategory, v))
+}
+
+// ToCategory applies equality check predicate on the "to_category" field. It's identical to ToCategoryEQ.
+func ToCategory(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldToCategory, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProfileIDEQ applies the EQ predicate on the "profile_id" field.
+func ProfileIDEQ(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ProfileIDNEQ applies the NEQ predicate on the "profile_id" field.
+func ProfileIDNEQ(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldProfileID, v))
+}
+
+// ProfileIDIn applies the In predicate on the "profile_id" field.
+func ProfileIDIn(vs ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldProfileID, vs...))
+}
+
+// ProfileIDNotIn applies the NotIn predicate on the "profile_id" field.
+func ProfileIDNotIn(vs ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldProfileID, vs...))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDGT applies the GT predicate on the "receipt_id" field.
+func ReceiptIDGT(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldReceiptID, v))
+}
+
+// ReceiptIDGTE applies the GTE predicate on the "receipt_id" field.
+func ReceiptIDGTE(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldReceiptID, v))
+}
+
+// ReceiptIDLT applies the LT predicate on the "receipt_id" field.
+func ReceiptIDLT(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldReceiptID, v))
+}
+
+// ReceiptIDLTE applies the LTE predicate on the "receipt_id" field.
+func ReceiptIDLTE(v uuid.UUID) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldReceiptID, v))
+}
+
+// MerchantNameEQ applies the EQ predicate on the "merchant_name" field.
+func MerchantNameEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// MerchantNameNEQ applies the NEQ predicate on the "merchant_name" field.
+func MerchantNameNEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldMerchantName, v))
+}
+
+// MerchantNameIn applies the In predicate on the "merchant_name" field.
+func MerchantNameIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameNotIn applies the NotIn predicate on the "merchant_name" field.
+func MerchantNameNotIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameGT applies the GT predicate on the "merchant_name" field.
+func MerchantNameGT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldMerchantName, v))
+}
+
+// MerchantNameGTE applies the GTE predicate on the "merchant_name" field.
+func MerchantNameGTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldMerchantName, v))
+}
+
+// MerchantNameLT applies the LT predicate on the "merchant_name" field.
+func MerchantNameLT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldMerchantName, v))
+}
+
+// MerchantNameLTE applies the LTE predicate on the "merchant_name" field.
+func MerchantNameLTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldMerchantName, v))
+}
+
+// MerchantNameContains applies the Contains predicate on the "merchant_name" field.
+func MerchantNameContains(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContains(FieldMerchantName, v))
+}
+
+// MerchantNameHasPrefix applies the HasPrefix predicate on the "merchant_name" field.
+func MerchantNameHasPrefix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasPrefix(FieldMerchantName, v))
+}
+
+// MerchantNameHasSuffix applies the HasSuffix predicate on the "merchant_name" field.
+func MerchantNameHasSuffix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasSuffix(FieldMerchantName, v))
+}
+
+// MerchantNameEqualFold applies the EqualFold predicate on the "merchant_name" field.
+func MerchantNameEqualFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEqualFold(FieldMerchantName, v))
+}
+
+// MerchantNameContainsFold applies the ContainsFold predicate on the "merchant_name" field.
+func MerchantNameContainsFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContainsFold(FieldMerchantName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// FromCategoryEQ applies the EQ predicate on the "from_category" field.
+func FromCategoryEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldFromCategory, v))
+}
+
+// FromCategoryNEQ applies the NEQ predicate on the "from_category" field.
+func FromCategoryNEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldFromCategory, v))
+}
+
+// FromCategoryIn applies the In predicate on the "from_category" field.
+func FromCategoryIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldFromCategory, vs...))
+}
+
+// FromCategoryNotIn applies the NotIn predicate on the "from_category" field.
+func FromCategoryNotIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldFromCategory, vs...))
+}
+
+// FromCategoryGT applies the GT predicate on the "from_category" field.
+func FromCategoryGT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldFromCategory, v))
+}
+
+// FromCategoryGTE applies the GTE predicate on the "from_category" field.
+func FromCategoryGTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldFromCategory, v))
+}
+
+// FromCategoryLT applies the LT predicate on the "from_category" field.
+func FromCategoryLT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldFromCategory, v))
+}
+
+// FromCategoryLTE applies the LTE predicate on the "from_category" field.
+func FromCategoryLTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldFromCategory, v))
+}
+
+// FromCategoryContains applies the Contains predicate on the "from_category" field.
+func FromCategoryContains(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContains(FieldFromCategory, v))
+}
+
+// FromCategoryHasPrefix applies the HasPrefix predicate on the "from_category" field.
+func FromCategoryHasPrefix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasPrefix(FieldFromCategory, v))
+}
+
+// FromCategoryHasSuffix applies the HasSuffix predicate on the "from_category" field.
+func FromCategoryHasSuffix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasSuffix(FieldFromCategory, v))
+}
+
+// FromCategoryEqualFold applies the EqualFold predicate on the "from_category" field.
+func FromCategoryEqualFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEqualFold(FieldFromCategory, v))
+}
+
+// FromCategoryContainsFold applies the ContainsFold predicate on the "from_category" field.
+func FromCategoryContainsFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContainsFold(FieldFromCategory, v))
+}
+
+// ToCategoryEQ applies the EQ predicate on the "to_category" field.
+func ToCategoryEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldToCategory, v))
+}
+
+// ToCategoryNEQ applies the NEQ predicate on the "to_category" field.
+func ToCategoryNEQ(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldToCategory, v))
+}
+
+// ToCategoryIn applies the In predicate on the "to_category" field.
+func ToCategoryIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldToCategory, vs...))
+}
+
+// ToCategoryNotIn applies the NotIn predicate on the "to_category" field.
+func ToCategoryNotIn(vs ...string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldToCategory, vs...))
+}
+
+// ToCategoryGT applies the GT predicate on the "to_category" field.
+func ToCategoryGT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldToCategory, v))
+}
+
+// ToCategoryGTE applies the GTE predicate on the "to_category" field.
+func ToCategoryGTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldToCategory, v))
+}
+
+// ToCategoryLT applies the LT predicate on the "to_category" field.
+func ToCategoryLT(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldToCategory, v))
+}
+
+// ToCategoryLTE applies the LTE predicate on the "to_category" field.
+func ToCategoryLTE(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldToCategory, v))
+}
+
+// ToCategoryContains applies the Contains predicate on the "to_category" field.
+func ToCategoryContains(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContains(FieldToCategory, v))
+}
+
+// ToCategoryHasPrefix applies the HasPrefix predicate on the "to_category" field.
+func ToCategoryHasPrefix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasPrefix(FieldToCategory, v))
+}
+
+// ToCategoryHasSuffix applies the HasSuffix predicate on the "to_category" field.
+func ToCategoryHasSuffix(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldHasSuffix(FieldToCategory, v))
+}
+
+// ToCategoryEqualFold applies the EqualFold predicate on the "to_category" field.
+func ToCategoryEqualFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEqualFold(FieldToCategory, v))
+}
+
+// ToCategoryContainsFold applies the ContainsFold predicate on the "to_category" field.
+func ToCategoryContainsFold(v string) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldContainsFold(FieldToCategory, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryCorrection) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryCorrection) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryCorrection) predicate.CategoryCorrection {
+	return predicate.CategoryCorrection(sql.NotPredicates(p))
+}
@@ -0,0 +1,862 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+)
+
+// CategoryCorrectionCreate is the builder for creating a CategoryCorrection entity.
+type CategoryCorrectionCreate struct {
+	config
+	mutation *CategoryCorrectionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *CategoryCorrectionCreate) SetProfileID(v uuid.UUID) *CategoryCorrectionCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *CategoryCorrectionCreate) SetReceiptID(v uuid.UUID) *CategoryCorrectionCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (_c *CategoryCorrectionCreate) SetMerchantName(v string) *CategoryCorrectionCreate {
+	_c.mutation.SetMerchantName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CategoryCorrectionCreate) SetDescription(v string) *CategoryCorrectionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CategoryCorrectionCreate) SetNillableDescription(v *string) *CategoryCorrectionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetFromCategory sets the "from_category" field.
+func (_c *CategoryCorrectionCreate) SetFromCategory(v string) *CategoryCorrectionCreate {
+	_c.mutation.SetFromCategory(v)
+	return _c
+}
+
+// SetToCategory sets the "to_category" field.
+func (_c *CategoryCorrectionCreate) SetToCategory(v string) *CategoryCorrectionCreate {
+	_c.mutation.SetToCategory(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryCorrectionCreate) SetCreatedAt(v time.Time) *CategoryCorrectionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryCorrectionCreate) SetNillableCreatedAt(v *time.Time) *CategoryCorrectionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryCorrectionCreate) SetID(v uuid.UUID) *CategoryCorrectionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CategoryCorrectionCreate) SetNillableID(v *uuid.UUID) *CategoryCorrectionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *CategoryCorrectionCreate) SetProfile(v *Profile) *CategoryCorrectionCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryCorrectionMutation object of the builder.
+func (_c *CategoryCorrectionCreate) Mutation() *CategoryCorrectionMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryCorrection in the database.
+func (_c *CategoryCorrectionCreate) Save(ctx context.Context) (*CategoryCorrection, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryCorrectionCreate) SaveX(ctx context.Context) *CategoryCorrection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryCorrectionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryCorrectionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryCorrectionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categorycorrection.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := categorycorrection.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryCorrectionCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "CategoryCorrection.profile_id"`)}
+	}
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "CategoryCorrection.receipt_id"`)}
+	}
+	if _, ok := _c.mutation.MerchantName(); !ok {
+		return &ValidationError{Name: "merchant_name", err: errors.New(`ent: missing required field "CategoryCorrection.merchant_name"`)}
+	}
+	if _, ok := _c.mutation.FromCategory(); !ok {
+		return &ValidationError{Name: "from_category", err: errors.New(`ent: missing required field "CategoryCorrection.from_category"`)}
+	}
+	if v, ok := _c.mutation.FromCategory(); ok {
+		if err := categorycorrection.FromCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "from_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.from_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToCategory(); !ok {
+		return &ValidationError{Name: "to_category", err: errors.New(`ent: missing required field "CategoryCorrection.to_category"`)}
+	}
+	if v, ok := _c.mutation.ToCategory(); ok {
+		if err := categorycorrection.ToCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "to_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.to_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryCorrection.created_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "CategoryCorrection.profile"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryCorrectionCreate) sqlSave(ctx context.Context) (*CategoryCorrection, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryCorrectionCreate) createSpec() (*CategoryCorrection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryCorrection{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorycorrection.Table, sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ReceiptID(); ok {
+		_spec.SetField(categorycorrection.FieldReceiptID, field.TypeUUID, value)
+		_node.ReceiptID = value
+	}
+	if value, ok := _c.mutation.MerchantName(); ok {
+		_spec.SetField(categorycorrection.FieldMerchantName, field.TypeString, value)
+		_node.MerchantName = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(categorycorrection.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.FromCategory(); ok {
+		_spec.SetField(categorycorrection.FieldFromCategory, field.TypeString, value)
+		_node.FromCategory = value
+	}
+	if value, ok := _c.mutation.ToCategory(); ok {
+		_spec.SetField(categorycorrection.FieldToCategory, field.TypeString, value)
+		_node.ToCategory = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categorycorrection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorycorrection.ProfileTable,
+			Columns: []string{categorycorrection.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryCorrection.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryCorrectionUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryCorrectionCreate) OnConflict(opts ...sql.ConflictOption) *CategoryCorrectionUpsertOne {
+	_c.conflict = opts
+	return &CategoryCorrectionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryCorrectionCreate) OnConflictColumns(columns ...string) *CategoryCorrectionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryCorrectionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryCorrectionUpsertOne is the builder for "upsert"-ing
+	//  one CategoryCorrection node.
+	CategoryCorrectionUpsertOne struct {
+		create *CategoryCorrectionCreate
+	}
+
+	// CategoryCorrectionUpsert is the "OnConflict" setter.
+	CategoryCorrectionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryCorrectionUpsert) SetProfileID(v uuid.UUID) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateProfileID() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldProfileID)
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *CategoryCorrectionUpsert) SetReceiptID(v uuid.UUID) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateReceiptID() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldReceiptID)
+	return u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *CategoryCorrectionUpsert) SetMerchantName(v string) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldMerchantName, v)
+	return u
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateMerchantName() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldMerchantName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryCorrectionUpsert) SetDescription(v string) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateDescription() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryCorrectionUpsert) ClearDescription() *CategoryCorrectionUpsert {
+	u.SetNull(categorycorrection.FieldDescription)
+	return u
+}
+
+// SetFromCategory sets the "from_category" field.
+func (u *CategoryCorrectionUpsert) SetFromCategory(v string) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldFromCategory, v)
+	return u
+}
+
+// UpdateFromCategory sets the "from_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateFromCategory() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldFromCategory)
+	return u
+}
+
+// SetToCategory sets the "to_category" field.
+func (u *CategoryCorrectionUpsert) SetToCategory(v string) *CategoryCorrectionUpsert {
+	u.Set(categorycorrection.FieldToCategory, v)
+	return u
+}
+
+// UpdateToCategory sets the "to_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsert) UpdateToCategory() *CategoryCorrectionUpsert {
+	u.SetExcluded(categorycorrection.FieldToCategory)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorycorrection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryCorrectionUpsertOne) UpdateNewValues() *CategoryCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categorycorrection.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(categorycorrection.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryCorrectionUpsertOne) Ignore() *CategoryCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryCorrectionUpsertOne) DoNothing() *CategoryCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCorrectionCreate.OnConflict
+// documentation for more info.
+func (u *CategoryCorrectionUpsertOne) Update(set func(*CategoryCorrectionUpsert)) *CategoryCorrectionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryCorrectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryCorrectionUpsertOne) SetProfileID(v uuid.UUID) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateProfileID() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *CategoryCorrectionUpsertOne) SetReceiptID(v uuid.UUID) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateReceiptID() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *CategoryCorrectionUpsertOne) SetMerchantName(v string) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateMerchantName() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryCorrectionUpsertOne) SetDescription(v string) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateDescription() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryCorrectionUpsertOne) ClearDescription() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFromCategory sets the "from_category" field.
+func (u *CategoryCorrectionUpsertOne) SetFromCategory(v string) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetFromCategory(v)
+	})
+}
+
+// UpdateFromCategory sets the "from_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateFromCategory() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateFromCategory()
+	})
+}
+
+// SetToCategory sets the "to_category" field.
+func (u *CategoryCorrectionUpsertOne) SetToCategory(v string) *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetToCategory(v)
+	})
+}
+
+// UpdateToCategory sets the "to_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertOne) UpdateToCategory() *CategoryCorrectionUpsertOne {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateToCategory()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryCorrectionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCorrectionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryCorrectionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryCorrectionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryCorrectionUpsertOne.ID is not supported by MySQL driver. Use CategoryCorrectionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryCorrectionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryCorrectionCreateBulk is the builder for creating many CategoryCorrection entities in bulk.
+type CategoryCorrectionCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryCorrectionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryCorrection entities in the database.
+func (_c *CategoryCorrectionCreateBulk) Save(ctx context.Context) ([]*CategoryCorrection, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryCorrection, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryCorrectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryCorrectionCreateBulk) SaveX(ctx context.Context) []*CategoryCorrection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryCorrectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryCorrectionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryCorrection.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryCorrectionUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryCorrectionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryCorrectionUpsertBulk {
+	_c.conflict = opts
+	return &CategoryCorrectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryCorrectionCreateBulk) OnConflictColumns(columns ...string) *CategoryCorrectionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryCorrectionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryCorrectionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryCorrection nodes.
+type CategoryCorrectionUpsertBulk struct {
+	create *CategoryCorrectionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categorycorrection.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryCorrectionUpsertBulk) UpdateNewValues() *CategoryCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categorycorrection.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(categorycorrection.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryCorrection.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryCorrectionUpsertBulk) Ignore() *CategoryCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryCorrectionUpsertBulk) DoNothing() *CategoryCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCorrectionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryCorrectionUpsertBulk) Update(set func(*CategoryCorrectionUpsert)) *CategoryCorrectionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryCorrectionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryCorrectionUpsertBulk) SetProfileID(v uuid.UUID) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateProfileID() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *CategoryCorrectionUpsertBulk) SetReceiptID(v uuid.UUID) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateReceiptID() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *CategoryCorrectionUpsertBulk) SetMerchantName(v string) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateMerchantName() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CategoryCorrectionUpsertBulk) SetDescription(v string) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateDescription() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CategoryCorrectionUpsertBulk) ClearDescription() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFromCategory sets the "from_category" field.
+func (u *CategoryCorrectionUpsertBulk) SetFromCategory(v string) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetFromCategory(v)
+	})
+}
+
+// UpdateFromCategory sets the "from_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateFromCategory() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateFromCategory()
+	})
+}
+
+// SetToCategory sets the "to_category" field.
+func (u *CategoryCorrectionUpsertBulk) SetToCategory(v string) *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.SetToCategory(v)
+	})
+}
+
+// UpdateToCategory sets the "to_category" field to the value that was provided on create.
+func (u *CategoryCorrectionUpsertBulk) UpdateToCategory() *CategoryCorrectionUpsertBulk {
+	return u.Update(func(s *CategoryCorrectionUpsert) {
+		s.UpdateToCategory()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryCorrectionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryCorrectionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCorrectionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryCorrectionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
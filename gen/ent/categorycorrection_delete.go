@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+)
+
+// CategoryCorrectionDelete is the builder for deleting a CategoryCorrection entity.
+type CategoryCorrectionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CategoryCorrectionMutation
+}
+
+// Where appends a list predicates to the CategoryCorrectionDelete builder.
+func (_d *CategoryCorrectionDelete) Where(ps ...predicate.CategoryCorrection) *CategoryCorrectionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CategoryCorrectionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategoryCorrectionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CategoryCorrectionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(categorycorrection.Table, sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CategoryCorrectionDeleteOne is the builder for deleting a single CategoryCorrection entity.
+type CategoryCorrectionDeleteOne struct {
+	_d *CategoryCorrectionDelete
+}
+
+// Where appends a list predicates to the CategoryCorrectionDelete builder.
+func (_d *CategoryCorrectionDeleteOne) Where(ps ...predicate.CategoryCorrection) *CategoryCorrectionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CategoryCorrectionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{categorycorrection.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CategoryCorrectionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
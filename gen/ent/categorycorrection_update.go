@@ -0,0 +1,531 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+)
+
+// CategoryCorrectionUpdate is the builder for updating CategoryCorrection entities.
+type CategoryCorrectionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CategoryCorrectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CategoryCorrectionUpdate builder.
+func (_u *CategoryCorrectionUpdate) Where(ps ...predicate.CategoryCorrection) *CategoryCorrectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *CategoryCorrectionUpdate) SetProfileID(v uuid.UUID) *CategoryCorrectionUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableProfileID(v *uuid.UUID) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *CategoryCorrectionUpdate) SetReceiptID(v uuid.UUID) *CategoryCorrectionUpdate {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableReceiptID(v *uuid.UUID) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (_u *CategoryCorrectionUpdate) SetMerchantName(v string) *CategoryCorrectionUpdate {
+	_u.mutation.SetMerchantName(v)
+	return _u
+}
+
+// SetNillableMerchantName sets the "merchant_name" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableMerchantName(v *string) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetMerchantName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryCorrectionUpdate) SetDescription(v string) *CategoryCorrectionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableDescription(v *string) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryCorrectionUpdate) ClearDescription() *CategoryCorrectionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFromCategory sets the "from_category" field.
+func (_u *CategoryCorrectionUpdate) SetFromCategory(v string) *CategoryCorrectionUpdate {
+	_u.mutation.SetFromCategory(v)
+	return _u
+}
+
+// SetNillableFromCategory sets the "from_category" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableFromCategory(v *string) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetFromCategory(*v)
+	}
+	return _u
+}
+
+// SetToCategory sets the "to_category" field.
+func (_u *CategoryCorrectionUpdate) SetToCategory(v string) *CategoryCorrectionUpdate {
+	_u.mutation.SetToCategory(v)
+	return _u
+}
+
+// SetNillableToCategory sets the "to_category" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdate) SetNillableToCategory(v *string) *CategoryCorrectionUpdate {
+	if v != nil {
+		_u.SetToCategory(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *CategoryCorrectionUpdate) SetProfile(v *Profile) *CategoryCorrectionUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryCorrectionMutation object of the builder.
+func (_u *CategoryCorrectionUpdate) Mutation() *CategoryCorrectionMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *CategoryCorrectionUpdate) ClearProfile() *CategoryCorrectionUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryCorrectionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryCorrectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryCorrectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryCorrectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryCorrectionUpdate) check() error {
+	if v, ok := _u.mutation.FromCategory(); ok {
+		if err := categorycorrection.FromCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "from_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.from_category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToCategory(); ok {
+		if err := categorycorrection.ToCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "to_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.to_category": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryCorrection.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryCorrectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryCorrectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryCorrectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorycorrection.Table, categorycorrection.Columns, sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(categorycorrection.FieldReceiptID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MerchantName(); ok {
+		_spec.SetField(categorycorrection.FieldMerchantName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorycorrection.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorycorrection.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromCategory(); ok {
+		_spec.SetField(categorycorrection.FieldFromCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToCategory(); ok {
+		_spec.SetField(categorycorrection.FieldToCategory, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorycorrection.ProfileTable,
+			Columns: []string{categorycorrection.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorycorrection.ProfileTable,
+			Columns: []string{categorycorrection.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorycorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryCorrectionUpdateOne is the builder for updating a single CategoryCorrection entity.
+type CategoryCorrectionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryCorrectionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *CategoryCorrectionUpdateOne) SetProfileID(v uuid.UUID) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableProfileID(v *uuid.UUID) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *CategoryCorrectionUpdateOne) SetReceiptID(v uuid.UUID) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableReceiptID(v *uuid.UUID) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (_u *CategoryCorrectionUpdateOne) SetMerchantName(v string) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetMerchantName(v)
+	return _u
+}
+
+// SetNillableMerchantName sets the "merchant_name" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableMerchantName(v *string) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetMerchantName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CategoryCorrectionUpdateOne) SetDescription(v string) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableDescription(v *string) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CategoryCorrectionUpdateOne) ClearDescription() *CategoryCorrectionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFromCategory sets the "from_category" field.
+func (_u *CategoryCorrectionUpdateOne) SetFromCategory(v string) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetFromCategory(v)
+	return _u
+}
+
+// SetNillableFromCategory sets the "from_category" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableFromCategory(v *string) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetFromCategory(*v)
+	}
+	return _u
+}
+
+// SetToCategory sets the "to_category" field.
+func (_u *CategoryCorrectionUpdateOne) SetToCategory(v string) *CategoryCorrectionUpdateOne {
+	_u.mutation.SetToCategory(v)
+	return _u
+}
+
+// SetNillableToCategory sets the "to_category" field if the given value is not nil.
+func (_u *CategoryCorrectionUpdateOne) SetNillableToCategory(v *string) *CategoryCorrectionUpdateOne {
+	if v != nil {
+		_u.SetToCategory(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *CategoryCorrectionUpdateOne) SetProfile(v *Profile) *CategoryCorrectionUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryCorrectionMutation object of the builder.
+func (_u *CategoryCorrectionUpdateOne) Mutation() *CategoryCorrectionMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *CategoryCorrectionUpdateOne) ClearProfile() *CategoryCorrectionUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the CategoryCorrectionUpdate builder.
+func (_u *CategoryCorrectionUpdateOne) Where(ps ...predicate.CategoryCorrection) *CategoryCorrectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryCorrectionUpdateOne) Select(field string, fields ...string) *CategoryCorrectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryCorrection entity.
+func (_u *CategoryCorrectionUpdateOne) Save(ctx context.Context) (*CategoryCorrection, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryCorrectionUpdateOne) SaveX(ctx context.Context) *CategoryCorrection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryCorrectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryCorrectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryCorrectionUpdateOne) check() error {
+	if v, ok := _u.mutation.FromCategory(); ok {
+		if err := categorycorrection.FromCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "from_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.from_category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToCategory(); ok {
+		if err := categorycorrection.ToCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "to_category", err: fmt.Errorf(`ent: validator failed for field "CategoryCorrection.to_category": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryCorrection.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryCorrectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryCorrectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryCorrectionUpdateOne) sqlSave(ctx context.Context) (_node *CategoryCorrection, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorycorrection.Table, categorycorrection.Columns, sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryCorrection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorycorrection.FieldID)
+		for _, f := range fields {
+			if !categorycorrection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorycorrection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(categorycorrection.FieldReceiptID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MerchantName(); ok {
+		_spec.SetField(categorycorrection.FieldMerchantName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(categorycorrection.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(categorycorrection.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromCategory(); ok {
+		_spec.SetField(categorycorrection.FieldFromCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToCategory(); ok {
+		_spec.SetField(categorycorrection.FieldToCategory, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorycorrection.ProfileTable,
+			Columns: []string{categorycorrection.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorycorrection.ProfileTable,
+			Columns: []string{categorycorrection.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CategoryCorrection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorycorrection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
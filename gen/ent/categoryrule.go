@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// CategoryRule is the model entity for the CategoryRule schema.
+type CategoryRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ProfileID holds the value of the "profile_id" field.
+	ProfileID uuid.UUID `json:"profile_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// MerchantPattern holds the value of the "merchant_pattern" field.
+	MerchantPattern *string `json:"merchant_pattern,omitempty"`
+	// PathPattern holds the value of the "path_pattern" field.
+	PathPattern *string `json:"path_pattern,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// MinTotal holds the value of the "min_total" field.
+	MinTotal *money.Amount `json:"min_total,omitempty"`
+	// MaxTotal holds the value of the "max_total" field.
+	MaxTotal *money.Amount `json:"max_total,omitempty"`
+	// SetCategory holds the value of the "set_category" field.
+	SetCategory *string `json:"set_category,omitempty"`
+	// ForceReview holds the value of the "force_review" field.
+	ForceReview bool `json:"force_review,omitempty"`
+	// SetDescription holds the value of the "set_description" field.
+	SetDescription *string `json:"set_description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryRuleQuery when eager-loading is set.
+	Edges        CategoryRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CategoryRuleEdges holds the relations/edges for other nodes in the graph.
+type CategoryRuleEdges struct {
+	// Profile holds the value of the profile edge.
+	Profile *Profile `json:"profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProfileOrErr returns the Profile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryRuleEdges) ProfileOrErr() (*Profile, error) {
+	if e.Profile != nil {
+		return e.Profile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: profile.Label}
+	}
+	return nil, &NotLoadedError{edge: "profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categoryrule.FieldMinTotal, categoryrule.FieldMaxTotal:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case categoryrule.FieldKeywords:
+			values[i] = new([]byte)
+		case categoryrule.FieldEnabled, categoryrule.FieldForceReview:
+			values[i] = new(sql.NullBool)
+		case categoryrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case categoryrule.FieldName, categoryrule.FieldMerchantPattern, categoryrule.FieldPathPattern, categoryrule.FieldSetCategory, categoryrule.FieldSetDescription:
+			values[i] = new(sql.NullString)
+		case categoryrule.FieldCreatedAt, categoryrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case categoryrule.FieldID, categoryrule.FieldProfileID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryRule fields.
+func (_m *CategoryRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categoryrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case categoryrule.FieldProfileID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_id", values[i])
+			} else if value != nil {
+				_m.ProfileID = *value
+			}
+		case categoryrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case categoryrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case categoryrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case categoryrule.FieldMerchantPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_pattern", values[i])
+			} else if value.Valid {
+				_m.MerchantPattern = new(string)
+				*_m.MerchantPattern = value.String
+			}
+		case categoryrule.FieldPathPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path_pattern", values[i])
+			} else if value.Valid {
+				_m.PathPattern = new(string)
+				*_m.PathPattern = value.String
+			}
+		case categoryrule.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case categoryrule.FieldMinTotal:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field min_total", values[i])
+			} else if value.Valid {
+				_m.MinTotal = new(money.Amount)
+				*_m.MinTotal = *value.S.(*money.Amount)
+			}
+		case categoryrule.FieldMaxTotal:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field max_total", values[i])
+			} else if value.Valid {
+				_m.MaxTotal = new(money.Amount)
+				*_m.MaxTotal = *value.S.(*money.Amount)
+			}
+		case categoryrule.FieldSetCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field set_category", values[i])
+			} else if value.Valid {
+				_m.SetCategory = new(string)
+				*_m.SetCategory = value.String
+			}
+		case categoryrule.FieldForceReview:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field force_review", values[i])
+			} else if value.Valid {
+				_m.ForceReview = value.Bool
+			}
+		case categoryrule.FieldSetDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field set_description", values[i])
+			} else if value.Valid {
+				_m.SetDescription = new(string)
+				*_m.SetDescription = value.String
+			}
+		case categoryrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case categoryrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProfile queries the "profile" edge of the CategoryRule entity.
+func (_m *CategoryRule) QueryProfile() *ProfileQuery {
+	return NewCategoryRuleClient(_m.config).QueryProfile(_m)
+}
+
+// Update returns a builder for updating this CategoryRule.
+// Note that you need to call CategoryRule.Unwrap() before calling this method if this CategoryRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryRule) Update() *CategoryRuleUpdateOne {
+	return NewCategoryRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryRule) Unwrap() *CategoryRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("profile_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfileID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.MerchantPattern; v != nil {
+		builder.WriteString("merchant_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PathPattern; v != nil {
+		builder.WriteString("path_pattern=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	if v := _m.MinTotal; v != nil {
+		builder.WriteString("min_total=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxTotal; v != nil {
+		builder.WriteString("max_total=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SetCategory; v != nil {
+		builder.WriteString("set_category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("force_review=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ForceReview))
+	builder.WriteString(", ")
+	if v := _m.SetDescription; v != nil {
+		builder.WriteString("set_description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryRules is a parsable slice of CategoryRule.
+type CategoryRules []*CategoryRule
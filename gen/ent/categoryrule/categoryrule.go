@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the categoryrule type in the database.
+	Label = "category_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProfileID holds the string denoting the profile_id field in the database.
+	FieldProfileID = "profile_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldMerchantPattern holds the string denoting the merchant_pattern field in the database.
+	FieldMerchantPattern = "merchant_pattern"
+	// FieldPathPattern holds the string denoting the path_pattern field in the database.
+	FieldPathPattern = "path_pattern"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldMinTotal holds the string denoting the min_total field in the database.
+	FieldMinTotal = "min_total"
+	// FieldMaxTotal holds the string denoting the max_total field in the database.
+	FieldMaxTotal = "max_total"
+	// FieldSetCategory holds the string denoting the set_category field in the database.
+	FieldSetCategory = "set_category"
+	// FieldForceReview holds the string denoting the force_review field in the database.
+	FieldForceReview = "force_review"
+	// FieldSetDescription holds the string denoting the set_description field in the database.
+	FieldSetDescription = "set_description"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeProfile holds the string denoting the profile edge name in mutations.
+	EdgeProfile = "profile"
+	// Table holds the table name of the categoryrule in the database.
+	Table = "category_rules"
+	// ProfileTable is the table that holds the profile relation/edge.
+	ProfileTable = "category_rules"
+	// ProfileInverseTable is the table name for the Profile entity.
+	// It exists in this package in order to avoid circular dependency with the "profile" package.
+	ProfileInverseTable = "profiles"
+	// ProfileColumn is the table column denoting the profile relation/edge.
+	ProfileColumn = "profile_id"
+)
+
+// Columns holds all SQL columns for categoryrule fields.
+var Columns = []string{
+	FieldID,
+	FieldProfileID,
+	FieldName,
+	FieldPriority,
+	FieldEnabled,
+	FieldMerchantPattern,
+	FieldPathPattern,
+	FieldKeywords,
+	FieldMinTotal,
+	FieldMaxTotal,
+	FieldSetCategory,
+	FieldForceReview,
+	FieldSetDescription,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultForceReview holds the default value on creation for the "force_review" field.
+	DefaultForceReview bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CategoryRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProfileID orders the results by the profile_id field.
+func ByProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfileID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByMerchantPattern orders the results by the merchant_pattern field.
+func ByMerchantPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantPattern, opts...).ToFunc()
+}
+
+// ByPathPattern orders the results by the path_pattern field.
+func ByPathPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPathPattern, opts...).ToFunc()
+}
+
+// ByMinTotal orders the results by the min_total field.
+func ByMinTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinTotal, opts...).ToFunc()
+}
+
+// ByMaxTotal orders the results by the max_total field.
+func ByMaxTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTotal, opts...).ToFunc()
+}
+
+// BySetCategory orders the results by the set_category field.
+func BySetCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSetCategory, opts...).ToFunc()
+}
+
+// ByForceReview orders the results by the force_review field.
+func ByForceReview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForceReview, opts...).ToFunc()
+}
+
+// BySetDescription orders the results by the set_description field.
+func BySetDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSetDescription, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByProfileField orders the results by profile field.
+func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+	)
+}
@@ -0,0 +1,796 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categoryrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldID, id))
+}
+
+// ProfileID applies equality check predicate on the "profile_id" field. It's identical to ProfileIDEQ.
+func ProfileID(v uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldProfileID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldName, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// MerchantPattern applies equality check predicate on the "merchant_pattern" field. It's identical to MerchantPatternEQ.
+func MerchantPattern(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMerchantPattern, v))
+}
+
+// PathPattern applies equality check predicate on the "path_pattern" field. It's identical to PathPatternEQ.
+func PathPattern(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldPathPattern, v))
+}
+
+// MinTotal applies equality check predicate on the "min_total" field. It's identical to MinTotalEQ.
+func MinTotal(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMinTotal, v))
+}
+
+// MaxTotal applies equality check predicate on the "max_total" field. It's identical to MaxTotalEQ.
+func MaxTotal(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMaxTotal, v))
+}
+
+// SetCategory applies equality check predicate on the "set_category" field. It's identical to SetCategoryEQ.
+func SetCategory(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldSetCategory, v))
+}
+
+// ForceReview applies equality check predicate on the "force_review" field. It's identical to ForceReviewEQ.
+func ForceReview(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldForceReview, v))
+}
+
+// SetDescription applies equality check predicate on the "set_description" field. It's identical to SetDescriptionEQ.
+func SetDescription(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldSetDescription, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProfileIDEQ applies the EQ predicate on the "profile_id" field.
+func ProfileIDEQ(v uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ProfileIDNEQ applies the NEQ predicate on the "profile_id" field.
+func ProfileIDNEQ(v uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldProfileID, v))
+}
+
+// ProfileIDIn applies the In predicate on the "profile_id" field.
+func ProfileIDIn(vs ...uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldProfileID, vs...))
+}
+
+// ProfileIDNotIn applies the NotIn predicate on the "profile_id" field.
+func ProfileIDNotIn(vs ...uuid.UUID) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldProfileID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// MerchantPatternEQ applies the EQ predicate on the "merchant_pattern" field.
+func MerchantPatternEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMerchantPattern, v))
+}
+
+// MerchantPatternNEQ applies the NEQ predicate on the "merchant_pattern" field.
+func MerchantPatternNEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldMerchantPattern, v))
+}
+
+// MerchantPatternIn applies the In predicate on the "merchant_pattern" field.
+func MerchantPatternIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldMerchantPattern, vs...))
+}
+
+// MerchantPatternNotIn applies the NotIn predicate on the "merchant_pattern" field.
+func MerchantPatternNotIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldMerchantPattern, vs...))
+}
+
+// MerchantPatternGT applies the GT predicate on the "merchant_pattern" field.
+func MerchantPatternGT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldMerchantPattern, v))
+}
+
+// MerchantPatternGTE applies the GTE predicate on the "merchant_pattern" field.
+func MerchantPatternGTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldMerchantPattern, v))
+}
+
+// MerchantPatternLT applies the LT predicate on the "merchant_pattern" field.
+func MerchantPatternLT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldMerchantPattern, v))
+}
+
+// MerchantPatternLTE applies the LTE predicate on the "merchant_pattern" field.
+func MerchantPatternLTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldMerchantPattern, v))
+}
+
+// MerchantPatternContains applies the Contains predicate on the "merchant_pattern" field.
+func MerchantPatternContains(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContains(FieldMerchantPattern, v))
+}
+
+// MerchantPatternHasPrefix applies the HasPrefix predicate on the "merchant_pattern" field.
+func MerchantPatternHasPrefix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasPrefix(FieldMerchantPattern, v))
+}
+
+// MerchantPatternHasSuffix applies the HasSuffix predicate on the "merchant_pattern" field.
+func MerchantPatternHasSuffix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasSuffix(FieldMerchantPattern, v))
+}
+
+// MerchantPatternIsNil applies the IsNil predicate on the "merchant_pattern" field.
+func MerchantPatternIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldMerchantPattern))
+}
+
+// MerchantPatternNotNil applies the NotNil predicate on the "merchant_pattern" field.
+func MerchantPatternNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldMerchantPattern))
+}
+
+// MerchantPatternEqualFold applies the EqualFold predicate on the "merchant_pattern" field.
+func MerchantPatternEqualFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEqualFold(FieldMerchantPattern, v))
+}
+
+// MerchantPatternContainsFold applies the ContainsFold predicate on the "merchant_pattern" field.
+func MerchantPatternContainsFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContainsFold(FieldMerchantPattern, v))
+}
+
+// PathPatternEQ applies the EQ predicate on the "path_pattern" field.
+func PathPatternEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldPathPattern, v))
+}
+
+// PathPatternNEQ applies the NEQ predicate on the "path_pattern" field.
+func PathPatternNEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldPathPattern, v))
+}
+
+// PathPatternIn applies the In predicate on the "path_pattern" field.
+func PathPatternIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldPathPattern, vs...))
+}
+
+// PathPatternNotIn applies the NotIn predicate on the "path_pattern" field.
+func PathPatternNotIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldPathPattern, vs...))
+}
+
+// PathPatternGT applies the GT predicate on the "path_pattern" field.
+func PathPatternGT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldPathPattern, v))
+}
+
+// PathPatternGTE applies the GTE predicate on the "path_pattern" field.
+func PathPatternGTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldPathPattern, v))
+}
+
+// PathPatternLT applies the LT predicate on the "path_pattern" field.
+func PathPatternLT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldPathPattern, v))
+}
+
+// PathPatternLTE applies the LTE predicate on the "path_pattern" field.
+func PathPatternLTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldPathPattern, v))
+}
+
+// PathPatternContains applies the Contains predicate on the "path_pattern" field.
+func PathPatternContains(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContains(FieldPathPattern, v))
+}
+
+// PathPatternHasPrefix applies the HasPrefix predicate on the "path_pattern" field.
+func PathPatternHasPrefix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasPrefix(FieldPathPattern, v))
+}
+
+// PathPatternHasSuffix applies the HasSuffix predicate on the "path_pattern" field.
+func PathPatternHasSuffix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasSuffix(FieldPathPattern, v))
+}
+
+// PathPatternIsNil applies the IsNil predicate on the "path_pattern" field.
+func PathPatternIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldPathPattern))
+}
+
+// PathPatternNotNil applies the NotNil predicate on the "path_pattern" field.
+func PathPatternNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldPathPattern))
+}
+
+// PathPatternEqualFold applies the EqualFold predicate on the "path_pattern" field.
+func PathPatternEqualFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEqualFold(FieldPathPattern, v))
+}
+
+// PathPatternContainsFold applies the ContainsFold predicate on the "path_pattern" field.
+func PathPatternContainsFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContainsFold(FieldPathPattern, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldKeywords))
+}
+
+// MinTotalEQ applies the EQ predicate on the "min_total" field.
+func MinTotalEQ(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMinTotal, v))
+}
+
+// MinTotalNEQ applies the NEQ predicate on the "min_total" field.
+func MinTotalNEQ(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldMinTotal, v))
+}
+
+// MinTotalIn applies the In predicate on the "min_total" field.
+func MinTotalIn(vs ...money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldMinTotal, vs...))
+}
+
+// MinTotalNotIn applies the NotIn predicate on the "min_total" field.
+func MinTotalNotIn(vs ...money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldMinTotal, vs...))
+}
+
+// MinTotalGT applies the GT predicate on the "min_total" field.
+func MinTotalGT(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldMinTotal, v))
+}
+
+// MinTotalGTE applies the GTE predicate on the "min_total" field.
+func MinTotalGTE(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldMinTotal, v))
+}
+
+// MinTotalLT applies the LT predicate on the "min_total" field.
+func MinTotalLT(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldMinTotal, v))
+}
+
+// MinTotalLTE applies the LTE predicate on the "min_total" field.
+func MinTotalLTE(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldMinTotal, v))
+}
+
+// MinTotalIsNil applies the IsNil predicate on the "min_total" field.
+func MinTotalIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldMinTotal))
+}
+
+// MinTotalNotNil applies the NotNil predicate on the "min_total" field.
+func MinTotalNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldMinTotal))
+}
+
+// MaxTotalEQ applies the EQ predicate on the "max_total" field.
+func MaxTotalEQ(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldMaxTotal, v))
+}
+
+// MaxTotalNEQ applies the NEQ predicate on the "max_total" field.
+func MaxTotalNEQ(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldMaxTotal, v))
+}
+
+// MaxTotalIn applies the In predicate on the "max_total" field.
+func MaxTotalIn(vs ...money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldMaxTotal, vs...))
+}
+
+// MaxTotalNotIn applies the NotIn predicate on the "max_total" field.
+func MaxTotalNotIn(vs ...money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldMaxTotal, vs...))
+}
+
+// MaxTotalGT applies the GT predicate on the "max_total" field.
+func MaxTotalGT(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldMaxTotal, v))
+}
+
+// MaxTotalGTE applies the GTE predicate on the "max_total" field.
+func MaxTotalGTE(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldMaxTotal, v))
+}
+
+// MaxTotalLT applies the LT predicate on the "max_total" field.
+func MaxTotalLT(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldMaxTotal, v))
+}
+
+// MaxTotalLTE applies the LTE predicate on the "max_total" field.
+func MaxTotalLTE(v money.Amount) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldMaxTotal, v))
+}
+
+// MaxTotalIsNil applies the IsNil predicate on the "max_total" field.
+func MaxTotalIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldMaxTotal))
+}
+
+// MaxTotalNotNil applies the NotNil predicate on the "max_total" field.
+func MaxTotalNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldMaxTotal))
+}
+
+// SetCategoryEQ applies the EQ predicate on the "set_category" field.
+func SetCategoryEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldSetCategory, v))
+}
+
+// SetCategoryNEQ applies the NEQ predicate on the "set_category" field.
+func SetCategoryNEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldSetCategory, v))
+}
+
+// SetCategoryIn applies the In predicate on the "set_category" field.
+func SetCategoryIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldSetCategory, vs...))
+}
+
+// SetCategoryNotIn applies the NotIn predicate on the "set_category" field.
+func SetCategoryNotIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldSetCategory, vs...))
+}
+
+// SetCategoryGT applies the GT predicate on the "set_category" field.
+func SetCategoryGT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldSetCategory, v))
+}
+
+// SetCategoryGTE applies the GTE predicate on the "set_category" field.
+func SetCategoryGTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldSetCategory, v))
+}
+
+// SetCategoryLT applies the LT predicate on the "set_category" field.
+func SetCategoryLT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldSetCategory, v))
+}
+
+// SetCategoryLTE applies the LTE predicate on the "set_category" field.
+func SetCategoryLTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldSetCategory, v))
+}
+
+// SetCategoryContains applies the Contains predicate on the "set_category" field.
+func SetCategoryContains(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContains(FieldSetCategory, v))
+}
+
+// SetCategoryHasPrefix applies the HasPrefix predicate on the "set_category" field.
+func SetCategoryHasPrefix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasPrefix(FieldSetCategory, v))
+}
+
+// SetCategoryHasSuffix applies the HasSuffix predicate on the "set_category" field.
+func SetCategoryHasSuffix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasSuffix(FieldSetCategory, v))
+}
+
+// SetCategoryIsNil applies the IsNil predicate on the "set_category" field.
+func SetCategoryIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldSetCategory))
+}
+
+// SetCategoryNotNil applies the NotNil predicate on the "set_category" field.
+func SetCategoryNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldSetCategory))
+}
+
+// SetCategoryEqualFold applies the EqualFold predicate on the "set_category" field.
+func SetCategoryEqualFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEqualFold(FieldSetCategory, v))
+}
+
+// SetCategoryContainsFold applies the ContainsFold predicate on the "set_category" field.
+func SetCategoryContainsFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContainsFold(FieldSetCategory, v))
+}
+
+// ForceReviewEQ applies the EQ predicate on the "force_review" field.
+func ForceReviewEQ(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldForceReview, v))
+}
+
+// ForceReviewNEQ applies the NEQ predicate on the "force_review" field.
+func ForceReviewNEQ(v bool) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldForceReview, v))
+}
+
+// SetDescriptionEQ applies the EQ predicate on the "set_description" field.
+func SetDescriptionEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldSetDescription, v))
+}
+
+// SetDescriptionNEQ applies the NEQ predicate on the "set_description" field.
+func SetDescriptionNEQ(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldSetDescription, v))
+}
+
+// SetDescriptionIn applies the In predicate on the "set_description" field.
+func SetDescriptionIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldSetDescription, vs...))
+}
+
+// SetDescriptionNotIn applies the NotIn predicate on the "set_description" field.
+func SetDescriptionNotIn(vs ...string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldSetDescription, vs...))
+}
+
+// SetDescriptionGT applies the GT predicate on the "set_description" field.
+func SetDescriptionGT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldSetDescription, v))
+}
+
+// SetDescriptionGTE applies the GTE predicate on the "set_description" field.
+func SetDescriptionGTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldSetDescription, v))
+}
+
+// SetDescriptionLT applies the LT predicate on the "set_description" field.
+func SetDescriptionLT(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldSetDescription, v))
+}
+
+// SetDescriptionLTE applies the LTE predicate on the "set_description" field.
+func SetDescriptionLTE(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldSetDescription, v))
+}
+
+// SetDescriptionContains applies the Contains predicate on the "set_description" field.
+func SetDescriptionContains(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContains(FieldSetDescription, v))
+}
+
+// SetDescriptionHasPrefix applies the HasPrefix predicate on the "set_description" field.
+func SetDescriptionHasPrefix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasPrefix(FieldSetDescription, v))
+}
+
+// SetDescriptionHasSuffix applies the HasSuffix predicate on the "set_description" field.
+func SetDescriptionHasSuffix(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldHasSuffix(FieldSetDescription, v))
+}
+
+// SetDescriptionIsNil applies the IsNil predicate on the "set_description" field.
+func SetDescriptionIsNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIsNull(FieldSetDescription))
+}
+
+// SetDescriptionNotNil applies the NotNil predicate on the "set_description" field.
+func SetDescriptionNotNil() predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotNull(FieldSetDescription))
+}
+
+// SetDescriptionEqualFold applies the EqualFold predicate on the "set_description" field.
+func SetDescriptionEqualFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEqualFold(FieldSetDescription, v))
+}
+
+// SetDescriptionContainsFold applies the ContainsFold predicate on the "set_description" field.
+func SetDescriptionContainsFold(v string) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldContainsFold(FieldSetDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.CategoryRule {
+	return predicate.CategoryRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.CategoryRule {
+	return predicate.CategoryRule(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryRule) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryRule) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryRule) predicate.CategoryRule {
+	return predicate.CategoryRule(sql.NotPredicates(p))
+}
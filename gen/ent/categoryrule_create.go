@@ -0,0 +1,1473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// CategoryRuleCreate is the builder for creating a CategoryRule entity.
+type CategoryRuleCreate struct {
+	config
+	mutation *CategoryRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *CategoryRuleCreate) SetProfileID(v uuid.UUID) *CategoryRuleCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CategoryRuleCreate) SetName(v string) *CategoryRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *CategoryRuleCreate) SetPriority(v int) *CategoryRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillablePriority(v *int) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *CategoryRuleCreate) SetEnabled(v bool) *CategoryRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableEnabled(v *bool) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_c *CategoryRuleCreate) SetMerchantPattern(v string) *CategoryRuleCreate {
+	_c.mutation.SetMerchantPattern(v)
+	return _c
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableMerchantPattern(v *string) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetMerchantPattern(*v)
+	}
+	return _c
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (_c *CategoryRuleCreate) SetPathPattern(v string) *CategoryRuleCreate {
+	_c.mutation.SetPathPattern(v)
+	return _c
+}
+
+// SetNillablePathPattern sets the "path_pattern" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillablePathPattern(v *string) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetPathPattern(*v)
+	}
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *CategoryRuleCreate) SetKeywords(v []string) *CategoryRuleCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetMinTotal sets the "min_total" field.
+func (_c *CategoryRuleCreate) SetMinTotal(v money.Amount) *CategoryRuleCreate {
+	_c.mutation.SetMinTotal(v)
+	return _c
+}
+
+// SetNillableMinTotal sets the "min_total" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableMinTotal(v *money.Amount) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetMinTotal(*v)
+	}
+	return _c
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (_c *CategoryRuleCreate) SetMaxTotal(v money.Amount) *CategoryRuleCreate {
+	_c.mutation.SetMaxTotal(v)
+	return _c
+}
+
+// SetNillableMaxTotal sets the "max_total" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableMaxTotal(v *money.Amount) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetMaxTotal(*v)
+	}
+	return _c
+}
+
+// SetSetCategory sets the "set_category" field.
+func (_c *CategoryRuleCreate) SetSetCategory(v string) *CategoryRuleCreate {
+	_c.mutation.SetSetCategory(v)
+	return _c
+}
+
+// SetNillableSetCategory sets the "set_category" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableSetCategory(v *string) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetSetCategory(*v)
+	}
+	return _c
+}
+
+// SetForceReview sets the "force_review" field.
+func (_c *CategoryRuleCreate) SetForceReview(v bool) *CategoryRuleCreate {
+	_c.mutation.SetForceReview(v)
+	return _c
+}
+
+// SetNillableForceReview sets the "force_review" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableForceReview(v *bool) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetForceReview(*v)
+	}
+	return _c
+}
+
+// SetSetDescription sets the "set_description" field.
+func (_c *CategoryRuleCreate) SetSetDescription(v string) *CategoryRuleCreate {
+	_c.mutation.SetSetDescription(v)
+	return _c
+}
+
+// SetNillableSetDescription sets the "set_description" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableSetDescription(v *string) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetSetDescription(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CategoryRuleCreate) SetCreatedAt(v time.Time) *CategoryRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableCreatedAt(v *time.Time) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CategoryRuleCreate) SetUpdatedAt(v time.Time) *CategoryRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableUpdatedAt(v *time.Time) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CategoryRuleCreate) SetID(v uuid.UUID) *CategoryRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CategoryRuleCreate) SetNillableID(v *uuid.UUID) *CategoryRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *CategoryRuleCreate) SetProfile(v *Profile) *CategoryRuleCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryRuleMutation object of the builder.
+func (_c *CategoryRuleCreate) Mutation() *CategoryRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryRule in the database.
+func (_c *CategoryRuleCreate) Save(ctx context.Context) (*CategoryRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryRuleCreate) SaveX(ctx context.Context) *CategoryRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CategoryRuleCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := categoryrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := categoryrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ForceReview(); !ok {
+		v := categoryrule.DefaultForceReview
+		_c.mutation.SetForceReview(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := categoryrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := categoryrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := categoryrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryRuleCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "CategoryRule.profile_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CategoryRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := categoryrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategoryRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "CategoryRule.priority"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "CategoryRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.ForceReview(); !ok {
+		return &ValidationError{Name: "force_review", err: errors.New(`ent: missing required field "CategoryRule.force_review"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CategoryRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CategoryRule.updated_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "CategoryRule.profile"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryRuleCreate) sqlSave(ctx context.Context) (*CategoryRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryRuleCreate) createSpec() (*CategoryRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categoryrule.Table, sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(categoryrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(categoryrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(categoryrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.MerchantPattern(); ok {
+		_spec.SetField(categoryrule.FieldMerchantPattern, field.TypeString, value)
+		_node.MerchantPattern = &value
+	}
+	if value, ok := _c.mutation.PathPattern(); ok {
+		_spec.SetField(categoryrule.FieldPathPattern, field.TypeString, value)
+		_node.PathPattern = &value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(categoryrule.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.MinTotal(); ok {
+		_spec.SetField(categoryrule.FieldMinTotal, field.TypeOther, value)
+		_node.MinTotal = &value
+	}
+	if value, ok := _c.mutation.MaxTotal(); ok {
+		_spec.SetField(categoryrule.FieldMaxTotal, field.TypeOther, value)
+		_node.MaxTotal = &value
+	}
+	if value, ok := _c.mutation.SetCategory(); ok {
+		_spec.SetField(categoryrule.FieldSetCategory, field.TypeString, value)
+		_node.SetCategory = &value
+	}
+	if value, ok := _c.mutation.ForceReview(); ok {
+		_spec.SetField(categoryrule.FieldForceReview, field.TypeBool, value)
+		_node.ForceReview = value
+	}
+	if value, ok := _c.mutation.SetDescription(); ok {
+		_spec.SetField(categoryrule.FieldSetDescription, field.TypeString, value)
+		_node.SetDescription = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryrule.ProfileTable,
+			Columns: []string{categoryrule.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryRule.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryRuleUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryRuleCreate) OnConflict(opts ...sql.ConflictOption) *CategoryRuleUpsertOne {
+	_c.conflict = opts
+	return &CategoryRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryRuleCreate) OnConflictColumns(columns ...string) *CategoryRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryRuleUpsertOne is the builder for "upsert"-ing
+	//  one CategoryRule node.
+	CategoryRuleUpsertOne struct {
+		create *CategoryRuleCreate
+	}
+
+	// CategoryRuleUpsert is the "OnConflict" setter.
+	CategoryRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryRuleUpsert) SetProfileID(v uuid.UUID) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateProfileID() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldProfileID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CategoryRuleUpsert) SetName(v string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateName() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldName)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategoryRuleUpsert) SetPriority(v int) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdatePriority() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategoryRuleUpsert) AddPriority(v int) *CategoryRuleUpsert {
+	u.Add(categoryrule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategoryRuleUpsert) SetEnabled(v bool) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateEnabled() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldEnabled)
+	return u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategoryRuleUpsert) SetMerchantPattern(v string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldMerchantPattern, v)
+	return u
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateMerchantPattern() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldMerchantPattern)
+	return u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategoryRuleUpsert) ClearMerchantPattern() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldMerchantPattern)
+	return u
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (u *CategoryRuleUpsert) SetPathPattern(v string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldPathPattern, v)
+	return u
+}
+
+// UpdatePathPattern sets the "path_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdatePathPattern() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldPathPattern)
+	return u
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (u *CategoryRuleUpsert) ClearPathPattern() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldPathPattern)
+	return u
+}
+
+// SetKeywords sets the "keywords" field.
+func (u *CategoryRuleUpsert) SetKeywords(v []string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldKeywords, v)
+	return u
+}
+
+// UpdateKeywords sets the "keywords" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateKeywords() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldKeywords)
+	return u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (u *CategoryRuleUpsert) ClearKeywords() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldKeywords)
+	return u
+}
+
+// SetMinTotal sets the "min_total" field.
+func (u *CategoryRuleUpsert) SetMinTotal(v money.Amount) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldMinTotal, v)
+	return u
+}
+
+// UpdateMinTotal sets the "min_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateMinTotal() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldMinTotal)
+	return u
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (u *CategoryRuleUpsert) ClearMinTotal() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldMinTotal)
+	return u
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (u *CategoryRuleUpsert) SetMaxTotal(v money.Amount) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldMaxTotal, v)
+	return u
+}
+
+// UpdateMaxTotal sets the "max_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateMaxTotal() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldMaxTotal)
+	return u
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (u *CategoryRuleUpsert) ClearMaxTotal() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldMaxTotal)
+	return u
+}
+
+// SetSetCategory sets the "set_category" field.
+func (u *CategoryRuleUpsert) SetSetCategory(v string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldSetCategory, v)
+	return u
+}
+
+// UpdateSetCategory sets the "set_category" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateSetCategory() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldSetCategory)
+	return u
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (u *CategoryRuleUpsert) ClearSetCategory() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldSetCategory)
+	return u
+}
+
+// SetForceReview sets the "force_review" field.
+func (u *CategoryRuleUpsert) SetForceReview(v bool) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldForceReview, v)
+	return u
+}
+
+// UpdateForceReview sets the "force_review" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateForceReview() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldForceReview)
+	return u
+}
+
+// SetSetDescription sets the "set_description" field.
+func (u *CategoryRuleUpsert) SetSetDescription(v string) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldSetDescription, v)
+	return u
+}
+
+// UpdateSetDescription sets the "set_description" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateSetDescription() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldSetDescription)
+	return u
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (u *CategoryRuleUpsert) ClearSetDescription() *CategoryRuleUpsert {
+	u.SetNull(categoryrule.FieldSetDescription)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CategoryRuleUpsert) SetCreatedAt(v time.Time) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateCreatedAt() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRuleUpsert) SetUpdatedAt(v time.Time) *CategoryRuleUpsert {
+	u.Set(categoryrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsert) UpdateUpdatedAt() *CategoryRuleUpsert {
+	u.SetExcluded(categoryrule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryRuleUpsertOne) UpdateNewValues() *CategoryRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(categoryrule.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryRuleUpsertOne) Ignore() *CategoryRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryRuleUpsertOne) DoNothing() *CategoryRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryRuleCreate.OnConflict
+// documentation for more info.
+func (u *CategoryRuleUpsertOne) Update(set func(*CategoryRuleUpsert)) *CategoryRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryRuleUpsertOne) SetProfileID(v uuid.UUID) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateProfileID() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CategoryRuleUpsertOne) SetName(v string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateName() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategoryRuleUpsertOne) SetPriority(v int) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategoryRuleUpsertOne) AddPriority(v int) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdatePriority() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategoryRuleUpsertOne) SetEnabled(v bool) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateEnabled() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategoryRuleUpsertOne) SetMerchantPattern(v string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMerchantPattern(v)
+	})
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateMerchantPattern() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMerchantPattern()
+	})
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategoryRuleUpsertOne) ClearMerchantPattern() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMerchantPattern()
+	})
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (u *CategoryRuleUpsertOne) SetPathPattern(v string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetPathPattern(v)
+	})
+}
+
+// UpdatePathPattern sets the "path_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdatePathPattern() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdatePathPattern()
+	})
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (u *CategoryRuleUpsertOne) ClearPathPattern() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearPathPattern()
+	})
+}
+
+// SetKeywords sets the "keywords" field.
+func (u *CategoryRuleUpsertOne) SetKeywords(v []string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetKeywords(v)
+	})
+}
+
+// UpdateKeywords sets the "keywords" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateKeywords() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateKeywords()
+	})
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (u *CategoryRuleUpsertOne) ClearKeywords() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearKeywords()
+	})
+}
+
+// SetMinTotal sets the "min_total" field.
+func (u *CategoryRuleUpsertOne) SetMinTotal(v money.Amount) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMinTotal(v)
+	})
+}
+
+// UpdateMinTotal sets the "min_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateMinTotal() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMinTotal()
+	})
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (u *CategoryRuleUpsertOne) ClearMinTotal() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMinTotal()
+	})
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (u *CategoryRuleUpsertOne) SetMaxTotal(v money.Amount) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMaxTotal(v)
+	})
+}
+
+// UpdateMaxTotal sets the "max_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateMaxTotal() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMaxTotal()
+	})
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (u *CategoryRuleUpsertOne) ClearMaxTotal() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMaxTotal()
+	})
+}
+
+// SetSetCategory sets the "set_category" field.
+func (u *CategoryRuleUpsertOne) SetSetCategory(v string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetSetCategory(v)
+	})
+}
+
+// UpdateSetCategory sets the "set_category" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateSetCategory() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateSetCategory()
+	})
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (u *CategoryRuleUpsertOne) ClearSetCategory() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearSetCategory()
+	})
+}
+
+// SetForceReview sets the "force_review" field.
+func (u *CategoryRuleUpsertOne) SetForceReview(v bool) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetForceReview(v)
+	})
+}
+
+// UpdateForceReview sets the "force_review" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateForceReview() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateForceReview()
+	})
+}
+
+// SetSetDescription sets the "set_description" field.
+func (u *CategoryRuleUpsertOne) SetSetDescription(v string) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetSetDescription(v)
+	})
+}
+
+// UpdateSetDescription sets the "set_description" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateSetDescription() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateSetDescription()
+	})
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (u *CategoryRuleUpsertOne) ClearSetDescription() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearSetDescription()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CategoryRuleUpsertOne) SetCreatedAt(v time.Time) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateCreatedAt() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRuleUpsertOne) SetUpdatedAt(v time.Time) *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsertOne) UpdateUpdatedAt() *CategoryRuleUpsertOne {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryRuleUpsertOne.ID is not supported by MySQL driver. Use CategoryRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryRuleCreateBulk is the builder for creating many CategoryRule entities in bulk.
+type CategoryRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryRule entities in the database.
+func (_c *CategoryRuleCreateBulk) Save(ctx context.Context) ([]*CategoryRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryRuleCreateBulk) SaveX(ctx context.Context) []*CategoryRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryRuleUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryRuleUpsertBulk {
+	_c.conflict = opts
+	return &CategoryRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryRuleCreateBulk) OnConflictColumns(columns ...string) *CategoryRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryRule nodes.
+type CategoryRuleUpsertBulk struct {
+	create *CategoryRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(categoryrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CategoryRuleUpsertBulk) UpdateNewValues() *CategoryRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(categoryrule.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryRuleUpsertBulk) Ignore() *CategoryRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryRuleUpsertBulk) DoNothing() *CategoryRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryRuleUpsertBulk) Update(set func(*CategoryRuleUpsert)) *CategoryRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *CategoryRuleUpsertBulk) SetProfileID(v uuid.UUID) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateProfileID() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CategoryRuleUpsertBulk) SetName(v string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateName() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *CategoryRuleUpsertBulk) SetPriority(v int) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *CategoryRuleUpsertBulk) AddPriority(v int) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdatePriority() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CategoryRuleUpsertBulk) SetEnabled(v bool) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateEnabled() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (u *CategoryRuleUpsertBulk) SetMerchantPattern(v string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMerchantPattern(v)
+	})
+}
+
+// UpdateMerchantPattern sets the "merchant_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateMerchantPattern() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMerchantPattern()
+	})
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (u *CategoryRuleUpsertBulk) ClearMerchantPattern() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMerchantPattern()
+	})
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (u *CategoryRuleUpsertBulk) SetPathPattern(v string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetPathPattern(v)
+	})
+}
+
+// UpdatePathPattern sets the "path_pattern" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdatePathPattern() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdatePathPattern()
+	})
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (u *CategoryRuleUpsertBulk) ClearPathPattern() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearPathPattern()
+	})
+}
+
+// SetKeywords sets the "keywords" field.
+func (u *CategoryRuleUpsertBulk) SetKeywords(v []string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetKeywords(v)
+	})
+}
+
+// UpdateKeywords sets the "keywords" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateKeywords() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateKeywords()
+	})
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (u *CategoryRuleUpsertBulk) ClearKeywords() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearKeywords()
+	})
+}
+
+// SetMinTotal sets the "min_total" field.
+func (u *CategoryRuleUpsertBulk) SetMinTotal(v money.Amount) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMinTotal(v)
+	})
+}
+
+// UpdateMinTotal sets the "min_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateMinTotal() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMinTotal()
+	})
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (u *CategoryRuleUpsertBulk) ClearMinTotal() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMinTotal()
+	})
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (u *CategoryRuleUpsertBulk) SetMaxTotal(v money.Amount) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetMaxTotal(v)
+	})
+}
+
+// UpdateMaxTotal sets the "max_total" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateMaxTotal() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateMaxTotal()
+	})
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (u *CategoryRuleUpsertBulk) ClearMaxTotal() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearMaxTotal()
+	})
+}
+
+// SetSetCategory sets the "set_category" field.
+func (u *CategoryRuleUpsertBulk) SetSetCategory(v string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetSetCategory(v)
+	})
+}
+
+// UpdateSetCategory sets the "set_category" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateSetCategory() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateSetCategory()
+	})
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (u *CategoryRuleUpsertBulk) ClearSetCategory() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearSetCategory()
+	})
+}
+
+// SetForceReview sets the "force_review" field.
+func (u *CategoryRuleUpsertBulk) SetForceReview(v bool) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetForceReview(v)
+	})
+}
+
+// UpdateForceReview sets the "force_review" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateForceReview() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateForceReview()
+	})
+}
+
+// SetSetDescription sets the "set_description" field.
+func (u *CategoryRuleUpsertBulk) SetSetDescription(v string) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetSetDescription(v)
+	})
+}
+
+// UpdateSetDescription sets the "set_description" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateSetDescription() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateSetDescription()
+	})
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (u *CategoryRuleUpsertBulk) ClearSetDescription() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.ClearSetDescription()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CategoryRuleUpsertBulk) SetCreatedAt(v time.Time) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateCreatedAt() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CategoryRuleUpsertBulk) SetUpdatedAt(v time.Time) *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CategoryRuleUpsertBulk) UpdateUpdatedAt() *CategoryRuleUpsertBulk {
+	return u.Update(func(s *CategoryRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
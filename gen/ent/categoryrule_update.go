@@ -0,0 +1,932 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// CategoryRuleUpdate is the builder for updating CategoryRule entities.
+type CategoryRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CategoryRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CategoryRuleUpdate builder.
+func (_u *CategoryRuleUpdate) Where(ps ...predicate.CategoryRule) *CategoryRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *CategoryRuleUpdate) SetProfileID(v uuid.UUID) *CategoryRuleUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableProfileID(v *uuid.UUID) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CategoryRuleUpdate) SetName(v string) *CategoryRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableName(v *string) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *CategoryRuleUpdate) SetPriority(v int) *CategoryRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillablePriority(v *int) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CategoryRuleUpdate) AddPriority(v int) *CategoryRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CategoryRuleUpdate) SetEnabled(v bool) *CategoryRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableEnabled(v *bool) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_u *CategoryRuleUpdate) SetMerchantPattern(v string) *CategoryRuleUpdate {
+	_u.mutation.SetMerchantPattern(v)
+	return _u
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableMerchantPattern(v *string) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetMerchantPattern(*v)
+	}
+	return _u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (_u *CategoryRuleUpdate) ClearMerchantPattern() *CategoryRuleUpdate {
+	_u.mutation.ClearMerchantPattern()
+	return _u
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (_u *CategoryRuleUpdate) SetPathPattern(v string) *CategoryRuleUpdate {
+	_u.mutation.SetPathPattern(v)
+	return _u
+}
+
+// SetNillablePathPattern sets the "path_pattern" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillablePathPattern(v *string) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetPathPattern(*v)
+	}
+	return _u
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (_u *CategoryRuleUpdate) ClearPathPattern() *CategoryRuleUpdate {
+	_u.mutation.ClearPathPattern()
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *CategoryRuleUpdate) SetKeywords(v []string) *CategoryRuleUpdate {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *CategoryRuleUpdate) AppendKeywords(v []string) *CategoryRuleUpdate {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *CategoryRuleUpdate) ClearKeywords() *CategoryRuleUpdate {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetMinTotal sets the "min_total" field.
+func (_u *CategoryRuleUpdate) SetMinTotal(v money.Amount) *CategoryRuleUpdate {
+	_u.mutation.SetMinTotal(v)
+	return _u
+}
+
+// SetNillableMinTotal sets the "min_total" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableMinTotal(v *money.Amount) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetMinTotal(*v)
+	}
+	return _u
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (_u *CategoryRuleUpdate) ClearMinTotal() *CategoryRuleUpdate {
+	_u.mutation.ClearMinTotal()
+	return _u
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (_u *CategoryRuleUpdate) SetMaxTotal(v money.Amount) *CategoryRuleUpdate {
+	_u.mutation.SetMaxTotal(v)
+	return _u
+}
+
+// SetNillableMaxTotal sets the "max_total" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableMaxTotal(v *money.Amount) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetMaxTotal(*v)
+	}
+	return _u
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (_u *CategoryRuleUpdate) ClearMaxTotal() *CategoryRuleUpdate {
+	_u.mutation.ClearMaxTotal()
+	return _u
+}
+
+// SetSetCategory sets the "set_category" field.
+func (_u *CategoryRuleUpdate) SetSetCategory(v string) *CategoryRuleUpdate {
+	_u.mutation.SetSetCategory(v)
+	return _u
+}
+
+// SetNillableSetCategory sets the "set_category" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableSetCategory(v *string) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetSetCategory(*v)
+	}
+	return _u
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (_u *CategoryRuleUpdate) ClearSetCategory() *CategoryRuleUpdate {
+	_u.mutation.ClearSetCategory()
+	return _u
+}
+
+// SetForceReview sets the "force_review" field.
+func (_u *CategoryRuleUpdate) SetForceReview(v bool) *CategoryRuleUpdate {
+	_u.mutation.SetForceReview(v)
+	return _u
+}
+
+// SetNillableForceReview sets the "force_review" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableForceReview(v *bool) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetForceReview(*v)
+	}
+	return _u
+}
+
+// SetSetDescription sets the "set_description" field.
+func (_u *CategoryRuleUpdate) SetSetDescription(v string) *CategoryRuleUpdate {
+	_u.mutation.SetSetDescription(v)
+	return _u
+}
+
+// SetNillableSetDescription sets the "set_description" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableSetDescription(v *string) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetSetDescription(*v)
+	}
+	return _u
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (_u *CategoryRuleUpdate) ClearSetDescription() *CategoryRuleUpdate {
+	_u.mutation.ClearSetDescription()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CategoryRuleUpdate) SetCreatedAt(v time.Time) *CategoryRuleUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CategoryRuleUpdate) SetNillableCreatedAt(v *time.Time) *CategoryRuleUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryRuleUpdate) SetUpdatedAt(v time.Time) *CategoryRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *CategoryRuleUpdate) SetProfile(v *Profile) *CategoryRuleUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryRuleMutation object of the builder.
+func (_u *CategoryRuleUpdate) Mutation() *CategoryRuleMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *CategoryRuleUpdate) ClearProfile() *CategoryRuleUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categoryrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := categoryrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategoryRule.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryRule.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categoryrule.Table, categoryrule.Columns, sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categoryrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(categoryrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(categoryrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(categoryrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MerchantPattern(); ok {
+		_spec.SetField(categoryrule.FieldMerchantPattern, field.TypeString, value)
+	}
+	if _u.mutation.MerchantPatternCleared() {
+		_spec.ClearField(categoryrule.FieldMerchantPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathPattern(); ok {
+		_spec.SetField(categoryrule.FieldPathPattern, field.TypeString, value)
+	}
+	if _u.mutation.PathPatternCleared() {
+		_spec.ClearField(categoryrule.FieldPathPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(categoryrule.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categoryrule.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(categoryrule.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MinTotal(); ok {
+		_spec.SetField(categoryrule.FieldMinTotal, field.TypeOther, value)
+	}
+	if _u.mutation.MinTotalCleared() {
+		_spec.ClearField(categoryrule.FieldMinTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.MaxTotal(); ok {
+		_spec.SetField(categoryrule.FieldMaxTotal, field.TypeOther, value)
+	}
+	if _u.mutation.MaxTotalCleared() {
+		_spec.ClearField(categoryrule.FieldMaxTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.SetCategory(); ok {
+		_spec.SetField(categoryrule.FieldSetCategory, field.TypeString, value)
+	}
+	if _u.mutation.SetCategoryCleared() {
+		_spec.ClearField(categoryrule.FieldSetCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.ForceReview(); ok {
+		_spec.SetField(categoryrule.FieldForceReview, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SetDescription(); ok {
+		_spec.SetField(categoryrule.FieldSetDescription, field.TypeString, value)
+	}
+	if _u.mutation.SetDescriptionCleared() {
+		_spec.ClearField(categoryrule.FieldSetDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryrule.ProfileTable,
+			Columns: []string{categoryrule.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryrule.ProfileTable,
+			Columns: []string{categoryrule.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryRuleUpdateOne is the builder for updating a single CategoryRule entity.
+type CategoryRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *CategoryRuleUpdateOne) SetProfileID(v uuid.UUID) *CategoryRuleUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableProfileID(v *uuid.UUID) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CategoryRuleUpdateOne) SetName(v string) *CategoryRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableName(v *string) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *CategoryRuleUpdateOne) SetPriority(v int) *CategoryRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillablePriority(v *int) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CategoryRuleUpdateOne) AddPriority(v int) *CategoryRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CategoryRuleUpdateOne) SetEnabled(v bool) *CategoryRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableEnabled(v *bool) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (_u *CategoryRuleUpdateOne) SetMerchantPattern(v string) *CategoryRuleUpdateOne {
+	_u.mutation.SetMerchantPattern(v)
+	return _u
+}
+
+// SetNillableMerchantPattern sets the "merchant_pattern" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableMerchantPattern(v *string) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetMerchantPattern(*v)
+	}
+	return _u
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (_u *CategoryRuleUpdateOne) ClearMerchantPattern() *CategoryRuleUpdateOne {
+	_u.mutation.ClearMerchantPattern()
+	return _u
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (_u *CategoryRuleUpdateOne) SetPathPattern(v string) *CategoryRuleUpdateOne {
+	_u.mutation.SetPathPattern(v)
+	return _u
+}
+
+// SetNillablePathPattern sets the "path_pattern" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillablePathPattern(v *string) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetPathPattern(*v)
+	}
+	return _u
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (_u *CategoryRuleUpdateOne) ClearPathPattern() *CategoryRuleUpdateOne {
+	_u.mutation.ClearPathPattern()
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *CategoryRuleUpdateOne) SetKeywords(v []string) *CategoryRuleUpdateOne {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *CategoryRuleUpdateOne) AppendKeywords(v []string) *CategoryRuleUpdateOne {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *CategoryRuleUpdateOne) ClearKeywords() *CategoryRuleUpdateOne {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetMinTotal sets the "min_total" field.
+func (_u *CategoryRuleUpdateOne) SetMinTotal(v money.Amount) *CategoryRuleUpdateOne {
+	_u.mutation.SetMinTotal(v)
+	return _u
+}
+
+// SetNillableMinTotal sets the "min_total" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableMinTotal(v *money.Amount) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetMinTotal(*v)
+	}
+	return _u
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (_u *CategoryRuleUpdateOne) ClearMinTotal() *CategoryRuleUpdateOne {
+	_u.mutation.ClearMinTotal()
+	return _u
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (_u *CategoryRuleUpdateOne) SetMaxTotal(v money.Amount) *CategoryRuleUpdateOne {
+	_u.mutation.SetMaxTotal(v)
+	return _u
+}
+
+// SetNillableMaxTotal sets the "max_total" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableMaxTotal(v *money.Amount) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxTotal(*v)
+	}
+	return _u
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (_u *CategoryRuleUpdateOne) ClearMaxTotal() *CategoryRuleUpdateOne {
+	_u.mutation.ClearMaxTotal()
+	return _u
+}
+
+// SetSetCategory sets the "set_category" field.
+func (_u *CategoryRuleUpdateOne) SetSetCategory(v string) *CategoryRuleUpdateOne {
+	_u.mutation.SetSetCategory(v)
+	return _u
+}
+
+// SetNillableSetCategory sets the "set_category" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableSetCategory(v *string) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetSetCategory(*v)
+	}
+	return _u
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (_u *CategoryRuleUpdateOne) ClearSetCategory() *CategoryRuleUpdateOne {
+	_u.mutation.ClearSetCategory()
+	return _u
+}
+
+// SetForceReview sets the "force_review" field.
+func (_u *CategoryRuleUpdateOne) SetForceReview(v bool) *CategoryRuleUpdateOne {
+	_u.mutation.SetForceReview(v)
+	return _u
+}
+
+// SetNillableForceReview sets the "force_review" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableForceReview(v *bool) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetForceReview(*v)
+	}
+	return _u
+}
+
+// SetSetDescription sets the "set_description" field.
+func (_u *CategoryRuleUpdateOne) SetSetDescription(v string) *CategoryRuleUpdateOne {
+	_u.mutation.SetSetDescription(v)
+	return _u
+}
+
+// SetNillableSetDescription sets the "set_description" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableSetDescription(v *string) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetSetDescription(*v)
+	}
+	return _u
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (_u *CategoryRuleUpdateOne) ClearSetDescription() *CategoryRuleUpdateOne {
+	_u.mutation.ClearSetDescription()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CategoryRuleUpdateOne) SetCreatedAt(v time.Time) *CategoryRuleUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CategoryRuleUpdateOne) SetNillableCreatedAt(v *time.Time) *CategoryRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CategoryRuleUpdateOne) SetUpdatedAt(v time.Time) *CategoryRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *CategoryRuleUpdateOne) SetProfile(v *Profile) *CategoryRuleUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the CategoryRuleMutation object of the builder.
+func (_u *CategoryRuleUpdateOne) Mutation() *CategoryRuleMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *CategoryRuleUpdateOne) ClearProfile() *CategoryRuleUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the CategoryRuleUpdate builder.
+func (_u *CategoryRuleUpdateOne) Where(ps ...predicate.CategoryRule) *CategoryRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryRuleUpdateOne) Select(field string, fields ...string) *CategoryRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryRule entity.
+func (_u *CategoryRuleUpdateOne) Save(ctx context.Context) (*CategoryRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryRuleUpdateOne) SaveX(ctx context.Context) *CategoryRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CategoryRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := categoryrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := categoryrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CategoryRule.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryRule.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CategoryRuleUpdateOne) sqlSave(ctx context.Context) (_node *CategoryRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categoryrule.Table, categoryrule.Columns, sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categoryrule.FieldID)
+		for _, f := range fields {
+			if !categoryrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categoryrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(categoryrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(categoryrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(categoryrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(categoryrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MerchantPattern(); ok {
+		_spec.SetField(categoryrule.FieldMerchantPattern, field.TypeString, value)
+	}
+	if _u.mutation.MerchantPatternCleared() {
+		_spec.ClearField(categoryrule.FieldMerchantPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.PathPattern(); ok {
+		_spec.SetField(categoryrule.FieldPathPattern, field.TypeString, value)
+	}
+	if _u.mutation.PathPatternCleared() {
+		_spec.ClearField(categoryrule.FieldPathPattern, field.TypeString)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(categoryrule.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, categoryrule.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(categoryrule.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MinTotal(); ok {
+		_spec.SetField(categoryrule.FieldMinTotal, field.TypeOther, value)
+	}
+	if _u.mutation.MinTotalCleared() {
+		_spec.ClearField(categoryrule.FieldMinTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.MaxTotal(); ok {
+		_spec.SetField(categoryrule.FieldMaxTotal, field.TypeOther, value)
+	}
+	if _u.mutation.MaxTotalCleared() {
+		_spec.ClearField(categoryrule.FieldMaxTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.SetCategory(); ok {
+		_spec.SetField(categoryrule.FieldSetCategory, field.TypeString, value)
+	}
+	if _u.mutation.SetCategoryCleared() {
+		_spec.ClearField(categoryrule.FieldSetCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.ForceReview(); ok {
+		_spec.SetField(categoryrule.FieldForceReview, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SetDescription(); ok {
+		_spec.SetField(categoryrule.FieldSetDescription, field.TypeString, value)
+	}
+	if _u.mutation.SetDescriptionCleared() {
+		_spec.ClearField(categoryrule.FieldSetDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(categoryrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(categoryrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryrule.ProfileTable,
+			Columns: []string{categoryrule.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categoryrule.ProfileTable,
+			Columns: []string{categoryrule.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CategoryRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categoryrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,10 +16,22 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,14 +39,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// CategoryCorrection is the client for interacting with the CategoryCorrection builders.
+	CategoryCorrection *CategoryCorrectionClient
+	// CategoryRule is the client for interacting with the CategoryRule builders.
+	CategoryRule *CategoryRuleClient
+	// ExportTemplate is the client for interacting with the ExportTemplate builders.
+	ExportTemplate *ExportTemplateClient
 	// ExtractJob is the client for interacting with the ExtractJob builders.
 	ExtractJob *ExtractJobClient
+	// FxRate is the client for interacting with the FxRate builders.
+	FxRate *FxRateClient
+	// Merchant is the client for interacting with the Merchant builders.
+	Merchant *MerchantClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
 	// Receipt is the client for interacting with the Receipt builders.
 	Receipt *ReceiptClient
+	// ReceiptAnomaly is the client for interacting with the ReceiptAnomaly builders.
+	ReceiptAnomaly *ReceiptAnomalyClient
 	// ReceiptFile is the client for interacting with the ReceiptFile builders.
 	ReceiptFile *ReceiptFileClient
+	// StatementTransaction is the client for interacting with the StatementTransaction builders.
+	StatementTransaction *StatementTransactionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,10 +78,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Budget = NewBudgetClient(c.config)
+	c.BudgetAlert = NewBudgetAlertClient(c.config)
+	c.Category = NewCategoryClient(c.config)
+	c.CategoryCorrection = NewCategoryCorrectionClient(c.config)
+	c.CategoryRule = NewCategoryRuleClient(c.config)
+	c.ExportTemplate = NewExportTemplateClient(c.config)
 	c.ExtractJob = NewExtractJobClient(c.config)
+	c.FxRate = NewFxRateClient(c.config)
+	c.Merchant = NewMerchantClient(c.config)
 	c.Profile = NewProfileClient(c.config)
 	c.Receipt = NewReceiptClient(c.config)
+	c.ReceiptAnomaly = NewReceiptAnomalyClient(c.config)
 	c.ReceiptFile = NewReceiptFileClient(c.config)
+	c.StatementTransaction = NewStatementTransactionClient(c.config)
 }
 
 type (
@@ -140,12 +182,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ExtractJob:  NewExtractJobClient(cfg),
-		Profile:     NewProfileClient(cfg),
-		Receipt:     NewReceiptClient(cfg),
-		ReceiptFile: NewReceiptFileClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Budget:               NewBudgetClient(cfg),
+		BudgetAlert:          NewBudgetAlertClient(cfg),
+		Category:             NewCategoryClient(cfg),
+		CategoryCorrection:   NewCategoryCorrectionClient(cfg),
+		CategoryRule:         NewCategoryRuleClient(cfg),
+		ExportTemplate:       NewExportTemplateClient(cfg),
+		ExtractJob:           NewExtractJobClient(cfg),
+		FxRate:               NewFxRateClient(cfg),
+		Merchant:             NewMerchantClient(cfg),
+		Profile:              NewProfileClient(cfg),
+		Receipt:              NewReceiptClient(cfg),
+		ReceiptAnomaly:       NewReceiptAnomalyClient(cfg),
+		ReceiptFile:          NewReceiptFileClient(cfg),
+		StatementTransaction: NewStatementTransactionClient(cfg),
 	}, nil
 }
 
@@ -163,19 +215,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		ExtractJob:  NewExtractJobClient(cfg),
-		Profile:     NewProfileClient(cfg),
-		Receipt:     NewReceiptClient(cfg),
-		ReceiptFile: NewReceiptFileClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Budget:               NewBudgetClient(cfg),
+		BudgetAlert:          NewBudgetAlertClient(cfg),
+		Category:             NewCategoryClient(cfg),
+		CategoryCorrection:   NewCategoryCorrectionClient(cfg),
+		CategoryRule:         NewCategoryRuleClient(cfg),
+		ExportTemplate:       NewExportTemplateClient(cfg),
+		ExtractJob:           NewExtractJobClient(cfg),
+		FxRate:               NewFxRateClient(cfg),
+		Merchant:             NewMerchantClient(cfg),
+		Profile:              NewProfileClient(cfg),
+		Receipt:              NewReceiptClient(cfg),
+		ReceiptAnomaly:       NewReceiptAnomalyClient(cfg),
+		ReceiptFile:          NewReceiptFileClient(cfg),
+		StatementTransaction: NewStatementTransactionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ExtractJob.
+//		Budget.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -197,138 +259,164 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ExtractJob.Use(hooks...)
-	c.Profile.Use(hooks...)
-	c.Receipt.Use(hooks...)
-	c.ReceiptFile.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Budget, c.BudgetAlert, c.Category, c.CategoryCorrection, c.CategoryRule,
+		c.ExportTemplate, c.ExtractJob, c.FxRate, c.Merchant, c.Profile, c.Receipt,
+		c.ReceiptAnomaly, c.ReceiptFile, c.StatementTransaction,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ExtractJob.Intercept(interceptors...)
-	c.Profile.Intercept(interceptors...)
-	c.Receipt.Intercept(interceptors...)
-	c.ReceiptFile.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Budget, c.BudgetAlert, c.Category, c.CategoryCorrection, c.CategoryRule,
+		c.ExportTemplate, c.ExtractJob, c.FxRate, c.Merchant, c.Profile, c.Receipt,
+		c.ReceiptAnomaly, c.ReceiptFile, c.StatementTransaction,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BudgetMutation:
+		return c.Budget.mutate(ctx, m)
+	case *BudgetAlertMutation:
+		return c.BudgetAlert.mutate(ctx, m)
+	case *CategoryMutation:
+		return c.Category.mutate(ctx, m)
+	case *CategoryCorrectionMutation:
+		return c.CategoryCorrection.mutate(ctx, m)
+	case *CategoryRuleMutation:
+		return c.CategoryRule.mutate(ctx, m)
+	case *ExportTemplateMutation:
+		return c.ExportTemplate.mutate(ctx, m)
 	case *ExtractJobMutation:
 		return c.ExtractJob.mutate(ctx, m)
+	case *FxRateMutation:
+		return c.FxRate.mutate(ctx, m)
+	case *MerchantMutation:
+		return c.Merchant.mutate(ctx, m)
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
 	case *ReceiptMutation:
 		return c.Receipt.mutate(ctx, m)
+	case *ReceiptAnomalyMutation:
+		return c.ReceiptAnomaly.mutate(ctx, m)
 	case *ReceiptFileMutation:
 		return c.ReceiptFile.mutate(ctx, m)
+	case *StatementTransactionMutation:
+		return c.StatementTransaction.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// ExtractJobClient is a client for the ExtractJob schema.
-type ExtractJobClient struct {
+// BudgetClient is a client for the Budget schema.
+type BudgetClient struct {
 	config
 }
 
-// NewExtractJobClient returns a client for the ExtractJob from the given config.
-func NewExtractJobClient(c config) *ExtractJobClient {
-	return &ExtractJobClient{config: c}
+// NewBudgetClient returns a client for the Budget from the given config.
+func NewBudgetClient(c config) *BudgetClient {
+	return &BudgetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `extractjob.Hooks(f(g(h())))`.
-func (c *ExtractJobClient) Use(hooks ...Hook) {
-	c.hooks.ExtractJob = append(c.hooks.ExtractJob, hooks...)
+// A call to `Use(f, g, h)` equals to `budget.Hooks(f(g(h())))`.
+func (c *BudgetClient) Use(hooks ...Hook) {
+	c.hooks.Budget = append(c.hooks.Budget, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `extractjob.Intercept(f(g(h())))`.
-func (c *ExtractJobClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ExtractJob = append(c.inters.ExtractJob, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `budget.Intercept(f(g(h())))`.
+func (c *BudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Budget = append(c.inters.Budget, interceptors...)
 }
 
-// Create returns a builder for creating a ExtractJob entity.
-func (c *ExtractJobClient) Create() *ExtractJobCreate {
-	mutation := newExtractJobMutation(c.config, OpCreate)
-	return &ExtractJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Budget entity.
+func (c *BudgetClient) Create() *BudgetCreate {
+	mutation := newBudgetMutation(c.config, OpCreate)
+	return &BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ExtractJob entities.
-func (c *ExtractJobClient) CreateBulk(builders ...*ExtractJobCreate) *ExtractJobCreateBulk {
-	return &ExtractJobCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Budget entities.
+func (c *BudgetClient) CreateBulk(builders ...*BudgetCreate) *BudgetCreateBulk {
+	return &BudgetCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ExtractJobClient) MapCreateBulk(slice any, setFunc func(*ExtractJobCreate, int)) *ExtractJobCreateBulk {
+func (c *BudgetClient) MapCreateBulk(slice any, setFunc func(*BudgetCreate, int)) *BudgetCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ExtractJobCreateBulk{err: fmt.Errorf("calling to ExtractJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BudgetCreateBulk{err: fmt.Errorf("calling to BudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ExtractJobCreate, rv.Len())
+	builders := make([]*BudgetCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ExtractJobCreateBulk{config: c.config, builders: builders}
+	return &BudgetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ExtractJob.
-func (c *ExtractJobClient) Update() *ExtractJobUpdate {
-	mutation := newExtractJobMutation(c.config, OpUpdate)
-	return &ExtractJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Budget.
+func (c *BudgetClient) Update() *BudgetUpdate {
+	mutation := newBudgetMutation(c.config, OpUpdate)
+	return &BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExtractJobClient) UpdateOne(_m *ExtractJob) *ExtractJobUpdateOne {
-	mutation := newExtractJobMutation(c.config, OpUpdateOne, withExtractJob(_m))
-	return &ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetClient) UpdateOne(_m *Budget) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudget(_m))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ExtractJobClient) UpdateOneID(id uuid.UUID) *ExtractJobUpdateOne {
-	mutation := newExtractJobMutation(c.config, OpUpdateOne, withExtractJobID(id))
-	return &ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetClient) UpdateOneID(id uuid.UUID) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudgetID(id))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ExtractJob.
-func (c *ExtractJobClient) Delete() *ExtractJobDelete {
-	mutation := newExtractJobMutation(c.config, OpDelete)
-	return &ExtractJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Budget.
+func (c *BudgetClient) Delete() *BudgetDelete {
+	mutation := newBudgetMutation(c.config, OpDelete)
+	return &BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExtractJobClient) DeleteOne(_m *ExtractJob) *ExtractJobDeleteOne {
+func (c *BudgetClient) DeleteOne(_m *Budget) *BudgetDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ExtractJobClient) DeleteOneID(id uuid.UUID) *ExtractJobDeleteOne {
-	builder := c.Delete().Where(extractjob.ID(id))
+func (c *BudgetClient) DeleteOneID(id uuid.UUID) *BudgetDeleteOne {
+	builder := c.Delete().Where(budget.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ExtractJobDeleteOne{builder}
+	return &BudgetDeleteOne{builder}
 }
 
-// Query returns a query builder for ExtractJob.
-func (c *ExtractJobClient) Query() *ExtractJobQuery {
-	return &ExtractJobQuery{
+// Query returns a query builder for Budget.
+func (c *BudgetClient) Query() *BudgetQuery {
+	return &BudgetQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeExtractJob},
+		ctx:    &QueryContext{Type: TypeBudget},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ExtractJob entity by its id.
-func (c *ExtractJobClient) Get(ctx context.Context, id uuid.UUID) (*ExtractJob, error) {
-	return c.Query().Where(extractjob.ID(id)).Only(ctx)
+// Get returns a Budget entity by its id.
+func (c *BudgetClient) Get(ctx context.Context, id uuid.UUID) (*Budget, error) {
+	return c.Query().Where(budget.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ExtractJobClient) GetX(ctx context.Context, id uuid.UUID) *ExtractJob {
+func (c *BudgetClient) GetX(ctx context.Context, id uuid.UUID) *Budget {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -336,31 +424,15 @@ func (c *ExtractJobClient) GetX(ctx context.Context, id uuid.UUID) *ExtractJob {
 	return obj
 }
 
-// QueryFile queries the file edge of a ExtractJob.
-func (c *ExtractJobClient) QueryFile(_m *ExtractJob) *ReceiptFileQuery {
-	query := (&ReceiptFileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
-			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.FileTable, extractjob.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProfile queries the profile edge of a ExtractJob.
-func (c *ExtractJobClient) QueryProfile(_m *ExtractJob) *ProfileQuery {
+// QueryProfile queries the profile edge of a Budget.
+func (c *BudgetClient) QueryProfile(_m *Budget) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
+			sqlgraph.From(budget.Table, budget.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.ProfileTable, extractjob.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.ProfileTable, budget.ProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -368,15 +440,15 @@ func (c *ExtractJobClient) QueryProfile(_m *ExtractJob) *ProfileQuery {
 	return query
 }
 
-// QueryReceipt queries the receipt edge of a ExtractJob.
-func (c *ExtractJobClient) QueryReceipt(_m *ExtractJob) *ReceiptQuery {
-	query := (&ReceiptClient{config: c.config}).Query()
+// QueryAlerts queries the alerts edge of a Budget.
+func (c *BudgetClient) QueryAlerts(_m *Budget) *BudgetAlertQuery {
+	query := (&BudgetAlertClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
-			sqlgraph.To(receipt.Table, receipt.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.ReceiptTable, extractjob.ReceiptColumn),
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(budgetalert.Table, budgetalert.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, budget.AlertsTable, budget.AlertsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -385,131 +457,131 @@ func (c *ExtractJobClient) QueryReceipt(_m *ExtractJob) *ReceiptQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ExtractJobClient) Hooks() []Hook {
-	return c.hooks.ExtractJob
+func (c *BudgetClient) Hooks() []Hook {
+	return c.hooks.Budget
 }
 
 // Interceptors returns the client interceptors.
-func (c *ExtractJobClient) Interceptors() []Interceptor {
-	return c.inters.ExtractJob
+func (c *BudgetClient) Interceptors() []Interceptor {
+	return c.inters.Budget
 }
 
-func (c *ExtractJobClient) mutate(ctx context.Context, m *ExtractJobMutation) (Value, error) {
+func (c *BudgetClient) mutate(ctx context.Context, m *BudgetMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ExtractJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ExtractJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ExtractJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ExtractJob mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Budget mutation op: %q", m.Op())
 	}
 }
 
-// ProfileClient is a client for the Profile schema.
-type ProfileClient struct {
+// BudgetAlertClient is a client for the BudgetAlert schema.
+type BudgetAlertClient struct {
 	config
 }
 
-// NewProfileClient returns a client for the Profile from the given config.
-func NewProfileClient(c config) *ProfileClient {
-	return &ProfileClient{config: c}
+// NewBudgetAlertClient returns a client for the BudgetAlert from the given config.
+func NewBudgetAlertClient(c config) *BudgetAlertClient {
+	return &BudgetAlertClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
-func (c *ProfileClient) Use(hooks ...Hook) {
-	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+// A call to `Use(f, g, h)` equals to `budgetalert.Hooks(f(g(h())))`.
+func (c *BudgetAlertClient) Use(hooks ...Hook) {
+	c.hooks.BudgetAlert = append(c.hooks.BudgetAlert, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
-func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Profile = append(c.inters.Profile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `budgetalert.Intercept(f(g(h())))`.
+func (c *BudgetAlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BudgetAlert = append(c.inters.BudgetAlert, interceptors...)
 }
 
-// Create returns a builder for creating a Profile entity.
-func (c *ProfileClient) Create() *ProfileCreate {
-	mutation := newProfileMutation(c.config, OpCreate)
-	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BudgetAlert entity.
+func (c *BudgetAlertClient) Create() *BudgetAlertCreate {
+	mutation := newBudgetAlertMutation(c.config, OpCreate)
+	return &BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Profile entities.
-func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BudgetAlert entities.
+func (c *BudgetAlertClient) CreateBulk(builders ...*BudgetAlertCreate) *BudgetAlertCreateBulk {
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+func (c *BudgetAlertClient) MapCreateBulk(slice any, setFunc func(*BudgetAlertCreate, int)) *BudgetAlertCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BudgetAlertCreateBulk{err: fmt.Errorf("calling to BudgetAlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileCreate, rv.Len())
+	builders := make([]*BudgetAlertCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Profile.
-func (c *ProfileClient) Update() *ProfileUpdate {
-	mutation := newProfileMutation(c.config, OpUpdate)
-	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BudgetAlert.
+func (c *BudgetAlertClient) Update() *BudgetAlertUpdate {
+	mutation := newBudgetAlertMutation(c.config, OpUpdate)
+	return &BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetAlertClient) UpdateOne(_m *BudgetAlert) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlert(_m))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileClient) UpdateOneID(id uuid.UUID) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetAlertClient) UpdateOneID(id uuid.UUID) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlertID(id))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Profile.
-func (c *ProfileClient) Delete() *ProfileDelete {
-	mutation := newProfileMutation(c.config, OpDelete)
-	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BudgetAlert.
+func (c *BudgetAlertClient) Delete() *BudgetAlertDelete {
+	mutation := newBudgetAlertMutation(c.config, OpDelete)
+	return &BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
+func (c *BudgetAlertClient) DeleteOne(_m *BudgetAlert) *BudgetAlertDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileClient) DeleteOneID(id uuid.UUID) *ProfileDeleteOne {
-	builder := c.Delete().Where(profile.ID(id))
+func (c *BudgetAlertClient) DeleteOneID(id uuid.UUID) *BudgetAlertDeleteOne {
+	builder := c.Delete().Where(budgetalert.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileDeleteOne{builder}
+	return &BudgetAlertDeleteOne{builder}
 }
 
-// Query returns a query builder for Profile.
-func (c *ProfileClient) Query() *ProfileQuery {
-	return &ProfileQuery{
+// Query returns a query builder for BudgetAlert.
+func (c *BudgetAlertClient) Query() *BudgetAlertQuery {
+	return &BudgetAlertQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfile},
+		ctx:    &QueryContext{Type: TypeBudgetAlert},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Profile entity by its id.
-func (c *ProfileClient) Get(ctx context.Context, id uuid.UUID) (*Profile, error) {
-	return c.Query().Where(profile.ID(id)).Only(ctx)
+// Get returns a BudgetAlert entity by its id.
+func (c *BudgetAlertClient) Get(ctx context.Context, id uuid.UUID) (*BudgetAlert, error) {
+	return c.Query().Where(budgetalert.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileClient) GetX(ctx context.Context, id uuid.UUID) *Profile {
+func (c *BudgetAlertClient) GetX(ctx context.Context, id uuid.UUID) *BudgetAlert {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -517,47 +589,15 @@ func (c *ProfileClient) GetX(ctx context.Context, id uuid.UUID) *Profile {
 	return obj
 }
 
-// QueryReceipts queries the receipts edge of a Profile.
-func (c *ProfileClient) QueryReceipts(_m *Profile) *ReceiptQuery {
-	query := (&ReceiptClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(receipt.Table, receipt.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReceiptsTable, profile.ReceiptsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFiles queries the files edge of a Profile.
-func (c *ProfileClient) QueryFiles(_m *Profile) *ReceiptFileQuery {
-	query := (&ReceiptFileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.FilesTable, profile.FilesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryJobs queries the jobs edge of a Profile.
-func (c *ProfileClient) QueryJobs(_m *Profile) *ExtractJobQuery {
-	query := (&ExtractJobClient{config: c.config}).Query()
+// QueryBudget queries the budget edge of a BudgetAlert.
+func (c *BudgetAlertClient) QueryBudget(_m *BudgetAlert) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(extractjob.Table, extractjob.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.JobsTable, profile.JobsColumn),
+			sqlgraph.From(budgetalert.Table, budgetalert.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budgetalert.BudgetTable, budgetalert.BudgetColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -566,131 +606,131 @@ func (c *ProfileClient) QueryJobs(_m *Profile) *ExtractJobQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ProfileClient) Hooks() []Hook {
-	return c.hooks.Profile
+func (c *BudgetAlertClient) Hooks() []Hook {
+	return c.hooks.BudgetAlert
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileClient) Interceptors() []Interceptor {
-	return c.inters.Profile
+func (c *BudgetAlertClient) Interceptors() []Interceptor {
+	return c.inters.BudgetAlert
 }
 
-func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+func (c *BudgetAlertClient) mutate(ctx context.Context, m *BudgetAlertMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BudgetAlert mutation op: %q", m.Op())
 	}
 }
 
-// ReceiptClient is a client for the Receipt schema.
-type ReceiptClient struct {
+// CategoryClient is a client for the Category schema.
+type CategoryClient struct {
 	config
 }
 
-// NewReceiptClient returns a client for the Receipt from the given config.
-func NewReceiptClient(c config) *ReceiptClient {
-	return &ReceiptClient{config: c}
+// NewCategoryClient returns a client for the Category from the given config.
+func NewCategoryClient(c config) *CategoryClient {
+	return &CategoryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `receipt.Hooks(f(g(h())))`.
-func (c *ReceiptClient) Use(hooks ...Hook) {
-	c.hooks.Receipt = append(c.hooks.Receipt, hooks...)
+// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
+func (c *CategoryClient) Use(hooks ...Hook) {
+	c.hooks.Category = append(c.hooks.Category, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `receipt.Intercept(f(g(h())))`.
-func (c *ReceiptClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Receipt = append(c.inters.Receipt, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
+func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Category = append(c.inters.Category, interceptors...)
 }
 
-// Create returns a builder for creating a Receipt entity.
-func (c *ReceiptClient) Create() *ReceiptCreate {
-	mutation := newReceiptMutation(c.config, OpCreate)
-	return &ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Category entity.
+func (c *CategoryClient) Create() *CategoryCreate {
+	mutation := newCategoryMutation(c.config, OpCreate)
+	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Receipt entities.
-func (c *ReceiptClient) CreateBulk(builders ...*ReceiptCreate) *ReceiptCreateBulk {
-	return &ReceiptCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Category entities.
+func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
+	return &CategoryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReceiptClient) MapCreateBulk(slice any, setFunc func(*ReceiptCreate, int)) *ReceiptCreateBulk {
+func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReceiptCreateBulk{err: fmt.Errorf("calling to ReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReceiptCreate, rv.Len())
+	builders := make([]*CategoryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReceiptCreateBulk{config: c.config, builders: builders}
+	return &CategoryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Receipt.
-func (c *ReceiptClient) Update() *ReceiptUpdate {
-	mutation := newReceiptMutation(c.config, OpUpdate)
-	return &ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Category.
+func (c *CategoryClient) Update() *CategoryUpdate {
+	mutation := newCategoryMutation(c.config, OpUpdate)
+	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReceiptClient) UpdateOne(_m *Receipt) *ReceiptUpdateOne {
-	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceipt(_m))
-	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReceiptClient) UpdateOneID(id uuid.UUID) *ReceiptUpdateOne {
-	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceiptID(id))
-	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryClient) UpdateOneID(id uuid.UUID) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Receipt.
-func (c *ReceiptClient) Delete() *ReceiptDelete {
-	mutation := newReceiptMutation(c.config, OpDelete)
-	return &ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Category.
+func (c *CategoryClient) Delete() *CategoryDelete {
+	mutation := newCategoryMutation(c.config, OpDelete)
+	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReceiptClient) DeleteOne(_m *Receipt) *ReceiptDeleteOne {
+func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReceiptClient) DeleteOneID(id uuid.UUID) *ReceiptDeleteOne {
-	builder := c.Delete().Where(receipt.ID(id))
+func (c *CategoryClient) DeleteOneID(id uuid.UUID) *CategoryDeleteOne {
+	builder := c.Delete().Where(category.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReceiptDeleteOne{builder}
+	return &CategoryDeleteOne{builder}
 }
 
-// Query returns a query builder for Receipt.
-func (c *ReceiptClient) Query() *ReceiptQuery {
-	return &ReceiptQuery{
+// Query returns a query builder for Category.
+func (c *CategoryClient) Query() *CategoryQuery {
+	return &CategoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReceipt},
+		ctx:    &QueryContext{Type: TypeCategory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Receipt entity by its id.
-func (c *ReceiptClient) Get(ctx context.Context, id uuid.UUID) (*Receipt, error) {
-	return c.Query().Where(receipt.ID(id)).Only(ctx)
+// Get returns a Category entity by its id.
+func (c *CategoryClient) Get(ctx context.Context, id uuid.UUID) (*Category, error) {
+	return c.Query().Where(category.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReceiptClient) GetX(ctx context.Context, id uuid.UUID) *Receipt {
+func (c *CategoryClient) GetX(ctx context.Context, id uuid.UUID) *Category {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -698,15 +738,15 @@ func (c *ReceiptClient) GetX(ctx context.Context, id uuid.UUID) *Receipt {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a Receipt.
-func (c *ReceiptClient) QueryProfile(_m *Receipt) *ProfileQuery {
+// QueryProfile queries the profile edge of a Category.
+func (c *CategoryClient) QueryProfile(_m *Category) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.From(category.Table, category.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, receipt.ProfileTable, receipt.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, category.ProfileTable, category.ProfileColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -714,28 +754,1412 @@ func (c *ReceiptClient) QueryProfile(_m *Receipt) *ProfileQuery {
 	return query
 }
 
-// QueryFiles queries the files edge of a Receipt.
-func (c *ReceiptClient) QueryFiles(_m *Receipt) *ReceiptFileQuery {
-	query := (&ReceiptFileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(receipt.Table, receipt.FieldID, id),
-			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, receipt.FilesTable, receipt.FilesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *CategoryClient) Hooks() []Hook {
+	return c.hooks.Category
 }
 
-// QueryJobs queries the jobs edge of a Receipt.
-func (c *ReceiptClient) QueryJobs(_m *Receipt) *ExtractJobQuery {
-	query := (&ExtractJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
+// Interceptors returns the client interceptors.
+func (c *CategoryClient) Interceptors() []Interceptor {
+	return c.inters.Category
+}
+
+func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+	}
+}
+
+// CategoryCorrectionClient is a client for the CategoryCorrection schema.
+type CategoryCorrectionClient struct {
+	config
+}
+
+// NewCategoryCorrectionClient returns a client for the CategoryCorrection from the given config.
+func NewCategoryCorrectionClient(c config) *CategoryCorrectionClient {
+	return &CategoryCorrectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categorycorrection.Hooks(f(g(h())))`.
+func (c *CategoryCorrectionClient) Use(hooks ...Hook) {
+	c.hooks.CategoryCorrection = append(c.hooks.CategoryCorrection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categorycorrection.Intercept(f(g(h())))`.
+func (c *CategoryCorrectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryCorrection = append(c.inters.CategoryCorrection, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryCorrection entity.
+func (c *CategoryCorrectionClient) Create() *CategoryCorrectionCreate {
+	mutation := newCategoryCorrectionMutation(c.config, OpCreate)
+	return &CategoryCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryCorrection entities.
+func (c *CategoryCorrectionClient) CreateBulk(builders ...*CategoryCorrectionCreate) *CategoryCorrectionCreateBulk {
+	return &CategoryCorrectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryCorrectionClient) MapCreateBulk(slice any, setFunc func(*CategoryCorrectionCreate, int)) *CategoryCorrectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryCorrectionCreateBulk{err: fmt.Errorf("calling to CategoryCorrectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryCorrectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryCorrectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryCorrection.
+func (c *CategoryCorrectionClient) Update() *CategoryCorrectionUpdate {
+	mutation := newCategoryCorrectionMutation(c.config, OpUpdate)
+	return &CategoryCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryCorrectionClient) UpdateOne(_m *CategoryCorrection) *CategoryCorrectionUpdateOne {
+	mutation := newCategoryCorrectionMutation(c.config, OpUpdateOne, withCategoryCorrection(_m))
+	return &CategoryCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryCorrectionClient) UpdateOneID(id uuid.UUID) *CategoryCorrectionUpdateOne {
+	mutation := newCategoryCorrectionMutation(c.config, OpUpdateOne, withCategoryCorrectionID(id))
+	return &CategoryCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryCorrection.
+func (c *CategoryCorrectionClient) Delete() *CategoryCorrectionDelete {
+	mutation := newCategoryCorrectionMutation(c.config, OpDelete)
+	return &CategoryCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryCorrectionClient) DeleteOne(_m *CategoryCorrection) *CategoryCorrectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryCorrectionClient) DeleteOneID(id uuid.UUID) *CategoryCorrectionDeleteOne {
+	builder := c.Delete().Where(categorycorrection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryCorrectionDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryCorrection.
+func (c *CategoryCorrectionClient) Query() *CategoryCorrectionQuery {
+	return &CategoryCorrectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryCorrection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryCorrection entity by its id.
+func (c *CategoryCorrectionClient) Get(ctx context.Context, id uuid.UUID) (*CategoryCorrection, error) {
+	return c.Query().Where(categorycorrection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryCorrectionClient) GetX(ctx context.Context, id uuid.UUID) *CategoryCorrection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a CategoryCorrection.
+func (c *CategoryCorrectionClient) QueryProfile(_m *CategoryCorrection) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categorycorrection.Table, categorycorrection.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categorycorrection.ProfileTable, categorycorrection.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryCorrectionClient) Hooks() []Hook {
+	return c.hooks.CategoryCorrection
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryCorrectionClient) Interceptors() []Interceptor {
+	return c.inters.CategoryCorrection
+}
+
+func (c *CategoryCorrectionClient) mutate(ctx context.Context, m *CategoryCorrectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryCorrectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryCorrectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryCorrectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryCorrectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryCorrection mutation op: %q", m.Op())
+	}
+}
+
+// CategoryRuleClient is a client for the CategoryRule schema.
+type CategoryRuleClient struct {
+	config
+}
+
+// NewCategoryRuleClient returns a client for the CategoryRule from the given config.
+func NewCategoryRuleClient(c config) *CategoryRuleClient {
+	return &CategoryRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `categoryrule.Hooks(f(g(h())))`.
+func (c *CategoryRuleClient) Use(hooks ...Hook) {
+	c.hooks.CategoryRule = append(c.hooks.CategoryRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `categoryrule.Intercept(f(g(h())))`.
+func (c *CategoryRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CategoryRule = append(c.inters.CategoryRule, interceptors...)
+}
+
+// Create returns a builder for creating a CategoryRule entity.
+func (c *CategoryRuleClient) Create() *CategoryRuleCreate {
+	mutation := newCategoryRuleMutation(c.config, OpCreate)
+	return &CategoryRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CategoryRule entities.
+func (c *CategoryRuleClient) CreateBulk(builders ...*CategoryRuleCreate) *CategoryRuleCreateBulk {
+	return &CategoryRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CategoryRuleClient) MapCreateBulk(slice any, setFunc func(*CategoryRuleCreate, int)) *CategoryRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CategoryRuleCreateBulk{err: fmt.Errorf("calling to CategoryRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CategoryRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CategoryRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CategoryRule.
+func (c *CategoryRuleClient) Update() *CategoryRuleUpdate {
+	mutation := newCategoryRuleMutation(c.config, OpUpdate)
+	return &CategoryRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CategoryRuleClient) UpdateOne(_m *CategoryRule) *CategoryRuleUpdateOne {
+	mutation := newCategoryRuleMutation(c.config, OpUpdateOne, withCategoryRule(_m))
+	return &CategoryRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CategoryRuleClient) UpdateOneID(id uuid.UUID) *CategoryRuleUpdateOne {
+	mutation := newCategoryRuleMutation(c.config, OpUpdateOne, withCategoryRuleID(id))
+	return &CategoryRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CategoryRule.
+func (c *CategoryRuleClient) Delete() *CategoryRuleDelete {
+	mutation := newCategoryRuleMutation(c.config, OpDelete)
+	return &CategoryRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CategoryRuleClient) DeleteOne(_m *CategoryRule) *CategoryRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CategoryRuleClient) DeleteOneID(id uuid.UUID) *CategoryRuleDeleteOne {
+	builder := c.Delete().Where(categoryrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CategoryRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CategoryRule.
+func (c *CategoryRuleClient) Query() *CategoryRuleQuery {
+	return &CategoryRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCategoryRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CategoryRule entity by its id.
+func (c *CategoryRuleClient) Get(ctx context.Context, id uuid.UUID) (*CategoryRule, error) {
+	return c.Query().Where(categoryrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CategoryRuleClient) GetX(ctx context.Context, id uuid.UUID) *CategoryRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a CategoryRule.
+func (c *CategoryRuleClient) QueryProfile(_m *CategoryRule) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(categoryrule.Table, categoryrule.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, categoryrule.ProfileTable, categoryrule.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CategoryRuleClient) Hooks() []Hook {
+	return c.hooks.CategoryRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *CategoryRuleClient) Interceptors() []Interceptor {
+	return c.inters.CategoryRule
+}
+
+func (c *CategoryRuleClient) mutate(ctx context.Context, m *CategoryRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CategoryRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CategoryRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CategoryRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CategoryRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CategoryRule mutation op: %q", m.Op())
+	}
+}
+
+// ExportTemplateClient is a client for the ExportTemplate schema.
+type ExportTemplateClient struct {
+	config
+}
+
+// NewExportTemplateClient returns a client for the ExportTemplate from the given config.
+func NewExportTemplateClient(c config) *ExportTemplateClient {
+	return &ExportTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exporttemplate.Hooks(f(g(h())))`.
+func (c *ExportTemplateClient) Use(hooks ...Hook) {
+	c.hooks.ExportTemplate = append(c.hooks.ExportTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exporttemplate.Intercept(f(g(h())))`.
+func (c *ExportTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExportTemplate = append(c.inters.ExportTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a ExportTemplate entity.
+func (c *ExportTemplateClient) Create() *ExportTemplateCreate {
+	mutation := newExportTemplateMutation(c.config, OpCreate)
+	return &ExportTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExportTemplate entities.
+func (c *ExportTemplateClient) CreateBulk(builders ...*ExportTemplateCreate) *ExportTemplateCreateBulk {
+	return &ExportTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportTemplateClient) MapCreateBulk(slice any, setFunc func(*ExportTemplateCreate, int)) *ExportTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportTemplateCreateBulk{err: fmt.Errorf("calling to ExportTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExportTemplate.
+func (c *ExportTemplateClient) Update() *ExportTemplateUpdate {
+	mutation := newExportTemplateMutation(c.config, OpUpdate)
+	return &ExportTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportTemplateClient) UpdateOne(_m *ExportTemplate) *ExportTemplateUpdateOne {
+	mutation := newExportTemplateMutation(c.config, OpUpdateOne, withExportTemplate(_m))
+	return &ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportTemplateClient) UpdateOneID(id uuid.UUID) *ExportTemplateUpdateOne {
+	mutation := newExportTemplateMutation(c.config, OpUpdateOne, withExportTemplateID(id))
+	return &ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExportTemplate.
+func (c *ExportTemplateClient) Delete() *ExportTemplateDelete {
+	mutation := newExportTemplateMutation(c.config, OpDelete)
+	return &ExportTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportTemplateClient) DeleteOne(_m *ExportTemplate) *ExportTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportTemplateClient) DeleteOneID(id uuid.UUID) *ExportTemplateDeleteOne {
+	builder := c.Delete().Where(exporttemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExportTemplate.
+func (c *ExportTemplateClient) Query() *ExportTemplateQuery {
+	return &ExportTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExportTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExportTemplate entity by its id.
+func (c *ExportTemplateClient) Get(ctx context.Context, id uuid.UUID) (*ExportTemplate, error) {
+	return c.Query().Where(exporttemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportTemplateClient) GetX(ctx context.Context, id uuid.UUID) *ExportTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a ExportTemplate.
+func (c *ExportTemplateClient) QueryProfile(_m *ExportTemplate) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exporttemplate.Table, exporttemplate.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exporttemplate.ProfileTable, exporttemplate.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExportTemplateClient) Hooks() []Hook {
+	return c.hooks.ExportTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportTemplateClient) Interceptors() []Interceptor {
+	return c.inters.ExportTemplate
+}
+
+func (c *ExportTemplateClient) mutate(ctx context.Context, m *ExportTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExportTemplate mutation op: %q", m.Op())
+	}
+}
+
+// ExtractJobClient is a client for the ExtractJob schema.
+type ExtractJobClient struct {
+	config
+}
+
+// NewExtractJobClient returns a client for the ExtractJob from the given config.
+func NewExtractJobClient(c config) *ExtractJobClient {
+	return &ExtractJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `extractjob.Hooks(f(g(h())))`.
+func (c *ExtractJobClient) Use(hooks ...Hook) {
+	c.hooks.ExtractJob = append(c.hooks.ExtractJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `extractjob.Intercept(f(g(h())))`.
+func (c *ExtractJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExtractJob = append(c.inters.ExtractJob, interceptors...)
+}
+
+// Create returns a builder for creating a ExtractJob entity.
+func (c *ExtractJobClient) Create() *ExtractJobCreate {
+	mutation := newExtractJobMutation(c.config, OpCreate)
+	return &ExtractJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExtractJob entities.
+func (c *ExtractJobClient) CreateBulk(builders ...*ExtractJobCreate) *ExtractJobCreateBulk {
+	return &ExtractJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExtractJobClient) MapCreateBulk(slice any, setFunc func(*ExtractJobCreate, int)) *ExtractJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExtractJobCreateBulk{err: fmt.Errorf("calling to ExtractJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExtractJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExtractJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExtractJob.
+func (c *ExtractJobClient) Update() *ExtractJobUpdate {
+	mutation := newExtractJobMutation(c.config, OpUpdate)
+	return &ExtractJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExtractJobClient) UpdateOne(_m *ExtractJob) *ExtractJobUpdateOne {
+	mutation := newExtractJobMutation(c.config, OpUpdateOne, withExtractJob(_m))
+	return &ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExtractJobClient) UpdateOneID(id uuid.UUID) *ExtractJobUpdateOne {
+	mutation := newExtractJobMutation(c.config, OpUpdateOne, withExtractJobID(id))
+	return &ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExtractJob.
+func (c *ExtractJobClient) Delete() *ExtractJobDelete {
+	mutation := newExtractJobMutation(c.config, OpDelete)
+	return &ExtractJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExtractJobClient) DeleteOne(_m *ExtractJob) *ExtractJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExtractJobClient) DeleteOneID(id uuid.UUID) *ExtractJobDeleteOne {
+	builder := c.Delete().Where(extractjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExtractJobDeleteOne{builder}
+}
+
+// Query returns a query builder for ExtractJob.
+func (c *ExtractJobClient) Query() *ExtractJobQuery {
+	return &ExtractJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExtractJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExtractJob entity by its id.
+func (c *ExtractJobClient) Get(ctx context.Context, id uuid.UUID) (*ExtractJob, error) {
+	return c.Query().Where(extractjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExtractJobClient) GetX(ctx context.Context, id uuid.UUID) *ExtractJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a ExtractJob.
+func (c *ExtractJobClient) QueryFile(_m *ExtractJob) *ReceiptFileQuery {
+	query := (&ReceiptFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
+			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.FileTable, extractjob.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfile queries the profile edge of a ExtractJob.
+func (c *ExtractJobClient) QueryProfile(_m *ExtractJob) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.ProfileTable, extractjob.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceipt queries the receipt edge of a ExtractJob.
+func (c *ExtractJobClient) QueryReceipt(_m *ExtractJob) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(extractjob.Table, extractjob.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, extractjob.ReceiptTable, extractjob.ReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExtractJobClient) Hooks() []Hook {
+	return c.hooks.ExtractJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExtractJobClient) Interceptors() []Interceptor {
+	return c.inters.ExtractJob
+}
+
+func (c *ExtractJobClient) mutate(ctx context.Context, m *ExtractJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExtractJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExtractJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExtractJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExtractJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExtractJob mutation op: %q", m.Op())
+	}
+}
+
+// FxRateClient is a client for the FxRate schema.
+type FxRateClient struct {
+	config
+}
+
+// NewFxRateClient returns a client for the FxRate from the given config.
+func NewFxRateClient(c config) *FxRateClient {
+	return &FxRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fxrate.Hooks(f(g(h())))`.
+func (c *FxRateClient) Use(hooks ...Hook) {
+	c.hooks.FxRate = append(c.hooks.FxRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fxrate.Intercept(f(g(h())))`.
+func (c *FxRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FxRate = append(c.inters.FxRate, interceptors...)
+}
+
+// Create returns a builder for creating a FxRate entity.
+func (c *FxRateClient) Create() *FxRateCreate {
+	mutation := newFxRateMutation(c.config, OpCreate)
+	return &FxRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FxRate entities.
+func (c *FxRateClient) CreateBulk(builders ...*FxRateCreate) *FxRateCreateBulk {
+	return &FxRateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FxRateClient) MapCreateBulk(slice any, setFunc func(*FxRateCreate, int)) *FxRateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FxRateCreateBulk{err: fmt.Errorf("calling to FxRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FxRateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FxRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FxRate.
+func (c *FxRateClient) Update() *FxRateUpdate {
+	mutation := newFxRateMutation(c.config, OpUpdate)
+	return &FxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FxRateClient) UpdateOne(_m *FxRate) *FxRateUpdateOne {
+	mutation := newFxRateMutation(c.config, OpUpdateOne, withFxRate(_m))
+	return &FxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FxRateClient) UpdateOneID(id uuid.UUID) *FxRateUpdateOne {
+	mutation := newFxRateMutation(c.config, OpUpdateOne, withFxRateID(id))
+	return &FxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FxRate.
+func (c *FxRateClient) Delete() *FxRateDelete {
+	mutation := newFxRateMutation(c.config, OpDelete)
+	return &FxRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FxRateClient) DeleteOne(_m *FxRate) *FxRateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FxRateClient) DeleteOneID(id uuid.UUID) *FxRateDeleteOne {
+	builder := c.Delete().Where(fxrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FxRateDeleteOne{builder}
+}
+
+// Query returns a query builder for FxRate.
+func (c *FxRateClient) Query() *FxRateQuery {
+	return &FxRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFxRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FxRate entity by its id.
+func (c *FxRateClient) Get(ctx context.Context, id uuid.UUID) (*FxRate, error) {
+	return c.Query().Where(fxrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FxRateClient) GetX(ctx context.Context, id uuid.UUID) *FxRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FxRateClient) Hooks() []Hook {
+	return c.hooks.FxRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *FxRateClient) Interceptors() []Interceptor {
+	return c.inters.FxRate
+}
+
+func (c *FxRateClient) mutate(ctx context.Context, m *FxRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FxRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FxRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FxRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FxRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FxRate mutation op: %q", m.Op())
+	}
+}
+
+// MerchantClient is a client for the Merchant schema.
+type MerchantClient struct {
+	config
+}
+
+// NewMerchantClient returns a client for the Merchant from the given config.
+func NewMerchantClient(c config) *MerchantClient {
+	return &MerchantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `merchant.Hooks(f(g(h())))`.
+func (c *MerchantClient) Use(hooks ...Hook) {
+	c.hooks.Merchant = append(c.hooks.Merchant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `merchant.Intercept(f(g(h())))`.
+func (c *MerchantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Merchant = append(c.inters.Merchant, interceptors...)
+}
+
+// Create returns a builder for creating a Merchant entity.
+func (c *MerchantClient) Create() *MerchantCreate {
+	mutation := newMerchantMutation(c.config, OpCreate)
+	return &MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Merchant entities.
+func (c *MerchantClient) CreateBulk(builders ...*MerchantCreate) *MerchantCreateBulk {
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MerchantClient) MapCreateBulk(slice any, setFunc func(*MerchantCreate, int)) *MerchantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MerchantCreateBulk{err: fmt.Errorf("calling to MerchantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MerchantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MerchantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Merchant.
+func (c *MerchantClient) Update() *MerchantUpdate {
+	mutation := newMerchantMutation(c.config, OpUpdate)
+	return &MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MerchantClient) UpdateOne(_m *Merchant) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchant(_m))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MerchantClient) UpdateOneID(id uuid.UUID) *MerchantUpdateOne {
+	mutation := newMerchantMutation(c.config, OpUpdateOne, withMerchantID(id))
+	return &MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Merchant.
+func (c *MerchantClient) Delete() *MerchantDelete {
+	mutation := newMerchantMutation(c.config, OpDelete)
+	return &MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MerchantClient) DeleteOne(_m *Merchant) *MerchantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MerchantClient) DeleteOneID(id uuid.UUID) *MerchantDeleteOne {
+	builder := c.Delete().Where(merchant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MerchantDeleteOne{builder}
+}
+
+// Query returns a query builder for Merchant.
+func (c *MerchantClient) Query() *MerchantQuery {
+	return &MerchantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMerchant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Merchant entity by its id.
+func (c *MerchantClient) Get(ctx context.Context, id uuid.UUID) (*Merchant, error) {
+	return c.Query().Where(merchant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MerchantClient) GetX(ctx context.Context, id uuid.UUID) *Merchant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a Merchant.
+func (c *MerchantClient) QueryProfile(_m *Merchant) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, merchant.ProfileTable, merchant.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceipts queries the receipts edge of a Merchant.
+func (c *MerchantClient) QueryReceipts(_m *Merchant) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(merchant.Table, merchant.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, merchant.ReceiptsTable, merchant.ReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MerchantClient) Hooks() []Hook {
+	return c.hooks.Merchant
+}
+
+// Interceptors returns the client interceptors.
+func (c *MerchantClient) Interceptors() []Interceptor {
+	return c.inters.Merchant
+}
+
+func (c *MerchantClient) mutate(ctx context.Context, m *MerchantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MerchantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MerchantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MerchantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MerchantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Merchant mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(_m *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(_m))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileClient) UpdateOneID(id uuid.UUID) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(_m *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id uuid.UUID) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id uuid.UUID) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id uuid.UUID) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReceipts queries the receipts edge of a Profile.
+func (c *ProfileClient) QueryReceipts(_m *Profile) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ReceiptsTable, profile.ReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Profile.
+func (c *ProfileClient) QueryFiles(_m *Profile) *ReceiptFileQuery {
+	query := (&ReceiptFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.FilesTable, profile.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Profile.
+func (c *ProfileClient) QueryJobs(_m *Profile) *ExtractJobQuery {
+	query := (&ExtractJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(extractjob.Table, extractjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.JobsTable, profile.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategories queries the categories edge of a Profile.
+func (c *ProfileClient) QueryCategories(_m *Profile) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.CategoriesTable, profile.CategoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRules queries the rules edge of a Profile.
+func (c *ProfileClient) QueryRules(_m *Profile) *CategoryRuleQuery {
+	query := (&CategoryRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(categoryrule.Table, categoryrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.RulesTable, profile.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCorrections queries the corrections edge of a Profile.
+func (c *ProfileClient) QueryCorrections(_m *Profile) *CategoryCorrectionQuery {
+	query := (&CategoryCorrectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(categorycorrection.Table, categorycorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.CorrectionsTable, profile.CorrectionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMerchants queries the merchants edge of a Profile.
+func (c *ProfileClient) QueryMerchants(_m *Profile) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.MerchantsTable, profile.MerchantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExportTemplates queries the export_templates edge of a Profile.
+func (c *ProfileClient) QueryExportTemplates(_m *Profile) *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ExportTemplatesTable, profile.ExportTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatementTransactions queries the statement_transactions edge of a Profile.
+func (c *ProfileClient) QueryStatementTransactions(_m *Profile) *StatementTransactionQuery {
+	query := (&StatementTransactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(statementtransaction.Table, statementtransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.StatementTransactionsTable, profile.StatementTransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a Profile.
+func (c *ProfileClient) QueryBudgets(_m *Profile) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.BudgetsTable, profile.BudgetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// ReceiptClient is a client for the Receipt schema.
+type ReceiptClient struct {
+	config
+}
+
+// NewReceiptClient returns a client for the Receipt from the given config.
+func NewReceiptClient(c config) *ReceiptClient {
+	return &ReceiptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receipt.Hooks(f(g(h())))`.
+func (c *ReceiptClient) Use(hooks ...Hook) {
+	c.hooks.Receipt = append(c.hooks.Receipt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receipt.Intercept(f(g(h())))`.
+func (c *ReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Receipt = append(c.inters.Receipt, interceptors...)
+}
+
+// Create returns a builder for creating a Receipt entity.
+func (c *ReceiptClient) Create() *ReceiptCreate {
+	mutation := newReceiptMutation(c.config, OpCreate)
+	return &ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Receipt entities.
+func (c *ReceiptClient) CreateBulk(builders ...*ReceiptCreate) *ReceiptCreateBulk {
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiptClient) MapCreateBulk(slice any, setFunc func(*ReceiptCreate, int)) *ReceiptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiptCreateBulk{err: fmt.Errorf("calling to ReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Receipt.
+func (c *ReceiptClient) Update() *ReceiptUpdate {
+	mutation := newReceiptMutation(c.config, OpUpdate)
+	return &ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiptClient) UpdateOne(_m *Receipt) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceipt(_m))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiptClient) UpdateOneID(id uuid.UUID) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceiptID(id))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Receipt.
+func (c *ReceiptClient) Delete() *ReceiptDelete {
+	mutation := newReceiptMutation(c.config, OpDelete)
+	return &ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiptClient) DeleteOne(_m *Receipt) *ReceiptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiptClient) DeleteOneID(id uuid.UUID) *ReceiptDeleteOne {
+	builder := c.Delete().Where(receipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for Receipt.
+func (c *ReceiptClient) Query() *ReceiptQuery {
+	return &ReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Receipt entity by its id.
+func (c *ReceiptClient) Get(ctx context.Context, id uuid.UUID) (*Receipt, error) {
+	return c.Query().Where(receipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiptClient) GetX(ctx context.Context, id uuid.UUID) *Receipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a Receipt.
+func (c *ReceiptClient) QueryProfile(_m *Receipt) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receipt.ProfileTable, receipt.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMerchant queries the merchant edge of a Receipt.
+func (c *ReceiptClient) QueryMerchant(_m *Receipt) *MerchantQuery {
+	query := (&MerchantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receipt.MerchantTable, receipt.MerchantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Receipt.
+func (c *ReceiptClient) QueryFiles(_m *Receipt) *ReceiptFileQuery {
+	query := (&ReceiptFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(receiptfile.Table, receiptfile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receipt.FilesTable, receipt.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Receipt.
+func (c *ReceiptClient) QueryJobs(_m *Receipt) *ExtractJobQuery {
+	query := (&ExtractJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
 			sqlgraph.From(receipt.Table, receipt.FieldID, id),
 			sqlgraph.To(extractjob.Table, extractjob.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, receipt.JobsTable, receipt.JobsColumn),
@@ -746,6 +2170,22 @@ func (c *ReceiptClient) QueryJobs(_m *Receipt) *ExtractJobQuery {
 	return query
 }
 
+// QueryAnomalies queries the anomalies edge of a Receipt.
+func (c *ReceiptClient) QueryAnomalies(_m *Receipt) *ReceiptAnomalyQuery {
+	query := (&ReceiptAnomalyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(receiptanomaly.Table, receiptanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receipt.AnomaliesTable, receipt.AnomaliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ReceiptClient) Hooks() []Hook {
 	return c.hooks.Receipt
@@ -771,6 +2211,155 @@ func (c *ReceiptClient) mutate(ctx context.Context, m *ReceiptMutation) (Value,
 	}
 }
 
+// ReceiptAnomalyClient is a client for the ReceiptAnomaly schema.
+type ReceiptAnomalyClient struct {
+	config
+}
+
+// NewReceiptAnomalyClient returns a client for the ReceiptAnomaly from the given config.
+func NewReceiptAnomalyClient(c config) *ReceiptAnomalyClient {
+	return &ReceiptAnomalyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receiptanomaly.Hooks(f(g(h())))`.
+func (c *ReceiptAnomalyClient) Use(hooks ...Hook) {
+	c.hooks.ReceiptAnomaly = append(c.hooks.ReceiptAnomaly, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receiptanomaly.Intercept(f(g(h())))`.
+func (c *ReceiptAnomalyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiptAnomaly = append(c.inters.ReceiptAnomaly, interceptors...)
+}
+
+// Create returns a builder for creating a ReceiptAnomaly entity.
+func (c *ReceiptAnomalyClient) Create() *ReceiptAnomalyCreate {
+	mutation := newReceiptAnomalyMutation(c.config, OpCreate)
+	return &ReceiptAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReceiptAnomaly entities.
+func (c *ReceiptAnomalyClient) CreateBulk(builders ...*ReceiptAnomalyCreate) *ReceiptAnomalyCreateBulk {
+	return &ReceiptAnomalyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiptAnomalyClient) MapCreateBulk(slice any, setFunc func(*ReceiptAnomalyCreate, int)) *ReceiptAnomalyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiptAnomalyCreateBulk{err: fmt.Errorf("calling to ReceiptAnomalyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiptAnomalyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiptAnomalyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReceiptAnomaly.
+func (c *ReceiptAnomalyClient) Update() *ReceiptAnomalyUpdate {
+	mutation := newReceiptAnomalyMutation(c.config, OpUpdate)
+	return &ReceiptAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiptAnomalyClient) UpdateOne(_m *ReceiptAnomaly) *ReceiptAnomalyUpdateOne {
+	mutation := newReceiptAnomalyMutation(c.config, OpUpdateOne, withReceiptAnomaly(_m))
+	return &ReceiptAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiptAnomalyClient) UpdateOneID(id uuid.UUID) *ReceiptAnomalyUpdateOne {
+	mutation := newReceiptAnomalyMutation(c.config, OpUpdateOne, withReceiptAnomalyID(id))
+	return &ReceiptAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReceiptAnomaly.
+func (c *ReceiptAnomalyClient) Delete() *ReceiptAnomalyDelete {
+	mutation := newReceiptAnomalyMutation(c.config, OpDelete)
+	return &ReceiptAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiptAnomalyClient) DeleteOne(_m *ReceiptAnomaly) *ReceiptAnomalyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiptAnomalyClient) DeleteOneID(id uuid.UUID) *ReceiptAnomalyDeleteOne {
+	builder := c.Delete().Where(receiptanomaly.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiptAnomalyDeleteOne{builder}
+}
+
+// Query returns a query builder for ReceiptAnomaly.
+func (c *ReceiptAnomalyClient) Query() *ReceiptAnomalyQuery {
+	return &ReceiptAnomalyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceiptAnomaly},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReceiptAnomaly entity by its id.
+func (c *ReceiptAnomalyClient) Get(ctx context.Context, id uuid.UUID) (*ReceiptAnomaly, error) {
+	return c.Query().Where(receiptanomaly.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiptAnomalyClient) GetX(ctx context.Context, id uuid.UUID) *ReceiptAnomaly {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryReceipt queries the receipt edge of a ReceiptAnomaly.
+func (c *ReceiptAnomalyClient) QueryReceipt(_m *ReceiptAnomaly) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiptanomaly.Table, receiptanomaly.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiptanomaly.ReceiptTable, receiptanomaly.ReceiptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReceiptAnomalyClient) Hooks() []Hook {
+	return c.hooks.ReceiptAnomaly
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReceiptAnomalyClient) Interceptors() []Interceptor {
+	return c.inters.ReceiptAnomaly
+}
+
+func (c *ReceiptAnomalyClient) mutate(ctx context.Context, m *ReceiptAnomalyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReceiptAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReceiptAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReceiptAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReceiptAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReceiptAnomaly mutation op: %q", m.Op())
+	}
+}
+
 // ReceiptFileClient is a client for the ReceiptFile schema.
 type ReceiptFileClient struct {
 	config
@@ -936,12 +2525,189 @@ func (c *ReceiptFileClient) mutate(ctx context.Context, m *ReceiptFileMutation)
 	}
 }
 
+// StatementTransactionClient is a client for the StatementTransaction schema.
+type StatementTransactionClient struct {
+	config
+}
+
+// NewStatementTransactionClient returns a client for the StatementTransaction from the given config.
+func NewStatementTransactionClient(c config) *StatementTransactionClient {
+	return &StatementTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `statementtransaction.Hooks(f(g(h())))`.
+func (c *StatementTransactionClient) Use(hooks ...Hook) {
+	c.hooks.StatementTransaction = append(c.hooks.StatementTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `statementtransaction.Intercept(f(g(h())))`.
+func (c *StatementTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StatementTransaction = append(c.inters.StatementTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a StatementTransaction entity.
+func (c *StatementTransactionClient) Create() *StatementTransactionCreate {
+	mutation := newStatementTransactionMutation(c.config, OpCreate)
+	return &StatementTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StatementTransaction entities.
+func (c *StatementTransactionClient) CreateBulk(builders ...*StatementTransactionCreate) *StatementTransactionCreateBulk {
+	return &StatementTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StatementTransactionClient) MapCreateBulk(slice any, setFunc func(*StatementTransactionCreate, int)) *StatementTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StatementTransactionCreateBulk{err: fmt.Errorf("calling to StatementTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StatementTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StatementTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StatementTransaction.
+func (c *StatementTransactionClient) Update() *StatementTransactionUpdate {
+	mutation := newStatementTransactionMutation(c.config, OpUpdate)
+	return &StatementTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StatementTransactionClient) UpdateOne(_m *StatementTransaction) *StatementTransactionUpdateOne {
+	mutation := newStatementTransactionMutation(c.config, OpUpdateOne, withStatementTransaction(_m))
+	return &StatementTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StatementTransactionClient) UpdateOneID(id uuid.UUID) *StatementTransactionUpdateOne {
+	mutation := newStatementTransactionMutation(c.config, OpUpdateOne, withStatementTransactionID(id))
+	return &StatementTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StatementTransaction.
+func (c *StatementTransactionClient) Delete() *StatementTransactionDelete {
+	mutation := newStatementTransactionMutation(c.config, OpDelete)
+	return &StatementTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StatementTransactionClient) DeleteOne(_m *StatementTransaction) *StatementTransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StatementTransactionClient) DeleteOneID(id uuid.UUID) *StatementTransactionDeleteOne {
+	builder := c.Delete().Where(statementtransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StatementTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for StatementTransaction.
+func (c *StatementTransactionClient) Query() *StatementTransactionQuery {
+	return &StatementTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStatementTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StatementTransaction entity by its id.
+func (c *StatementTransactionClient) Get(ctx context.Context, id uuid.UUID) (*StatementTransaction, error) {
+	return c.Query().Where(statementtransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StatementTransactionClient) GetX(ctx context.Context, id uuid.UUID) *StatementTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a StatementTransaction.
+func (c *StatementTransactionClient) QueryProfile(_m *StatementTransaction) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statementtransaction.Table, statementtransaction.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, statementtransaction.ProfileTable, statementtransaction.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StatementTransactionClient) Hooks() []Hook {
+	return c.hooks.StatementTransaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *StatementTransactionClient) Interceptors() []Interceptor {
+	return c.inters.StatementTransaction
+}
+
+func (c *StatementTransactionClient) mutate(ctx context.Context, m *StatementTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StatementTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StatementTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StatementTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StatementTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StatementTransaction mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ExtractJob, Profile, Receipt, ReceiptFile []ent.Hook
+		Budget, BudgetAlert, Category, CategoryCorrection, CategoryRule, ExportTemplate,
+		ExtractJob, FxRate, Merchant, Profile, Receipt, ReceiptAnomaly, ReceiptFile,
+		StatementTransaction []ent.Hook
 	}
 	inters struct {
-		ExtractJob, Profile, Receipt, ReceiptFile []ent.Interceptor
+		Budget, BudgetAlert, Category, CategoryCorrection, CategoryRule, ExportTemplate,
+		ExtractJob, FxRate, Merchant, Profile, Receipt, ReceiptAnomaly, ReceiptFile,
+		StatementTransaction []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
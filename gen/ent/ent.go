@@ -12,10 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			extractjob.Table:  extractjob.ValidColumn,
-			profile.Table:     profile.ValidColumn,
-			receipt.Table:     receipt.ValidColumn,
-			receiptfile.Table: receiptfile.ValidColumn,
+			budget.Table:               budget.ValidColumn,
+			budgetalert.Table:          budgetalert.ValidColumn,
+			category.Table:             category.ValidColumn,
+			categorycorrection.Table:   categorycorrection.ValidColumn,
+			categoryrule.Table:         categoryrule.ValidColumn,
+			exporttemplate.Table:       exporttemplate.ValidColumn,
+			extractjob.Table:           extractjob.ValidColumn,
+			fxrate.Table:               fxrate.ValidColumn,
+			merchant.Table:             merchant.ValidColumn,
+			profile.Table:              profile.ValidColumn,
+			receipt.Table:              receipt.ValidColumn,
+			receiptanomaly.Table:       receiptanomaly.ValidColumn,
+			receiptfile.Table:          receiptfile.ValidColumn,
+			statementtransaction.Table: statementtransaction.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,773 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// ExportTemplateCreate is the builder for creating a ExportTemplate entity.
+type ExportTemplateCreate struct {
+	config
+	mutation *ExportTemplateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *ExportTemplateCreate) SetProfileID(v uuid.UUID) *ExportTemplateCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ExportTemplateCreate) SetName(v string) *ExportTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetColumns sets the "columns" field.
+func (_c *ExportTemplateCreate) SetColumns(v []entity.ExportColumn) *ExportTemplateCreate {
+	_c.mutation.SetColumns(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExportTemplateCreate) SetCreatedAt(v time.Time) *ExportTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableCreatedAt(v *time.Time) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExportTemplateCreate) SetUpdatedAt(v time.Time) *ExportTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableUpdatedAt(v *time.Time) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExportTemplateCreate) SetID(v uuid.UUID) *ExportTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExportTemplateCreate) SetNillableID(v *uuid.UUID) *ExportTemplateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *ExportTemplateCreate) SetProfile(v *Profile) *ExportTemplateCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the ExportTemplateMutation object of the builder.
+func (_c *ExportTemplateCreate) Mutation() *ExportTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the ExportTemplate in the database.
+func (_c *ExportTemplateCreate) Save(ctx context.Context) (*ExportTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExportTemplateCreate) SaveX(ctx context.Context) *ExportTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExportTemplateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exporttemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := exporttemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := exporttemplate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExportTemplateCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "ExportTemplate.profile_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ExportTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := exporttemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ExportTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Columns(); !ok {
+		return &ValidationError{Name: "columns", err: errors.New(`ent: missing required field "ExportTemplate.columns"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExportTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExportTemplate.updated_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "ExportTemplate.profile"`)}
+	}
+	return nil
+}
+
+func (_c *ExportTemplateCreate) sqlSave(ctx context.Context) (*ExportTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExportTemplateCreate) createSpec() (*ExportTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exporttemplate.Table, sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(exporttemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Columns(); ok {
+		_spec.SetField(exporttemplate.FieldColumns, field.TypeJSON, value)
+		_node.Columns = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(exporttemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exporttemplate.ProfileTable,
+			Columns: []string{exporttemplate.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExportTemplate.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExportTemplateUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExportTemplateCreate) OnConflict(opts ...sql.ConflictOption) *ExportTemplateUpsertOne {
+	_c.conflict = opts
+	return &ExportTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExportTemplateCreate) OnConflictColumns(columns ...string) *ExportTemplateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExportTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExportTemplateUpsertOne is the builder for "upsert"-ing
+	//  one ExportTemplate node.
+	ExportTemplateUpsertOne struct {
+		create *ExportTemplateCreate
+	}
+
+	// ExportTemplateUpsert is the "OnConflict" setter.
+	ExportTemplateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *ExportTemplateUpsert) SetProfileID(v uuid.UUID) *ExportTemplateUpsert {
+	u.Set(exporttemplate.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExportTemplateUpsert) UpdateProfileID() *ExportTemplateUpsert {
+	u.SetExcluded(exporttemplate.FieldProfileID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ExportTemplateUpsert) SetName(v string) *ExportTemplateUpsert {
+	u.Set(exporttemplate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ExportTemplateUpsert) UpdateName() *ExportTemplateUpsert {
+	u.SetExcluded(exporttemplate.FieldName)
+	return u
+}
+
+// SetColumns sets the "columns" field.
+func (u *ExportTemplateUpsert) SetColumns(v []entity.ExportColumn) *ExportTemplateUpsert {
+	u.Set(exporttemplate.FieldColumns, v)
+	return u
+}
+
+// UpdateColumns sets the "columns" field to the value that was provided on create.
+func (u *ExportTemplateUpsert) UpdateColumns() *ExportTemplateUpsert {
+	u.SetExcluded(exporttemplate.FieldColumns)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ExportTemplateUpsert) SetCreatedAt(v time.Time) *ExportTemplateUpsert {
+	u.Set(exporttemplate.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsert) UpdateCreatedAt() *ExportTemplateUpsert {
+	u.SetExcluded(exporttemplate.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExportTemplateUpsert) SetUpdatedAt(v time.Time) *ExportTemplateUpsert {
+	u.Set(exporttemplate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsert) UpdateUpdatedAt() *ExportTemplateUpsert {
+	u.SetExcluded(exporttemplate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exporttemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExportTemplateUpsertOne) UpdateNewValues() *ExportTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exporttemplate.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExportTemplateUpsertOne) Ignore() *ExportTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExportTemplateUpsertOne) DoNothing() *ExportTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExportTemplateCreate.OnConflict
+// documentation for more info.
+func (u *ExportTemplateUpsertOne) Update(set func(*ExportTemplateUpsert)) *ExportTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExportTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ExportTemplateUpsertOne) SetProfileID(v uuid.UUID) *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExportTemplateUpsertOne) UpdateProfileID() *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ExportTemplateUpsertOne) SetName(v string) *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ExportTemplateUpsertOne) UpdateName() *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetColumns sets the "columns" field.
+func (u *ExportTemplateUpsertOne) SetColumns(v []entity.ExportColumn) *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetColumns(v)
+	})
+}
+
+// UpdateColumns sets the "columns" field to the value that was provided on create.
+func (u *ExportTemplateUpsertOne) UpdateColumns() *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateColumns()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ExportTemplateUpsertOne) SetCreatedAt(v time.Time) *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsertOne) UpdateCreatedAt() *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExportTemplateUpsertOne) SetUpdatedAt(v time.Time) *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsertOne) UpdateUpdatedAt() *ExportTemplateUpsertOne {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExportTemplateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExportTemplateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExportTemplateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExportTemplateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExportTemplateUpsertOne.ID is not supported by MySQL driver. Use ExportTemplateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExportTemplateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExportTemplateCreateBulk is the builder for creating many ExportTemplate entities in bulk.
+type ExportTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*ExportTemplateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExportTemplate entities in the database.
+func (_c *ExportTemplateCreateBulk) Save(ctx context.Context) ([]*ExportTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExportTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExportTemplateCreateBulk) SaveX(ctx context.Context) []*ExportTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExportTemplate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExportTemplateUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExportTemplateCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExportTemplateUpsertBulk {
+	_c.conflict = opts
+	return &ExportTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExportTemplateCreateBulk) OnConflictColumns(columns ...string) *ExportTemplateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExportTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExportTemplateUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExportTemplate nodes.
+type ExportTemplateUpsertBulk struct {
+	create *ExportTemplateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exporttemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExportTemplateUpsertBulk) UpdateNewValues() *ExportTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exporttemplate.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExportTemplate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExportTemplateUpsertBulk) Ignore() *ExportTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExportTemplateUpsertBulk) DoNothing() *ExportTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExportTemplateCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExportTemplateUpsertBulk) Update(set func(*ExportTemplateUpsert)) *ExportTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExportTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ExportTemplateUpsertBulk) SetProfileID(v uuid.UUID) *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExportTemplateUpsertBulk) UpdateProfileID() *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ExportTemplateUpsertBulk) SetName(v string) *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ExportTemplateUpsertBulk) UpdateName() *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetColumns sets the "columns" field.
+func (u *ExportTemplateUpsertBulk) SetColumns(v []entity.ExportColumn) *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetColumns(v)
+	})
+}
+
+// UpdateColumns sets the "columns" field to the value that was provided on create.
+func (u *ExportTemplateUpsertBulk) UpdateColumns() *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateColumns()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ExportTemplateUpsertBulk) SetCreatedAt(v time.Time) *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsertBulk) UpdateCreatedAt() *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExportTemplateUpsertBulk) SetUpdatedAt(v time.Time) *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExportTemplateUpsertBulk) UpdateUpdatedAt() *ExportTemplateUpsertBulk {
+	return u.Update(func(s *ExportTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExportTemplateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExportTemplateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExportTemplateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExportTemplateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
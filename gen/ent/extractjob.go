@@ -50,6 +50,18 @@ type ExtractJob struct {
 	ModelName *string `json:"model_name,omitempty"`
 	// ModelParams holds the value of the "model_params" field.
 	ModelParams json.RawMessage `json:"model_params,omitempty"`
+	// PromptTokens holds the value of the "prompt_tokens" field.
+	PromptTokens *int `json:"prompt_tokens,omitempty"`
+	// CompletionTokens holds the value of the "completion_tokens" field.
+	CompletionTokens *int `json:"completion_tokens,omitempty"`
+	// ImageTokens holds the value of the "image_tokens" field.
+	ImageTokens *int `json:"image_tokens,omitempty"`
+	// CostUsd holds the value of the "cost_usd" field.
+	CostUsd *float64 `json:"cost_usd,omitempty"`
+	// LlmCacheHit holds the value of the "llm_cache_hit" field.
+	LlmCacheHit bool `json:"llm_cache_hit,omitempty"`
+	// ParseAttempts holds the value of the "parse_attempts" field.
+	ParseAttempts json.RawMessage `json:"parse_attempts,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ExtractJobQuery when eager-loading is set.
 	Edges        ExtractJobEdges `json:"edges"`
@@ -109,12 +121,14 @@ func (*ExtractJob) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case extractjob.FieldReceiptID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case extractjob.FieldExtractedJSON, extractjob.FieldModelParams:
+		case extractjob.FieldExtractedJSON, extractjob.FieldModelParams, extractjob.FieldParseAttempts:
 			values[i] = new([]byte)
-		case extractjob.FieldNeedsReview:
+		case extractjob.FieldNeedsReview, extractjob.FieldLlmCacheHit:
 			values[i] = new(sql.NullBool)
-		case extractjob.FieldExtractionConfidence:
+		case extractjob.FieldExtractionConfidence, extractjob.FieldCostUsd:
 			values[i] = new(sql.NullFloat64)
+		case extractjob.FieldPromptTokens, extractjob.FieldCompletionTokens, extractjob.FieldImageTokens:
+			values[i] = new(sql.NullInt64)
 		case extractjob.FieldFormat, extractjob.FieldStatus, extractjob.FieldErrorMessage, extractjob.FieldOcrText, extractjob.FieldModelName:
 			values[i] = new(sql.NullString)
 		case extractjob.FieldStartedAt, extractjob.FieldFinishedAt:
@@ -237,6 +251,48 @@ func (_m *ExtractJob) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field model_params: %w", err)
 				}
 			}
+		case extractjob.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = new(int)
+				*_m.PromptTokens = int(value.Int64)
+			}
+		case extractjob.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = new(int)
+				*_m.CompletionTokens = int(value.Int64)
+			}
+		case extractjob.FieldImageTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_tokens", values[i])
+			} else if value.Valid {
+				_m.ImageTokens = new(int)
+				*_m.ImageTokens = int(value.Int64)
+			}
+		case extractjob.FieldCostUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_usd", values[i])
+			} else if value.Valid {
+				_m.CostUsd = new(float64)
+				*_m.CostUsd = value.Float64
+			}
+		case extractjob.FieldLlmCacheHit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_cache_hit", values[i])
+			} else if value.Valid {
+				_m.LlmCacheHit = value.Bool
+			}
+		case extractjob.FieldParseAttempts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parse_attempts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ParseAttempts); err != nil {
+					return fmt.Errorf("unmarshal field parse_attempts: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -343,6 +399,32 @@ func (_m *ExtractJob) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("model_params=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ModelParams))
+	builder.WriteString(", ")
+	if v := _m.PromptTokens; v != nil {
+		builder.WriteString("prompt_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletionTokens; v != nil {
+		builder.WriteString("completion_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ImageTokens; v != nil {
+		builder.WriteString("image_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CostUsd; v != nil {
+		builder.WriteString("cost_usd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("llm_cache_hit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LlmCacheHit))
+	builder.WriteString(", ")
+	builder.WriteString("parse_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParseAttempts))
 	builder.WriteByte(')')
 	return builder.String()
 }
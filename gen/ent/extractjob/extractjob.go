@@ -43,6 +43,18 @@ const (
 	FieldModelName = "model_name"
 	// FieldModelParams holds the string denoting the model_params field in the database.
 	FieldModelParams = "model_params"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldImageTokens holds the string denoting the image_tokens field in the database.
+	FieldImageTokens = "image_tokens"
+	// FieldCostUsd holds the string denoting the cost_usd field in the database.
+	FieldCostUsd = "cost_usd"
+	// FieldLlmCacheHit holds the string denoting the llm_cache_hit field in the database.
+	FieldLlmCacheHit = "llm_cache_hit"
+	// FieldParseAttempts holds the string denoting the parse_attempts field in the database.
+	FieldParseAttempts = "parse_attempts"
 	// EdgeFile holds the string denoting the file edge name in mutations.
 	EdgeFile = "file"
 	// EdgeProfile holds the string denoting the profile edge name in mutations.
@@ -91,6 +103,12 @@ var Columns = []string{
 	FieldExtractedJSON,
 	FieldModelName,
 	FieldModelParams,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldImageTokens,
+	FieldCostUsd,
+	FieldLlmCacheHit,
+	FieldParseAttempts,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -110,6 +128,8 @@ var (
 	DefaultStartedAt func() time.Time
 	// DefaultNeedsReview holds the default value on creation for the "needs_review" field.
 	DefaultNeedsReview bool
+	// DefaultLlmCacheHit holds the default value on creation for the "llm_cache_hit" field.
+	DefaultLlmCacheHit bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -182,6 +202,31 @@ func ByModelName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelName, opts...).ToFunc()
 }
 
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByImageTokens orders the results by the image_tokens field.
+func ByImageTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageTokens, opts...).ToFunc()
+}
+
+// ByCostUsd orders the results by the cost_usd field.
+func ByCostUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostUsd, opts...).ToFunc()
+}
+
+// ByLlmCacheHit orders the results by the llm_cache_hit field.
+func ByLlmCacheHit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmCacheHit, opts...).ToFunc()
+}
+
 // ByFileField orders the results by file field.
 func ByFileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
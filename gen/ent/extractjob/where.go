@@ -116,6 +116,31 @@ func ModelName(v string) predicate.ExtractJob {
 	return predicate.ExtractJob(sql.FieldEQ(FieldModelName, v))
 }
 
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// ImageTokens applies equality check predicate on the "image_tokens" field. It's identical to ImageTokensEQ.
+func ImageTokens(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldImageTokens, v))
+}
+
+// CostUsd applies equality check predicate on the "cost_usd" field. It's identical to CostUsdEQ.
+func CostUsd(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// LlmCacheHit applies equality check predicate on the "llm_cache_hit" field. It's identical to LlmCacheHitEQ.
+func LlmCacheHit(v bool) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldLlmCacheHit, v))
+}
+
 // FileIDEQ applies the EQ predicate on the "file_id" field.
 func FileIDEQ(v uuid.UUID) predicate.ExtractJob {
 	return predicate.ExtractJob(sql.FieldEQ(FieldFileID, v))
@@ -721,6 +746,226 @@ func ModelParamsNotNil() predicate.ExtractJob {
 	return predicate.ExtractJob(sql.FieldNotNull(FieldModelParams))
 }
 
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// PromptTokensIsNil applies the IsNil predicate on the "prompt_tokens" field.
+func PromptTokensIsNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIsNull(FieldPromptTokens))
+}
+
+// PromptTokensNotNil applies the NotNil predicate on the "prompt_tokens" field.
+func PromptTokensNotNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotNull(FieldPromptTokens))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIsNil applies the IsNil predicate on the "completion_tokens" field.
+func CompletionTokensIsNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIsNull(FieldCompletionTokens))
+}
+
+// CompletionTokensNotNil applies the NotNil predicate on the "completion_tokens" field.
+func CompletionTokensNotNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotNull(FieldCompletionTokens))
+}
+
+// ImageTokensEQ applies the EQ predicate on the "image_tokens" field.
+func ImageTokensEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldImageTokens, v))
+}
+
+// ImageTokensNEQ applies the NEQ predicate on the "image_tokens" field.
+func ImageTokensNEQ(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNEQ(FieldImageTokens, v))
+}
+
+// ImageTokensIn applies the In predicate on the "image_tokens" field.
+func ImageTokensIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIn(FieldImageTokens, vs...))
+}
+
+// ImageTokensNotIn applies the NotIn predicate on the "image_tokens" field.
+func ImageTokensNotIn(vs ...int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotIn(FieldImageTokens, vs...))
+}
+
+// ImageTokensGT applies the GT predicate on the "image_tokens" field.
+func ImageTokensGT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGT(FieldImageTokens, v))
+}
+
+// ImageTokensGTE applies the GTE predicate on the "image_tokens" field.
+func ImageTokensGTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGTE(FieldImageTokens, v))
+}
+
+// ImageTokensLT applies the LT predicate on the "image_tokens" field.
+func ImageTokensLT(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLT(FieldImageTokens, v))
+}
+
+// ImageTokensLTE applies the LTE predicate on the "image_tokens" field.
+func ImageTokensLTE(v int) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLTE(FieldImageTokens, v))
+}
+
+// ImageTokensIsNil applies the IsNil predicate on the "image_tokens" field.
+func ImageTokensIsNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIsNull(FieldImageTokens))
+}
+
+// ImageTokensNotNil applies the NotNil predicate on the "image_tokens" field.
+func ImageTokensNotNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotNull(FieldImageTokens))
+}
+
+// CostUsdEQ applies the EQ predicate on the "cost_usd" field.
+func CostUsdEQ(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// CostUsdNEQ applies the NEQ predicate on the "cost_usd" field.
+func CostUsdNEQ(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNEQ(FieldCostUsd, v))
+}
+
+// CostUsdIn applies the In predicate on the "cost_usd" field.
+func CostUsdIn(vs ...float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIn(FieldCostUsd, vs...))
+}
+
+// CostUsdNotIn applies the NotIn predicate on the "cost_usd" field.
+func CostUsdNotIn(vs ...float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotIn(FieldCostUsd, vs...))
+}
+
+// CostUsdGT applies the GT predicate on the "cost_usd" field.
+func CostUsdGT(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGT(FieldCostUsd, v))
+}
+
+// CostUsdGTE applies the GTE predicate on the "cost_usd" field.
+func CostUsdGTE(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldGTE(FieldCostUsd, v))
+}
+
+// CostUsdLT applies the LT predicate on the "cost_usd" field.
+func CostUsdLT(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLT(FieldCostUsd, v))
+}
+
+// CostUsdLTE applies the LTE predicate on the "cost_usd" field.
+func CostUsdLTE(v float64) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldLTE(FieldCostUsd, v))
+}
+
+// CostUsdIsNil applies the IsNil predicate on the "cost_usd" field.
+func CostUsdIsNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIsNull(FieldCostUsd))
+}
+
+// CostUsdNotNil applies the NotNil predicate on the "cost_usd" field.
+func CostUsdNotNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotNull(FieldCostUsd))
+}
+
+// LlmCacheHitEQ applies the EQ predicate on the "llm_cache_hit" field.
+func LlmCacheHitEQ(v bool) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldEQ(FieldLlmCacheHit, v))
+}
+
+// LlmCacheHitNEQ applies the NEQ predicate on the "llm_cache_hit" field.
+func LlmCacheHitNEQ(v bool) predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNEQ(FieldLlmCacheHit, v))
+}
+
+// ParseAttemptsIsNil applies the IsNil predicate on the "parse_attempts" field.
+func ParseAttemptsIsNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldIsNull(FieldParseAttempts))
+}
+
+// ParseAttemptsNotNil applies the NotNil predicate on the "parse_attempts" field.
+func ParseAttemptsNotNil() predicate.ExtractJob {
+	return predicate.ExtractJob(sql.FieldNotNull(FieldParseAttempts))
+}
+
 // HasFile applies the HasEdge predicate on the "file" edge.
 func HasFile() predicate.ExtractJob {
 	return predicate.ExtractJob(func(s *sql.Selector) {
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -23,6 +25,7 @@ type ExtractJobCreate struct {
 	config
 	mutation *ExtractJobMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetFileID sets the "file_id" field.
@@ -181,6 +184,82 @@ func (_c *ExtractJobCreate) SetModelParams(v json.RawMessage) *ExtractJobCreate
 	return _c
 }
 
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *ExtractJobCreate) SetPromptTokens(v int) *ExtractJobCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *ExtractJobCreate) SetNillablePromptTokens(v *int) *ExtractJobCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *ExtractJobCreate) SetCompletionTokens(v int) *ExtractJobCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *ExtractJobCreate) SetNillableCompletionTokens(v *int) *ExtractJobCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (_c *ExtractJobCreate) SetImageTokens(v int) *ExtractJobCreate {
+	_c.mutation.SetImageTokens(v)
+	return _c
+}
+
+// SetNillableImageTokens sets the "image_tokens" field if the given value is not nil.
+func (_c *ExtractJobCreate) SetNillableImageTokens(v *int) *ExtractJobCreate {
+	if v != nil {
+		_c.SetImageTokens(*v)
+	}
+	return _c
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_c *ExtractJobCreate) SetCostUsd(v float64) *ExtractJobCreate {
+	_c.mutation.SetCostUsd(v)
+	return _c
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_c *ExtractJobCreate) SetNillableCostUsd(v *float64) *ExtractJobCreate {
+	if v != nil {
+		_c.SetCostUsd(*v)
+	}
+	return _c
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (_c *ExtractJobCreate) SetLlmCacheHit(v bool) *ExtractJobCreate {
+	_c.mutation.SetLlmCacheHit(v)
+	return _c
+}
+
+// SetNillableLlmCacheHit sets the "llm_cache_hit" field if the given value is not nil.
+func (_c *ExtractJobCreate) SetNillableLlmCacheHit(v *bool) *ExtractJobCreate {
+	if v != nil {
+		_c.SetLlmCacheHit(*v)
+	}
+	return _c
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (_c *ExtractJobCreate) SetParseAttempts(v json.RawMessage) *ExtractJobCreate {
+	_c.mutation.SetParseAttempts(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ExtractJobCreate) SetID(v uuid.UUID) *ExtractJobCreate {
 	_c.mutation.SetID(v)
@@ -253,6 +332,10 @@ func (_c *ExtractJobCreate) defaults() {
 		v := extractjob.DefaultNeedsReview
 		_c.mutation.SetNeedsReview(v)
 	}
+	if _, ok := _c.mutation.LlmCacheHit(); !ok {
+		v := extractjob.DefaultLlmCacheHit
+		_c.mutation.SetLlmCacheHit(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := extractjob.DefaultID()
 		_c.mutation.SetID(v)
@@ -281,6 +364,9 @@ func (_c *ExtractJobCreate) check() error {
 	if _, ok := _c.mutation.NeedsReview(); !ok {
 		return &ValidationError{Name: "needs_review", err: errors.New(`ent: missing required field "ExtractJob.needs_review"`)}
 	}
+	if _, ok := _c.mutation.LlmCacheHit(); !ok {
+		return &ValidationError{Name: "llm_cache_hit", err: errors.New(`ent: missing required field "ExtractJob.llm_cache_hit"`)}
+	}
 	if len(_c.mutation.FileIDs()) == 0 {
 		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "ExtractJob.file"`)}
 	}
@@ -318,6 +404,7 @@ func (_c *ExtractJobCreate) createSpec() (*ExtractJob, *sqlgraph.CreateSpec) {
 		_node = &ExtractJob{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(extractjob.Table, sqlgraph.NewFieldSpec(extractjob.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -366,6 +453,30 @@ func (_c *ExtractJobCreate) createSpec() (*ExtractJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(extractjob.FieldModelParams, field.TypeJSON, value)
 		_node.ModelParams = value
 	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(extractjob.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = &value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(extractjob.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = &value
+	}
+	if value, ok := _c.mutation.ImageTokens(); ok {
+		_spec.SetField(extractjob.FieldImageTokens, field.TypeInt, value)
+		_node.ImageTokens = &value
+	}
+	if value, ok := _c.mutation.CostUsd(); ok {
+		_spec.SetField(extractjob.FieldCostUsd, field.TypeFloat64, value)
+		_node.CostUsd = &value
+	}
+	if value, ok := _c.mutation.LlmCacheHit(); ok {
+		_spec.SetField(extractjob.FieldLlmCacheHit, field.TypeBool, value)
+		_node.LlmCacheHit = value
+	}
+	if value, ok := _c.mutation.ParseAttempts(); ok {
+		_spec.SetField(extractjob.FieldParseAttempts, field.TypeJSON, value)
+		_node.ParseAttempts = value
+	}
 	if nodes := _c.mutation.FileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -420,86 +531,1517 @@ func (_c *ExtractJobCreate) createSpec() (*ExtractJob, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
-// ExtractJobCreateBulk is the builder for creating many ExtractJob entities in bulk.
-type ExtractJobCreateBulk struct {
-	config
-	err      error
-	builders []*ExtractJobCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtractJob.Create().
+//		SetFileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtractJobUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtractJobCreate) OnConflict(opts ...sql.ConflictOption) *ExtractJobUpsertOne {
+	_c.conflict = opts
+	return &ExtractJobUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the ExtractJob entities in the database.
-func (_c *ExtractJobCreateBulk) Save(ctx context.Context) ([]*ExtractJob, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtractJobCreate) OnConflictColumns(columns ...string) *ExtractJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtractJobUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*ExtractJob, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ExtractJobMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// ExtractJobUpsertOne is the builder for "upsert"-ing
+	//  one ExtractJob node.
+	ExtractJobUpsertOne struct {
+		create *ExtractJobCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// ExtractJobUpsert is the "OnConflict" setter.
+	ExtractJobUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetFileID sets the "file_id" field.
+func (u *ExtractJobUpsert) SetFileID(v uuid.UUID) *ExtractJobUpsert {
+	u.Set(extractjob.FieldFileID, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *ExtractJobCreateBulk) SaveX(ctx context.Context) []*ExtractJob {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateFileID() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldFileID)
+	return u
 }
 
-// Exec executes the query.
-func (_c *ExtractJobCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// SetProfileID sets the "profile_id" field.
+func (u *ExtractJobUpsert) SetProfileID(v uuid.UUID) *ExtractJobUpsert {
+	u.Set(extractjob.FieldProfileID, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *ExtractJobCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateProfileID() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldProfileID)
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ExtractJobUpsert) SetReceiptID(v uuid.UUID) *ExtractJobUpsert {
+	u.Set(extractjob.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateReceiptID() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldReceiptID)
+	return u
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *ExtractJobUpsert) ClearReceiptID() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldReceiptID)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *ExtractJobUpsert) SetFormat(v string) *ExtractJobUpsert {
+	u.Set(extractjob.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateFormat() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldFormat)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ExtractJobUpsert) SetStartedAt(v time.Time) *ExtractJobUpsert {
+	u.Set(extractjob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateStartedAt() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ExtractJobUpsert) SetFinishedAt(v time.Time) *ExtractJobUpsert {
+	u.Set(extractjob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateFinishedAt() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ExtractJobUpsert) ClearFinishedAt() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldFinishedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtractJobUpsert) SetStatus(v string) *ExtractJobUpsert {
+	u.Set(extractjob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateStatus() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *ExtractJobUpsert) ClearStatus() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldStatus)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ExtractJobUpsert) SetErrorMessage(v string) *ExtractJobUpsert {
+	u.Set(extractjob.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateErrorMessage() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ExtractJobUpsert) ClearErrorMessage() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldErrorMessage)
+	return u
+}
+
+// SetExtractionConfidence sets the "extraction_confidence" field.
+func (u *ExtractJobUpsert) SetExtractionConfidence(v float32) *ExtractJobUpsert {
+	u.Set(extractjob.FieldExtractionConfidence, v)
+	return u
+}
+
+// UpdateExtractionConfidence sets the "extraction_confidence" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateExtractionConfidence() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldExtractionConfidence)
+	return u
+}
+
+// AddExtractionConfidence adds v to the "extraction_confidence" field.
+func (u *ExtractJobUpsert) AddExtractionConfidence(v float32) *ExtractJobUpsert {
+	u.Add(extractjob.FieldExtractionConfidence, v)
+	return u
+}
+
+// ClearExtractionConfidence clears the value of the "extraction_confidence" field.
+func (u *ExtractJobUpsert) ClearExtractionConfidence() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldExtractionConfidence)
+	return u
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (u *ExtractJobUpsert) SetNeedsReview(v bool) *ExtractJobUpsert {
+	u.Set(extractjob.FieldNeedsReview, v)
+	return u
+}
+
+// UpdateNeedsReview sets the "needs_review" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateNeedsReview() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldNeedsReview)
+	return u
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ExtractJobUpsert) SetOcrText(v string) *ExtractJobUpsert {
+	u.Set(extractjob.FieldOcrText, v)
+	return u
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateOcrText() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldOcrText)
+	return u
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ExtractJobUpsert) ClearOcrText() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldOcrText)
+	return u
+}
+
+// SetExtractedJSON sets the "extracted_json" field.
+func (u *ExtractJobUpsert) SetExtractedJSON(v json.RawMessage) *ExtractJobUpsert {
+	u.Set(extractjob.FieldExtractedJSON, v)
+	return u
+}
+
+// UpdateExtractedJSON sets the "extracted_json" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateExtractedJSON() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldExtractedJSON)
+	return u
+}
+
+// ClearExtractedJSON clears the value of the "extracted_json" field.
+func (u *ExtractJobUpsert) ClearExtractedJSON() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldExtractedJSON)
+	return u
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ExtractJobUpsert) SetModelName(v string) *ExtractJobUpsert {
+	u.Set(extractjob.FieldModelName, v)
+	return u
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateModelName() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldModelName)
+	return u
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *ExtractJobUpsert) ClearModelName() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldModelName)
+	return u
+}
+
+// SetModelParams sets the "model_params" field.
+func (u *ExtractJobUpsert) SetModelParams(v json.RawMessage) *ExtractJobUpsert {
+	u.Set(extractjob.FieldModelParams, v)
+	return u
+}
+
+// UpdateModelParams sets the "model_params" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateModelParams() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldModelParams)
+	return u
+}
+
+// ClearModelParams clears the value of the "model_params" field.
+func (u *ExtractJobUpsert) ClearModelParams() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldModelParams)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *ExtractJobUpsert) SetPromptTokens(v int) *ExtractJobUpsert {
+	u.Set(extractjob.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdatePromptTokens() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *ExtractJobUpsert) AddPromptTokens(v int) *ExtractJobUpsert {
+	u.Add(extractjob.FieldPromptTokens, v)
+	return u
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (u *ExtractJobUpsert) ClearPromptTokens() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldPromptTokens)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *ExtractJobUpsert) SetCompletionTokens(v int) *ExtractJobUpsert {
+	u.Set(extractjob.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateCompletionTokens() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *ExtractJobUpsert) AddCompletionTokens(v int) *ExtractJobUpsert {
+	u.Add(extractjob.FieldCompletionTokens, v)
+	return u
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (u *ExtractJobUpsert) ClearCompletionTokens() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldCompletionTokens)
+	return u
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (u *ExtractJobUpsert) SetImageTokens(v int) *ExtractJobUpsert {
+	u.Set(extractjob.FieldImageTokens, v)
+	return u
+}
+
+// UpdateImageTokens sets the "image_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateImageTokens() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldImageTokens)
+	return u
+}
+
+// AddImageTokens adds v to the "image_tokens" field.
+func (u *ExtractJobUpsert) AddImageTokens(v int) *ExtractJobUpsert {
+	u.Add(extractjob.FieldImageTokens, v)
+	return u
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (u *ExtractJobUpsert) ClearImageTokens() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldImageTokens)
+	return u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (u *ExtractJobUpsert) SetCostUsd(v float64) *ExtractJobUpsert {
+	u.Set(extractjob.FieldCostUsd, v)
+	return u
+}
+
+// UpdateCostUsd sets the "cost_usd" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateCostUsd() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldCostUsd)
+	return u
+}
+
+// AddCostUsd adds v to the "cost_usd" field.
+func (u *ExtractJobUpsert) AddCostUsd(v float64) *ExtractJobUpsert {
+	u.Add(extractjob.FieldCostUsd, v)
+	return u
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (u *ExtractJobUpsert) ClearCostUsd() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldCostUsd)
+	return u
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (u *ExtractJobUpsert) SetLlmCacheHit(v bool) *ExtractJobUpsert {
+	u.Set(extractjob.FieldLlmCacheHit, v)
+	return u
+}
+
+// UpdateLlmCacheHit sets the "llm_cache_hit" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateLlmCacheHit() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldLlmCacheHit)
+	return u
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (u *ExtractJobUpsert) SetParseAttempts(v json.RawMessage) *ExtractJobUpsert {
+	u.Set(extractjob.FieldParseAttempts, v)
+	return u
+}
+
+// UpdateParseAttempts sets the "parse_attempts" field to the value that was provided on create.
+func (u *ExtractJobUpsert) UpdateParseAttempts() *ExtractJobUpsert {
+	u.SetExcluded(extractjob.FieldParseAttempts)
+	return u
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (u *ExtractJobUpsert) ClearParseAttempts() *ExtractJobUpsert {
+	u.SetNull(extractjob.FieldParseAttempts)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(extractjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExtractJobUpsertOne) UpdateNewValues() *ExtractJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(extractjob.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExtractJobUpsertOne) Ignore() *ExtractJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtractJobUpsertOne) DoNothing() *ExtractJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtractJobCreate.OnConflict
+// documentation for more info.
+func (u *ExtractJobUpsertOne) Update(set func(*ExtractJobUpsert)) *ExtractJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtractJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ExtractJobUpsertOne) SetFileID(v uuid.UUID) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateFileID() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ExtractJobUpsertOne) SetProfileID(v uuid.UUID) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateProfileID() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ExtractJobUpsertOne) SetReceiptID(v uuid.UUID) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateReceiptID() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *ExtractJobUpsertOne) ClearReceiptID() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ExtractJobUpsertOne) SetFormat(v string) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateFormat() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ExtractJobUpsertOne) SetStartedAt(v time.Time) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateStartedAt() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ExtractJobUpsertOne) SetFinishedAt(v time.Time) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateFinishedAt() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ExtractJobUpsertOne) ClearFinishedAt() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtractJobUpsertOne) SetStatus(v string) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateStatus() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *ExtractJobUpsertOne) ClearStatus() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ExtractJobUpsertOne) SetErrorMessage(v string) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateErrorMessage() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ExtractJobUpsertOne) ClearErrorMessage() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetExtractionConfidence sets the "extraction_confidence" field.
+func (u *ExtractJobUpsertOne) SetExtractionConfidence(v float32) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetExtractionConfidence(v)
+	})
+}
+
+// AddExtractionConfidence adds v to the "extraction_confidence" field.
+func (u *ExtractJobUpsertOne) AddExtractionConfidence(v float32) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddExtractionConfidence(v)
+	})
+}
+
+// UpdateExtractionConfidence sets the "extraction_confidence" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateExtractionConfidence() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateExtractionConfidence()
+	})
+}
+
+// ClearExtractionConfidence clears the value of the "extraction_confidence" field.
+func (u *ExtractJobUpsertOne) ClearExtractionConfidence() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearExtractionConfidence()
+	})
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (u *ExtractJobUpsertOne) SetNeedsReview(v bool) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetNeedsReview(v)
+	})
+}
+
+// UpdateNeedsReview sets the "needs_review" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateNeedsReview() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateNeedsReview()
+	})
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ExtractJobUpsertOne) SetOcrText(v string) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetOcrText(v)
+	})
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateOcrText() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateOcrText()
+	})
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ExtractJobUpsertOne) ClearOcrText() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearOcrText()
+	})
+}
+
+// SetExtractedJSON sets the "extracted_json" field.
+func (u *ExtractJobUpsertOne) SetExtractedJSON(v json.RawMessage) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetExtractedJSON(v)
+	})
+}
+
+// UpdateExtractedJSON sets the "extracted_json" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateExtractedJSON() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateExtractedJSON()
+	})
+}
+
+// ClearExtractedJSON clears the value of the "extracted_json" field.
+func (u *ExtractJobUpsertOne) ClearExtractedJSON() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearExtractedJSON()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ExtractJobUpsertOne) SetModelName(v string) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateModelName() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *ExtractJobUpsertOne) ClearModelName() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetModelParams sets the "model_params" field.
+func (u *ExtractJobUpsertOne) SetModelParams(v json.RawMessage) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetModelParams(v)
+	})
+}
+
+// UpdateModelParams sets the "model_params" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateModelParams() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateModelParams()
+	})
+}
+
+// ClearModelParams clears the value of the "model_params" field.
+func (u *ExtractJobUpsertOne) ClearModelParams() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearModelParams()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *ExtractJobUpsertOne) SetPromptTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *ExtractJobUpsertOne) AddPromptTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdatePromptTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (u *ExtractJobUpsertOne) ClearPromptTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearPromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *ExtractJobUpsertOne) SetCompletionTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *ExtractJobUpsertOne) AddCompletionTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateCompletionTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (u *ExtractJobUpsertOne) ClearCompletionTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearCompletionTokens()
+	})
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (u *ExtractJobUpsertOne) SetImageTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetImageTokens(v)
+	})
+}
+
+// AddImageTokens adds v to the "image_tokens" field.
+func (u *ExtractJobUpsertOne) AddImageTokens(v int) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddImageTokens(v)
+	})
+}
+
+// UpdateImageTokens sets the "image_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateImageTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateImageTokens()
+	})
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (u *ExtractJobUpsertOne) ClearImageTokens() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearImageTokens()
+	})
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (u *ExtractJobUpsertOne) SetCostUsd(v float64) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetCostUsd(v)
+	})
+}
+
+// AddCostUsd adds v to the "cost_usd" field.
+func (u *ExtractJobUpsertOne) AddCostUsd(v float64) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddCostUsd(v)
+	})
+}
+
+// UpdateCostUsd sets the "cost_usd" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateCostUsd() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateCostUsd()
+	})
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (u *ExtractJobUpsertOne) ClearCostUsd() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearCostUsd()
+	})
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (u *ExtractJobUpsertOne) SetLlmCacheHit(v bool) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetLlmCacheHit(v)
+	})
+}
+
+// UpdateLlmCacheHit sets the "llm_cache_hit" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateLlmCacheHit() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateLlmCacheHit()
+	})
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (u *ExtractJobUpsertOne) SetParseAttempts(v json.RawMessage) *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetParseAttempts(v)
+	})
+}
+
+// UpdateParseAttempts sets the "parse_attempts" field to the value that was provided on create.
+func (u *ExtractJobUpsertOne) UpdateParseAttempts() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateParseAttempts()
+	})
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (u *ExtractJobUpsertOne) ClearParseAttempts() *ExtractJobUpsertOne {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearParseAttempts()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtractJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtractJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtractJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExtractJobUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExtractJobUpsertOne.ID is not supported by MySQL driver. Use ExtractJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExtractJobUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExtractJobCreateBulk is the builder for creating many ExtractJob entities in bulk.
+type ExtractJobCreateBulk struct {
+	config
+	err      error
+	builders []*ExtractJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExtractJob entities in the database.
+func (_c *ExtractJobCreateBulk) Save(ctx context.Context) ([]*ExtractJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExtractJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExtractJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExtractJobCreateBulk) SaveX(ctx context.Context) []*ExtractJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExtractJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExtractJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExtractJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExtractJobUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExtractJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExtractJobUpsertBulk {
+	_c.conflict = opts
+	return &ExtractJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExtractJobCreateBulk) OnConflictColumns(columns ...string) *ExtractJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExtractJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExtractJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExtractJob nodes.
+type ExtractJobUpsertBulk struct {
+	create *ExtractJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(extractjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExtractJobUpsertBulk) UpdateNewValues() *ExtractJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(extractjob.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExtractJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExtractJobUpsertBulk) Ignore() *ExtractJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExtractJobUpsertBulk) DoNothing() *ExtractJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExtractJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExtractJobUpsertBulk) Update(set func(*ExtractJobUpsert)) *ExtractJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExtractJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ExtractJobUpsertBulk) SetFileID(v uuid.UUID) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateFileID() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ExtractJobUpsertBulk) SetProfileID(v uuid.UUID) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateProfileID() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ExtractJobUpsertBulk) SetReceiptID(v uuid.UUID) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateReceiptID() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (u *ExtractJobUpsertBulk) ClearReceiptID() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearReceiptID()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ExtractJobUpsertBulk) SetFormat(v string) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateFormat() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ExtractJobUpsertBulk) SetStartedAt(v time.Time) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateStartedAt() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ExtractJobUpsertBulk) SetFinishedAt(v time.Time) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateFinishedAt() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ExtractJobUpsertBulk) ClearFinishedAt() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ExtractJobUpsertBulk) SetStatus(v string) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateStatus() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *ExtractJobUpsertBulk) ClearStatus() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *ExtractJobUpsertBulk) SetErrorMessage(v string) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateErrorMessage() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *ExtractJobUpsertBulk) ClearErrorMessage() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetExtractionConfidence sets the "extraction_confidence" field.
+func (u *ExtractJobUpsertBulk) SetExtractionConfidence(v float32) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetExtractionConfidence(v)
+	})
+}
+
+// AddExtractionConfidence adds v to the "extraction_confidence" field.
+func (u *ExtractJobUpsertBulk) AddExtractionConfidence(v float32) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddExtractionConfidence(v)
+	})
+}
+
+// UpdateExtractionConfidence sets the "extraction_confidence" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateExtractionConfidence() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateExtractionConfidence()
+	})
+}
+
+// ClearExtractionConfidence clears the value of the "extraction_confidence" field.
+func (u *ExtractJobUpsertBulk) ClearExtractionConfidence() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearExtractionConfidence()
+	})
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (u *ExtractJobUpsertBulk) SetNeedsReview(v bool) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetNeedsReview(v)
+	})
+}
+
+// UpdateNeedsReview sets the "needs_review" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateNeedsReview() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateNeedsReview()
+	})
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (u *ExtractJobUpsertBulk) SetOcrText(v string) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetOcrText(v)
+	})
+}
+
+// UpdateOcrText sets the "ocr_text" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateOcrText() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateOcrText()
+	})
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (u *ExtractJobUpsertBulk) ClearOcrText() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearOcrText()
+	})
+}
+
+// SetExtractedJSON sets the "extracted_json" field.
+func (u *ExtractJobUpsertBulk) SetExtractedJSON(v json.RawMessage) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetExtractedJSON(v)
+	})
+}
+
+// UpdateExtractedJSON sets the "extracted_json" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateExtractedJSON() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateExtractedJSON()
+	})
+}
+
+// ClearExtractedJSON clears the value of the "extracted_json" field.
+func (u *ExtractJobUpsertBulk) ClearExtractedJSON() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearExtractedJSON()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ExtractJobUpsertBulk) SetModelName(v string) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateModelName() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *ExtractJobUpsertBulk) ClearModelName() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetModelParams sets the "model_params" field.
+func (u *ExtractJobUpsertBulk) SetModelParams(v json.RawMessage) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetModelParams(v)
+	})
+}
+
+// UpdateModelParams sets the "model_params" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateModelParams() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateModelParams()
+	})
+}
+
+// ClearModelParams clears the value of the "model_params" field.
+func (u *ExtractJobUpsertBulk) ClearModelParams() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearModelParams()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *ExtractJobUpsertBulk) SetPromptTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *ExtractJobUpsertBulk) AddPromptTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdatePromptTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (u *ExtractJobUpsertBulk) ClearPromptTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearPromptTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *ExtractJobUpsertBulk) SetCompletionTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *ExtractJobUpsertBulk) AddCompletionTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateCompletionTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (u *ExtractJobUpsertBulk) ClearCompletionTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearCompletionTokens()
+	})
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (u *ExtractJobUpsertBulk) SetImageTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetImageTokens(v)
+	})
+}
+
+// AddImageTokens adds v to the "image_tokens" field.
+func (u *ExtractJobUpsertBulk) AddImageTokens(v int) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddImageTokens(v)
+	})
+}
+
+// UpdateImageTokens sets the "image_tokens" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateImageTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateImageTokens()
+	})
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (u *ExtractJobUpsertBulk) ClearImageTokens() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearImageTokens()
+	})
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (u *ExtractJobUpsertBulk) SetCostUsd(v float64) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetCostUsd(v)
+	})
+}
+
+// AddCostUsd adds v to the "cost_usd" field.
+func (u *ExtractJobUpsertBulk) AddCostUsd(v float64) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.AddCostUsd(v)
+	})
+}
+
+// UpdateCostUsd sets the "cost_usd" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateCostUsd() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateCostUsd()
+	})
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (u *ExtractJobUpsertBulk) ClearCostUsd() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearCostUsd()
+	})
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (u *ExtractJobUpsertBulk) SetLlmCacheHit(v bool) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetLlmCacheHit(v)
+	})
+}
+
+// UpdateLlmCacheHit sets the "llm_cache_hit" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateLlmCacheHit() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateLlmCacheHit()
+	})
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (u *ExtractJobUpsertBulk) SetParseAttempts(v json.RawMessage) *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.SetParseAttempts(v)
+	})
+}
+
+// UpdateParseAttempts sets the "parse_attempts" field to the value that was provided on create.
+func (u *ExtractJobUpsertBulk) UpdateParseAttempts() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.UpdateParseAttempts()
+	})
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (u *ExtractJobUpsertBulk) ClearParseAttempts() *ExtractJobUpsertBulk {
+	return u.Update(func(s *ExtractJobUpsert) {
+		s.ClearParseAttempts()
+	})
+}
+
+// Exec executes the query.
+func (u *ExtractJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExtractJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExtractJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExtractJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
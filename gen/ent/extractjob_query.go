@@ -29,6 +29,7 @@ type ExtractJobQuery struct {
 	withFile    *ReceiptFileQuery
 	withProfile *ProfileQuery
 	withReceipt *ReceiptQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -327,8 +328,9 @@ func (_q *ExtractJobQuery) Clone() *ExtractJobQuery {
 		withProfile: _q.withProfile.Clone(),
 		withReceipt: _q.withReceipt.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -458,6 +460,9 @@ func (_q *ExtractJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -581,6 +586,9 @@ func (_q *ExtractJobQuery) loadReceipt(ctx context.Context, query *ReceiptQuery,
 
 func (_q *ExtractJobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -652,6 +660,9 @@ func (_q *ExtractJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -669,6 +680,12 @@ func (_q *ExtractJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ExtractJobQuery) Modify(modifiers ...func(s *sql.Selector)) *ExtractJobSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ExtractJobGroupBy is the group-by builder for ExtractJob entities.
 type ExtractJobGroupBy struct {
 	selector
@@ -758,3 +775,9 @@ func (_s *ExtractJobSelect) sqlScan(ctx context.Context, root *ExtractJobQuery,
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ExtractJobSelect) Modify(modifiers ...func(s *sql.Selector)) *ExtractJobSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -24,8 +24,9 @@ import (
 // ExtractJobUpdate is the builder for updating ExtractJob entities.
 type ExtractJobUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ExtractJobMutation
+	hooks     []Hook
+	mutation  *ExtractJobMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ExtractJobUpdate builder.
@@ -287,6 +288,146 @@ func (_u *ExtractJobUpdate) ClearModelParams() *ExtractJobUpdate {
 	return _u
 }
 
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *ExtractJobUpdate) SetPromptTokens(v int) *ExtractJobUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdate) SetNillablePromptTokens(v *int) *ExtractJobUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *ExtractJobUpdate) AddPromptTokens(v int) *ExtractJobUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (_u *ExtractJobUpdate) ClearPromptTokens() *ExtractJobUpdate {
+	_u.mutation.ClearPromptTokens()
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *ExtractJobUpdate) SetCompletionTokens(v int) *ExtractJobUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdate) SetNillableCompletionTokens(v *int) *ExtractJobUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *ExtractJobUpdate) AddCompletionTokens(v int) *ExtractJobUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (_u *ExtractJobUpdate) ClearCompletionTokens() *ExtractJobUpdate {
+	_u.mutation.ClearCompletionTokens()
+	return _u
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (_u *ExtractJobUpdate) SetImageTokens(v int) *ExtractJobUpdate {
+	_u.mutation.ResetImageTokens()
+	_u.mutation.SetImageTokens(v)
+	return _u
+}
+
+// SetNillableImageTokens sets the "image_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdate) SetNillableImageTokens(v *int) *ExtractJobUpdate {
+	if v != nil {
+		_u.SetImageTokens(*v)
+	}
+	return _u
+}
+
+// AddImageTokens adds value to the "image_tokens" field.
+func (_u *ExtractJobUpdate) AddImageTokens(v int) *ExtractJobUpdate {
+	_u.mutation.AddImageTokens(v)
+	return _u
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (_u *ExtractJobUpdate) ClearImageTokens() *ExtractJobUpdate {
+	_u.mutation.ClearImageTokens()
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *ExtractJobUpdate) SetCostUsd(v float64) *ExtractJobUpdate {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *ExtractJobUpdate) SetNillableCostUsd(v *float64) *ExtractJobUpdate {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *ExtractJobUpdate) AddCostUsd(v float64) *ExtractJobUpdate {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (_u *ExtractJobUpdate) ClearCostUsd() *ExtractJobUpdate {
+	_u.mutation.ClearCostUsd()
+	return _u
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (_u *ExtractJobUpdate) SetLlmCacheHit(v bool) *ExtractJobUpdate {
+	_u.mutation.SetLlmCacheHit(v)
+	return _u
+}
+
+// SetNillableLlmCacheHit sets the "llm_cache_hit" field if the given value is not nil.
+func (_u *ExtractJobUpdate) SetNillableLlmCacheHit(v *bool) *ExtractJobUpdate {
+	if v != nil {
+		_u.SetLlmCacheHit(*v)
+	}
+	return _u
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (_u *ExtractJobUpdate) SetParseAttempts(v json.RawMessage) *ExtractJobUpdate {
+	_u.mutation.SetParseAttempts(v)
+	return _u
+}
+
+// AppendParseAttempts appends value to the "parse_attempts" field.
+func (_u *ExtractJobUpdate) AppendParseAttempts(v json.RawMessage) *ExtractJobUpdate {
+	_u.mutation.AppendParseAttempts(v)
+	return _u
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (_u *ExtractJobUpdate) ClearParseAttempts() *ExtractJobUpdate {
+	_u.mutation.ClearParseAttempts()
+	return _u
+}
+
 // SetFile sets the "file" edge to the ReceiptFile entity.
 func (_u *ExtractJobUpdate) SetFile(v *ReceiptFile) *ExtractJobUpdate {
 	return _u.SetFileID(v.ID)
@@ -368,6 +509,12 @@ func (_u *ExtractJobUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExtractJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExtractJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExtractJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -450,6 +597,56 @@ func (_u *ExtractJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ModelParamsCleared() {
 		_spec.ClearField(extractjob.FieldModelParams, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(extractjob.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(extractjob.FieldPromptTokens, field.TypeInt, value)
+	}
+	if _u.mutation.PromptTokensCleared() {
+		_spec.ClearField(extractjob.FieldPromptTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(extractjob.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(extractjob.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if _u.mutation.CompletionTokensCleared() {
+		_spec.ClearField(extractjob.FieldCompletionTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ImageTokens(); ok {
+		_spec.SetField(extractjob.FieldImageTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedImageTokens(); ok {
+		_spec.AddField(extractjob.FieldImageTokens, field.TypeInt, value)
+	}
+	if _u.mutation.ImageTokensCleared() {
+		_spec.ClearField(extractjob.FieldImageTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(extractjob.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(extractjob.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.CostUsdCleared() {
+		_spec.ClearField(extractjob.FieldCostUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LlmCacheHit(); ok {
+		_spec.SetField(extractjob.FieldLlmCacheHit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ParseAttempts(); ok {
+		_spec.SetField(extractjob.FieldParseAttempts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParseAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, extractjob.FieldParseAttempts, value)
+		})
+	}
+	if _u.mutation.ParseAttemptsCleared() {
+		_spec.ClearField(extractjob.FieldParseAttempts, field.TypeJSON)
+	}
 	if _u.mutation.FileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -537,6 +734,7 @@ func (_u *ExtractJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{extractjob.Label}
@@ -552,9 +750,10 @@ func (_u *ExtractJobUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // ExtractJobUpdateOne is the builder for updating a single ExtractJob entity.
 type ExtractJobUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ExtractJobMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ExtractJobMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetFileID sets the "file_id" field.
@@ -810,6 +1009,146 @@ func (_u *ExtractJobUpdateOne) ClearModelParams() *ExtractJobUpdateOne {
 	return _u
 }
 
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *ExtractJobUpdateOne) SetPromptTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdateOne) SetNillablePromptTokens(v *int) *ExtractJobUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *ExtractJobUpdateOne) AddPromptTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (_u *ExtractJobUpdateOne) ClearPromptTokens() *ExtractJobUpdateOne {
+	_u.mutation.ClearPromptTokens()
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *ExtractJobUpdateOne) SetCompletionTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdateOne) SetNillableCompletionTokens(v *int) *ExtractJobUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *ExtractJobUpdateOne) AddCompletionTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (_u *ExtractJobUpdateOne) ClearCompletionTokens() *ExtractJobUpdateOne {
+	_u.mutation.ClearCompletionTokens()
+	return _u
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (_u *ExtractJobUpdateOne) SetImageTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.ResetImageTokens()
+	_u.mutation.SetImageTokens(v)
+	return _u
+}
+
+// SetNillableImageTokens sets the "image_tokens" field if the given value is not nil.
+func (_u *ExtractJobUpdateOne) SetNillableImageTokens(v *int) *ExtractJobUpdateOne {
+	if v != nil {
+		_u.SetImageTokens(*v)
+	}
+	return _u
+}
+
+// AddImageTokens adds value to the "image_tokens" field.
+func (_u *ExtractJobUpdateOne) AddImageTokens(v int) *ExtractJobUpdateOne {
+	_u.mutation.AddImageTokens(v)
+	return _u
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (_u *ExtractJobUpdateOne) ClearImageTokens() *ExtractJobUpdateOne {
+	_u.mutation.ClearImageTokens()
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *ExtractJobUpdateOne) SetCostUsd(v float64) *ExtractJobUpdateOne {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *ExtractJobUpdateOne) SetNillableCostUsd(v *float64) *ExtractJobUpdateOne {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *ExtractJobUpdateOne) AddCostUsd(v float64) *ExtractJobUpdateOne {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (_u *ExtractJobUpdateOne) ClearCostUsd() *ExtractJobUpdateOne {
+	_u.mutation.ClearCostUsd()
+	return _u
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (_u *ExtractJobUpdateOne) SetLlmCacheHit(v bool) *ExtractJobUpdateOne {
+	_u.mutation.SetLlmCacheHit(v)
+	return _u
+}
+
+// SetNillableLlmCacheHit sets the "llm_cache_hit" field if the given value is not nil.
+func (_u *ExtractJobUpdateOne) SetNillableLlmCacheHit(v *bool) *ExtractJobUpdateOne {
+	if v != nil {
+		_u.SetLlmCacheHit(*v)
+	}
+	return _u
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (_u *ExtractJobUpdateOne) SetParseAttempts(v json.RawMessage) *ExtractJobUpdateOne {
+	_u.mutation.SetParseAttempts(v)
+	return _u
+}
+
+// AppendParseAttempts appends value to the "parse_attempts" field.
+func (_u *ExtractJobUpdateOne) AppendParseAttempts(v json.RawMessage) *ExtractJobUpdateOne {
+	_u.mutation.AppendParseAttempts(v)
+	return _u
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (_u *ExtractJobUpdateOne) ClearParseAttempts() *ExtractJobUpdateOne {
+	_u.mutation.ClearParseAttempts()
+	return _u
+}
+
 // SetFile sets the "file" edge to the ReceiptFile entity.
 func (_u *ExtractJobUpdateOne) SetFile(v *ReceiptFile) *ExtractJobUpdateOne {
 	return _u.SetFileID(v.ID)
@@ -904,6 +1243,12 @@ func (_u *ExtractJobUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ExtractJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExtractJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ExtractJobUpdateOne) sqlSave(ctx context.Context) (_node *ExtractJob, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1003,6 +1348,56 @@ func (_u *ExtractJobUpdateOne) sqlSave(ctx context.Context) (_node *ExtractJob,
 	if _u.mutation.ModelParamsCleared() {
 		_spec.ClearField(extractjob.FieldModelParams, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(extractjob.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(extractjob.FieldPromptTokens, field.TypeInt, value)
+	}
+	if _u.mutation.PromptTokensCleared() {
+		_spec.ClearField(extractjob.FieldPromptTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(extractjob.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(extractjob.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if _u.mutation.CompletionTokensCleared() {
+		_spec.ClearField(extractjob.FieldCompletionTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ImageTokens(); ok {
+		_spec.SetField(extractjob.FieldImageTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedImageTokens(); ok {
+		_spec.AddField(extractjob.FieldImageTokens, field.TypeInt, value)
+	}
+	if _u.mutation.ImageTokensCleared() {
+		_spec.ClearField(extractjob.FieldImageTokens, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(extractjob.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(extractjob.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.CostUsdCleared() {
+		_spec.ClearField(extractjob.FieldCostUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LlmCacheHit(); ok {
+		_spec.SetField(extractjob.FieldLlmCacheHit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ParseAttempts(); ok {
+		_spec.SetField(extractjob.FieldParseAttempts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParseAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, extractjob.FieldParseAttempts, value)
+		})
+	}
+	if _u.mutation.ParseAttemptsCleared() {
+		_spec.ClearField(extractjob.FieldParseAttempts, field.TypeJSON)
+	}
 	if _u.mutation.FileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1090,6 +1485,7 @@ func (_u *ExtractJobUpdateOne) sqlSave(ctx context.Context) (_node *ExtractJob,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ExtractJob{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fxrate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldID, id))
+}
+
+// Base applies equality check predicate on the "base" field. It's identical to BaseEQ.
+func Base(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldBase, v))
+}
+
+// Quote applies equality check predicate on the "quote" field. It's identical to QuoteEQ.
+func Quote(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldQuote, v))
+}
+
+// RateDate applies equality check predicate on the "rate_date" field. It's identical to RateDateEQ.
+func RateDate(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRateDate, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRate, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BaseEQ applies the EQ predicate on the "base" field.
+func BaseEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldBase, v))
+}
+
+// BaseNEQ applies the NEQ predicate on the "base" field.
+func BaseNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldBase, v))
+}
+
+// BaseIn applies the In predicate on the "base" field.
+func BaseIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldBase, vs...))
+}
+
+// BaseNotIn applies the NotIn predicate on the "base" field.
+func BaseNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldBase, vs...))
+}
+
+// BaseGT applies the GT predicate on the "base" field.
+func BaseGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldBase, v))
+}
+
+// BaseGTE applies the GTE predicate on the "base" field.
+func BaseGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldBase, v))
+}
+
+// BaseLT applies the LT predicate on the "base" field.
+func BaseLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldBase, v))
+}
+
+// BaseLTE applies the LTE predicate on the "base" field.
+func BaseLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldBase, v))
+}
+
+// BaseContains applies the Contains predicate on the "base" field.
+func BaseContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldBase, v))
+}
+
+// BaseHasPrefix applies the HasPrefix predicate on the "base" field.
+func BaseHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldBase, v))
+}
+
+// BaseHasSuffix applies the HasSuffix predicate on the "base" field.
+func BaseHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldBase, v))
+}
+
+// BaseEqualFold applies the EqualFold predicate on the "base" field.
+func BaseEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldBase, v))
+}
+
+// BaseContainsFold applies the ContainsFold predicate on the "base" field.
+func BaseContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldBase, v))
+}
+
+// QuoteEQ applies the EQ predicate on the "quote" field.
+func QuoteEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldQuote, v))
+}
+
+// QuoteNEQ applies the NEQ predicate on the "quote" field.
+func QuoteNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldQuote, v))
+}
+
+// QuoteIn applies the In predicate on the "quote" field.
+func QuoteIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldQuote, vs...))
+}
+
+// QuoteNotIn applies the NotIn predicate on the "quote" field.
+func QuoteNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldQuote, vs...))
+}
+
+// QuoteGT applies the GT predicate on the "quote" field.
+func QuoteGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldQuote, v))
+}
+
+// QuoteGTE applies the GTE predicate on the "quote" field.
+func QuoteGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldQuote, v))
+}
+
+// QuoteLT applies the LT predicate on the "quote" field.
+func QuoteLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldQuote, v))
+}
+
+// QuoteLTE applies the LTE predicate on the "quote" field.
+func QuoteLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldQuote, v))
+}
+
+// QuoteContains applies the Contains predicate on the "quote" field.
+func QuoteContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldQuote, v))
+}
+
+// QuoteHasPrefix applies the HasPrefix predicate on the "quote" field.
+func QuoteHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldQuote, v))
+}
+
+// QuoteHasSuffix applies the HasSuffix predicate on the "quote" field.
+func QuoteHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldQuote, v))
+}
+
+// QuoteEqualFold applies the EqualFold predicate on the "quote" field.
+func QuoteEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldQuote, v))
+}
+
+// QuoteContainsFold applies the ContainsFold predicate on the "quote" field.
+func QuoteContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldQuote, v))
+}
+
+// RateDateEQ applies the EQ predicate on the "rate_date" field.
+func RateDateEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRateDate, v))
+}
+
+// RateDateNEQ applies the NEQ predicate on the "rate_date" field.
+func RateDateNEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldRateDate, v))
+}
+
+// RateDateIn applies the In predicate on the "rate_date" field.
+func RateDateIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldRateDate, vs...))
+}
+
+// RateDateNotIn applies the NotIn predicate on the "rate_date" field.
+func RateDateNotIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldRateDate, vs...))
+}
+
+// RateDateGT applies the GT predicate on the "rate_date" field.
+func RateDateGT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldRateDate, v))
+}
+
+// RateDateGTE applies the GTE predicate on the "rate_date" field.
+func RateDateGTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldRateDate, v))
+}
+
+// RateDateLT applies the LT predicate on the "rate_date" field.
+func RateDateLT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldRateDate, v))
+}
+
+// RateDateLTE applies the LTE predicate on the "rate_date" field.
+func RateDateLTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldRateDate, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldRate, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.NotPredicates(p))
+}
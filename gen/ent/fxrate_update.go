@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+)
+
+// FxRateUpdate is the builder for updating FxRate entities.
+type FxRateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FxRateUpdate builder.
+func (_u *FxRateUpdate) Where(ps ...predicate.FxRate) *FxRateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBase sets the "base" field.
+func (_u *FxRateUpdate) SetBase(v string) *FxRateUpdate {
+	_u.mutation.SetBase(v)
+	return _u
+}
+
+// SetNillableBase sets the "base" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableBase(v *string) *FxRateUpdate {
+	if v != nil {
+		_u.SetBase(*v)
+	}
+	return _u
+}
+
+// SetQuote sets the "quote" field.
+func (_u *FxRateUpdate) SetQuote(v string) *FxRateUpdate {
+	_u.mutation.SetQuote(v)
+	return _u
+}
+
+// SetNillableQuote sets the "quote" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableQuote(v *string) *FxRateUpdate {
+	if v != nil {
+		_u.SetQuote(*v)
+	}
+	return _u
+}
+
+// SetRateDate sets the "rate_date" field.
+func (_u *FxRateUpdate) SetRateDate(v time.Time) *FxRateUpdate {
+	_u.mutation.SetRateDate(v)
+	return _u
+}
+
+// SetNillableRateDate sets the "rate_date" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableRateDate(v *time.Time) *FxRateUpdate {
+	if v != nil {
+		_u.SetRateDate(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *FxRateUpdate) SetRate(v float64) *FxRateUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableRate(v *float64) *FxRateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *FxRateUpdate) AddRate(v float64) *FxRateUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *FxRateUpdate) SetSource(v string) *FxRateUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableSource(v *string) *FxRateUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *FxRateUpdate) SetCreatedAt(v time.Time) *FxRateUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *FxRateUpdate) SetNillableCreatedAt(v *time.Time) *FxRateUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FxRateMutation object of the builder.
+func (_u *FxRateUpdate) Mutation() *FxRateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FxRateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FxRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FxRateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FxRateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FxRateUpdate) check() error {
+	if v, ok := _u.mutation.Base(); ok {
+		if err := fxrate.BaseValidator(v); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "FxRate.base": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quote(); ok {
+		if err := fxrate.QuoteValidator(v); err != nil {
+			return &ValidationError{Name: "quote", err: fmt.Errorf(`ent: validator failed for field "FxRate.quote": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := fxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "FxRate.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := fxrate.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "FxRate.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FxRateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FxRateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FxRateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Base(); ok {
+		_spec.SetField(fxrate.FieldBase, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quote(); ok {
+		_spec.SetField(fxrate.FieldQuote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RateDate(); ok {
+		_spec.SetField(fxrate.FieldRateDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(fxrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(fxrate.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(fxrate.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FxRateUpdateOne is the builder for updating a single FxRate entity.
+type FxRateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetBase sets the "base" field.
+func (_u *FxRateUpdateOne) SetBase(v string) *FxRateUpdateOne {
+	_u.mutation.SetBase(v)
+	return _u
+}
+
+// SetNillableBase sets the "base" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableBase(v *string) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetBase(*v)
+	}
+	return _u
+}
+
+// SetQuote sets the "quote" field.
+func (_u *FxRateUpdateOne) SetQuote(v string) *FxRateUpdateOne {
+	_u.mutation.SetQuote(v)
+	return _u
+}
+
+// SetNillableQuote sets the "quote" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableQuote(v *string) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetQuote(*v)
+	}
+	return _u
+}
+
+// SetRateDate sets the "rate_date" field.
+func (_u *FxRateUpdateOne) SetRateDate(v time.Time) *FxRateUpdateOne {
+	_u.mutation.SetRateDate(v)
+	return _u
+}
+
+// SetNillableRateDate sets the "rate_date" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableRateDate(v *time.Time) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetRateDate(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *FxRateUpdateOne) SetRate(v float64) *FxRateUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableRate(v *float64) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *FxRateUpdateOne) AddRate(v float64) *FxRateUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *FxRateUpdateOne) SetSource(v string) *FxRateUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableSource(v *string) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *FxRateUpdateOne) SetCreatedAt(v time.Time) *FxRateUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *FxRateUpdateOne) SetNillableCreatedAt(v *time.Time) *FxRateUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FxRateMutation object of the builder.
+func (_u *FxRateUpdateOne) Mutation() *FxRateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FxRateUpdate builder.
+func (_u *FxRateUpdateOne) Where(ps ...predicate.FxRate) *FxRateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FxRateUpdateOne) Select(field string, fields ...string) *FxRateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FxRate entity.
+func (_u *FxRateUpdateOne) Save(ctx context.Context) (*FxRate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FxRateUpdateOne) SaveX(ctx context.Context) *FxRate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FxRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FxRateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FxRateUpdateOne) check() error {
+	if v, ok := _u.mutation.Base(); ok {
+		if err := fxrate.BaseValidator(v); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "FxRate.base": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Quote(); ok {
+		if err := fxrate.QuoteValidator(v); err != nil {
+			return &ValidationError{Name: "quote", err: fmt.Errorf(`ent: validator failed for field "FxRate.quote": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := fxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "FxRate.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := fxrate.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "FxRate.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FxRateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FxRateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FxRateUpdateOne) sqlSave(ctx context.Context) (_node *FxRate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FxRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fxrate.FieldID)
+		for _, f := range fields {
+			if !fxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Base(); ok {
+		_spec.SetField(fxrate.FieldBase, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quote(); ok {
+		_spec.SetField(fxrate.FieldQuote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RateDate(); ok {
+		_spec.SetField(fxrate.FieldRateDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(fxrate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(fxrate.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(fxrate.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FxRate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,78 @@ import (
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
 )
 
+// The BudgetFunc type is an adapter to allow the use of ordinary
+// function as Budget mutator.
+type BudgetFunc func(context.Context, *ent.BudgetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BudgetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BudgetMutation", m)
+}
+
+// The BudgetAlertFunc type is an adapter to allow the use of ordinary
+// function as BudgetAlert mutator.
+type BudgetAlertFunc func(context.Context, *ent.BudgetAlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetAlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BudgetAlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BudgetAlertMutation", m)
+}
+
+// The CategoryFunc type is an adapter to allow the use of ordinary
+// function as Category mutator.
+type CategoryFunc func(context.Context, *ent.CategoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryMutation", m)
+}
+
+// The CategoryCorrectionFunc type is an adapter to allow the use of ordinary
+// function as CategoryCorrection mutator.
+type CategoryCorrectionFunc func(context.Context, *ent.CategoryCorrectionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryCorrectionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryCorrectionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryCorrectionMutation", m)
+}
+
+// The CategoryRuleFunc type is an adapter to allow the use of ordinary
+// function as CategoryRule mutator.
+type CategoryRuleFunc func(context.Context, *ent.CategoryRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CategoryRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CategoryRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CategoryRuleMutation", m)
+}
+
+// The ExportTemplateFunc type is an adapter to allow the use of ordinary
+// function as ExportTemplate mutator.
+type ExportTemplateFunc func(context.Context, *ent.ExportTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExportTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExportTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExportTemplateMutation", m)
+}
+
 // The ExtractJobFunc type is an adapter to allow the use of ordinary
 // function as ExtractJob mutator.
 type ExtractJobFunc func(context.Context, *ent.ExtractJobMutation) (ent.Value, error)
@@ -21,6 +93,30 @@ func (f ExtractJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExtractJobMutation", m)
 }
 
+// The FxRateFunc type is an adapter to allow the use of ordinary
+// function as FxRate mutator.
+type FxRateFunc func(context.Context, *ent.FxRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FxRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FxRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FxRateMutation", m)
+}
+
+// The MerchantFunc type is an adapter to allow the use of ordinary
+// function as Merchant mutator.
+type MerchantFunc func(context.Context, *ent.MerchantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MerchantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MerchantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MerchantMutation", m)
+}
+
 // The ProfileFunc type is an adapter to allow the use of ordinary
 // function as Profile mutator.
 type ProfileFunc func(context.Context, *ent.ProfileMutation) (ent.Value, error)
@@ -45,6 +141,18 @@ func (f ReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptMutation", m)
 }
 
+// The ReceiptAnomalyFunc type is an adapter to allow the use of ordinary
+// function as ReceiptAnomaly mutator.
+type ReceiptAnomalyFunc func(context.Context, *ent.ReceiptAnomalyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReceiptAnomalyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReceiptAnomalyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptAnomalyMutation", m)
+}
+
 // The ReceiptFileFunc type is an adapter to allow the use of ordinary
 // function as ReceiptFile mutator.
 type ReceiptFileFunc func(context.Context, *ent.ReceiptFileMutation) (ent.Value, error)
@@ -57,6 +165,18 @@ func (f ReceiptFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptFileMutation", m)
 }
 
+// The StatementTransactionFunc type is an adapter to allow the use of ordinary
+// function as StatementTransaction mutator.
+type StatementTransactionFunc func(context.Context, *ent.StatementTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StatementTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StatementTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StatementTransactionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
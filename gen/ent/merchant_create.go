@@ -0,0 +1,969 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+)
+
+// MerchantCreate is the builder for creating a Merchant entity.
+type MerchantCreate struct {
+	config
+	mutation *MerchantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *MerchantCreate) SetProfileID(v uuid.UUID) *MerchantCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *MerchantCreate) SetName(v string) *MerchantCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAliases sets the "aliases" field.
+func (_c *MerchantCreate) SetAliases(v []string) *MerchantCreate {
+	_c.mutation.SetAliases(v)
+	return _c
+}
+
+// SetKeys sets the "keys" field.
+func (_c *MerchantCreate) SetKeys(v []string) *MerchantCreate {
+	_c.mutation.SetKeys(v)
+	return _c
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (_c *MerchantCreate) SetDefaultCategory(v string) *MerchantCreate {
+	_c.mutation.SetDefaultCategory(v)
+	return _c
+}
+
+// SetNillableDefaultCategory sets the "default_category" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableDefaultCategory(v *string) *MerchantCreate {
+	if v != nil {
+		_c.SetDefaultCategory(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MerchantCreate) SetCreatedAt(v time.Time) *MerchantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableCreatedAt(v *time.Time) *MerchantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MerchantCreate) SetUpdatedAt(v time.Time) *MerchantCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableUpdatedAt(v *time.Time) *MerchantCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MerchantCreate) SetID(v uuid.UUID) *MerchantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MerchantCreate) SetNillableID(v *uuid.UUID) *MerchantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *MerchantCreate) SetProfile(v *Profile) *MerchantCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_c *MerchantCreate) AddReceiptIDs(ids ...uuid.UUID) *MerchantCreate {
+	_c.mutation.AddReceiptIDs(ids...)
+	return _c
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_c *MerchantCreate) AddReceipts(v ...*Receipt) *MerchantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (_c *MerchantCreate) Mutation() *MerchantMutation {
+	return _c.mutation
+}
+
+// Save creates the Merchant in the database.
+func (_c *MerchantCreate) Save(ctx context.Context) (*Merchant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MerchantCreate) SaveX(ctx context.Context) *Merchant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MerchantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MerchantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MerchantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := merchant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := merchant.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := merchant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MerchantCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "Merchant.profile_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Merchant.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Merchant.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Merchant.updated_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "Merchant.profile"`)}
+	}
+	return nil
+}
+
+func (_c *MerchantCreate) sqlSave(ctx context.Context) (*Merchant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MerchantCreate) createSpec() (*Merchant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Merchant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(merchant.Table, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Aliases(); ok {
+		_spec.SetField(merchant.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
+	if value, ok := _c.mutation.Keys(); ok {
+		_spec.SetField(merchant.FieldKeys, field.TypeJSON, value)
+		_node.Keys = value
+	}
+	if value, ok := _c.mutation.DefaultCategory(); ok {
+		_spec.SetField(merchant.FieldDefaultCategory, field.TypeString, value)
+		_node.DefaultCategory = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(merchant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   merchant.ProfileTable,
+			Columns: []string{merchant.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Merchant.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MerchantUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MerchantCreate) OnConflict(opts ...sql.ConflictOption) *MerchantUpsertOne {
+	_c.conflict = opts
+	return &MerchantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MerchantCreate) OnConflictColumns(columns ...string) *MerchantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MerchantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MerchantUpsertOne is the builder for "upsert"-ing
+	//  one Merchant node.
+	MerchantUpsertOne struct {
+		create *MerchantCreate
+	}
+
+	// MerchantUpsert is the "OnConflict" setter.
+	MerchantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *MerchantUpsert) SetProfileID(v uuid.UUID) *MerchantUpsert {
+	u.Set(merchant.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateProfileID() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldProfileID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MerchantUpsert) SetName(v string) *MerchantUpsert {
+	u.Set(merchant.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateName() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldName)
+	return u
+}
+
+// SetAliases sets the "aliases" field.
+func (u *MerchantUpsert) SetAliases(v []string) *MerchantUpsert {
+	u.Set(merchant.FieldAliases, v)
+	return u
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateAliases() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldAliases)
+	return u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *MerchantUpsert) ClearAliases() *MerchantUpsert {
+	u.SetNull(merchant.FieldAliases)
+	return u
+}
+
+// SetKeys sets the "keys" field.
+func (u *MerchantUpsert) SetKeys(v []string) *MerchantUpsert {
+	u.Set(merchant.FieldKeys, v)
+	return u
+}
+
+// UpdateKeys sets the "keys" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateKeys() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldKeys)
+	return u
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (u *MerchantUpsert) ClearKeys() *MerchantUpsert {
+	u.SetNull(merchant.FieldKeys)
+	return u
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (u *MerchantUpsert) SetDefaultCategory(v string) *MerchantUpsert {
+	u.Set(merchant.FieldDefaultCategory, v)
+	return u
+}
+
+// UpdateDefaultCategory sets the "default_category" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateDefaultCategory() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldDefaultCategory)
+	return u
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (u *MerchantUpsert) ClearDefaultCategory() *MerchantUpsert {
+	u.SetNull(merchant.FieldDefaultCategory)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MerchantUpsert) SetCreatedAt(v time.Time) *MerchantUpsert {
+	u.Set(merchant.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateCreatedAt() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsert) SetUpdatedAt(v time.Time) *MerchantUpsert {
+	u.Set(merchant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsert) UpdateUpdatedAt() *MerchantUpsert {
+	u.SetExcluded(merchant.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(merchant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MerchantUpsertOne) UpdateNewValues() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(merchant.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MerchantUpsertOne) Ignore() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MerchantUpsertOne) DoNothing() *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MerchantCreate.OnConflict
+// documentation for more info.
+func (u *MerchantUpsertOne) Update(set func(*MerchantUpsert)) *MerchantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MerchantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *MerchantUpsertOne) SetProfileID(v uuid.UUID) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateProfileID() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MerchantUpsertOne) SetName(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateName() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *MerchantUpsertOne) SetAliases(v []string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateAliases() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *MerchantUpsertOne) ClearAliases() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearAliases()
+	})
+}
+
+// SetKeys sets the "keys" field.
+func (u *MerchantUpsertOne) SetKeys(v []string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetKeys(v)
+	})
+}
+
+// UpdateKeys sets the "keys" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateKeys() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateKeys()
+	})
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (u *MerchantUpsertOne) ClearKeys() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearKeys()
+	})
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (u *MerchantUpsertOne) SetDefaultCategory(v string) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetDefaultCategory(v)
+	})
+}
+
+// UpdateDefaultCategory sets the "default_category" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateDefaultCategory() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateDefaultCategory()
+	})
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (u *MerchantUpsertOne) ClearDefaultCategory() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearDefaultCategory()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MerchantUpsertOne) SetCreatedAt(v time.Time) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateCreatedAt() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsertOne) SetUpdatedAt(v time.Time) *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsertOne) UpdateUpdatedAt() *MerchantUpsertOne {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MerchantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MerchantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MerchantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MerchantUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MerchantUpsertOne.ID is not supported by MySQL driver. Use MerchantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MerchantUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MerchantCreateBulk is the builder for creating many Merchant entities in bulk.
+type MerchantCreateBulk struct {
+	config
+	err      error
+	builders []*MerchantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Merchant entities in the database.
+func (_c *MerchantCreateBulk) Save(ctx context.Context) ([]*Merchant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Merchant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MerchantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MerchantCreateBulk) SaveX(ctx context.Context) []*Merchant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MerchantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MerchantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Merchant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MerchantUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MerchantCreateBulk) OnConflict(opts ...sql.ConflictOption) *MerchantUpsertBulk {
+	_c.conflict = opts
+	return &MerchantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MerchantCreateBulk) OnConflictColumns(columns ...string) *MerchantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MerchantUpsertBulk{
+		create: _c,
+	}
+}
+
+// MerchantUpsertBulk is the builder for "upsert"-ing
+// a bulk of Merchant nodes.
+type MerchantUpsertBulk struct {
+	create *MerchantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(merchant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MerchantUpsertBulk) UpdateNewValues() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(merchant.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Merchant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MerchantUpsertBulk) Ignore() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MerchantUpsertBulk) DoNothing() *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MerchantCreateBulk.OnConflict
+// documentation for more info.
+func (u *MerchantUpsertBulk) Update(set func(*MerchantUpsert)) *MerchantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MerchantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *MerchantUpsertBulk) SetProfileID(v uuid.UUID) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateProfileID() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MerchantUpsertBulk) SetName(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateName() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAliases sets the "aliases" field.
+func (u *MerchantUpsertBulk) SetAliases(v []string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateAliases() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *MerchantUpsertBulk) ClearAliases() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearAliases()
+	})
+}
+
+// SetKeys sets the "keys" field.
+func (u *MerchantUpsertBulk) SetKeys(v []string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetKeys(v)
+	})
+}
+
+// UpdateKeys sets the "keys" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateKeys() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateKeys()
+	})
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (u *MerchantUpsertBulk) ClearKeys() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearKeys()
+	})
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (u *MerchantUpsertBulk) SetDefaultCategory(v string) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetDefaultCategory(v)
+	})
+}
+
+// UpdateDefaultCategory sets the "default_category" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateDefaultCategory() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateDefaultCategory()
+	})
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (u *MerchantUpsertBulk) ClearDefaultCategory() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.ClearDefaultCategory()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MerchantUpsertBulk) SetCreatedAt(v time.Time) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateCreatedAt() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MerchantUpsertBulk) SetUpdatedAt(v time.Time) *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MerchantUpsertBulk) UpdateUpdatedAt() *MerchantUpsertBulk {
+	return u.Update(func(s *MerchantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MerchantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MerchantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MerchantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MerchantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+)
+
+// MerchantUpdate is the builder for updating Merchant entities.
+type MerchantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MerchantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MerchantUpdate builder.
+func (_u *MerchantUpdate) Where(ps ...predicate.Merchant) *MerchantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *MerchantUpdate) SetProfileID(v uuid.UUID) *MerchantUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *MerchantUpdate) SetNillableProfileID(v *uuid.UUID) *MerchantUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MerchantUpdate) SetName(v string) *MerchantUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MerchantUpdate) SetNillableName(v *string) *MerchantUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *MerchantUpdate) SetAliases(v []string) *MerchantUpdate {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *MerchantUpdate) AppendAliases(v []string) *MerchantUpdate {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *MerchantUpdate) ClearAliases() *MerchantUpdate {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetKeys sets the "keys" field.
+func (_u *MerchantUpdate) SetKeys(v []string) *MerchantUpdate {
+	_u.mutation.SetKeys(v)
+	return _u
+}
+
+// AppendKeys appends value to the "keys" field.
+func (_u *MerchantUpdate) AppendKeys(v []string) *MerchantUpdate {
+	_u.mutation.AppendKeys(v)
+	return _u
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (_u *MerchantUpdate) ClearKeys() *MerchantUpdate {
+	_u.mutation.ClearKeys()
+	return _u
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (_u *MerchantUpdate) SetDefaultCategory(v string) *MerchantUpdate {
+	_u.mutation.SetDefaultCategory(v)
+	return _u
+}
+
+// SetNillableDefaultCategory sets the "default_category" field if the given value is not nil.
+func (_u *MerchantUpdate) SetNillableDefaultCategory(v *string) *MerchantUpdate {
+	if v != nil {
+		_u.SetDefaultCategory(*v)
+	}
+	return _u
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (_u *MerchantUpdate) ClearDefaultCategory() *MerchantUpdate {
+	_u.mutation.ClearDefaultCategory()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MerchantUpdate) SetCreatedAt(v time.Time) *MerchantUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MerchantUpdate) SetNillableCreatedAt(v *time.Time) *MerchantUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MerchantUpdate) SetUpdatedAt(v time.Time) *MerchantUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *MerchantUpdate) SetProfile(v *Profile) *MerchantUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_u *MerchantUpdate) AddReceiptIDs(ids ...uuid.UUID) *MerchantUpdate {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_u *MerchantUpdate) AddReceipts(v ...*Receipt) *MerchantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (_u *MerchantUpdate) Mutation() *MerchantMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *MerchantUpdate) ClearProfile() *MerchantUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// ClearReceipts clears all "receipts" edges to the Receipt entity.
+func (_u *MerchantUpdate) ClearReceipts() *MerchantUpdate {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to Receipt entities by IDs.
+func (_u *MerchantUpdate) RemoveReceiptIDs(ids ...uuid.UUID) *MerchantUpdate {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to Receipt entities.
+func (_u *MerchantUpdate) RemoveReceipts(v ...*Receipt) *MerchantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MerchantUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MerchantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MerchantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MerchantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MerchantUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := merchant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MerchantUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Merchant.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MerchantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MerchantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MerchantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(merchant.Table, merchant.Columns, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(merchant.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(merchant.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Keys(); ok {
+		_spec.SetField(merchant.FieldKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldKeys, value)
+		})
+	}
+	if _u.mutation.KeysCleared() {
+		_spec.ClearField(merchant.FieldKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCategory(); ok {
+		_spec.SetField(merchant.FieldDefaultCategory, field.TypeString, value)
+	}
+	if _u.mutation.DefaultCategoryCleared() {
+		_spec.ClearField(merchant.FieldDefaultCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(merchant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   merchant.ProfileTable,
+			Columns: []string{merchant.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   merchant.ProfileTable,
+			Columns: []string{merchant.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{merchant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MerchantUpdateOne is the builder for updating a single Merchant entity.
+type MerchantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MerchantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *MerchantUpdateOne) SetProfileID(v uuid.UUID) *MerchantUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *MerchantUpdateOne) SetNillableProfileID(v *uuid.UUID) *MerchantUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MerchantUpdateOne) SetName(v string) *MerchantUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MerchantUpdateOne) SetNillableName(v *string) *MerchantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAliases sets the "aliases" field.
+func (_u *MerchantUpdateOne) SetAliases(v []string) *MerchantUpdateOne {
+	_u.mutation.SetAliases(v)
+	return _u
+}
+
+// AppendAliases appends value to the "aliases" field.
+func (_u *MerchantUpdateOne) AppendAliases(v []string) *MerchantUpdateOne {
+	_u.mutation.AppendAliases(v)
+	return _u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (_u *MerchantUpdateOne) ClearAliases() *MerchantUpdateOne {
+	_u.mutation.ClearAliases()
+	return _u
+}
+
+// SetKeys sets the "keys" field.
+func (_u *MerchantUpdateOne) SetKeys(v []string) *MerchantUpdateOne {
+	_u.mutation.SetKeys(v)
+	return _u
+}
+
+// AppendKeys appends value to the "keys" field.
+func (_u *MerchantUpdateOne) AppendKeys(v []string) *MerchantUpdateOne {
+	_u.mutation.AppendKeys(v)
+	return _u
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (_u *MerchantUpdateOne) ClearKeys() *MerchantUpdateOne {
+	_u.mutation.ClearKeys()
+	return _u
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (_u *MerchantUpdateOne) SetDefaultCategory(v string) *MerchantUpdateOne {
+	_u.mutation.SetDefaultCategory(v)
+	return _u
+}
+
+// SetNillableDefaultCategory sets the "default_category" field if the given value is not nil.
+func (_u *MerchantUpdateOne) SetNillableDefaultCategory(v *string) *MerchantUpdateOne {
+	if v != nil {
+		_u.SetDefaultCategory(*v)
+	}
+	return _u
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (_u *MerchantUpdateOne) ClearDefaultCategory() *MerchantUpdateOne {
+	_u.mutation.ClearDefaultCategory()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MerchantUpdateOne) SetCreatedAt(v time.Time) *MerchantUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MerchantUpdateOne) SetNillableCreatedAt(v *time.Time) *MerchantUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MerchantUpdateOne) SetUpdatedAt(v time.Time) *MerchantUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *MerchantUpdateOne) SetProfile(v *Profile) *MerchantUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_u *MerchantUpdateOne) AddReceiptIDs(ids ...uuid.UUID) *MerchantUpdateOne {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_u *MerchantUpdateOne) AddReceipts(v ...*Receipt) *MerchantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (_u *MerchantUpdateOne) Mutation() *MerchantMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *MerchantUpdateOne) ClearProfile() *MerchantUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// ClearReceipts clears all "receipts" edges to the Receipt entity.
+func (_u *MerchantUpdateOne) ClearReceipts() *MerchantUpdateOne {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to Receipt entities by IDs.
+func (_u *MerchantUpdateOne) RemoveReceiptIDs(ids ...uuid.UUID) *MerchantUpdateOne {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to Receipt entities.
+func (_u *MerchantUpdateOne) RemoveReceipts(v ...*Receipt) *MerchantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
+// Where appends a list predicates to the MerchantUpdate builder.
+func (_u *MerchantUpdateOne) Where(ps ...predicate.Merchant) *MerchantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MerchantUpdateOne) Select(field string, fields ...string) *MerchantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Merchant entity.
+func (_u *MerchantUpdateOne) Save(ctx context.Context) (*Merchant, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MerchantUpdateOne) SaveX(ctx context.Context) *Merchant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MerchantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MerchantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MerchantUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := merchant.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MerchantUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Merchant.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MerchantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MerchantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MerchantUpdateOne) sqlSave(ctx context.Context) (_node *Merchant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(merchant.Table, merchant.Columns, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Merchant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, merchant.FieldID)
+		for _, f := range fields {
+			if !merchant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != merchant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aliases(); ok {
+		_spec.SetField(merchant.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldAliases, value)
+		})
+	}
+	if _u.mutation.AliasesCleared() {
+		_spec.ClearField(merchant.FieldAliases, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Keys(); ok {
+		_spec.SetField(merchant.FieldKeys, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeys(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldKeys, value)
+		})
+	}
+	if _u.mutation.KeysCleared() {
+		_spec.ClearField(merchant.FieldKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DefaultCategory(); ok {
+		_spec.SetField(merchant.FieldDefaultCategory, field.TypeString, value)
+	}
+	if _u.mutation.DefaultCategoryCleared() {
+		_spec.ClearField(merchant.FieldDefaultCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(merchant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   merchant.ProfileTable,
+			Columns: []string{merchant.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   merchant.ProfileTable,
+			Columns: []string{merchant.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   merchant.ReceiptsTable,
+			Columns: []string{merchant.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Merchant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{merchant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,220 @@ import (
 )
 
 var (
+	// BudgetsColumns holds the columns for the "budgets" table.
+	BudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "category_name", Type: field.TypeString},
+		{Name: "period", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// BudgetsTable holds the schema information for the "budgets" table.
+	BudgetsTable = &schema.Table{
+		Name:       "budgets",
+		Columns:    BudgetsColumns,
+		PrimaryKey: []*schema.Column{BudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budgets_profiles_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[6]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budget_profile_id_category_name_period",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetsColumns[6], BudgetsColumns[1], BudgetsColumns[2]},
+			},
+		},
+	}
+	// BudgetAlertsColumns holds the columns for the "budget_alerts" table.
+	BudgetAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "profile_id", Type: field.TypeUUID},
+		{Name: "period_start", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "threshold", Type: field.TypeInt},
+		{Name: "spent", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "budget_amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "receipt_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "budget_id", Type: field.TypeUUID},
+	}
+	// BudgetAlertsTable holds the schema information for the "budget_alerts" table.
+	BudgetAlertsTable = &schema.Table{
+		Name:       "budget_alerts",
+		Columns:    BudgetAlertsColumns,
+		PrimaryKey: []*schema.Column{BudgetAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budget_alerts_budgets_alerts",
+				Columns:    []*schema.Column{BudgetAlertsColumns[9]},
+				RefColumns: []*schema.Column{BudgetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetalert_budget_id_period_start_threshold",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetAlertsColumns[9], BudgetAlertsColumns[2], BudgetAlertsColumns[3]},
+			},
+			{
+				Name:    "budgetalert_profile_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetAlertsColumns[1], BudgetAlertsColumns[7]},
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "synonyms", Type: field.TypeJSON, Nullable: true},
+		{Name: "tax_line", Type: field.TypeString, Nullable: true},
+		{Name: "account", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_profiles_categories",
+				Columns:    []*schema.Column{CategoriesColumns[8]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_profile_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CategoriesColumns[8], CategoriesColumns[1]},
+			},
+		},
+	}
+	// CategoryCorrectionsColumns holds the columns for the "category_corrections" table.
+	CategoryCorrectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "receipt_id", Type: field.TypeUUID},
+		{Name: "merchant_name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "from_category", Type: field.TypeString},
+		{Name: "to_category", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// CategoryCorrectionsTable holds the schema information for the "category_corrections" table.
+	CategoryCorrectionsTable = &schema.Table{
+		Name:       "category_corrections",
+		Columns:    CategoryCorrectionsColumns,
+		PrimaryKey: []*schema.Column{CategoryCorrectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_corrections_profiles_corrections",
+				Columns:    []*schema.Column{CategoryCorrectionsColumns[7]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorycorrection_profile_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryCorrectionsColumns[7], CategoryCorrectionsColumns[6]},
+			},
+			{
+				Name:    "categorycorrection_receipt_id",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryCorrectionsColumns[1]},
+			},
+		},
+	}
+	// CategoryRulesColumns holds the columns for the "category_rules" table.
+	CategoryRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt, Default: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "merchant_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "path_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "min_total", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "max_total", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "set_category", Type: field.TypeString, Nullable: true},
+		{Name: "force_review", Type: field.TypeBool, Default: false},
+		{Name: "set_description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// CategoryRulesTable holds the schema information for the "category_rules" table.
+	CategoryRulesTable = &schema.Table{
+		Name:       "category_rules",
+		Columns:    CategoryRulesColumns,
+		PrimaryKey: []*schema.Column{CategoryRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_rules_profiles_rules",
+				Columns:    []*schema.Column{CategoryRulesColumns[14]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categoryrule_profile_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryRulesColumns[14], CategoryRulesColumns[1]},
+			},
+			{
+				Name:    "categoryrule_profile_id_priority",
+				Unique:  false,
+				Columns: []*schema.Column{CategoryRulesColumns[14], CategoryRulesColumns[2]},
+			},
+		},
+	}
+	// ExportTemplatesColumns holds the columns for the "export_templates" table.
+	ExportTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "columns", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// ExportTemplatesTable holds the schema information for the "export_templates" table.
+	ExportTemplatesTable = &schema.Table{
+		Name:       "export_templates",
+		Columns:    ExportTemplatesColumns,
+		PrimaryKey: []*schema.Column{ExportTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "export_templates_profiles_export_templates",
+				Columns:    []*schema.Column{ExportTemplatesColumns[5]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exporttemplate_profile_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ExportTemplatesColumns[5], ExportTemplatesColumns[1]},
+			},
+		},
+	}
 	// ExtractJobColumns holds the columns for the "extract_job" table.
 	ExtractJobColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -23,6 +237,12 @@ var (
 		{Name: "extracted_json", Type: field.TypeJSON, Nullable: true},
 		{Name: "model_name", Type: field.TypeString, Nullable: true},
 		{Name: "model_params", Type: field.TypeJSON, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "completion_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "image_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "cost_usd", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,6)"}},
+		{Name: "llm_cache_hit", Type: field.TypeBool, Default: false},
+		{Name: "parse_attempts", Type: field.TypeJSON, Nullable: true},
 		{Name: "profile_id", Type: field.TypeUUID},
 		{Name: "receipt_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "file_id", Type: field.TypeUUID},
@@ -35,19 +255,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "extract_job_profiles_jobs",
-				Columns:    []*schema.Column{ExtractJobColumns[12]},
+				Columns:    []*schema.Column{ExtractJobColumns[18]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "extract_job_receipts_jobs",
-				Columns:    []*schema.Column{ExtractJobColumns[13]},
+				Columns:    []*schema.Column{ExtractJobColumns[19]},
 				RefColumns: []*schema.Column{ReceiptsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "extract_job_receipt_files_jobs",
-				Columns:    []*schema.Column{ExtractJobColumns[14]},
+				Columns:    []*schema.Column{ExtractJobColumns[20]},
 				RefColumns: []*schema.Column{ReceiptFilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -56,17 +276,72 @@ var (
 			{
 				Name:    "extractjob_profile_id_status_started_at",
 				Unique:  false,
-				Columns: []*schema.Column{ExtractJobColumns[12], ExtractJobColumns[4], ExtractJobColumns[2]},
+				Columns: []*schema.Column{ExtractJobColumns[18], ExtractJobColumns[4], ExtractJobColumns[2]},
 			},
 			{
 				Name:    "extractjob_file_id",
 				Unique:  false,
-				Columns: []*schema.Column{ExtractJobColumns[14]},
+				Columns: []*schema.Column{ExtractJobColumns[20]},
 			},
 			{
 				Name:    "extractjob_receipt_id",
 				Unique:  false,
-				Columns: []*schema.Column{ExtractJobColumns[13]},
+				Columns: []*schema.Column{ExtractJobColumns[19]},
+			},
+		},
+	}
+	// FxRatesColumns holds the columns for the "fx_rates" table.
+	FxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "base", Type: field.TypeString, Size: 3, SchemaType: map[string]string{"postgres": "char(3)"}},
+		{Name: "quote", Type: field.TypeString, Size: 3, SchemaType: map[string]string{"postgres": "char(3)"}},
+		{Name: "rate_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "rate", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(18,8)"}},
+		{Name: "source", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// FxRatesTable holds the schema information for the "fx_rates" table.
+	FxRatesTable = &schema.Table{
+		Name:       "fx_rates",
+		Columns:    FxRatesColumns,
+		PrimaryKey: []*schema.Column{FxRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fxrate_base_quote_rate_date",
+				Unique:  true,
+				Columns: []*schema.Column{FxRatesColumns[1], FxRatesColumns[2], FxRatesColumns[3]},
+			},
+		},
+	}
+	// MerchantsColumns holds the columns for the "merchants" table.
+	MerchantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
+		{Name: "keys", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_category", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// MerchantsTable holds the schema information for the "merchants" table.
+	MerchantsTable = &schema.Table{
+		Name:       "merchants",
+		Columns:    MerchantsColumns,
+		PrimaryKey: []*schema.Column{MerchantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "merchants_profiles_merchants",
+				Columns:    []*schema.Column{MerchantsColumns[7]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "merchant_profile_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{MerchantsColumns[7], MerchantsColumns[1]},
 			},
 		},
 	}
@@ -92,16 +367,26 @@ var (
 		{Name: "file_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "merchant_name", Type: field.TypeString},
 		{Name: "tx_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
-		{Name: "subtotal", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)"}},
-		{Name: "tax", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)"}},
-		{Name: "total", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric(12,2)"}},
+		{Name: "subtotal", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "tax", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "discount", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "other_fees", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "tip", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "fees", Type: field.TypeJSON, Nullable: true},
+		{Name: "total", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
 		{Name: "currency_code", Type: field.TypeString, Size: 3, SchemaType: map[string]string{"postgres": "char(3)"}},
+		{Name: "converted_total", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "converted_currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(3)"}},
+		{Name: "fx_rate", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(18,8)"}},
+		{Name: "fx_rate_date", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "fx_source", Type: field.TypeString, Nullable: true},
 		{Name: "category_name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "file_path", Type: field.TypeString, Nullable: true},
 		{Name: "is_current", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "merchant_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "profile_id", Type: field.TypeUUID},
 	}
 	// ReceiptsTable holds the schema information for the "receipts" table.
@@ -110,9 +395,15 @@ var (
 		Columns:    ReceiptsColumns,
 		PrimaryKey: []*schema.Column{ReceiptsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "receipts_merchants_receipts",
+				Columns:    []*schema.Column{ReceiptsColumns[23]},
+				RefColumns: []*schema.Column{MerchantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "receipts_profiles_receipts",
-				Columns:    []*schema.Column{ReceiptsColumns[14]},
+				Columns:    []*schema.Column{ReceiptsColumns[24]},
 				RefColumns: []*schema.Column{ProfilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -121,17 +412,58 @@ var (
 			{
 				Name:    "receipt_profile_id_tx_date",
 				Unique:  false,
-				Columns: []*schema.Column{ReceiptsColumns[14], ReceiptsColumns[3]},
+				Columns: []*schema.Column{ReceiptsColumns[24], ReceiptsColumns[3]},
 			},
 			{
 				Name:    "receipt_profile_id_category_name",
 				Unique:  false,
-				Columns: []*schema.Column{ReceiptsColumns[14], ReceiptsColumns[8]},
+				Columns: []*schema.Column{ReceiptsColumns[24], ReceiptsColumns[17]},
 			},
 			{
 				Name:    "receipt_profile_id_merchant_name",
 				Unique:  false,
-				Columns: []*schema.Column{ReceiptsColumns[14], ReceiptsColumns[2]},
+				Columns: []*schema.Column{ReceiptsColumns[24], ReceiptsColumns[2]},
+			},
+			{
+				Name:    "receipt_profile_id_merchant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptsColumns[24], ReceiptsColumns[23]},
+			},
+		},
+	}
+	// ReceiptAnomaliesColumns holds the columns for the "receipt_anomalies" table.
+	ReceiptAnomaliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "profile_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "receipt_id", Type: field.TypeUUID},
+	}
+	// ReceiptAnomaliesTable holds the schema information for the "receipt_anomalies" table.
+	ReceiptAnomaliesTable = &schema.Table{
+		Name:       "receipt_anomalies",
+		Columns:    ReceiptAnomaliesColumns,
+		PrimaryKey: []*schema.Column{ReceiptAnomaliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "receipt_anomalies_receipts_anomalies",
+				Columns:    []*schema.Column{ReceiptAnomaliesColumns[6]},
+				RefColumns: []*schema.Column{ReceiptsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "receiptanomaly_receipt_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{ReceiptAnomaliesColumns[6], ReceiptAnomaliesColumns[2]},
+			},
+			{
+				Name:    "receiptanomaly_profile_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReceiptAnomaliesColumns[1], ReceiptAnomaliesColumns[5]},
 			},
 		},
 	}
@@ -179,32 +511,120 @@ var (
 			},
 		},
 	}
+	// StatementTransactionsColumns holds the columns for the "statement_transactions" table.
+	StatementTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeString, Default: ""},
+		{Name: "posted_date", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "amount", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,2)", "sqlite3": "numeric"}},
+		{Name: "currency_code", Type: field.TypeString, Size: 3, SchemaType: map[string]string{"postgres": "char(3)"}},
+		{Name: "external_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "profile_id", Type: field.TypeUUID},
+	}
+	// StatementTransactionsTable holds the schema information for the "statement_transactions" table.
+	StatementTransactionsTable = &schema.Table{
+		Name:       "statement_transactions",
+		Columns:    StatementTransactionsColumns,
+		PrimaryKey: []*schema.Column{StatementTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "statement_transactions_profiles_statement_transactions",
+				Columns:    []*schema.Column{StatementTransactionsColumns[8]},
+				RefColumns: []*schema.Column{ProfilesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statementtransaction_profile_id_account_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{StatementTransactionsColumns[8], StatementTransactionsColumns[1], StatementTransactionsColumns[6]},
+			},
+			{
+				Name:    "statementtransaction_profile_id_posted_date",
+				Unique:  false,
+				Columns: []*schema.Column{StatementTransactionsColumns[8], StatementTransactionsColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BudgetsTable,
+		BudgetAlertsTable,
+		CategoriesTable,
+		CategoryCorrectionsTable,
+		CategoryRulesTable,
+		ExportTemplatesTable,
 		ExtractJobTable,
+		FxRatesTable,
+		MerchantsTable,
 		ProfilesTable,
 		ReceiptsTable,
+		ReceiptAnomaliesTable,
 		ReceiptFilesTable,
+		StatementTransactionsTable,
 	}
 )
 
 func init() {
+	BudgetsTable.ForeignKeys[0].RefTable = ProfilesTable
+	BudgetsTable.Annotation = &entsql.Annotation{
+		Table: "budgets",
+	}
+	BudgetAlertsTable.ForeignKeys[0].RefTable = BudgetsTable
+	BudgetAlertsTable.Annotation = &entsql.Annotation{
+		Table: "budget_alerts",
+	}
+	CategoriesTable.ForeignKeys[0].RefTable = ProfilesTable
+	CategoriesTable.Annotation = &entsql.Annotation{
+		Table: "categories",
+	}
+	CategoryCorrectionsTable.ForeignKeys[0].RefTable = ProfilesTable
+	CategoryCorrectionsTable.Annotation = &entsql.Annotation{
+		Table: "category_corrections",
+	}
+	CategoryRulesTable.ForeignKeys[0].RefTable = ProfilesTable
+	CategoryRulesTable.Annotation = &entsql.Annotation{
+		Table: "category_rules",
+	}
+	ExportTemplatesTable.ForeignKeys[0].RefTable = ProfilesTable
+	ExportTemplatesTable.Annotation = &entsql.Annotation{
+		Table: "export_templates",
+	}
 	ExtractJobTable.ForeignKeys[0].RefTable = ProfilesTable
 	ExtractJobTable.ForeignKeys[1].RefTable = ReceiptsTable
 	ExtractJobTable.ForeignKeys[2].RefTable = ReceiptFilesTable
 	ExtractJobTable.Annotation = &entsql.Annotation{
 		Table: "extract_job",
 	}
+	FxRatesTable.Annotation = &entsql.Annotation{
+		Table: "fx_rates",
+	}
+	MerchantsTable.ForeignKeys[0].RefTable = ProfilesTable
+	MerchantsTable.Annotation = &entsql.Annotation{
+		Table: "merchants",
+	}
 	ProfilesTable.Annotation = &entsql.Annotation{
 		Table: "profiles",
 	}
-	ReceiptsTable.ForeignKeys[0].RefTable = ProfilesTable
+	ReceiptsTable.ForeignKeys[0].RefTable = MerchantsTable
+	ReceiptsTable.ForeignKeys[1].RefTable = ProfilesTable
 	ReceiptsTable.Annotation = &entsql.Annotation{
 		Table: "receipts",
 	}
+	ReceiptAnomaliesTable.ForeignKeys[0].RefTable = ReceiptsTable
+	ReceiptAnomaliesTable.Annotation = &entsql.Annotation{
+		Table: "receipt_anomalies",
+	}
 	ReceiptFilesTable.ForeignKeys[0].RefTable = ProfilesTable
 	ReceiptFilesTable.ForeignKeys[1].RefTable = ReceiptsTable
 	ReceiptFilesTable.Annotation = &entsql.Annotation{
 		Table: "receipt_files",
 	}
+	StatementTransactionsTable.ForeignKeys[0].RefTable = ProfilesTable
+	StatementTransactionsTable.Annotation = &entsql.Annotation{
+		Table: "statement_transactions",
+	}
 }
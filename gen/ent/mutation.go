@@ -13,11 +13,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 const (
@@ -29,55 +41,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeExtractJob  = "ExtractJob"
-	TypeProfile     = "Profile"
-	TypeReceipt     = "Receipt"
-	TypeReceiptFile = "ReceiptFile"
+	TypeBudget               = "Budget"
+	TypeBudgetAlert          = "BudgetAlert"
+	TypeCategory             = "Category"
+	TypeCategoryCorrection   = "CategoryCorrection"
+	TypeCategoryRule         = "CategoryRule"
+	TypeExportTemplate       = "ExportTemplate"
+	TypeExtractJob           = "ExtractJob"
+	TypeFxRate               = "FxRate"
+	TypeMerchant             = "Merchant"
+	TypeProfile              = "Profile"
+	TypeReceipt              = "Receipt"
+	TypeReceiptAnomaly       = "ReceiptAnomaly"
+	TypeReceiptFile          = "ReceiptFile"
+	TypeStatementTransaction = "StatementTransaction"
 )
 
-// ExtractJobMutation represents an operation that mutates the ExtractJob nodes in the graph.
-type ExtractJobMutation struct {
+// BudgetMutation represents an operation that mutates the Budget nodes in the graph.
+type BudgetMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	format                   *string
-	started_at               *time.Time
-	finished_at              *time.Time
-	status                   *string
-	error_message            *string
-	extraction_confidence    *float32
-	addextraction_confidence *float32
-	needs_review             *bool
-	ocr_text                 *string
-	extracted_json           *json.RawMessage
-	appendextracted_json     json.RawMessage
-	model_name               *string
-	model_params             *json.RawMessage
-	appendmodel_params       json.RawMessage
-	clearedFields            map[string]struct{}
-	file                     *uuid.UUID
-	clearedfile              bool
-	profile                  *uuid.UUID
-	clearedprofile           bool
-	receipt                  *uuid.UUID
-	clearedreceipt           bool
-	done                     bool
-	oldValue                 func(context.Context) (*ExtractJob, error)
-	predicates               []predicate.ExtractJob
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	category_name  *string
+	period         *string
+	amount         *money.Amount
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	profile        *uuid.UUID
+	clearedprofile bool
+	alerts         map[uuid.UUID]struct{}
+	removedalerts  map[uuid.UUID]struct{}
+	clearedalerts  bool
+	done           bool
+	oldValue       func(context.Context) (*Budget, error)
+	predicates     []predicate.Budget
 }
 
-var _ ent.Mutation = (*ExtractJobMutation)(nil)
+var _ ent.Mutation = (*BudgetMutation)(nil)
 
-// extractjobOption allows management of the mutation configuration using functional options.
-type extractjobOption func(*ExtractJobMutation)
+// budgetOption allows management of the mutation configuration using functional options.
+type budgetOption func(*BudgetMutation)
 
-// newExtractJobMutation creates new mutation for the ExtractJob entity.
-func newExtractJobMutation(c config, op Op, opts ...extractjobOption) *ExtractJobMutation {
-	m := &ExtractJobMutation{
+// newBudgetMutation creates new mutation for the Budget entity.
+func newBudgetMutation(c config, op Op, opts ...budgetOption) *BudgetMutation {
+	m := &BudgetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExtractJob,
+		typ:           TypeBudget,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -86,20 +98,20 @@ func newExtractJobMutation(c config, op Op, opts ...extractjobOption) *ExtractJo
 	return m
 }
 
-// withExtractJobID sets the ID field of the mutation.
-func withExtractJobID(id uuid.UUID) extractjobOption {
-	return func(m *ExtractJobMutation) {
+// withBudgetID sets the ID field of the mutation.
+func withBudgetID(id uuid.UUID) budgetOption {
+	return func(m *BudgetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExtractJob
+			value *Budget
 		)
-		m.oldValue = func(ctx context.Context) (*ExtractJob, error) {
+		m.oldValue = func(ctx context.Context) (*Budget, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExtractJob.Get(ctx, id)
+					value, err = m.Client().Budget.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -108,10 +120,10 @@ func withExtractJobID(id uuid.UUID) extractjobOption {
 	}
 }
 
-// withExtractJob sets the old ExtractJob of the mutation.
-func withExtractJob(node *ExtractJob) extractjobOption {
-	return func(m *ExtractJobMutation) {
-		m.oldValue = func(context.Context) (*ExtractJob, error) {
+// withBudget sets the old Budget of the mutation.
+func withBudget(node *Budget) budgetOption {
+	return func(m *BudgetMutation) {
+		m.oldValue = func(context.Context) (*Budget, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -120,7 +132,7 @@ func withExtractJob(node *ExtractJob) extractjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExtractJobMutation) Client() *Client {
+func (m BudgetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -128,7 +140,7 @@ func (m ExtractJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExtractJobMutation) Tx() (*Tx, error) {
+func (m BudgetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -138,14 +150,14 @@ func (m ExtractJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ExtractJob entities.
-func (m *ExtractJobMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Budget entities.
+func (m *BudgetMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExtractJobMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BudgetMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -156,7 +168,7 @@ func (m *ExtractJobMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExtractJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BudgetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -165,55 +177,19 @@ func (m *ExtractJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExtractJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Budget.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFileID sets the "file_id" field.
-func (m *ExtractJobMutation) SetFileID(u uuid.UUID) {
-	m.file = &u
-}
-
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *ExtractJobMutation) FileID() (r uuid.UUID, exists bool) {
-	v := m.file
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFileID returns the old "file_id" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldFileID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
-	}
-	return oldValue.FileID, nil
-}
-
-// ResetFileID resets all changes to the "file_id" field.
-func (m *ExtractJobMutation) ResetFileID() {
-	m.file = nil
-}
-
 // SetProfileID sets the "profile_id" field.
-func (m *ExtractJobMutation) SetProfileID(u uuid.UUID) {
+func (m *BudgetMutation) SetProfileID(u uuid.UUID) {
 	m.profile = &u
 }
 
 // ProfileID returns the value of the "profile_id" field in the mutation.
-func (m *ExtractJobMutation) ProfileID() (r uuid.UUID, exists bool) {
+func (m *BudgetMutation) ProfileID() (r uuid.UUID, exists bool) {
 	v := m.profile
 	if v == nil {
 		return
@@ -221,10 +197,10 @@ func (m *ExtractJobMutation) ProfileID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldProfileID returns the old "profile_id" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileID returns the old "profile_id" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BudgetMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
 	}
@@ -239,702 +215,1122 @@ func (m *ExtractJobMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err
 }
 
 // ResetProfileID resets all changes to the "profile_id" field.
-func (m *ExtractJobMutation) ResetProfileID() {
+func (m *BudgetMutation) ResetProfileID() {
 	m.profile = nil
 }
 
-// SetReceiptID sets the "receipt_id" field.
-func (m *ExtractJobMutation) SetReceiptID(u uuid.UUID) {
-	m.receipt = &u
+// SetCategoryName sets the "category_name" field.
+func (m *BudgetMutation) SetCategoryName(s string) {
+	m.category_name = &s
 }
 
-// ReceiptID returns the value of the "receipt_id" field in the mutation.
-func (m *ExtractJobMutation) ReceiptID() (r uuid.UUID, exists bool) {
-	v := m.receipt
+// CategoryName returns the value of the "category_name" field in the mutation.
+func (m *BudgetMutation) CategoryName() (r string, exists bool) {
+	v := m.category_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReceiptID returns the old "receipt_id" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCategoryName returns the old "category_name" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldReceiptID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *BudgetMutation) OldCategoryName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategoryName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiptID requires an ID field in the mutation")
+		return v, errors.New("OldCategoryName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategoryName: %w", err)
 	}
-	return oldValue.ReceiptID, nil
-}
-
-// ClearReceiptID clears the value of the "receipt_id" field.
-func (m *ExtractJobMutation) ClearReceiptID() {
-	m.receipt = nil
-	m.clearedFields[extractjob.FieldReceiptID] = struct{}{}
-}
-
-// ReceiptIDCleared returns if the "receipt_id" field was cleared in this mutation.
-func (m *ExtractJobMutation) ReceiptIDCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldReceiptID]
-	return ok
+	return oldValue.CategoryName, nil
 }
 
-// ResetReceiptID resets all changes to the "receipt_id" field.
-func (m *ExtractJobMutation) ResetReceiptID() {
-	m.receipt = nil
-	delete(m.clearedFields, extractjob.FieldReceiptID)
+// ResetCategoryName resets all changes to the "category_name" field.
+func (m *BudgetMutation) ResetCategoryName() {
+	m.category_name = nil
 }
 
-// SetFormat sets the "format" field.
-func (m *ExtractJobMutation) SetFormat(s string) {
-	m.format = &s
+// SetPeriod sets the "period" field.
+func (m *BudgetMutation) SetPeriod(s string) {
+	m.period = &s
 }
 
-// Format returns the value of the "format" field in the mutation.
-func (m *ExtractJobMutation) Format() (r string, exists bool) {
-	v := m.format
+// Period returns the value of the "period" field in the mutation.
+func (m *BudgetMutation) Period() (r string, exists bool) {
+	v := m.period
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFormat returns the old "format" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriod returns the old "period" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldFormat(ctx context.Context) (v string, err error) {
+func (m *BudgetMutation) OldPeriod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormat requires an ID field in the mutation")
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
 	}
-	return oldValue.Format, nil
+	return oldValue.Period, nil
 }
 
-// ResetFormat resets all changes to the "format" field.
-func (m *ExtractJobMutation) ResetFormat() {
-	m.format = nil
+// ResetPeriod resets all changes to the "period" field.
+func (m *BudgetMutation) ResetPeriod() {
+	m.period = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *ExtractJobMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetAmount sets the "amount" field.
+func (m *BudgetMutation) SetAmount(value money.Amount) {
+	m.amount = &value
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *ExtractJobMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Amount returns the value of the "amount" field in the mutation.
+func (m *BudgetMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BudgetMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.Amount, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *ExtractJobMutation) ResetStartedAt() {
-	m.started_at = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *BudgetMutation) ResetAmount() {
+	m.amount = nil
 }
 
-// SetFinishedAt sets the "finished_at" field.
-func (m *ExtractJobMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *BudgetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *ExtractJobMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BudgetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinishedAt returns the old "finished_at" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *BudgetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.FinishedAt, nil
-}
-
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *ExtractJobMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[extractjob.FieldFinishedAt] = struct{}{}
-}
-
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *ExtractJobMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldFinishedAt]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *ExtractJobMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, extractjob.FieldFinishedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BudgetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *ExtractJobMutation) SetStatus(s string) {
-	m.status = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BudgetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ExtractJobMutation) Status() (r string, exists bool) {
-	v := m.status
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BudgetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldStatus(ctx context.Context) (v *string, err error) {
+func (m *BudgetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *ExtractJobMutation) ClearStatus() {
-	m.status = nil
-	m.clearedFields[extractjob.FieldStatus] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BudgetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *ExtractJobMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldStatus]
-	return ok
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *BudgetMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[budget.FieldProfileID] = struct{}{}
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ExtractJobMutation) ResetStatus() {
-	m.status = nil
-	delete(m.clearedFields, extractjob.FieldStatus)
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *BudgetMutation) ProfileCleared() bool {
+	return m.clearedprofile
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *ExtractJobMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *BudgetMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *ExtractJobMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetProfile resets all changes to the "profile" edge.
+func (m *BudgetMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+// AddAlertIDs adds the "alerts" edge to the BudgetAlert entity by ids.
+func (m *BudgetMutation) AddAlertIDs(ids ...uuid.UUID) {
+	if m.alerts == nil {
+		m.alerts = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	for i := range ids {
+		m.alerts[ids[i]] = struct{}{}
 	}
-	return oldValue.ErrorMessage, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *ExtractJobMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[extractjob.FieldErrorMessage] = struct{}{}
+// ClearAlerts clears the "alerts" edge to the BudgetAlert entity.
+func (m *BudgetMutation) ClearAlerts() {
+	m.clearedalerts = true
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *ExtractJobMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldErrorMessage]
-	return ok
+// AlertsCleared reports if the "alerts" edge to the BudgetAlert entity was cleared.
+func (m *BudgetMutation) AlertsCleared() bool {
+	return m.clearedalerts
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *ExtractJobMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, extractjob.FieldErrorMessage)
+// RemoveAlertIDs removes the "alerts" edge to the BudgetAlert entity by IDs.
+func (m *BudgetMutation) RemoveAlertIDs(ids ...uuid.UUID) {
+	if m.removedalerts == nil {
+		m.removedalerts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.alerts, ids[i])
+		m.removedalerts[ids[i]] = struct{}{}
+	}
 }
 
-// SetExtractionConfidence sets the "extraction_confidence" field.
-func (m *ExtractJobMutation) SetExtractionConfidence(f float32) {
-	m.extraction_confidence = &f
-	m.addextraction_confidence = nil
+// RemovedAlerts returns the removed IDs of the "alerts" edge to the BudgetAlert entity.
+func (m *BudgetMutation) RemovedAlertsIDs() (ids []uuid.UUID) {
+	for id := range m.removedalerts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ExtractionConfidence returns the value of the "extraction_confidence" field in the mutation.
-func (m *ExtractJobMutation) ExtractionConfidence() (r float32, exists bool) {
-	v := m.extraction_confidence
-	if v == nil {
-		return
+// AlertsIDs returns the "alerts" edge IDs in the mutation.
+func (m *BudgetMutation) AlertsIDs() (ids []uuid.UUID) {
+	for id := range m.alerts {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldExtractionConfidence returns the old "extraction_confidence" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldExtractionConfidence(ctx context.Context) (v *float32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtractionConfidence is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtractionConfidence requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtractionConfidence: %w", err)
-	}
-	return oldValue.ExtractionConfidence, nil
+// ResetAlerts resets all changes to the "alerts" edge.
+func (m *BudgetMutation) ResetAlerts() {
+	m.alerts = nil
+	m.clearedalerts = false
+	m.removedalerts = nil
 }
 
-// AddExtractionConfidence adds f to the "extraction_confidence" field.
-func (m *ExtractJobMutation) AddExtractionConfidence(f float32) {
-	if m.addextraction_confidence != nil {
-		*m.addextraction_confidence += f
-	} else {
-		m.addextraction_confidence = &f
-	}
+// Where appends a list predicates to the BudgetMutation builder.
+func (m *BudgetMutation) Where(ps ...predicate.Budget) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedExtractionConfidence returns the value that was added to the "extraction_confidence" field in this mutation.
-func (m *ExtractJobMutation) AddedExtractionConfidence() (r float32, exists bool) {
-	v := m.addextraction_confidence
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the BudgetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BudgetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Budget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ClearExtractionConfidence clears the value of the "extraction_confidence" field.
-func (m *ExtractJobMutation) ClearExtractionConfidence() {
-	m.extraction_confidence = nil
-	m.addextraction_confidence = nil
-	m.clearedFields[extractjob.FieldExtractionConfidence] = struct{}{}
+// Op returns the operation name.
+func (m *BudgetMutation) Op() Op {
+	return m.op
 }
 
-// ExtractionConfidenceCleared returns if the "extraction_confidence" field was cleared in this mutation.
-func (m *ExtractJobMutation) ExtractionConfidenceCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldExtractionConfidence]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *BudgetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetExtractionConfidence resets all changes to the "extraction_confidence" field.
-func (m *ExtractJobMutation) ResetExtractionConfidence() {
-	m.extraction_confidence = nil
-	m.addextraction_confidence = nil
-	delete(m.clearedFields, extractjob.FieldExtractionConfidence)
+// Type returns the node type of this mutation (Budget).
+func (m *BudgetMutation) Type() string {
+	return m.typ
 }
 
-// SetNeedsReview sets the "needs_review" field.
-func (m *ExtractJobMutation) SetNeedsReview(b bool) {
-	m.needs_review = &b
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BudgetMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.profile != nil {
+		fields = append(fields, budget.FieldProfileID)
+	}
+	if m.category_name != nil {
+		fields = append(fields, budget.FieldCategoryName)
+	}
+	if m.period != nil {
+		fields = append(fields, budget.FieldPeriod)
+	}
+	if m.amount != nil {
+		fields = append(fields, budget.FieldAmount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, budget.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, budget.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// NeedsReview returns the value of the "needs_review" field in the mutation.
-func (m *ExtractJobMutation) NeedsReview() (r bool, exists bool) {
-	v := m.needs_review
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BudgetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case budget.FieldProfileID:
+		return m.ProfileID()
+	case budget.FieldCategoryName:
+		return m.CategoryName()
+	case budget.FieldPeriod:
+		return m.Period()
+	case budget.FieldAmount:
+		return m.Amount()
+	case budget.FieldCreatedAt:
+		return m.CreatedAt()
+	case budget.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldNeedsReview returns the old "needs_review" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldNeedsReview(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNeedsReview is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNeedsReview requires an ID field in the mutation")
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BudgetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case budget.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case budget.FieldCategoryName:
+		return m.OldCategoryName(ctx)
+	case budget.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case budget.FieldAmount:
+		return m.OldAmount(ctx)
+	case budget.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case budget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNeedsReview: %w", err)
+	return nil, fmt.Errorf("unknown Budget field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BudgetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case budget.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case budget.FieldCategoryName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryName(v)
+		return nil
+	case budget.FieldPeriod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case budget.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case budget.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case budget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.NeedsReview, nil
+	return fmt.Errorf("unknown Budget field %s", name)
 }
 
-// ResetNeedsReview resets all changes to the "needs_review" field.
-func (m *ExtractJobMutation) ResetNeedsReview() {
-	m.needs_review = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BudgetMutation) AddedFields() []string {
+	return nil
 }
 
-// SetOcrText sets the "ocr_text" field.
-func (m *ExtractJobMutation) SetOcrText(s string) {
-	m.ocr_text = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BudgetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OcrText returns the value of the "ocr_text" field in the mutation.
-func (m *ExtractJobMutation) OcrText() (r string, exists bool) {
-	v := m.ocr_text
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BudgetMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Budget numeric field %s", name)
 }
 
-// OldOcrText returns the old "ocr_text" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldOcrText(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOcrText is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOcrText requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOcrText: %w", err)
-	}
-	return oldValue.OcrText, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BudgetMutation) ClearedFields() []string {
+	return nil
 }
 
-// ClearOcrText clears the value of the "ocr_text" field.
-func (m *ExtractJobMutation) ClearOcrText() {
-	m.ocr_text = nil
-	m.clearedFields[extractjob.FieldOcrText] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BudgetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// OcrTextCleared returns if the "ocr_text" field was cleared in this mutation.
-func (m *ExtractJobMutation) OcrTextCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldOcrText]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BudgetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Budget nullable field %s", name)
 }
 
-// ResetOcrText resets all changes to the "ocr_text" field.
-func (m *ExtractJobMutation) ResetOcrText() {
-	m.ocr_text = nil
-	delete(m.clearedFields, extractjob.FieldOcrText)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BudgetMutation) ResetField(name string) error {
+	switch name {
+	case budget.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case budget.FieldCategoryName:
+		m.ResetCategoryName()
+		return nil
+	case budget.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	case budget.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case budget.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case budget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Budget field %s", name)
 }
 
-// SetExtractedJSON sets the "extracted_json" field.
-func (m *ExtractJobMutation) SetExtractedJSON(jm json.RawMessage) {
-	m.extracted_json = &jm
-	m.appendextracted_json = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BudgetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, budget.EdgeProfile)
+	}
+	if m.alerts != nil {
+		edges = append(edges, budget.EdgeAlerts)
+	}
+	return edges
 }
 
-// ExtractedJSON returns the value of the "extracted_json" field in the mutation.
-func (m *ExtractJobMutation) ExtractedJSON() (r json.RawMessage, exists bool) {
-	v := m.extracted_json
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BudgetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case budget.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case budget.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.alerts))
+		for id := range m.alerts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldExtractedJSON returns the old "extracted_json" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldExtractedJSON(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExtractedJSON is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BudgetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedalerts != nil {
+		edges = append(edges, budget.EdgeAlerts)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExtractedJSON requires an ID field in the mutation")
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BudgetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case budget.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.removedalerts))
+		for id := range m.removedalerts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExtractedJSON: %w", err)
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BudgetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, budget.EdgeProfile)
 	}
-	return oldValue.ExtractedJSON, nil
+	if m.clearedalerts {
+		edges = append(edges, budget.EdgeAlerts)
+	}
+	return edges
 }
 
-// AppendExtractedJSON adds jm to the "extracted_json" field.
-func (m *ExtractJobMutation) AppendExtractedJSON(jm json.RawMessage) {
-	m.appendextracted_json = append(m.appendextracted_json, jm...)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BudgetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case budget.EdgeProfile:
+		return m.clearedprofile
+	case budget.EdgeAlerts:
+		return m.clearedalerts
+	}
+	return false
 }
 
-// AppendedExtractedJSON returns the list of values that were appended to the "extracted_json" field in this mutation.
-func (m *ExtractJobMutation) AppendedExtractedJSON() (json.RawMessage, bool) {
-	if len(m.appendextracted_json) == 0 {
-		return nil, false
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BudgetMutation) ClearEdge(name string) error {
+	switch name {
+	case budget.EdgeProfile:
+		m.ClearProfile()
+		return nil
 	}
-	return m.appendextracted_json, true
+	return fmt.Errorf("unknown Budget unique edge %s", name)
 }
 
-// ClearExtractedJSON clears the value of the "extracted_json" field.
-func (m *ExtractJobMutation) ClearExtractedJSON() {
-	m.extracted_json = nil
-	m.appendextracted_json = nil
-	m.clearedFields[extractjob.FieldExtractedJSON] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BudgetMutation) ResetEdge(name string) error {
+	switch name {
+	case budget.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case budget.EdgeAlerts:
+		m.ResetAlerts()
+		return nil
+	}
+	return fmt.Errorf("unknown Budget edge %s", name)
 }
 
-// ExtractedJSONCleared returns if the "extracted_json" field was cleared in this mutation.
-func (m *ExtractJobMutation) ExtractedJSONCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldExtractedJSON]
-	return ok
+// BudgetAlertMutation represents an operation that mutates the BudgetAlert nodes in the graph.
+type BudgetAlertMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	profile_id    *uuid.UUID
+	period_start  *time.Time
+	threshold     *int
+	addthreshold  *int
+	spent         *money.Amount
+	budget_amount *money.Amount
+	receipt_id    *uuid.UUID
+	created_at    *time.Time
+	delivered_at  *time.Time
+	clearedFields map[string]struct{}
+	budget        *uuid.UUID
+	clearedbudget bool
+	done          bool
+	oldValue      func(context.Context) (*BudgetAlert, error)
+	predicates    []predicate.BudgetAlert
+}
+
+var _ ent.Mutation = (*BudgetAlertMutation)(nil)
+
+// budgetalertOption allows management of the mutation configuration using functional options.
+type budgetalertOption func(*BudgetAlertMutation)
+
+// newBudgetAlertMutation creates new mutation for the BudgetAlert entity.
+func newBudgetAlertMutation(c config, op Op, opts ...budgetalertOption) *BudgetAlertMutation {
+	m := &BudgetAlertMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBudgetAlert,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetExtractedJSON resets all changes to the "extracted_json" field.
-func (m *ExtractJobMutation) ResetExtractedJSON() {
-	m.extracted_json = nil
-	m.appendextracted_json = nil
-	delete(m.clearedFields, extractjob.FieldExtractedJSON)
+// withBudgetAlertID sets the ID field of the mutation.
+func withBudgetAlertID(id uuid.UUID) budgetalertOption {
+	return func(m *BudgetAlertMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BudgetAlert
+		)
+		m.oldValue = func(ctx context.Context) (*BudgetAlert, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BudgetAlert.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetModelName sets the "model_name" field.
-func (m *ExtractJobMutation) SetModelName(s string) {
-	m.model_name = &s
+// withBudgetAlert sets the old BudgetAlert of the mutation.
+func withBudgetAlert(node *BudgetAlert) budgetalertOption {
+	return func(m *BudgetAlertMutation) {
+		m.oldValue = func(context.Context) (*BudgetAlert, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ModelName returns the value of the "model_name" field in the mutation.
-func (m *ExtractJobMutation) ModelName() (r string, exists bool) {
-	v := m.model_name
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BudgetAlertMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BudgetAlertMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BudgetAlert entities.
+func (m *BudgetAlertMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BudgetAlertMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BudgetAlertMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BudgetAlert.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (m *BudgetAlertMutation) SetBudgetID(u uuid.UUID) {
+	m.budget = &u
+}
+
+// BudgetID returns the value of the "budget_id" field in the mutation.
+func (m *BudgetAlertMutation) BudgetID() (r uuid.UUID, exists bool) {
+	v := m.budget
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelName returns the old "model_name" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldBudgetID returns the old "budget_id" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldModelName(ctx context.Context) (v *string, err error) {
+func (m *BudgetAlertMutation) OldBudgetID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBudgetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelName requires an ID field in the mutation")
+		return v, errors.New("OldBudgetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBudgetID: %w", err)
 	}
-	return oldValue.ModelName, nil
-}
-
-// ClearModelName clears the value of the "model_name" field.
-func (m *ExtractJobMutation) ClearModelName() {
-	m.model_name = nil
-	m.clearedFields[extractjob.FieldModelName] = struct{}{}
-}
-
-// ModelNameCleared returns if the "model_name" field was cleared in this mutation.
-func (m *ExtractJobMutation) ModelNameCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldModelName]
-	return ok
+	return oldValue.BudgetID, nil
 }
 
-// ResetModelName resets all changes to the "model_name" field.
-func (m *ExtractJobMutation) ResetModelName() {
-	m.model_name = nil
-	delete(m.clearedFields, extractjob.FieldModelName)
+// ResetBudgetID resets all changes to the "budget_id" field.
+func (m *BudgetAlertMutation) ResetBudgetID() {
+	m.budget = nil
 }
 
-// SetModelParams sets the "model_params" field.
-func (m *ExtractJobMutation) SetModelParams(jm json.RawMessage) {
-	m.model_params = &jm
-	m.appendmodel_params = nil
+// SetProfileID sets the "profile_id" field.
+func (m *BudgetAlertMutation) SetProfileID(u uuid.UUID) {
+	m.profile_id = &u
 }
 
-// ModelParams returns the value of the "model_params" field in the mutation.
-func (m *ExtractJobMutation) ModelParams() (r json.RawMessage, exists bool) {
-	v := m.model_params
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *BudgetAlertMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelParams returns the old "model_params" field's value of the ExtractJob entity.
-// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileID returns the old "profile_id" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExtractJobMutation) OldModelParams(ctx context.Context) (v json.RawMessage, err error) {
+func (m *BudgetAlertMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelParams is only allowed on UpdateOne operations")
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelParams requires an ID field in the mutation")
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelParams: %w", err)
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
 	}
-	return oldValue.ModelParams, nil
+	return oldValue.ProfileID, nil
 }
 
-// AppendModelParams adds jm to the "model_params" field.
-func (m *ExtractJobMutation) AppendModelParams(jm json.RawMessage) {
-	m.appendmodel_params = append(m.appendmodel_params, jm...)
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *BudgetAlertMutation) ResetProfileID() {
+	m.profile_id = nil
 }
 
-// AppendedModelParams returns the list of values that were appended to the "model_params" field in this mutation.
-func (m *ExtractJobMutation) AppendedModelParams() (json.RawMessage, bool) {
-	if len(m.appendmodel_params) == 0 {
-		return nil, false
-	}
-	return m.appendmodel_params, true
+// SetPeriodStart sets the "period_start" field.
+func (m *BudgetAlertMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
 }
 
-// ClearModelParams clears the value of the "model_params" field.
-func (m *ExtractJobMutation) ClearModelParams() {
-	m.model_params = nil
-	m.appendmodel_params = nil
-	m.clearedFields[extractjob.FieldModelParams] = struct{}{}
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *BudgetAlertMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ModelParamsCleared returns if the "model_params" field was cleared in this mutation.
-func (m *ExtractJobMutation) ModelParamsCleared() bool {
-	_, ok := m.clearedFields[extractjob.FieldModelParams]
+// OldPeriodStart returns the old "period_start" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *BudgetAlertMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *BudgetAlertMutation) SetThreshold(i int) {
+	m.threshold = &i
+	m.addthreshold = nil
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *BudgetAlertMutation) Threshold() (r int, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// AddThreshold adds i to the "threshold" field.
+func (m *BudgetAlertMutation) AddThreshold(i int) {
+	if m.addthreshold != nil {
+		*m.addthreshold += i
+	} else {
+		m.addthreshold = &i
+	}
+}
+
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *BudgetAlertMutation) AddedThreshold() (r int, exists bool) {
+	v := m.addthreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *BudgetAlertMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+}
+
+// SetSpent sets the "spent" field.
+func (m *BudgetAlertMutation) SetSpent(value money.Amount) {
+	m.spent = &value
+}
+
+// Spent returns the value of the "spent" field in the mutation.
+func (m *BudgetAlertMutation) Spent() (r money.Amount, exists bool) {
+	v := m.spent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpent returns the old "spent" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldSpent(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpent: %w", err)
+	}
+	return oldValue.Spent, nil
+}
+
+// ResetSpent resets all changes to the "spent" field.
+func (m *BudgetAlertMutation) ResetSpent() {
+	m.spent = nil
+}
+
+// SetBudgetAmount sets the "budget_amount" field.
+func (m *BudgetAlertMutation) SetBudgetAmount(value money.Amount) {
+	m.budget_amount = &value
+}
+
+// BudgetAmount returns the value of the "budget_amount" field in the mutation.
+func (m *BudgetAlertMutation) BudgetAmount() (r money.Amount, exists bool) {
+	v := m.budget_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBudgetAmount returns the old "budget_amount" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldBudgetAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBudgetAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBudgetAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudgetAmount: %w", err)
+	}
+	return oldValue.BudgetAmount, nil
+}
+
+// ResetBudgetAmount resets all changes to the "budget_amount" field.
+func (m *BudgetAlertMutation) ResetBudgetAmount() {
+	m.budget_amount = nil
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (m *BudgetAlertMutation) SetReceiptID(u uuid.UUID) {
+	m.receipt_id = &u
+}
+
+// ReceiptID returns the value of the "receipt_id" field in the mutation.
+func (m *BudgetAlertMutation) ReceiptID() (r uuid.UUID, exists bool) {
+	v := m.receipt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiptID returns the old "receipt_id" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldReceiptID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
+	}
+	return oldValue.ReceiptID, nil
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (m *BudgetAlertMutation) ClearReceiptID() {
+	m.receipt_id = nil
+	m.clearedFields[budgetalert.FieldReceiptID] = struct{}{}
+}
+
+// ReceiptIDCleared returns if the "receipt_id" field was cleared in this mutation.
+func (m *BudgetAlertMutation) ReceiptIDCleared() bool {
+	_, ok := m.clearedFields[budgetalert.FieldReceiptID]
 	return ok
 }
 
-// ResetModelParams resets all changes to the "model_params" field.
-func (m *ExtractJobMutation) ResetModelParams() {
-	m.model_params = nil
-	m.appendmodel_params = nil
-	delete(m.clearedFields, extractjob.FieldModelParams)
+// ResetReceiptID resets all changes to the "receipt_id" field.
+func (m *BudgetAlertMutation) ResetReceiptID() {
+	m.receipt_id = nil
+	delete(m.clearedFields, budgetalert.FieldReceiptID)
 }
 
-// ClearFile clears the "file" edge to the ReceiptFile entity.
-func (m *ExtractJobMutation) ClearFile() {
-	m.clearedfile = true
-	m.clearedFields[extractjob.FieldFileID] = struct{}{}
+// SetCreatedAt sets the "created_at" field.
+func (m *BudgetAlertMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FileCleared reports if the "file" edge to the ReceiptFile entity was cleared.
-func (m *ExtractJobMutation) FileCleared() bool {
-	return m.clearedfile
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BudgetAlertMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FileIDs returns the "file" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FileID instead. It exists only for internal usage by the builders.
-func (m *ExtractJobMutation) FileIDs() (ids []uuid.UUID) {
-	if id := m.file; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetFile resets all changes to the "file" edge.
-func (m *ExtractJobMutation) ResetFile() {
-	m.file = nil
-	m.clearedfile = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BudgetAlertMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearProfile clears the "profile" edge to the Profile entity.
-func (m *ExtractJobMutation) ClearProfile() {
-	m.clearedprofile = true
-	m.clearedFields[extractjob.FieldProfileID] = struct{}{}
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *BudgetAlertMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
-func (m *ExtractJobMutation) ProfileCleared() bool {
-	return m.clearedprofile
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *BudgetAlertMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProfileIDs returns the "profile" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProfileID instead. It exists only for internal usage by the builders.
-func (m *ExtractJobMutation) ProfileIDs() (ids []uuid.UUID) {
-	if id := m.profile; id != nil {
-		ids = append(ids, *id)
+// OldDeliveredAt returns the old "delivered_at" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetProfile resets all changes to the "profile" edge.
-func (m *ExtractJobMutation) ResetProfile() {
-	m.profile = nil
-	m.clearedprofile = false
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *BudgetAlertMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[budgetalert.FieldDeliveredAt] = struct{}{}
 }
 
-// ClearReceipt clears the "receipt" edge to the Receipt entity.
-func (m *ExtractJobMutation) ClearReceipt() {
-	m.clearedreceipt = true
-	m.clearedFields[extractjob.FieldReceiptID] = struct{}{}
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *BudgetAlertMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[budgetalert.FieldDeliveredAt]
+	return ok
 }
 
-// ReceiptCleared reports if the "receipt" edge to the Receipt entity was cleared.
-func (m *ExtractJobMutation) ReceiptCleared() bool {
-	return m.ReceiptIDCleared() || m.clearedreceipt
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *BudgetAlertMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, budgetalert.FieldDeliveredAt)
 }
 
-// ReceiptIDs returns the "receipt" edge IDs in the mutation.
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (m *BudgetAlertMutation) ClearBudget() {
+	m.clearedbudget = true
+	m.clearedFields[budgetalert.FieldBudgetID] = struct{}{}
+}
+
+// BudgetCleared reports if the "budget" edge to the Budget entity was cleared.
+func (m *BudgetAlertMutation) BudgetCleared() bool {
+	return m.clearedbudget
+}
+
+// BudgetIDs returns the "budget" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReceiptID instead. It exists only for internal usage by the builders.
-func (m *ExtractJobMutation) ReceiptIDs() (ids []uuid.UUID) {
-	if id := m.receipt; id != nil {
+// BudgetID instead. It exists only for internal usage by the builders.
+func (m *BudgetAlertMutation) BudgetIDs() (ids []uuid.UUID) {
+	if id := m.budget; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetReceipt resets all changes to the "receipt" edge.
-func (m *ExtractJobMutation) ResetReceipt() {
-	m.receipt = nil
-	m.clearedreceipt = false
+// ResetBudget resets all changes to the "budget" edge.
+func (m *BudgetAlertMutation) ResetBudget() {
+	m.budget = nil
+	m.clearedbudget = false
 }
 
-// Where appends a list predicates to the ExtractJobMutation builder.
-func (m *ExtractJobMutation) Where(ps ...predicate.ExtractJob) {
+// Where appends a list predicates to the BudgetAlertMutation builder.
+func (m *BudgetAlertMutation) Where(ps ...predicate.BudgetAlert) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExtractJobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BudgetAlertMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExtractJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExtractJob, len(ps))
+func (m *BudgetAlertMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BudgetAlert, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -942,66 +1338,51 @@ func (m *ExtractJobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExtractJobMutation) Op() Op {
+func (m *BudgetAlertMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExtractJobMutation) SetOp(op Op) {
+func (m *BudgetAlertMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ExtractJob).
-func (m *ExtractJobMutation) Type() string {
+// Type returns the node type of this mutation (BudgetAlert).
+func (m *BudgetAlertMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ExtractJobMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.file != nil {
-		fields = append(fields, extractjob.FieldFileID)
-	}
-	if m.profile != nil {
-		fields = append(fields, extractjob.FieldProfileID)
-	}
-	if m.receipt != nil {
-		fields = append(fields, extractjob.FieldReceiptID)
-	}
-	if m.format != nil {
-		fields = append(fields, extractjob.FieldFormat)
+func (m *BudgetAlertMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.budget != nil {
+		fields = append(fields, budgetalert.FieldBudgetID)
 	}
-	if m.started_at != nil {
-		fields = append(fields, extractjob.FieldStartedAt)
+	if m.profile_id != nil {
+		fields = append(fields, budgetalert.FieldProfileID)
 	}
-	if m.finished_at != nil {
-		fields = append(fields, extractjob.FieldFinishedAt)
+	if m.period_start != nil {
+		fields = append(fields, budgetalert.FieldPeriodStart)
 	}
-	if m.status != nil {
-		fields = append(fields, extractjob.FieldStatus)
+	if m.threshold != nil {
+		fields = append(fields, budgetalert.FieldThreshold)
 	}
-	if m.error_message != nil {
-		fields = append(fields, extractjob.FieldErrorMessage)
+	if m.spent != nil {
+		fields = append(fields, budgetalert.FieldSpent)
 	}
-	if m.extraction_confidence != nil {
-		fields = append(fields, extractjob.FieldExtractionConfidence)
+	if m.budget_amount != nil {
+		fields = append(fields, budgetalert.FieldBudgetAmount)
 	}
-	if m.needs_review != nil {
-		fields = append(fields, extractjob.FieldNeedsReview)
+	if m.receipt_id != nil {
+		fields = append(fields, budgetalert.FieldReceiptID)
 	}
-	if m.ocr_text != nil {
-		fields = append(fields, extractjob.FieldOcrText)
+	if m.created_at != nil {
+		fields = append(fields, budgetalert.FieldCreatedAt)
 	}
-	if m.extracted_json != nil {
-		fields = append(fields, extractjob.FieldExtractedJSON)
-	}
-	if m.model_name != nil {
-		fields = append(fields, extractjob.FieldModelName)
-	}
-	if m.model_params != nil {
-		fields = append(fields, extractjob.FieldModelParams)
+	if m.delivered_at != nil {
+		fields = append(fields, budgetalert.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -1009,36 +1390,26 @@ func (m *ExtractJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExtractJobMutation) Field(name string) (ent.Value, bool) {
+func (m *BudgetAlertMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case extractjob.FieldFileID:
-		return m.FileID()
-	case extractjob.FieldProfileID:
+	case budgetalert.FieldBudgetID:
+		return m.BudgetID()
+	case budgetalert.FieldProfileID:
 		return m.ProfileID()
-	case extractjob.FieldReceiptID:
+	case budgetalert.FieldPeriodStart:
+		return m.PeriodStart()
+	case budgetalert.FieldThreshold:
+		return m.Threshold()
+	case budgetalert.FieldSpent:
+		return m.Spent()
+	case budgetalert.FieldBudgetAmount:
+		return m.BudgetAmount()
+	case budgetalert.FieldReceiptID:
 		return m.ReceiptID()
-	case extractjob.FieldFormat:
-		return m.Format()
-	case extractjob.FieldStartedAt:
-		return m.StartedAt()
-	case extractjob.FieldFinishedAt:
-		return m.FinishedAt()
-	case extractjob.FieldStatus:
-		return m.Status()
-	case extractjob.FieldErrorMessage:
-		return m.ErrorMessage()
-	case extractjob.FieldExtractionConfidence:
-		return m.ExtractionConfidence()
-	case extractjob.FieldNeedsReview:
-		return m.NeedsReview()
-	case extractjob.FieldOcrText:
-		return m.OcrText()
-	case extractjob.FieldExtractedJSON:
-		return m.ExtractedJSON()
-	case extractjob.FieldModelName:
-		return m.ModelName()
-	case extractjob.FieldModelParams:
-		return m.ModelParams()
+	case budgetalert.FieldCreatedAt:
+		return m.CreatedAt()
+	case budgetalert.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -1046,153 +1417,108 @@ func (m *ExtractJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExtractJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BudgetAlertMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case extractjob.FieldFileID:
-		return m.OldFileID(ctx)
-	case extractjob.FieldProfileID:
+	case budgetalert.FieldBudgetID:
+		return m.OldBudgetID(ctx)
+	case budgetalert.FieldProfileID:
 		return m.OldProfileID(ctx)
-	case extractjob.FieldReceiptID:
+	case budgetalert.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case budgetalert.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case budgetalert.FieldSpent:
+		return m.OldSpent(ctx)
+	case budgetalert.FieldBudgetAmount:
+		return m.OldBudgetAmount(ctx)
+	case budgetalert.FieldReceiptID:
 		return m.OldReceiptID(ctx)
-	case extractjob.FieldFormat:
-		return m.OldFormat(ctx)
-	case extractjob.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case extractjob.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
-	case extractjob.FieldStatus:
-		return m.OldStatus(ctx)
-	case extractjob.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case extractjob.FieldExtractionConfidence:
-		return m.OldExtractionConfidence(ctx)
-	case extractjob.FieldNeedsReview:
-		return m.OldNeedsReview(ctx)
-	case extractjob.FieldOcrText:
-		return m.OldOcrText(ctx)
-	case extractjob.FieldExtractedJSON:
-		return m.OldExtractedJSON(ctx)
-	case extractjob.FieldModelName:
-		return m.OldModelName(ctx)
-	case extractjob.FieldModelParams:
-		return m.OldModelParams(ctx)
+	case budgetalert.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case budgetalert.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExtractJob field %s", name)
+	return nil, fmt.Errorf("unknown BudgetAlert field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtractJobMutation) SetField(name string, value ent.Value) error {
+func (m *BudgetAlertMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case extractjob.FieldFileID:
+	case budgetalert.FieldBudgetID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileID(v)
+		m.SetBudgetID(v)
 		return nil
-	case extractjob.FieldProfileID:
+	case budgetalert.FieldProfileID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProfileID(v)
 		return nil
-	case extractjob.FieldReceiptID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReceiptID(v)
-		return nil
-	case extractjob.FieldFormat:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFormat(v)
-		return nil
-	case extractjob.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case extractjob.FieldFinishedAt:
+	case budgetalert.FieldPeriodStart:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFinishedAt(v)
-		return nil
-	case extractjob.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case extractjob.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
+		m.SetPeriodStart(v)
 		return nil
-	case extractjob.FieldExtractionConfidence:
-		v, ok := value.(float32)
+	case budgetalert.FieldThreshold:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExtractionConfidence(v)
+		m.SetThreshold(v)
 		return nil
-	case extractjob.FieldNeedsReview:
-		v, ok := value.(bool)
+	case budgetalert.FieldSpent:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNeedsReview(v)
+		m.SetSpent(v)
 		return nil
-	case extractjob.FieldOcrText:
-		v, ok := value.(string)
+	case budgetalert.FieldBudgetAmount:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOcrText(v)
+		m.SetBudgetAmount(v)
 		return nil
-	case extractjob.FieldExtractedJSON:
-		v, ok := value.(json.RawMessage)
+	case budgetalert.FieldReceiptID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExtractedJSON(v)
+		m.SetReceiptID(v)
 		return nil
-	case extractjob.FieldModelName:
-		v, ok := value.(string)
+	case budgetalert.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case extractjob.FieldModelParams:
-		v, ok := value.(json.RawMessage)
+	case budgetalert.FieldDeliveredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelParams(v)
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob field %s", name)
+	return fmt.Errorf("unknown BudgetAlert field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExtractJobMutation) AddedFields() []string {
+func (m *BudgetAlertMutation) AddedFields() []string {
 	var fields []string
-	if m.addextraction_confidence != nil {
-		fields = append(fields, extractjob.FieldExtractionConfidence)
+	if m.addthreshold != nil {
+		fields = append(fields, budgetalert.FieldThreshold)
 	}
 	return fields
 }
@@ -1200,10 +1526,10 @@ func (m *ExtractJobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExtractJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BudgetAlertMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case extractjob.FieldExtractionConfidence:
-		return m.AddedExtractionConfidence()
+	case budgetalert.FieldThreshold:
+		return m.AddedThreshold()
 	}
 	return nil, false
 }
@@ -1211,174 +1537,103 @@ func (m *ExtractJobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExtractJobMutation) AddField(name string, value ent.Value) error {
+func (m *BudgetAlertMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case extractjob.FieldExtractionConfidence:
-		v, ok := value.(float32)
+	case budgetalert.FieldThreshold:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExtractionConfidence(v)
+		m.AddThreshold(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob numeric field %s", name)
+	return fmt.Errorf("unknown BudgetAlert numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExtractJobMutation) ClearedFields() []string {
+func (m *BudgetAlertMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(extractjob.FieldReceiptID) {
-		fields = append(fields, extractjob.FieldReceiptID)
-	}
-	if m.FieldCleared(extractjob.FieldFinishedAt) {
-		fields = append(fields, extractjob.FieldFinishedAt)
-	}
-	if m.FieldCleared(extractjob.FieldStatus) {
-		fields = append(fields, extractjob.FieldStatus)
-	}
-	if m.FieldCleared(extractjob.FieldErrorMessage) {
-		fields = append(fields, extractjob.FieldErrorMessage)
-	}
-	if m.FieldCleared(extractjob.FieldExtractionConfidence) {
-		fields = append(fields, extractjob.FieldExtractionConfidence)
-	}
-	if m.FieldCleared(extractjob.FieldOcrText) {
-		fields = append(fields, extractjob.FieldOcrText)
-	}
-	if m.FieldCleared(extractjob.FieldExtractedJSON) {
-		fields = append(fields, extractjob.FieldExtractedJSON)
+	if m.FieldCleared(budgetalert.FieldReceiptID) {
+		fields = append(fields, budgetalert.FieldReceiptID)
 	}
-	if m.FieldCleared(extractjob.FieldModelName) {
-		fields = append(fields, extractjob.FieldModelName)
-	}
-	if m.FieldCleared(extractjob.FieldModelParams) {
-		fields = append(fields, extractjob.FieldModelParams)
+	if m.FieldCleared(budgetalert.FieldDeliveredAt) {
+		fields = append(fields, budgetalert.FieldDeliveredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExtractJobMutation) FieldCleared(name string) bool {
+func (m *BudgetAlertMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExtractJobMutation) ClearField(name string) error {
+func (m *BudgetAlertMutation) ClearField(name string) error {
 	switch name {
-	case extractjob.FieldReceiptID:
+	case budgetalert.FieldReceiptID:
 		m.ClearReceiptID()
 		return nil
-	case extractjob.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
-	case extractjob.FieldStatus:
-		m.ClearStatus()
-		return nil
-	case extractjob.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case extractjob.FieldExtractionConfidence:
-		m.ClearExtractionConfidence()
-		return nil
-	case extractjob.FieldOcrText:
-		m.ClearOcrText()
-		return nil
-	case extractjob.FieldExtractedJSON:
-		m.ClearExtractedJSON()
-		return nil
-	case extractjob.FieldModelName:
-		m.ClearModelName()
-		return nil
-	case extractjob.FieldModelParams:
-		m.ClearModelParams()
+	case budgetalert.FieldDeliveredAt:
+		m.ClearDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob nullable field %s", name)
+	return fmt.Errorf("unknown BudgetAlert nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExtractJobMutation) ResetField(name string) error {
+func (m *BudgetAlertMutation) ResetField(name string) error {
 	switch name {
-	case extractjob.FieldFileID:
-		m.ResetFileID()
+	case budgetalert.FieldBudgetID:
+		m.ResetBudgetID()
 		return nil
-	case extractjob.FieldProfileID:
+	case budgetalert.FieldProfileID:
 		m.ResetProfileID()
 		return nil
-	case extractjob.FieldReceiptID:
-		m.ResetReceiptID()
-		return nil
-	case extractjob.FieldFormat:
-		m.ResetFormat()
-		return nil
-	case extractjob.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case extractjob.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
-	case extractjob.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case extractjob.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case budgetalert.FieldPeriodStart:
+		m.ResetPeriodStart()
 		return nil
-	case extractjob.FieldExtractionConfidence:
-		m.ResetExtractionConfidence()
+	case budgetalert.FieldThreshold:
+		m.ResetThreshold()
 		return nil
-	case extractjob.FieldNeedsReview:
-		m.ResetNeedsReview()
+	case budgetalert.FieldSpent:
+		m.ResetSpent()
 		return nil
-	case extractjob.FieldOcrText:
-		m.ResetOcrText()
+	case budgetalert.FieldBudgetAmount:
+		m.ResetBudgetAmount()
 		return nil
-	case extractjob.FieldExtractedJSON:
-		m.ResetExtractedJSON()
+	case budgetalert.FieldReceiptID:
+		m.ResetReceiptID()
 		return nil
-	case extractjob.FieldModelName:
-		m.ResetModelName()
+	case budgetalert.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case extractjob.FieldModelParams:
-		m.ResetModelParams()
+	case budgetalert.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob field %s", name)
+	return fmt.Errorf("unknown BudgetAlert field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExtractJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.file != nil {
-		edges = append(edges, extractjob.EdgeFile)
-	}
-	if m.profile != nil {
-		edges = append(edges, extractjob.EdgeProfile)
-	}
-	if m.receipt != nil {
-		edges = append(edges, extractjob.EdgeReceipt)
+func (m *BudgetAlertMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.budget != nil {
+		edges = append(edges, budgetalert.EdgeBudget)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExtractJobMutation) AddedIDs(name string) []ent.Value {
+func (m *BudgetAlertMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case extractjob.EdgeFile:
-		if id := m.file; id != nil {
-			return []ent.Value{*id}
-		}
-	case extractjob.EdgeProfile:
-		if id := m.profile; id != nil {
-			return []ent.Value{*id}
-		}
-	case extractjob.EdgeReceipt:
-		if id := m.receipt; id != nil {
+	case budgetalert.EdgeBudget:
+		if id := m.budget; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1386,118 +1641,91 @@ func (m *ExtractJobMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExtractJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *BudgetAlertMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExtractJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *BudgetAlertMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExtractJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfile {
-		edges = append(edges, extractjob.EdgeFile)
-	}
-	if m.clearedprofile {
-		edges = append(edges, extractjob.EdgeProfile)
-	}
-	if m.clearedreceipt {
-		edges = append(edges, extractjob.EdgeReceipt)
+func (m *BudgetAlertMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbudget {
+		edges = append(edges, budgetalert.EdgeBudget)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExtractJobMutation) EdgeCleared(name string) bool {
+func (m *BudgetAlertMutation) EdgeCleared(name string) bool {
 	switch name {
-	case extractjob.EdgeFile:
-		return m.clearedfile
-	case extractjob.EdgeProfile:
-		return m.clearedprofile
-	case extractjob.EdgeReceipt:
-		return m.clearedreceipt
+	case budgetalert.EdgeBudget:
+		return m.clearedbudget
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExtractJobMutation) ClearEdge(name string) error {
+func (m *BudgetAlertMutation) ClearEdge(name string) error {
 	switch name {
-	case extractjob.EdgeFile:
-		m.ClearFile()
-		return nil
-	case extractjob.EdgeProfile:
-		m.ClearProfile()
-		return nil
-	case extractjob.EdgeReceipt:
-		m.ClearReceipt()
+	case budgetalert.EdgeBudget:
+		m.ClearBudget()
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob unique edge %s", name)
+	return fmt.Errorf("unknown BudgetAlert unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExtractJobMutation) ResetEdge(name string) error {
+func (m *BudgetAlertMutation) ResetEdge(name string) error {
 	switch name {
-	case extractjob.EdgeFile:
-		m.ResetFile()
-		return nil
-	case extractjob.EdgeProfile:
-		m.ResetProfile()
-		return nil
-	case extractjob.EdgeReceipt:
-		m.ResetReceipt()
+	case budgetalert.EdgeBudget:
+		m.ResetBudget()
 		return nil
 	}
-	return fmt.Errorf("unknown ExtractJob edge %s", name)
+	return fmt.Errorf("unknown BudgetAlert edge %s", name)
 }
 
-// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
-type ProfileMutation struct {
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	job_title        *string
-	job_description  *string
-	default_currency *string
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	receipts         map[uuid.UUID]struct{}
-	removedreceipts  map[uuid.UUID]struct{}
-	clearedreceipts  bool
-	files            map[uuid.UUID]struct{}
-	removedfiles     map[uuid.UUID]struct{}
-	clearedfiles     bool
-	jobs             map[uuid.UUID]struct{}
-	removedjobs      map[uuid.UUID]struct{}
-	clearedjobs      bool
-	done             bool
-	oldValue         func(context.Context) (*Profile, error)
-	predicates       []predicate.Profile
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	description    *string
+	synonyms       *[]string
+	appendsynonyms []string
+	tax_line       *string
+	account        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	profile        *uuid.UUID
+	clearedprofile bool
+	done           bool
+	oldValue       func(context.Context) (*Category, error)
+	predicates     []predicate.Category
 }
 
-var _ ent.Mutation = (*ProfileMutation)(nil)
+var _ ent.Mutation = (*CategoryMutation)(nil)
 
-// profileOption allows management of the mutation configuration using functional options.
-type profileOption func(*ProfileMutation)
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
 
-// newProfileMutation creates new mutation for the Profile entity.
-func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
-	m := &ProfileMutation{
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfile,
+		typ:           TypeCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1506,20 +1734,20 @@ func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation
 	return m
 }
 
-// withProfileID sets the ID field of the mutation.
-func withProfileID(id uuid.UUID) profileOption {
-	return func(m *ProfileMutation) {
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id uuid.UUID) categoryOption {
+	return func(m *CategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Profile
+			value *Category
 		)
-		m.oldValue = func(ctx context.Context) (*Profile, error) {
+		m.oldValue = func(ctx context.Context) (*Category, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Profile.Get(ctx, id)
+					value, err = m.Client().Category.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1528,10 +1756,10 @@ func withProfileID(id uuid.UUID) profileOption {
 	}
 }
 
-// withProfile sets the old Profile of the mutation.
-func withProfile(node *Profile) profileOption {
-	return func(m *ProfileMutation) {
-		m.oldValue = func(context.Context) (*Profile, error) {
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1540,7 +1768,7 @@ func withProfile(node *Profile) profileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfileMutation) Client() *Client {
+func (m CategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1548,7 +1776,7 @@ func (m ProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfileMutation) Tx() (*Tx, error) {
+func (m CategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1558,14 +1786,14 @@ func (m ProfileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Profile entities.
-func (m *ProfileMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Category entities.
+func (m *CategoryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CategoryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1576,7 +1804,7 @@ func (m *ProfileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CategoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1585,19 +1813,55 @@ func (m *ProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetProfileID sets the "profile_id" field.
+func (m *CategoryMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *CategoryMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *CategoryMutation) ResetProfileID() {
+	m.profile = nil
+}
+
 // SetName sets the "name" field.
-func (m *ProfileMutation) SetName(s string) {
+func (m *CategoryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ProfileMutation) Name() (r string, exists bool) {
+func (m *CategoryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1605,10 +1869,10 @@ func (m *ProfileMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1623,151 +1887,229 @@ func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ProfileMutation) ResetName() {
+func (m *CategoryMutation) ResetName() {
 	m.name = nil
 }
 
-// SetJobTitle sets the "job_title" field.
-func (m *ProfileMutation) SetJobTitle(s string) {
-	m.job_title = &s
+// SetDescription sets the "description" field.
+func (m *CategoryMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// JobTitle returns the value of the "job_title" field in the mutation.
-func (m *ProfileMutation) JobTitle() (r string, exists bool) {
-	v := m.job_title
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobTitle returns the old "job_title" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldJobTitle(ctx context.Context) (v *string, err error) {
+func (m *CategoryMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobTitle requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.JobTitle, nil
+	return oldValue.Description, nil
 }
 
-// ClearJobTitle clears the value of the "job_title" field.
-func (m *ProfileMutation) ClearJobTitle() {
-	m.job_title = nil
-	m.clearedFields[profile.FieldJobTitle] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *CategoryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[category.FieldDescription] = struct{}{}
 }
 
-// JobTitleCleared returns if the "job_title" field was cleared in this mutation.
-func (m *ProfileMutation) JobTitleCleared() bool {
-	_, ok := m.clearedFields[profile.FieldJobTitle]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[category.FieldDescription]
 	return ok
 }
 
-// ResetJobTitle resets all changes to the "job_title" field.
-func (m *ProfileMutation) ResetJobTitle() {
-	m.job_title = nil
-	delete(m.clearedFields, profile.FieldJobTitle)
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, category.FieldDescription)
 }
 
-// SetJobDescription sets the "job_description" field.
-func (m *ProfileMutation) SetJobDescription(s string) {
-	m.job_description = &s
+// SetSynonyms sets the "synonyms" field.
+func (m *CategoryMutation) SetSynonyms(s []string) {
+	m.synonyms = &s
+	m.appendsynonyms = nil
 }
 
-// JobDescription returns the value of the "job_description" field in the mutation.
-func (m *ProfileMutation) JobDescription() (r string, exists bool) {
-	v := m.job_description
+// Synonyms returns the value of the "synonyms" field in the mutation.
+func (m *CategoryMutation) Synonyms() (r []string, exists bool) {
+	v := m.synonyms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJobDescription returns the old "job_description" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldSynonyms returns the old "synonyms" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldJobDescription(ctx context.Context) (v *string, err error) {
+func (m *CategoryMutation) OldSynonyms(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSynonyms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobDescription requires an ID field in the mutation")
+		return v, errors.New("OldSynonyms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSynonyms: %w", err)
 	}
-	return oldValue.JobDescription, nil
+	return oldValue.Synonyms, nil
 }
 
-// ClearJobDescription clears the value of the "job_description" field.
-func (m *ProfileMutation) ClearJobDescription() {
-	m.job_description = nil
-	m.clearedFields[profile.FieldJobDescription] = struct{}{}
+// AppendSynonyms adds s to the "synonyms" field.
+func (m *CategoryMutation) AppendSynonyms(s []string) {
+	m.appendsynonyms = append(m.appendsynonyms, s...)
 }
 
-// JobDescriptionCleared returns if the "job_description" field was cleared in this mutation.
-func (m *ProfileMutation) JobDescriptionCleared() bool {
-	_, ok := m.clearedFields[profile.FieldJobDescription]
+// AppendedSynonyms returns the list of values that were appended to the "synonyms" field in this mutation.
+func (m *CategoryMutation) AppendedSynonyms() ([]string, bool) {
+	if len(m.appendsynonyms) == 0 {
+		return nil, false
+	}
+	return m.appendsynonyms, true
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (m *CategoryMutation) ClearSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	m.clearedFields[category.FieldSynonyms] = struct{}{}
+}
+
+// SynonymsCleared returns if the "synonyms" field was cleared in this mutation.
+func (m *CategoryMutation) SynonymsCleared() bool {
+	_, ok := m.clearedFields[category.FieldSynonyms]
 	return ok
 }
 
-// ResetJobDescription resets all changes to the "job_description" field.
-func (m *ProfileMutation) ResetJobDescription() {
-	m.job_description = nil
-	delete(m.clearedFields, profile.FieldJobDescription)
+// ResetSynonyms resets all changes to the "synonyms" field.
+func (m *CategoryMutation) ResetSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	delete(m.clearedFields, category.FieldSynonyms)
 }
 
-// SetDefaultCurrency sets the "default_currency" field.
-func (m *ProfileMutation) SetDefaultCurrency(s string) {
-	m.default_currency = &s
+// SetTaxLine sets the "tax_line" field.
+func (m *CategoryMutation) SetTaxLine(s string) {
+	m.tax_line = &s
 }
 
-// DefaultCurrency returns the value of the "default_currency" field in the mutation.
-func (m *ProfileMutation) DefaultCurrency() (r string, exists bool) {
-	v := m.default_currency
+// TaxLine returns the value of the "tax_line" field in the mutation.
+func (m *CategoryMutation) TaxLine() (r string, exists bool) {
+	v := m.tax_line
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDefaultCurrency returns the old "default_currency" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxLine returns the old "tax_line" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldDefaultCurrency(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldTaxLine(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDefaultCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaxLine is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDefaultCurrency requires an ID field in the mutation")
+		return v, errors.New("OldTaxLine requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDefaultCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxLine: %w", err)
 	}
-	return oldValue.DefaultCurrency, nil
+	return oldValue.TaxLine, nil
 }
 
-// ResetDefaultCurrency resets all changes to the "default_currency" field.
-func (m *ProfileMutation) ResetDefaultCurrency() {
-	m.default_currency = nil
+// ClearTaxLine clears the value of the "tax_line" field.
+func (m *CategoryMutation) ClearTaxLine() {
+	m.tax_line = nil
+	m.clearedFields[category.FieldTaxLine] = struct{}{}
+}
+
+// TaxLineCleared returns if the "tax_line" field was cleared in this mutation.
+func (m *CategoryMutation) TaxLineCleared() bool {
+	_, ok := m.clearedFields[category.FieldTaxLine]
+	return ok
+}
+
+// ResetTaxLine resets all changes to the "tax_line" field.
+func (m *CategoryMutation) ResetTaxLine() {
+	m.tax_line = nil
+	delete(m.clearedFields, category.FieldTaxLine)
+}
+
+// SetAccount sets the "account" field.
+func (m *CategoryMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *CategoryMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldAccount(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ClearAccount clears the value of the "account" field.
+func (m *CategoryMutation) ClearAccount() {
+	m.account = nil
+	m.clearedFields[category.FieldAccount] = struct{}{}
+}
+
+// AccountCleared returns if the "account" field was cleared in this mutation.
+func (m *CategoryMutation) AccountCleared() bool {
+	_, ok := m.clearedFields[category.FieldAccount]
+	return ok
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *CategoryMutation) ResetAccount() {
+	m.account = nil
+	delete(m.clearedFields, category.FieldAccount)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+func (m *CategoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1775,10 +2117,10 @@ func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1793,17 +2135,17 @@ func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfileMutation) ResetCreatedAt() {
+func (m *CategoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1811,10 +2153,10 @@ func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1829,181 +2171,46 @@ func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfileMutation) ResetUpdatedAt() {
+func (m *CategoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddReceiptIDs adds the "receipts" edge to the Receipt entity by ids.
-func (m *ProfileMutation) AddReceiptIDs(ids ...uuid.UUID) {
-	if m.receipts == nil {
-		m.receipts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.receipts[ids[i]] = struct{}{}
-	}
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *CategoryMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[category.FieldProfileID] = struct{}{}
 }
 
-// ClearReceipts clears the "receipts" edge to the Receipt entity.
-func (m *ProfileMutation) ClearReceipts() {
-	m.clearedreceipts = true
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *CategoryMutation) ProfileCleared() bool {
+	return m.clearedprofile
 }
 
-// ReceiptsCleared reports if the "receipts" edge to the Receipt entity was cleared.
-func (m *ProfileMutation) ReceiptsCleared() bool {
-	return m.clearedreceipts
-}
-
-// RemoveReceiptIDs removes the "receipts" edge to the Receipt entity by IDs.
-func (m *ProfileMutation) RemoveReceiptIDs(ids ...uuid.UUID) {
-	if m.removedreceipts == nil {
-		m.removedreceipts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.receipts, ids[i])
-		m.removedreceipts[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedReceipts returns the removed IDs of the "receipts" edge to the Receipt entity.
-func (m *ProfileMutation) RemovedReceiptsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreceipts {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ReceiptsIDs returns the "receipts" edge IDs in the mutation.
-func (m *ProfileMutation) ReceiptsIDs() (ids []uuid.UUID) {
-	for id := range m.receipts {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetReceipts resets all changes to the "receipts" edge.
-func (m *ProfileMutation) ResetReceipts() {
-	m.receipts = nil
-	m.clearedreceipts = false
-	m.removedreceipts = nil
-}
-
-// AddFileIDs adds the "files" edge to the ReceiptFile entity by ids.
-func (m *ProfileMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFiles clears the "files" edge to the ReceiptFile entity.
-func (m *ProfileMutation) ClearFiles() {
-	m.clearedfiles = true
-}
-
-// FilesCleared reports if the "files" edge to the ReceiptFile entity was cleared.
-func (m *ProfileMutation) FilesCleared() bool {
-	return m.clearedfiles
-}
-
-// RemoveFileIDs removes the "files" edge to the ReceiptFile entity by IDs.
-func (m *ProfileMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFiles returns the removed IDs of the "files" edge to the ReceiptFile entity.
-func (m *ProfileMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *ProfileMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetFiles resets all changes to the "files" edge.
-func (m *ProfileMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
-}
-
-// AddJobIDs adds the "jobs" edge to the ExtractJob entity by ids.
-func (m *ProfileMutation) AddJobIDs(ids ...uuid.UUID) {
-	if m.jobs == nil {
-		m.jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.jobs[ids[i]] = struct{}{}
-	}
-}
-
-// ClearJobs clears the "jobs" edge to the ExtractJob entity.
-func (m *ProfileMutation) ClearJobs() {
-	m.clearedjobs = true
-}
-
-// JobsCleared reports if the "jobs" edge to the ExtractJob entity was cleared.
-func (m *ProfileMutation) JobsCleared() bool {
-	return m.clearedjobs
-}
-
-// RemoveJobIDs removes the "jobs" edge to the ExtractJob entity by IDs.
-func (m *ProfileMutation) RemoveJobIDs(ids ...uuid.UUID) {
-	if m.removedjobs == nil {
-		m.removedjobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.jobs, ids[i])
-		m.removedjobs[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedJobs returns the removed IDs of the "jobs" edge to the ExtractJob entity.
-func (m *ProfileMutation) RemovedJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedjobs {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *ProfileMutation) JobsIDs() (ids []uuid.UUID) {
-	for id := range m.jobs {
-		ids = append(ids, id)
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetJobs resets all changes to the "jobs" edge.
-func (m *ProfileMutation) ResetJobs() {
-	m.jobs = nil
-	m.clearedjobs = false
-	m.removedjobs = nil
+// ResetProfile resets all changes to the "profile" edge.
+func (m *CategoryMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
 }
 
-// Where appends a list predicates to the ProfileMutation builder.
-func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Profile, len(ps))
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2011,42 +2218,48 @@ func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProfileMutation) Op() Op {
+func (m *CategoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProfileMutation) SetOp(op Op) {
+func (m *CategoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Profile).
-func (m *ProfileMutation) Type() string {
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProfileMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.profile != nil {
+		fields = append(fields, category.FieldProfileID)
+	}
 	if m.name != nil {
-		fields = append(fields, profile.FieldName)
+		fields = append(fields, category.FieldName)
 	}
-	if m.job_title != nil {
-		fields = append(fields, profile.FieldJobTitle)
+	if m.description != nil {
+		fields = append(fields, category.FieldDescription)
 	}
-	if m.job_description != nil {
-		fields = append(fields, profile.FieldJobDescription)
+	if m.synonyms != nil {
+		fields = append(fields, category.FieldSynonyms)
 	}
-	if m.default_currency != nil {
-		fields = append(fields, profile.FieldDefaultCurrency)
+	if m.tax_line != nil {
+		fields = append(fields, category.FieldTaxLine)
+	}
+	if m.account != nil {
+		fields = append(fields, category.FieldAccount)
 	}
 	if m.created_at != nil {
-		fields = append(fields, profile.FieldCreatedAt)
+		fields = append(fields, category.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, profile.FieldUpdatedAt)
+		fields = append(fields, category.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2054,19 +2267,23 @@ func (m *ProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case profile.FieldName:
+	case category.FieldProfileID:
+		return m.ProfileID()
+	case category.FieldName:
 		return m.Name()
-	case profile.FieldJobTitle:
-		return m.JobTitle()
-	case profile.FieldJobDescription:
-		return m.JobDescription()
-	case profile.FieldDefaultCurrency:
-		return m.DefaultCurrency()
-	case profile.FieldCreatedAt:
+	case category.FieldDescription:
+		return m.Description()
+	case category.FieldSynonyms:
+		return m.Synonyms()
+	case category.FieldTaxLine:
+		return m.TaxLine()
+	case category.FieldAccount:
+		return m.Account()
+	case category.FieldCreatedAt:
 		return m.CreatedAt()
-	case profile.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2075,65 +2292,83 @@ func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case profile.FieldName:
+	case category.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case category.FieldName:
 		return m.OldName(ctx)
-	case profile.FieldJobTitle:
-		return m.OldJobTitle(ctx)
-	case profile.FieldJobDescription:
-		return m.OldJobDescription(ctx)
-	case profile.FieldDefaultCurrency:
-		return m.OldDefaultCurrency(ctx)
-	case profile.FieldCreatedAt:
+	case category.FieldDescription:
+		return m.OldDescription(ctx)
+	case category.FieldSynonyms:
+		return m.OldSynonyms(ctx)
+	case category.FieldTaxLine:
+		return m.OldTaxLine(ctx)
+	case category.FieldAccount:
+		return m.OldAccount(ctx)
+	case category.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case profile.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Profile field %s", name)
+	return nil, fmt.Errorf("unknown Category field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profile.FieldName:
+	case category.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case category.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case profile.FieldJobTitle:
+	case category.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJobTitle(v)
+		m.SetDescription(v)
 		return nil
-	case profile.FieldJobDescription:
+	case category.FieldSynonyms:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSynonyms(v)
+		return nil
+	case category.FieldTaxLine:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJobDescription(v)
+		m.SetTaxLine(v)
 		return nil
-	case profile.FieldDefaultCurrency:
+	case category.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultCurrency(v)
+		m.SetAccount(v)
 		return nil
-	case profile.FieldCreatedAt:
+	case category.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case profile.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2141,274 +2376,214 @@ func (m *ProfileMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProfileMutation) AddedFields() []string {
+func (m *CategoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Profile numeric field %s", name)
+	return fmt.Errorf("unknown Category numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfileMutation) ClearedFields() []string {
+func (m *CategoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(profile.FieldJobTitle) {
-		fields = append(fields, profile.FieldJobTitle)
+	if m.FieldCleared(category.FieldDescription) {
+		fields = append(fields, category.FieldDescription)
 	}
-	if m.FieldCleared(profile.FieldJobDescription) {
-		fields = append(fields, profile.FieldJobDescription)
+	if m.FieldCleared(category.FieldSynonyms) {
+		fields = append(fields, category.FieldSynonyms)
+	}
+	if m.FieldCleared(category.FieldTaxLine) {
+		fields = append(fields, category.FieldTaxLine)
+	}
+	if m.FieldCleared(category.FieldAccount) {
+		fields = append(fields, category.FieldAccount)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfileMutation) FieldCleared(name string) bool {
+func (m *CategoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfileMutation) ClearField(name string) error {
+func (m *CategoryMutation) ClearField(name string) error {
 	switch name {
-	case profile.FieldJobTitle:
-		m.ClearJobTitle()
+	case category.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case profile.FieldJobDescription:
-		m.ClearJobDescription()
+	case category.FieldSynonyms:
+		m.ClearSynonyms()
+		return nil
+	case category.FieldTaxLine:
+		m.ClearTaxLine()
+		return nil
+	case category.FieldAccount:
+		m.ClearAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile nullable field %s", name)
+	return fmt.Errorf("unknown Category nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfileMutation) ResetField(name string) error {
+func (m *CategoryMutation) ResetField(name string) error {
 	switch name {
-	case profile.FieldName:
+	case category.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case category.FieldName:
 		m.ResetName()
 		return nil
-	case profile.FieldJobTitle:
-		m.ResetJobTitle()
+	case category.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case profile.FieldJobDescription:
-		m.ResetJobDescription()
+	case category.FieldSynonyms:
+		m.ResetSynonyms()
 		return nil
-	case profile.FieldDefaultCurrency:
-		m.ResetDefaultCurrency()
+	case category.FieldTaxLine:
+		m.ResetTaxLine()
 		return nil
-	case profile.FieldCreatedAt:
+	case category.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case category.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case profile.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.receipts != nil {
-		edges = append(edges, profile.EdgeReceipts)
-	}
-	if m.files != nil {
-		edges = append(edges, profile.EdgeFiles)
-	}
-	if m.jobs != nil {
-		edges = append(edges, profile.EdgeJobs)
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, category.EdgeProfile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case profile.EdgeReceipts:
-		ids := make([]ent.Value, 0, len(m.receipts))
-		for id := range m.receipts {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.jobs))
-		for id := range m.jobs {
-			ids = append(ids, id)
+	case category.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedreceipts != nil {
-		edges = append(edges, profile.EdgeReceipts)
-	}
-	if m.removedfiles != nil {
-		edges = append(edges, profile.EdgeFiles)
-	}
-	if m.removedjobs != nil {
-		edges = append(edges, profile.EdgeJobs)
-	}
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case profile.EdgeReceipts:
-		ids := make([]ent.Value, 0, len(m.removedreceipts))
-		for id := range m.removedreceipts {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case profile.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.removedjobs))
-		for id := range m.removedjobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedreceipts {
-		edges = append(edges, profile.EdgeReceipts)
-	}
-	if m.clearedfiles {
-		edges = append(edges, profile.EdgeFiles)
-	}
-	if m.clearedjobs {
-		edges = append(edges, profile.EdgeJobs)
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, category.EdgeProfile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfileMutation) EdgeCleared(name string) bool {
+func (m *CategoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case profile.EdgeReceipts:
-		return m.clearedreceipts
-	case profile.EdgeFiles:
-		return m.clearedfiles
-	case profile.EdgeJobs:
-		return m.clearedjobs
+	case category.EdgeProfile:
+		return m.clearedprofile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfileMutation) ClearEdge(name string) error {
+func (m *CategoryMutation) ClearEdge(name string) error {
 	switch name {
+	case category.EdgeProfile:
+		m.ClearProfile()
+		return nil
 	}
-	return fmt.Errorf("unknown Profile unique edge %s", name)
+	return fmt.Errorf("unknown Category unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfileMutation) ResetEdge(name string) error {
+func (m *CategoryMutation) ResetEdge(name string) error {
 	switch name {
-	case profile.EdgeReceipts:
-		m.ResetReceipts()
-		return nil
-	case profile.EdgeFiles:
-		m.ResetFiles()
-		return nil
-	case profile.EdgeJobs:
-		m.ResetJobs()
+	case category.EdgeProfile:
+		m.ResetProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile edge %s", name)
+	return fmt.Errorf("unknown Category edge %s", name)
 }
 
-// ReceiptMutation represents an operation that mutates the Receipt nodes in the graph.
-type ReceiptMutation struct {
+// CategoryCorrectionMutation represents an operation that mutates the CategoryCorrection nodes in the graph.
+type CategoryCorrectionMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *uuid.UUID
-	file_id        *uuid.UUID
+	receipt_id     *uuid.UUID
 	merchant_name  *string
-	tx_date        *time.Time
-	subtotal       *float64
-	addsubtotal    *float64
-	tax            *float64
-	addtax         *float64
-	total          *float64
-	addtotal       *float64
-	currency_code  *string
-	category_name  *string
 	description    *string
-	file_path      *string
-	is_current     *bool
+	from_category  *string
+	to_category    *string
 	created_at     *time.Time
-	updated_at     *time.Time
 	clearedFields  map[string]struct{}
 	profile        *uuid.UUID
 	clearedprofile bool
-	files          map[uuid.UUID]struct{}
-	removedfiles   map[uuid.UUID]struct{}
-	clearedfiles   bool
-	jobs           map[uuid.UUID]struct{}
-	removedjobs    map[uuid.UUID]struct{}
-	clearedjobs    bool
 	done           bool
-	oldValue       func(context.Context) (*Receipt, error)
-	predicates     []predicate.Receipt
+	oldValue       func(context.Context) (*CategoryCorrection, error)
+	predicates     []predicate.CategoryCorrection
 }
 
-var _ ent.Mutation = (*ReceiptMutation)(nil)
+var _ ent.Mutation = (*CategoryCorrectionMutation)(nil)
 
-// receiptOption allows management of the mutation configuration using functional options.
-type receiptOption func(*ReceiptMutation)
+// categorycorrectionOption allows management of the mutation configuration using functional options.
+type categorycorrectionOption func(*CategoryCorrectionMutation)
 
-// newReceiptMutation creates new mutation for the Receipt entity.
-func newReceiptMutation(c config, op Op, opts ...receiptOption) *ReceiptMutation {
-	m := &ReceiptMutation{
+// newCategoryCorrectionMutation creates new mutation for the CategoryCorrection entity.
+func newCategoryCorrectionMutation(c config, op Op, opts ...categorycorrectionOption) *CategoryCorrectionMutation {
+	m := &CategoryCorrectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReceipt,
+		typ:           TypeCategoryCorrection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2417,20 +2592,20 @@ func newReceiptMutation(c config, op Op, opts ...receiptOption) *ReceiptMutation
 	return m
 }
 
-// withReceiptID sets the ID field of the mutation.
-func withReceiptID(id uuid.UUID) receiptOption {
-	return func(m *ReceiptMutation) {
+// withCategoryCorrectionID sets the ID field of the mutation.
+func withCategoryCorrectionID(id uuid.UUID) categorycorrectionOption {
+	return func(m *CategoryCorrectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Receipt
+			value *CategoryCorrection
 		)
-		m.oldValue = func(ctx context.Context) (*Receipt, error) {
+		m.oldValue = func(ctx context.Context) (*CategoryCorrection, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Receipt.Get(ctx, id)
+					value, err = m.Client().CategoryCorrection.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2439,10 +2614,10 @@ func withReceiptID(id uuid.UUID) receiptOption {
 	}
 }
 
-// withReceipt sets the old Receipt of the mutation.
-func withReceipt(node *Receipt) receiptOption {
-	return func(m *ReceiptMutation) {
-		m.oldValue = func(context.Context) (*Receipt, error) {
+// withCategoryCorrection sets the old CategoryCorrection of the mutation.
+func withCategoryCorrection(node *CategoryCorrection) categorycorrectionOption {
+	return func(m *CategoryCorrectionMutation) {
+		m.oldValue = func(context.Context) (*CategoryCorrection, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2451,7 +2626,7 @@ func withReceipt(node *Receipt) receiptOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReceiptMutation) Client() *Client {
+func (m CategoryCorrectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2459,7 +2634,7 @@ func (m ReceiptMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReceiptMutation) Tx() (*Tx, error) {
+func (m CategoryCorrectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2469,14 +2644,14 @@ func (m ReceiptMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Receipt entities.
-func (m *ReceiptMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CategoryCorrection entities.
+func (m *CategoryCorrectionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReceiptMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CategoryCorrectionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2487,7 +2662,7 @@ func (m *ReceiptMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReceiptMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CategoryCorrectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2496,19 +2671,19 @@ func (m *ReceiptMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Receipt.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CategoryCorrection.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetProfileID sets the "profile_id" field.
-func (m *ReceiptMutation) SetProfileID(u uuid.UUID) {
+func (m *CategoryCorrectionMutation) SetProfileID(u uuid.UUID) {
 	m.profile = &u
 }
 
 // ProfileID returns the value of the "profile_id" field in the mutation.
-func (m *ReceiptMutation) ProfileID() (r uuid.UUID, exists bool) {
+func (m *CategoryCorrectionMutation) ProfileID() (r uuid.UUID, exists bool) {
 	v := m.profile
 	if v == nil {
 		return
@@ -2516,10 +2691,10 @@ func (m *ReceiptMutation) ProfileID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldProfileID returns the old "profile_id" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileID returns the old "profile_id" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CategoryCorrectionMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
 	}
@@ -2534,66 +2709,53 @@ func (m *ReceiptMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err er
 }
 
 // ResetProfileID resets all changes to the "profile_id" field.
-func (m *ReceiptMutation) ResetProfileID() {
+func (m *CategoryCorrectionMutation) ResetProfileID() {
 	m.profile = nil
 }
 
-// SetFileID sets the "file_id" field.
-func (m *ReceiptMutation) SetFileID(u uuid.UUID) {
-	m.file_id = &u
+// SetReceiptID sets the "receipt_id" field.
+func (m *CategoryCorrectionMutation) SetReceiptID(u uuid.UUID) {
+	m.receipt_id = &u
 }
 
-// FileID returns the value of the "file_id" field in the mutation.
-func (m *ReceiptMutation) FileID() (r uuid.UUID, exists bool) {
-	v := m.file_id
+// ReceiptID returns the value of the "receipt_id" field in the mutation.
+func (m *CategoryCorrectionMutation) ReceiptID() (r uuid.UUID, exists bool) {
+	v := m.receipt_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileID returns the old "file_id" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldReceiptID returns the old "receipt_id" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldFileID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *CategoryCorrectionMutation) OldReceiptID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileID requires an ID field in the mutation")
+		return v, errors.New("OldReceiptID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
 	}
-	return oldValue.FileID, nil
-}
-
-// ClearFileID clears the value of the "file_id" field.
-func (m *ReceiptMutation) ClearFileID() {
-	m.file_id = nil
-	m.clearedFields[receipt.FieldFileID] = struct{}{}
-}
-
-// FileIDCleared returns if the "file_id" field was cleared in this mutation.
-func (m *ReceiptMutation) FileIDCleared() bool {
-	_, ok := m.clearedFields[receipt.FieldFileID]
-	return ok
+	return oldValue.ReceiptID, nil
 }
 
-// ResetFileID resets all changes to the "file_id" field.
-func (m *ReceiptMutation) ResetFileID() {
-	m.file_id = nil
-	delete(m.clearedFields, receipt.FieldFileID)
+// ResetReceiptID resets all changes to the "receipt_id" field.
+func (m *CategoryCorrectionMutation) ResetReceiptID() {
+	m.receipt_id = nil
 }
 
 // SetMerchantName sets the "merchant_name" field.
-func (m *ReceiptMutation) SetMerchantName(s string) {
+func (m *CategoryCorrectionMutation) SetMerchantName(s string) {
 	m.merchant_name = &s
 }
 
 // MerchantName returns the value of the "merchant_name" field in the mutation.
-func (m *ReceiptMutation) MerchantName() (r string, exists bool) {
+func (m *CategoryCorrectionMutation) MerchantName() (r string, exists bool) {
 	v := m.merchant_name
 	if v == nil {
 		return
@@ -2601,10 +2763,10 @@ func (m *ReceiptMutation) MerchantName() (r string, exists bool) {
 	return *v, true
 }
 
-// OldMerchantName returns the old "merchant_name" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldMerchantName returns the old "merchant_name" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldMerchantName(ctx context.Context) (v string, err error) {
+func (m *CategoryCorrectionMutation) OldMerchantName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMerchantName is only allowed on UpdateOne operations")
 	}
@@ -2619,522 +2781,10767 @@ func (m *ReceiptMutation) OldMerchantName(ctx context.Context) (v string, err er
 }
 
 // ResetMerchantName resets all changes to the "merchant_name" field.
-func (m *ReceiptMutation) ResetMerchantName() {
+func (m *CategoryCorrectionMutation) ResetMerchantName() {
 	m.merchant_name = nil
 }
 
-// SetTxDate sets the "tx_date" field.
-func (m *ReceiptMutation) SetTxDate(t time.Time) {
-	m.tx_date = &t
+// SetDescription sets the "description" field.
+func (m *CategoryCorrectionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// TxDate returns the value of the "tx_date" field in the mutation.
-func (m *ReceiptMutation) TxDate() (r time.Time, exists bool) {
-	v := m.tx_date
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryCorrectionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTxDate returns the old "tx_date" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldTxDate(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryCorrectionMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTxDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTxDate requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTxDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.TxDate, nil
+	return oldValue.Description, nil
 }
 
-// ResetTxDate resets all changes to the "tx_date" field.
-func (m *ReceiptMutation) ResetTxDate() {
-	m.tx_date = nil
+// ClearDescription clears the value of the "description" field.
+func (m *CategoryCorrectionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[categorycorrection.FieldDescription] = struct{}{}
 }
 
-// SetSubtotal sets the "subtotal" field.
-func (m *ReceiptMutation) SetSubtotal(f float64) {
-	m.subtotal = &f
-	m.addsubtotal = nil
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CategoryCorrectionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[categorycorrection.FieldDescription]
+	return ok
 }
 
-// Subtotal returns the value of the "subtotal" field in the mutation.
-func (m *ReceiptMutation) Subtotal() (r float64, exists bool) {
-	v := m.subtotal
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryCorrectionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, categorycorrection.FieldDescription)
+}
+
+// SetFromCategory sets the "from_category" field.
+func (m *CategoryCorrectionMutation) SetFromCategory(s string) {
+	m.from_category = &s
+}
+
+// FromCategory returns the value of the "from_category" field in the mutation.
+func (m *CategoryCorrectionMutation) FromCategory() (r string, exists bool) {
+	v := m.from_category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubtotal returns the old "subtotal" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldFromCategory returns the old "from_category" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldSubtotal(ctx context.Context) (v *float64, err error) {
+func (m *CategoryCorrectionMutation) OldFromCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubtotal is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubtotal requires an ID field in the mutation")
+		return v, errors.New("OldFromCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromCategory: %w", err)
 	}
-	return oldValue.Subtotal, nil
+	return oldValue.FromCategory, nil
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (m *ReceiptMutation) AddSubtotal(f float64) {
-	if m.addsubtotal != nil {
-		*m.addsubtotal += f
-	} else {
-		m.addsubtotal = &f
-	}
+// ResetFromCategory resets all changes to the "from_category" field.
+func (m *CategoryCorrectionMutation) ResetFromCategory() {
+	m.from_category = nil
+}
+
+// SetToCategory sets the "to_category" field.
+func (m *CategoryCorrectionMutation) SetToCategory(s string) {
+	m.to_category = &s
 }
 
-// AddedSubtotal returns the value that was added to the "subtotal" field in this mutation.
-func (m *ReceiptMutation) AddedSubtotal() (r float64, exists bool) {
-	v := m.addsubtotal
+// ToCategory returns the value of the "to_category" field in the mutation.
+func (m *CategoryCorrectionMutation) ToCategory() (r string, exists bool) {
+	v := m.to_category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSubtotal clears the value of the "subtotal" field.
-func (m *ReceiptMutation) ClearSubtotal() {
-	m.subtotal = nil
-	m.addsubtotal = nil
-	m.clearedFields[receipt.FieldSubtotal] = struct{}{}
-}
-
-// SubtotalCleared returns if the "subtotal" field was cleared in this mutation.
-func (m *ReceiptMutation) SubtotalCleared() bool {
-	_, ok := m.clearedFields[receipt.FieldSubtotal]
-	return ok
+// OldToCategory returns the old "to_category" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryCorrectionMutation) OldToCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToCategory: %w", err)
+	}
+	return oldValue.ToCategory, nil
 }
 
-// ResetSubtotal resets all changes to the "subtotal" field.
-func (m *ReceiptMutation) ResetSubtotal() {
-	m.subtotal = nil
-	m.addsubtotal = nil
-	delete(m.clearedFields, receipt.FieldSubtotal)
+// ResetToCategory resets all changes to the "to_category" field.
+func (m *CategoryCorrectionMutation) ResetToCategory() {
+	m.to_category = nil
 }
 
-// SetTax sets the "tax" field.
-func (m *ReceiptMutation) SetTax(f float64) {
-	m.tax = &f
-	m.addtax = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *CategoryCorrectionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Tax returns the value of the "tax" field in the mutation.
-func (m *ReceiptMutation) Tax() (r float64, exists bool) {
-	v := m.tax
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CategoryCorrectionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTax returns the old "tax" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CategoryCorrection entity.
+// If the CategoryCorrection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldTax(ctx context.Context) (v *float64, err error) {
+func (m *CategoryCorrectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTax is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTax requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTax: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CategoryCorrectionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *CategoryCorrectionMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[categorycorrection.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *CategoryCorrectionMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *CategoryCorrectionMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *CategoryCorrectionMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the CategoryCorrectionMutation builder.
+func (m *CategoryCorrectionMutation) Where(ps ...predicate.CategoryCorrection) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryCorrectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryCorrectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CategoryCorrection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryCorrectionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryCorrectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CategoryCorrection).
+func (m *CategoryCorrectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryCorrectionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.profile != nil {
+		fields = append(fields, categorycorrection.FieldProfileID)
+	}
+	if m.receipt_id != nil {
+		fields = append(fields, categorycorrection.FieldReceiptID)
+	}
+	if m.merchant_name != nil {
+		fields = append(fields, categorycorrection.FieldMerchantName)
+	}
+	if m.description != nil {
+		fields = append(fields, categorycorrection.FieldDescription)
+	}
+	if m.from_category != nil {
+		fields = append(fields, categorycorrection.FieldFromCategory)
+	}
+	if m.to_category != nil {
+		fields = append(fields, categorycorrection.FieldToCategory)
+	}
+	if m.created_at != nil {
+		fields = append(fields, categorycorrection.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryCorrectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case categorycorrection.FieldProfileID:
+		return m.ProfileID()
+	case categorycorrection.FieldReceiptID:
+		return m.ReceiptID()
+	case categorycorrection.FieldMerchantName:
+		return m.MerchantName()
+	case categorycorrection.FieldDescription:
+		return m.Description()
+	case categorycorrection.FieldFromCategory:
+		return m.FromCategory()
+	case categorycorrection.FieldToCategory:
+		return m.ToCategory()
+	case categorycorrection.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryCorrectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case categorycorrection.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case categorycorrection.FieldReceiptID:
+		return m.OldReceiptID(ctx)
+	case categorycorrection.FieldMerchantName:
+		return m.OldMerchantName(ctx)
+	case categorycorrection.FieldDescription:
+		return m.OldDescription(ctx)
+	case categorycorrection.FieldFromCategory:
+		return m.OldFromCategory(ctx)
+	case categorycorrection.FieldToCategory:
+		return m.OldToCategory(ctx)
+	case categorycorrection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CategoryCorrection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryCorrectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case categorycorrection.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case categorycorrection.FieldReceiptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiptID(v)
+		return nil
+	case categorycorrection.FieldMerchantName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantName(v)
+		return nil
+	case categorycorrection.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case categorycorrection.FieldFromCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromCategory(v)
+		return nil
+	case categorycorrection.FieldToCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToCategory(v)
+		return nil
+	case categorycorrection.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryCorrection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryCorrectionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryCorrectionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryCorrectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CategoryCorrection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryCorrectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(categorycorrection.FieldDescription) {
+		fields = append(fields, categorycorrection.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryCorrectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryCorrectionMutation) ClearField(name string) error {
+	switch name {
+	case categorycorrection.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryCorrection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryCorrectionMutation) ResetField(name string) error {
+	switch name {
+	case categorycorrection.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case categorycorrection.FieldReceiptID:
+		m.ResetReceiptID()
+		return nil
+	case categorycorrection.FieldMerchantName:
+		m.ResetMerchantName()
+		return nil
+	case categorycorrection.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case categorycorrection.FieldFromCategory:
+		m.ResetFromCategory()
+		return nil
+	case categorycorrection.FieldToCategory:
+		m.ResetToCategory()
+		return nil
+	case categorycorrection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryCorrection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryCorrectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, categorycorrection.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryCorrectionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case categorycorrection.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryCorrectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryCorrectionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryCorrectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, categorycorrection.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryCorrectionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case categorycorrection.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryCorrectionMutation) ClearEdge(name string) error {
+	switch name {
+	case categorycorrection.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryCorrection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryCorrectionMutation) ResetEdge(name string) error {
+	switch name {
+	case categorycorrection.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryCorrection edge %s", name)
+}
+
+// CategoryRuleMutation represents an operation that mutates the CategoryRule nodes in the graph.
+type CategoryRuleMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	priority         *int
+	addpriority      *int
+	enabled          *bool
+	merchant_pattern *string
+	path_pattern     *string
+	keywords         *[]string
+	appendkeywords   []string
+	min_total        *money.Amount
+	max_total        *money.Amount
+	set_category     *string
+	force_review     *bool
+	set_description  *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	profile          *uuid.UUID
+	clearedprofile   bool
+	done             bool
+	oldValue         func(context.Context) (*CategoryRule, error)
+	predicates       []predicate.CategoryRule
+}
+
+var _ ent.Mutation = (*CategoryRuleMutation)(nil)
+
+// categoryruleOption allows management of the mutation configuration using functional options.
+type categoryruleOption func(*CategoryRuleMutation)
+
+// newCategoryRuleMutation creates new mutation for the CategoryRule entity.
+func newCategoryRuleMutation(c config, op Op, opts ...categoryruleOption) *CategoryRuleMutation {
+	m := &CategoryRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategoryRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryRuleID sets the ID field of the mutation.
+func withCategoryRuleID(id uuid.UUID) categoryruleOption {
+	return func(m *CategoryRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CategoryRule
+		)
+		m.oldValue = func(ctx context.Context) (*CategoryRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CategoryRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategoryRule sets the old CategoryRule of the mutation.
+func withCategoryRule(node *CategoryRule) categoryruleOption {
+	return func(m *CategoryRuleMutation) {
+		m.oldValue = func(context.Context) (*CategoryRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CategoryRule entities.
+func (m *CategoryRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CategoryRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *CategoryRuleMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *CategoryRuleMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *CategoryRuleMutation) ResetProfileID() {
+	m.profile = nil
+}
+
+// SetName sets the "name" field.
+func (m *CategoryRuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryRuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryRuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *CategoryRuleMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *CategoryRuleMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *CategoryRuleMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *CategoryRuleMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *CategoryRuleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *CategoryRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *CategoryRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *CategoryRuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetMerchantPattern sets the "merchant_pattern" field.
+func (m *CategoryRuleMutation) SetMerchantPattern(s string) {
+	m.merchant_pattern = &s
+}
+
+// MerchantPattern returns the value of the "merchant_pattern" field in the mutation.
+func (m *CategoryRuleMutation) MerchantPattern() (r string, exists bool) {
+	v := m.merchant_pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantPattern returns the old "merchant_pattern" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldMerchantPattern(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantPattern: %w", err)
+	}
+	return oldValue.MerchantPattern, nil
+}
+
+// ClearMerchantPattern clears the value of the "merchant_pattern" field.
+func (m *CategoryRuleMutation) ClearMerchantPattern() {
+	m.merchant_pattern = nil
+	m.clearedFields[categoryrule.FieldMerchantPattern] = struct{}{}
+}
+
+// MerchantPatternCleared returns if the "merchant_pattern" field was cleared in this mutation.
+func (m *CategoryRuleMutation) MerchantPatternCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldMerchantPattern]
+	return ok
+}
+
+// ResetMerchantPattern resets all changes to the "merchant_pattern" field.
+func (m *CategoryRuleMutation) ResetMerchantPattern() {
+	m.merchant_pattern = nil
+	delete(m.clearedFields, categoryrule.FieldMerchantPattern)
+}
+
+// SetPathPattern sets the "path_pattern" field.
+func (m *CategoryRuleMutation) SetPathPattern(s string) {
+	m.path_pattern = &s
+}
+
+// PathPattern returns the value of the "path_pattern" field in the mutation.
+func (m *CategoryRuleMutation) PathPattern() (r string, exists bool) {
+	v := m.path_pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPathPattern returns the old "path_pattern" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldPathPattern(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPathPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPathPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPathPattern: %w", err)
+	}
+	return oldValue.PathPattern, nil
+}
+
+// ClearPathPattern clears the value of the "path_pattern" field.
+func (m *CategoryRuleMutation) ClearPathPattern() {
+	m.path_pattern = nil
+	m.clearedFields[categoryrule.FieldPathPattern] = struct{}{}
+}
+
+// PathPatternCleared returns if the "path_pattern" field was cleared in this mutation.
+func (m *CategoryRuleMutation) PathPatternCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldPathPattern]
+	return ok
+}
+
+// ResetPathPattern resets all changes to the "path_pattern" field.
+func (m *CategoryRuleMutation) ResetPathPattern() {
+	m.path_pattern = nil
+	delete(m.clearedFields, categoryrule.FieldPathPattern)
+}
+
+// SetKeywords sets the "keywords" field.
+func (m *CategoryRuleMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
+}
+
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *CategoryRuleMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old "keywords" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords adds s to the "keywords" field.
+func (m *CategoryRuleMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
+}
+
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *CategoryRuleMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (m *CategoryRuleMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[categoryrule.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *CategoryRuleMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *CategoryRuleMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, categoryrule.FieldKeywords)
+}
+
+// SetMinTotal sets the "min_total" field.
+func (m *CategoryRuleMutation) SetMinTotal(value money.Amount) {
+	m.min_total = &value
+}
+
+// MinTotal returns the value of the "min_total" field in the mutation.
+func (m *CategoryRuleMutation) MinTotal() (r money.Amount, exists bool) {
+	v := m.min_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinTotal returns the old "min_total" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldMinTotal(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinTotal: %w", err)
+	}
+	return oldValue.MinTotal, nil
+}
+
+// ClearMinTotal clears the value of the "min_total" field.
+func (m *CategoryRuleMutation) ClearMinTotal() {
+	m.min_total = nil
+	m.clearedFields[categoryrule.FieldMinTotal] = struct{}{}
+}
+
+// MinTotalCleared returns if the "min_total" field was cleared in this mutation.
+func (m *CategoryRuleMutation) MinTotalCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldMinTotal]
+	return ok
+}
+
+// ResetMinTotal resets all changes to the "min_total" field.
+func (m *CategoryRuleMutation) ResetMinTotal() {
+	m.min_total = nil
+	delete(m.clearedFields, categoryrule.FieldMinTotal)
+}
+
+// SetMaxTotal sets the "max_total" field.
+func (m *CategoryRuleMutation) SetMaxTotal(value money.Amount) {
+	m.max_total = &value
+}
+
+// MaxTotal returns the value of the "max_total" field in the mutation.
+func (m *CategoryRuleMutation) MaxTotal() (r money.Amount, exists bool) {
+	v := m.max_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxTotal returns the old "max_total" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldMaxTotal(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxTotal: %w", err)
+	}
+	return oldValue.MaxTotal, nil
+}
+
+// ClearMaxTotal clears the value of the "max_total" field.
+func (m *CategoryRuleMutation) ClearMaxTotal() {
+	m.max_total = nil
+	m.clearedFields[categoryrule.FieldMaxTotal] = struct{}{}
+}
+
+// MaxTotalCleared returns if the "max_total" field was cleared in this mutation.
+func (m *CategoryRuleMutation) MaxTotalCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldMaxTotal]
+	return ok
+}
+
+// ResetMaxTotal resets all changes to the "max_total" field.
+func (m *CategoryRuleMutation) ResetMaxTotal() {
+	m.max_total = nil
+	delete(m.clearedFields, categoryrule.FieldMaxTotal)
+}
+
+// SetSetCategory sets the "set_category" field.
+func (m *CategoryRuleMutation) SetSetCategory(s string) {
+	m.set_category = &s
+}
+
+// SetCategory returns the value of the "set_category" field in the mutation.
+func (m *CategoryRuleMutation) SetCategory() (r string, exists bool) {
+	v := m.set_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSetCategory returns the old "set_category" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldSetCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSetCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSetCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSetCategory: %w", err)
+	}
+	return oldValue.SetCategory, nil
+}
+
+// ClearSetCategory clears the value of the "set_category" field.
+func (m *CategoryRuleMutation) ClearSetCategory() {
+	m.set_category = nil
+	m.clearedFields[categoryrule.FieldSetCategory] = struct{}{}
+}
+
+// SetCategoryCleared returns if the "set_category" field was cleared in this mutation.
+func (m *CategoryRuleMutation) SetCategoryCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldSetCategory]
+	return ok
+}
+
+// ResetSetCategory resets all changes to the "set_category" field.
+func (m *CategoryRuleMutation) ResetSetCategory() {
+	m.set_category = nil
+	delete(m.clearedFields, categoryrule.FieldSetCategory)
+}
+
+// SetForceReview sets the "force_review" field.
+func (m *CategoryRuleMutation) SetForceReview(b bool) {
+	m.force_review = &b
+}
+
+// ForceReview returns the value of the "force_review" field in the mutation.
+func (m *CategoryRuleMutation) ForceReview() (r bool, exists bool) {
+	v := m.force_review
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForceReview returns the old "force_review" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldForceReview(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForceReview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForceReview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForceReview: %w", err)
+	}
+	return oldValue.ForceReview, nil
+}
+
+// ResetForceReview resets all changes to the "force_review" field.
+func (m *CategoryRuleMutation) ResetForceReview() {
+	m.force_review = nil
+}
+
+// SetSetDescription sets the "set_description" field.
+func (m *CategoryRuleMutation) SetSetDescription(s string) {
+	m.set_description = &s
+}
+
+// SetDescription returns the value of the "set_description" field in the mutation.
+func (m *CategoryRuleMutation) SetDescription() (r string, exists bool) {
+	v := m.set_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSetDescription returns the old "set_description" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldSetDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSetDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSetDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSetDescription: %w", err)
+	}
+	return oldValue.SetDescription, nil
+}
+
+// ClearSetDescription clears the value of the "set_description" field.
+func (m *CategoryRuleMutation) ClearSetDescription() {
+	m.set_description = nil
+	m.clearedFields[categoryrule.FieldSetDescription] = struct{}{}
+}
+
+// SetDescriptionCleared returns if the "set_description" field was cleared in this mutation.
+func (m *CategoryRuleMutation) SetDescriptionCleared() bool {
+	_, ok := m.clearedFields[categoryrule.FieldSetDescription]
+	return ok
+}
+
+// ResetSetDescription resets all changes to the "set_description" field.
+func (m *CategoryRuleMutation) ResetSetDescription() {
+	m.set_description = nil
+	delete(m.clearedFields, categoryrule.FieldSetDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CategoryRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CategoryRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CategoryRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CategoryRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CategoryRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CategoryRule entity.
+// If the CategoryRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CategoryRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *CategoryRuleMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[categoryrule.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *CategoryRuleMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *CategoryRuleMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *CategoryRuleMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the CategoryRuleMutation builder.
+func (m *CategoryRuleMutation) Where(ps ...predicate.CategoryRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CategoryRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CategoryRule).
+func (m *CategoryRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryRuleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.profile != nil {
+		fields = append(fields, categoryrule.FieldProfileID)
+	}
+	if m.name != nil {
+		fields = append(fields, categoryrule.FieldName)
+	}
+	if m.priority != nil {
+		fields = append(fields, categoryrule.FieldPriority)
+	}
+	if m.enabled != nil {
+		fields = append(fields, categoryrule.FieldEnabled)
+	}
+	if m.merchant_pattern != nil {
+		fields = append(fields, categoryrule.FieldMerchantPattern)
+	}
+	if m.path_pattern != nil {
+		fields = append(fields, categoryrule.FieldPathPattern)
+	}
+	if m.keywords != nil {
+		fields = append(fields, categoryrule.FieldKeywords)
+	}
+	if m.min_total != nil {
+		fields = append(fields, categoryrule.FieldMinTotal)
+	}
+	if m.max_total != nil {
+		fields = append(fields, categoryrule.FieldMaxTotal)
+	}
+	if m.set_category != nil {
+		fields = append(fields, categoryrule.FieldSetCategory)
+	}
+	if m.force_review != nil {
+		fields = append(fields, categoryrule.FieldForceReview)
+	}
+	if m.set_description != nil {
+		fields = append(fields, categoryrule.FieldSetDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, categoryrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, categoryrule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case categoryrule.FieldProfileID:
+		return m.ProfileID()
+	case categoryrule.FieldName:
+		return m.Name()
+	case categoryrule.FieldPriority:
+		return m.Priority()
+	case categoryrule.FieldEnabled:
+		return m.Enabled()
+	case categoryrule.FieldMerchantPattern:
+		return m.MerchantPattern()
+	case categoryrule.FieldPathPattern:
+		return m.PathPattern()
+	case categoryrule.FieldKeywords:
+		return m.Keywords()
+	case categoryrule.FieldMinTotal:
+		return m.MinTotal()
+	case categoryrule.FieldMaxTotal:
+		return m.MaxTotal()
+	case categoryrule.FieldSetCategory:
+		return m.SetCategory()
+	case categoryrule.FieldForceReview:
+		return m.ForceReview()
+	case categoryrule.FieldSetDescription:
+		return m.SetDescription()
+	case categoryrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case categoryrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case categoryrule.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case categoryrule.FieldName:
+		return m.OldName(ctx)
+	case categoryrule.FieldPriority:
+		return m.OldPriority(ctx)
+	case categoryrule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case categoryrule.FieldMerchantPattern:
+		return m.OldMerchantPattern(ctx)
+	case categoryrule.FieldPathPattern:
+		return m.OldPathPattern(ctx)
+	case categoryrule.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case categoryrule.FieldMinTotal:
+		return m.OldMinTotal(ctx)
+	case categoryrule.FieldMaxTotal:
+		return m.OldMaxTotal(ctx)
+	case categoryrule.FieldSetCategory:
+		return m.OldSetCategory(ctx)
+	case categoryrule.FieldForceReview:
+		return m.OldForceReview(ctx)
+	case categoryrule.FieldSetDescription:
+		return m.OldSetDescription(ctx)
+	case categoryrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case categoryrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CategoryRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case categoryrule.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case categoryrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case categoryrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case categoryrule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case categoryrule.FieldMerchantPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantPattern(v)
+		return nil
+	case categoryrule.FieldPathPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPathPattern(v)
+		return nil
+	case categoryrule.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case categoryrule.FieldMinTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinTotal(v)
+		return nil
+	case categoryrule.FieldMaxTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxTotal(v)
+		return nil
+	case categoryrule.FieldSetCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSetCategory(v)
+		return nil
+	case categoryrule.FieldForceReview:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForceReview(v)
+		return nil
+	case categoryrule.FieldSetDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSetDescription(v)
+		return nil
+	case categoryrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case categoryrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, categoryrule.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case categoryrule.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case categoryrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(categoryrule.FieldMerchantPattern) {
+		fields = append(fields, categoryrule.FieldMerchantPattern)
+	}
+	if m.FieldCleared(categoryrule.FieldPathPattern) {
+		fields = append(fields, categoryrule.FieldPathPattern)
+	}
+	if m.FieldCleared(categoryrule.FieldKeywords) {
+		fields = append(fields, categoryrule.FieldKeywords)
+	}
+	if m.FieldCleared(categoryrule.FieldMinTotal) {
+		fields = append(fields, categoryrule.FieldMinTotal)
+	}
+	if m.FieldCleared(categoryrule.FieldMaxTotal) {
+		fields = append(fields, categoryrule.FieldMaxTotal)
+	}
+	if m.FieldCleared(categoryrule.FieldSetCategory) {
+		fields = append(fields, categoryrule.FieldSetCategory)
+	}
+	if m.FieldCleared(categoryrule.FieldSetDescription) {
+		fields = append(fields, categoryrule.FieldSetDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryRuleMutation) ClearField(name string) error {
+	switch name {
+	case categoryrule.FieldMerchantPattern:
+		m.ClearMerchantPattern()
+		return nil
+	case categoryrule.FieldPathPattern:
+		m.ClearPathPattern()
+		return nil
+	case categoryrule.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	case categoryrule.FieldMinTotal:
+		m.ClearMinTotal()
+		return nil
+	case categoryrule.FieldMaxTotal:
+		m.ClearMaxTotal()
+		return nil
+	case categoryrule.FieldSetCategory:
+		m.ClearSetCategory()
+		return nil
+	case categoryrule.FieldSetDescription:
+		m.ClearSetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryRuleMutation) ResetField(name string) error {
+	switch name {
+	case categoryrule.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case categoryrule.FieldName:
+		m.ResetName()
+		return nil
+	case categoryrule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case categoryrule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case categoryrule.FieldMerchantPattern:
+		m.ResetMerchantPattern()
+		return nil
+	case categoryrule.FieldPathPattern:
+		m.ResetPathPattern()
+		return nil
+	case categoryrule.FieldKeywords:
+		m.ResetKeywords()
+		return nil
+	case categoryrule.FieldMinTotal:
+		m.ResetMinTotal()
+		return nil
+	case categoryrule.FieldMaxTotal:
+		m.ResetMaxTotal()
+		return nil
+	case categoryrule.FieldSetCategory:
+		m.ResetSetCategory()
+		return nil
+	case categoryrule.FieldForceReview:
+		m.ResetForceReview()
+		return nil
+	case categoryrule.FieldSetDescription:
+		m.ResetSetDescription()
+		return nil
+	case categoryrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case categoryrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, categoryrule.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case categoryrule.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, categoryrule.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case categoryrule.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case categoryrule.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case categoryrule.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryRule edge %s", name)
+}
+
+// ExportTemplateMutation represents an operation that mutates the ExportTemplate nodes in the graph.
+type ExportTemplateMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	columns        *[]entity.ExportColumn
+	appendcolumns  []entity.ExportColumn
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	profile        *uuid.UUID
+	clearedprofile bool
+	done           bool
+	oldValue       func(context.Context) (*ExportTemplate, error)
+	predicates     []predicate.ExportTemplate
+}
+
+var _ ent.Mutation = (*ExportTemplateMutation)(nil)
+
+// exporttemplateOption allows management of the mutation configuration using functional options.
+type exporttemplateOption func(*ExportTemplateMutation)
+
+// newExportTemplateMutation creates new mutation for the ExportTemplate entity.
+func newExportTemplateMutation(c config, op Op, opts ...exporttemplateOption) *ExportTemplateMutation {
+	m := &ExportTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExportTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExportTemplateID sets the ID field of the mutation.
+func withExportTemplateID(id uuid.UUID) exporttemplateOption {
+	return func(m *ExportTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExportTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*ExportTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExportTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExportTemplate sets the old ExportTemplate of the mutation.
+func withExportTemplate(node *ExportTemplate) exporttemplateOption {
+	return func(m *ExportTemplateMutation) {
+		m.oldValue = func(context.Context) (*ExportTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExportTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExportTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExportTemplate entities.
+func (m *ExportTemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExportTemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExportTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExportTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *ExportTemplateMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *ExportTemplateMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the ExportTemplate entity.
+// If the ExportTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportTemplateMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *ExportTemplateMutation) ResetProfileID() {
+	m.profile = nil
+}
+
+// SetName sets the "name" field.
+func (m *ExportTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ExportTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ExportTemplate entity.
+// If the ExportTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ExportTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColumns sets the "columns" field.
+func (m *ExportTemplateMutation) SetColumns(ec []entity.ExportColumn) {
+	m.columns = &ec
+	m.appendcolumns = nil
+}
+
+// Columns returns the value of the "columns" field in the mutation.
+func (m *ExportTemplateMutation) Columns() (r []entity.ExportColumn, exists bool) {
+	v := m.columns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumns returns the old "columns" field's value of the ExportTemplate entity.
+// If the ExportTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportTemplateMutation) OldColumns(ctx context.Context) (v []entity.ExportColumn, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumns: %w", err)
+	}
+	return oldValue.Columns, nil
+}
+
+// AppendColumns adds ec to the "columns" field.
+func (m *ExportTemplateMutation) AppendColumns(ec []entity.ExportColumn) {
+	m.appendcolumns = append(m.appendcolumns, ec...)
+}
+
+// AppendedColumns returns the list of values that were appended to the "columns" field in this mutation.
+func (m *ExportTemplateMutation) AppendedColumns() ([]entity.ExportColumn, bool) {
+	if len(m.appendcolumns) == 0 {
+		return nil, false
+	}
+	return m.appendcolumns, true
+}
+
+// ResetColumns resets all changes to the "columns" field.
+func (m *ExportTemplateMutation) ResetColumns() {
+	m.columns = nil
+	m.appendcolumns = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExportTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExportTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExportTemplate entity.
+// If the ExportTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExportTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExportTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExportTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExportTemplate entity.
+// If the ExportTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExportTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ExportTemplateMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[exporttemplate.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ExportTemplateMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ExportTemplateMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ExportTemplateMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// Where appends a list predicates to the ExportTemplateMutation builder.
+func (m *ExportTemplateMutation) Where(ps ...predicate.ExportTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExportTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExportTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExportTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExportTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExportTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExportTemplate).
+func (m *ExportTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExportTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.profile != nil {
+		fields = append(fields, exporttemplate.FieldProfileID)
+	}
+	if m.name != nil {
+		fields = append(fields, exporttemplate.FieldName)
+	}
+	if m.columns != nil {
+		fields = append(fields, exporttemplate.FieldColumns)
+	}
+	if m.created_at != nil {
+		fields = append(fields, exporttemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, exporttemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExportTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exporttemplate.FieldProfileID:
+		return m.ProfileID()
+	case exporttemplate.FieldName:
+		return m.Name()
+	case exporttemplate.FieldColumns:
+		return m.Columns()
+	case exporttemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case exporttemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExportTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exporttemplate.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case exporttemplate.FieldName:
+		return m.OldName(ctx)
+	case exporttemplate.FieldColumns:
+		return m.OldColumns(ctx)
+	case exporttemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case exporttemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExportTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exporttemplate.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case exporttemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case exporttemplate.FieldColumns:
+		v, ok := value.([]entity.ExportColumn)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumns(v)
+		return nil
+	case exporttemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case exporttemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExportTemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExportTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExportTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExportTemplateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExportTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExportTemplateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExportTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExportTemplateMutation) ResetField(name string) error {
+	switch name {
+	case exporttemplate.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case exporttemplate.FieldName:
+		m.ResetName()
+		return nil
+	case exporttemplate.FieldColumns:
+		m.ResetColumns()
+		return nil
+	case exporttemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case exporttemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExportTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.profile != nil {
+		edges = append(edges, exporttemplate.EdgeProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExportTemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exporttemplate.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExportTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExportTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExportTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprofile {
+		edges = append(edges, exporttemplate.EdgeProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExportTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exporttemplate.EdgeProfile:
+		return m.clearedprofile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExportTemplateMutation) ClearEdge(name string) error {
+	switch name {
+	case exporttemplate.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExportTemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case exporttemplate.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportTemplate edge %s", name)
+}
+
+// ExtractJobMutation represents an operation that mutates the ExtractJob nodes in the graph.
+type ExtractJobMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	format                   *string
+	started_at               *time.Time
+	finished_at              *time.Time
+	status                   *string
+	error_message            *string
+	extraction_confidence    *float32
+	addextraction_confidence *float32
+	needs_review             *bool
+	ocr_text                 *string
+	extracted_json           *json.RawMessage
+	appendextracted_json     json.RawMessage
+	model_name               *string
+	model_params             *json.RawMessage
+	appendmodel_params       json.RawMessage
+	prompt_tokens            *int
+	addprompt_tokens         *int
+	completion_tokens        *int
+	addcompletion_tokens     *int
+	image_tokens             *int
+	addimage_tokens          *int
+	cost_usd                 *float64
+	addcost_usd              *float64
+	llm_cache_hit            *bool
+	parse_attempts           *json.RawMessage
+	appendparse_attempts     json.RawMessage
+	clearedFields            map[string]struct{}
+	file                     *uuid.UUID
+	clearedfile              bool
+	profile                  *uuid.UUID
+	clearedprofile           bool
+	receipt                  *uuid.UUID
+	clearedreceipt           bool
+	done                     bool
+	oldValue                 func(context.Context) (*ExtractJob, error)
+	predicates               []predicate.ExtractJob
+}
+
+var _ ent.Mutation = (*ExtractJobMutation)(nil)
+
+// extractjobOption allows management of the mutation configuration using functional options.
+type extractjobOption func(*ExtractJobMutation)
+
+// newExtractJobMutation creates new mutation for the ExtractJob entity.
+func newExtractJobMutation(c config, op Op, opts ...extractjobOption) *ExtractJobMutation {
+	m := &ExtractJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExtractJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExtractJobID sets the ID field of the mutation.
+func withExtractJobID(id uuid.UUID) extractjobOption {
+	return func(m *ExtractJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExtractJob
+		)
+		m.oldValue = func(ctx context.Context) (*ExtractJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExtractJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExtractJob sets the old ExtractJob of the mutation.
+func withExtractJob(node *ExtractJob) extractjobOption {
+	return func(m *ExtractJobMutation) {
+		m.oldValue = func(context.Context) (*ExtractJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExtractJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExtractJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExtractJob entities.
+func (m *ExtractJobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExtractJobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExtractJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExtractJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFileID sets the "file_id" field.
+func (m *ExtractJobMutation) SetFileID(u uuid.UUID) {
+	m.file = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *ExtractJobMutation) FileID() (r uuid.UUID, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldFileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *ExtractJobMutation) ResetFileID() {
+	m.file = nil
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *ExtractJobMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *ExtractJobMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *ExtractJobMutation) ResetProfileID() {
+	m.profile = nil
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (m *ExtractJobMutation) SetReceiptID(u uuid.UUID) {
+	m.receipt = &u
+}
+
+// ReceiptID returns the value of the "receipt_id" field in the mutation.
+func (m *ExtractJobMutation) ReceiptID() (r uuid.UUID, exists bool) {
+	v := m.receipt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiptID returns the old "receipt_id" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldReceiptID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
+	}
+	return oldValue.ReceiptID, nil
+}
+
+// ClearReceiptID clears the value of the "receipt_id" field.
+func (m *ExtractJobMutation) ClearReceiptID() {
+	m.receipt = nil
+	m.clearedFields[extractjob.FieldReceiptID] = struct{}{}
+}
+
+// ReceiptIDCleared returns if the "receipt_id" field was cleared in this mutation.
+func (m *ExtractJobMutation) ReceiptIDCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldReceiptID]
+	return ok
+}
+
+// ResetReceiptID resets all changes to the "receipt_id" field.
+func (m *ExtractJobMutation) ResetReceiptID() {
+	m.receipt = nil
+	delete(m.clearedFields, extractjob.FieldReceiptID)
+}
+
+// SetFormat sets the "format" field.
+func (m *ExtractJobMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ExtractJobMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ExtractJobMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ExtractJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ExtractJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ExtractJobMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ExtractJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ExtractJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ExtractJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[extractjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ExtractJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ExtractJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, extractjob.FieldFinishedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *ExtractJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExtractJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldStatus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ClearStatus clears the value of the "status" field.
+func (m *ExtractJobMutation) ClearStatus() {
+	m.status = nil
+	m.clearedFields[extractjob.FieldStatus] = struct{}{}
+}
+
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *ExtractJobMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldStatus]
+	return ok
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExtractJobMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, extractjob.FieldStatus)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *ExtractJobMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *ExtractJobMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *ExtractJobMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[extractjob.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *ExtractJobMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *ExtractJobMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, extractjob.FieldErrorMessage)
+}
+
+// SetExtractionConfidence sets the "extraction_confidence" field.
+func (m *ExtractJobMutation) SetExtractionConfidence(f float32) {
+	m.extraction_confidence = &f
+	m.addextraction_confidence = nil
+}
+
+// ExtractionConfidence returns the value of the "extraction_confidence" field in the mutation.
+func (m *ExtractJobMutation) ExtractionConfidence() (r float32, exists bool) {
+	v := m.extraction_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtractionConfidence returns the old "extraction_confidence" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldExtractionConfidence(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtractionConfidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtractionConfidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtractionConfidence: %w", err)
+	}
+	return oldValue.ExtractionConfidence, nil
+}
+
+// AddExtractionConfidence adds f to the "extraction_confidence" field.
+func (m *ExtractJobMutation) AddExtractionConfidence(f float32) {
+	if m.addextraction_confidence != nil {
+		*m.addextraction_confidence += f
+	} else {
+		m.addextraction_confidence = &f
+	}
+}
+
+// AddedExtractionConfidence returns the value that was added to the "extraction_confidence" field in this mutation.
+func (m *ExtractJobMutation) AddedExtractionConfidence() (r float32, exists bool) {
+	v := m.addextraction_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExtractionConfidence clears the value of the "extraction_confidence" field.
+func (m *ExtractJobMutation) ClearExtractionConfidence() {
+	m.extraction_confidence = nil
+	m.addextraction_confidence = nil
+	m.clearedFields[extractjob.FieldExtractionConfidence] = struct{}{}
+}
+
+// ExtractionConfidenceCleared returns if the "extraction_confidence" field was cleared in this mutation.
+func (m *ExtractJobMutation) ExtractionConfidenceCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldExtractionConfidence]
+	return ok
+}
+
+// ResetExtractionConfidence resets all changes to the "extraction_confidence" field.
+func (m *ExtractJobMutation) ResetExtractionConfidence() {
+	m.extraction_confidence = nil
+	m.addextraction_confidence = nil
+	delete(m.clearedFields, extractjob.FieldExtractionConfidence)
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (m *ExtractJobMutation) SetNeedsReview(b bool) {
+	m.needs_review = &b
+}
+
+// NeedsReview returns the value of the "needs_review" field in the mutation.
+func (m *ExtractJobMutation) NeedsReview() (r bool, exists bool) {
+	v := m.needs_review
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNeedsReview returns the old "needs_review" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldNeedsReview(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNeedsReview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNeedsReview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNeedsReview: %w", err)
+	}
+	return oldValue.NeedsReview, nil
+}
+
+// ResetNeedsReview resets all changes to the "needs_review" field.
+func (m *ExtractJobMutation) ResetNeedsReview() {
+	m.needs_review = nil
+}
+
+// SetOcrText sets the "ocr_text" field.
+func (m *ExtractJobMutation) SetOcrText(s string) {
+	m.ocr_text = &s
+}
+
+// OcrText returns the value of the "ocr_text" field in the mutation.
+func (m *ExtractJobMutation) OcrText() (r string, exists bool) {
+	v := m.ocr_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOcrText returns the old "ocr_text" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldOcrText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOcrText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOcrText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOcrText: %w", err)
+	}
+	return oldValue.OcrText, nil
+}
+
+// ClearOcrText clears the value of the "ocr_text" field.
+func (m *ExtractJobMutation) ClearOcrText() {
+	m.ocr_text = nil
+	m.clearedFields[extractjob.FieldOcrText] = struct{}{}
+}
+
+// OcrTextCleared returns if the "ocr_text" field was cleared in this mutation.
+func (m *ExtractJobMutation) OcrTextCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldOcrText]
+	return ok
+}
+
+// ResetOcrText resets all changes to the "ocr_text" field.
+func (m *ExtractJobMutation) ResetOcrText() {
+	m.ocr_text = nil
+	delete(m.clearedFields, extractjob.FieldOcrText)
+}
+
+// SetExtractedJSON sets the "extracted_json" field.
+func (m *ExtractJobMutation) SetExtractedJSON(jm json.RawMessage) {
+	m.extracted_json = &jm
+	m.appendextracted_json = nil
+}
+
+// ExtractedJSON returns the value of the "extracted_json" field in the mutation.
+func (m *ExtractJobMutation) ExtractedJSON() (r json.RawMessage, exists bool) {
+	v := m.extracted_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtractedJSON returns the old "extracted_json" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldExtractedJSON(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtractedJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtractedJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtractedJSON: %w", err)
+	}
+	return oldValue.ExtractedJSON, nil
+}
+
+// AppendExtractedJSON adds jm to the "extracted_json" field.
+func (m *ExtractJobMutation) AppendExtractedJSON(jm json.RawMessage) {
+	m.appendextracted_json = append(m.appendextracted_json, jm...)
+}
+
+// AppendedExtractedJSON returns the list of values that were appended to the "extracted_json" field in this mutation.
+func (m *ExtractJobMutation) AppendedExtractedJSON() (json.RawMessage, bool) {
+	if len(m.appendextracted_json) == 0 {
+		return nil, false
+	}
+	return m.appendextracted_json, true
+}
+
+// ClearExtractedJSON clears the value of the "extracted_json" field.
+func (m *ExtractJobMutation) ClearExtractedJSON() {
+	m.extracted_json = nil
+	m.appendextracted_json = nil
+	m.clearedFields[extractjob.FieldExtractedJSON] = struct{}{}
+}
+
+// ExtractedJSONCleared returns if the "extracted_json" field was cleared in this mutation.
+func (m *ExtractJobMutation) ExtractedJSONCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldExtractedJSON]
+	return ok
+}
+
+// ResetExtractedJSON resets all changes to the "extracted_json" field.
+func (m *ExtractJobMutation) ResetExtractedJSON() {
+	m.extracted_json = nil
+	m.appendextracted_json = nil
+	delete(m.clearedFields, extractjob.FieldExtractedJSON)
+}
+
+// SetModelName sets the "model_name" field.
+func (m *ExtractJobMutation) SetModelName(s string) {
+	m.model_name = &s
+}
+
+// ModelName returns the value of the "model_name" field in the mutation.
+func (m *ExtractJobMutation) ModelName() (r string, exists bool) {
+	v := m.model_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelName returns the old "model_name" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldModelName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+	}
+	return oldValue.ModelName, nil
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (m *ExtractJobMutation) ClearModelName() {
+	m.model_name = nil
+	m.clearedFields[extractjob.FieldModelName] = struct{}{}
+}
+
+// ModelNameCleared returns if the "model_name" field was cleared in this mutation.
+func (m *ExtractJobMutation) ModelNameCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldModelName]
+	return ok
+}
+
+// ResetModelName resets all changes to the "model_name" field.
+func (m *ExtractJobMutation) ResetModelName() {
+	m.model_name = nil
+	delete(m.clearedFields, extractjob.FieldModelName)
+}
+
+// SetModelParams sets the "model_params" field.
+func (m *ExtractJobMutation) SetModelParams(jm json.RawMessage) {
+	m.model_params = &jm
+	m.appendmodel_params = nil
+}
+
+// ModelParams returns the value of the "model_params" field in the mutation.
+func (m *ExtractJobMutation) ModelParams() (r json.RawMessage, exists bool) {
+	v := m.model_params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelParams returns the old "model_params" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldModelParams(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelParams: %w", err)
+	}
+	return oldValue.ModelParams, nil
+}
+
+// AppendModelParams adds jm to the "model_params" field.
+func (m *ExtractJobMutation) AppendModelParams(jm json.RawMessage) {
+	m.appendmodel_params = append(m.appendmodel_params, jm...)
+}
+
+// AppendedModelParams returns the list of values that were appended to the "model_params" field in this mutation.
+func (m *ExtractJobMutation) AppendedModelParams() (json.RawMessage, bool) {
+	if len(m.appendmodel_params) == 0 {
+		return nil, false
+	}
+	return m.appendmodel_params, true
+}
+
+// ClearModelParams clears the value of the "model_params" field.
+func (m *ExtractJobMutation) ClearModelParams() {
+	m.model_params = nil
+	m.appendmodel_params = nil
+	m.clearedFields[extractjob.FieldModelParams] = struct{}{}
+}
+
+// ModelParamsCleared returns if the "model_params" field was cleared in this mutation.
+func (m *ExtractJobMutation) ModelParamsCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldModelParams]
+	return ok
+}
+
+// ResetModelParams resets all changes to the "model_params" field.
+func (m *ExtractJobMutation) ResetModelParams() {
+	m.model_params = nil
+	m.appendmodel_params = nil
+	delete(m.clearedFields, extractjob.FieldModelParams)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *ExtractJobMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *ExtractJobMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldPromptTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *ExtractJobMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *ExtractJobMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (m *ExtractJobMutation) ClearPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	m.clearedFields[extractjob.FieldPromptTokens] = struct{}{}
+}
+
+// PromptTokensCleared returns if the "prompt_tokens" field was cleared in this mutation.
+func (m *ExtractJobMutation) PromptTokensCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldPromptTokens]
+	return ok
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *ExtractJobMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	delete(m.clearedFields, extractjob.FieldPromptTokens)
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *ExtractJobMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *ExtractJobMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldCompletionTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *ExtractJobMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *ExtractJobMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (m *ExtractJobMutation) ClearCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	m.clearedFields[extractjob.FieldCompletionTokens] = struct{}{}
+}
+
+// CompletionTokensCleared returns if the "completion_tokens" field was cleared in this mutation.
+func (m *ExtractJobMutation) CompletionTokensCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldCompletionTokens]
+	return ok
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *ExtractJobMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	delete(m.clearedFields, extractjob.FieldCompletionTokens)
+}
+
+// SetImageTokens sets the "image_tokens" field.
+func (m *ExtractJobMutation) SetImageTokens(i int) {
+	m.image_tokens = &i
+	m.addimage_tokens = nil
+}
+
+// ImageTokens returns the value of the "image_tokens" field in the mutation.
+func (m *ExtractJobMutation) ImageTokens() (r int, exists bool) {
+	v := m.image_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageTokens returns the old "image_tokens" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldImageTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageTokens: %w", err)
+	}
+	return oldValue.ImageTokens, nil
+}
+
+// AddImageTokens adds i to the "image_tokens" field.
+func (m *ExtractJobMutation) AddImageTokens(i int) {
+	if m.addimage_tokens != nil {
+		*m.addimage_tokens += i
+	} else {
+		m.addimage_tokens = &i
+	}
+}
+
+// AddedImageTokens returns the value that was added to the "image_tokens" field in this mutation.
+func (m *ExtractJobMutation) AddedImageTokens() (r int, exists bool) {
+	v := m.addimage_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearImageTokens clears the value of the "image_tokens" field.
+func (m *ExtractJobMutation) ClearImageTokens() {
+	m.image_tokens = nil
+	m.addimage_tokens = nil
+	m.clearedFields[extractjob.FieldImageTokens] = struct{}{}
+}
+
+// ImageTokensCleared returns if the "image_tokens" field was cleared in this mutation.
+func (m *ExtractJobMutation) ImageTokensCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldImageTokens]
+	return ok
+}
+
+// ResetImageTokens resets all changes to the "image_tokens" field.
+func (m *ExtractJobMutation) ResetImageTokens() {
+	m.image_tokens = nil
+	m.addimage_tokens = nil
+	delete(m.clearedFields, extractjob.FieldImageTokens)
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (m *ExtractJobMutation) SetCostUsd(f float64) {
+	m.cost_usd = &f
+	m.addcost_usd = nil
+}
+
+// CostUsd returns the value of the "cost_usd" field in the mutation.
+func (m *ExtractJobMutation) CostUsd() (r float64, exists bool) {
+	v := m.cost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostUsd returns the old "cost_usd" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldCostUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostUsd: %w", err)
+	}
+	return oldValue.CostUsd, nil
+}
+
+// AddCostUsd adds f to the "cost_usd" field.
+func (m *ExtractJobMutation) AddCostUsd(f float64) {
+	if m.addcost_usd != nil {
+		*m.addcost_usd += f
+	} else {
+		m.addcost_usd = &f
+	}
+}
+
+// AddedCostUsd returns the value that was added to the "cost_usd" field in this mutation.
+func (m *ExtractJobMutation) AddedCostUsd() (r float64, exists bool) {
+	v := m.addcost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (m *ExtractJobMutation) ClearCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
+	m.clearedFields[extractjob.FieldCostUsd] = struct{}{}
+}
+
+// CostUsdCleared returns if the "cost_usd" field was cleared in this mutation.
+func (m *ExtractJobMutation) CostUsdCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldCostUsd]
+	return ok
+}
+
+// ResetCostUsd resets all changes to the "cost_usd" field.
+func (m *ExtractJobMutation) ResetCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
+	delete(m.clearedFields, extractjob.FieldCostUsd)
+}
+
+// SetLlmCacheHit sets the "llm_cache_hit" field.
+func (m *ExtractJobMutation) SetLlmCacheHit(b bool) {
+	m.llm_cache_hit = &b
+}
+
+// LlmCacheHit returns the value of the "llm_cache_hit" field in the mutation.
+func (m *ExtractJobMutation) LlmCacheHit() (r bool, exists bool) {
+	v := m.llm_cache_hit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLlmCacheHit returns the old "llm_cache_hit" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldLlmCacheHit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLlmCacheHit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLlmCacheHit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLlmCacheHit: %w", err)
+	}
+	return oldValue.LlmCacheHit, nil
+}
+
+// ResetLlmCacheHit resets all changes to the "llm_cache_hit" field.
+func (m *ExtractJobMutation) ResetLlmCacheHit() {
+	m.llm_cache_hit = nil
+}
+
+// SetParseAttempts sets the "parse_attempts" field.
+func (m *ExtractJobMutation) SetParseAttempts(jm json.RawMessage) {
+	m.parse_attempts = &jm
+	m.appendparse_attempts = nil
+}
+
+// ParseAttempts returns the value of the "parse_attempts" field in the mutation.
+func (m *ExtractJobMutation) ParseAttempts() (r json.RawMessage, exists bool) {
+	v := m.parse_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParseAttempts returns the old "parse_attempts" field's value of the ExtractJob entity.
+// If the ExtractJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExtractJobMutation) OldParseAttempts(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParseAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParseAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParseAttempts: %w", err)
+	}
+	return oldValue.ParseAttempts, nil
+}
+
+// AppendParseAttempts adds jm to the "parse_attempts" field.
+func (m *ExtractJobMutation) AppendParseAttempts(jm json.RawMessage) {
+	m.appendparse_attempts = append(m.appendparse_attempts, jm...)
+}
+
+// AppendedParseAttempts returns the list of values that were appended to the "parse_attempts" field in this mutation.
+func (m *ExtractJobMutation) AppendedParseAttempts() (json.RawMessage, bool) {
+	if len(m.appendparse_attempts) == 0 {
+		return nil, false
+	}
+	return m.appendparse_attempts, true
+}
+
+// ClearParseAttempts clears the value of the "parse_attempts" field.
+func (m *ExtractJobMutation) ClearParseAttempts() {
+	m.parse_attempts = nil
+	m.appendparse_attempts = nil
+	m.clearedFields[extractjob.FieldParseAttempts] = struct{}{}
+}
+
+// ParseAttemptsCleared returns if the "parse_attempts" field was cleared in this mutation.
+func (m *ExtractJobMutation) ParseAttemptsCleared() bool {
+	_, ok := m.clearedFields[extractjob.FieldParseAttempts]
+	return ok
+}
+
+// ResetParseAttempts resets all changes to the "parse_attempts" field.
+func (m *ExtractJobMutation) ResetParseAttempts() {
+	m.parse_attempts = nil
+	m.appendparse_attempts = nil
+	delete(m.clearedFields, extractjob.FieldParseAttempts)
+}
+
+// ClearFile clears the "file" edge to the ReceiptFile entity.
+func (m *ExtractJobMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[extractjob.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the ReceiptFile entity was cleared.
+func (m *ExtractJobMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ExtractJobMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *ExtractJobMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ExtractJobMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[extractjob.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ExtractJobMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ExtractJobMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ExtractJobMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// ClearReceipt clears the "receipt" edge to the Receipt entity.
+func (m *ExtractJobMutation) ClearReceipt() {
+	m.clearedreceipt = true
+	m.clearedFields[extractjob.FieldReceiptID] = struct{}{}
+}
+
+// ReceiptCleared reports if the "receipt" edge to the Receipt entity was cleared.
+func (m *ExtractJobMutation) ReceiptCleared() bool {
+	return m.ReceiptIDCleared() || m.clearedreceipt
+}
+
+// ReceiptIDs returns the "receipt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReceiptID instead. It exists only for internal usage by the builders.
+func (m *ExtractJobMutation) ReceiptIDs() (ids []uuid.UUID) {
+	if id := m.receipt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReceipt resets all changes to the "receipt" edge.
+func (m *ExtractJobMutation) ResetReceipt() {
+	m.receipt = nil
+	m.clearedreceipt = false
+}
+
+// Where appends a list predicates to the ExtractJobMutation builder.
+func (m *ExtractJobMutation) Where(ps ...predicate.ExtractJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExtractJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExtractJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExtractJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExtractJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExtractJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExtractJob).
+func (m *ExtractJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExtractJobMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.file != nil {
+		fields = append(fields, extractjob.FieldFileID)
+	}
+	if m.profile != nil {
+		fields = append(fields, extractjob.FieldProfileID)
+	}
+	if m.receipt != nil {
+		fields = append(fields, extractjob.FieldReceiptID)
+	}
+	if m.format != nil {
+		fields = append(fields, extractjob.FieldFormat)
+	}
+	if m.started_at != nil {
+		fields = append(fields, extractjob.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, extractjob.FieldFinishedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, extractjob.FieldStatus)
+	}
+	if m.error_message != nil {
+		fields = append(fields, extractjob.FieldErrorMessage)
+	}
+	if m.extraction_confidence != nil {
+		fields = append(fields, extractjob.FieldExtractionConfidence)
+	}
+	if m.needs_review != nil {
+		fields = append(fields, extractjob.FieldNeedsReview)
+	}
+	if m.ocr_text != nil {
+		fields = append(fields, extractjob.FieldOcrText)
+	}
+	if m.extracted_json != nil {
+		fields = append(fields, extractjob.FieldExtractedJSON)
+	}
+	if m.model_name != nil {
+		fields = append(fields, extractjob.FieldModelName)
+	}
+	if m.model_params != nil {
+		fields = append(fields, extractjob.FieldModelParams)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, extractjob.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, extractjob.FieldCompletionTokens)
+	}
+	if m.image_tokens != nil {
+		fields = append(fields, extractjob.FieldImageTokens)
+	}
+	if m.cost_usd != nil {
+		fields = append(fields, extractjob.FieldCostUsd)
+	}
+	if m.llm_cache_hit != nil {
+		fields = append(fields, extractjob.FieldLlmCacheHit)
+	}
+	if m.parse_attempts != nil {
+		fields = append(fields, extractjob.FieldParseAttempts)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExtractJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case extractjob.FieldFileID:
+		return m.FileID()
+	case extractjob.FieldProfileID:
+		return m.ProfileID()
+	case extractjob.FieldReceiptID:
+		return m.ReceiptID()
+	case extractjob.FieldFormat:
+		return m.Format()
+	case extractjob.FieldStartedAt:
+		return m.StartedAt()
+	case extractjob.FieldFinishedAt:
+		return m.FinishedAt()
+	case extractjob.FieldStatus:
+		return m.Status()
+	case extractjob.FieldErrorMessage:
+		return m.ErrorMessage()
+	case extractjob.FieldExtractionConfidence:
+		return m.ExtractionConfidence()
+	case extractjob.FieldNeedsReview:
+		return m.NeedsReview()
+	case extractjob.FieldOcrText:
+		return m.OcrText()
+	case extractjob.FieldExtractedJSON:
+		return m.ExtractedJSON()
+	case extractjob.FieldModelName:
+		return m.ModelName()
+	case extractjob.FieldModelParams:
+		return m.ModelParams()
+	case extractjob.FieldPromptTokens:
+		return m.PromptTokens()
+	case extractjob.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case extractjob.FieldImageTokens:
+		return m.ImageTokens()
+	case extractjob.FieldCostUsd:
+		return m.CostUsd()
+	case extractjob.FieldLlmCacheHit:
+		return m.LlmCacheHit()
+	case extractjob.FieldParseAttempts:
+		return m.ParseAttempts()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExtractJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case extractjob.FieldFileID:
+		return m.OldFileID(ctx)
+	case extractjob.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case extractjob.FieldReceiptID:
+		return m.OldReceiptID(ctx)
+	case extractjob.FieldFormat:
+		return m.OldFormat(ctx)
+	case extractjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case extractjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case extractjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case extractjob.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case extractjob.FieldExtractionConfidence:
+		return m.OldExtractionConfidence(ctx)
+	case extractjob.FieldNeedsReview:
+		return m.OldNeedsReview(ctx)
+	case extractjob.FieldOcrText:
+		return m.OldOcrText(ctx)
+	case extractjob.FieldExtractedJSON:
+		return m.OldExtractedJSON(ctx)
+	case extractjob.FieldModelName:
+		return m.OldModelName(ctx)
+	case extractjob.FieldModelParams:
+		return m.OldModelParams(ctx)
+	case extractjob.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case extractjob.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case extractjob.FieldImageTokens:
+		return m.OldImageTokens(ctx)
+	case extractjob.FieldCostUsd:
+		return m.OldCostUsd(ctx)
+	case extractjob.FieldLlmCacheHit:
+		return m.OldLlmCacheHit(ctx)
+	case extractjob.FieldParseAttempts:
+		return m.OldParseAttempts(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExtractJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtractJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case extractjob.FieldFileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case extractjob.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case extractjob.FieldReceiptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiptID(v)
+		return nil
+	case extractjob.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case extractjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case extractjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case extractjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case extractjob.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case extractjob.FieldExtractionConfidence:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtractionConfidence(v)
+		return nil
+	case extractjob.FieldNeedsReview:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNeedsReview(v)
+		return nil
+	case extractjob.FieldOcrText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOcrText(v)
+		return nil
+	case extractjob.FieldExtractedJSON:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtractedJSON(v)
+		return nil
+	case extractjob.FieldModelName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelName(v)
+		return nil
+	case extractjob.FieldModelParams:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelParams(v)
+		return nil
+	case extractjob.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case extractjob.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case extractjob.FieldImageTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageTokens(v)
+		return nil
+	case extractjob.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostUsd(v)
+		return nil
+	case extractjob.FieldLlmCacheHit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLlmCacheHit(v)
+		return nil
+	case extractjob.FieldParseAttempts:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParseAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExtractJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addextraction_confidence != nil {
+		fields = append(fields, extractjob.FieldExtractionConfidence)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, extractjob.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, extractjob.FieldCompletionTokens)
+	}
+	if m.addimage_tokens != nil {
+		fields = append(fields, extractjob.FieldImageTokens)
+	}
+	if m.addcost_usd != nil {
+		fields = append(fields, extractjob.FieldCostUsd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExtractJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case extractjob.FieldExtractionConfidence:
+		return m.AddedExtractionConfidence()
+	case extractjob.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case extractjob.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case extractjob.FieldImageTokens:
+		return m.AddedImageTokens()
+	case extractjob.FieldCostUsd:
+		return m.AddedCostUsd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExtractJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case extractjob.FieldExtractionConfidence:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExtractionConfidence(v)
+		return nil
+	case extractjob.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case extractjob.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case extractjob.FieldImageTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImageTokens(v)
+		return nil
+	case extractjob.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExtractJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(extractjob.FieldReceiptID) {
+		fields = append(fields, extractjob.FieldReceiptID)
+	}
+	if m.FieldCleared(extractjob.FieldFinishedAt) {
+		fields = append(fields, extractjob.FieldFinishedAt)
+	}
+	if m.FieldCleared(extractjob.FieldStatus) {
+		fields = append(fields, extractjob.FieldStatus)
+	}
+	if m.FieldCleared(extractjob.FieldErrorMessage) {
+		fields = append(fields, extractjob.FieldErrorMessage)
+	}
+	if m.FieldCleared(extractjob.FieldExtractionConfidence) {
+		fields = append(fields, extractjob.FieldExtractionConfidence)
+	}
+	if m.FieldCleared(extractjob.FieldOcrText) {
+		fields = append(fields, extractjob.FieldOcrText)
+	}
+	if m.FieldCleared(extractjob.FieldExtractedJSON) {
+		fields = append(fields, extractjob.FieldExtractedJSON)
+	}
+	if m.FieldCleared(extractjob.FieldModelName) {
+		fields = append(fields, extractjob.FieldModelName)
+	}
+	if m.FieldCleared(extractjob.FieldModelParams) {
+		fields = append(fields, extractjob.FieldModelParams)
+	}
+	if m.FieldCleared(extractjob.FieldPromptTokens) {
+		fields = append(fields, extractjob.FieldPromptTokens)
+	}
+	if m.FieldCleared(extractjob.FieldCompletionTokens) {
+		fields = append(fields, extractjob.FieldCompletionTokens)
+	}
+	if m.FieldCleared(extractjob.FieldImageTokens) {
+		fields = append(fields, extractjob.FieldImageTokens)
+	}
+	if m.FieldCleared(extractjob.FieldCostUsd) {
+		fields = append(fields, extractjob.FieldCostUsd)
+	}
+	if m.FieldCleared(extractjob.FieldParseAttempts) {
+		fields = append(fields, extractjob.FieldParseAttempts)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExtractJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExtractJobMutation) ClearField(name string) error {
+	switch name {
+	case extractjob.FieldReceiptID:
+		m.ClearReceiptID()
+		return nil
+	case extractjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case extractjob.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case extractjob.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case extractjob.FieldExtractionConfidence:
+		m.ClearExtractionConfidence()
+		return nil
+	case extractjob.FieldOcrText:
+		m.ClearOcrText()
+		return nil
+	case extractjob.FieldExtractedJSON:
+		m.ClearExtractedJSON()
+		return nil
+	case extractjob.FieldModelName:
+		m.ClearModelName()
+		return nil
+	case extractjob.FieldModelParams:
+		m.ClearModelParams()
+		return nil
+	case extractjob.FieldPromptTokens:
+		m.ClearPromptTokens()
+		return nil
+	case extractjob.FieldCompletionTokens:
+		m.ClearCompletionTokens()
+		return nil
+	case extractjob.FieldImageTokens:
+		m.ClearImageTokens()
+		return nil
+	case extractjob.FieldCostUsd:
+		m.ClearCostUsd()
+		return nil
+	case extractjob.FieldParseAttempts:
+		m.ClearParseAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExtractJobMutation) ResetField(name string) error {
+	switch name {
+	case extractjob.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case extractjob.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case extractjob.FieldReceiptID:
+		m.ResetReceiptID()
+		return nil
+	case extractjob.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case extractjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case extractjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case extractjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case extractjob.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case extractjob.FieldExtractionConfidence:
+		m.ResetExtractionConfidence()
+		return nil
+	case extractjob.FieldNeedsReview:
+		m.ResetNeedsReview()
+		return nil
+	case extractjob.FieldOcrText:
+		m.ResetOcrText()
+		return nil
+	case extractjob.FieldExtractedJSON:
+		m.ResetExtractedJSON()
+		return nil
+	case extractjob.FieldModelName:
+		m.ResetModelName()
+		return nil
+	case extractjob.FieldModelParams:
+		m.ResetModelParams()
+		return nil
+	case extractjob.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case extractjob.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case extractjob.FieldImageTokens:
+		m.ResetImageTokens()
+		return nil
+	case extractjob.FieldCostUsd:
+		m.ResetCostUsd()
+		return nil
+	case extractjob.FieldLlmCacheHit:
+		m.ResetLlmCacheHit()
+		return nil
+	case extractjob.FieldParseAttempts:
+		m.ResetParseAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExtractJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.file != nil {
+		edges = append(edges, extractjob.EdgeFile)
+	}
+	if m.profile != nil {
+		edges = append(edges, extractjob.EdgeProfile)
+	}
+	if m.receipt != nil {
+		edges = append(edges, extractjob.EdgeReceipt)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExtractJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case extractjob.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	case extractjob.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case extractjob.EdgeReceipt:
+		if id := m.receipt; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExtractJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExtractJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExtractJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedfile {
+		edges = append(edges, extractjob.EdgeFile)
+	}
+	if m.clearedprofile {
+		edges = append(edges, extractjob.EdgeProfile)
+	}
+	if m.clearedreceipt {
+		edges = append(edges, extractjob.EdgeReceipt)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExtractJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case extractjob.EdgeFile:
+		return m.clearedfile
+	case extractjob.EdgeProfile:
+		return m.clearedprofile
+	case extractjob.EdgeReceipt:
+		return m.clearedreceipt
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExtractJobMutation) ClearEdge(name string) error {
+	switch name {
+	case extractjob.EdgeFile:
+		m.ClearFile()
+		return nil
+	case extractjob.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	case extractjob.EdgeReceipt:
+		m.ClearReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExtractJobMutation) ResetEdge(name string) error {
+	switch name {
+	case extractjob.EdgeFile:
+		m.ResetFile()
+		return nil
+	case extractjob.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case extractjob.EdgeReceipt:
+		m.ResetReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExtractJob edge %s", name)
+}
+
+// FxRateMutation represents an operation that mutates the FxRate nodes in the graph.
+type FxRateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	base          *string
+	quote         *string
+	rate_date     *time.Time
+	rate          *float64
+	addrate       *float64
+	source        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FxRate, error)
+	predicates    []predicate.FxRate
+}
+
+var _ ent.Mutation = (*FxRateMutation)(nil)
+
+// fxrateOption allows management of the mutation configuration using functional options.
+type fxrateOption func(*FxRateMutation)
+
+// newFxRateMutation creates new mutation for the FxRate entity.
+func newFxRateMutation(c config, op Op, opts ...fxrateOption) *FxRateMutation {
+	m := &FxRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFxRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFxRateID sets the ID field of the mutation.
+func withFxRateID(id uuid.UUID) fxrateOption {
+	return func(m *FxRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FxRate
+		)
+		m.oldValue = func(ctx context.Context) (*FxRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FxRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFxRate sets the old FxRate of the mutation.
+func withFxRate(node *FxRate) fxrateOption {
+	return func(m *FxRateMutation) {
+		m.oldValue = func(context.Context) (*FxRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FxRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FxRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FxRate entities.
+func (m *FxRateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FxRateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FxRateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FxRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBase sets the "base" field.
+func (m *FxRateMutation) SetBase(s string) {
+	m.base = &s
+}
+
+// Base returns the value of the "base" field in the mutation.
+func (m *FxRateMutation) Base() (r string, exists bool) {
+	v := m.base
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBase returns the old "base" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldBase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBase: %w", err)
+	}
+	return oldValue.Base, nil
+}
+
+// ResetBase resets all changes to the "base" field.
+func (m *FxRateMutation) ResetBase() {
+	m.base = nil
+}
+
+// SetQuote sets the "quote" field.
+func (m *FxRateMutation) SetQuote(s string) {
+	m.quote = &s
+}
+
+// Quote returns the value of the "quote" field in the mutation.
+func (m *FxRateMutation) Quote() (r string, exists bool) {
+	v := m.quote
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuote returns the old "quote" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldQuote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuote: %w", err)
+	}
+	return oldValue.Quote, nil
+}
+
+// ResetQuote resets all changes to the "quote" field.
+func (m *FxRateMutation) ResetQuote() {
+	m.quote = nil
+}
+
+// SetRateDate sets the "rate_date" field.
+func (m *FxRateMutation) SetRateDate(t time.Time) {
+	m.rate_date = &t
+}
+
+// RateDate returns the value of the "rate_date" field in the mutation.
+func (m *FxRateMutation) RateDate() (r time.Time, exists bool) {
+	v := m.rate_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRateDate returns the old "rate_date" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldRateDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRateDate: %w", err)
+	}
+	return oldValue.RateDate, nil
+}
+
+// ResetRateDate resets all changes to the "rate_date" field.
+func (m *FxRateMutation) ResetRateDate() {
+	m.rate_date = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *FxRateMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *FxRateMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *FxRateMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *FxRateMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *FxRateMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetSource sets the "source" field.
+func (m *FxRateMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *FxRateMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *FxRateMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FxRateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FxRateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FxRateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FxRateMutation builder.
+func (m *FxRateMutation) Where(ps ...predicate.FxRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FxRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FxRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FxRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FxRateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FxRateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FxRate).
+func (m *FxRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FxRateMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.base != nil {
+		fields = append(fields, fxrate.FieldBase)
+	}
+	if m.quote != nil {
+		fields = append(fields, fxrate.FieldQuote)
+	}
+	if m.rate_date != nil {
+		fields = append(fields, fxrate.FieldRateDate)
+	}
+	if m.rate != nil {
+		fields = append(fields, fxrate.FieldRate)
+	}
+	if m.source != nil {
+		fields = append(fields, fxrate.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fxrate.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FxRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fxrate.FieldBase:
+		return m.Base()
+	case fxrate.FieldQuote:
+		return m.Quote()
+	case fxrate.FieldRateDate:
+		return m.RateDate()
+	case fxrate.FieldRate:
+		return m.Rate()
+	case fxrate.FieldSource:
+		return m.Source()
+	case fxrate.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FxRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fxrate.FieldBase:
+		return m.OldBase(ctx)
+	case fxrate.FieldQuote:
+		return m.OldQuote(ctx)
+	case fxrate.FieldRateDate:
+		return m.OldRateDate(ctx)
+	case fxrate.FieldRate:
+		return m.OldRate(ctx)
+	case fxrate.FieldSource:
+		return m.OldSource(ctx)
+	case fxrate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FxRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fxrate.FieldBase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBase(v)
+		return nil
+	case fxrate.FieldQuote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuote(v)
+		return nil
+	case fxrate.FieldRateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateDate(v)
+		return nil
+	case fxrate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case fxrate.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case fxrate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FxRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, fxrate.FieldRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FxRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fxrate.FieldRate:
+		return m.AddedRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FxRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fxrate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FxRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FxRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FxRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FxRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FxRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FxRateMutation) ResetField(name string) error {
+	switch name {
+	case fxrate.FieldBase:
+		m.ResetBase()
+		return nil
+	case fxrate.FieldQuote:
+		m.ResetQuote()
+		return nil
+	case fxrate.FieldRateDate:
+		m.ResetRateDate()
+		return nil
+	case fxrate.FieldRate:
+		m.ResetRate()
+		return nil
+	case fxrate.FieldSource:
+		m.ResetSource()
+		return nil
+	case fxrate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FxRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FxRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FxRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FxRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FxRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FxRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FxRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FxRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FxRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FxRate edge %s", name)
+}
+
+// MerchantMutation represents an operation that mutates the Merchant nodes in the graph.
+type MerchantMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	aliases          *[]string
+	appendaliases    []string
+	keys             *[]string
+	appendkeys       []string
+	default_category *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	profile          *uuid.UUID
+	clearedprofile   bool
+	receipts         map[uuid.UUID]struct{}
+	removedreceipts  map[uuid.UUID]struct{}
+	clearedreceipts  bool
+	done             bool
+	oldValue         func(context.Context) (*Merchant, error)
+	predicates       []predicate.Merchant
+}
+
+var _ ent.Mutation = (*MerchantMutation)(nil)
+
+// merchantOption allows management of the mutation configuration using functional options.
+type merchantOption func(*MerchantMutation)
+
+// newMerchantMutation creates new mutation for the Merchant entity.
+func newMerchantMutation(c config, op Op, opts ...merchantOption) *MerchantMutation {
+	m := &MerchantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMerchant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMerchantID sets the ID field of the mutation.
+func withMerchantID(id uuid.UUID) merchantOption {
+	return func(m *MerchantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Merchant
+		)
+		m.oldValue = func(ctx context.Context) (*Merchant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Merchant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMerchant sets the old Merchant of the mutation.
+func withMerchant(node *Merchant) merchantOption {
+	return func(m *MerchantMutation) {
+		m.oldValue = func(context.Context) (*Merchant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MerchantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MerchantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Merchant entities.
+func (m *MerchantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MerchantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MerchantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Merchant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *MerchantMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *MerchantMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *MerchantMutation) ResetProfileID() {
+	m.profile = nil
+}
+
+// SetName sets the "name" field.
+func (m *MerchantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MerchantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MerchantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAliases sets the "aliases" field.
+func (m *MerchantMutation) SetAliases(s []string) {
+	m.aliases = &s
+	m.appendaliases = nil
+}
+
+// Aliases returns the value of the "aliases" field in the mutation.
+func (m *MerchantMutation) Aliases() (r []string, exists bool) {
+	v := m.aliases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAliases returns the old "aliases" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldAliases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAliases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAliases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAliases: %w", err)
+	}
+	return oldValue.Aliases, nil
+}
+
+// AppendAliases adds s to the "aliases" field.
+func (m *MerchantMutation) AppendAliases(s []string) {
+	m.appendaliases = append(m.appendaliases, s...)
+}
+
+// AppendedAliases returns the list of values that were appended to the "aliases" field in this mutation.
+func (m *MerchantMutation) AppendedAliases() ([]string, bool) {
+	if len(m.appendaliases) == 0 {
+		return nil, false
+	}
+	return m.appendaliases, true
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (m *MerchantMutation) ClearAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	m.clearedFields[merchant.FieldAliases] = struct{}{}
+}
+
+// AliasesCleared returns if the "aliases" field was cleared in this mutation.
+func (m *MerchantMutation) AliasesCleared() bool {
+	_, ok := m.clearedFields[merchant.FieldAliases]
+	return ok
+}
+
+// ResetAliases resets all changes to the "aliases" field.
+func (m *MerchantMutation) ResetAliases() {
+	m.aliases = nil
+	m.appendaliases = nil
+	delete(m.clearedFields, merchant.FieldAliases)
+}
+
+// SetKeys sets the "keys" field.
+func (m *MerchantMutation) SetKeys(s []string) {
+	m.keys = &s
+	m.appendkeys = nil
+}
+
+// Keys returns the value of the "keys" field in the mutation.
+func (m *MerchantMutation) Keys() (r []string, exists bool) {
+	v := m.keys
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeys returns the old "keys" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldKeys(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeys is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeys requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeys: %w", err)
+	}
+	return oldValue.Keys, nil
+}
+
+// AppendKeys adds s to the "keys" field.
+func (m *MerchantMutation) AppendKeys(s []string) {
+	m.appendkeys = append(m.appendkeys, s...)
+}
+
+// AppendedKeys returns the list of values that were appended to the "keys" field in this mutation.
+func (m *MerchantMutation) AppendedKeys() ([]string, bool) {
+	if len(m.appendkeys) == 0 {
+		return nil, false
+	}
+	return m.appendkeys, true
+}
+
+// ClearKeys clears the value of the "keys" field.
+func (m *MerchantMutation) ClearKeys() {
+	m.keys = nil
+	m.appendkeys = nil
+	m.clearedFields[merchant.FieldKeys] = struct{}{}
+}
+
+// KeysCleared returns if the "keys" field was cleared in this mutation.
+func (m *MerchantMutation) KeysCleared() bool {
+	_, ok := m.clearedFields[merchant.FieldKeys]
+	return ok
+}
+
+// ResetKeys resets all changes to the "keys" field.
+func (m *MerchantMutation) ResetKeys() {
+	m.keys = nil
+	m.appendkeys = nil
+	delete(m.clearedFields, merchant.FieldKeys)
+}
+
+// SetDefaultCategory sets the "default_category" field.
+func (m *MerchantMutation) SetDefaultCategory(s string) {
+	m.default_category = &s
+}
+
+// DefaultCategory returns the value of the "default_category" field in the mutation.
+func (m *MerchantMutation) DefaultCategory() (r string, exists bool) {
+	v := m.default_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultCategory returns the old "default_category" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldDefaultCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultCategory: %w", err)
+	}
+	return oldValue.DefaultCategory, nil
+}
+
+// ClearDefaultCategory clears the value of the "default_category" field.
+func (m *MerchantMutation) ClearDefaultCategory() {
+	m.default_category = nil
+	m.clearedFields[merchant.FieldDefaultCategory] = struct{}{}
+}
+
+// DefaultCategoryCleared returns if the "default_category" field was cleared in this mutation.
+func (m *MerchantMutation) DefaultCategoryCleared() bool {
+	_, ok := m.clearedFields[merchant.FieldDefaultCategory]
+	return ok
+}
+
+// ResetDefaultCategory resets all changes to the "default_category" field.
+func (m *MerchantMutation) ResetDefaultCategory() {
+	m.default_category = nil
+	delete(m.clearedFields, merchant.FieldDefaultCategory)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MerchantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MerchantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MerchantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MerchantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MerchantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Merchant entity.
+// If the Merchant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MerchantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MerchantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *MerchantMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[merchant.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *MerchantMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *MerchantMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *MerchantMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by ids.
+func (m *MerchantMutation) AddReceiptIDs(ids ...uuid.UUID) {
+	if m.receipts == nil {
+		m.receipts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.receipts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReceipts clears the "receipts" edge to the Receipt entity.
+func (m *MerchantMutation) ClearReceipts() {
+	m.clearedreceipts = true
+}
+
+// ReceiptsCleared reports if the "receipts" edge to the Receipt entity was cleared.
+func (m *MerchantMutation) ReceiptsCleared() bool {
+	return m.clearedreceipts
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to the Receipt entity by IDs.
+func (m *MerchantMutation) RemoveReceiptIDs(ids ...uuid.UUID) {
+	if m.removedreceipts == nil {
+		m.removedreceipts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.receipts, ids[i])
+		m.removedreceipts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceipts returns the removed IDs of the "receipts" edge to the Receipt entity.
+func (m *MerchantMutation) RemovedReceiptsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreceipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReceiptsIDs returns the "receipts" edge IDs in the mutation.
+func (m *MerchantMutation) ReceiptsIDs() (ids []uuid.UUID) {
+	for id := range m.receipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReceipts resets all changes to the "receipts" edge.
+func (m *MerchantMutation) ResetReceipts() {
+	m.receipts = nil
+	m.clearedreceipts = false
+	m.removedreceipts = nil
+}
+
+// Where appends a list predicates to the MerchantMutation builder.
+func (m *MerchantMutation) Where(ps ...predicate.Merchant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MerchantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MerchantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Merchant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MerchantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MerchantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Merchant).
+func (m *MerchantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MerchantMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.profile != nil {
+		fields = append(fields, merchant.FieldProfileID)
+	}
+	if m.name != nil {
+		fields = append(fields, merchant.FieldName)
+	}
+	if m.aliases != nil {
+		fields = append(fields, merchant.FieldAliases)
+	}
+	if m.keys != nil {
+		fields = append(fields, merchant.FieldKeys)
+	}
+	if m.default_category != nil {
+		fields = append(fields, merchant.FieldDefaultCategory)
+	}
+	if m.created_at != nil {
+		fields = append(fields, merchant.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, merchant.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MerchantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case merchant.FieldProfileID:
+		return m.ProfileID()
+	case merchant.FieldName:
+		return m.Name()
+	case merchant.FieldAliases:
+		return m.Aliases()
+	case merchant.FieldKeys:
+		return m.Keys()
+	case merchant.FieldDefaultCategory:
+		return m.DefaultCategory()
+	case merchant.FieldCreatedAt:
+		return m.CreatedAt()
+	case merchant.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MerchantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case merchant.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case merchant.FieldName:
+		return m.OldName(ctx)
+	case merchant.FieldAliases:
+		return m.OldAliases(ctx)
+	case merchant.FieldKeys:
+		return m.OldKeys(ctx)
+	case merchant.FieldDefaultCategory:
+		return m.OldDefaultCategory(ctx)
+	case merchant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case merchant.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Merchant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MerchantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case merchant.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case merchant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case merchant.FieldAliases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAliases(v)
+		return nil
+	case merchant.FieldKeys:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeys(v)
+		return nil
+	case merchant.FieldDefaultCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultCategory(v)
+		return nil
+	case merchant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case merchant.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Merchant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MerchantMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MerchantMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MerchantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Merchant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MerchantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(merchant.FieldAliases) {
+		fields = append(fields, merchant.FieldAliases)
+	}
+	if m.FieldCleared(merchant.FieldKeys) {
+		fields = append(fields, merchant.FieldKeys)
+	}
+	if m.FieldCleared(merchant.FieldDefaultCategory) {
+		fields = append(fields, merchant.FieldDefaultCategory)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MerchantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MerchantMutation) ClearField(name string) error {
+	switch name {
+	case merchant.FieldAliases:
+		m.ClearAliases()
+		return nil
+	case merchant.FieldKeys:
+		m.ClearKeys()
+		return nil
+	case merchant.FieldDefaultCategory:
+		m.ClearDefaultCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Merchant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MerchantMutation) ResetField(name string) error {
+	switch name {
+	case merchant.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case merchant.FieldName:
+		m.ResetName()
+		return nil
+	case merchant.FieldAliases:
+		m.ResetAliases()
+		return nil
+	case merchant.FieldKeys:
+		m.ResetKeys()
+		return nil
+	case merchant.FieldDefaultCategory:
+		m.ResetDefaultCategory()
+		return nil
+	case merchant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case merchant.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Merchant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MerchantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.profile != nil {
+		edges = append(edges, merchant.EdgeProfile)
+	}
+	if m.receipts != nil {
+		edges = append(edges, merchant.EdgeReceipts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MerchantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case merchant.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case merchant.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.receipts))
+		for id := range m.receipts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MerchantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedreceipts != nil {
+		edges = append(edges, merchant.EdgeReceipts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MerchantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case merchant.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.removedreceipts))
+		for id := range m.removedreceipts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MerchantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedprofile {
+		edges = append(edges, merchant.EdgeProfile)
+	}
+	if m.clearedreceipts {
+		edges = append(edges, merchant.EdgeReceipts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MerchantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case merchant.EdgeProfile:
+		return m.clearedprofile
+	case merchant.EdgeReceipts:
+		return m.clearedreceipts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MerchantMutation) ClearEdge(name string) error {
+	switch name {
+	case merchant.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown Merchant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MerchantMutation) ResetEdge(name string) error {
+	switch name {
+	case merchant.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case merchant.EdgeReceipts:
+		m.ResetReceipts()
+		return nil
+	}
+	return fmt.Errorf("unknown Merchant edge %s", name)
+}
+
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	name                          *string
+	job_title                     *string
+	job_description               *string
+	default_currency              *string
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	receipts                      map[uuid.UUID]struct{}
+	removedreceipts               map[uuid.UUID]struct{}
+	clearedreceipts               bool
+	files                         map[uuid.UUID]struct{}
+	removedfiles                  map[uuid.UUID]struct{}
+	clearedfiles                  bool
+	jobs                          map[uuid.UUID]struct{}
+	removedjobs                   map[uuid.UUID]struct{}
+	clearedjobs                   bool
+	categories                    map[uuid.UUID]struct{}
+	removedcategories             map[uuid.UUID]struct{}
+	clearedcategories             bool
+	rules                         map[uuid.UUID]struct{}
+	removedrules                  map[uuid.UUID]struct{}
+	clearedrules                  bool
+	corrections                   map[uuid.UUID]struct{}
+	removedcorrections            map[uuid.UUID]struct{}
+	clearedcorrections            bool
+	merchants                     map[uuid.UUID]struct{}
+	removedmerchants              map[uuid.UUID]struct{}
+	clearedmerchants              bool
+	export_templates              map[uuid.UUID]struct{}
+	removedexport_templates       map[uuid.UUID]struct{}
+	clearedexport_templates       bool
+	statement_transactions        map[uuid.UUID]struct{}
+	removedstatement_transactions map[uuid.UUID]struct{}
+	clearedstatement_transactions bool
+	budgets                       map[uuid.UUID]struct{}
+	removedbudgets                map[uuid.UUID]struct{}
+	clearedbudgets                bool
+	done                          bool
+	oldValue                      func(context.Context) (*Profile, error)
+	predicates                    []predicate.Profile
+}
+
+var _ ent.Mutation = (*ProfileMutation)(nil)
+
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
+
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id uuid.UUID) profileOption {
+	return func(m *ProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Profile
+		)
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Profile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Profile entities.
+func (m *ProfileMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetJobTitle sets the "job_title" field.
+func (m *ProfileMutation) SetJobTitle(s string) {
+	m.job_title = &s
+}
+
+// JobTitle returns the value of the "job_title" field in the mutation.
+func (m *ProfileMutation) JobTitle() (r string, exists bool) {
+	v := m.job_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobTitle returns the old "job_title" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldJobTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobTitle: %w", err)
+	}
+	return oldValue.JobTitle, nil
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (m *ProfileMutation) ClearJobTitle() {
+	m.job_title = nil
+	m.clearedFields[profile.FieldJobTitle] = struct{}{}
+}
+
+// JobTitleCleared returns if the "job_title" field was cleared in this mutation.
+func (m *ProfileMutation) JobTitleCleared() bool {
+	_, ok := m.clearedFields[profile.FieldJobTitle]
+	return ok
+}
+
+// ResetJobTitle resets all changes to the "job_title" field.
+func (m *ProfileMutation) ResetJobTitle() {
+	m.job_title = nil
+	delete(m.clearedFields, profile.FieldJobTitle)
+}
+
+// SetJobDescription sets the "job_description" field.
+func (m *ProfileMutation) SetJobDescription(s string) {
+	m.job_description = &s
+}
+
+// JobDescription returns the value of the "job_description" field in the mutation.
+func (m *ProfileMutation) JobDescription() (r string, exists bool) {
+	v := m.job_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobDescription returns the old "job_description" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldJobDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobDescription: %w", err)
+	}
+	return oldValue.JobDescription, nil
+}
+
+// ClearJobDescription clears the value of the "job_description" field.
+func (m *ProfileMutation) ClearJobDescription() {
+	m.job_description = nil
+	m.clearedFields[profile.FieldJobDescription] = struct{}{}
+}
+
+// JobDescriptionCleared returns if the "job_description" field was cleared in this mutation.
+func (m *ProfileMutation) JobDescriptionCleared() bool {
+	_, ok := m.clearedFields[profile.FieldJobDescription]
+	return ok
+}
+
+// ResetJobDescription resets all changes to the "job_description" field.
+func (m *ProfileMutation) ResetJobDescription() {
+	m.job_description = nil
+	delete(m.clearedFields, profile.FieldJobDescription)
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (m *ProfileMutation) SetDefaultCurrency(s string) {
+	m.default_currency = &s
+}
+
+// DefaultCurrency returns the value of the "default_currency" field in the mutation.
+func (m *ProfileMutation) DefaultCurrency() (r string, exists bool) {
+	v := m.default_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultCurrency returns the old "default_currency" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldDefaultCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultCurrency: %w", err)
+	}
+	return oldValue.DefaultCurrency, nil
+}
+
+// ResetDefaultCurrency resets all changes to the "default_currency" field.
+func (m *ProfileMutation) ResetDefaultCurrency() {
+	m.default_currency = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by ids.
+func (m *ProfileMutation) AddReceiptIDs(ids ...uuid.UUID) {
+	if m.receipts == nil {
+		m.receipts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.receipts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReceipts clears the "receipts" edge to the Receipt entity.
+func (m *ProfileMutation) ClearReceipts() {
+	m.clearedreceipts = true
+}
+
+// ReceiptsCleared reports if the "receipts" edge to the Receipt entity was cleared.
+func (m *ProfileMutation) ReceiptsCleared() bool {
+	return m.clearedreceipts
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to the Receipt entity by IDs.
+func (m *ProfileMutation) RemoveReceiptIDs(ids ...uuid.UUID) {
+	if m.removedreceipts == nil {
+		m.removedreceipts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.receipts, ids[i])
+		m.removedreceipts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceipts returns the removed IDs of the "receipts" edge to the Receipt entity.
+func (m *ProfileMutation) RemovedReceiptsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreceipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReceiptsIDs returns the "receipts" edge IDs in the mutation.
+func (m *ProfileMutation) ReceiptsIDs() (ids []uuid.UUID) {
+	for id := range m.receipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReceipts resets all changes to the "receipts" edge.
+func (m *ProfileMutation) ResetReceipts() {
+	m.receipts = nil
+	m.clearedreceipts = false
+	m.removedreceipts = nil
+}
+
+// AddFileIDs adds the "files" edge to the ReceiptFile entity by ids.
+func (m *ProfileMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the ReceiptFile entity.
+func (m *ProfileMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the ReceiptFile entity was cleared.
+func (m *ProfileMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the ReceiptFile entity by IDs.
+func (m *ProfileMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the ReceiptFile entity.
+func (m *ProfileMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *ProfileMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *ProfileMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the ExtractJob entity by ids.
+func (m *ProfileMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the ExtractJob entity.
+func (m *ProfileMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the ExtractJob entity was cleared.
+func (m *ProfileMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the ExtractJob entity by IDs.
+func (m *ProfileMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the ExtractJob entity.
+func (m *ProfileMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *ProfileMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *ProfileMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
+func (m *ProfileMutation) AddCategoryIDs(ids ...uuid.UUID) {
+	if m.categories == nil {
+		m.categories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.categories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCategories clears the "categories" edge to the Category entity.
+func (m *ProfileMutation) ClearCategories() {
+	m.clearedcategories = true
+}
+
+// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
+func (m *ProfileMutation) CategoriesCleared() bool {
+	return m.clearedcategories
+}
+
+// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
+func (m *ProfileMutation) RemoveCategoryIDs(ids ...uuid.UUID) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
+func (m *ProfileMutation) RemovedCategoriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcategories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *ProfileMutation) CategoriesIDs() (ids []uuid.UUID) {
+	for id := range m.categories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCategories resets all changes to the "categories" edge.
+func (m *ProfileMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
+}
+
+// AddRuleIDs adds the "rules" edge to the CategoryRule entity by ids.
+func (m *ProfileMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRules clears the "rules" edge to the CategoryRule entity.
+func (m *ProfileMutation) ClearRules() {
+	m.clearedrules = true
+}
+
+// RulesCleared reports if the "rules" edge to the CategoryRule entity was cleared.
+func (m *ProfileMutation) RulesCleared() bool {
+	return m.clearedrules
+}
+
+// RemoveRuleIDs removes the "rules" edge to the CategoryRule entity by IDs.
+func (m *ProfileMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRules returns the removed IDs of the "rules" edge to the CategoryRule entity.
+func (m *ProfileMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *ProfileMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRules resets all changes to the "rules" edge.
+func (m *ProfileMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the CategoryCorrection entity by ids.
+func (m *ProfileMutation) AddCorrectionIDs(ids ...uuid.UUID) {
+	if m.corrections == nil {
+		m.corrections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.corrections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCorrections clears the "corrections" edge to the CategoryCorrection entity.
+func (m *ProfileMutation) ClearCorrections() {
+	m.clearedcorrections = true
+}
+
+// CorrectionsCleared reports if the "corrections" edge to the CategoryCorrection entity was cleared.
+func (m *ProfileMutation) CorrectionsCleared() bool {
+	return m.clearedcorrections
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to the CategoryCorrection entity by IDs.
+func (m *ProfileMutation) RemoveCorrectionIDs(ids ...uuid.UUID) {
+	if m.removedcorrections == nil {
+		m.removedcorrections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.corrections, ids[i])
+		m.removedcorrections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCorrections returns the removed IDs of the "corrections" edge to the CategoryCorrection entity.
+func (m *ProfileMutation) RemovedCorrectionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcorrections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CorrectionsIDs returns the "corrections" edge IDs in the mutation.
+func (m *ProfileMutation) CorrectionsIDs() (ids []uuid.UUID) {
+	for id := range m.corrections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCorrections resets all changes to the "corrections" edge.
+func (m *ProfileMutation) ResetCorrections() {
+	m.corrections = nil
+	m.clearedcorrections = false
+	m.removedcorrections = nil
+}
+
+// AddMerchantIDs adds the "merchants" edge to the Merchant entity by ids.
+func (m *ProfileMutation) AddMerchantIDs(ids ...uuid.UUID) {
+	if m.merchants == nil {
+		m.merchants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.merchants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMerchants clears the "merchants" edge to the Merchant entity.
+func (m *ProfileMutation) ClearMerchants() {
+	m.clearedmerchants = true
+}
+
+// MerchantsCleared reports if the "merchants" edge to the Merchant entity was cleared.
+func (m *ProfileMutation) MerchantsCleared() bool {
+	return m.clearedmerchants
+}
+
+// RemoveMerchantIDs removes the "merchants" edge to the Merchant entity by IDs.
+func (m *ProfileMutation) RemoveMerchantIDs(ids ...uuid.UUID) {
+	if m.removedmerchants == nil {
+		m.removedmerchants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.merchants, ids[i])
+		m.removedmerchants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMerchants returns the removed IDs of the "merchants" edge to the Merchant entity.
+func (m *ProfileMutation) RemovedMerchantsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmerchants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MerchantsIDs returns the "merchants" edge IDs in the mutation.
+func (m *ProfileMutation) MerchantsIDs() (ids []uuid.UUID) {
+	for id := range m.merchants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMerchants resets all changes to the "merchants" edge.
+func (m *ProfileMutation) ResetMerchants() {
+	m.merchants = nil
+	m.clearedmerchants = false
+	m.removedmerchants = nil
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by ids.
+func (m *ProfileMutation) AddExportTemplateIDs(ids ...uuid.UUID) {
+	if m.export_templates == nil {
+		m.export_templates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.export_templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExportTemplates clears the "export_templates" edge to the ExportTemplate entity.
+func (m *ProfileMutation) ClearExportTemplates() {
+	m.clearedexport_templates = true
+}
+
+// ExportTemplatesCleared reports if the "export_templates" edge to the ExportTemplate entity was cleared.
+func (m *ProfileMutation) ExportTemplatesCleared() bool {
+	return m.clearedexport_templates
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to the ExportTemplate entity by IDs.
+func (m *ProfileMutation) RemoveExportTemplateIDs(ids ...uuid.UUID) {
+	if m.removedexport_templates == nil {
+		m.removedexport_templates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.export_templates, ids[i])
+		m.removedexport_templates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExportTemplates returns the removed IDs of the "export_templates" edge to the ExportTemplate entity.
+func (m *ProfileMutation) RemovedExportTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedexport_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExportTemplatesIDs returns the "export_templates" edge IDs in the mutation.
+func (m *ProfileMutation) ExportTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.export_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExportTemplates resets all changes to the "export_templates" edge.
+func (m *ProfileMutation) ResetExportTemplates() {
+	m.export_templates = nil
+	m.clearedexport_templates = false
+	m.removedexport_templates = nil
+}
+
+// AddStatementTransactionIDs adds the "statement_transactions" edge to the StatementTransaction entity by ids.
+func (m *ProfileMutation) AddStatementTransactionIDs(ids ...uuid.UUID) {
+	if m.statement_transactions == nil {
+		m.statement_transactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.statement_transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatementTransactions clears the "statement_transactions" edge to the StatementTransaction entity.
+func (m *ProfileMutation) ClearStatementTransactions() {
+	m.clearedstatement_transactions = true
+}
+
+// StatementTransactionsCleared reports if the "statement_transactions" edge to the StatementTransaction entity was cleared.
+func (m *ProfileMutation) StatementTransactionsCleared() bool {
+	return m.clearedstatement_transactions
+}
+
+// RemoveStatementTransactionIDs removes the "statement_transactions" edge to the StatementTransaction entity by IDs.
+func (m *ProfileMutation) RemoveStatementTransactionIDs(ids ...uuid.UUID) {
+	if m.removedstatement_transactions == nil {
+		m.removedstatement_transactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.statement_transactions, ids[i])
+		m.removedstatement_transactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatementTransactions returns the removed IDs of the "statement_transactions" edge to the StatementTransaction entity.
+func (m *ProfileMutation) RemovedStatementTransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedstatement_transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatementTransactionsIDs returns the "statement_transactions" edge IDs in the mutation.
+func (m *ProfileMutation) StatementTransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.statement_transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatementTransactions resets all changes to the "statement_transactions" edge.
+func (m *ProfileMutation) ResetStatementTransactions() {
+	m.statement_transactions = nil
+	m.clearedstatement_transactions = false
+	m.removedstatement_transactions = nil
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by ids.
+func (m *ProfileMutation) AddBudgetIDs(ids ...uuid.UUID) {
+	if m.budgets == nil {
+		m.budgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.budgets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBudgets clears the "budgets" edge to the Budget entity.
+func (m *ProfileMutation) ClearBudgets() {
+	m.clearedbudgets = true
+}
+
+// BudgetsCleared reports if the "budgets" edge to the Budget entity was cleared.
+func (m *ProfileMutation) BudgetsCleared() bool {
+	return m.clearedbudgets
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to the Budget entity by IDs.
+func (m *ProfileMutation) RemoveBudgetIDs(ids ...uuid.UUID) {
+	if m.removedbudgets == nil {
+		m.removedbudgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.budgets, ids[i])
+		m.removedbudgets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBudgets returns the removed IDs of the "budgets" edge to the Budget entity.
+func (m *ProfileMutation) RemovedBudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbudgets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BudgetsIDs returns the "budgets" edge IDs in the mutation.
+func (m *ProfileMutation) BudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.budgets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBudgets resets all changes to the "budgets" edge.
+func (m *ProfileMutation) ResetBudgets() {
+	m.budgets = nil
+	m.clearedbudgets = false
+	m.removedbudgets = nil
+}
+
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, profile.FieldName)
+	}
+	if m.job_title != nil {
+		fields = append(fields, profile.FieldJobTitle)
+	}
+	if m.job_description != nil {
+		fields = append(fields, profile.FieldJobDescription)
+	}
+	if m.default_currency != nil {
+		fields = append(fields, profile.FieldDefaultCurrency)
+	}
+	if m.created_at != nil {
+		fields = append(fields, profile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profile.FieldName:
+		return m.Name()
+	case profile.FieldJobTitle:
+		return m.JobTitle()
+	case profile.FieldJobDescription:
+		return m.JobDescription()
+	case profile.FieldDefaultCurrency:
+		return m.DefaultCurrency()
+	case profile.FieldCreatedAt:
+		return m.CreatedAt()
+	case profile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profile.FieldName:
+		return m.OldName(ctx)
+	case profile.FieldJobTitle:
+		return m.OldJobTitle(ctx)
+	case profile.FieldJobDescription:
+		return m.OldJobDescription(ctx)
+	case profile.FieldDefaultCurrency:
+		return m.OldDefaultCurrency(ctx)
+	case profile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Profile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case profile.FieldJobTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobTitle(v)
+		return nil
+	case profile.FieldJobDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobDescription(v)
+		return nil
+	case profile.FieldDefaultCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultCurrency(v)
+		return nil
+	case profile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Profile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(profile.FieldJobTitle) {
+		fields = append(fields, profile.FieldJobTitle)
+	}
+	if m.FieldCleared(profile.FieldJobDescription) {
+		fields = append(fields, profile.FieldJobDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileMutation) ClearField(name string) error {
+	switch name {
+	case profile.FieldJobTitle:
+		m.ClearJobTitle()
+		return nil
+	case profile.FieldJobDescription:
+		m.ClearJobDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileMutation) ResetField(name string) error {
+	switch name {
+	case profile.FieldName:
+		m.ResetName()
+		return nil
+	case profile.FieldJobTitle:
+		m.ResetJobTitle()
+		return nil
+	case profile.FieldJobDescription:
+		m.ResetJobDescription()
+		return nil
+	case profile.FieldDefaultCurrency:
+		m.ResetDefaultCurrency()
+		return nil
+	case profile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.receipts != nil {
+		edges = append(edges, profile.EdgeReceipts)
+	}
+	if m.files != nil {
+		edges = append(edges, profile.EdgeFiles)
+	}
+	if m.jobs != nil {
+		edges = append(edges, profile.EdgeJobs)
+	}
+	if m.categories != nil {
+		edges = append(edges, profile.EdgeCategories)
+	}
+	if m.rules != nil {
+		edges = append(edges, profile.EdgeRules)
+	}
+	if m.corrections != nil {
+		edges = append(edges, profile.EdgeCorrections)
+	}
+	if m.merchants != nil {
+		edges = append(edges, profile.EdgeMerchants)
+	}
+	if m.export_templates != nil {
+		edges = append(edges, profile.EdgeExportTemplates)
+	}
+	if m.statement_transactions != nil {
+		edges = append(edges, profile.EdgeStatementTransactions)
+	}
+	if m.budgets != nil {
+		edges = append(edges, profile.EdgeBudgets)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.receipts))
+		for id := range m.receipts {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCorrections:
+		ids := make([]ent.Value, 0, len(m.corrections))
+		for id := range m.corrections {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeMerchants:
+		ids := make([]ent.Value, 0, len(m.merchants))
+		for id := range m.merchants {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeExportTemplates:
+		ids := make([]ent.Value, 0, len(m.export_templates))
+		for id := range m.export_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeStatementTransactions:
+		ids := make([]ent.Value, 0, len(m.statement_transactions))
+		for id := range m.statement_transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.budgets))
+		for id := range m.budgets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedreceipts != nil {
+		edges = append(edges, profile.EdgeReceipts)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, profile.EdgeFiles)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, profile.EdgeJobs)
+	}
+	if m.removedcategories != nil {
+		edges = append(edges, profile.EdgeCategories)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, profile.EdgeRules)
+	}
+	if m.removedcorrections != nil {
+		edges = append(edges, profile.EdgeCorrections)
+	}
+	if m.removedmerchants != nil {
+		edges = append(edges, profile.EdgeMerchants)
+	}
+	if m.removedexport_templates != nil {
+		edges = append(edges, profile.EdgeExportTemplates)
+	}
+	if m.removedstatement_transactions != nil {
+		edges = append(edges, profile.EdgeStatementTransactions)
+	}
+	if m.removedbudgets != nil {
+		edges = append(edges, profile.EdgeBudgets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case profile.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.removedreceipts))
+		for id := range m.removedreceipts {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeCorrections:
+		ids := make([]ent.Value, 0, len(m.removedcorrections))
+		for id := range m.removedcorrections {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeMerchants:
+		ids := make([]ent.Value, 0, len(m.removedmerchants))
+		for id := range m.removedmerchants {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeExportTemplates:
+		ids := make([]ent.Value, 0, len(m.removedexport_templates))
+		for id := range m.removedexport_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeStatementTransactions:
+		ids := make([]ent.Value, 0, len(m.removedstatement_transactions))
+		for id := range m.removedstatement_transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case profile.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.removedbudgets))
+		for id := range m.removedbudgets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedreceipts {
+		edges = append(edges, profile.EdgeReceipts)
+	}
+	if m.clearedfiles {
+		edges = append(edges, profile.EdgeFiles)
+	}
+	if m.clearedjobs {
+		edges = append(edges, profile.EdgeJobs)
+	}
+	if m.clearedcategories {
+		edges = append(edges, profile.EdgeCategories)
+	}
+	if m.clearedrules {
+		edges = append(edges, profile.EdgeRules)
+	}
+	if m.clearedcorrections {
+		edges = append(edges, profile.EdgeCorrections)
+	}
+	if m.clearedmerchants {
+		edges = append(edges, profile.EdgeMerchants)
+	}
+	if m.clearedexport_templates {
+		edges = append(edges, profile.EdgeExportTemplates)
+	}
+	if m.clearedstatement_transactions {
+		edges = append(edges, profile.EdgeStatementTransactions)
+	}
+	if m.clearedbudgets {
+		edges = append(edges, profile.EdgeBudgets)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case profile.EdgeReceipts:
+		return m.clearedreceipts
+	case profile.EdgeFiles:
+		return m.clearedfiles
+	case profile.EdgeJobs:
+		return m.clearedjobs
+	case profile.EdgeCategories:
+		return m.clearedcategories
+	case profile.EdgeRules:
+		return m.clearedrules
+	case profile.EdgeCorrections:
+		return m.clearedcorrections
+	case profile.EdgeMerchants:
+		return m.clearedmerchants
+	case profile.EdgeExportTemplates:
+		return m.clearedexport_templates
+	case profile.EdgeStatementTransactions:
+		return m.clearedstatement_transactions
+	case profile.EdgeBudgets:
+		return m.clearedbudgets
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Profile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case profile.EdgeReceipts:
+		m.ResetReceipts()
+		return nil
+	case profile.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case profile.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case profile.EdgeCategories:
+		m.ResetCategories()
+		return nil
+	case profile.EdgeRules:
+		m.ResetRules()
+		return nil
+	case profile.EdgeCorrections:
+		m.ResetCorrections()
+		return nil
+	case profile.EdgeMerchants:
+		m.ResetMerchants()
+		return nil
+	case profile.EdgeExportTemplates:
+		m.ResetExportTemplates()
+		return nil
+	case profile.EdgeStatementTransactions:
+		m.ResetStatementTransactions()
+		return nil
+	case profile.EdgeBudgets:
+		m.ResetBudgets()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile edge %s", name)
+}
+
+// ReceiptMutation represents an operation that mutates the Receipt nodes in the graph.
+type ReceiptMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	file_id            *uuid.UUID
+	merchant_name      *string
+	tx_date            *time.Time
+	subtotal           *money.Amount
+	tax                *money.Amount
+	discount           *money.Amount
+	other_fees         *money.Amount
+	tip                *money.Amount
+	fees               *json.RawMessage
+	appendfees         json.RawMessage
+	total              *money.Amount
+	currency_code      *string
+	converted_total    *money.Amount
+	converted_currency *string
+	fx_rate            *float64
+	addfx_rate         *float64
+	fx_rate_date       *time.Time
+	fx_source          *string
+	category_name      *string
+	description        *string
+	file_path          *string
+	is_current         *bool
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	profile            *uuid.UUID
+	clearedprofile     bool
+	merchant           *uuid.UUID
+	clearedmerchant    bool
+	files              map[uuid.UUID]struct{}
+	removedfiles       map[uuid.UUID]struct{}
+	clearedfiles       bool
+	jobs               map[uuid.UUID]struct{}
+	removedjobs        map[uuid.UUID]struct{}
+	clearedjobs        bool
+	anomalies          map[uuid.UUID]struct{}
+	removedanomalies   map[uuid.UUID]struct{}
+	clearedanomalies   bool
+	done               bool
+	oldValue           func(context.Context) (*Receipt, error)
+	predicates         []predicate.Receipt
+}
+
+var _ ent.Mutation = (*ReceiptMutation)(nil)
+
+// receiptOption allows management of the mutation configuration using functional options.
+type receiptOption func(*ReceiptMutation)
+
+// newReceiptMutation creates new mutation for the Receipt entity.
+func newReceiptMutation(c config, op Op, opts ...receiptOption) *ReceiptMutation {
+	m := &ReceiptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReceipt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReceiptID sets the ID field of the mutation.
+func withReceiptID(id uuid.UUID) receiptOption {
+	return func(m *ReceiptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Receipt
+		)
+		m.oldValue = func(ctx context.Context) (*Receipt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Receipt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReceipt sets the old Receipt of the mutation.
+func withReceipt(node *Receipt) receiptOption {
+	return func(m *ReceiptMutation) {
+		m.oldValue = func(context.Context) (*Receipt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReceiptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReceiptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Receipt entities.
+func (m *ReceiptMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReceiptMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReceiptMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Receipt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *ReceiptMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *ReceiptMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *ReceiptMutation) ResetProfileID() {
+	m.profile = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *ReceiptMutation) SetFileID(u uuid.UUID) {
+	m.file_id = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *ReceiptMutation) FileID() (r uuid.UUID, exists bool) {
+	v := m.file_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFileID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (m *ReceiptMutation) ClearFileID() {
+	m.file_id = nil
+	m.clearedFields[receipt.FieldFileID] = struct{}{}
+}
+
+// FileIDCleared returns if the "file_id" field was cleared in this mutation.
+func (m *ReceiptMutation) FileIDCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFileID]
+	return ok
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *ReceiptMutation) ResetFileID() {
+	m.file_id = nil
+	delete(m.clearedFields, receipt.FieldFileID)
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (m *ReceiptMutation) SetMerchantName(s string) {
+	m.merchant_name = &s
+}
+
+// MerchantName returns the value of the "merchant_name" field in the mutation.
+func (m *ReceiptMutation) MerchantName() (r string, exists bool) {
+	v := m.merchant_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantName returns the old "merchant_name" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldMerchantName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantName: %w", err)
+	}
+	return oldValue.MerchantName, nil
+}
+
+// ResetMerchantName resets all changes to the "merchant_name" field.
+func (m *ReceiptMutation) ResetMerchantName() {
+	m.merchant_name = nil
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (m *ReceiptMutation) SetMerchantID(u uuid.UUID) {
+	m.merchant = &u
+}
+
+// MerchantID returns the value of the "merchant_id" field in the mutation.
+func (m *ReceiptMutation) MerchantID() (r uuid.UUID, exists bool) {
+	v := m.merchant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantID returns the old "merchant_id" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldMerchantID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantID: %w", err)
+	}
+	return oldValue.MerchantID, nil
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (m *ReceiptMutation) ClearMerchantID() {
+	m.merchant = nil
+	m.clearedFields[receipt.FieldMerchantID] = struct{}{}
+}
+
+// MerchantIDCleared returns if the "merchant_id" field was cleared in this mutation.
+func (m *ReceiptMutation) MerchantIDCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldMerchantID]
+	return ok
+}
+
+// ResetMerchantID resets all changes to the "merchant_id" field.
+func (m *ReceiptMutation) ResetMerchantID() {
+	m.merchant = nil
+	delete(m.clearedFields, receipt.FieldMerchantID)
+}
+
+// SetTxDate sets the "tx_date" field.
+func (m *ReceiptMutation) SetTxDate(t time.Time) {
+	m.tx_date = &t
+}
+
+// TxDate returns the value of the "tx_date" field in the mutation.
+func (m *ReceiptMutation) TxDate() (r time.Time, exists bool) {
+	v := m.tx_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxDate returns the old "tx_date" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldTxDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxDate: %w", err)
+	}
+	return oldValue.TxDate, nil
+}
+
+// ResetTxDate resets all changes to the "tx_date" field.
+func (m *ReceiptMutation) ResetTxDate() {
+	m.tx_date = nil
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (m *ReceiptMutation) SetSubtotal(value money.Amount) {
+	m.subtotal = &value
+}
+
+// Subtotal returns the value of the "subtotal" field in the mutation.
+func (m *ReceiptMutation) Subtotal() (r money.Amount, exists bool) {
+	v := m.subtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtotal returns the old "subtotal" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldSubtotal(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
+	}
+	return oldValue.Subtotal, nil
+}
+
+// ClearSubtotal clears the value of the "subtotal" field.
+func (m *ReceiptMutation) ClearSubtotal() {
+	m.subtotal = nil
+	m.clearedFields[receipt.FieldSubtotal] = struct{}{}
+}
+
+// SubtotalCleared returns if the "subtotal" field was cleared in this mutation.
+func (m *ReceiptMutation) SubtotalCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldSubtotal]
+	return ok
+}
+
+// ResetSubtotal resets all changes to the "subtotal" field.
+func (m *ReceiptMutation) ResetSubtotal() {
+	m.subtotal = nil
+	delete(m.clearedFields, receipt.FieldSubtotal)
+}
+
+// SetTax sets the "tax" field.
+func (m *ReceiptMutation) SetTax(value money.Amount) {
+	m.tax = &value
+}
+
+// Tax returns the value of the "tax" field in the mutation.
+func (m *ReceiptMutation) Tax() (r money.Amount, exists bool) {
+	v := m.tax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTax returns the old "tax" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldTax(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTax: %w", err)
 	}
 	return oldValue.Tax, nil
 }
 
-// AddTax adds f to the "tax" field.
-func (m *ReceiptMutation) AddTax(f float64) {
-	if m.addtax != nil {
-		*m.addtax += f
-	} else {
-		m.addtax = &f
+// ClearTax clears the value of the "tax" field.
+func (m *ReceiptMutation) ClearTax() {
+	m.tax = nil
+	m.clearedFields[receipt.FieldTax] = struct{}{}
+}
+
+// TaxCleared returns if the "tax" field was cleared in this mutation.
+func (m *ReceiptMutation) TaxCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldTax]
+	return ok
+}
+
+// ResetTax resets all changes to the "tax" field.
+func (m *ReceiptMutation) ResetTax() {
+	m.tax = nil
+	delete(m.clearedFields, receipt.FieldTax)
+}
+
+// SetDiscount sets the "discount" field.
+func (m *ReceiptMutation) SetDiscount(value money.Amount) {
+	m.discount = &value
+}
+
+// Discount returns the value of the "discount" field in the mutation.
+func (m *ReceiptMutation) Discount() (r money.Amount, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscount returns the old "discount" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldDiscount(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	}
+	return oldValue.Discount, nil
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (m *ReceiptMutation) ClearDiscount() {
+	m.discount = nil
+	m.clearedFields[receipt.FieldDiscount] = struct{}{}
+}
+
+// DiscountCleared returns if the "discount" field was cleared in this mutation.
+func (m *ReceiptMutation) DiscountCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldDiscount]
+	return ok
+}
+
+// ResetDiscount resets all changes to the "discount" field.
+func (m *ReceiptMutation) ResetDiscount() {
+	m.discount = nil
+	delete(m.clearedFields, receipt.FieldDiscount)
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (m *ReceiptMutation) SetOtherFees(value money.Amount) {
+	m.other_fees = &value
+}
+
+// OtherFees returns the value of the "other_fees" field in the mutation.
+func (m *ReceiptMutation) OtherFees() (r money.Amount, exists bool) {
+	v := m.other_fees
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtherFees returns the old "other_fees" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldOtherFees(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtherFees is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtherFees requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtherFees: %w", err)
+	}
+	return oldValue.OtherFees, nil
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (m *ReceiptMutation) ClearOtherFees() {
+	m.other_fees = nil
+	m.clearedFields[receipt.FieldOtherFees] = struct{}{}
+}
+
+// OtherFeesCleared returns if the "other_fees" field was cleared in this mutation.
+func (m *ReceiptMutation) OtherFeesCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldOtherFees]
+	return ok
+}
+
+// ResetOtherFees resets all changes to the "other_fees" field.
+func (m *ReceiptMutation) ResetOtherFees() {
+	m.other_fees = nil
+	delete(m.clearedFields, receipt.FieldOtherFees)
+}
+
+// SetTip sets the "tip" field.
+func (m *ReceiptMutation) SetTip(value money.Amount) {
+	m.tip = &value
+}
+
+// Tip returns the value of the "tip" field in the mutation.
+func (m *ReceiptMutation) Tip() (r money.Amount, exists bool) {
+	v := m.tip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTip returns the old "tip" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldTip(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTip is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTip requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTip: %w", err)
+	}
+	return oldValue.Tip, nil
+}
+
+// ClearTip clears the value of the "tip" field.
+func (m *ReceiptMutation) ClearTip() {
+	m.tip = nil
+	m.clearedFields[receipt.FieldTip] = struct{}{}
+}
+
+// TipCleared returns if the "tip" field was cleared in this mutation.
+func (m *ReceiptMutation) TipCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldTip]
+	return ok
+}
+
+// ResetTip resets all changes to the "tip" field.
+func (m *ReceiptMutation) ResetTip() {
+	m.tip = nil
+	delete(m.clearedFields, receipt.FieldTip)
+}
+
+// SetFees sets the "fees" field.
+func (m *ReceiptMutation) SetFees(jm json.RawMessage) {
+	m.fees = &jm
+	m.appendfees = nil
+}
+
+// Fees returns the value of the "fees" field in the mutation.
+func (m *ReceiptMutation) Fees() (r json.RawMessage, exists bool) {
+	v := m.fees
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFees returns the old "fees" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFees(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFees is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFees requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFees: %w", err)
+	}
+	return oldValue.Fees, nil
+}
+
+// AppendFees adds jm to the "fees" field.
+func (m *ReceiptMutation) AppendFees(jm json.RawMessage) {
+	m.appendfees = append(m.appendfees, jm...)
+}
+
+// AppendedFees returns the list of values that were appended to the "fees" field in this mutation.
+func (m *ReceiptMutation) AppendedFees() (json.RawMessage, bool) {
+	if len(m.appendfees) == 0 {
+		return nil, false
+	}
+	return m.appendfees, true
+}
+
+// ClearFees clears the value of the "fees" field.
+func (m *ReceiptMutation) ClearFees() {
+	m.fees = nil
+	m.appendfees = nil
+	m.clearedFields[receipt.FieldFees] = struct{}{}
+}
+
+// FeesCleared returns if the "fees" field was cleared in this mutation.
+func (m *ReceiptMutation) FeesCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFees]
+	return ok
+}
+
+// ResetFees resets all changes to the "fees" field.
+func (m *ReceiptMutation) ResetFees() {
+	m.fees = nil
+	m.appendfees = nil
+	delete(m.clearedFields, receipt.FieldFees)
+}
+
+// SetTotal sets the "total" field.
+func (m *ReceiptMutation) SetTotal(value money.Amount) {
+	m.total = &value
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *ReceiptMutation) Total() (r money.Amount, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldTotal(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *ReceiptMutation) ResetTotal() {
+	m.total = nil
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (m *ReceiptMutation) SetCurrencyCode(s string) {
+	m.currency_code = &s
+}
+
+// CurrencyCode returns the value of the "currency_code" field in the mutation.
+func (m *ReceiptMutation) CurrencyCode() (r string, exists bool) {
+	v := m.currency_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrencyCode returns the old "currency_code" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldCurrencyCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrencyCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrencyCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrencyCode: %w", err)
+	}
+	return oldValue.CurrencyCode, nil
+}
+
+// ResetCurrencyCode resets all changes to the "currency_code" field.
+func (m *ReceiptMutation) ResetCurrencyCode() {
+	m.currency_code = nil
+}
+
+// SetConvertedTotal sets the "converted_total" field.
+func (m *ReceiptMutation) SetConvertedTotal(value money.Amount) {
+	m.converted_total = &value
+}
+
+// ConvertedTotal returns the value of the "converted_total" field in the mutation.
+func (m *ReceiptMutation) ConvertedTotal() (r money.Amount, exists bool) {
+	v := m.converted_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConvertedTotal returns the old "converted_total" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldConvertedTotal(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConvertedTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConvertedTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConvertedTotal: %w", err)
+	}
+	return oldValue.ConvertedTotal, nil
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (m *ReceiptMutation) ClearConvertedTotal() {
+	m.converted_total = nil
+	m.clearedFields[receipt.FieldConvertedTotal] = struct{}{}
+}
+
+// ConvertedTotalCleared returns if the "converted_total" field was cleared in this mutation.
+func (m *ReceiptMutation) ConvertedTotalCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldConvertedTotal]
+	return ok
+}
+
+// ResetConvertedTotal resets all changes to the "converted_total" field.
+func (m *ReceiptMutation) ResetConvertedTotal() {
+	m.converted_total = nil
+	delete(m.clearedFields, receipt.FieldConvertedTotal)
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (m *ReceiptMutation) SetConvertedCurrency(s string) {
+	m.converted_currency = &s
+}
+
+// ConvertedCurrency returns the value of the "converted_currency" field in the mutation.
+func (m *ReceiptMutation) ConvertedCurrency() (r string, exists bool) {
+	v := m.converted_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConvertedCurrency returns the old "converted_currency" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldConvertedCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConvertedCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConvertedCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConvertedCurrency: %w", err)
+	}
+	return oldValue.ConvertedCurrency, nil
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (m *ReceiptMutation) ClearConvertedCurrency() {
+	m.converted_currency = nil
+	m.clearedFields[receipt.FieldConvertedCurrency] = struct{}{}
+}
+
+// ConvertedCurrencyCleared returns if the "converted_currency" field was cleared in this mutation.
+func (m *ReceiptMutation) ConvertedCurrencyCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldConvertedCurrency]
+	return ok
+}
+
+// ResetConvertedCurrency resets all changes to the "converted_currency" field.
+func (m *ReceiptMutation) ResetConvertedCurrency() {
+	m.converted_currency = nil
+	delete(m.clearedFields, receipt.FieldConvertedCurrency)
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (m *ReceiptMutation) SetFxRate(f float64) {
+	m.fx_rate = &f
+	m.addfx_rate = nil
+}
+
+// FxRate returns the value of the "fx_rate" field in the mutation.
+func (m *ReceiptMutation) FxRate() (r float64, exists bool) {
+	v := m.fx_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFxRate returns the old "fx_rate" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFxRate(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxRate: %w", err)
+	}
+	return oldValue.FxRate, nil
+}
+
+// AddFxRate adds f to the "fx_rate" field.
+func (m *ReceiptMutation) AddFxRate(f float64) {
+	if m.addfx_rate != nil {
+		*m.addfx_rate += f
+	} else {
+		m.addfx_rate = &f
+	}
+}
+
+// AddedFxRate returns the value that was added to the "fx_rate" field in this mutation.
+func (m *ReceiptMutation) AddedFxRate() (r float64, exists bool) {
+	v := m.addfx_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (m *ReceiptMutation) ClearFxRate() {
+	m.fx_rate = nil
+	m.addfx_rate = nil
+	m.clearedFields[receipt.FieldFxRate] = struct{}{}
+}
+
+// FxRateCleared returns if the "fx_rate" field was cleared in this mutation.
+func (m *ReceiptMutation) FxRateCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFxRate]
+	return ok
+}
+
+// ResetFxRate resets all changes to the "fx_rate" field.
+func (m *ReceiptMutation) ResetFxRate() {
+	m.fx_rate = nil
+	m.addfx_rate = nil
+	delete(m.clearedFields, receipt.FieldFxRate)
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (m *ReceiptMutation) SetFxRateDate(t time.Time) {
+	m.fx_rate_date = &t
+}
+
+// FxRateDate returns the value of the "fx_rate_date" field in the mutation.
+func (m *ReceiptMutation) FxRateDate() (r time.Time, exists bool) {
+	v := m.fx_rate_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFxRateDate returns the old "fx_rate_date" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFxRateDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxRateDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxRateDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxRateDate: %w", err)
+	}
+	return oldValue.FxRateDate, nil
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (m *ReceiptMutation) ClearFxRateDate() {
+	m.fx_rate_date = nil
+	m.clearedFields[receipt.FieldFxRateDate] = struct{}{}
+}
+
+// FxRateDateCleared returns if the "fx_rate_date" field was cleared in this mutation.
+func (m *ReceiptMutation) FxRateDateCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFxRateDate]
+	return ok
+}
+
+// ResetFxRateDate resets all changes to the "fx_rate_date" field.
+func (m *ReceiptMutation) ResetFxRateDate() {
+	m.fx_rate_date = nil
+	delete(m.clearedFields, receipt.FieldFxRateDate)
+}
+
+// SetFxSource sets the "fx_source" field.
+func (m *ReceiptMutation) SetFxSource(s string) {
+	m.fx_source = &s
+}
+
+// FxSource returns the value of the "fx_source" field in the mutation.
+func (m *ReceiptMutation) FxSource() (r string, exists bool) {
+	v := m.fx_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFxSource returns the old "fx_source" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFxSource(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxSource: %w", err)
+	}
+	return oldValue.FxSource, nil
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (m *ReceiptMutation) ClearFxSource() {
+	m.fx_source = nil
+	m.clearedFields[receipt.FieldFxSource] = struct{}{}
+}
+
+// FxSourceCleared returns if the "fx_source" field was cleared in this mutation.
+func (m *ReceiptMutation) FxSourceCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFxSource]
+	return ok
+}
+
+// ResetFxSource resets all changes to the "fx_source" field.
+func (m *ReceiptMutation) ResetFxSource() {
+	m.fx_source = nil
+	delete(m.clearedFields, receipt.FieldFxSource)
+}
+
+// SetCategoryName sets the "category_name" field.
+func (m *ReceiptMutation) SetCategoryName(s string) {
+	m.category_name = &s
+}
+
+// CategoryName returns the value of the "category_name" field in the mutation.
+func (m *ReceiptMutation) CategoryName() (r string, exists bool) {
+	v := m.category_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryName returns the old "category_name" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldCategoryName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryName: %w", err)
+	}
+	return oldValue.CategoryName, nil
+}
+
+// ResetCategoryName resets all changes to the "category_name" field.
+func (m *ReceiptMutation) ResetCategoryName() {
+	m.category_name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ReceiptMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ReceiptMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ReceiptMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *ReceiptMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *ReceiptMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldFilePath(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (m *ReceiptMutation) ClearFilePath() {
+	m.file_path = nil
+	m.clearedFields[receipt.FieldFilePath] = struct{}{}
+}
+
+// FilePathCleared returns if the "file_path" field was cleared in this mutation.
+func (m *ReceiptMutation) FilePathCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldFilePath]
+	return ok
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *ReceiptMutation) ResetFilePath() {
+	m.file_path = nil
+	delete(m.clearedFields, receipt.FieldFilePath)
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (m *ReceiptMutation) SetIsCurrent(b bool) {
+	m.is_current = &b
+}
+
+// IsCurrent returns the value of the "is_current" field in the mutation.
+func (m *ReceiptMutation) IsCurrent() (r bool, exists bool) {
+	v := m.is_current
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCurrent returns the old "is_current" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldIsCurrent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCurrent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCurrent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCurrent: %w", err)
+	}
+	return oldValue.IsCurrent, nil
+}
+
+// ResetIsCurrent resets all changes to the "is_current" field.
+func (m *ReceiptMutation) ResetIsCurrent() {
+	m.is_current = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReceiptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReceiptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReceiptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReceiptMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReceiptMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReceiptMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (m *ReceiptMutation) ClearProfile() {
+	m.clearedprofile = true
+	m.clearedFields[receipt.FieldProfileID] = struct{}{}
+}
+
+// ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
+func (m *ReceiptMutation) ProfileCleared() bool {
+	return m.clearedprofile
+}
+
+// ProfileIDs returns the "profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProfileID instead. It exists only for internal usage by the builders.
+func (m *ReceiptMutation) ProfileIDs() (ids []uuid.UUID) {
+	if id := m.profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProfile resets all changes to the "profile" edge.
+func (m *ReceiptMutation) ResetProfile() {
+	m.profile = nil
+	m.clearedprofile = false
+}
+
+// ClearMerchant clears the "merchant" edge to the Merchant entity.
+func (m *ReceiptMutation) ClearMerchant() {
+	m.clearedmerchant = true
+	m.clearedFields[receipt.FieldMerchantID] = struct{}{}
+}
+
+// MerchantCleared reports if the "merchant" edge to the Merchant entity was cleared.
+func (m *ReceiptMutation) MerchantCleared() bool {
+	return m.MerchantIDCleared() || m.clearedmerchant
+}
+
+// MerchantIDs returns the "merchant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MerchantID instead. It exists only for internal usage by the builders.
+func (m *ReceiptMutation) MerchantIDs() (ids []uuid.UUID) {
+	if id := m.merchant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMerchant resets all changes to the "merchant" edge.
+func (m *ReceiptMutation) ResetMerchant() {
+	m.merchant = nil
+	m.clearedmerchant = false
+}
+
+// AddFileIDs adds the "files" edge to the ReceiptFile entity by ids.
+func (m *ReceiptMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the ReceiptFile entity.
+func (m *ReceiptMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the ReceiptFile entity was cleared.
+func (m *ReceiptMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the ReceiptFile entity by IDs.
+func (m *ReceiptMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the ReceiptFile entity.
+func (m *ReceiptMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *ReceiptMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *ReceiptMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the ExtractJob entity by ids.
+func (m *ReceiptMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the ExtractJob entity.
+func (m *ReceiptMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the ExtractJob entity was cleared.
+func (m *ReceiptMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the ExtractJob entity by IDs.
+func (m *ReceiptMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the ExtractJob entity.
+func (m *ReceiptMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *ReceiptMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *ReceiptMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddAnomalyIDs adds the "anomalies" edge to the ReceiptAnomaly entity by ids.
+func (m *ReceiptMutation) AddAnomalyIDs(ids ...uuid.UUID) {
+	if m.anomalies == nil {
+		m.anomalies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.anomalies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnomalies clears the "anomalies" edge to the ReceiptAnomaly entity.
+func (m *ReceiptMutation) ClearAnomalies() {
+	m.clearedanomalies = true
+}
+
+// AnomaliesCleared reports if the "anomalies" edge to the ReceiptAnomaly entity was cleared.
+func (m *ReceiptMutation) AnomaliesCleared() bool {
+	return m.clearedanomalies
+}
+
+// RemoveAnomalyIDs removes the "anomalies" edge to the ReceiptAnomaly entity by IDs.
+func (m *ReceiptMutation) RemoveAnomalyIDs(ids ...uuid.UUID) {
+	if m.removedanomalies == nil {
+		m.removedanomalies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.anomalies, ids[i])
+		m.removedanomalies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnomalies returns the removed IDs of the "anomalies" edge to the ReceiptAnomaly entity.
+func (m *ReceiptMutation) RemovedAnomaliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedanomalies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnomaliesIDs returns the "anomalies" edge IDs in the mutation.
+func (m *ReceiptMutation) AnomaliesIDs() (ids []uuid.UUID) {
+	for id := range m.anomalies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnomalies resets all changes to the "anomalies" edge.
+func (m *ReceiptMutation) ResetAnomalies() {
+	m.anomalies = nil
+	m.clearedanomalies = false
+	m.removedanomalies = nil
+}
+
+// Where appends a list predicates to the ReceiptMutation builder.
+func (m *ReceiptMutation) Where(ps ...predicate.Receipt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReceiptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReceiptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Receipt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReceiptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReceiptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Receipt).
+func (m *ReceiptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReceiptMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.profile != nil {
+		fields = append(fields, receipt.FieldProfileID)
+	}
+	if m.file_id != nil {
+		fields = append(fields, receipt.FieldFileID)
+	}
+	if m.merchant_name != nil {
+		fields = append(fields, receipt.FieldMerchantName)
+	}
+	if m.merchant != nil {
+		fields = append(fields, receipt.FieldMerchantID)
+	}
+	if m.tx_date != nil {
+		fields = append(fields, receipt.FieldTxDate)
+	}
+	if m.subtotal != nil {
+		fields = append(fields, receipt.FieldSubtotal)
+	}
+	if m.tax != nil {
+		fields = append(fields, receipt.FieldTax)
+	}
+	if m.discount != nil {
+		fields = append(fields, receipt.FieldDiscount)
+	}
+	if m.other_fees != nil {
+		fields = append(fields, receipt.FieldOtherFees)
+	}
+	if m.tip != nil {
+		fields = append(fields, receipt.FieldTip)
+	}
+	if m.fees != nil {
+		fields = append(fields, receipt.FieldFees)
+	}
+	if m.total != nil {
+		fields = append(fields, receipt.FieldTotal)
+	}
+	if m.currency_code != nil {
+		fields = append(fields, receipt.FieldCurrencyCode)
+	}
+	if m.converted_total != nil {
+		fields = append(fields, receipt.FieldConvertedTotal)
+	}
+	if m.converted_currency != nil {
+		fields = append(fields, receipt.FieldConvertedCurrency)
+	}
+	if m.fx_rate != nil {
+		fields = append(fields, receipt.FieldFxRate)
+	}
+	if m.fx_rate_date != nil {
+		fields = append(fields, receipt.FieldFxRateDate)
+	}
+	if m.fx_source != nil {
+		fields = append(fields, receipt.FieldFxSource)
+	}
+	if m.category_name != nil {
+		fields = append(fields, receipt.FieldCategoryName)
+	}
+	if m.description != nil {
+		fields = append(fields, receipt.FieldDescription)
+	}
+	if m.file_path != nil {
+		fields = append(fields, receipt.FieldFilePath)
+	}
+	if m.is_current != nil {
+		fields = append(fields, receipt.FieldIsCurrent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, receipt.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, receipt.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReceiptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case receipt.FieldProfileID:
+		return m.ProfileID()
+	case receipt.FieldFileID:
+		return m.FileID()
+	case receipt.FieldMerchantName:
+		return m.MerchantName()
+	case receipt.FieldMerchantID:
+		return m.MerchantID()
+	case receipt.FieldTxDate:
+		return m.TxDate()
+	case receipt.FieldSubtotal:
+		return m.Subtotal()
+	case receipt.FieldTax:
+		return m.Tax()
+	case receipt.FieldDiscount:
+		return m.Discount()
+	case receipt.FieldOtherFees:
+		return m.OtherFees()
+	case receipt.FieldTip:
+		return m.Tip()
+	case receipt.FieldFees:
+		return m.Fees()
+	case receipt.FieldTotal:
+		return m.Total()
+	case receipt.FieldCurrencyCode:
+		return m.CurrencyCode()
+	case receipt.FieldConvertedTotal:
+		return m.ConvertedTotal()
+	case receipt.FieldConvertedCurrency:
+		return m.ConvertedCurrency()
+	case receipt.FieldFxRate:
+		return m.FxRate()
+	case receipt.FieldFxRateDate:
+		return m.FxRateDate()
+	case receipt.FieldFxSource:
+		return m.FxSource()
+	case receipt.FieldCategoryName:
+		return m.CategoryName()
+	case receipt.FieldDescription:
+		return m.Description()
+	case receipt.FieldFilePath:
+		return m.FilePath()
+	case receipt.FieldIsCurrent:
+		return m.IsCurrent()
+	case receipt.FieldCreatedAt:
+		return m.CreatedAt()
+	case receipt.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReceiptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case receipt.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case receipt.FieldFileID:
+		return m.OldFileID(ctx)
+	case receipt.FieldMerchantName:
+		return m.OldMerchantName(ctx)
+	case receipt.FieldMerchantID:
+		return m.OldMerchantID(ctx)
+	case receipt.FieldTxDate:
+		return m.OldTxDate(ctx)
+	case receipt.FieldSubtotal:
+		return m.OldSubtotal(ctx)
+	case receipt.FieldTax:
+		return m.OldTax(ctx)
+	case receipt.FieldDiscount:
+		return m.OldDiscount(ctx)
+	case receipt.FieldOtherFees:
+		return m.OldOtherFees(ctx)
+	case receipt.FieldTip:
+		return m.OldTip(ctx)
+	case receipt.FieldFees:
+		return m.OldFees(ctx)
+	case receipt.FieldTotal:
+		return m.OldTotal(ctx)
+	case receipt.FieldCurrencyCode:
+		return m.OldCurrencyCode(ctx)
+	case receipt.FieldConvertedTotal:
+		return m.OldConvertedTotal(ctx)
+	case receipt.FieldConvertedCurrency:
+		return m.OldConvertedCurrency(ctx)
+	case receipt.FieldFxRate:
+		return m.OldFxRate(ctx)
+	case receipt.FieldFxRateDate:
+		return m.OldFxRateDate(ctx)
+	case receipt.FieldFxSource:
+		return m.OldFxSource(ctx)
+	case receipt.FieldCategoryName:
+		return m.OldCategoryName(ctx)
+	case receipt.FieldDescription:
+		return m.OldDescription(ctx)
+	case receipt.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case receipt.FieldIsCurrent:
+		return m.OldIsCurrent(ctx)
+	case receipt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case receipt.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case receipt.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case receipt.FieldFileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case receipt.FieldMerchantName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantName(v)
+		return nil
+	case receipt.FieldMerchantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantID(v)
+		return nil
+	case receipt.FieldTxDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxDate(v)
+		return nil
+	case receipt.FieldSubtotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtotal(v)
+		return nil
+	case receipt.FieldTax:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTax(v)
+		return nil
+	case receipt.FieldDiscount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	case receipt.FieldOtherFees:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtherFees(v)
+		return nil
+	case receipt.FieldTip:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTip(v)
+		return nil
+	case receipt.FieldFees:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFees(v)
+		return nil
+	case receipt.FieldTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case receipt.FieldCurrencyCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrencyCode(v)
+		return nil
+	case receipt.FieldConvertedTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConvertedTotal(v)
+		return nil
+	case receipt.FieldConvertedCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConvertedCurrency(v)
+		return nil
+	case receipt.FieldFxRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxRate(v)
+		return nil
+	case receipt.FieldFxRateDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxRateDate(v)
+		return nil
+	case receipt.FieldFxSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxSource(v)
+		return nil
+	case receipt.FieldCategoryName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryName(v)
+		return nil
+	case receipt.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case receipt.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case receipt.FieldIsCurrent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCurrent(v)
+		return nil
+	case receipt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case receipt.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReceiptMutation) AddedFields() []string {
+	var fields []string
+	if m.addfx_rate != nil {
+		fields = append(fields, receipt.FieldFxRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReceiptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case receipt.FieldFxRate:
+		return m.AddedFxRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case receipt.FieldFxRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFxRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReceiptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(receipt.FieldFileID) {
+		fields = append(fields, receipt.FieldFileID)
+	}
+	if m.FieldCleared(receipt.FieldMerchantID) {
+		fields = append(fields, receipt.FieldMerchantID)
+	}
+	if m.FieldCleared(receipt.FieldSubtotal) {
+		fields = append(fields, receipt.FieldSubtotal)
+	}
+	if m.FieldCleared(receipt.FieldTax) {
+		fields = append(fields, receipt.FieldTax)
+	}
+	if m.FieldCleared(receipt.FieldDiscount) {
+		fields = append(fields, receipt.FieldDiscount)
+	}
+	if m.FieldCleared(receipt.FieldOtherFees) {
+		fields = append(fields, receipt.FieldOtherFees)
+	}
+	if m.FieldCleared(receipt.FieldTip) {
+		fields = append(fields, receipt.FieldTip)
+	}
+	if m.FieldCleared(receipt.FieldFees) {
+		fields = append(fields, receipt.FieldFees)
+	}
+	if m.FieldCleared(receipt.FieldConvertedTotal) {
+		fields = append(fields, receipt.FieldConvertedTotal)
+	}
+	if m.FieldCleared(receipt.FieldConvertedCurrency) {
+		fields = append(fields, receipt.FieldConvertedCurrency)
+	}
+	if m.FieldCleared(receipt.FieldFxRate) {
+		fields = append(fields, receipt.FieldFxRate)
+	}
+	if m.FieldCleared(receipt.FieldFxRateDate) {
+		fields = append(fields, receipt.FieldFxRateDate)
+	}
+	if m.FieldCleared(receipt.FieldFxSource) {
+		fields = append(fields, receipt.FieldFxSource)
+	}
+	if m.FieldCleared(receipt.FieldFilePath) {
+		fields = append(fields, receipt.FieldFilePath)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReceiptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReceiptMutation) ClearField(name string) error {
+	switch name {
+	case receipt.FieldFileID:
+		m.ClearFileID()
+		return nil
+	case receipt.FieldMerchantID:
+		m.ClearMerchantID()
+		return nil
+	case receipt.FieldSubtotal:
+		m.ClearSubtotal()
+		return nil
+	case receipt.FieldTax:
+		m.ClearTax()
+		return nil
+	case receipt.FieldDiscount:
+		m.ClearDiscount()
+		return nil
+	case receipt.FieldOtherFees:
+		m.ClearOtherFees()
+		return nil
+	case receipt.FieldTip:
+		m.ClearTip()
+		return nil
+	case receipt.FieldFees:
+		m.ClearFees()
+		return nil
+	case receipt.FieldConvertedTotal:
+		m.ClearConvertedTotal()
+		return nil
+	case receipt.FieldConvertedCurrency:
+		m.ClearConvertedCurrency()
+		return nil
+	case receipt.FieldFxRate:
+		m.ClearFxRate()
+		return nil
+	case receipt.FieldFxRateDate:
+		m.ClearFxRateDate()
+		return nil
+	case receipt.FieldFxSource:
+		m.ClearFxSource()
+		return nil
+	case receipt.FieldFilePath:
+		m.ClearFilePath()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReceiptMutation) ResetField(name string) error {
+	switch name {
+	case receipt.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case receipt.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case receipt.FieldMerchantName:
+		m.ResetMerchantName()
+		return nil
+	case receipt.FieldMerchantID:
+		m.ResetMerchantID()
+		return nil
+	case receipt.FieldTxDate:
+		m.ResetTxDate()
+		return nil
+	case receipt.FieldSubtotal:
+		m.ResetSubtotal()
+		return nil
+	case receipt.FieldTax:
+		m.ResetTax()
+		return nil
+	case receipt.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	case receipt.FieldOtherFees:
+		m.ResetOtherFees()
+		return nil
+	case receipt.FieldTip:
+		m.ResetTip()
+		return nil
+	case receipt.FieldFees:
+		m.ResetFees()
+		return nil
+	case receipt.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case receipt.FieldCurrencyCode:
+		m.ResetCurrencyCode()
+		return nil
+	case receipt.FieldConvertedTotal:
+		m.ResetConvertedTotal()
+		return nil
+	case receipt.FieldConvertedCurrency:
+		m.ResetConvertedCurrency()
+		return nil
+	case receipt.FieldFxRate:
+		m.ResetFxRate()
+		return nil
+	case receipt.FieldFxRateDate:
+		m.ResetFxRateDate()
+		return nil
+	case receipt.FieldFxSource:
+		m.ResetFxSource()
+		return nil
+	case receipt.FieldCategoryName:
+		m.ResetCategoryName()
+		return nil
+	case receipt.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case receipt.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case receipt.FieldIsCurrent:
+		m.ResetIsCurrent()
+		return nil
+	case receipt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case receipt.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReceiptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.profile != nil {
+		edges = append(edges, receipt.EdgeProfile)
+	}
+	if m.merchant != nil {
+		edges = append(edges, receipt.EdgeMerchant)
+	}
+	if m.files != nil {
+		edges = append(edges, receipt.EdgeFiles)
+	}
+	if m.jobs != nil {
+		edges = append(edges, receipt.EdgeJobs)
+	}
+	if m.anomalies != nil {
+		edges = append(edges, receipt.EdgeAnomalies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReceiptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case receipt.EdgeProfile:
+		if id := m.profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case receipt.EdgeMerchant:
+		if id := m.merchant; id != nil {
+			return []ent.Value{*id}
+		}
+	case receipt.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case receipt.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case receipt.EdgeAnomalies:
+		ids := make([]ent.Value, 0, len(m.anomalies))
+		for id := range m.anomalies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReceiptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedfiles != nil {
+		edges = append(edges, receipt.EdgeFiles)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, receipt.EdgeJobs)
+	}
+	if m.removedanomalies != nil {
+		edges = append(edges, receipt.EdgeAnomalies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReceiptMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case receipt.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case receipt.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case receipt.EdgeAnomalies:
+		ids := make([]ent.Value, 0, len(m.removedanomalies))
+		for id := range m.removedanomalies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReceiptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedprofile {
+		edges = append(edges, receipt.EdgeProfile)
+	}
+	if m.clearedmerchant {
+		edges = append(edges, receipt.EdgeMerchant)
+	}
+	if m.clearedfiles {
+		edges = append(edges, receipt.EdgeFiles)
+	}
+	if m.clearedjobs {
+		edges = append(edges, receipt.EdgeJobs)
+	}
+	if m.clearedanomalies {
+		edges = append(edges, receipt.EdgeAnomalies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReceiptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case receipt.EdgeProfile:
+		return m.clearedprofile
+	case receipt.EdgeMerchant:
+		return m.clearedmerchant
+	case receipt.EdgeFiles:
+		return m.clearedfiles
+	case receipt.EdgeJobs:
+		return m.clearedjobs
+	case receipt.EdgeAnomalies:
+		return m.clearedanomalies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReceiptMutation) ClearEdge(name string) error {
+	switch name {
+	case receipt.EdgeProfile:
+		m.ClearProfile()
+		return nil
+	case receipt.EdgeMerchant:
+		m.ClearMerchant()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReceiptMutation) ResetEdge(name string) error {
+	switch name {
+	case receipt.EdgeProfile:
+		m.ResetProfile()
+		return nil
+	case receipt.EdgeMerchant:
+		m.ResetMerchant()
+		return nil
+	case receipt.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case receipt.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case receipt.EdgeAnomalies:
+		m.ResetAnomalies()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt edge %s", name)
+}
+
+// ReceiptAnomalyMutation represents an operation that mutates the ReceiptAnomaly nodes in the graph.
+type ReceiptAnomalyMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	profile_id     *uuid.UUID
+	kind           *string
+	reason         *string
+	score          *float64
+	addscore       *float64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	receipt        *uuid.UUID
+	clearedreceipt bool
+	done           bool
+	oldValue       func(context.Context) (*ReceiptAnomaly, error)
+	predicates     []predicate.ReceiptAnomaly
+}
+
+var _ ent.Mutation = (*ReceiptAnomalyMutation)(nil)
+
+// receiptanomalyOption allows management of the mutation configuration using functional options.
+type receiptanomalyOption func(*ReceiptAnomalyMutation)
+
+// newReceiptAnomalyMutation creates new mutation for the ReceiptAnomaly entity.
+func newReceiptAnomalyMutation(c config, op Op, opts ...receiptanomalyOption) *ReceiptAnomalyMutation {
+	m := &ReceiptAnomalyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReceiptAnomaly,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReceiptAnomalyID sets the ID field of the mutation.
+func withReceiptAnomalyID(id uuid.UUID) receiptanomalyOption {
+	return func(m *ReceiptAnomalyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReceiptAnomaly
+		)
+		m.oldValue = func(ctx context.Context) (*ReceiptAnomaly, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReceiptAnomaly.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReceiptAnomaly sets the old ReceiptAnomaly of the mutation.
+func withReceiptAnomaly(node *ReceiptAnomaly) receiptanomalyOption {
+	return func(m *ReceiptAnomalyMutation) {
+		m.oldValue = func(context.Context) (*ReceiptAnomaly, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReceiptAnomalyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReceiptAnomalyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReceiptAnomaly entities.
+func (m *ReceiptAnomalyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReceiptAnomalyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReceiptAnomalyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReceiptAnomaly.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (m *ReceiptAnomalyMutation) SetReceiptID(u uuid.UUID) {
+	m.receipt = &u
+}
+
+// ReceiptID returns the value of the "receipt_id" field in the mutation.
+func (m *ReceiptAnomalyMutation) ReceiptID() (r uuid.UUID, exists bool) {
+	v := m.receipt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiptID returns the old "receipt_id" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldReceiptID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
+	}
+	return oldValue.ReceiptID, nil
+}
+
+// ResetReceiptID resets all changes to the "receipt_id" field.
+func (m *ReceiptAnomalyMutation) ResetReceiptID() {
+	m.receipt = nil
+}
+
+// SetProfileID sets the "profile_id" field.
+func (m *ReceiptAnomalyMutation) SetProfileID(u uuid.UUID) {
+	m.profile_id = &u
+}
+
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *ReceiptAnomalyMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfileID returns the old "profile_id" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
+	}
+	return oldValue.ProfileID, nil
+}
+
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *ReceiptAnomalyMutation) ResetProfileID() {
+	m.profile_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ReceiptAnomalyMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ReceiptAnomalyMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ReceiptAnomalyMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReceiptAnomalyMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReceiptAnomalyMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReceiptAnomalyMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetScore sets the "score" field.
+func (m *ReceiptAnomalyMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *ReceiptAnomalyMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *ReceiptAnomalyMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ReceiptAnomalyMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *ReceiptAnomalyMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReceiptAnomalyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReceiptAnomalyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReceiptAnomaly entity.
+// If the ReceiptAnomaly object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptAnomalyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReceiptAnomalyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearReceipt clears the "receipt" edge to the Receipt entity.
+func (m *ReceiptAnomalyMutation) ClearReceipt() {
+	m.clearedreceipt = true
+	m.clearedFields[receiptanomaly.FieldReceiptID] = struct{}{}
+}
+
+// ReceiptCleared reports if the "receipt" edge to the Receipt entity was cleared.
+func (m *ReceiptAnomalyMutation) ReceiptCleared() bool {
+	return m.clearedreceipt
+}
+
+// ReceiptIDs returns the "receipt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReceiptID instead. It exists only for internal usage by the builders.
+func (m *ReceiptAnomalyMutation) ReceiptIDs() (ids []uuid.UUID) {
+	if id := m.receipt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReceipt resets all changes to the "receipt" edge.
+func (m *ReceiptAnomalyMutation) ResetReceipt() {
+	m.receipt = nil
+	m.clearedreceipt = false
+}
+
+// Where appends a list predicates to the ReceiptAnomalyMutation builder.
+func (m *ReceiptAnomalyMutation) Where(ps ...predicate.ReceiptAnomaly) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReceiptAnomalyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReceiptAnomalyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReceiptAnomaly, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReceiptAnomalyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReceiptAnomalyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReceiptAnomaly).
+func (m *ReceiptAnomalyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReceiptAnomalyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.receipt != nil {
+		fields = append(fields, receiptanomaly.FieldReceiptID)
+	}
+	if m.profile_id != nil {
+		fields = append(fields, receiptanomaly.FieldProfileID)
+	}
+	if m.kind != nil {
+		fields = append(fields, receiptanomaly.FieldKind)
+	}
+	if m.reason != nil {
+		fields = append(fields, receiptanomaly.FieldReason)
+	}
+	if m.score != nil {
+		fields = append(fields, receiptanomaly.FieldScore)
+	}
+	if m.created_at != nil {
+		fields = append(fields, receiptanomaly.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReceiptAnomalyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case receiptanomaly.FieldReceiptID:
+		return m.ReceiptID()
+	case receiptanomaly.FieldProfileID:
+		return m.ProfileID()
+	case receiptanomaly.FieldKind:
+		return m.Kind()
+	case receiptanomaly.FieldReason:
+		return m.Reason()
+	case receiptanomaly.FieldScore:
+		return m.Score()
+	case receiptanomaly.FieldCreatedAt:
+		return m.CreatedAt()
 	}
+	return nil, false
 }
 
-// AddedTax returns the value that was added to the "tax" field in this mutation.
-func (m *ReceiptMutation) AddedTax() (r float64, exists bool) {
-	v := m.addtax
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReceiptAnomalyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case receiptanomaly.FieldReceiptID:
+		return m.OldReceiptID(ctx)
+	case receiptanomaly.FieldProfileID:
+		return m.OldProfileID(ctx)
+	case receiptanomaly.FieldKind:
+		return m.OldKind(ctx)
+	case receiptanomaly.FieldReason:
+		return m.OldReason(ctx)
+	case receiptanomaly.FieldScore:
+		return m.OldScore(ctx)
+	case receiptanomaly.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ReceiptAnomaly field %s", name)
 }
 
-// ClearTax clears the value of the "tax" field.
-func (m *ReceiptMutation) ClearTax() {
-	m.tax = nil
-	m.addtax = nil
-	m.clearedFields[receipt.FieldTax] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptAnomalyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case receiptanomaly.FieldReceiptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiptID(v)
+		return nil
+	case receiptanomaly.FieldProfileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfileID(v)
+		return nil
+	case receiptanomaly.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case receiptanomaly.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case receiptanomaly.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case receiptanomaly.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReceiptAnomaly field %s", name)
 }
 
-// TaxCleared returns if the "tax" field was cleared in this mutation.
-func (m *ReceiptMutation) TaxCleared() bool {
-	_, ok := m.clearedFields[receipt.FieldTax]
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReceiptAnomalyMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, receiptanomaly.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReceiptAnomalyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case receiptanomaly.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptAnomalyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case receiptanomaly.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReceiptAnomaly numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReceiptAnomalyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReceiptAnomalyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetTax resets all changes to the "tax" field.
-func (m *ReceiptMutation) ResetTax() {
-	m.tax = nil
-	m.addtax = nil
-	delete(m.clearedFields, receipt.FieldTax)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReceiptAnomalyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReceiptAnomaly nullable field %s", name)
 }
 
-// SetTotal sets the "total" field.
-func (m *ReceiptMutation) SetTotal(f float64) {
-	m.total = &f
-	m.addtotal = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReceiptAnomalyMutation) ResetField(name string) error {
+	switch name {
+	case receiptanomaly.FieldReceiptID:
+		m.ResetReceiptID()
+		return nil
+	case receiptanomaly.FieldProfileID:
+		m.ResetProfileID()
+		return nil
+	case receiptanomaly.FieldKind:
+		m.ResetKind()
+		return nil
+	case receiptanomaly.FieldReason:
+		m.ResetReason()
+		return nil
+	case receiptanomaly.FieldScore:
+		m.ResetScore()
+		return nil
+	case receiptanomaly.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceiptAnomaly field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReceiptAnomalyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.receipt != nil {
+		edges = append(edges, receiptanomaly.EdgeReceipt)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReceiptAnomalyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case receiptanomaly.EdgeReceipt:
+		if id := m.receipt; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReceiptAnomalyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReceiptAnomalyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReceiptAnomalyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreceipt {
+		edges = append(edges, receiptanomaly.EdgeReceipt)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReceiptAnomalyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case receiptanomaly.EdgeReceipt:
+		return m.clearedreceipt
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReceiptAnomalyMutation) ClearEdge(name string) error {
+	switch name {
+	case receiptanomaly.EdgeReceipt:
+		m.ClearReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceiptAnomaly unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReceiptAnomalyMutation) ResetEdge(name string) error {
+	switch name {
+	case receiptanomaly.EdgeReceipt:
+		m.ResetReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceiptAnomaly edge %s", name)
+}
+
+// ReceiptFileMutation represents an operation that mutates the ReceiptFile nodes in the graph.
+type ReceiptFileMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	source_path    *string
+	content_hash   *[]byte
+	filename       *string
+	file_ext       *string
+	file_size      *int
+	addfile_size   *int
+	uploaded_at    *time.Time
+	clearedFields  map[string]struct{}
+	profile        *uuid.UUID
+	clearedprofile bool
+	jobs           map[uuid.UUID]struct{}
+	removedjobs    map[uuid.UUID]struct{}
+	clearedjobs    bool
+	done           bool
+	oldValue       func(context.Context) (*ReceiptFile, error)
+	predicates     []predicate.ReceiptFile
+}
+
+var _ ent.Mutation = (*ReceiptFileMutation)(nil)
+
+// receiptfileOption allows management of the mutation configuration using functional options.
+type receiptfileOption func(*ReceiptFileMutation)
+
+// newReceiptFileMutation creates new mutation for the ReceiptFile entity.
+func newReceiptFileMutation(c config, op Op, opts ...receiptfileOption) *ReceiptFileMutation {
+	m := &ReceiptFileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReceiptFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReceiptFileID sets the ID field of the mutation.
+func withReceiptFileID(id uuid.UUID) receiptfileOption {
+	return func(m *ReceiptFileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReceiptFile
+		)
+		m.oldValue = func(ctx context.Context) (*ReceiptFile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReceiptFile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReceiptFile sets the old ReceiptFile of the mutation.
+func withReceiptFile(node *ReceiptFile) receiptfileOption {
+	return func(m *ReceiptFileMutation) {
+		m.oldValue = func(context.Context) (*ReceiptFile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Total returns the value of the "total" field in the mutation.
-func (m *ReceiptMutation) Total() (r float64, exists bool) {
-	v := m.total
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReceiptFileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldTotal returns the old "total" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldTotal(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotal requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReceiptFileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Total, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddTotal adds f to the "total" field.
-func (m *ReceiptMutation) AddTotal(f float64) {
-	if m.addtotal != nil {
-		*m.addtotal += f
-	} else {
-		m.addtotal = &f
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReceiptFile entities.
+func (m *ReceiptFileMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddedTotal returns the value that was added to the "total" field in this mutation.
-func (m *ReceiptMutation) AddedTotal() (r float64, exists bool) {
-	v := m.addtotal
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReceiptFileMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetTotal resets all changes to the "total" field.
-func (m *ReceiptMutation) ResetTotal() {
-	m.total = nil
-	m.addtotal = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReceiptFileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReceiptFile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCurrencyCode sets the "currency_code" field.
-func (m *ReceiptMutation) SetCurrencyCode(s string) {
-	m.currency_code = &s
+// SetProfileID sets the "profile_id" field.
+func (m *ReceiptFileMutation) SetProfileID(u uuid.UUID) {
+	m.profile = &u
 }
 
-// CurrencyCode returns the value of the "currency_code" field in the mutation.
-func (m *ReceiptMutation) CurrencyCode() (r string, exists bool) {
-	v := m.currency_code
+// ProfileID returns the value of the "profile_id" field in the mutation.
+func (m *ReceiptFileMutation) ProfileID() (r uuid.UUID, exists bool) {
+	v := m.profile
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrencyCode returns the old "currency_code" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileID returns the old "profile_id" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldCurrencyCode(ctx context.Context) (v string, err error) {
+func (m *ReceiptFileMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrencyCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrencyCode requires an ID field in the mutation")
+		return v, errors.New("OldProfileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrencyCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldProfileID: %w", err)
 	}
-	return oldValue.CurrencyCode, nil
+	return oldValue.ProfileID, nil
 }
 
-// ResetCurrencyCode resets all changes to the "currency_code" field.
-func (m *ReceiptMutation) ResetCurrencyCode() {
-	m.currency_code = nil
+// ResetProfileID resets all changes to the "profile_id" field.
+func (m *ReceiptFileMutation) ResetProfileID() {
+	m.profile = nil
 }
 
-// SetCategoryName sets the "category_name" field.
-func (m *ReceiptMutation) SetCategoryName(s string) {
-	m.category_name = &s
+// SetSourcePath sets the "source_path" field.
+func (m *ReceiptFileMutation) SetSourcePath(s string) {
+	m.source_path = &s
 }
 
-// CategoryName returns the value of the "category_name" field in the mutation.
-func (m *ReceiptMutation) CategoryName() (r string, exists bool) {
-	v := m.category_name
+// SourcePath returns the value of the "source_path" field in the mutation.
+func (m *ReceiptFileMutation) SourcePath() (r string, exists bool) {
+	v := m.source_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategoryName returns the old "category_name" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldSourcePath returns the old "source_path" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldCategoryName(ctx context.Context) (v string, err error) {
+func (m *ReceiptFileMutation) OldSourcePath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategoryName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourcePath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategoryName requires an ID field in the mutation")
+		return v, errors.New("OldSourcePath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategoryName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourcePath: %w", err)
 	}
-	return oldValue.CategoryName, nil
+	return oldValue.SourcePath, nil
 }
 
-// ResetCategoryName resets all changes to the "category_name" field.
-func (m *ReceiptMutation) ResetCategoryName() {
-	m.category_name = nil
+// ResetSourcePath resets all changes to the "source_path" field.
+func (m *ReceiptFileMutation) ResetSourcePath() {
+	m.source_path = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ReceiptMutation) SetDescription(s string) {
-	m.description = &s
+// SetContentHash sets the "content_hash" field.
+func (m *ReceiptFileMutation) SetContentHash(b []byte) {
+	m.content_hash = &b
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ReceiptMutation) Description() (r string, exists bool) {
-	v := m.description
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ReceiptFileMutation) ContentHash() (r []byte, exists bool) {
+	v := m.content_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldContentHash returns the old "content_hash" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ReceiptFileMutation) OldContentHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ContentHash, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ReceiptMutation) ResetDescription() {
-	m.description = nil
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ReceiptFileMutation) ResetContentHash() {
+	m.content_hash = nil
 }
 
-// SetFilePath sets the "file_path" field.
-func (m *ReceiptMutation) SetFilePath(s string) {
-	m.file_path = &s
+// SetFilename sets the "filename" field.
+func (m *ReceiptFileMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// FilePath returns the value of the "file_path" field in the mutation.
-func (m *ReceiptMutation) FilePath() (r string, exists bool) {
-	v := m.file_path
+// Filename returns the value of the "filename" field in the mutation.
+func (m *ReceiptFileMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilePath returns the old "file_path" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldFilePath(ctx context.Context) (v *string, err error) {
+func (m *ReceiptFileMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilePath requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.FilePath, nil
-}
-
-// ClearFilePath clears the value of the "file_path" field.
-func (m *ReceiptMutation) ClearFilePath() {
-	m.file_path = nil
-	m.clearedFields[receipt.FieldFilePath] = struct{}{}
-}
-
-// FilePathCleared returns if the "file_path" field was cleared in this mutation.
-func (m *ReceiptMutation) FilePathCleared() bool {
-	_, ok := m.clearedFields[receipt.FieldFilePath]
-	return ok
+	return oldValue.Filename, nil
 }
 
-// ResetFilePath resets all changes to the "file_path" field.
-func (m *ReceiptMutation) ResetFilePath() {
-	m.file_path = nil
-	delete(m.clearedFields, receipt.FieldFilePath)
+// ResetFilename resets all changes to the "filename" field.
+func (m *ReceiptFileMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// SetIsCurrent sets the "is_current" field.
-func (m *ReceiptMutation) SetIsCurrent(b bool) {
-	m.is_current = &b
+// SetFileExt sets the "file_ext" field.
+func (m *ReceiptFileMutation) SetFileExt(s string) {
+	m.file_ext = &s
 }
 
-// IsCurrent returns the value of the "is_current" field in the mutation.
-func (m *ReceiptMutation) IsCurrent() (r bool, exists bool) {
-	v := m.is_current
+// FileExt returns the value of the "file_ext" field in the mutation.
+func (m *ReceiptFileMutation) FileExt() (r string, exists bool) {
+	v := m.file_ext
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsCurrent returns the old "is_current" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldFileExt returns the old "file_ext" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldIsCurrent(ctx context.Context) (v bool, err error) {
+func (m *ReceiptFileMutation) OldFileExt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCurrent is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileExt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCurrent requires an ID field in the mutation")
+		return v, errors.New("OldFileExt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCurrent: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileExt: %w", err)
 	}
-	return oldValue.IsCurrent, nil
+	return oldValue.FileExt, nil
 }
 
-// ResetIsCurrent resets all changes to the "is_current" field.
-func (m *ReceiptMutation) ResetIsCurrent() {
-	m.is_current = nil
+// ResetFileExt resets all changes to the "file_ext" field.
+func (m *ReceiptFileMutation) ResetFileExt() {
+	m.file_ext = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ReceiptMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetFileSize sets the "file_size" field.
+func (m *ReceiptFileMutation) SetFileSize(i int) {
+	m.file_size = &i
+	m.addfile_size = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReceiptMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// FileSize returns the value of the "file_size" field in the mutation.
+func (m *ReceiptFileMutation) FileSize() (r int, exists bool) {
+	v := m.file_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldFileSize returns the old "file_size" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReceiptFileMutation) OldFileSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.FileSize, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReceiptMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddFileSize adds i to the "file_size" field.
+func (m *ReceiptFileMutation) AddFileSize(i int) {
+	if m.addfile_size != nil {
+		*m.addfile_size += i
+	} else {
+		m.addfile_size = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ReceiptMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
+func (m *ReceiptFileMutation) AddedFileSize() (r int, exists bool) {
+	v := m.addfile_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ReceiptMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetFileSize resets all changes to the "file_size" field.
+func (m *ReceiptFileMutation) ResetFileSize() {
+	m.file_size = nil
+	m.addfile_size = nil
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (m *ReceiptFileMutation) SetUploadedAt(t time.Time) {
+	m.uploaded_at = &t
+}
+
+// UploadedAt returns the value of the "uploaded_at" field in the mutation.
+func (m *ReceiptFileMutation) UploadedAt() (r time.Time, exists bool) {
+	v := m.uploaded_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Receipt entity.
-// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// OldUploadedAt returns the old "uploaded_at" field's value of the ReceiptFile entity.
+// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReceiptFileMutation) OldUploadedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploadedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUploadedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploadedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UploadedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ReceiptMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUploadedAt resets all changes to the "uploaded_at" field.
+func (m *ReceiptFileMutation) ResetUploadedAt() {
+	m.uploaded_at = nil
 }
 
 // ClearProfile clears the "profile" edge to the Profile entity.
-func (m *ReceiptMutation) ClearProfile() {
+func (m *ReceiptFileMutation) ClearProfile() {
 	m.clearedprofile = true
-	m.clearedFields[receipt.FieldProfileID] = struct{}{}
+	m.clearedFields[receiptfile.FieldProfileID] = struct{}{}
 }
 
 // ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
-func (m *ReceiptMutation) ProfileCleared() bool {
+func (m *ReceiptFileMutation) ProfileCleared() bool {
 	return m.clearedprofile
 }
 
 // ProfileIDs returns the "profile" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProfileID instead. It exists only for internal usage by the builders.
-func (m *ReceiptMutation) ProfileIDs() (ids []uuid.UUID) {
+func (m *ReceiptFileMutation) ProfileIDs() (ids []uuid.UUID) {
 	if id := m.profile; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3142,67 +13549,13 @@ func (m *ReceiptMutation) ProfileIDs() (ids []uuid.UUID) {
 }
 
 // ResetProfile resets all changes to the "profile" edge.
-func (m *ReceiptMutation) ResetProfile() {
+func (m *ReceiptFileMutation) ResetProfile() {
 	m.profile = nil
 	m.clearedprofile = false
 }
 
-// AddFileIDs adds the "files" edge to the ReceiptFile entity by ids.
-func (m *ReceiptMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFiles clears the "files" edge to the ReceiptFile entity.
-func (m *ReceiptMutation) ClearFiles() {
-	m.clearedfiles = true
-}
-
-// FilesCleared reports if the "files" edge to the ReceiptFile entity was cleared.
-func (m *ReceiptMutation) FilesCleared() bool {
-	return m.clearedfiles
-}
-
-// RemoveFileIDs removes the "files" edge to the ReceiptFile entity by IDs.
-func (m *ReceiptMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFiles returns the removed IDs of the "files" edge to the ReceiptFile entity.
-func (m *ReceiptMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *ReceiptMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetFiles resets all changes to the "files" edge.
-func (m *ReceiptMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
-}
-
 // AddJobIDs adds the "jobs" edge to the ExtractJob entity by ids.
-func (m *ReceiptMutation) AddJobIDs(ids ...uuid.UUID) {
+func (m *ReceiptFileMutation) AddJobIDs(ids ...uuid.UUID) {
 	if m.jobs == nil {
 		m.jobs = make(map[uuid.UUID]struct{})
 	}
@@ -3212,17 +13565,17 @@ func (m *ReceiptMutation) AddJobIDs(ids ...uuid.UUID) {
 }
 
 // ClearJobs clears the "jobs" edge to the ExtractJob entity.
-func (m *ReceiptMutation) ClearJobs() {
+func (m *ReceiptFileMutation) ClearJobs() {
 	m.clearedjobs = true
 }
 
 // JobsCleared reports if the "jobs" edge to the ExtractJob entity was cleared.
-func (m *ReceiptMutation) JobsCleared() bool {
+func (m *ReceiptFileMutation) JobsCleared() bool {
 	return m.clearedjobs
 }
 
 // RemoveJobIDs removes the "jobs" edge to the ExtractJob entity by IDs.
-func (m *ReceiptMutation) RemoveJobIDs(ids ...uuid.UUID) {
+func (m *ReceiptFileMutation) RemoveJobIDs(ids ...uuid.UUID) {
 	if m.removedjobs == nil {
 		m.removedjobs = make(map[uuid.UUID]struct{})
 	}
@@ -3233,7 +13586,7 @@ func (m *ReceiptMutation) RemoveJobIDs(ids ...uuid.UUID) {
 }
 
 // RemovedJobs returns the removed IDs of the "jobs" edge to the ExtractJob entity.
-func (m *ReceiptMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+func (m *ReceiptFileMutation) RemovedJobsIDs() (ids []uuid.UUID) {
 	for id := range m.removedjobs {
 		ids = append(ids, id)
 	}
@@ -3241,7 +13594,7 @@ func (m *ReceiptMutation) RemovedJobsIDs() (ids []uuid.UUID) {
 }
 
 // JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *ReceiptMutation) JobsIDs() (ids []uuid.UUID) {
+func (m *ReceiptFileMutation) JobsIDs() (ids []uuid.UUID) {
 	for id := range m.jobs {
 		ids = append(ids, id)
 	}
@@ -3249,21 +13602,21 @@ func (m *ReceiptMutation) JobsIDs() (ids []uuid.UUID) {
 }
 
 // ResetJobs resets all changes to the "jobs" edge.
-func (m *ReceiptMutation) ResetJobs() {
+func (m *ReceiptFileMutation) ResetJobs() {
 	m.jobs = nil
 	m.clearedjobs = false
 	m.removedjobs = nil
 }
 
-// Where appends a list predicates to the ReceiptMutation builder.
-func (m *ReceiptMutation) Where(ps ...predicate.Receipt) {
+// Where appends a list predicates to the ReceiptFileMutation builder.
+func (m *ReceiptFileMutation) Where(ps ...predicate.ReceiptFile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReceiptMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReceiptFileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReceiptMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Receipt, len(ps))
+func (m *ReceiptFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReceiptFile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3271,66 +13624,45 @@ func (m *ReceiptMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReceiptMutation) Op() Op {
+func (m *ReceiptFileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReceiptMutation) SetOp(op Op) {
+func (m *ReceiptFileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Receipt).
-func (m *ReceiptMutation) Type() string {
+// Type returns the node type of this mutation (ReceiptFile).
+func (m *ReceiptFileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReceiptMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *ReceiptFileMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.profile != nil {
-		fields = append(fields, receipt.FieldProfileID)
-	}
-	if m.file_id != nil {
-		fields = append(fields, receipt.FieldFileID)
-	}
-	if m.merchant_name != nil {
-		fields = append(fields, receipt.FieldMerchantName)
-	}
-	if m.tx_date != nil {
-		fields = append(fields, receipt.FieldTxDate)
-	}
-	if m.subtotal != nil {
-		fields = append(fields, receipt.FieldSubtotal)
-	}
-	if m.tax != nil {
-		fields = append(fields, receipt.FieldTax)
-	}
-	if m.total != nil {
-		fields = append(fields, receipt.FieldTotal)
-	}
-	if m.currency_code != nil {
-		fields = append(fields, receipt.FieldCurrencyCode)
+		fields = append(fields, receiptfile.FieldProfileID)
 	}
-	if m.category_name != nil {
-		fields = append(fields, receipt.FieldCategoryName)
+	if m.source_path != nil {
+		fields = append(fields, receiptfile.FieldSourcePath)
 	}
-	if m.description != nil {
-		fields = append(fields, receipt.FieldDescription)
+	if m.content_hash != nil {
+		fields = append(fields, receiptfile.FieldContentHash)
 	}
-	if m.file_path != nil {
-		fields = append(fields, receipt.FieldFilePath)
+	if m.filename != nil {
+		fields = append(fields, receiptfile.FieldFilename)
 	}
-	if m.is_current != nil {
-		fields = append(fields, receipt.FieldIsCurrent)
+	if m.file_ext != nil {
+		fields = append(fields, receiptfile.FieldFileExt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, receipt.FieldCreatedAt)
+	if m.file_size != nil {
+		fields = append(fields, receiptfile.FieldFileSize)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, receipt.FieldUpdatedAt)
+	if m.uploaded_at != nil {
+		fields = append(fields, receiptfile.FieldUploadedAt)
 	}
 	return fields
 }
@@ -3338,36 +13670,22 @@ func (m *ReceiptMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReceiptMutation) Field(name string) (ent.Value, bool) {
+func (m *ReceiptFileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case receipt.FieldProfileID:
+	case receiptfile.FieldProfileID:
 		return m.ProfileID()
-	case receipt.FieldFileID:
-		return m.FileID()
-	case receipt.FieldMerchantName:
-		return m.MerchantName()
-	case receipt.FieldTxDate:
-		return m.TxDate()
-	case receipt.FieldSubtotal:
-		return m.Subtotal()
-	case receipt.FieldTax:
-		return m.Tax()
-	case receipt.FieldTotal:
-		return m.Total()
-	case receipt.FieldCurrencyCode:
-		return m.CurrencyCode()
-	case receipt.FieldCategoryName:
-		return m.CategoryName()
-	case receipt.FieldDescription:
-		return m.Description()
-	case receipt.FieldFilePath:
-		return m.FilePath()
-	case receipt.FieldIsCurrent:
-		return m.IsCurrent()
-	case receipt.FieldCreatedAt:
-		return m.CreatedAt()
-	case receipt.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case receiptfile.FieldSourcePath:
+		return m.SourcePath()
+	case receiptfile.FieldContentHash:
+		return m.ContentHash()
+	case receiptfile.FieldFilename:
+		return m.Filename()
+	case receiptfile.FieldFileExt:
+		return m.FileExt()
+	case receiptfile.FieldFileSize:
+		return m.FileSize()
+	case receiptfile.FieldUploadedAt:
+		return m.UploadedAt()
 	}
 	return nil, false
 }
@@ -3375,159 +13693,90 @@ func (m *ReceiptMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReceiptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReceiptFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case receipt.FieldProfileID:
+	case receiptfile.FieldProfileID:
 		return m.OldProfileID(ctx)
-	case receipt.FieldFileID:
-		return m.OldFileID(ctx)
-	case receipt.FieldMerchantName:
-		return m.OldMerchantName(ctx)
-	case receipt.FieldTxDate:
-		return m.OldTxDate(ctx)
-	case receipt.FieldSubtotal:
-		return m.OldSubtotal(ctx)
-	case receipt.FieldTax:
-		return m.OldTax(ctx)
-	case receipt.FieldTotal:
-		return m.OldTotal(ctx)
-	case receipt.FieldCurrencyCode:
-		return m.OldCurrencyCode(ctx)
-	case receipt.FieldCategoryName:
-		return m.OldCategoryName(ctx)
-	case receipt.FieldDescription:
-		return m.OldDescription(ctx)
-	case receipt.FieldFilePath:
-		return m.OldFilePath(ctx)
-	case receipt.FieldIsCurrent:
-		return m.OldIsCurrent(ctx)
-	case receipt.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case receipt.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case receiptfile.FieldSourcePath:
+		return m.OldSourcePath(ctx)
+	case receiptfile.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case receiptfile.FieldFilename:
+		return m.OldFilename(ctx)
+	case receiptfile.FieldFileExt:
+		return m.OldFileExt(ctx)
+	case receiptfile.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case receiptfile.FieldUploadedAt:
+		return m.OldUploadedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Receipt field %s", name)
+	return nil, fmt.Errorf("unknown ReceiptFile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReceiptMutation) SetField(name string, value ent.Value) error {
+func (m *ReceiptFileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case receipt.FieldProfileID:
+	case receiptfile.FieldProfileID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProfileID(v)
 		return nil
-	case receipt.FieldFileID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileID(v)
-		return nil
-	case receipt.FieldMerchantName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMerchantName(v)
-		return nil
-	case receipt.FieldTxDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTxDate(v)
-		return nil
-	case receipt.FieldSubtotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSubtotal(v)
-		return nil
-	case receipt.FieldTax:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTax(v)
-		return nil
-	case receipt.FieldTotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotal(v)
-		return nil
-	case receipt.FieldCurrencyCode:
+	case receiptfile.FieldSourcePath:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrencyCode(v)
+		m.SetSourcePath(v)
 		return nil
-	case receipt.FieldCategoryName:
-		v, ok := value.(string)
+	case receiptfile.FieldContentHash:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategoryName(v)
+		m.SetContentHash(v)
 		return nil
-	case receipt.FieldDescription:
+	case receiptfile.FieldFilename:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetFilename(v)
 		return nil
-	case receipt.FieldFilePath:
+	case receiptfile.FieldFileExt:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilePath(v)
-		return nil
-	case receipt.FieldIsCurrent:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsCurrent(v)
+		m.SetFileExt(v)
 		return nil
-	case receipt.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case receiptfile.FieldFileSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetFileSize(v)
 		return nil
-	case receipt.FieldUpdatedAt:
+	case receiptfile.FieldUploadedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetUploadedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Receipt field %s", name)
+	return fmt.Errorf("unknown ReceiptFile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReceiptMutation) AddedFields() []string {
+func (m *ReceiptFileMutation) AddedFields() []string {
 	var fields []string
-	if m.addsubtotal != nil {
-		fields = append(fields, receipt.FieldSubtotal)
-	}
-	if m.addtax != nil {
-		fields = append(fields, receipt.FieldTax)
-	}
-	if m.addtotal != nil {
-		fields = append(fields, receipt.FieldTotal)
+	if m.addfile_size != nil {
+		fields = append(fields, receiptfile.FieldFileSize)
 	}
 	return fields
 }
@@ -3535,14 +13784,10 @@ func (m *ReceiptMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReceiptMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReceiptFileMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case receipt.FieldSubtotal:
-		return m.AddedSubtotal()
-	case receipt.FieldTax:
-		return m.AddedTax()
-	case receipt.FieldTotal:
-		return m.AddedTotal()
+	case receiptfile.FieldFileSize:
+		return m.AddedFileSize()
 	}
 	return nil, false
 }
@@ -3550,159 +13795,88 @@ func (m *ReceiptMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReceiptMutation) AddField(name string, value ent.Value) error {
+func (m *ReceiptFileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case receipt.FieldSubtotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubtotal(v)
-		return nil
-	case receipt.FieldTax:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTax(v)
-		return nil
-	case receipt.FieldTotal:
-		v, ok := value.(float64)
+	case receiptfile.FieldFileSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotal(v)
+		m.AddFileSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Receipt numeric field %s", name)
+	return fmt.Errorf("unknown ReceiptFile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReceiptMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(receipt.FieldFileID) {
-		fields = append(fields, receipt.FieldFileID)
-	}
-	if m.FieldCleared(receipt.FieldSubtotal) {
-		fields = append(fields, receipt.FieldSubtotal)
-	}
-	if m.FieldCleared(receipt.FieldTax) {
-		fields = append(fields, receipt.FieldTax)
-	}
-	if m.FieldCleared(receipt.FieldFilePath) {
-		fields = append(fields, receipt.FieldFilePath)
-	}
-	return fields
+func (m *ReceiptFileMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReceiptMutation) FieldCleared(name string) bool {
+func (m *ReceiptFileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReceiptMutation) ClearField(name string) error {
-	switch name {
-	case receipt.FieldFileID:
-		m.ClearFileID()
-		return nil
-	case receipt.FieldSubtotal:
-		m.ClearSubtotal()
-		return nil
-	case receipt.FieldTax:
-		m.ClearTax()
-		return nil
-	case receipt.FieldFilePath:
-		m.ClearFilePath()
-		return nil
-	}
-	return fmt.Errorf("unknown Receipt nullable field %s", name)
+func (m *ReceiptFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReceiptFile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReceiptMutation) ResetField(name string) error {
+func (m *ReceiptFileMutation) ResetField(name string) error {
 	switch name {
-	case receipt.FieldProfileID:
+	case receiptfile.FieldProfileID:
 		m.ResetProfileID()
 		return nil
-	case receipt.FieldFileID:
-		m.ResetFileID()
-		return nil
-	case receipt.FieldMerchantName:
-		m.ResetMerchantName()
-		return nil
-	case receipt.FieldTxDate:
-		m.ResetTxDate()
-		return nil
-	case receipt.FieldSubtotal:
-		m.ResetSubtotal()
-		return nil
-	case receipt.FieldTax:
-		m.ResetTax()
-		return nil
-	case receipt.FieldTotal:
-		m.ResetTotal()
-		return nil
-	case receipt.FieldCurrencyCode:
-		m.ResetCurrencyCode()
-		return nil
-	case receipt.FieldCategoryName:
-		m.ResetCategoryName()
+	case receiptfile.FieldSourcePath:
+		m.ResetSourcePath()
 		return nil
-	case receipt.FieldDescription:
-		m.ResetDescription()
+	case receiptfile.FieldContentHash:
+		m.ResetContentHash()
 		return nil
-	case receipt.FieldFilePath:
-		m.ResetFilePath()
+	case receiptfile.FieldFilename:
+		m.ResetFilename()
 		return nil
-	case receipt.FieldIsCurrent:
-		m.ResetIsCurrent()
+	case receiptfile.FieldFileExt:
+		m.ResetFileExt()
 		return nil
-	case receipt.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case receiptfile.FieldFileSize:
+		m.ResetFileSize()
 		return nil
-	case receipt.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case receiptfile.FieldUploadedAt:
+		m.ResetUploadedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Receipt field %s", name)
+	return fmt.Errorf("unknown ReceiptFile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReceiptMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *ReceiptFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.profile != nil {
-		edges = append(edges, receipt.EdgeProfile)
-	}
-	if m.files != nil {
-		edges = append(edges, receipt.EdgeFiles)
+		edges = append(edges, receiptfile.EdgeProfile)
 	}
 	if m.jobs != nil {
-		edges = append(edges, receipt.EdgeJobs)
+		edges = append(edges, receiptfile.EdgeJobs)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReceiptMutation) AddedIDs(name string) []ent.Value {
+func (m *ReceiptFileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case receipt.EdgeProfile:
+	case receiptfile.EdgeProfile:
 		if id := m.profile; id != nil {
 			return []ent.Value{*id}
 		}
-	case receipt.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
-		}
-		return ids
-	case receipt.EdgeJobs:
+	case receiptfile.EdgeJobs:
 		ids := make([]ent.Value, 0, len(m.jobs))
 		for id := range m.jobs {
 			ids = append(ids, id)
@@ -3713,28 +13887,19 @@ func (m *ReceiptMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReceiptMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedfiles != nil {
-		edges = append(edges, receipt.EdgeFiles)
-	}
+func (m *ReceiptFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.removedjobs != nil {
-		edges = append(edges, receipt.EdgeJobs)
+		edges = append(edges, receiptfile.EdgeJobs)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReceiptMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReceiptFileMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case receipt.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case receipt.EdgeJobs:
+	case receiptfile.EdgeJobs:
 		ids := make([]ent.Value, 0, len(m.removedjobs))
 		for id := range m.removedjobs {
 			ids = append(ids, id)
@@ -3745,29 +13910,24 @@ func (m *ReceiptMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReceiptMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *ReceiptFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedprofile {
-		edges = append(edges, receipt.EdgeProfile)
-	}
-	if m.clearedfiles {
-		edges = append(edges, receipt.EdgeFiles)
+		edges = append(edges, receiptfile.EdgeProfile)
 	}
 	if m.clearedjobs {
-		edges = append(edges, receipt.EdgeJobs)
+		edges = append(edges, receiptfile.EdgeJobs)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReceiptMutation) EdgeCleared(name string) bool {
+func (m *ReceiptFileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case receipt.EdgeProfile:
+	case receiptfile.EdgeProfile:
 		return m.clearedprofile
-	case receipt.EdgeFiles:
-		return m.clearedfiles
-	case receipt.EdgeJobs:
+	case receiptfile.EdgeJobs:
 		return m.clearedjobs
 	}
 	return false
@@ -3775,67 +13935,61 @@ func (m *ReceiptMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReceiptMutation) ClearEdge(name string) error {
+func (m *ReceiptFileMutation) ClearEdge(name string) error {
 	switch name {
-	case receipt.EdgeProfile:
+	case receiptfile.EdgeProfile:
 		m.ClearProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown Receipt unique edge %s", name)
+	return fmt.Errorf("unknown ReceiptFile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReceiptMutation) ResetEdge(name string) error {
+func (m *ReceiptFileMutation) ResetEdge(name string) error {
 	switch name {
-	case receipt.EdgeProfile:
+	case receiptfile.EdgeProfile:
 		m.ResetProfile()
 		return nil
-	case receipt.EdgeFiles:
-		m.ResetFiles()
-		return nil
-	case receipt.EdgeJobs:
+	case receiptfile.EdgeJobs:
 		m.ResetJobs()
 		return nil
 	}
-	return fmt.Errorf("unknown Receipt edge %s", name)
+	return fmt.Errorf("unknown ReceiptFile edge %s", name)
 }
 
-// ReceiptFileMutation represents an operation that mutates the ReceiptFile nodes in the graph.
-type ReceiptFileMutation struct {
+// StatementTransactionMutation represents an operation that mutates the StatementTransaction nodes in the graph.
+type StatementTransactionMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *uuid.UUID
-	source_path    *string
-	content_hash   *[]byte
-	filename       *string
-	file_ext       *string
-	file_size      *int
-	addfile_size   *int
-	uploaded_at    *time.Time
+	account        *string
+	posted_date    *time.Time
+	description    *string
+	amount         *money.Amount
+	currency_code  *string
+	external_id    *string
+	created_at     *time.Time
 	clearedFields  map[string]struct{}
 	profile        *uuid.UUID
 	clearedprofile bool
-	jobs           map[uuid.UUID]struct{}
-	removedjobs    map[uuid.UUID]struct{}
-	clearedjobs    bool
 	done           bool
-	oldValue       func(context.Context) (*ReceiptFile, error)
-	predicates     []predicate.ReceiptFile
+	oldValue       func(context.Context) (*StatementTransaction, error)
+	predicates     []predicate.StatementTransaction
 }
 
-var _ ent.Mutation = (*ReceiptFileMutation)(nil)
+var _ ent.Mutation = (*StatementTransactionMutation)(nil)
 
-// receiptfileOption allows management of the mutation configuration using functional options.
-type receiptfileOption func(*ReceiptFileMutation)
+// statementtransactionOption allows management of the mutation configuration using functional options.
+type statementtransactionOption func(*StatementTransactionMutation)
 
-// newReceiptFileMutation creates new mutation for the ReceiptFile entity.
-func newReceiptFileMutation(c config, op Op, opts ...receiptfileOption) *ReceiptFileMutation {
-	m := &ReceiptFileMutation{
+// newStatementTransactionMutation creates new mutation for the StatementTransaction entity.
+func newStatementTransactionMutation(c config, op Op, opts ...statementtransactionOption) *StatementTransactionMutation {
+	m := &StatementTransactionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReceiptFile,
+		typ:           TypeStatementTransaction,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3844,20 +13998,20 @@ func newReceiptFileMutation(c config, op Op, opts ...receiptfileOption) *Receipt
 	return m
 }
 
-// withReceiptFileID sets the ID field of the mutation.
-func withReceiptFileID(id uuid.UUID) receiptfileOption {
-	return func(m *ReceiptFileMutation) {
+// withStatementTransactionID sets the ID field of the mutation.
+func withStatementTransactionID(id uuid.UUID) statementtransactionOption {
+	return func(m *StatementTransactionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ReceiptFile
+			value *StatementTransaction
 		)
-		m.oldValue = func(ctx context.Context) (*ReceiptFile, error) {
+		m.oldValue = func(ctx context.Context) (*StatementTransaction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ReceiptFile.Get(ctx, id)
+					value, err = m.Client().StatementTransaction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3866,10 +14020,10 @@ func withReceiptFileID(id uuid.UUID) receiptfileOption {
 	}
 }
 
-// withReceiptFile sets the old ReceiptFile of the mutation.
-func withReceiptFile(node *ReceiptFile) receiptfileOption {
-	return func(m *ReceiptFileMutation) {
-		m.oldValue = func(context.Context) (*ReceiptFile, error) {
+// withStatementTransaction sets the old StatementTransaction of the mutation.
+func withStatementTransaction(node *StatementTransaction) statementtransactionOption {
+	return func(m *StatementTransactionMutation) {
+		m.oldValue = func(context.Context) (*StatementTransaction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3878,7 +14032,7 @@ func withReceiptFile(node *ReceiptFile) receiptfileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReceiptFileMutation) Client() *Client {
+func (m StatementTransactionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3886,7 +14040,7 @@ func (m ReceiptFileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReceiptFileMutation) Tx() (*Tx, error) {
+func (m StatementTransactionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3896,14 +14050,14 @@ func (m ReceiptFileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ReceiptFile entities.
-func (m *ReceiptFileMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of StatementTransaction entities.
+func (m *StatementTransactionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReceiptFileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *StatementTransactionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3914,7 +14068,7 @@ func (m *ReceiptFileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReceiptFileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *StatementTransactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3923,19 +14077,19 @@ func (m *ReceiptFileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ReceiptFile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StatementTransaction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetProfileID sets the "profile_id" field.
-func (m *ReceiptFileMutation) SetProfileID(u uuid.UUID) {
+func (m *StatementTransactionMutation) SetProfileID(u uuid.UUID) {
 	m.profile = &u
 }
 
 // ProfileID returns the value of the "profile_id" field in the mutation.
-func (m *ReceiptFileMutation) ProfileID() (r uuid.UUID, exists bool) {
+func (m *StatementTransactionMutation) ProfileID() (r uuid.UUID, exists bool) {
 	v := m.profile
 	if v == nil {
 		return
@@ -3943,10 +14097,10 @@ func (m *ReceiptFileMutation) ProfileID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldProfileID returns the old "profile_id" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileID returns the old "profile_id" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *StatementTransactionMutation) OldProfileID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProfileID is only allowed on UpdateOne operations")
 	}
@@ -3961,261 +14115,277 @@ func (m *ReceiptFileMutation) OldProfileID(ctx context.Context) (v uuid.UUID, er
 }
 
 // ResetProfileID resets all changes to the "profile_id" field.
-func (m *ReceiptFileMutation) ResetProfileID() {
+func (m *StatementTransactionMutation) ResetProfileID() {
 	m.profile = nil
 }
 
-// SetSourcePath sets the "source_path" field.
-func (m *ReceiptFileMutation) SetSourcePath(s string) {
-	m.source_path = &s
+// SetAccount sets the "account" field.
+func (m *StatementTransactionMutation) SetAccount(s string) {
+	m.account = &s
 }
 
-// SourcePath returns the value of the "source_path" field in the mutation.
-func (m *ReceiptFileMutation) SourcePath() (r string, exists bool) {
-	v := m.source_path
+// Account returns the value of the "account" field in the mutation.
+func (m *StatementTransactionMutation) Account() (r string, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourcePath returns the old "source_path" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldSourcePath(ctx context.Context) (v string, err error) {
+func (m *StatementTransactionMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourcePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourcePath requires an ID field in the mutation")
+		return v, errors.New("OldAccount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourcePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
 	}
-	return oldValue.SourcePath, nil
+	return oldValue.Account, nil
 }
 
-// ResetSourcePath resets all changes to the "source_path" field.
-func (m *ReceiptFileMutation) ResetSourcePath() {
-	m.source_path = nil
+// ResetAccount resets all changes to the "account" field.
+func (m *StatementTransactionMutation) ResetAccount() {
+	m.account = nil
 }
 
-// SetContentHash sets the "content_hash" field.
-func (m *ReceiptFileMutation) SetContentHash(b []byte) {
-	m.content_hash = &b
+// SetPostedDate sets the "posted_date" field.
+func (m *StatementTransactionMutation) SetPostedDate(t time.Time) {
+	m.posted_date = &t
 }
 
-// ContentHash returns the value of the "content_hash" field in the mutation.
-func (m *ReceiptFileMutation) ContentHash() (r []byte, exists bool) {
-	v := m.content_hash
+// PostedDate returns the value of the "posted_date" field in the mutation.
+func (m *StatementTransactionMutation) PostedDate() (r time.Time, exists bool) {
+	v := m.posted_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentHash returns the old "content_hash" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldPostedDate returns the old "posted_date" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldContentHash(ctx context.Context) (v []byte, err error) {
+func (m *StatementTransactionMutation) OldPostedDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldPostedDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentHash requires an ID field in the mutation")
+		return v, errors.New("OldPostedDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldPostedDate: %w", err)
 	}
-	return oldValue.ContentHash, nil
+	return oldValue.PostedDate, nil
 }
 
-// ResetContentHash resets all changes to the "content_hash" field.
-func (m *ReceiptFileMutation) ResetContentHash() {
-	m.content_hash = nil
+// ResetPostedDate resets all changes to the "posted_date" field.
+func (m *StatementTransactionMutation) ResetPostedDate() {
+	m.posted_date = nil
 }
 
-// SetFilename sets the "filename" field.
-func (m *ReceiptFileMutation) SetFilename(s string) {
-	m.filename = &s
+// SetDescription sets the "description" field.
+func (m *StatementTransactionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *ReceiptFileMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// Description returns the value of the "description" field in the mutation.
+func (m *StatementTransactionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *StatementTransactionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.Description, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *ReceiptFileMutation) ResetFilename() {
-	m.filename = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *StatementTransactionMutation) ResetDescription() {
+	m.description = nil
 }
 
-// SetFileExt sets the "file_ext" field.
-func (m *ReceiptFileMutation) SetFileExt(s string) {
-	m.file_ext = &s
+// SetAmount sets the "amount" field.
+func (m *StatementTransactionMutation) SetAmount(value money.Amount) {
+	m.amount = &value
 }
 
-// FileExt returns the value of the "file_ext" field in the mutation.
-func (m *ReceiptFileMutation) FileExt() (r string, exists bool) {
-	v := m.file_ext
+// Amount returns the value of the "amount" field in the mutation.
+func (m *StatementTransactionMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileExt returns the old "file_ext" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldFileExt(ctx context.Context) (v string, err error) {
+func (m *StatementTransactionMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileExt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileExt requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileExt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.FileExt, nil
+	return oldValue.Amount, nil
 }
 
-// ResetFileExt resets all changes to the "file_ext" field.
-func (m *ReceiptFileMutation) ResetFileExt() {
-	m.file_ext = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *StatementTransactionMutation) ResetAmount() {
+	m.amount = nil
 }
 
-// SetFileSize sets the "file_size" field.
-func (m *ReceiptFileMutation) SetFileSize(i int) {
-	m.file_size = &i
-	m.addfile_size = nil
+// SetCurrencyCode sets the "currency_code" field.
+func (m *StatementTransactionMutation) SetCurrencyCode(s string) {
+	m.currency_code = &s
 }
 
-// FileSize returns the value of the "file_size" field in the mutation.
-func (m *ReceiptFileMutation) FileSize() (r int, exists bool) {
-	v := m.file_size
+// CurrencyCode returns the value of the "currency_code" field in the mutation.
+func (m *StatementTransactionMutation) CurrencyCode() (r string, exists bool) {
+	v := m.currency_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileSize returns the old "file_size" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrencyCode returns the old "currency_code" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldFileSize(ctx context.Context) (v int, err error) {
+func (m *StatementTransactionMutation) OldCurrencyCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrencyCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileSize requires an ID field in the mutation")
+		return v, errors.New("OldCurrencyCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrencyCode: %w", err)
 	}
-	return oldValue.FileSize, nil
+	return oldValue.CurrencyCode, nil
 }
 
-// AddFileSize adds i to the "file_size" field.
-func (m *ReceiptFileMutation) AddFileSize(i int) {
-	if m.addfile_size != nil {
-		*m.addfile_size += i
-	} else {
-		m.addfile_size = &i
-	}
+// ResetCurrencyCode resets all changes to the "currency_code" field.
+func (m *StatementTransactionMutation) ResetCurrencyCode() {
+	m.currency_code = nil
 }
 
-// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
-func (m *ReceiptFileMutation) AddedFileSize() (r int, exists bool) {
-	v := m.addfile_size
+// SetExternalID sets the "external_id" field.
+func (m *StatementTransactionMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *StatementTransactionMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFileSize resets all changes to the "file_size" field.
-func (m *ReceiptFileMutation) ResetFileSize() {
-	m.file_size = nil
-	m.addfile_size = nil
+// OldExternalID returns the old "external_id" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatementTransactionMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
 }
 
-// SetUploadedAt sets the "uploaded_at" field.
-func (m *ReceiptFileMutation) SetUploadedAt(t time.Time) {
-	m.uploaded_at = &t
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *StatementTransactionMutation) ResetExternalID() {
+	m.external_id = nil
 }
 
-// UploadedAt returns the value of the "uploaded_at" field in the mutation.
-func (m *ReceiptFileMutation) UploadedAt() (r time.Time, exists bool) {
-	v := m.uploaded_at
+// SetCreatedAt sets the "created_at" field.
+func (m *StatementTransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StatementTransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploadedAt returns the old "uploaded_at" field's value of the ReceiptFile entity.
-// If the ReceiptFile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the StatementTransaction entity.
+// If the StatementTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReceiptFileMutation) OldUploadedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StatementTransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UploadedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUploadedAt resets all changes to the "uploaded_at" field.
-func (m *ReceiptFileMutation) ResetUploadedAt() {
-	m.uploaded_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StatementTransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // ClearProfile clears the "profile" edge to the Profile entity.
-func (m *ReceiptFileMutation) ClearProfile() {
+func (m *StatementTransactionMutation) ClearProfile() {
 	m.clearedprofile = true
-	m.clearedFields[receiptfile.FieldProfileID] = struct{}{}
+	m.clearedFields[statementtransaction.FieldProfileID] = struct{}{}
 }
 
 // ProfileCleared reports if the "profile" edge to the Profile entity was cleared.
-func (m *ReceiptFileMutation) ProfileCleared() bool {
+func (m *StatementTransactionMutation) ProfileCleared() bool {
 	return m.clearedprofile
 }
 
 // ProfileIDs returns the "profile" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProfileID instead. It exists only for internal usage by the builders.
-func (m *ReceiptFileMutation) ProfileIDs() (ids []uuid.UUID) {
+func (m *StatementTransactionMutation) ProfileIDs() (ids []uuid.UUID) {
 	if id := m.profile; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4223,74 +14393,20 @@ func (m *ReceiptFileMutation) ProfileIDs() (ids []uuid.UUID) {
 }
 
 // ResetProfile resets all changes to the "profile" edge.
-func (m *ReceiptFileMutation) ResetProfile() {
+func (m *StatementTransactionMutation) ResetProfile() {
 	m.profile = nil
 	m.clearedprofile = false
 }
 
-// AddJobIDs adds the "jobs" edge to the ExtractJob entity by ids.
-func (m *ReceiptFileMutation) AddJobIDs(ids ...uuid.UUID) {
-	if m.jobs == nil {
-		m.jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.jobs[ids[i]] = struct{}{}
-	}
-}
-
-// ClearJobs clears the "jobs" edge to the ExtractJob entity.
-func (m *ReceiptFileMutation) ClearJobs() {
-	m.clearedjobs = true
-}
-
-// JobsCleared reports if the "jobs" edge to the ExtractJob entity was cleared.
-func (m *ReceiptFileMutation) JobsCleared() bool {
-	return m.clearedjobs
-}
-
-// RemoveJobIDs removes the "jobs" edge to the ExtractJob entity by IDs.
-func (m *ReceiptFileMutation) RemoveJobIDs(ids ...uuid.UUID) {
-	if m.removedjobs == nil {
-		m.removedjobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.jobs, ids[i])
-		m.removedjobs[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedJobs returns the removed IDs of the "jobs" edge to the ExtractJob entity.
-func (m *ReceiptFileMutation) RemovedJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedjobs {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// JobsIDs returns the "jobs" edge IDs in the mutation.
-func (m *ReceiptFileMutation) JobsIDs() (ids []uuid.UUID) {
-	for id := range m.jobs {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetJobs resets all changes to the "jobs" edge.
-func (m *ReceiptFileMutation) ResetJobs() {
-	m.jobs = nil
-	m.clearedjobs = false
-	m.removedjobs = nil
-}
-
-// Where appends a list predicates to the ReceiptFileMutation builder.
-func (m *ReceiptFileMutation) Where(ps ...predicate.ReceiptFile) {
+// Where appends a list predicates to the StatementTransactionMutation builder.
+func (m *StatementTransactionMutation) Where(ps ...predicate.StatementTransaction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReceiptFileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StatementTransactionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReceiptFileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ReceiptFile, len(ps))
+func (m *StatementTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StatementTransaction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4298,45 +14414,48 @@ func (m *ReceiptFileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReceiptFileMutation) Op() Op {
+func (m *StatementTransactionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReceiptFileMutation) SetOp(op Op) {
+func (m *StatementTransactionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ReceiptFile).
-func (m *ReceiptFileMutation) Type() string {
+// Type returns the node type of this mutation (StatementTransaction).
+func (m *StatementTransactionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReceiptFileMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *StatementTransactionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.profile != nil {
-		fields = append(fields, receiptfile.FieldProfileID)
+		fields = append(fields, statementtransaction.FieldProfileID)
 	}
-	if m.source_path != nil {
-		fields = append(fields, receiptfile.FieldSourcePath)
+	if m.account != nil {
+		fields = append(fields, statementtransaction.FieldAccount)
 	}
-	if m.content_hash != nil {
-		fields = append(fields, receiptfile.FieldContentHash)
+	if m.posted_date != nil {
+		fields = append(fields, statementtransaction.FieldPostedDate)
 	}
-	if m.filename != nil {
-		fields = append(fields, receiptfile.FieldFilename)
+	if m.description != nil {
+		fields = append(fields, statementtransaction.FieldDescription)
 	}
-	if m.file_ext != nil {
-		fields = append(fields, receiptfile.FieldFileExt)
+	if m.amount != nil {
+		fields = append(fields, statementtransaction.FieldAmount)
 	}
-	if m.file_size != nil {
-		fields = append(fields, receiptfile.FieldFileSize)
+	if m.currency_code != nil {
+		fields = append(fields, statementtransaction.FieldCurrencyCode)
 	}
-	if m.uploaded_at != nil {
-		fields = append(fields, receiptfile.FieldUploadedAt)
+	if m.external_id != nil {
+		fields = append(fields, statementtransaction.FieldExternalID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, statementtransaction.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4344,22 +14463,24 @@ func (m *ReceiptFileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReceiptFileMutation) Field(name string) (ent.Value, bool) {
+func (m *StatementTransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case receiptfile.FieldProfileID:
+	case statementtransaction.FieldProfileID:
 		return m.ProfileID()
-	case receiptfile.FieldSourcePath:
-		return m.SourcePath()
-	case receiptfile.FieldContentHash:
-		return m.ContentHash()
-	case receiptfile.FieldFilename:
-		return m.Filename()
-	case receiptfile.FieldFileExt:
-		return m.FileExt()
-	case receiptfile.FieldFileSize:
-		return m.FileSize()
-	case receiptfile.FieldUploadedAt:
-		return m.UploadedAt()
+	case statementtransaction.FieldAccount:
+		return m.Account()
+	case statementtransaction.FieldPostedDate:
+		return m.PostedDate()
+	case statementtransaction.FieldDescription:
+		return m.Description()
+	case statementtransaction.FieldAmount:
+		return m.Amount()
+	case statementtransaction.FieldCurrencyCode:
+		return m.CurrencyCode()
+	case statementtransaction.FieldExternalID:
+		return m.ExternalID()
+	case statementtransaction.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -4367,267 +14488,236 @@ func (m *ReceiptFileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReceiptFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StatementTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case receiptfile.FieldProfileID:
+	case statementtransaction.FieldProfileID:
 		return m.OldProfileID(ctx)
-	case receiptfile.FieldSourcePath:
-		return m.OldSourcePath(ctx)
-	case receiptfile.FieldContentHash:
-		return m.OldContentHash(ctx)
-	case receiptfile.FieldFilename:
-		return m.OldFilename(ctx)
-	case receiptfile.FieldFileExt:
-		return m.OldFileExt(ctx)
-	case receiptfile.FieldFileSize:
-		return m.OldFileSize(ctx)
-	case receiptfile.FieldUploadedAt:
-		return m.OldUploadedAt(ctx)
+	case statementtransaction.FieldAccount:
+		return m.OldAccount(ctx)
+	case statementtransaction.FieldPostedDate:
+		return m.OldPostedDate(ctx)
+	case statementtransaction.FieldDescription:
+		return m.OldDescription(ctx)
+	case statementtransaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case statementtransaction.FieldCurrencyCode:
+		return m.OldCurrencyCode(ctx)
+	case statementtransaction.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case statementtransaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ReceiptFile field %s", name)
+	return nil, fmt.Errorf("unknown StatementTransaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReceiptFileMutation) SetField(name string, value ent.Value) error {
+func (m *StatementTransactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case receiptfile.FieldProfileID:
+	case statementtransaction.FieldProfileID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProfileID(v)
 		return nil
-	case receiptfile.FieldSourcePath:
+	case statementtransaction.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourcePath(v)
+		m.SetAccount(v)
 		return nil
-	case receiptfile.FieldContentHash:
-		v, ok := value.([]byte)
+	case statementtransaction.FieldPostedDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentHash(v)
+		m.SetPostedDate(v)
 		return nil
-	case receiptfile.FieldFilename:
+	case statementtransaction.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetDescription(v)
 		return nil
-	case receiptfile.FieldFileExt:
+	case statementtransaction.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case statementtransaction.FieldCurrencyCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileExt(v)
+		m.SetCurrencyCode(v)
 		return nil
-	case receiptfile.FieldFileSize:
-		v, ok := value.(int)
+	case statementtransaction.FieldExternalID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileSize(v)
+		m.SetExternalID(v)
 		return nil
-	case receiptfile.FieldUploadedAt:
+	case statementtransaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploadedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ReceiptFile field %s", name)
+	return fmt.Errorf("unknown StatementTransaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReceiptFileMutation) AddedFields() []string {
-	var fields []string
-	if m.addfile_size != nil {
-		fields = append(fields, receiptfile.FieldFileSize)
-	}
-	return fields
+func (m *StatementTransactionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReceiptFileMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case receiptfile.FieldFileSize:
-		return m.AddedFileSize()
-	}
+func (m *StatementTransactionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReceiptFileMutation) AddField(name string, value ent.Value) error {
+func (m *StatementTransactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case receiptfile.FieldFileSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFileSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ReceiptFile numeric field %s", name)
+	return fmt.Errorf("unknown StatementTransaction numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReceiptFileMutation) ClearedFields() []string {
+func (m *StatementTransactionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReceiptFileMutation) FieldCleared(name string) bool {
+func (m *StatementTransactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReceiptFileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ReceiptFile nullable field %s", name)
+func (m *StatementTransactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StatementTransaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReceiptFileMutation) ResetField(name string) error {
+func (m *StatementTransactionMutation) ResetField(name string) error {
 	switch name {
-	case receiptfile.FieldProfileID:
+	case statementtransaction.FieldProfileID:
 		m.ResetProfileID()
 		return nil
-	case receiptfile.FieldSourcePath:
-		m.ResetSourcePath()
+	case statementtransaction.FieldAccount:
+		m.ResetAccount()
 		return nil
-	case receiptfile.FieldContentHash:
-		m.ResetContentHash()
+	case statementtransaction.FieldPostedDate:
+		m.ResetPostedDate()
 		return nil
-	case receiptfile.FieldFilename:
-		m.ResetFilename()
+	case statementtransaction.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case receiptfile.FieldFileExt:
-		m.ResetFileExt()
+	case statementtransaction.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case receiptfile.FieldFileSize:
-		m.ResetFileSize()
+	case statementtransaction.FieldCurrencyCode:
+		m.ResetCurrencyCode()
 		return nil
-	case receiptfile.FieldUploadedAt:
-		m.ResetUploadedAt()
+	case statementtransaction.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case statementtransaction.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ReceiptFile field %s", name)
+	return fmt.Errorf("unknown StatementTransaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReceiptFileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *StatementTransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.profile != nil {
-		edges = append(edges, receiptfile.EdgeProfile)
-	}
-	if m.jobs != nil {
-		edges = append(edges, receiptfile.EdgeJobs)
+		edges = append(edges, statementtransaction.EdgeProfile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReceiptFileMutation) AddedIDs(name string) []ent.Value {
+func (m *StatementTransactionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case receiptfile.EdgeProfile:
+	case statementtransaction.EdgeProfile:
 		if id := m.profile; id != nil {
 			return []ent.Value{*id}
 		}
-	case receiptfile.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.jobs))
-		for id := range m.jobs {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReceiptFileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedjobs != nil {
-		edges = append(edges, receiptfile.EdgeJobs)
-	}
+func (m *StatementTransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReceiptFileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case receiptfile.EdgeJobs:
-		ids := make([]ent.Value, 0, len(m.removedjobs))
-		for id := range m.removedjobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StatementTransactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReceiptFileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *StatementTransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedprofile {
-		edges = append(edges, receiptfile.EdgeProfile)
-	}
-	if m.clearedjobs {
-		edges = append(edges, receiptfile.EdgeJobs)
+		edges = append(edges, statementtransaction.EdgeProfile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReceiptFileMutation) EdgeCleared(name string) bool {
+func (m *StatementTransactionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case receiptfile.EdgeProfile:
+	case statementtransaction.EdgeProfile:
 		return m.clearedprofile
-	case receiptfile.EdgeJobs:
-		return m.clearedjobs
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReceiptFileMutation) ClearEdge(name string) error {
+func (m *StatementTransactionMutation) ClearEdge(name string) error {
 	switch name {
-	case receiptfile.EdgeProfile:
+	case statementtransaction.EdgeProfile:
 		m.ClearProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown ReceiptFile unique edge %s", name)
+	return fmt.Errorf("unknown StatementTransaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReceiptFileMutation) ResetEdge(name string) error {
+func (m *StatementTransactionMutation) ResetEdge(name string) error {
 	switch name {
-	case receiptfile.EdgeProfile:
+	case statementtransaction.EdgeProfile:
 		m.ResetProfile()
 		return nil
-	case receiptfile.EdgeJobs:
-		m.ResetJobs()
-		return nil
 	}
-	return fmt.Errorf("unknown ReceiptFile edge %s", name)
+	return fmt.Errorf("unknown StatementTransaction edge %s", name)
 }
@@ -6,14 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Budget is the predicate function for budget builders.
+type Budget func(*sql.Selector)
+
+// BudgetAlert is the predicate function for budgetalert builders.
+type BudgetAlert func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// CategoryCorrection is the predicate function for categorycorrection builders.
+type CategoryCorrection func(*sql.Selector)
+
+// CategoryRule is the predicate function for categoryrule builders.
+type CategoryRule func(*sql.Selector)
+
+// ExportTemplate is the predicate function for exporttemplate builders.
+type ExportTemplate func(*sql.Selector)
+
 // ExtractJob is the predicate function for extractjob builders.
 type ExtractJob func(*sql.Selector)
 
+// FxRate is the predicate function for fxrate builders.
+type FxRate func(*sql.Selector)
+
+// Merchant is the predicate function for merchant builders.
+type Merchant func(*sql.Selector)
+
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
 
 // Receipt is the predicate function for receipt builders.
 type Receipt func(*sql.Selector)
 
+// ReceiptAnomaly is the predicate function for receiptanomaly builders.
+type ReceiptAnomaly func(*sql.Selector)
+
 // ReceiptFile is the predicate function for receiptfile builders.
 type ReceiptFile func(*sql.Selector)
+
+// StatementTransaction is the predicate function for statementtransaction builders.
+type StatementTransaction func(*sql.Selector)
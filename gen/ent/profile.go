@@ -44,9 +44,23 @@ type ProfileEdges struct {
 	Files []*ReceiptFile `json:"files,omitempty"`
 	// Jobs holds the value of the jobs edge.
 	Jobs []*ExtractJob `json:"jobs,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// Rules holds the value of the rules edge.
+	Rules []*CategoryRule `json:"rules,omitempty"`
+	// Corrections holds the value of the corrections edge.
+	Corrections []*CategoryCorrection `json:"corrections,omitempty"`
+	// Merchants holds the value of the merchants edge.
+	Merchants []*Merchant `json:"merchants,omitempty"`
+	// ExportTemplates holds the value of the export_templates edge.
+	ExportTemplates []*ExportTemplate `json:"export_templates,omitempty"`
+	// StatementTransactions holds the value of the statement_transactions edge.
+	StatementTransactions []*StatementTransaction `json:"statement_transactions,omitempty"`
+	// Budgets holds the value of the budgets edge.
+	Budgets []*Budget `json:"budgets,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // ReceiptsOrErr returns the Receipts value or an error if the edge
@@ -76,6 +90,69 @@ func (e ProfileEdges) JobsOrErr() ([]*ExtractJob, error) {
 	return nil, &NotLoadedError{edge: "jobs"}
 }
 
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[3] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// RulesOrErr returns the Rules value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) RulesOrErr() ([]*CategoryRule, error) {
+	if e.loadedTypes[4] {
+		return e.Rules, nil
+	}
+	return nil, &NotLoadedError{edge: "rules"}
+}
+
+// CorrectionsOrErr returns the Corrections value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) CorrectionsOrErr() ([]*CategoryCorrection, error) {
+	if e.loadedTypes[5] {
+		return e.Corrections, nil
+	}
+	return nil, &NotLoadedError{edge: "corrections"}
+}
+
+// MerchantsOrErr returns the Merchants value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) MerchantsOrErr() ([]*Merchant, error) {
+	if e.loadedTypes[6] {
+		return e.Merchants, nil
+	}
+	return nil, &NotLoadedError{edge: "merchants"}
+}
+
+// ExportTemplatesOrErr returns the ExportTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) ExportTemplatesOrErr() ([]*ExportTemplate, error) {
+	if e.loadedTypes[7] {
+		return e.ExportTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "export_templates"}
+}
+
+// StatementTransactionsOrErr returns the StatementTransactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) StatementTransactionsOrErr() ([]*StatementTransaction, error) {
+	if e.loadedTypes[8] {
+		return e.StatementTransactions, nil
+	}
+	return nil, &NotLoadedError{edge: "statement_transactions"}
+}
+
+// BudgetsOrErr returns the Budgets value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProfileEdges) BudgetsOrErr() ([]*Budget, error) {
+	if e.loadedTypes[9] {
+		return e.Budgets, nil
+	}
+	return nil, &NotLoadedError{edge: "budgets"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Profile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -174,6 +251,41 @@ func (_m *Profile) QueryJobs() *ExtractJobQuery {
 	return NewProfileClient(_m.config).QueryJobs(_m)
 }
 
+// QueryCategories queries the "categories" edge of the Profile entity.
+func (_m *Profile) QueryCategories() *CategoryQuery {
+	return NewProfileClient(_m.config).QueryCategories(_m)
+}
+
+// QueryRules queries the "rules" edge of the Profile entity.
+func (_m *Profile) QueryRules() *CategoryRuleQuery {
+	return NewProfileClient(_m.config).QueryRules(_m)
+}
+
+// QueryCorrections queries the "corrections" edge of the Profile entity.
+func (_m *Profile) QueryCorrections() *CategoryCorrectionQuery {
+	return NewProfileClient(_m.config).QueryCorrections(_m)
+}
+
+// QueryMerchants queries the "merchants" edge of the Profile entity.
+func (_m *Profile) QueryMerchants() *MerchantQuery {
+	return NewProfileClient(_m.config).QueryMerchants(_m)
+}
+
+// QueryExportTemplates queries the "export_templates" edge of the Profile entity.
+func (_m *Profile) QueryExportTemplates() *ExportTemplateQuery {
+	return NewProfileClient(_m.config).QueryExportTemplates(_m)
+}
+
+// QueryStatementTransactions queries the "statement_transactions" edge of the Profile entity.
+func (_m *Profile) QueryStatementTransactions() *StatementTransactionQuery {
+	return NewProfileClient(_m.config).QueryStatementTransactions(_m)
+}
+
+// QueryBudgets queries the "budgets" edge of the Profile entity.
+func (_m *Profile) QueryBudgets() *BudgetQuery {
+	return NewProfileClient(_m.config).QueryBudgets(_m)
+}
+
 // Update returns a builder for updating this Profile.
 // Note that you need to call Profile.Unwrap() before calling this method if this Profile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -33,6 +33,20 @@ const (
 	EdgeFiles = "files"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
 	EdgeJobs = "jobs"
+	// EdgeCategories holds the string denoting the categories edge name in mutations.
+	EdgeCategories = "categories"
+	// EdgeRules holds the string denoting the rules edge name in mutations.
+	EdgeRules = "rules"
+	// EdgeCorrections holds the string denoting the corrections edge name in mutations.
+	EdgeCorrections = "corrections"
+	// EdgeMerchants holds the string denoting the merchants edge name in mutations.
+	EdgeMerchants = "merchants"
+	// EdgeExportTemplates holds the string denoting the export_templates edge name in mutations.
+	EdgeExportTemplates = "export_templates"
+	// EdgeStatementTransactions holds the string denoting the statement_transactions edge name in mutations.
+	EdgeStatementTransactions = "statement_transactions"
+	// EdgeBudgets holds the string denoting the budgets edge name in mutations.
+	EdgeBudgets = "budgets"
 	// Table holds the table name of the profile in the database.
 	Table = "profiles"
 	// ReceiptsTable is the table that holds the receipts relation/edge.
@@ -56,6 +70,55 @@ const (
 	JobsInverseTable = "extract_job"
 	// JobsColumn is the table column denoting the jobs relation/edge.
 	JobsColumn = "profile_id"
+	// CategoriesTable is the table that holds the categories relation/edge.
+	CategoriesTable = "categories"
+	// CategoriesInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoriesInverseTable = "categories"
+	// CategoriesColumn is the table column denoting the categories relation/edge.
+	CategoriesColumn = "profile_id"
+	// RulesTable is the table that holds the rules relation/edge.
+	RulesTable = "category_rules"
+	// RulesInverseTable is the table name for the CategoryRule entity.
+	// It exists in this package in order to avoid circular dependency with the "categoryrule" package.
+	RulesInverseTable = "category_rules"
+	// RulesColumn is the table column denoting the rules relation/edge.
+	RulesColumn = "profile_id"
+	// CorrectionsTable is the table that holds the corrections relation/edge.
+	CorrectionsTable = "category_corrections"
+	// CorrectionsInverseTable is the table name for the CategoryCorrection entity.
+	// It exists in this package in order to avoid circular dependency with the "categorycorrection" package.
+	CorrectionsInverseTable = "category_corrections"
+	// CorrectionsColumn is the table column denoting the corrections relation/edge.
+	CorrectionsColumn = "profile_id"
+	// MerchantsTable is the table that holds the merchants relation/edge.
+	MerchantsTable = "merchants"
+	// MerchantsInverseTable is the table name for the Merchant entity.
+	// It exists in this package in order to avoid circular dependency with the "merchant" package.
+	MerchantsInverseTable = "merchants"
+	// MerchantsColumn is the table column denoting the merchants relation/edge.
+	MerchantsColumn = "profile_id"
+	// ExportTemplatesTable is the table that holds the export_templates relation/edge.
+	ExportTemplatesTable = "export_templates"
+	// ExportTemplatesInverseTable is the table name for the ExportTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "exporttemplate" package.
+	ExportTemplatesInverseTable = "export_templates"
+	// ExportTemplatesColumn is the table column denoting the export_templates relation/edge.
+	ExportTemplatesColumn = "profile_id"
+	// StatementTransactionsTable is the table that holds the statement_transactions relation/edge.
+	StatementTransactionsTable = "statement_transactions"
+	// StatementTransactionsInverseTable is the table name for the StatementTransaction entity.
+	// It exists in this package in order to avoid circular dependency with the "statementtransaction" package.
+	StatementTransactionsInverseTable = "statement_transactions"
+	// StatementTransactionsColumn is the table column denoting the statement_transactions relation/edge.
+	StatementTransactionsColumn = "profile_id"
+	// BudgetsTable is the table that holds the budgets relation/edge.
+	BudgetsTable = "budgets"
+	// BudgetsInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetsInverseTable = "budgets"
+	// BudgetsColumn is the table column denoting the budgets relation/edge.
+	BudgetsColumn = "profile_id"
 )
 
 // Columns holds all SQL columns for profile fields.
@@ -173,6 +236,104 @@ func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCategoriesCount orders the results by categories count.
+func ByCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCategoriesStep(), opts...)
+	}
+}
+
+// ByCategories orders the results by categories terms.
+func ByCategories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRulesCount orders the results by rules count.
+func ByRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRulesStep(), opts...)
+	}
+}
+
+// ByRules orders the results by rules terms.
+func ByRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCorrectionsCount orders the results by corrections count.
+func ByCorrectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCorrectionsStep(), opts...)
+	}
+}
+
+// ByCorrections orders the results by corrections terms.
+func ByCorrections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCorrectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMerchantsCount orders the results by merchants count.
+func ByMerchantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMerchantsStep(), opts...)
+	}
+}
+
+// ByMerchants orders the results by merchants terms.
+func ByMerchants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMerchantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExportTemplatesCount orders the results by export_templates count.
+func ByExportTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExportTemplatesStep(), opts...)
+	}
+}
+
+// ByExportTemplates orders the results by export_templates terms.
+func ByExportTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatementTransactionsCount orders the results by statement_transactions count.
+func ByStatementTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatementTransactionsStep(), opts...)
+	}
+}
+
+// ByStatementTransactions orders the results by statement_transactions terms.
+func ByStatementTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatementTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetsCount orders the results by budgets count.
+func ByBudgetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetsStep(), opts...)
+	}
+}
+
+// ByBudgets orders the results by budgets terms.
+func ByBudgets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newReceiptsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -194,3 +355,52 @@ func newJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
 	)
 }
+func newCategoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CategoriesTable, CategoriesColumn),
+	)
+}
+func newRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RulesTable, RulesColumn),
+	)
+}
+func newCorrectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CorrectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CorrectionsTable, CorrectionsColumn),
+	)
+}
+func newMerchantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MerchantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MerchantsTable, MerchantsColumn),
+	)
+}
+func newExportTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExportTemplatesTable, ExportTemplatesColumn),
+	)
+}
+func newStatementTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatementTransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatementTransactionsTable, StatementTransactionsColumn),
+	)
+}
+func newBudgetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+	)
+}
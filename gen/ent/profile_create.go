@@ -8,13 +8,22 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
 )
 
 // ProfileCreate is the builder for creating a Profile entity.
@@ -22,6 +31,7 @@ type ProfileCreate struct {
 	config
 	mutation *ProfileMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -151,6 +161,111 @@ func (_c *ProfileCreate) AddJobs(v ...*ExtractJob) *ProfileCreate {
 	return _c.AddJobIDs(ids...)
 }
 
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_c *ProfileCreate) AddCategoryIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddCategoryIDs(ids...)
+	return _c
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_c *ProfileCreate) AddCategories(v ...*Category) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCategoryIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the CategoryRule entity by IDs.
+func (_c *ProfileCreate) AddRuleIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddRuleIDs(ids...)
+	return _c
+}
+
+// AddRules adds the "rules" edges to the CategoryRule entity.
+func (_c *ProfileCreate) AddRules(v ...*CategoryRule) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRuleIDs(ids...)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the CategoryCorrection entity by IDs.
+func (_c *ProfileCreate) AddCorrectionIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddCorrectionIDs(ids...)
+	return _c
+}
+
+// AddCorrections adds the "corrections" edges to the CategoryCorrection entity.
+func (_c *ProfileCreate) AddCorrections(v ...*CategoryCorrection) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCorrectionIDs(ids...)
+}
+
+// AddMerchantIDs adds the "merchants" edge to the Merchant entity by IDs.
+func (_c *ProfileCreate) AddMerchantIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddMerchantIDs(ids...)
+	return _c
+}
+
+// AddMerchants adds the "merchants" edges to the Merchant entity.
+func (_c *ProfileCreate) AddMerchants(v ...*Merchant) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMerchantIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_c *ProfileCreate) AddExportTemplateIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddExportTemplateIDs(ids...)
+	return _c
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_c *ProfileCreate) AddExportTemplates(v ...*ExportTemplate) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExportTemplateIDs(ids...)
+}
+
+// AddStatementTransactionIDs adds the "statement_transactions" edge to the StatementTransaction entity by IDs.
+func (_c *ProfileCreate) AddStatementTransactionIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddStatementTransactionIDs(ids...)
+	return _c
+}
+
+// AddStatementTransactions adds the "statement_transactions" edges to the StatementTransaction entity.
+func (_c *ProfileCreate) AddStatementTransactions(v ...*StatementTransaction) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatementTransactionIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_c *ProfileCreate) AddBudgetIDs(ids ...uuid.UUID) *ProfileCreate {
+	_c.mutation.AddBudgetIDs(ids...)
+	return _c
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_c *ProfileCreate) AddBudgets(v ...*Budget) *ProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBudgetIDs(ids...)
+}
+
 // Mutation returns the ProfileMutation object of the builder.
 func (_c *ProfileCreate) Mutation() *ProfileMutation {
 	return _c.mutation
@@ -255,6 +370,7 @@ func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		_node = &Profile{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(profile.Table, sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -331,14 +447,444 @@ func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MerchantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatementTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProfileCreate) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertOne {
+	_c.conflict = opts
+	return &ProfileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProfileCreate) OnConflictColumns(columns ...string) *ProfileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProfileUpsertOne is the builder for "upsert"-ing
+	//  one Profile node.
+	ProfileUpsertOne struct {
+		create *ProfileCreate
+	}
+
+	// ProfileUpsert is the "OnConflict" setter.
+	ProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ProfileUpsert) SetName(v string) *ProfileUpsert {
+	u.Set(profile.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateName() *ProfileUpsert {
+	u.SetExcluded(profile.FieldName)
+	return u
+}
+
+// SetJobTitle sets the "job_title" field.
+func (u *ProfileUpsert) SetJobTitle(v string) *ProfileUpsert {
+	u.Set(profile.FieldJobTitle, v)
+	return u
+}
+
+// UpdateJobTitle sets the "job_title" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateJobTitle() *ProfileUpsert {
+	u.SetExcluded(profile.FieldJobTitle)
+	return u
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (u *ProfileUpsert) ClearJobTitle() *ProfileUpsert {
+	u.SetNull(profile.FieldJobTitle)
+	return u
+}
+
+// SetJobDescription sets the "job_description" field.
+func (u *ProfileUpsert) SetJobDescription(v string) *ProfileUpsert {
+	u.Set(profile.FieldJobDescription, v)
+	return u
+}
+
+// UpdateJobDescription sets the "job_description" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateJobDescription() *ProfileUpsert {
+	u.SetExcluded(profile.FieldJobDescription)
+	return u
+}
+
+// ClearJobDescription clears the value of the "job_description" field.
+func (u *ProfileUpsert) ClearJobDescription() *ProfileUpsert {
+	u.SetNull(profile.FieldJobDescription)
+	return u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *ProfileUpsert) SetDefaultCurrency(v string) *ProfileUpsert {
+	u.Set(profile.FieldDefaultCurrency, v)
+	return u
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateDefaultCurrency() *ProfileUpsert {
+	u.SetExcluded(profile.FieldDefaultCurrency)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProfileUpsert) SetCreatedAt(v time.Time) *ProfileUpsert {
+	u.Set(profile.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateCreatedAt() *ProfileUpsert {
+	u.SetExcluded(profile.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsert) SetUpdatedAt(v time.Time) *ProfileUpsert {
+	u.Set(profile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsert) UpdateUpdatedAt() *ProfileUpsert {
+	u.SetExcluded(profile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertOne) UpdateNewValues() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(profile.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProfileUpsertOne) Ignore() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertOne) DoNothing() *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreate.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertOne) Update(set func(*ProfileUpsert)) *ProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProfileUpsertOne) SetName(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateName() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetJobTitle sets the "job_title" field.
+func (u *ProfileUpsertOne) SetJobTitle(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetJobTitle(v)
+	})
+}
+
+// UpdateJobTitle sets the "job_title" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateJobTitle() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateJobTitle()
+	})
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (u *ProfileUpsertOne) ClearJobTitle() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearJobTitle()
+	})
+}
+
+// SetJobDescription sets the "job_description" field.
+func (u *ProfileUpsertOne) SetJobDescription(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetJobDescription(v)
+	})
+}
+
+// UpdateJobDescription sets the "job_description" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateJobDescription() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateJobDescription()
+	})
+}
+
+// ClearJobDescription clears the value of the "job_description" field.
+func (u *ProfileUpsertOne) ClearJobDescription() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearJobDescription()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *ProfileUpsertOne) SetDefaultCurrency(v string) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateDefaultCurrency() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProfileUpsertOne) SetCreatedAt(v time.Time) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateCreatedAt() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsertOne) SetUpdatedAt(v time.Time) *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsertOne) UpdateUpdatedAt() *ProfileUpsertOne {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProfileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProfileUpsertOne.ID is not supported by MySQL driver. Use ProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProfileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ProfileCreateBulk is the builder for creating many Profile entities in bulk.
 type ProfileCreateBulk struct {
 	config
 	err      error
 	builders []*ProfileCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Profile entities in the database.
@@ -368,6 +914,7 @@ func (_c *ProfileCreateBulk) Save(ctx context.Context) ([]*Profile, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -417,3 +964,215 @@ func (_c *ProfileCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Profile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProfileUpsertBulk {
+	_c.conflict = opts
+	return &ProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProfileCreateBulk) OnConflictColumns(columns ...string) *ProfileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of Profile nodes.
+type ProfileUpsertBulk struct {
+	create *ProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(profile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) UpdateNewValues() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(profile.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Profile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProfileUpsertBulk) Ignore() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProfileUpsertBulk) DoNothing() *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProfileUpsertBulk) Update(set func(*ProfileUpsert)) *ProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProfileUpsertBulk) SetName(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateName() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetJobTitle sets the "job_title" field.
+func (u *ProfileUpsertBulk) SetJobTitle(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetJobTitle(v)
+	})
+}
+
+// UpdateJobTitle sets the "job_title" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateJobTitle() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateJobTitle()
+	})
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (u *ProfileUpsertBulk) ClearJobTitle() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearJobTitle()
+	})
+}
+
+// SetJobDescription sets the "job_description" field.
+func (u *ProfileUpsertBulk) SetJobDescription(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetJobDescription(v)
+	})
+}
+
+// UpdateJobDescription sets the "job_description" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateJobDescription() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateJobDescription()
+	})
+}
+
+// ClearJobDescription clears the value of the "job_description" field.
+func (u *ProfileUpsertBulk) ClearJobDescription() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.ClearJobDescription()
+	})
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (u *ProfileUpsertBulk) SetDefaultCurrency(v string) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetDefaultCurrency(v)
+	})
+}
+
+// UpdateDefaultCurrency sets the "default_currency" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateDefaultCurrency() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateDefaultCurrency()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ProfileUpsertBulk) SetCreatedAt(v time.Time) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateCreatedAt() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProfileUpsertBulk) SetUpdatedAt(v time.Time) *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProfileUpsertBulk) UpdateUpdatedAt() *ProfileUpsertBulk {
+	return u.Update(func(s *ProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -13,23 +13,38 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
 )
 
 // ProfileQuery is the builder for querying Profile entities.
 type ProfileQuery struct {
 	config
-	ctx          *QueryContext
-	order        []profile.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Profile
-	withReceipts *ReceiptQuery
-	withFiles    *ReceiptFileQuery
-	withJobs     *ExtractJobQuery
+	ctx                       *QueryContext
+	order                     []profile.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Profile
+	withReceipts              *ReceiptQuery
+	withFiles                 *ReceiptFileQuery
+	withJobs                  *ExtractJobQuery
+	withCategories            *CategoryQuery
+	withRules                 *CategoryRuleQuery
+	withCorrections           *CategoryCorrectionQuery
+	withMerchants             *MerchantQuery
+	withExportTemplates       *ExportTemplateQuery
+	withStatementTransactions *StatementTransactionQuery
+	withBudgets               *BudgetQuery
+	modifiers                 []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +147,160 @@ func (_q *ProfileQuery) QueryJobs() *ExtractJobQuery {
 	return query
 }
 
+// QueryCategories chains the current query on the "categories" edge.
+func (_q *ProfileQuery) QueryCategories() *CategoryQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.CategoriesTable, profile.CategoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRules chains the current query on the "rules" edge.
+func (_q *ProfileQuery) QueryRules() *CategoryRuleQuery {
+	query := (&CategoryRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(categoryrule.Table, categoryrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.RulesTable, profile.RulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCorrections chains the current query on the "corrections" edge.
+func (_q *ProfileQuery) QueryCorrections() *CategoryCorrectionQuery {
+	query := (&CategoryCorrectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(categorycorrection.Table, categorycorrection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.CorrectionsTable, profile.CorrectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMerchants chains the current query on the "merchants" edge.
+func (_q *ProfileQuery) QueryMerchants() *MerchantQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.MerchantsTable, profile.MerchantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExportTemplates chains the current query on the "export_templates" edge.
+func (_q *ProfileQuery) QueryExportTemplates() *ExportTemplateQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(exporttemplate.Table, exporttemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.ExportTemplatesTable, profile.ExportTemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatementTransactions chains the current query on the "statement_transactions" edge.
+func (_q *ProfileQuery) QueryStatementTransactions() *StatementTransactionQuery {
+	query := (&StatementTransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(statementtransaction.Table, statementtransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.StatementTransactionsTable, profile.StatementTransactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgets chains the current query on the "budgets" edge.
+func (_q *ProfileQuery) QueryBudgets() *BudgetQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.BudgetsTable, profile.BudgetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Profile entity from the query.
 // Returns a *NotFoundError when no Profile was found.
 func (_q *ProfileQuery) First(ctx context.Context) (*Profile, error) {
@@ -319,17 +488,25 @@ func (_q *ProfileQuery) Clone() *ProfileQuery {
 		return nil
 	}
 	return &ProfileQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]profile.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Profile{}, _q.predicates...),
-		withReceipts: _q.withReceipts.Clone(),
-		withFiles:    _q.withFiles.Clone(),
-		withJobs:     _q.withJobs.Clone(),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]profile.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.Profile{}, _q.predicates...),
+		withReceipts:              _q.withReceipts.Clone(),
+		withFiles:                 _q.withFiles.Clone(),
+		withJobs:                  _q.withJobs.Clone(),
+		withCategories:            _q.withCategories.Clone(),
+		withRules:                 _q.withRules.Clone(),
+		withCorrections:           _q.withCorrections.Clone(),
+		withMerchants:             _q.withMerchants.Clone(),
+		withExportTemplates:       _q.withExportTemplates.Clone(),
+		withStatementTransactions: _q.withStatementTransactions.Clone(),
+		withBudgets:               _q.withBudgets.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -366,6 +543,83 @@ func (_q *ProfileQuery) WithJobs(opts ...func(*ExtractJobQuery)) *ProfileQuery {
 	return _q
 }
 
+// WithCategories tells the query-builder to eager-load the nodes that are connected to
+// the "categories" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithCategories(opts ...func(*CategoryQuery)) *ProfileQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCategories = query
+	return _q
+}
+
+// WithRules tells the query-builder to eager-load the nodes that are connected to
+// the "rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithRules(opts ...func(*CategoryRuleQuery)) *ProfileQuery {
+	query := (&CategoryRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRules = query
+	return _q
+}
+
+// WithCorrections tells the query-builder to eager-load the nodes that are connected to
+// the "corrections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithCorrections(opts ...func(*CategoryCorrectionQuery)) *ProfileQuery {
+	query := (&CategoryCorrectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCorrections = query
+	return _q
+}
+
+// WithMerchants tells the query-builder to eager-load the nodes that are connected to
+// the "merchants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithMerchants(opts ...func(*MerchantQuery)) *ProfileQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMerchants = query
+	return _q
+}
+
+// WithExportTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "export_templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithExportTemplates(opts ...func(*ExportTemplateQuery)) *ProfileQuery {
+	query := (&ExportTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExportTemplates = query
+	return _q
+}
+
+// WithStatementTransactions tells the query-builder to eager-load the nodes that are connected to
+// the "statement_transactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithStatementTransactions(opts ...func(*StatementTransactionQuery)) *ProfileQuery {
+	query := (&StatementTransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatementTransactions = query
+	return _q
+}
+
+// WithBudgets tells the query-builder to eager-load the nodes that are connected to
+// the "budgets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProfileQuery) WithBudgets(opts ...func(*BudgetQuery)) *ProfileQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBudgets = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +698,17 @@ func (_q *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 	var (
 		nodes       = []*Profile{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [10]bool{
 			_q.withReceipts != nil,
 			_q.withFiles != nil,
 			_q.withJobs != nil,
+			_q.withCategories != nil,
+			_q.withRules != nil,
+			_q.withCorrections != nil,
+			_q.withMerchants != nil,
+			_q.withExportTemplates != nil,
+			_q.withStatementTransactions != nil,
+			_q.withBudgets != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -459,6 +720,9 @@ func (_q *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -489,6 +753,57 @@ func (_q *ProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prof
 			return nil, err
 		}
 	}
+	if query := _q.withCategories; query != nil {
+		if err := _q.loadCategories(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Categories = []*Category{} },
+			func(n *Profile, e *Category) { n.Edges.Categories = append(n.Edges.Categories, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRules; query != nil {
+		if err := _q.loadRules(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Rules = []*CategoryRule{} },
+			func(n *Profile, e *CategoryRule) { n.Edges.Rules = append(n.Edges.Rules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCorrections; query != nil {
+		if err := _q.loadCorrections(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Corrections = []*CategoryCorrection{} },
+			func(n *Profile, e *CategoryCorrection) { n.Edges.Corrections = append(n.Edges.Corrections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMerchants; query != nil {
+		if err := _q.loadMerchants(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Merchants = []*Merchant{} },
+			func(n *Profile, e *Merchant) { n.Edges.Merchants = append(n.Edges.Merchants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExportTemplates; query != nil {
+		if err := _q.loadExportTemplates(ctx, query, nodes,
+			func(n *Profile) { n.Edges.ExportTemplates = []*ExportTemplate{} },
+			func(n *Profile, e *ExportTemplate) { n.Edges.ExportTemplates = append(n.Edges.ExportTemplates, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatementTransactions; query != nil {
+		if err := _q.loadStatementTransactions(ctx, query, nodes,
+			func(n *Profile) { n.Edges.StatementTransactions = []*StatementTransaction{} },
+			func(n *Profile, e *StatementTransaction) {
+				n.Edges.StatementTransactions = append(n.Edges.StatementTransactions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBudgets; query != nil {
+		if err := _q.loadBudgets(ctx, query, nodes,
+			func(n *Profile) { n.Edges.Budgets = []*Budget{} },
+			func(n *Profile, e *Budget) { n.Edges.Budgets = append(n.Edges.Budgets, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -583,9 +898,222 @@ func (_q *ProfileQuery) loadJobs(ctx context.Context, query *ExtractJobQuery, no
 	}
 	return nil
 }
+func (_q *ProfileQuery) loadCategories(ctx context.Context, query *CategoryQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Category)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(category.FieldProfileID)
+	}
+	query.Where(predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.CategoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadRules(ctx context.Context, query *CategoryRuleQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *CategoryRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categoryrule.FieldProfileID)
+	}
+	query.Where(predicate.CategoryRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.RulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadCorrections(ctx context.Context, query *CategoryCorrectionQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *CategoryCorrection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(categorycorrection.FieldProfileID)
+	}
+	query.Where(predicate.CategoryCorrection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.CorrectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadMerchants(ctx context.Context, query *MerchantQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Merchant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(merchant.FieldProfileID)
+	}
+	query.Where(predicate.Merchant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.MerchantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadExportTemplates(ctx context.Context, query *ExportTemplateQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *ExportTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(exporttemplate.FieldProfileID)
+	}
+	query.Where(predicate.ExportTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.ExportTemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadStatementTransactions(ctx context.Context, query *StatementTransactionQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *StatementTransaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(statementtransaction.FieldProfileID)
+	}
+	query.Where(predicate.StatementTransaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.StatementTransactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProfileQuery) loadBudgets(ctx context.Context, query *BudgetQuery, nodes []*Profile, init func(*Profile), assign func(*Profile, *Budget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Profile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budget.FieldProfileID)
+	}
+	query.Where(predicate.Budget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(profile.BudgetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProfileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "profile_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -648,6 +1176,9 @@ func (_q *ProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -665,6 +1196,12 @@ func (_q *ProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ProfileQuery) Modify(modifiers ...func(s *sql.Selector)) *ProfileSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ProfileGroupBy is the group-by builder for Profile entities.
 type ProfileGroupBy struct {
 	selector
@@ -754,3 +1291,9 @@ func (_s *ProfileSelect) sqlScan(ctx context.Context, root *ProfileQuery, v any)
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ProfileSelect) Modify(modifiers ...func(s *sql.Selector)) *ProfileSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
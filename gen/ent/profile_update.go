@@ -12,18 +12,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
 )
 
 // ProfileUpdate is the builder for updating Profile entities.
 type ProfileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ProfileMutation
+	hooks     []Hook
+	mutation  *ProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ProfileUpdate builder.
@@ -165,6 +173,111 @@ func (_u *ProfileUpdate) AddJobs(v ...*ExtractJob) *ProfileUpdate {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *ProfileUpdate) AddCategoryIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddCategoryIDs(ids...)
+	return _u
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *ProfileUpdate) AddCategories(v ...*Category) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the CategoryRule entity by IDs.
+func (_u *ProfileUpdate) AddRuleIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the CategoryRule entity.
+func (_u *ProfileUpdate) AddRules(v ...*CategoryRule) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the CategoryCorrection entity by IDs.
+func (_u *ProfileUpdate) AddCorrectionIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddCorrectionIDs(ids...)
+	return _u
+}
+
+// AddCorrections adds the "corrections" edges to the CategoryCorrection entity.
+func (_u *ProfileUpdate) AddCorrections(v ...*CategoryCorrection) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCorrectionIDs(ids...)
+}
+
+// AddMerchantIDs adds the "merchants" edge to the Merchant entity by IDs.
+func (_u *ProfileUpdate) AddMerchantIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddMerchantIDs(ids...)
+	return _u
+}
+
+// AddMerchants adds the "merchants" edges to the Merchant entity.
+func (_u *ProfileUpdate) AddMerchants(v ...*Merchant) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMerchantIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *ProfileUpdate) AddExportTemplateIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *ProfileUpdate) AddExportTemplates(v ...*ExportTemplate) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddStatementTransactionIDs adds the "statement_transactions" edge to the StatementTransaction entity by IDs.
+func (_u *ProfileUpdate) AddStatementTransactionIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddStatementTransactionIDs(ids...)
+	return _u
+}
+
+// AddStatementTransactions adds the "statement_transactions" edges to the StatementTransaction entity.
+func (_u *ProfileUpdate) AddStatementTransactions(v ...*StatementTransaction) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatementTransactionIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *ProfileUpdate) AddBudgetIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *ProfileUpdate) AddBudgets(v ...*Budget) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
 // Mutation returns the ProfileMutation object of the builder.
 func (_u *ProfileUpdate) Mutation() *ProfileMutation {
 	return _u.mutation
@@ -233,6 +346,153 @@ func (_u *ProfileUpdate) RemoveJobs(v ...*ExtractJob) *ProfileUpdate {
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *ProfileUpdate) ClearCategories() *ProfileUpdate {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *ProfileUpdate) RemoveCategoryIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *ProfileUpdate) RemoveCategories(v ...*Category) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearRules clears all "rules" edges to the CategoryRule entity.
+func (_u *ProfileUpdate) ClearRules() *ProfileUpdate {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to CategoryRule entities by IDs.
+func (_u *ProfileUpdate) RemoveRuleIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to CategoryRule entities.
+func (_u *ProfileUpdate) RemoveRules(v ...*CategoryRule) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearCorrections clears all "corrections" edges to the CategoryCorrection entity.
+func (_u *ProfileUpdate) ClearCorrections() *ProfileUpdate {
+	_u.mutation.ClearCorrections()
+	return _u
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to CategoryCorrection entities by IDs.
+func (_u *ProfileUpdate) RemoveCorrectionIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveCorrectionIDs(ids...)
+	return _u
+}
+
+// RemoveCorrections removes "corrections" edges to CategoryCorrection entities.
+func (_u *ProfileUpdate) RemoveCorrections(v ...*CategoryCorrection) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCorrectionIDs(ids...)
+}
+
+// ClearMerchants clears all "merchants" edges to the Merchant entity.
+func (_u *ProfileUpdate) ClearMerchants() *ProfileUpdate {
+	_u.mutation.ClearMerchants()
+	return _u
+}
+
+// RemoveMerchantIDs removes the "merchants" edge to Merchant entities by IDs.
+func (_u *ProfileUpdate) RemoveMerchantIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveMerchantIDs(ids...)
+	return _u
+}
+
+// RemoveMerchants removes "merchants" edges to Merchant entities.
+func (_u *ProfileUpdate) RemoveMerchants(v ...*Merchant) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMerchantIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *ProfileUpdate) ClearExportTemplates() *ProfileUpdate {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *ProfileUpdate) RemoveExportTemplateIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *ProfileUpdate) RemoveExportTemplates(v ...*ExportTemplate) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportTemplateIDs(ids...)
+}
+
+// ClearStatementTransactions clears all "statement_transactions" edges to the StatementTransaction entity.
+func (_u *ProfileUpdate) ClearStatementTransactions() *ProfileUpdate {
+	_u.mutation.ClearStatementTransactions()
+	return _u
+}
+
+// RemoveStatementTransactionIDs removes the "statement_transactions" edge to StatementTransaction entities by IDs.
+func (_u *ProfileUpdate) RemoveStatementTransactionIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveStatementTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveStatementTransactions removes "statement_transactions" edges to StatementTransaction entities.
+func (_u *ProfileUpdate) RemoveStatementTransactions(v ...*StatementTransaction) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatementTransactionIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *ProfileUpdate) ClearBudgets() *ProfileUpdate {
+	_u.mutation.ClearBudgets()
+	return _u
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *ProfileUpdate) RemoveBudgetIDs(ids ...uuid.UUID) *ProfileUpdate {
+	_u.mutation.RemoveBudgetIDs(ids...)
+	return _u
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *ProfileUpdate) RemoveBudgets(v ...*Budget) *ProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ProfileUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -284,6 +544,12 @@ func (_u *ProfileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProfileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -455,86 +721,403 @@ func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{profile.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// ProfileUpdateOne is the builder for updating a single Profile entity.
-type ProfileUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProfileMutation
-}
-
-// SetName sets the "name" field.
-func (_u *ProfileUpdateOne) SetName(v string) *ProfileUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ProfileUpdateOne) SetNillableName(v *string) *ProfileUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetJobTitle sets the "job_title" field.
-func (_u *ProfileUpdateOne) SetJobTitle(v string) *ProfileUpdateOne {
-	_u.mutation.SetJobTitle(v)
-	return _u
-}
-
-// SetNillableJobTitle sets the "job_title" field if the given value is not nil.
-func (_u *ProfileUpdateOne) SetNillableJobTitle(v *string) *ProfileUpdateOne {
-	if v != nil {
-		_u.SetJobTitle(*v)
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearJobTitle clears the value of the "job_title" field.
-func (_u *ProfileUpdateOne) ClearJobTitle() *ProfileUpdateOne {
-	_u.mutation.ClearJobTitle()
-	return _u
-}
-
-// SetJobDescription sets the "job_description" field.
-func (_u *ProfileUpdateOne) SetJobDescription(v string) *ProfileUpdateOne {
-	_u.mutation.SetJobDescription(v)
-	return _u
-}
-
-// SetNillableJobDescription sets the "job_description" field if the given value is not nil.
-func (_u *ProfileUpdateOne) SetNillableJobDescription(v *string) *ProfileUpdateOne {
-	if v != nil {
-		_u.SetJobDescription(*v)
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !_u.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MerchantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMerchantsIDs(); len(nodes) > 0 && !_u.mutation.MerchantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatementTransactionsIDs(); len(nodes) > 0 && !_u.mutation.StatementTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProfileUpdateOne is the builder for updating a single Profile entity.
+type ProfileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *ProfileUpdateOne) SetName(v string) *ProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableName(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetJobTitle sets the "job_title" field.
+func (_u *ProfileUpdateOne) SetJobTitle(v string) *ProfileUpdateOne {
+	_u.mutation.SetJobTitle(v)
+	return _u
+}
+
+// SetNillableJobTitle sets the "job_title" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableJobTitle(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetJobTitle(*v)
+	}
+	return _u
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (_u *ProfileUpdateOne) ClearJobTitle() *ProfileUpdateOne {
+	_u.mutation.ClearJobTitle()
+	return _u
+}
+
+// SetJobDescription sets the "job_description" field.
+func (_u *ProfileUpdateOne) SetJobDescription(v string) *ProfileUpdateOne {
+	_u.mutation.SetJobDescription(v)
+	return _u
+}
+
+// SetNillableJobDescription sets the "job_description" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableJobDescription(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetJobDescription(*v)
 	}
 	return _u
 }
 
-// ClearJobDescription clears the value of the "job_description" field.
-func (_u *ProfileUpdateOne) ClearJobDescription() *ProfileUpdateOne {
-	_u.mutation.ClearJobDescription()
-	return _u
-}
-
-// SetDefaultCurrency sets the "default_currency" field.
-func (_u *ProfileUpdateOne) SetDefaultCurrency(v string) *ProfileUpdateOne {
-	_u.mutation.SetDefaultCurrency(v)
-	return _u
-}
-
+// ClearJobDescription clears the value of the "job_description" field.
+func (_u *ProfileUpdateOne) ClearJobDescription() *ProfileUpdateOne {
+	_u.mutation.ClearJobDescription()
+	return _u
+}
+
+// SetDefaultCurrency sets the "default_currency" field.
+func (_u *ProfileUpdateOne) SetDefaultCurrency(v string) *ProfileUpdateOne {
+	_u.mutation.SetDefaultCurrency(v)
+	return _u
+}
+
 // SetNillableDefaultCurrency sets the "default_currency" field if the given value is not nil.
 func (_u *ProfileUpdateOne) SetNillableDefaultCurrency(v *string) *ProfileUpdateOne {
 	if v != nil {
@@ -608,6 +1191,111 @@ func (_u *ProfileUpdateOne) AddJobs(v ...*ExtractJob) *ProfileUpdateOne {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (_u *ProfileUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddCategoryIDs(ids...)
+	return _u
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (_u *ProfileUpdateOne) AddCategories(v ...*Category) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCategoryIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the CategoryRule entity by IDs.
+func (_u *ProfileUpdateOne) AddRuleIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the CategoryRule entity.
+func (_u *ProfileUpdateOne) AddRules(v ...*CategoryRule) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddCorrectionIDs adds the "corrections" edge to the CategoryCorrection entity by IDs.
+func (_u *ProfileUpdateOne) AddCorrectionIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddCorrectionIDs(ids...)
+	return _u
+}
+
+// AddCorrections adds the "corrections" edges to the CategoryCorrection entity.
+func (_u *ProfileUpdateOne) AddCorrections(v ...*CategoryCorrection) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCorrectionIDs(ids...)
+}
+
+// AddMerchantIDs adds the "merchants" edge to the Merchant entity by IDs.
+func (_u *ProfileUpdateOne) AddMerchantIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddMerchantIDs(ids...)
+	return _u
+}
+
+// AddMerchants adds the "merchants" edges to the Merchant entity.
+func (_u *ProfileUpdateOne) AddMerchants(v ...*Merchant) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMerchantIDs(ids...)
+}
+
+// AddExportTemplateIDs adds the "export_templates" edge to the ExportTemplate entity by IDs.
+func (_u *ProfileUpdateOne) AddExportTemplateIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddExportTemplateIDs(ids...)
+	return _u
+}
+
+// AddExportTemplates adds the "export_templates" edges to the ExportTemplate entity.
+func (_u *ProfileUpdateOne) AddExportTemplates(v ...*ExportTemplate) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportTemplateIDs(ids...)
+}
+
+// AddStatementTransactionIDs adds the "statement_transactions" edge to the StatementTransaction entity by IDs.
+func (_u *ProfileUpdateOne) AddStatementTransactionIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddStatementTransactionIDs(ids...)
+	return _u
+}
+
+// AddStatementTransactions adds the "statement_transactions" edges to the StatementTransaction entity.
+func (_u *ProfileUpdateOne) AddStatementTransactions(v ...*StatementTransaction) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatementTransactionIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *ProfileUpdateOne) AddBudgetIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *ProfileUpdateOne) AddBudgets(v ...*Budget) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
 // Mutation returns the ProfileMutation object of the builder.
 func (_u *ProfileUpdateOne) Mutation() *ProfileMutation {
 	return _u.mutation
@@ -625,55 +1313,202 @@ func (_u *ProfileUpdateOne) RemoveReceiptIDs(ids ...uuid.UUID) *ProfileUpdateOne
 	return _u
 }
 
-// RemoveReceipts removes "receipts" edges to Receipt entities.
-func (_u *ProfileUpdateOne) RemoveReceipts(v ...*Receipt) *ProfileUpdateOne {
+// RemoveReceipts removes "receipts" edges to Receipt entities.
+func (_u *ProfileUpdateOne) RemoveReceipts(v ...*Receipt) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
+// ClearFiles clears all "files" edges to the ReceiptFile entity.
+func (_u *ProfileUpdateOne) ClearFiles() *ProfileUpdateOne {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to ReceiptFile entities by IDs.
+func (_u *ProfileUpdateOne) RemoveFileIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to ReceiptFile entities.
+func (_u *ProfileUpdateOne) RemoveFiles(v ...*ReceiptFile) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the ExtractJob entity.
+func (_u *ProfileUpdateOne) ClearJobs() *ProfileUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to ExtractJob entities by IDs.
+func (_u *ProfileUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to ExtractJob entities.
+func (_u *ProfileUpdateOne) RemoveJobs(v ...*ExtractJob) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (_u *ProfileUpdateOne) ClearCategories() *ProfileUpdateOne {
+	_u.mutation.ClearCategories()
+	return _u
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (_u *ProfileUpdateOne) RemoveCategoryIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveCategoryIDs(ids...)
+	return _u
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (_u *ProfileUpdateOne) RemoveCategories(v ...*Category) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCategoryIDs(ids...)
+}
+
+// ClearRules clears all "rules" edges to the CategoryRule entity.
+func (_u *ProfileUpdateOne) ClearRules() *ProfileUpdateOne {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to CategoryRule entities by IDs.
+func (_u *ProfileUpdateOne) RemoveRuleIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to CategoryRule entities.
+func (_u *ProfileUpdateOne) RemoveRules(v ...*CategoryRule) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearCorrections clears all "corrections" edges to the CategoryCorrection entity.
+func (_u *ProfileUpdateOne) ClearCorrections() *ProfileUpdateOne {
+	_u.mutation.ClearCorrections()
+	return _u
+}
+
+// RemoveCorrectionIDs removes the "corrections" edge to CategoryCorrection entities by IDs.
+func (_u *ProfileUpdateOne) RemoveCorrectionIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveCorrectionIDs(ids...)
+	return _u
+}
+
+// RemoveCorrections removes "corrections" edges to CategoryCorrection entities.
+func (_u *ProfileUpdateOne) RemoveCorrections(v ...*CategoryCorrection) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCorrectionIDs(ids...)
+}
+
+// ClearMerchants clears all "merchants" edges to the Merchant entity.
+func (_u *ProfileUpdateOne) ClearMerchants() *ProfileUpdateOne {
+	_u.mutation.ClearMerchants()
+	return _u
+}
+
+// RemoveMerchantIDs removes the "merchants" edge to Merchant entities by IDs.
+func (_u *ProfileUpdateOne) RemoveMerchantIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveMerchantIDs(ids...)
+	return _u
+}
+
+// RemoveMerchants removes "merchants" edges to Merchant entities.
+func (_u *ProfileUpdateOne) RemoveMerchants(v ...*Merchant) *ProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMerchantIDs(ids...)
+}
+
+// ClearExportTemplates clears all "export_templates" edges to the ExportTemplate entity.
+func (_u *ProfileUpdateOne) ClearExportTemplates() *ProfileUpdateOne {
+	_u.mutation.ClearExportTemplates()
+	return _u
+}
+
+// RemoveExportTemplateIDs removes the "export_templates" edge to ExportTemplate entities by IDs.
+func (_u *ProfileUpdateOne) RemoveExportTemplateIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveExportTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveExportTemplates removes "export_templates" edges to ExportTemplate entities.
+func (_u *ProfileUpdateOne) RemoveExportTemplates(v ...*ExportTemplate) *ProfileUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReceiptIDs(ids...)
+	return _u.RemoveExportTemplateIDs(ids...)
 }
 
-// ClearFiles clears all "files" edges to the ReceiptFile entity.
-func (_u *ProfileUpdateOne) ClearFiles() *ProfileUpdateOne {
-	_u.mutation.ClearFiles()
+// ClearStatementTransactions clears all "statement_transactions" edges to the StatementTransaction entity.
+func (_u *ProfileUpdateOne) ClearStatementTransactions() *ProfileUpdateOne {
+	_u.mutation.ClearStatementTransactions()
 	return _u
 }
 
-// RemoveFileIDs removes the "files" edge to ReceiptFile entities by IDs.
-func (_u *ProfileUpdateOne) RemoveFileIDs(ids ...uuid.UUID) *ProfileUpdateOne {
-	_u.mutation.RemoveFileIDs(ids...)
+// RemoveStatementTransactionIDs removes the "statement_transactions" edge to StatementTransaction entities by IDs.
+func (_u *ProfileUpdateOne) RemoveStatementTransactionIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveStatementTransactionIDs(ids...)
 	return _u
 }
 
-// RemoveFiles removes "files" edges to ReceiptFile entities.
-func (_u *ProfileUpdateOne) RemoveFiles(v ...*ReceiptFile) *ProfileUpdateOne {
+// RemoveStatementTransactions removes "statement_transactions" edges to StatementTransaction entities.
+func (_u *ProfileUpdateOne) RemoveStatementTransactions(v ...*StatementTransaction) *ProfileUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveFileIDs(ids...)
+	return _u.RemoveStatementTransactionIDs(ids...)
 }
 
-// ClearJobs clears all "jobs" edges to the ExtractJob entity.
-func (_u *ProfileUpdateOne) ClearJobs() *ProfileUpdateOne {
-	_u.mutation.ClearJobs()
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *ProfileUpdateOne) ClearBudgets() *ProfileUpdateOne {
+	_u.mutation.ClearBudgets()
 	return _u
 }
 
-// RemoveJobIDs removes the "jobs" edge to ExtractJob entities by IDs.
-func (_u *ProfileUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *ProfileUpdateOne {
-	_u.mutation.RemoveJobIDs(ids...)
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *ProfileUpdateOne) RemoveBudgetIDs(ids ...uuid.UUID) *ProfileUpdateOne {
+	_u.mutation.RemoveBudgetIDs(ids...)
 	return _u
 }
 
-// RemoveJobs removes "jobs" edges to ExtractJob entities.
-func (_u *ProfileUpdateOne) RemoveJobs(v ...*ExtractJob) *ProfileUpdateOne {
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *ProfileUpdateOne) RemoveBudgets(v ...*Budget) *ProfileUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveJobIDs(ids...)
+	return _u.RemoveBudgetIDs(ids...)
 }
 
 // Where appends a list predicates to the ProfileUpdate builder.
@@ -740,6 +1575,12 @@ func (_u *ProfileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProfileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -928,6 +1769,322 @@ func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !_u.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CategoriesTable,
+			Columns: []string{profile.CategoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.RulesTable,
+			Columns: []string{profile.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categoryrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCorrectionsIDs(); len(nodes) > 0 && !_u.mutation.CorrectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CorrectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.CorrectionsTable,
+			Columns: []string{profile.CorrectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(categorycorrection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MerchantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMerchantsIDs(); len(nodes) > 0 && !_u.mutation.MerchantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.MerchantsTable,
+			Columns: []string{profile.MerchantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportTemplatesIDs(); len(nodes) > 0 && !_u.mutation.ExportTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.ExportTemplatesTable,
+			Columns: []string{profile.ExportTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exporttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatementTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatementTransactionsIDs(); len(nodes) > 0 && !_u.mutation.StatementTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatementTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.StatementTransactionsTable,
+			Columns: []string{profile.StatementTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   profile.BudgetsTable,
+			Columns: []string{profile.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Profile{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
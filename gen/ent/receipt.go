@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,8 +11,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 // Receipt is the model entity for the Receipt schema.
@@ -25,16 +28,36 @@ type Receipt struct {
 	FileID *uuid.UUID `json:"file_id,omitempty"`
 	// MerchantName holds the value of the "merchant_name" field.
 	MerchantName string `json:"merchant_name,omitempty"`
+	// MerchantID holds the value of the "merchant_id" field.
+	MerchantID *uuid.UUID `json:"merchant_id,omitempty"`
 	// TxDate holds the value of the "tx_date" field.
 	TxDate time.Time `json:"tx_date,omitempty"`
 	// Subtotal holds the value of the "subtotal" field.
-	Subtotal *float64 `json:"subtotal,omitempty"`
+	Subtotal *money.Amount `json:"subtotal,omitempty"`
 	// Tax holds the value of the "tax" field.
-	Tax *float64 `json:"tax,omitempty"`
+	Tax *money.Amount `json:"tax,omitempty"`
+	// Discount holds the value of the "discount" field.
+	Discount *money.Amount `json:"discount,omitempty"`
+	// OtherFees holds the value of the "other_fees" field.
+	OtherFees *money.Amount `json:"other_fees,omitempty"`
+	// Tip holds the value of the "tip" field.
+	Tip *money.Amount `json:"tip,omitempty"`
+	// Fees holds the value of the "fees" field.
+	Fees json.RawMessage `json:"fees,omitempty"`
 	// Total holds the value of the "total" field.
-	Total float64 `json:"total,omitempty"`
+	Total money.Amount `json:"total,omitempty"`
 	// CurrencyCode holds the value of the "currency_code" field.
 	CurrencyCode string `json:"currency_code,omitempty"`
+	// ConvertedTotal holds the value of the "converted_total" field.
+	ConvertedTotal *money.Amount `json:"converted_total,omitempty"`
+	// ConvertedCurrency holds the value of the "converted_currency" field.
+	ConvertedCurrency *string `json:"converted_currency,omitempty"`
+	// FxRate holds the value of the "fx_rate" field.
+	FxRate *float64 `json:"fx_rate,omitempty"`
+	// FxRateDate holds the value of the "fx_rate_date" field.
+	FxRateDate *time.Time `json:"fx_rate_date,omitempty"`
+	// FxSource holds the value of the "fx_source" field.
+	FxSource *string `json:"fx_source,omitempty"`
 	// CategoryName holds the value of the "category_name" field.
 	CategoryName string `json:"category_name,omitempty"`
 	// Description holds the value of the "description" field.
@@ -57,13 +80,17 @@ type Receipt struct {
 type ReceiptEdges struct {
 	// Profile holds the value of the profile edge.
 	Profile *Profile `json:"profile,omitempty"`
+	// Merchant holds the value of the merchant edge.
+	Merchant *Merchant `json:"merchant,omitempty"`
 	// Files holds the value of the files edge.
 	Files []*ReceiptFile `json:"files,omitempty"`
 	// Jobs holds the value of the jobs edge.
 	Jobs []*ExtractJob `json:"jobs,omitempty"`
+	// Anomalies holds the value of the anomalies edge.
+	Anomalies []*ReceiptAnomaly `json:"anomalies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // ProfileOrErr returns the Profile value or an error if the edge
@@ -77,10 +104,21 @@ func (e ReceiptEdges) ProfileOrErr() (*Profile, error) {
 	return nil, &NotLoadedError{edge: "profile"}
 }
 
+// MerchantOrErr returns the Merchant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiptEdges) MerchantOrErr() (*Merchant, error) {
+	if e.Merchant != nil {
+		return e.Merchant, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: merchant.Label}
+	}
+	return nil, &NotLoadedError{edge: "merchant"}
+}
+
 // FilesOrErr returns the Files value or an error if the edge
 // was not loaded in eager-loading.
 func (e ReceiptEdges) FilesOrErr() ([]*ReceiptFile, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Files, nil
 	}
 	return nil, &NotLoadedError{edge: "files"}
@@ -89,26 +127,41 @@ func (e ReceiptEdges) FilesOrErr() ([]*ReceiptFile, error) {
 // JobsOrErr returns the Jobs value or an error if the edge
 // was not loaded in eager-loading.
 func (e ReceiptEdges) JobsOrErr() ([]*ExtractJob, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Jobs, nil
 	}
 	return nil, &NotLoadedError{edge: "jobs"}
 }
 
+// AnomaliesOrErr returns the Anomalies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReceiptEdges) AnomaliesOrErr() ([]*ReceiptAnomaly, error) {
+	if e.loadedTypes[4] {
+		return e.Anomalies, nil
+	}
+	return nil, &NotLoadedError{edge: "anomalies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Receipt) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case receipt.FieldFileID:
+		case receipt.FieldSubtotal, receipt.FieldTax, receipt.FieldDiscount, receipt.FieldOtherFees, receipt.FieldTip, receipt.FieldConvertedTotal:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case receipt.FieldFileID, receipt.FieldMerchantID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case receipt.FieldFees:
+			values[i] = new([]byte)
+		case receipt.FieldTotal:
+			values[i] = new(money.Amount)
 		case receipt.FieldIsCurrent:
 			values[i] = new(sql.NullBool)
-		case receipt.FieldSubtotal, receipt.FieldTax, receipt.FieldTotal:
+		case receipt.FieldFxRate:
 			values[i] = new(sql.NullFloat64)
-		case receipt.FieldMerchantName, receipt.FieldCurrencyCode, receipt.FieldCategoryName, receipt.FieldDescription, receipt.FieldFilePath:
+		case receipt.FieldMerchantName, receipt.FieldCurrencyCode, receipt.FieldConvertedCurrency, receipt.FieldFxSource, receipt.FieldCategoryName, receipt.FieldDescription, receipt.FieldFilePath:
 			values[i] = new(sql.NullString)
-		case receipt.FieldTxDate, receipt.FieldCreatedAt, receipt.FieldUpdatedAt:
+		case receipt.FieldTxDate, receipt.FieldFxRateDate, receipt.FieldCreatedAt, receipt.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case receipt.FieldID, receipt.FieldProfileID:
 			values[i] = new(uuid.UUID)
@@ -152,6 +205,13 @@ func (_m *Receipt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MerchantName = value.String
 			}
+		case receipt.FieldMerchantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_id", values[i])
+			} else if value.Valid {
+				_m.MerchantID = new(uuid.UUID)
+				*_m.MerchantID = *value.S.(*uuid.UUID)
+			}
 		case receipt.FieldTxDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field tx_date", values[i])
@@ -159,24 +219,53 @@ func (_m *Receipt) assignValues(columns []string, values []any) error {
 				_m.TxDate = value.Time
 			}
 		case receipt.FieldSubtotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
 			} else if value.Valid {
-				_m.Subtotal = new(float64)
-				*_m.Subtotal = value.Float64
+				_m.Subtotal = new(money.Amount)
+				*_m.Subtotal = *value.S.(*money.Amount)
 			}
 		case receipt.FieldTax:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field tax", values[i])
 			} else if value.Valid {
-				_m.Tax = new(float64)
-				*_m.Tax = value.Float64
+				_m.Tax = new(money.Amount)
+				*_m.Tax = *value.S.(*money.Amount)
+			}
+		case receipt.FieldDiscount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				_m.Discount = new(money.Amount)
+				*_m.Discount = *value.S.(*money.Amount)
+			}
+		case receipt.FieldOtherFees:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field other_fees", values[i])
+			} else if value.Valid {
+				_m.OtherFees = new(money.Amount)
+				*_m.OtherFees = *value.S.(*money.Amount)
+			}
+		case receipt.FieldTip:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field tip", values[i])
+			} else if value.Valid {
+				_m.Tip = new(money.Amount)
+				*_m.Tip = *value.S.(*money.Amount)
+			}
+		case receipt.FieldFees:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fees", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Fees); err != nil {
+					return fmt.Errorf("unmarshal field fees: %w", err)
+				}
 			}
 		case receipt.FieldTotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[i])
-			} else if value.Valid {
-				_m.Total = value.Float64
+			} else if value != nil {
+				_m.Total = *value
 			}
 		case receipt.FieldCurrencyCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -184,6 +273,41 @@ func (_m *Receipt) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CurrencyCode = value.String
 			}
+		case receipt.FieldConvertedTotal:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field converted_total", values[i])
+			} else if value.Valid {
+				_m.ConvertedTotal = new(money.Amount)
+				*_m.ConvertedTotal = *value.S.(*money.Amount)
+			}
+		case receipt.FieldConvertedCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field converted_currency", values[i])
+			} else if value.Valid {
+				_m.ConvertedCurrency = new(string)
+				*_m.ConvertedCurrency = value.String
+			}
+		case receipt.FieldFxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate", values[i])
+			} else if value.Valid {
+				_m.FxRate = new(float64)
+				*_m.FxRate = value.Float64
+			}
+		case receipt.FieldFxRateDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate_date", values[i])
+			} else if value.Valid {
+				_m.FxRateDate = new(time.Time)
+				*_m.FxRateDate = value.Time
+			}
+		case receipt.FieldFxSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_source", values[i])
+			} else if value.Valid {
+				_m.FxSource = new(string)
+				*_m.FxSource = value.String
+			}
 		case receipt.FieldCategoryName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category_name", values[i])
@@ -239,6 +363,11 @@ func (_m *Receipt) QueryProfile() *ProfileQuery {
 	return NewReceiptClient(_m.config).QueryProfile(_m)
 }
 
+// QueryMerchant queries the "merchant" edge of the Receipt entity.
+func (_m *Receipt) QueryMerchant() *MerchantQuery {
+	return NewReceiptClient(_m.config).QueryMerchant(_m)
+}
+
 // QueryFiles queries the "files" edge of the Receipt entity.
 func (_m *Receipt) QueryFiles() *ReceiptFileQuery {
 	return NewReceiptClient(_m.config).QueryFiles(_m)
@@ -249,6 +378,11 @@ func (_m *Receipt) QueryJobs() *ExtractJobQuery {
 	return NewReceiptClient(_m.config).QueryJobs(_m)
 }
 
+// QueryAnomalies queries the "anomalies" edge of the Receipt entity.
+func (_m *Receipt) QueryAnomalies() *ReceiptAnomalyQuery {
+	return NewReceiptClient(_m.config).QueryAnomalies(_m)
+}
+
 // Update returns a builder for updating this Receipt.
 // Note that you need to call Receipt.Unwrap() before calling this method if this Receipt
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -283,6 +417,11 @@ func (_m *Receipt) String() string {
 	builder.WriteString("merchant_name=")
 	builder.WriteString(_m.MerchantName)
 	builder.WriteString(", ")
+	if v := _m.MerchantID; v != nil {
+		builder.WriteString("merchant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tx_date=")
 	builder.WriteString(_m.TxDate.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -296,12 +435,55 @@ func (_m *Receipt) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.Discount; v != nil {
+		builder.WriteString("discount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OtherFees; v != nil {
+		builder.WriteString("other_fees=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Tip; v != nil {
+		builder.WriteString("tip=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fees=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fees))
+	builder.WriteString(", ")
 	builder.WriteString("total=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Total))
 	builder.WriteString(", ")
 	builder.WriteString("currency_code=")
 	builder.WriteString(_m.CurrencyCode)
 	builder.WriteString(", ")
+	if v := _m.ConvertedTotal; v != nil {
+		builder.WriteString("converted_total=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConvertedCurrency; v != nil {
+		builder.WriteString("converted_currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FxRate; v != nil {
+		builder.WriteString("fx_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FxRateDate; v != nil {
+		builder.WriteString("fx_rate_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FxSource; v != nil {
+		builder.WriteString("fx_source=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("category_name=")
 	builder.WriteString(_m.CategoryName)
 	builder.WriteString(", ")
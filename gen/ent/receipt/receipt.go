@@ -21,16 +21,36 @@ const (
 	FieldFileID = "file_id"
 	// FieldMerchantName holds the string denoting the merchant_name field in the database.
 	FieldMerchantName = "merchant_name"
+	// FieldMerchantID holds the string denoting the merchant_id field in the database.
+	FieldMerchantID = "merchant_id"
 	// FieldTxDate holds the string denoting the tx_date field in the database.
 	FieldTxDate = "tx_date"
 	// FieldSubtotal holds the string denoting the subtotal field in the database.
 	FieldSubtotal = "subtotal"
 	// FieldTax holds the string denoting the tax field in the database.
 	FieldTax = "tax"
+	// FieldDiscount holds the string denoting the discount field in the database.
+	FieldDiscount = "discount"
+	// FieldOtherFees holds the string denoting the other_fees field in the database.
+	FieldOtherFees = "other_fees"
+	// FieldTip holds the string denoting the tip field in the database.
+	FieldTip = "tip"
+	// FieldFees holds the string denoting the fees field in the database.
+	FieldFees = "fees"
 	// FieldTotal holds the string denoting the total field in the database.
 	FieldTotal = "total"
 	// FieldCurrencyCode holds the string denoting the currency_code field in the database.
 	FieldCurrencyCode = "currency_code"
+	// FieldConvertedTotal holds the string denoting the converted_total field in the database.
+	FieldConvertedTotal = "converted_total"
+	// FieldConvertedCurrency holds the string denoting the converted_currency field in the database.
+	FieldConvertedCurrency = "converted_currency"
+	// FieldFxRate holds the string denoting the fx_rate field in the database.
+	FieldFxRate = "fx_rate"
+	// FieldFxRateDate holds the string denoting the fx_rate_date field in the database.
+	FieldFxRateDate = "fx_rate_date"
+	// FieldFxSource holds the string denoting the fx_source field in the database.
+	FieldFxSource = "fx_source"
 	// FieldCategoryName holds the string denoting the category_name field in the database.
 	FieldCategoryName = "category_name"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -45,10 +65,14 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeProfile holds the string denoting the profile edge name in mutations.
 	EdgeProfile = "profile"
+	// EdgeMerchant holds the string denoting the merchant edge name in mutations.
+	EdgeMerchant = "merchant"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
 	// EdgeJobs holds the string denoting the jobs edge name in mutations.
 	EdgeJobs = "jobs"
+	// EdgeAnomalies holds the string denoting the anomalies edge name in mutations.
+	EdgeAnomalies = "anomalies"
 	// Table holds the table name of the receipt in the database.
 	Table = "receipts"
 	// ProfileTable is the table that holds the profile relation/edge.
@@ -58,6 +82,13 @@ const (
 	ProfileInverseTable = "profiles"
 	// ProfileColumn is the table column denoting the profile relation/edge.
 	ProfileColumn = "profile_id"
+	// MerchantTable is the table that holds the merchant relation/edge.
+	MerchantTable = "receipts"
+	// MerchantInverseTable is the table name for the Merchant entity.
+	// It exists in this package in order to avoid circular dependency with the "merchant" package.
+	MerchantInverseTable = "merchants"
+	// MerchantColumn is the table column denoting the merchant relation/edge.
+	MerchantColumn = "merchant_id"
 	// FilesTable is the table that holds the files relation/edge.
 	FilesTable = "receipt_files"
 	// FilesInverseTable is the table name for the ReceiptFile entity.
@@ -72,6 +103,13 @@ const (
 	JobsInverseTable = "extract_job"
 	// JobsColumn is the table column denoting the jobs relation/edge.
 	JobsColumn = "receipt_id"
+	// AnomaliesTable is the table that holds the anomalies relation/edge.
+	AnomaliesTable = "receipt_anomalies"
+	// AnomaliesInverseTable is the table name for the ReceiptAnomaly entity.
+	// It exists in this package in order to avoid circular dependency with the "receiptanomaly" package.
+	AnomaliesInverseTable = "receipt_anomalies"
+	// AnomaliesColumn is the table column denoting the anomalies relation/edge.
+	AnomaliesColumn = "receipt_id"
 )
 
 // Columns holds all SQL columns for receipt fields.
@@ -80,11 +118,21 @@ var Columns = []string{
 	FieldProfileID,
 	FieldFileID,
 	FieldMerchantName,
+	FieldMerchantID,
 	FieldTxDate,
 	FieldSubtotal,
 	FieldTax,
+	FieldDiscount,
+	FieldOtherFees,
+	FieldTip,
+	FieldFees,
 	FieldTotal,
 	FieldCurrencyCode,
+	FieldConvertedTotal,
+	FieldConvertedCurrency,
+	FieldFxRate,
+	FieldFxRateDate,
+	FieldFxSource,
 	FieldCategoryName,
 	FieldDescription,
 	FieldFilePath,
@@ -145,6 +193,11 @@ func ByMerchantName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMerchantName, opts...).ToFunc()
 }
 
+// ByMerchantID orders the results by the merchant_id field.
+func ByMerchantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantID, opts...).ToFunc()
+}
+
 // ByTxDate orders the results by the tx_date field.
 func ByTxDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTxDate, opts...).ToFunc()
@@ -160,6 +213,21 @@ func ByTax(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTax, opts...).ToFunc()
 }
 
+// ByDiscount orders the results by the discount field.
+func ByDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscount, opts...).ToFunc()
+}
+
+// ByOtherFees orders the results by the other_fees field.
+func ByOtherFees(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOtherFees, opts...).ToFunc()
+}
+
+// ByTip orders the results by the tip field.
+func ByTip(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTip, opts...).ToFunc()
+}
+
 // ByTotal orders the results by the total field.
 func ByTotal(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotal, opts...).ToFunc()
@@ -170,6 +238,31 @@ func ByCurrencyCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrencyCode, opts...).ToFunc()
 }
 
+// ByConvertedTotal orders the results by the converted_total field.
+func ByConvertedTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConvertedTotal, opts...).ToFunc()
+}
+
+// ByConvertedCurrency orders the results by the converted_currency field.
+func ByConvertedCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConvertedCurrency, opts...).ToFunc()
+}
+
+// ByFxRate orders the results by the fx_rate field.
+func ByFxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRate, opts...).ToFunc()
+}
+
+// ByFxRateDate orders the results by the fx_rate_date field.
+func ByFxRateDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRateDate, opts...).ToFunc()
+}
+
+// ByFxSource orders the results by the fx_source field.
+func ByFxSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxSource, opts...).ToFunc()
+}
+
 // ByCategoryName orders the results by the category_name field.
 func ByCategoryName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCategoryName, opts...).ToFunc()
@@ -207,6 +300,13 @@ func ByProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByMerchantField orders the results by merchant field.
+func ByMerchantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMerchantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByFilesCount orders the results by files count.
 func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -234,6 +334,20 @@ func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAnomaliesCount orders the results by anomalies count.
+func ByAnomaliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnomaliesStep(), opts...)
+	}
+}
+
+// ByAnomalies orders the results by anomalies terms.
+func ByAnomalies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnomaliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProfileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -241,6 +355,13 @@ func newProfileStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
 	)
 }
+func newMerchantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MerchantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MerchantTable, MerchantColumn),
+	)
+}
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -255,3 +376,10 @@ func newJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
 	)
 }
+func newAnomaliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnomaliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnomaliesTable, AnomaliesColumn),
+	)
+}
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -71,23 +72,43 @@ func MerchantName(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldMerchantName, v))
 }
 
+// MerchantID applies equality check predicate on the "merchant_id" field. It's identical to MerchantIDEQ.
+func MerchantID(v uuid.UUID) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldMerchantID, v))
+}
+
 // TxDate applies equality check predicate on the "tx_date" field. It's identical to TxDateEQ.
 func TxDate(v time.Time) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTxDate, v))
 }
 
 // Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
-func Subtotal(v float64) predicate.Receipt {
+func Subtotal(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldSubtotal, v))
 }
 
 // Tax applies equality check predicate on the "tax" field. It's identical to TaxEQ.
-func Tax(v float64) predicate.Receipt {
+func Tax(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTax, v))
 }
 
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldDiscount, v))
+}
+
+// OtherFees applies equality check predicate on the "other_fees" field. It's identical to OtherFeesEQ.
+func OtherFees(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldOtherFees, v))
+}
+
+// Tip applies equality check predicate on the "tip" field. It's identical to TipEQ.
+func Tip(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldTip, v))
+}
+
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
-func Total(v float64) predicate.Receipt {
+func Total(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTotal, v))
 }
 
@@ -96,6 +117,31 @@ func CurrencyCode(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldCurrencyCode, v))
 }
 
+// ConvertedTotal applies equality check predicate on the "converted_total" field. It's identical to ConvertedTotalEQ.
+func ConvertedTotal(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldConvertedTotal, v))
+}
+
+// ConvertedCurrency applies equality check predicate on the "converted_currency" field. It's identical to ConvertedCurrencyEQ.
+func ConvertedCurrency(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldConvertedCurrency, v))
+}
+
+// FxRate applies equality check predicate on the "fx_rate" field. It's identical to FxRateEQ.
+func FxRate(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateDate applies equality check predicate on the "fx_rate_date" field. It's identical to FxRateDateEQ.
+func FxRateDate(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxRateDate, v))
+}
+
+// FxSource applies equality check predicate on the "fx_source" field. It's identical to FxSourceEQ.
+func FxSource(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxSource, v))
+}
+
 // CategoryName applies equality check predicate on the "category_name" field. It's identical to CategoryNameEQ.
 func CategoryName(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldCategoryName, v))
@@ -261,6 +307,36 @@ func MerchantNameContainsFold(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldContainsFold(FieldMerchantName, v))
 }
 
+// MerchantIDEQ applies the EQ predicate on the "merchant_id" field.
+func MerchantIDEQ(v uuid.UUID) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldMerchantID, v))
+}
+
+// MerchantIDNEQ applies the NEQ predicate on the "merchant_id" field.
+func MerchantIDNEQ(v uuid.UUID) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldMerchantID, v))
+}
+
+// MerchantIDIn applies the In predicate on the "merchant_id" field.
+func MerchantIDIn(vs ...uuid.UUID) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDNotIn applies the NotIn predicate on the "merchant_id" field.
+func MerchantIDNotIn(vs ...uuid.UUID) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldMerchantID, vs...))
+}
+
+// MerchantIDIsNil applies the IsNil predicate on the "merchant_id" field.
+func MerchantIDIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldMerchantID))
+}
+
+// MerchantIDNotNil applies the NotNil predicate on the "merchant_id" field.
+func MerchantIDNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldMerchantID))
+}
+
 // TxDateEQ applies the EQ predicate on the "tx_date" field.
 func TxDateEQ(v time.Time) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTxDate, v))
@@ -302,42 +378,42 @@ func TxDateLTE(v time.Time) predicate.Receipt {
 }
 
 // SubtotalEQ applies the EQ predicate on the "subtotal" field.
-func SubtotalEQ(v float64) predicate.Receipt {
+func SubtotalEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldSubtotal, v))
 }
 
 // SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
-func SubtotalNEQ(v float64) predicate.Receipt {
+func SubtotalNEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNEQ(FieldSubtotal, v))
 }
 
 // SubtotalIn applies the In predicate on the "subtotal" field.
-func SubtotalIn(vs ...float64) predicate.Receipt {
+func SubtotalIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldIn(FieldSubtotal, vs...))
 }
 
 // SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
-func SubtotalNotIn(vs ...float64) predicate.Receipt {
+func SubtotalNotIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNotIn(FieldSubtotal, vs...))
 }
 
 // SubtotalGT applies the GT predicate on the "subtotal" field.
-func SubtotalGT(v float64) predicate.Receipt {
+func SubtotalGT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGT(FieldSubtotal, v))
 }
 
 // SubtotalGTE applies the GTE predicate on the "subtotal" field.
-func SubtotalGTE(v float64) predicate.Receipt {
+func SubtotalGTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGTE(FieldSubtotal, v))
 }
 
 // SubtotalLT applies the LT predicate on the "subtotal" field.
-func SubtotalLT(v float64) predicate.Receipt {
+func SubtotalLT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLT(FieldSubtotal, v))
 }
 
 // SubtotalLTE applies the LTE predicate on the "subtotal" field.
-func SubtotalLTE(v float64) predicate.Receipt {
+func SubtotalLTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLTE(FieldSubtotal, v))
 }
 
@@ -352,42 +428,42 @@ func SubtotalNotNil() predicate.Receipt {
 }
 
 // TaxEQ applies the EQ predicate on the "tax" field.
-func TaxEQ(v float64) predicate.Receipt {
+func TaxEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTax, v))
 }
 
 // TaxNEQ applies the NEQ predicate on the "tax" field.
-func TaxNEQ(v float64) predicate.Receipt {
+func TaxNEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNEQ(FieldTax, v))
 }
 
 // TaxIn applies the In predicate on the "tax" field.
-func TaxIn(vs ...float64) predicate.Receipt {
+func TaxIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldIn(FieldTax, vs...))
 }
 
 // TaxNotIn applies the NotIn predicate on the "tax" field.
-func TaxNotIn(vs ...float64) predicate.Receipt {
+func TaxNotIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNotIn(FieldTax, vs...))
 }
 
 // TaxGT applies the GT predicate on the "tax" field.
-func TaxGT(v float64) predicate.Receipt {
+func TaxGT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGT(FieldTax, v))
 }
 
 // TaxGTE applies the GTE predicate on the "tax" field.
-func TaxGTE(v float64) predicate.Receipt {
+func TaxGTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGTE(FieldTax, v))
 }
 
 // TaxLT applies the LT predicate on the "tax" field.
-func TaxLT(v float64) predicate.Receipt {
+func TaxLT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLT(FieldTax, v))
 }
 
 // TaxLTE applies the LTE predicate on the "tax" field.
-func TaxLTE(v float64) predicate.Receipt {
+func TaxLTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLTE(FieldTax, v))
 }
 
@@ -401,43 +477,203 @@ func TaxNotNil() predicate.Receipt {
 	return predicate.Receipt(sql.FieldNotNull(FieldTax))
 }
 
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldDiscount, v))
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldDiscount, v))
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldDiscount, vs...))
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldDiscount, vs...))
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldDiscount, v))
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldDiscount, v))
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldDiscount, v))
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldDiscount, v))
+}
+
+// DiscountIsNil applies the IsNil predicate on the "discount" field.
+func DiscountIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldDiscount))
+}
+
+// DiscountNotNil applies the NotNil predicate on the "discount" field.
+func DiscountNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldDiscount))
+}
+
+// OtherFeesEQ applies the EQ predicate on the "other_fees" field.
+func OtherFeesEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldOtherFees, v))
+}
+
+// OtherFeesNEQ applies the NEQ predicate on the "other_fees" field.
+func OtherFeesNEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldOtherFees, v))
+}
+
+// OtherFeesIn applies the In predicate on the "other_fees" field.
+func OtherFeesIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldOtherFees, vs...))
+}
+
+// OtherFeesNotIn applies the NotIn predicate on the "other_fees" field.
+func OtherFeesNotIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldOtherFees, vs...))
+}
+
+// OtherFeesGT applies the GT predicate on the "other_fees" field.
+func OtherFeesGT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldOtherFees, v))
+}
+
+// OtherFeesGTE applies the GTE predicate on the "other_fees" field.
+func OtherFeesGTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldOtherFees, v))
+}
+
+// OtherFeesLT applies the LT predicate on the "other_fees" field.
+func OtherFeesLT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldOtherFees, v))
+}
+
+// OtherFeesLTE applies the LTE predicate on the "other_fees" field.
+func OtherFeesLTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldOtherFees, v))
+}
+
+// OtherFeesIsNil applies the IsNil predicate on the "other_fees" field.
+func OtherFeesIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldOtherFees))
+}
+
+// OtherFeesNotNil applies the NotNil predicate on the "other_fees" field.
+func OtherFeesNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldOtherFees))
+}
+
+// TipEQ applies the EQ predicate on the "tip" field.
+func TipEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldTip, v))
+}
+
+// TipNEQ applies the NEQ predicate on the "tip" field.
+func TipNEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldTip, v))
+}
+
+// TipIn applies the In predicate on the "tip" field.
+func TipIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldTip, vs...))
+}
+
+// TipNotIn applies the NotIn predicate on the "tip" field.
+func TipNotIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldTip, vs...))
+}
+
+// TipGT applies the GT predicate on the "tip" field.
+func TipGT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldTip, v))
+}
+
+// TipGTE applies the GTE predicate on the "tip" field.
+func TipGTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldTip, v))
+}
+
+// TipLT applies the LT predicate on the "tip" field.
+func TipLT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldTip, v))
+}
+
+// TipLTE applies the LTE predicate on the "tip" field.
+func TipLTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldTip, v))
+}
+
+// TipIsNil applies the IsNil predicate on the "tip" field.
+func TipIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldTip))
+}
+
+// TipNotNil applies the NotNil predicate on the "tip" field.
+func TipNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldTip))
+}
+
+// FeesIsNil applies the IsNil predicate on the "fees" field.
+func FeesIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldFees))
+}
+
+// FeesNotNil applies the NotNil predicate on the "fees" field.
+func FeesNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldFees))
+}
+
 // TotalEQ applies the EQ predicate on the "total" field.
-func TotalEQ(v float64) predicate.Receipt {
+func TotalEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldTotal, v))
 }
 
 // TotalNEQ applies the NEQ predicate on the "total" field.
-func TotalNEQ(v float64) predicate.Receipt {
+func TotalNEQ(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNEQ(FieldTotal, v))
 }
 
 // TotalIn applies the In predicate on the "total" field.
-func TotalIn(vs ...float64) predicate.Receipt {
+func TotalIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldIn(FieldTotal, vs...))
 }
 
 // TotalNotIn applies the NotIn predicate on the "total" field.
-func TotalNotIn(vs ...float64) predicate.Receipt {
+func TotalNotIn(vs ...money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldNotIn(FieldTotal, vs...))
 }
 
 // TotalGT applies the GT predicate on the "total" field.
-func TotalGT(v float64) predicate.Receipt {
+func TotalGT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGT(FieldTotal, v))
 }
 
 // TotalGTE applies the GTE predicate on the "total" field.
-func TotalGTE(v float64) predicate.Receipt {
+func TotalGTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldGTE(FieldTotal, v))
 }
 
 // TotalLT applies the LT predicate on the "total" field.
-func TotalLT(v float64) predicate.Receipt {
+func TotalLT(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLT(FieldTotal, v))
 }
 
 // TotalLTE applies the LTE predicate on the "total" field.
-func TotalLTE(v float64) predicate.Receipt {
+func TotalLTE(v money.Amount) predicate.Receipt {
 	return predicate.Receipt(sql.FieldLTE(FieldTotal, v))
 }
 
@@ -506,6 +742,306 @@ func CurrencyCodeContainsFold(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldContainsFold(FieldCurrencyCode, v))
 }
 
+// ConvertedTotalEQ applies the EQ predicate on the "converted_total" field.
+func ConvertedTotalEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalNEQ applies the NEQ predicate on the "converted_total" field.
+func ConvertedTotalNEQ(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalIn applies the In predicate on the "converted_total" field.
+func ConvertedTotalIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldConvertedTotal, vs...))
+}
+
+// ConvertedTotalNotIn applies the NotIn predicate on the "converted_total" field.
+func ConvertedTotalNotIn(vs ...money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldConvertedTotal, vs...))
+}
+
+// ConvertedTotalGT applies the GT predicate on the "converted_total" field.
+func ConvertedTotalGT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalGTE applies the GTE predicate on the "converted_total" field.
+func ConvertedTotalGTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalLT applies the LT predicate on the "converted_total" field.
+func ConvertedTotalLT(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalLTE applies the LTE predicate on the "converted_total" field.
+func ConvertedTotalLTE(v money.Amount) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldConvertedTotal, v))
+}
+
+// ConvertedTotalIsNil applies the IsNil predicate on the "converted_total" field.
+func ConvertedTotalIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldConvertedTotal))
+}
+
+// ConvertedTotalNotNil applies the NotNil predicate on the "converted_total" field.
+func ConvertedTotalNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldConvertedTotal))
+}
+
+// ConvertedCurrencyEQ applies the EQ predicate on the "converted_currency" field.
+func ConvertedCurrencyEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyNEQ applies the NEQ predicate on the "converted_currency" field.
+func ConvertedCurrencyNEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyIn applies the In predicate on the "converted_currency" field.
+func ConvertedCurrencyIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldConvertedCurrency, vs...))
+}
+
+// ConvertedCurrencyNotIn applies the NotIn predicate on the "converted_currency" field.
+func ConvertedCurrencyNotIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldConvertedCurrency, vs...))
+}
+
+// ConvertedCurrencyGT applies the GT predicate on the "converted_currency" field.
+func ConvertedCurrencyGT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyGTE applies the GTE predicate on the "converted_currency" field.
+func ConvertedCurrencyGTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyLT applies the LT predicate on the "converted_currency" field.
+func ConvertedCurrencyLT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyLTE applies the LTE predicate on the "converted_currency" field.
+func ConvertedCurrencyLTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyContains applies the Contains predicate on the "converted_currency" field.
+func ConvertedCurrencyContains(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContains(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyHasPrefix applies the HasPrefix predicate on the "converted_currency" field.
+func ConvertedCurrencyHasPrefix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasPrefix(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyHasSuffix applies the HasSuffix predicate on the "converted_currency" field.
+func ConvertedCurrencyHasSuffix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasSuffix(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyIsNil applies the IsNil predicate on the "converted_currency" field.
+func ConvertedCurrencyIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldConvertedCurrency))
+}
+
+// ConvertedCurrencyNotNil applies the NotNil predicate on the "converted_currency" field.
+func ConvertedCurrencyNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldConvertedCurrency))
+}
+
+// ConvertedCurrencyEqualFold applies the EqualFold predicate on the "converted_currency" field.
+func ConvertedCurrencyEqualFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEqualFold(FieldConvertedCurrency, v))
+}
+
+// ConvertedCurrencyContainsFold applies the ContainsFold predicate on the "converted_currency" field.
+func ConvertedCurrencyContainsFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContainsFold(FieldConvertedCurrency, v))
+}
+
+// FxRateEQ applies the EQ predicate on the "fx_rate" field.
+func FxRateEQ(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateNEQ applies the NEQ predicate on the "fx_rate" field.
+func FxRateNEQ(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldFxRate, v))
+}
+
+// FxRateIn applies the In predicate on the "fx_rate" field.
+func FxRateIn(vs ...float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldFxRate, vs...))
+}
+
+// FxRateNotIn applies the NotIn predicate on the "fx_rate" field.
+func FxRateNotIn(vs ...float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldFxRate, vs...))
+}
+
+// FxRateGT applies the GT predicate on the "fx_rate" field.
+func FxRateGT(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldFxRate, v))
+}
+
+// FxRateGTE applies the GTE predicate on the "fx_rate" field.
+func FxRateGTE(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldFxRate, v))
+}
+
+// FxRateLT applies the LT predicate on the "fx_rate" field.
+func FxRateLT(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldFxRate, v))
+}
+
+// FxRateLTE applies the LTE predicate on the "fx_rate" field.
+func FxRateLTE(v float64) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldFxRate, v))
+}
+
+// FxRateIsNil applies the IsNil predicate on the "fx_rate" field.
+func FxRateIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldFxRate))
+}
+
+// FxRateNotNil applies the NotNil predicate on the "fx_rate" field.
+func FxRateNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldFxRate))
+}
+
+// FxRateDateEQ applies the EQ predicate on the "fx_rate_date" field.
+func FxRateDateEQ(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxRateDate, v))
+}
+
+// FxRateDateNEQ applies the NEQ predicate on the "fx_rate_date" field.
+func FxRateDateNEQ(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldFxRateDate, v))
+}
+
+// FxRateDateIn applies the In predicate on the "fx_rate_date" field.
+func FxRateDateIn(vs ...time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldFxRateDate, vs...))
+}
+
+// FxRateDateNotIn applies the NotIn predicate on the "fx_rate_date" field.
+func FxRateDateNotIn(vs ...time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldFxRateDate, vs...))
+}
+
+// FxRateDateGT applies the GT predicate on the "fx_rate_date" field.
+func FxRateDateGT(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldFxRateDate, v))
+}
+
+// FxRateDateGTE applies the GTE predicate on the "fx_rate_date" field.
+func FxRateDateGTE(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldFxRateDate, v))
+}
+
+// FxRateDateLT applies the LT predicate on the "fx_rate_date" field.
+func FxRateDateLT(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldFxRateDate, v))
+}
+
+// FxRateDateLTE applies the LTE predicate on the "fx_rate_date" field.
+func FxRateDateLTE(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldFxRateDate, v))
+}
+
+// FxRateDateIsNil applies the IsNil predicate on the "fx_rate_date" field.
+func FxRateDateIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldFxRateDate))
+}
+
+// FxRateDateNotNil applies the NotNil predicate on the "fx_rate_date" field.
+func FxRateDateNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldFxRateDate))
+}
+
+// FxSourceEQ applies the EQ predicate on the "fx_source" field.
+func FxSourceEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldFxSource, v))
+}
+
+// FxSourceNEQ applies the NEQ predicate on the "fx_source" field.
+func FxSourceNEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldFxSource, v))
+}
+
+// FxSourceIn applies the In predicate on the "fx_source" field.
+func FxSourceIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldFxSource, vs...))
+}
+
+// FxSourceNotIn applies the NotIn predicate on the "fx_source" field.
+func FxSourceNotIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldFxSource, vs...))
+}
+
+// FxSourceGT applies the GT predicate on the "fx_source" field.
+func FxSourceGT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldFxSource, v))
+}
+
+// FxSourceGTE applies the GTE predicate on the "fx_source" field.
+func FxSourceGTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldFxSource, v))
+}
+
+// FxSourceLT applies the LT predicate on the "fx_source" field.
+func FxSourceLT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldFxSource, v))
+}
+
+// FxSourceLTE applies the LTE predicate on the "fx_source" field.
+func FxSourceLTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldFxSource, v))
+}
+
+// FxSourceContains applies the Contains predicate on the "fx_source" field.
+func FxSourceContains(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContains(FieldFxSource, v))
+}
+
+// FxSourceHasPrefix applies the HasPrefix predicate on the "fx_source" field.
+func FxSourceHasPrefix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasPrefix(FieldFxSource, v))
+}
+
+// FxSourceHasSuffix applies the HasSuffix predicate on the "fx_source" field.
+func FxSourceHasSuffix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasSuffix(FieldFxSource, v))
+}
+
+// FxSourceIsNil applies the IsNil predicate on the "fx_source" field.
+func FxSourceIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldFxSource))
+}
+
+// FxSourceNotNil applies the NotNil predicate on the "fx_source" field.
+func FxSourceNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldFxSource))
+}
+
+// FxSourceEqualFold applies the EqualFold predicate on the "fx_source" field.
+func FxSourceEqualFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEqualFold(FieldFxSource, v))
+}
+
+// FxSourceContainsFold applies the ContainsFold predicate on the "fx_source" field.
+func FxSourceContainsFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContainsFold(FieldFxSource, v))
+}
+
 // CategoryNameEQ applies the EQ predicate on the "category_name" field.
 func CategoryNameEQ(v string) predicate.Receipt {
 	return predicate.Receipt(sql.FieldEQ(FieldCategoryName, v))
@@ -824,6 +1360,29 @@ func HasProfileWith(preds ...predicate.Profile) predicate.Receipt {
 	})
 }
 
+// HasMerchant applies the HasEdge predicate on the "merchant" edge.
+func HasMerchant() predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MerchantTable, MerchantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMerchantWith applies the HasEdge predicate on the "merchant" edge with a given conditions (other predicates).
+func HasMerchantWith(preds ...predicate.Merchant) predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := newMerchantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Receipt {
 	return predicate.Receipt(func(s *sql.Selector) {
@@ -870,6 +1429,29 @@ func HasJobsWith(preds ...predicate.ExtractJob) predicate.Receipt {
 	})
 }
 
+// HasAnomalies applies the HasEdge predicate on the "anomalies" edge.
+func HasAnomalies() predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnomaliesTable, AnomaliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnomaliesWith applies the HasEdge predicate on the "anomalies" edge with a given conditions (other predicates).
+func HasAnomaliesWith(preds ...predicate.ReceiptAnomaly) predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := newAnomaliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Receipt) predicate.Receipt {
 	return predicate.Receipt(sql.AndPredicates(predicates...))
@@ -4,17 +4,23 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 // ReceiptCreate is the builder for creating a Receipt entity.
@@ -22,6 +28,7 @@ type ReceiptCreate struct {
 	config
 	mutation *ReceiptMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProfileID sets the "profile_id" field.
@@ -50,6 +57,20 @@ func (_c *ReceiptCreate) SetMerchantName(v string) *ReceiptCreate {
 	return _c
 }
 
+// SetMerchantID sets the "merchant_id" field.
+func (_c *ReceiptCreate) SetMerchantID(v uuid.UUID) *ReceiptCreate {
+	_c.mutation.SetMerchantID(v)
+	return _c
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableMerchantID(v *uuid.UUID) *ReceiptCreate {
+	if v != nil {
+		_c.SetMerchantID(*v)
+	}
+	return _c
+}
+
 // SetTxDate sets the "tx_date" field.
 func (_c *ReceiptCreate) SetTxDate(v time.Time) *ReceiptCreate {
 	_c.mutation.SetTxDate(v)
@@ -57,13 +78,13 @@ func (_c *ReceiptCreate) SetTxDate(v time.Time) *ReceiptCreate {
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (_c *ReceiptCreate) SetSubtotal(v float64) *ReceiptCreate {
+func (_c *ReceiptCreate) SetSubtotal(v money.Amount) *ReceiptCreate {
 	_c.mutation.SetSubtotal(v)
 	return _c
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (_c *ReceiptCreate) SetNillableSubtotal(v *float64) *ReceiptCreate {
+func (_c *ReceiptCreate) SetNillableSubtotal(v *money.Amount) *ReceiptCreate {
 	if v != nil {
 		_c.SetSubtotal(*v)
 	}
@@ -71,21 +92,69 @@ func (_c *ReceiptCreate) SetNillableSubtotal(v *float64) *ReceiptCreate {
 }
 
 // SetTax sets the "tax" field.
-func (_c *ReceiptCreate) SetTax(v float64) *ReceiptCreate {
+func (_c *ReceiptCreate) SetTax(v money.Amount) *ReceiptCreate {
 	_c.mutation.SetTax(v)
 	return _c
 }
 
 // SetNillableTax sets the "tax" field if the given value is not nil.
-func (_c *ReceiptCreate) SetNillableTax(v *float64) *ReceiptCreate {
+func (_c *ReceiptCreate) SetNillableTax(v *money.Amount) *ReceiptCreate {
 	if v != nil {
 		_c.SetTax(*v)
 	}
 	return _c
 }
 
+// SetDiscount sets the "discount" field.
+func (_c *ReceiptCreate) SetDiscount(v money.Amount) *ReceiptCreate {
+	_c.mutation.SetDiscount(v)
+	return _c
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableDiscount(v *money.Amount) *ReceiptCreate {
+	if v != nil {
+		_c.SetDiscount(*v)
+	}
+	return _c
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (_c *ReceiptCreate) SetOtherFees(v money.Amount) *ReceiptCreate {
+	_c.mutation.SetOtherFees(v)
+	return _c
+}
+
+// SetNillableOtherFees sets the "other_fees" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableOtherFees(v *money.Amount) *ReceiptCreate {
+	if v != nil {
+		_c.SetOtherFees(*v)
+	}
+	return _c
+}
+
+// SetTip sets the "tip" field.
+func (_c *ReceiptCreate) SetTip(v money.Amount) *ReceiptCreate {
+	_c.mutation.SetTip(v)
+	return _c
+}
+
+// SetNillableTip sets the "tip" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableTip(v *money.Amount) *ReceiptCreate {
+	if v != nil {
+		_c.SetTip(*v)
+	}
+	return _c
+}
+
+// SetFees sets the "fees" field.
+func (_c *ReceiptCreate) SetFees(v json.RawMessage) *ReceiptCreate {
+	_c.mutation.SetFees(v)
+	return _c
+}
+
 // SetTotal sets the "total" field.
-func (_c *ReceiptCreate) SetTotal(v float64) *ReceiptCreate {
+func (_c *ReceiptCreate) SetTotal(v money.Amount) *ReceiptCreate {
 	_c.mutation.SetTotal(v)
 	return _c
 }
@@ -96,6 +165,76 @@ func (_c *ReceiptCreate) SetCurrencyCode(v string) *ReceiptCreate {
 	return _c
 }
 
+// SetConvertedTotal sets the "converted_total" field.
+func (_c *ReceiptCreate) SetConvertedTotal(v money.Amount) *ReceiptCreate {
+	_c.mutation.SetConvertedTotal(v)
+	return _c
+}
+
+// SetNillableConvertedTotal sets the "converted_total" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableConvertedTotal(v *money.Amount) *ReceiptCreate {
+	if v != nil {
+		_c.SetConvertedTotal(*v)
+	}
+	return _c
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (_c *ReceiptCreate) SetConvertedCurrency(v string) *ReceiptCreate {
+	_c.mutation.SetConvertedCurrency(v)
+	return _c
+}
+
+// SetNillableConvertedCurrency sets the "converted_currency" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableConvertedCurrency(v *string) *ReceiptCreate {
+	if v != nil {
+		_c.SetConvertedCurrency(*v)
+	}
+	return _c
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_c *ReceiptCreate) SetFxRate(v float64) *ReceiptCreate {
+	_c.mutation.SetFxRate(v)
+	return _c
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableFxRate(v *float64) *ReceiptCreate {
+	if v != nil {
+		_c.SetFxRate(*v)
+	}
+	return _c
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (_c *ReceiptCreate) SetFxRateDate(v time.Time) *ReceiptCreate {
+	_c.mutation.SetFxRateDate(v)
+	return _c
+}
+
+// SetNillableFxRateDate sets the "fx_rate_date" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableFxRateDate(v *time.Time) *ReceiptCreate {
+	if v != nil {
+		_c.SetFxRateDate(*v)
+	}
+	return _c
+}
+
+// SetFxSource sets the "fx_source" field.
+func (_c *ReceiptCreate) SetFxSource(v string) *ReceiptCreate {
+	_c.mutation.SetFxSource(v)
+	return _c
+}
+
+// SetNillableFxSource sets the "fx_source" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableFxSource(v *string) *ReceiptCreate {
+	if v != nil {
+		_c.SetFxSource(*v)
+	}
+	return _c
+}
+
 // SetCategoryName sets the "category_name" field.
 func (_c *ReceiptCreate) SetCategoryName(v string) *ReceiptCreate {
 	_c.mutation.SetCategoryName(v)
@@ -183,6 +322,11 @@ func (_c *ReceiptCreate) SetProfile(v *Profile) *ReceiptCreate {
 	return _c.SetProfileID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_c *ReceiptCreate) SetMerchant(v *Merchant) *ReceiptCreate {
+	return _c.SetMerchantID(v.ID)
+}
+
 // AddFileIDs adds the "files" edge to the ReceiptFile entity by IDs.
 func (_c *ReceiptCreate) AddFileIDs(ids ...uuid.UUID) *ReceiptCreate {
 	_c.mutation.AddFileIDs(ids...)
@@ -213,6 +357,21 @@ func (_c *ReceiptCreate) AddJobs(v ...*ExtractJob) *ReceiptCreate {
 	return _c.AddJobIDs(ids...)
 }
 
+// AddAnomalyIDs adds the "anomalies" edge to the ReceiptAnomaly entity by IDs.
+func (_c *ReceiptCreate) AddAnomalyIDs(ids ...uuid.UUID) *ReceiptCreate {
+	_c.mutation.AddAnomalyIDs(ids...)
+	return _c
+}
+
+// AddAnomalies adds the "anomalies" edges to the ReceiptAnomaly entity.
+func (_c *ReceiptCreate) AddAnomalies(v ...*ReceiptAnomaly) *ReceiptCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnomalyIDs(ids...)
+}
+
 // Mutation returns the ReceiptMutation object of the builder.
 func (_c *ReceiptCreate) Mutation() *ReceiptMutation {
 	return _c.mutation
@@ -347,6 +506,7 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		_node = &Receipt{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(receipt.Table, sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -364,21 +524,57 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		_node.TxDate = value
 	}
 	if value, ok := _c.mutation.Subtotal(); ok {
-		_spec.SetField(receipt.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldSubtotal, field.TypeOther, value)
 		_node.Subtotal = &value
 	}
 	if value, ok := _c.mutation.Tax(); ok {
-		_spec.SetField(receipt.FieldTax, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldTax, field.TypeOther, value)
 		_node.Tax = &value
 	}
+	if value, ok := _c.mutation.Discount(); ok {
+		_spec.SetField(receipt.FieldDiscount, field.TypeOther, value)
+		_node.Discount = &value
+	}
+	if value, ok := _c.mutation.OtherFees(); ok {
+		_spec.SetField(receipt.FieldOtherFees, field.TypeOther, value)
+		_node.OtherFees = &value
+	}
+	if value, ok := _c.mutation.Tip(); ok {
+		_spec.SetField(receipt.FieldTip, field.TypeOther, value)
+		_node.Tip = &value
+	}
+	if value, ok := _c.mutation.Fees(); ok {
+		_spec.SetField(receipt.FieldFees, field.TypeJSON, value)
+		_node.Fees = value
+	}
 	if value, ok := _c.mutation.Total(); ok {
-		_spec.SetField(receipt.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldTotal, field.TypeOther, value)
 		_node.Total = value
 	}
 	if value, ok := _c.mutation.CurrencyCode(); ok {
 		_spec.SetField(receipt.FieldCurrencyCode, field.TypeString, value)
 		_node.CurrencyCode = value
 	}
+	if value, ok := _c.mutation.ConvertedTotal(); ok {
+		_spec.SetField(receipt.FieldConvertedTotal, field.TypeOther, value)
+		_node.ConvertedTotal = &value
+	}
+	if value, ok := _c.mutation.ConvertedCurrency(); ok {
+		_spec.SetField(receipt.FieldConvertedCurrency, field.TypeString, value)
+		_node.ConvertedCurrency = &value
+	}
+	if value, ok := _c.mutation.FxRate(); ok {
+		_spec.SetField(receipt.FieldFxRate, field.TypeFloat64, value)
+		_node.FxRate = &value
+	}
+	if value, ok := _c.mutation.FxRateDate(); ok {
+		_spec.SetField(receipt.FieldFxRateDate, field.TypeTime, value)
+		_node.FxRateDate = &value
+	}
+	if value, ok := _c.mutation.FxSource(); ok {
+		_spec.SetField(receipt.FieldFxSource, field.TypeString, value)
+		_node.FxSource = &value
+	}
 	if value, ok := _c.mutation.CategoryName(); ok {
 		_spec.SetField(receipt.FieldCategoryName, field.TypeString, value)
 		_node.CategoryName = value
@@ -420,6 +616,23 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		_node.ProfileID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.MerchantTable,
+			Columns: []string{receipt.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MerchantID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -452,89 +665,1582 @@ func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// ReceiptCreateBulk is the builder for creating many Receipt entities in bulk.
-type ReceiptCreateBulk struct {
-	config
-	err      error
-	builders []*ReceiptCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Receipt.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptUpsertOne {
+	_c.conflict = opts
+	return &ReceiptUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Receipt entities in the database.
-func (_c *ReceiptCreateBulk) Save(ctx context.Context) ([]*Receipt, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptCreate) OnConflictColumns(columns ...string) *ReceiptUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Receipt, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ReceiptMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// ReceiptUpsertOne is the builder for "upsert"-ing
+	//  one Receipt node.
+	ReceiptUpsertOne struct {
+		create *ReceiptCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// ReceiptUpsert is the "OnConflict" setter.
+	ReceiptUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptUpsert) SetProfileID(v uuid.UUID) *ReceiptUpsert {
+	u.Set(receipt.FieldProfileID, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *ReceiptCreateBulk) SaveX(ctx context.Context) []*Receipt {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateProfileID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldProfileID)
+	return u
 }
 
-// Exec executes the query.
-func (_c *ReceiptCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// SetFileID sets the "file_id" field.
+func (u *ReceiptUpsert) SetFileID(v uuid.UUID) *ReceiptUpsert {
+	u.Set(receipt.FieldFileID, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *ReceiptCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFileID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFileID)
+	return u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *ReceiptUpsert) ClearFileID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFileID)
+	return u
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsert) SetMerchantName(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldMerchantName, v)
+	return u
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMerchantName() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMerchantName)
+	return u
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *ReceiptUpsert) SetMerchantID(v uuid.UUID) *ReceiptUpsert {
+	u.Set(receipt.FieldMerchantID, v)
+	return u
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateMerchantID() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldMerchantID)
+	return u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *ReceiptUpsert) ClearMerchantID() *ReceiptUpsert {
+	u.SetNull(receipt.FieldMerchantID)
+	return u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *ReceiptUpsert) SetSubtotal(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateSubtotal() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldSubtotal)
+	return u
+}
+
+// ClearSubtotal clears the value of the "subtotal" field.
+func (u *ReceiptUpsert) ClearSubtotal() *ReceiptUpsert {
+	u.SetNull(receipt.FieldSubtotal)
+	return u
+}
+
+// SetTax sets the "tax" field.
+func (u *ReceiptUpsert) SetTax(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldTax, v)
+	return u
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateTax() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldTax)
+	return u
+}
+
+// ClearTax clears the value of the "tax" field.
+func (u *ReceiptUpsert) ClearTax() *ReceiptUpsert {
+	u.SetNull(receipt.FieldTax)
+	return u
+}
+
+// SetDiscount sets the "discount" field.
+func (u *ReceiptUpsert) SetDiscount(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldDiscount, v)
+	return u
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateDiscount() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldDiscount)
+	return u
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (u *ReceiptUpsert) ClearDiscount() *ReceiptUpsert {
+	u.SetNull(receipt.FieldDiscount)
+	return u
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (u *ReceiptUpsert) SetOtherFees(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldOtherFees, v)
+	return u
+}
+
+// UpdateOtherFees sets the "other_fees" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateOtherFees() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldOtherFees)
+	return u
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (u *ReceiptUpsert) ClearOtherFees() *ReceiptUpsert {
+	u.SetNull(receipt.FieldOtherFees)
+	return u
+}
+
+// SetTip sets the "tip" field.
+func (u *ReceiptUpsert) SetTip(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldTip, v)
+	return u
+}
+
+// UpdateTip sets the "tip" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateTip() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldTip)
+	return u
+}
+
+// ClearTip clears the value of the "tip" field.
+func (u *ReceiptUpsert) ClearTip() *ReceiptUpsert {
+	u.SetNull(receipt.FieldTip)
+	return u
+}
+
+// SetFees sets the "fees" field.
+func (u *ReceiptUpsert) SetFees(v json.RawMessage) *ReceiptUpsert {
+	u.Set(receipt.FieldFees, v)
+	return u
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFees() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFees)
+	return u
+}
+
+// ClearFees clears the value of the "fees" field.
+func (u *ReceiptUpsert) ClearFees() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFees)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *ReceiptUpsert) SetTotal(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateTotal() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldTotal)
+	return u
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *ReceiptUpsert) SetCurrencyCode(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldCurrencyCode, v)
+	return u
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateCurrencyCode() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldCurrencyCode)
+	return u
+}
+
+// SetConvertedTotal sets the "converted_total" field.
+func (u *ReceiptUpsert) SetConvertedTotal(v money.Amount) *ReceiptUpsert {
+	u.Set(receipt.FieldConvertedTotal, v)
+	return u
+}
+
+// UpdateConvertedTotal sets the "converted_total" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateConvertedTotal() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldConvertedTotal)
+	return u
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (u *ReceiptUpsert) ClearConvertedTotal() *ReceiptUpsert {
+	u.SetNull(receipt.FieldConvertedTotal)
+	return u
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (u *ReceiptUpsert) SetConvertedCurrency(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldConvertedCurrency, v)
+	return u
+}
+
+// UpdateConvertedCurrency sets the "converted_currency" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateConvertedCurrency() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldConvertedCurrency)
+	return u
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (u *ReceiptUpsert) ClearConvertedCurrency() *ReceiptUpsert {
+	u.SetNull(receipt.FieldConvertedCurrency)
+	return u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (u *ReceiptUpsert) SetFxRate(v float64) *ReceiptUpsert {
+	u.Set(receipt.FieldFxRate, v)
+	return u
+}
+
+// UpdateFxRate sets the "fx_rate" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFxRate() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFxRate)
+	return u
+}
+
+// AddFxRate adds v to the "fx_rate" field.
+func (u *ReceiptUpsert) AddFxRate(v float64) *ReceiptUpsert {
+	u.Add(receipt.FieldFxRate, v)
+	return u
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (u *ReceiptUpsert) ClearFxRate() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFxRate)
+	return u
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (u *ReceiptUpsert) SetFxRateDate(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldFxRateDate, v)
+	return u
+}
+
+// UpdateFxRateDate sets the "fx_rate_date" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFxRateDate() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFxRateDate)
+	return u
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (u *ReceiptUpsert) ClearFxRateDate() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFxRateDate)
+	return u
+}
+
+// SetFxSource sets the "fx_source" field.
+func (u *ReceiptUpsert) SetFxSource(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldFxSource, v)
+	return u
+}
+
+// UpdateFxSource sets the "fx_source" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFxSource() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFxSource)
+	return u
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (u *ReceiptUpsert) ClearFxSource() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFxSource)
+	return u
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *ReceiptUpsert) SetCategoryName(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldCategoryName, v)
+	return u
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateCategoryName() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldCategoryName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ReceiptUpsert) SetDescription(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateDescription() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldDescription)
+	return u
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsert) SetFilePath(v string) *ReceiptUpsert {
+	u.Set(receipt.FieldFilePath, v)
+	return u
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateFilePath() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldFilePath)
+	return u
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsert) ClearFilePath() *ReceiptUpsert {
+	u.SetNull(receipt.FieldFilePath)
+	return u
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ReceiptUpsert) SetIsCurrent(v bool) *ReceiptUpsert {
+	u.Set(receipt.FieldIsCurrent, v)
+	return u
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateIsCurrent() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldIsCurrent)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReceiptUpsert) SetCreatedAt(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateCreatedAt() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsert) SetUpdatedAt(v time.Time) *ReceiptUpsert {
+	u.Set(receipt.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsert) UpdateUpdatedAt() *ReceiptUpsert {
+	u.SetExcluded(receipt.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptUpsertOne) UpdateNewValues() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receipt.FieldID)
+		}
+		if _, exists := u.create.mutation.TxDate(); exists {
+			s.SetIgnore(receipt.FieldTxDate)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptUpsertOne) Ignore() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptUpsertOne) DoNothing() *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptUpsertOne) Update(set func(*ReceiptUpsert)) *ReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptUpsertOne) SetProfileID(v uuid.UUID) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateProfileID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ReceiptUpsertOne) SetFileID(v uuid.UUID) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFileID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *ReceiptUpsertOne) ClearFileID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFileID()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsertOne) SetMerchantName(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMerchantName() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *ReceiptUpsertOne) SetMerchantID(v uuid.UUID) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantID(v)
+	})
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateMerchantID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantID()
+	})
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *ReceiptUpsertOne) ClearMerchantID() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantID()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *ReceiptUpsertOne) SetSubtotal(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateSubtotal() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// ClearSubtotal clears the value of the "subtotal" field.
+func (u *ReceiptUpsertOne) ClearSubtotal() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *ReceiptUpsertOne) SetTax(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateTax() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// ClearTax clears the value of the "tax" field.
+func (u *ReceiptUpsertOne) ClearTax() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTax()
+	})
+}
+
+// SetDiscount sets the "discount" field.
+func (u *ReceiptUpsertOne) SetDiscount(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetDiscount(v)
+	})
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateDiscount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateDiscount()
+	})
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (u *ReceiptUpsertOne) ClearDiscount() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearDiscount()
+	})
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (u *ReceiptUpsertOne) SetOtherFees(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOtherFees(v)
+	})
+}
+
+// UpdateOtherFees sets the "other_fees" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateOtherFees() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOtherFees()
+	})
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (u *ReceiptUpsertOne) ClearOtherFees() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOtherFees()
+	})
+}
+
+// SetTip sets the "tip" field.
+func (u *ReceiptUpsertOne) SetTip(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTip(v)
+	})
+}
+
+// UpdateTip sets the "tip" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateTip() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTip()
+	})
+}
+
+// ClearTip clears the value of the "tip" field.
+func (u *ReceiptUpsertOne) ClearTip() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTip()
+	})
+}
+
+// SetFees sets the "fees" field.
+func (u *ReceiptUpsertOne) SetFees(v json.RawMessage) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFees(v)
+	})
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFees() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFees()
+	})
+}
+
+// ClearFees clears the value of the "fees" field.
+func (u *ReceiptUpsertOne) ClearFees() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFees()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ReceiptUpsertOne) SetTotal(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateTotal() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *ReceiptUpsertOne) SetCurrencyCode(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCurrencyCode(v)
+	})
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateCurrencyCode() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCurrencyCode()
+	})
+}
+
+// SetConvertedTotal sets the "converted_total" field.
+func (u *ReceiptUpsertOne) SetConvertedTotal(v money.Amount) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetConvertedTotal(v)
+	})
+}
+
+// UpdateConvertedTotal sets the "converted_total" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateConvertedTotal() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateConvertedTotal()
+	})
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (u *ReceiptUpsertOne) ClearConvertedTotal() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearConvertedTotal()
+	})
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (u *ReceiptUpsertOne) SetConvertedCurrency(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetConvertedCurrency(v)
+	})
+}
+
+// UpdateConvertedCurrency sets the "converted_currency" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateConvertedCurrency() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateConvertedCurrency()
+	})
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (u *ReceiptUpsertOne) ClearConvertedCurrency() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearConvertedCurrency()
+	})
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (u *ReceiptUpsertOne) SetFxRate(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxRate(v)
+	})
+}
+
+// AddFxRate adds v to the "fx_rate" field.
+func (u *ReceiptUpsertOne) AddFxRate(v float64) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddFxRate(v)
+	})
+}
+
+// UpdateFxRate sets the "fx_rate" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFxRate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxRate()
+	})
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (u *ReceiptUpsertOne) ClearFxRate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxRate()
+	})
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (u *ReceiptUpsertOne) SetFxRateDate(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxRateDate(v)
+	})
+}
+
+// UpdateFxRateDate sets the "fx_rate_date" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFxRateDate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxRateDate()
+	})
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (u *ReceiptUpsertOne) ClearFxRateDate() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxRateDate()
+	})
+}
+
+// SetFxSource sets the "fx_source" field.
+func (u *ReceiptUpsertOne) SetFxSource(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxSource(v)
+	})
+}
+
+// UpdateFxSource sets the "fx_source" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFxSource() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxSource()
+	})
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (u *ReceiptUpsertOne) ClearFxSource() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxSource()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *ReceiptUpsertOne) SetCategoryName(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateCategoryName() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ReceiptUpsertOne) SetDescription(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateDescription() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsertOne) SetFilePath(v string) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateFilePath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsertOne) ClearFilePath() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFilePath()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ReceiptUpsertOne) SetIsCurrent(v bool) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateIsCurrent() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReceiptUpsertOne) SetCreatedAt(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateCreatedAt() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsertOne) SetUpdatedAt(v time.Time) *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsertOne) UpdateUpdatedAt() *ReceiptUpsertOne {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptUpsertOne.ID is not supported by MySQL driver. Use ReceiptUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiptCreateBulk is the builder for creating many Receipt entities in bulk.
+type ReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Receipt entities in the database.
+func (_c *ReceiptCreateBulk) Save(ctx context.Context) ([]*Receipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Receipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) SaveX(ctx context.Context) []*Receipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Receipt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptUpsertBulk {
+	_c.conflict = opts
+	return &ReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptCreateBulk) OnConflictColumns(columns ...string) *ReceiptUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReceiptUpsertBulk is the builder for "upsert"-ing
+// a bulk of Receipt nodes.
+type ReceiptUpsertBulk struct {
+	create *ReceiptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receipt.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptUpsertBulk) UpdateNewValues() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receipt.FieldID)
+			}
+			if _, exists := b.mutation.TxDate(); exists {
+				s.SetIgnore(receipt.FieldTxDate)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Receipt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptUpsertBulk) Ignore() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptUpsertBulk) DoNothing() *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptUpsertBulk) Update(set func(*ReceiptUpsert)) *ReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptUpsertBulk) SetProfileID(v uuid.UUID) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateProfileID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ReceiptUpsertBulk) SetFileID(v uuid.UUID) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFileID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (u *ReceiptUpsertBulk) ClearFileID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFileID()
+	})
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (u *ReceiptUpsertBulk) SetMerchantName(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantName(v)
+	})
+}
+
+// UpdateMerchantName sets the "merchant_name" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMerchantName() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantName()
+	})
+}
+
+// SetMerchantID sets the "merchant_id" field.
+func (u *ReceiptUpsertBulk) SetMerchantID(v uuid.UUID) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetMerchantID(v)
+	})
+}
+
+// UpdateMerchantID sets the "merchant_id" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateMerchantID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateMerchantID()
+	})
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (u *ReceiptUpsertBulk) ClearMerchantID() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearMerchantID()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *ReceiptUpsertBulk) SetSubtotal(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateSubtotal() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// ClearSubtotal clears the value of the "subtotal" field.
+func (u *ReceiptUpsertBulk) ClearSubtotal() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearSubtotal()
+	})
+}
+
+// SetTax sets the "tax" field.
+func (u *ReceiptUpsertBulk) SetTax(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTax(v)
+	})
+}
+
+// UpdateTax sets the "tax" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateTax() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTax()
+	})
+}
+
+// ClearTax clears the value of the "tax" field.
+func (u *ReceiptUpsertBulk) ClearTax() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTax()
+	})
+}
+
+// SetDiscount sets the "discount" field.
+func (u *ReceiptUpsertBulk) SetDiscount(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetDiscount(v)
+	})
+}
+
+// UpdateDiscount sets the "discount" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateDiscount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateDiscount()
+	})
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (u *ReceiptUpsertBulk) ClearDiscount() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearDiscount()
+	})
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (u *ReceiptUpsertBulk) SetOtherFees(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetOtherFees(v)
+	})
+}
+
+// UpdateOtherFees sets the "other_fees" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateOtherFees() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateOtherFees()
+	})
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (u *ReceiptUpsertBulk) ClearOtherFees() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearOtherFees()
+	})
+}
+
+// SetTip sets the "tip" field.
+func (u *ReceiptUpsertBulk) SetTip(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTip(v)
+	})
+}
+
+// UpdateTip sets the "tip" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateTip() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTip()
+	})
+}
+
+// ClearTip clears the value of the "tip" field.
+func (u *ReceiptUpsertBulk) ClearTip() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearTip()
+	})
+}
+
+// SetFees sets the "fees" field.
+func (u *ReceiptUpsertBulk) SetFees(v json.RawMessage) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFees(v)
+	})
+}
+
+// UpdateFees sets the "fees" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFees() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFees()
+	})
+}
+
+// ClearFees clears the value of the "fees" field.
+func (u *ReceiptUpsertBulk) ClearFees() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFees()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *ReceiptUpsertBulk) SetTotal(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateTotal() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *ReceiptUpsertBulk) SetCurrencyCode(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCurrencyCode(v)
+	})
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateCurrencyCode() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCurrencyCode()
+	})
+}
+
+// SetConvertedTotal sets the "converted_total" field.
+func (u *ReceiptUpsertBulk) SetConvertedTotal(v money.Amount) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetConvertedTotal(v)
+	})
+}
+
+// UpdateConvertedTotal sets the "converted_total" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateConvertedTotal() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateConvertedTotal()
+	})
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (u *ReceiptUpsertBulk) ClearConvertedTotal() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearConvertedTotal()
+	})
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (u *ReceiptUpsertBulk) SetConvertedCurrency(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetConvertedCurrency(v)
+	})
+}
+
+// UpdateConvertedCurrency sets the "converted_currency" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateConvertedCurrency() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateConvertedCurrency()
+	})
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (u *ReceiptUpsertBulk) ClearConvertedCurrency() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearConvertedCurrency()
+	})
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (u *ReceiptUpsertBulk) SetFxRate(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxRate(v)
+	})
+}
+
+// AddFxRate adds v to the "fx_rate" field.
+func (u *ReceiptUpsertBulk) AddFxRate(v float64) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.AddFxRate(v)
+	})
+}
+
+// UpdateFxRate sets the "fx_rate" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFxRate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxRate()
+	})
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (u *ReceiptUpsertBulk) ClearFxRate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxRate()
+	})
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (u *ReceiptUpsertBulk) SetFxRateDate(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxRateDate(v)
+	})
+}
+
+// UpdateFxRateDate sets the "fx_rate_date" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFxRateDate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxRateDate()
+	})
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (u *ReceiptUpsertBulk) ClearFxRateDate() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxRateDate()
+	})
+}
+
+// SetFxSource sets the "fx_source" field.
+func (u *ReceiptUpsertBulk) SetFxSource(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFxSource(v)
+	})
+}
+
+// UpdateFxSource sets the "fx_source" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFxSource() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFxSource()
+	})
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (u *ReceiptUpsertBulk) ClearFxSource() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFxSource()
+	})
+}
+
+// SetCategoryName sets the "category_name" field.
+func (u *ReceiptUpsertBulk) SetCategoryName(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCategoryName(v)
+	})
+}
+
+// UpdateCategoryName sets the "category_name" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateCategoryName() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCategoryName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ReceiptUpsertBulk) SetDescription(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateDescription() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ReceiptUpsertBulk) SetFilePath(v string) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateFilePath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// ClearFilePath clears the value of the "file_path" field.
+func (u *ReceiptUpsertBulk) ClearFilePath() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.ClearFilePath()
+	})
+}
+
+// SetIsCurrent sets the "is_current" field.
+func (u *ReceiptUpsertBulk) SetIsCurrent(v bool) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetIsCurrent(v)
+	})
+}
+
+// UpdateIsCurrent sets the "is_current" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateIsCurrent() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateIsCurrent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReceiptUpsertBulk) SetCreatedAt(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateCreatedAt() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReceiptUpsertBulk) SetUpdatedAt(v time.Time) *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReceiptUpsertBulk) UpdateUpdatedAt() *ReceiptUpsertBulk {
+	return u.Update(func(s *ReceiptUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
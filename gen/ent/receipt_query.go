@@ -14,22 +14,27 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
 )
 
 // ReceiptQuery is the builder for querying Receipt entities.
 type ReceiptQuery struct {
 	config
-	ctx         *QueryContext
-	order       []receipt.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Receipt
-	withProfile *ProfileQuery
-	withFiles   *ReceiptFileQuery
-	withJobs    *ExtractJobQuery
+	ctx           *QueryContext
+	order         []receipt.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Receipt
+	withProfile   *ProfileQuery
+	withMerchant  *MerchantQuery
+	withFiles     *ReceiptFileQuery
+	withJobs      *ExtractJobQuery
+	withAnomalies *ReceiptAnomalyQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +93,28 @@ func (_q *ReceiptQuery) QueryProfile() *ProfileQuery {
 	return query
 }
 
+// QueryMerchant chains the current query on the "merchant" edge.
+func (_q *ReceiptQuery) QueryMerchant() *MerchantQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, selector),
+			sqlgraph.To(merchant.Table, merchant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receipt.MerchantTable, receipt.MerchantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFiles chains the current query on the "files" edge.
 func (_q *ReceiptQuery) QueryFiles() *ReceiptFileQuery {
 	query := (&ReceiptFileClient{config: _q.config}).Query()
@@ -132,6 +159,28 @@ func (_q *ReceiptQuery) QueryJobs() *ExtractJobQuery {
 	return query
 }
 
+// QueryAnomalies chains the current query on the "anomalies" edge.
+func (_q *ReceiptQuery) QueryAnomalies() *ReceiptAnomalyQuery {
+	query := (&ReceiptAnomalyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, selector),
+			sqlgraph.To(receiptanomaly.Table, receiptanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, receipt.AnomaliesTable, receipt.AnomaliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Receipt entity from the query.
 // Returns a *NotFoundError when no Receipt was found.
 func (_q *ReceiptQuery) First(ctx context.Context) (*Receipt, error) {
@@ -319,17 +368,20 @@ func (_q *ReceiptQuery) Clone() *ReceiptQuery {
 		return nil
 	}
 	return &ReceiptQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]receipt.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Receipt{}, _q.predicates...),
-		withProfile: _q.withProfile.Clone(),
-		withFiles:   _q.withFiles.Clone(),
-		withJobs:    _q.withJobs.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]receipt.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Receipt{}, _q.predicates...),
+		withProfile:   _q.withProfile.Clone(),
+		withMerchant:  _q.withMerchant.Clone(),
+		withFiles:     _q.withFiles.Clone(),
+		withJobs:      _q.withJobs.Clone(),
+		withAnomalies: _q.withAnomalies.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -344,6 +396,17 @@ func (_q *ReceiptQuery) WithProfile(opts ...func(*ProfileQuery)) *ReceiptQuery {
 	return _q
 }
 
+// WithMerchant tells the query-builder to eager-load the nodes that are connected to
+// the "merchant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReceiptQuery) WithMerchant(opts ...func(*MerchantQuery)) *ReceiptQuery {
+	query := (&MerchantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMerchant = query
+	return _q
+}
+
 // WithFiles tells the query-builder to eager-load the nodes that are connected to
 // the "files" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ReceiptQuery) WithFiles(opts ...func(*ReceiptFileQuery)) *ReceiptQuery {
@@ -366,6 +429,17 @@ func (_q *ReceiptQuery) WithJobs(opts ...func(*ExtractJobQuery)) *ReceiptQuery {
 	return _q
 }
 
+// WithAnomalies tells the query-builder to eager-load the nodes that are connected to
+// the "anomalies" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReceiptQuery) WithAnomalies(opts ...func(*ReceiptAnomalyQuery)) *ReceiptQuery {
+	query := (&ReceiptAnomalyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnomalies = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +518,12 @@ func (_q *ReceiptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rece
 	var (
 		nodes       = []*Receipt{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withProfile != nil,
+			_q.withMerchant != nil,
 			_q.withFiles != nil,
 			_q.withJobs != nil,
+			_q.withAnomalies != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -459,6 +535,9 @@ func (_q *ReceiptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rece
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -474,6 +553,12 @@ func (_q *ReceiptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rece
 			return nil, err
 		}
 	}
+	if query := _q.withMerchant; query != nil {
+		if err := _q.loadMerchant(ctx, query, nodes, nil,
+			func(n *Receipt, e *Merchant) { n.Edges.Merchant = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withFiles; query != nil {
 		if err := _q.loadFiles(ctx, query, nodes,
 			func(n *Receipt) { n.Edges.Files = []*ReceiptFile{} },
@@ -488,6 +573,13 @@ func (_q *ReceiptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rece
 			return nil, err
 		}
 	}
+	if query := _q.withAnomalies; query != nil {
+		if err := _q.loadAnomalies(ctx, query, nodes,
+			func(n *Receipt) { n.Edges.Anomalies = []*ReceiptAnomaly{} },
+			func(n *Receipt, e *ReceiptAnomaly) { n.Edges.Anomalies = append(n.Edges.Anomalies, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -520,6 +612,38 @@ func (_q *ReceiptQuery) loadProfile(ctx context.Context, query *ProfileQuery, no
 	}
 	return nil
 }
+func (_q *ReceiptQuery) loadMerchant(ctx context.Context, query *MerchantQuery, nodes []*Receipt, init func(*Receipt), assign func(*Receipt, *Merchant)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Receipt)
+	for i := range nodes {
+		if nodes[i].MerchantID == nil {
+			continue
+		}
+		fk := *nodes[i].MerchantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(merchant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "merchant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *ReceiptQuery) loadFiles(ctx context.Context, query *ReceiptFileQuery, nodes []*Receipt, init func(*Receipt), assign func(*Receipt, *ReceiptFile)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Receipt)
@@ -584,9 +708,42 @@ func (_q *ReceiptQuery) loadJobs(ctx context.Context, query *ExtractJobQuery, no
 	}
 	return nil
 }
+func (_q *ReceiptQuery) loadAnomalies(ctx context.Context, query *ReceiptAnomalyQuery, nodes []*Receipt, init func(*Receipt), assign func(*Receipt, *ReceiptAnomaly)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Receipt)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(receiptanomaly.FieldReceiptID)
+	}
+	query.Where(predicate.ReceiptAnomaly(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(receipt.AnomaliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReceiptID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "receipt_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ReceiptQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -613,6 +770,9 @@ func (_q *ReceiptQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withProfile != nil {
 			_spec.Node.AddColumnOnce(receipt.FieldProfileID)
 		}
+		if _q.withMerchant != nil {
+			_spec.Node.AddColumnOnce(receipt.FieldMerchantID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -652,6 +812,9 @@ func (_q *ReceiptQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -669,6 +832,12 @@ func (_q *ReceiptQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ReceiptQuery) Modify(modifiers ...func(s *sql.Selector)) *ReceiptSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ReceiptGroupBy is the group-by builder for Receipt entities.
 type ReceiptGroupBy struct {
 	selector
@@ -758,3 +927,9 @@ func (_s *ReceiptSelect) sqlScan(ctx context.Context, root *ReceiptQuery, v any)
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ReceiptSelect) Modify(modifiers ...func(s *sql.Selector)) *ReceiptSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -4,26 +4,32 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 // ReceiptUpdate is the builder for updating Receipt entities.
 type ReceiptUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ReceiptMutation
+	hooks     []Hook
+	mutation  *ReceiptMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ReceiptUpdate builder.
@@ -80,27 +86,40 @@ func (_u *ReceiptUpdate) SetNillableMerchantName(v *string) *ReceiptUpdate {
 	return _u
 }
 
+// SetMerchantID sets the "merchant_id" field.
+func (_u *ReceiptUpdate) SetMerchantID(v uuid.UUID) *ReceiptUpdate {
+	_u.mutation.SetMerchantID(v)
+	return _u
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableMerchantID(v *uuid.UUID) *ReceiptUpdate {
+	if v != nil {
+		_u.SetMerchantID(*v)
+	}
+	return _u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (_u *ReceiptUpdate) ClearMerchantID() *ReceiptUpdate {
+	_u.mutation.ClearMerchantID()
+	return _u
+}
+
 // SetSubtotal sets the "subtotal" field.
-func (_u *ReceiptUpdate) SetSubtotal(v float64) *ReceiptUpdate {
-	_u.mutation.ResetSubtotal()
+func (_u *ReceiptUpdate) SetSubtotal(v money.Amount) *ReceiptUpdate {
 	_u.mutation.SetSubtotal(v)
 	return _u
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (_u *ReceiptUpdate) SetNillableSubtotal(v *float64) *ReceiptUpdate {
+func (_u *ReceiptUpdate) SetNillableSubtotal(v *money.Amount) *ReceiptUpdate {
 	if v != nil {
 		_u.SetSubtotal(*v)
 	}
 	return _u
 }
 
-// AddSubtotal adds value to the "subtotal" field.
-func (_u *ReceiptUpdate) AddSubtotal(v float64) *ReceiptUpdate {
-	_u.mutation.AddSubtotal(v)
-	return _u
-}
-
 // ClearSubtotal clears the value of the "subtotal" field.
 func (_u *ReceiptUpdate) ClearSubtotal() *ReceiptUpdate {
 	_u.mutation.ClearSubtotal()
@@ -108,53 +127,117 @@ func (_u *ReceiptUpdate) ClearSubtotal() *ReceiptUpdate {
 }
 
 // SetTax sets the "tax" field.
-func (_u *ReceiptUpdate) SetTax(v float64) *ReceiptUpdate {
-	_u.mutation.ResetTax()
+func (_u *ReceiptUpdate) SetTax(v money.Amount) *ReceiptUpdate {
 	_u.mutation.SetTax(v)
 	return _u
 }
 
 // SetNillableTax sets the "tax" field if the given value is not nil.
-func (_u *ReceiptUpdate) SetNillableTax(v *float64) *ReceiptUpdate {
+func (_u *ReceiptUpdate) SetNillableTax(v *money.Amount) *ReceiptUpdate {
 	if v != nil {
 		_u.SetTax(*v)
 	}
 	return _u
 }
 
-// AddTax adds value to the "tax" field.
-func (_u *ReceiptUpdate) AddTax(v float64) *ReceiptUpdate {
-	_u.mutation.AddTax(v)
-	return _u
-}
-
 // ClearTax clears the value of the "tax" field.
 func (_u *ReceiptUpdate) ClearTax() *ReceiptUpdate {
 	_u.mutation.ClearTax()
 	return _u
 }
 
+// SetDiscount sets the "discount" field.
+func (_u *ReceiptUpdate) SetDiscount(v money.Amount) *ReceiptUpdate {
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableDiscount(v *money.Amount) *ReceiptUpdate {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (_u *ReceiptUpdate) ClearDiscount() *ReceiptUpdate {
+	_u.mutation.ClearDiscount()
+	return _u
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (_u *ReceiptUpdate) SetOtherFees(v money.Amount) *ReceiptUpdate {
+	_u.mutation.SetOtherFees(v)
+	return _u
+}
+
+// SetNillableOtherFees sets the "other_fees" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableOtherFees(v *money.Amount) *ReceiptUpdate {
+	if v != nil {
+		_u.SetOtherFees(*v)
+	}
+	return _u
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (_u *ReceiptUpdate) ClearOtherFees() *ReceiptUpdate {
+	_u.mutation.ClearOtherFees()
+	return _u
+}
+
+// SetTip sets the "tip" field.
+func (_u *ReceiptUpdate) SetTip(v money.Amount) *ReceiptUpdate {
+	_u.mutation.SetTip(v)
+	return _u
+}
+
+// SetNillableTip sets the "tip" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableTip(v *money.Amount) *ReceiptUpdate {
+	if v != nil {
+		_u.SetTip(*v)
+	}
+	return _u
+}
+
+// ClearTip clears the value of the "tip" field.
+func (_u *ReceiptUpdate) ClearTip() *ReceiptUpdate {
+	_u.mutation.ClearTip()
+	return _u
+}
+
+// SetFees sets the "fees" field.
+func (_u *ReceiptUpdate) SetFees(v json.RawMessage) *ReceiptUpdate {
+	_u.mutation.SetFees(v)
+	return _u
+}
+
+// AppendFees appends value to the "fees" field.
+func (_u *ReceiptUpdate) AppendFees(v json.RawMessage) *ReceiptUpdate {
+	_u.mutation.AppendFees(v)
+	return _u
+}
+
+// ClearFees clears the value of the "fees" field.
+func (_u *ReceiptUpdate) ClearFees() *ReceiptUpdate {
+	_u.mutation.ClearFees()
+	return _u
+}
+
 // SetTotal sets the "total" field.
-func (_u *ReceiptUpdate) SetTotal(v float64) *ReceiptUpdate {
-	_u.mutation.ResetTotal()
+func (_u *ReceiptUpdate) SetTotal(v money.Amount) *ReceiptUpdate {
 	_u.mutation.SetTotal(v)
 	return _u
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (_u *ReceiptUpdate) SetNillableTotal(v *float64) *ReceiptUpdate {
+func (_u *ReceiptUpdate) SetNillableTotal(v *money.Amount) *ReceiptUpdate {
 	if v != nil {
 		_u.SetTotal(*v)
 	}
 	return _u
 }
 
-// AddTotal adds value to the "total" field.
-func (_u *ReceiptUpdate) AddTotal(v float64) *ReceiptUpdate {
-	_u.mutation.AddTotal(v)
-	return _u
-}
-
 // SetCurrencyCode sets the "currency_code" field.
 func (_u *ReceiptUpdate) SetCurrencyCode(v string) *ReceiptUpdate {
 	_u.mutation.SetCurrencyCode(v)
@@ -169,6 +252,113 @@ func (_u *ReceiptUpdate) SetNillableCurrencyCode(v *string) *ReceiptUpdate {
 	return _u
 }
 
+// SetConvertedTotal sets the "converted_total" field.
+func (_u *ReceiptUpdate) SetConvertedTotal(v money.Amount) *ReceiptUpdate {
+	_u.mutation.SetConvertedTotal(v)
+	return _u
+}
+
+// SetNillableConvertedTotal sets the "converted_total" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableConvertedTotal(v *money.Amount) *ReceiptUpdate {
+	if v != nil {
+		_u.SetConvertedTotal(*v)
+	}
+	return _u
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (_u *ReceiptUpdate) ClearConvertedTotal() *ReceiptUpdate {
+	_u.mutation.ClearConvertedTotal()
+	return _u
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (_u *ReceiptUpdate) SetConvertedCurrency(v string) *ReceiptUpdate {
+	_u.mutation.SetConvertedCurrency(v)
+	return _u
+}
+
+// SetNillableConvertedCurrency sets the "converted_currency" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableConvertedCurrency(v *string) *ReceiptUpdate {
+	if v != nil {
+		_u.SetConvertedCurrency(*v)
+	}
+	return _u
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (_u *ReceiptUpdate) ClearConvertedCurrency() *ReceiptUpdate {
+	_u.mutation.ClearConvertedCurrency()
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *ReceiptUpdate) SetFxRate(v float64) *ReceiptUpdate {
+	_u.mutation.ResetFxRate()
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableFxRate(v *float64) *ReceiptUpdate {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// AddFxRate adds value to the "fx_rate" field.
+func (_u *ReceiptUpdate) AddFxRate(v float64) *ReceiptUpdate {
+	_u.mutation.AddFxRate(v)
+	return _u
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (_u *ReceiptUpdate) ClearFxRate() *ReceiptUpdate {
+	_u.mutation.ClearFxRate()
+	return _u
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (_u *ReceiptUpdate) SetFxRateDate(v time.Time) *ReceiptUpdate {
+	_u.mutation.SetFxRateDate(v)
+	return _u
+}
+
+// SetNillableFxRateDate sets the "fx_rate_date" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableFxRateDate(v *time.Time) *ReceiptUpdate {
+	if v != nil {
+		_u.SetFxRateDate(*v)
+	}
+	return _u
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (_u *ReceiptUpdate) ClearFxRateDate() *ReceiptUpdate {
+	_u.mutation.ClearFxRateDate()
+	return _u
+}
+
+// SetFxSource sets the "fx_source" field.
+func (_u *ReceiptUpdate) SetFxSource(v string) *ReceiptUpdate {
+	_u.mutation.SetFxSource(v)
+	return _u
+}
+
+// SetNillableFxSource sets the "fx_source" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableFxSource(v *string) *ReceiptUpdate {
+	if v != nil {
+		_u.SetFxSource(*v)
+	}
+	return _u
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (_u *ReceiptUpdate) ClearFxSource() *ReceiptUpdate {
+	_u.mutation.ClearFxSource()
+	return _u
+}
+
 // SetCategoryName sets the "category_name" field.
 func (_u *ReceiptUpdate) SetCategoryName(v string) *ReceiptUpdate {
 	_u.mutation.SetCategoryName(v)
@@ -256,6 +446,11 @@ func (_u *ReceiptUpdate) SetProfile(v *Profile) *ReceiptUpdate {
 	return _u.SetProfileID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_u *ReceiptUpdate) SetMerchant(v *Merchant) *ReceiptUpdate {
+	return _u.SetMerchantID(v.ID)
+}
+
 // AddFileIDs adds the "files" edge to the ReceiptFile entity by IDs.
 func (_u *ReceiptUpdate) AddFileIDs(ids ...uuid.UUID) *ReceiptUpdate {
 	_u.mutation.AddFileIDs(ids...)
@@ -286,6 +481,21 @@ func (_u *ReceiptUpdate) AddJobs(v ...*ExtractJob) *ReceiptUpdate {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddAnomalyIDs adds the "anomalies" edge to the ReceiptAnomaly entity by IDs.
+func (_u *ReceiptUpdate) AddAnomalyIDs(ids ...uuid.UUID) *ReceiptUpdate {
+	_u.mutation.AddAnomalyIDs(ids...)
+	return _u
+}
+
+// AddAnomalies adds the "anomalies" edges to the ReceiptAnomaly entity.
+func (_u *ReceiptUpdate) AddAnomalies(v ...*ReceiptAnomaly) *ReceiptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnomalyIDs(ids...)
+}
+
 // Mutation returns the ReceiptMutation object of the builder.
 func (_u *ReceiptUpdate) Mutation() *ReceiptMutation {
 	return _u.mutation
@@ -297,6 +507,12 @@ func (_u *ReceiptUpdate) ClearProfile() *ReceiptUpdate {
 	return _u
 }
 
+// ClearMerchant clears the "merchant" edge to the Merchant entity.
+func (_u *ReceiptUpdate) ClearMerchant() *ReceiptUpdate {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
 // ClearFiles clears all "files" edges to the ReceiptFile entity.
 func (_u *ReceiptUpdate) ClearFiles() *ReceiptUpdate {
 	_u.mutation.ClearFiles()
@@ -339,6 +555,27 @@ func (_u *ReceiptUpdate) RemoveJobs(v ...*ExtractJob) *ReceiptUpdate {
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearAnomalies clears all "anomalies" edges to the ReceiptAnomaly entity.
+func (_u *ReceiptUpdate) ClearAnomalies() *ReceiptUpdate {
+	_u.mutation.ClearAnomalies()
+	return _u
+}
+
+// RemoveAnomalyIDs removes the "anomalies" edge to ReceiptAnomaly entities by IDs.
+func (_u *ReceiptUpdate) RemoveAnomalyIDs(ids ...uuid.UUID) *ReceiptUpdate {
+	_u.mutation.RemoveAnomalyIDs(ids...)
+	return _u
+}
+
+// RemoveAnomalies removes "anomalies" edges to ReceiptAnomaly entities.
+func (_u *ReceiptUpdate) RemoveAnomalies(v ...*ReceiptAnomaly) *ReceiptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnomalyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ReceiptUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -398,6 +635,12 @@ func (_u *ReceiptUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -420,32 +663,85 @@ func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.SetField(receipt.FieldMerchantName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Subtotal(); ok {
-		_spec.SetField(receipt.FieldSubtotal, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedSubtotal(); ok {
-		_spec.AddField(receipt.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldSubtotal, field.TypeOther, value)
 	}
 	if _u.mutation.SubtotalCleared() {
-		_spec.ClearField(receipt.FieldSubtotal, field.TypeFloat64)
+		_spec.ClearField(receipt.FieldSubtotal, field.TypeOther)
 	}
 	if value, ok := _u.mutation.Tax(); ok {
-		_spec.SetField(receipt.FieldTax, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedTax(); ok {
-		_spec.AddField(receipt.FieldTax, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldTax, field.TypeOther, value)
 	}
 	if _u.mutation.TaxCleared() {
-		_spec.ClearField(receipt.FieldTax, field.TypeFloat64)
+		_spec.ClearField(receipt.FieldTax, field.TypeOther)
 	}
-	if value, ok := _u.mutation.Total(); ok {
-		_spec.SetField(receipt.FieldTotal, field.TypeFloat64, value)
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(receipt.FieldDiscount, field.TypeOther, value)
+	}
+	if _u.mutation.DiscountCleared() {
+		_spec.ClearField(receipt.FieldDiscount, field.TypeOther)
+	}
+	if value, ok := _u.mutation.OtherFees(); ok {
+		_spec.SetField(receipt.FieldOtherFees, field.TypeOther, value)
+	}
+	if _u.mutation.OtherFeesCleared() {
+		_spec.ClearField(receipt.FieldOtherFees, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Tip(); ok {
+		_spec.SetField(receipt.FieldTip, field.TypeOther, value)
 	}
-	if value, ok := _u.mutation.AddedTotal(); ok {
-		_spec.AddField(receipt.FieldTotal, field.TypeFloat64, value)
+	if _u.mutation.TipCleared() {
+		_spec.ClearField(receipt.FieldTip, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Fees(); ok {
+		_spec.SetField(receipt.FieldFees, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFees(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, receipt.FieldFees, value)
+		})
+	}
+	if _u.mutation.FeesCleared() {
+		_spec.ClearField(receipt.FieldFees, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(receipt.FieldTotal, field.TypeOther, value)
 	}
 	if value, ok := _u.mutation.CurrencyCode(); ok {
 		_spec.SetField(receipt.FieldCurrencyCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ConvertedTotal(); ok {
+		_spec.SetField(receipt.FieldConvertedTotal, field.TypeOther, value)
+	}
+	if _u.mutation.ConvertedTotalCleared() {
+		_spec.ClearField(receipt.FieldConvertedTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ConvertedCurrency(); ok {
+		_spec.SetField(receipt.FieldConvertedCurrency, field.TypeString, value)
+	}
+	if _u.mutation.ConvertedCurrencyCleared() {
+		_spec.ClearField(receipt.FieldConvertedCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(receipt.FieldFxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFxRate(); ok {
+		_spec.AddField(receipt.FieldFxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.FxRateCleared() {
+		_spec.ClearField(receipt.FieldFxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FxRateDate(); ok {
+		_spec.SetField(receipt.FieldFxRateDate, field.TypeTime, value)
+	}
+	if _u.mutation.FxRateDateCleared() {
+		_spec.ClearField(receipt.FieldFxRateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FxSource(); ok {
+		_spec.SetField(receipt.FieldFxSource, field.TypeString, value)
+	}
+	if _u.mutation.FxSourceCleared() {
+		_spec.ClearField(receipt.FieldFxSource, field.TypeString)
+	}
 	if value, ok := _u.mutation.CategoryName(); ok {
 		_spec.SetField(receipt.FieldCategoryName, field.TypeString, value)
 	}
@@ -496,6 +792,35 @@ func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MerchantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.MerchantTable,
+			Columns: []string{receipt.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.MerchantTable,
+			Columns: []string{receipt.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -586,6 +911,52 @@ func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnomaliesIDs(); len(nodes) > 0 && !_u.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{receipt.Label}
@@ -601,9 +972,10 @@ func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ReceiptUpdateOne is the builder for updating a single Receipt entity.
 type ReceiptUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ReceiptMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ReceiptMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetProfileID sets the "profile_id" field.
@@ -654,27 +1026,40 @@ func (_u *ReceiptUpdateOne) SetNillableMerchantName(v *string) *ReceiptUpdateOne
 	return _u
 }
 
+// SetMerchantID sets the "merchant_id" field.
+func (_u *ReceiptUpdateOne) SetMerchantID(v uuid.UUID) *ReceiptUpdateOne {
+	_u.mutation.SetMerchantID(v)
+	return _u
+}
+
+// SetNillableMerchantID sets the "merchant_id" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableMerchantID(v *uuid.UUID) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetMerchantID(*v)
+	}
+	return _u
+}
+
+// ClearMerchantID clears the value of the "merchant_id" field.
+func (_u *ReceiptUpdateOne) ClearMerchantID() *ReceiptUpdateOne {
+	_u.mutation.ClearMerchantID()
+	return _u
+}
+
 // SetSubtotal sets the "subtotal" field.
-func (_u *ReceiptUpdateOne) SetSubtotal(v float64) *ReceiptUpdateOne {
-	_u.mutation.ResetSubtotal()
+func (_u *ReceiptUpdateOne) SetSubtotal(v money.Amount) *ReceiptUpdateOne {
 	_u.mutation.SetSubtotal(v)
 	return _u
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (_u *ReceiptUpdateOne) SetNillableSubtotal(v *float64) *ReceiptUpdateOne {
+func (_u *ReceiptUpdateOne) SetNillableSubtotal(v *money.Amount) *ReceiptUpdateOne {
 	if v != nil {
 		_u.SetSubtotal(*v)
 	}
 	return _u
 }
 
-// AddSubtotal adds value to the "subtotal" field.
-func (_u *ReceiptUpdateOne) AddSubtotal(v float64) *ReceiptUpdateOne {
-	_u.mutation.AddSubtotal(v)
-	return _u
-}
-
 // ClearSubtotal clears the value of the "subtotal" field.
 func (_u *ReceiptUpdateOne) ClearSubtotal() *ReceiptUpdateOne {
 	_u.mutation.ClearSubtotal()
@@ -682,53 +1067,117 @@ func (_u *ReceiptUpdateOne) ClearSubtotal() *ReceiptUpdateOne {
 }
 
 // SetTax sets the "tax" field.
-func (_u *ReceiptUpdateOne) SetTax(v float64) *ReceiptUpdateOne {
-	_u.mutation.ResetTax()
+func (_u *ReceiptUpdateOne) SetTax(v money.Amount) *ReceiptUpdateOne {
 	_u.mutation.SetTax(v)
 	return _u
 }
 
 // SetNillableTax sets the "tax" field if the given value is not nil.
-func (_u *ReceiptUpdateOne) SetNillableTax(v *float64) *ReceiptUpdateOne {
+func (_u *ReceiptUpdateOne) SetNillableTax(v *money.Amount) *ReceiptUpdateOne {
 	if v != nil {
 		_u.SetTax(*v)
 	}
 	return _u
 }
 
-// AddTax adds value to the "tax" field.
-func (_u *ReceiptUpdateOne) AddTax(v float64) *ReceiptUpdateOne {
-	_u.mutation.AddTax(v)
-	return _u
-}
-
 // ClearTax clears the value of the "tax" field.
 func (_u *ReceiptUpdateOne) ClearTax() *ReceiptUpdateOne {
 	_u.mutation.ClearTax()
 	return _u
 }
 
+// SetDiscount sets the "discount" field.
+func (_u *ReceiptUpdateOne) SetDiscount(v money.Amount) *ReceiptUpdateOne {
+	_u.mutation.SetDiscount(v)
+	return _u
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableDiscount(v *money.Amount) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetDiscount(*v)
+	}
+	return _u
+}
+
+// ClearDiscount clears the value of the "discount" field.
+func (_u *ReceiptUpdateOne) ClearDiscount() *ReceiptUpdateOne {
+	_u.mutation.ClearDiscount()
+	return _u
+}
+
+// SetOtherFees sets the "other_fees" field.
+func (_u *ReceiptUpdateOne) SetOtherFees(v money.Amount) *ReceiptUpdateOne {
+	_u.mutation.SetOtherFees(v)
+	return _u
+}
+
+// SetNillableOtherFees sets the "other_fees" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableOtherFees(v *money.Amount) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetOtherFees(*v)
+	}
+	return _u
+}
+
+// ClearOtherFees clears the value of the "other_fees" field.
+func (_u *ReceiptUpdateOne) ClearOtherFees() *ReceiptUpdateOne {
+	_u.mutation.ClearOtherFees()
+	return _u
+}
+
+// SetTip sets the "tip" field.
+func (_u *ReceiptUpdateOne) SetTip(v money.Amount) *ReceiptUpdateOne {
+	_u.mutation.SetTip(v)
+	return _u
+}
+
+// SetNillableTip sets the "tip" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableTip(v *money.Amount) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetTip(*v)
+	}
+	return _u
+}
+
+// ClearTip clears the value of the "tip" field.
+func (_u *ReceiptUpdateOne) ClearTip() *ReceiptUpdateOne {
+	_u.mutation.ClearTip()
+	return _u
+}
+
+// SetFees sets the "fees" field.
+func (_u *ReceiptUpdateOne) SetFees(v json.RawMessage) *ReceiptUpdateOne {
+	_u.mutation.SetFees(v)
+	return _u
+}
+
+// AppendFees appends value to the "fees" field.
+func (_u *ReceiptUpdateOne) AppendFees(v json.RawMessage) *ReceiptUpdateOne {
+	_u.mutation.AppendFees(v)
+	return _u
+}
+
+// ClearFees clears the value of the "fees" field.
+func (_u *ReceiptUpdateOne) ClearFees() *ReceiptUpdateOne {
+	_u.mutation.ClearFees()
+	return _u
+}
+
 // SetTotal sets the "total" field.
-func (_u *ReceiptUpdateOne) SetTotal(v float64) *ReceiptUpdateOne {
-	_u.mutation.ResetTotal()
+func (_u *ReceiptUpdateOne) SetTotal(v money.Amount) *ReceiptUpdateOne {
 	_u.mutation.SetTotal(v)
 	return _u
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (_u *ReceiptUpdateOne) SetNillableTotal(v *float64) *ReceiptUpdateOne {
+func (_u *ReceiptUpdateOne) SetNillableTotal(v *money.Amount) *ReceiptUpdateOne {
 	if v != nil {
 		_u.SetTotal(*v)
 	}
 	return _u
 }
 
-// AddTotal adds value to the "total" field.
-func (_u *ReceiptUpdateOne) AddTotal(v float64) *ReceiptUpdateOne {
-	_u.mutation.AddTotal(v)
-	return _u
-}
-
 // SetCurrencyCode sets the "currency_code" field.
 func (_u *ReceiptUpdateOne) SetCurrencyCode(v string) *ReceiptUpdateOne {
 	_u.mutation.SetCurrencyCode(v)
@@ -743,6 +1192,113 @@ func (_u *ReceiptUpdateOne) SetNillableCurrencyCode(v *string) *ReceiptUpdateOne
 	return _u
 }
 
+// SetConvertedTotal sets the "converted_total" field.
+func (_u *ReceiptUpdateOne) SetConvertedTotal(v money.Amount) *ReceiptUpdateOne {
+	_u.mutation.SetConvertedTotal(v)
+	return _u
+}
+
+// SetNillableConvertedTotal sets the "converted_total" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableConvertedTotal(v *money.Amount) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetConvertedTotal(*v)
+	}
+	return _u
+}
+
+// ClearConvertedTotal clears the value of the "converted_total" field.
+func (_u *ReceiptUpdateOne) ClearConvertedTotal() *ReceiptUpdateOne {
+	_u.mutation.ClearConvertedTotal()
+	return _u
+}
+
+// SetConvertedCurrency sets the "converted_currency" field.
+func (_u *ReceiptUpdateOne) SetConvertedCurrency(v string) *ReceiptUpdateOne {
+	_u.mutation.SetConvertedCurrency(v)
+	return _u
+}
+
+// SetNillableConvertedCurrency sets the "converted_currency" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableConvertedCurrency(v *string) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetConvertedCurrency(*v)
+	}
+	return _u
+}
+
+// ClearConvertedCurrency clears the value of the "converted_currency" field.
+func (_u *ReceiptUpdateOne) ClearConvertedCurrency() *ReceiptUpdateOne {
+	_u.mutation.ClearConvertedCurrency()
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *ReceiptUpdateOne) SetFxRate(v float64) *ReceiptUpdateOne {
+	_u.mutation.ResetFxRate()
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableFxRate(v *float64) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// AddFxRate adds value to the "fx_rate" field.
+func (_u *ReceiptUpdateOne) AddFxRate(v float64) *ReceiptUpdateOne {
+	_u.mutation.AddFxRate(v)
+	return _u
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (_u *ReceiptUpdateOne) ClearFxRate() *ReceiptUpdateOne {
+	_u.mutation.ClearFxRate()
+	return _u
+}
+
+// SetFxRateDate sets the "fx_rate_date" field.
+func (_u *ReceiptUpdateOne) SetFxRateDate(v time.Time) *ReceiptUpdateOne {
+	_u.mutation.SetFxRateDate(v)
+	return _u
+}
+
+// SetNillableFxRateDate sets the "fx_rate_date" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableFxRateDate(v *time.Time) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetFxRateDate(*v)
+	}
+	return _u
+}
+
+// ClearFxRateDate clears the value of the "fx_rate_date" field.
+func (_u *ReceiptUpdateOne) ClearFxRateDate() *ReceiptUpdateOne {
+	_u.mutation.ClearFxRateDate()
+	return _u
+}
+
+// SetFxSource sets the "fx_source" field.
+func (_u *ReceiptUpdateOne) SetFxSource(v string) *ReceiptUpdateOne {
+	_u.mutation.SetFxSource(v)
+	return _u
+}
+
+// SetNillableFxSource sets the "fx_source" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableFxSource(v *string) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetFxSource(*v)
+	}
+	return _u
+}
+
+// ClearFxSource clears the value of the "fx_source" field.
+func (_u *ReceiptUpdateOne) ClearFxSource() *ReceiptUpdateOne {
+	_u.mutation.ClearFxSource()
+	return _u
+}
+
 // SetCategoryName sets the "category_name" field.
 func (_u *ReceiptUpdateOne) SetCategoryName(v string) *ReceiptUpdateOne {
 	_u.mutation.SetCategoryName(v)
@@ -830,6 +1386,11 @@ func (_u *ReceiptUpdateOne) SetProfile(v *Profile) *ReceiptUpdateOne {
 	return _u.SetProfileID(v.ID)
 }
 
+// SetMerchant sets the "merchant" edge to the Merchant entity.
+func (_u *ReceiptUpdateOne) SetMerchant(v *Merchant) *ReceiptUpdateOne {
+	return _u.SetMerchantID(v.ID)
+}
+
 // AddFileIDs adds the "files" edge to the ReceiptFile entity by IDs.
 func (_u *ReceiptUpdateOne) AddFileIDs(ids ...uuid.UUID) *ReceiptUpdateOne {
 	_u.mutation.AddFileIDs(ids...)
@@ -860,6 +1421,21 @@ func (_u *ReceiptUpdateOne) AddJobs(v ...*ExtractJob) *ReceiptUpdateOne {
 	return _u.AddJobIDs(ids...)
 }
 
+// AddAnomalyIDs adds the "anomalies" edge to the ReceiptAnomaly entity by IDs.
+func (_u *ReceiptUpdateOne) AddAnomalyIDs(ids ...uuid.UUID) *ReceiptUpdateOne {
+	_u.mutation.AddAnomalyIDs(ids...)
+	return _u
+}
+
+// AddAnomalies adds the "anomalies" edges to the ReceiptAnomaly entity.
+func (_u *ReceiptUpdateOne) AddAnomalies(v ...*ReceiptAnomaly) *ReceiptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnomalyIDs(ids...)
+}
+
 // Mutation returns the ReceiptMutation object of the builder.
 func (_u *ReceiptUpdateOne) Mutation() *ReceiptMutation {
 	return _u.mutation
@@ -871,6 +1447,12 @@ func (_u *ReceiptUpdateOne) ClearProfile() *ReceiptUpdateOne {
 	return _u
 }
 
+// ClearMerchant clears the "merchant" edge to the Merchant entity.
+func (_u *ReceiptUpdateOne) ClearMerchant() *ReceiptUpdateOne {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
 // ClearFiles clears all "files" edges to the ReceiptFile entity.
 func (_u *ReceiptUpdateOne) ClearFiles() *ReceiptUpdateOne {
 	_u.mutation.ClearFiles()
@@ -913,6 +1495,27 @@ func (_u *ReceiptUpdateOne) RemoveJobs(v ...*ExtractJob) *ReceiptUpdateOne {
 	return _u.RemoveJobIDs(ids...)
 }
 
+// ClearAnomalies clears all "anomalies" edges to the ReceiptAnomaly entity.
+func (_u *ReceiptUpdateOne) ClearAnomalies() *ReceiptUpdateOne {
+	_u.mutation.ClearAnomalies()
+	return _u
+}
+
+// RemoveAnomalyIDs removes the "anomalies" edge to ReceiptAnomaly entities by IDs.
+func (_u *ReceiptUpdateOne) RemoveAnomalyIDs(ids ...uuid.UUID) *ReceiptUpdateOne {
+	_u.mutation.RemoveAnomalyIDs(ids...)
+	return _u
+}
+
+// RemoveAnomalies removes "anomalies" edges to ReceiptAnomaly entities.
+func (_u *ReceiptUpdateOne) RemoveAnomalies(v ...*ReceiptAnomaly) *ReceiptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnomalyIDs(ids...)
+}
+
 // Where appends a list predicates to the ReceiptUpdate builder.
 func (_u *ReceiptUpdateOne) Where(ps ...predicate.Receipt) *ReceiptUpdateOne {
 	_u.mutation.Where(ps...)
@@ -985,6 +1588,12 @@ func (_u *ReceiptUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -1024,32 +1633,85 @@ func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err er
 		_spec.SetField(receipt.FieldMerchantName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Subtotal(); ok {
-		_spec.SetField(receipt.FieldSubtotal, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedSubtotal(); ok {
-		_spec.AddField(receipt.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldSubtotal, field.TypeOther, value)
 	}
 	if _u.mutation.SubtotalCleared() {
-		_spec.ClearField(receipt.FieldSubtotal, field.TypeFloat64)
+		_spec.ClearField(receipt.FieldSubtotal, field.TypeOther)
 	}
 	if value, ok := _u.mutation.Tax(); ok {
-		_spec.SetField(receipt.FieldTax, field.TypeFloat64, value)
-	}
-	if value, ok := _u.mutation.AddedTax(); ok {
-		_spec.AddField(receipt.FieldTax, field.TypeFloat64, value)
+		_spec.SetField(receipt.FieldTax, field.TypeOther, value)
 	}
 	if _u.mutation.TaxCleared() {
-		_spec.ClearField(receipt.FieldTax, field.TypeFloat64)
+		_spec.ClearField(receipt.FieldTax, field.TypeOther)
 	}
-	if value, ok := _u.mutation.Total(); ok {
-		_spec.SetField(receipt.FieldTotal, field.TypeFloat64, value)
+	if value, ok := _u.mutation.Discount(); ok {
+		_spec.SetField(receipt.FieldDiscount, field.TypeOther, value)
+	}
+	if _u.mutation.DiscountCleared() {
+		_spec.ClearField(receipt.FieldDiscount, field.TypeOther)
+	}
+	if value, ok := _u.mutation.OtherFees(); ok {
+		_spec.SetField(receipt.FieldOtherFees, field.TypeOther, value)
+	}
+	if _u.mutation.OtherFeesCleared() {
+		_spec.ClearField(receipt.FieldOtherFees, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Tip(); ok {
+		_spec.SetField(receipt.FieldTip, field.TypeOther, value)
 	}
-	if value, ok := _u.mutation.AddedTotal(); ok {
-		_spec.AddField(receipt.FieldTotal, field.TypeFloat64, value)
+	if _u.mutation.TipCleared() {
+		_spec.ClearField(receipt.FieldTip, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Fees(); ok {
+		_spec.SetField(receipt.FieldFees, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFees(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, receipt.FieldFees, value)
+		})
+	}
+	if _u.mutation.FeesCleared() {
+		_spec.ClearField(receipt.FieldFees, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(receipt.FieldTotal, field.TypeOther, value)
 	}
 	if value, ok := _u.mutation.CurrencyCode(); ok {
 		_spec.SetField(receipt.FieldCurrencyCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ConvertedTotal(); ok {
+		_spec.SetField(receipt.FieldConvertedTotal, field.TypeOther, value)
+	}
+	if _u.mutation.ConvertedTotalCleared() {
+		_spec.ClearField(receipt.FieldConvertedTotal, field.TypeOther)
+	}
+	if value, ok := _u.mutation.ConvertedCurrency(); ok {
+		_spec.SetField(receipt.FieldConvertedCurrency, field.TypeString, value)
+	}
+	if _u.mutation.ConvertedCurrencyCleared() {
+		_spec.ClearField(receipt.FieldConvertedCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(receipt.FieldFxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFxRate(); ok {
+		_spec.AddField(receipt.FieldFxRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.FxRateCleared() {
+		_spec.ClearField(receipt.FieldFxRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.FxRateDate(); ok {
+		_spec.SetField(receipt.FieldFxRateDate, field.TypeTime, value)
+	}
+	if _u.mutation.FxRateDateCleared() {
+		_spec.ClearField(receipt.FieldFxRateDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FxSource(); ok {
+		_spec.SetField(receipt.FieldFxSource, field.TypeString, value)
+	}
+	if _u.mutation.FxSourceCleared() {
+		_spec.ClearField(receipt.FieldFxSource, field.TypeString)
+	}
 	if value, ok := _u.mutation.CategoryName(); ok {
 		_spec.SetField(receipt.FieldCategoryName, field.TypeString, value)
 	}
@@ -1100,6 +1762,35 @@ func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MerchantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.MerchantTable,
+			Columns: []string{receipt.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MerchantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.MerchantTable,
+			Columns: []string{receipt.MerchantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1190,6 +1881,52 @@ func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnomaliesIDs(); len(nodes) > 0 && !_u.mutation.AnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   receipt.AnomaliesTable,
+			Columns: []string{receipt.AnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Receipt{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
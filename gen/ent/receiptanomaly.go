@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+)
+
+// ReceiptAnomaly is the model entity for the ReceiptAnomaly schema.
+type ReceiptAnomaly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ReceiptID holds the value of the "receipt_id" field.
+	ReceiptID uuid.UUID `json:"receipt_id,omitempty"`
+	// ProfileID holds the value of the "profile_id" field.
+	ProfileID uuid.UUID `json:"profile_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReceiptAnomalyQuery when eager-loading is set.
+	Edges        ReceiptAnomalyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReceiptAnomalyEdges holds the relations/edges for other nodes in the graph.
+type ReceiptAnomalyEdges struct {
+	// Receipt holds the value of the receipt edge.
+	Receipt *Receipt `json:"receipt,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReceiptOrErr returns the Receipt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiptAnomalyEdges) ReceiptOrErr() (*Receipt, error) {
+	if e.Receipt != nil {
+		return e.Receipt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: receipt.Label}
+	}
+	return nil, &NotLoadedError{edge: "receipt"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReceiptAnomaly) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case receiptanomaly.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case receiptanomaly.FieldKind, receiptanomaly.FieldReason:
+			values[i] = new(sql.NullString)
+		case receiptanomaly.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case receiptanomaly.FieldID, receiptanomaly.FieldReceiptID, receiptanomaly.FieldProfileID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReceiptAnomaly fields.
+func (_m *ReceiptAnomaly) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case receiptanomaly.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case receiptanomaly.FieldReceiptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value != nil {
+				_m.ReceiptID = *value
+			}
+		case receiptanomaly.FieldProfileID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_id", values[i])
+			} else if value != nil {
+				_m.ProfileID = *value
+			}
+		case receiptanomaly.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case receiptanomaly.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case receiptanomaly.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case receiptanomaly.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReceiptAnomaly.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReceiptAnomaly) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReceipt queries the "receipt" edge of the ReceiptAnomaly entity.
+func (_m *ReceiptAnomaly) QueryReceipt() *ReceiptQuery {
+	return NewReceiptAnomalyClient(_m.config).QueryReceipt(_m)
+}
+
+// Update returns a builder for updating this ReceiptAnomaly.
+// Note that you need to call ReceiptAnomaly.Unwrap() before calling this method if this ReceiptAnomaly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReceiptAnomaly) Update() *ReceiptAnomalyUpdateOne {
+	return NewReceiptAnomalyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReceiptAnomaly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReceiptAnomaly) Unwrap() *ReceiptAnomaly {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReceiptAnomaly is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReceiptAnomaly) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReceiptAnomaly(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("receipt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiptID))
+	builder.WriteString(", ")
+	builder.WriteString("profile_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfileID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReceiptAnomalies is a parsable slice of ReceiptAnomaly.
+type ReceiptAnomalies []*ReceiptAnomaly
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receiptanomaly
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldID, id))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ProfileID applies equality check predicate on the "profile_id" field. It's identical to ProfileIDEQ.
+func ProfileID(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldProfileID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldKind, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldReason, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldScore, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ProfileIDEQ applies the EQ predicate on the "profile_id" field.
+func ProfileIDEQ(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ProfileIDNEQ applies the NEQ predicate on the "profile_id" field.
+func ProfileIDNEQ(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldProfileID, v))
+}
+
+// ProfileIDIn applies the In predicate on the "profile_id" field.
+func ProfileIDIn(vs ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldProfileID, vs...))
+}
+
+// ProfileIDNotIn applies the NotIn predicate on the "profile_id" field.
+func ProfileIDNotIn(vs ...uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldProfileID, vs...))
+}
+
+// ProfileIDGT applies the GT predicate on the "profile_id" field.
+func ProfileIDGT(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldProfileID, v))
+}
+
+// ProfileIDGTE applies the GTE predicate on the "profile_id" field.
+func ProfileIDGTE(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldProfileID, v))
+}
+
+// ProfileIDLT applies the LT predicate on the "profile_id" field.
+func ProfileIDLT(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldProfileID, v))
+}
+
+// ProfileIDLTE applies the LTE predicate on the "profile_id" field.
+func ProfileIDLTE(v uuid.UUID) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldProfileID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldScore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasReceipt applies the HasEdge predicate on the "receipt" edge.
+func HasReceipt() predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReceiptTable, ReceiptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptWith applies the HasEdge predicate on the "receipt" edge with a given conditions (other predicates).
+func HasReceiptWith(preds ...predicate.Receipt) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(func(s *sql.Selector) {
+		step := newReceiptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReceiptAnomaly) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReceiptAnomaly) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReceiptAnomaly) predicate.ReceiptAnomaly {
+	return predicate.ReceiptAnomaly(sql.NotPredicates(p))
+}
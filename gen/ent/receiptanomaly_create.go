@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+)
+
+// ReceiptAnomalyCreate is the builder for creating a ReceiptAnomaly entity.
+type ReceiptAnomalyCreate struct {
+	config
+	mutation *ReceiptAnomalyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *ReceiptAnomalyCreate) SetReceiptID(v uuid.UUID) *ReceiptAnomalyCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *ReceiptAnomalyCreate) SetProfileID(v uuid.UUID) *ReceiptAnomalyCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ReceiptAnomalyCreate) SetKind(v string) *ReceiptAnomalyCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ReceiptAnomalyCreate) SetReason(v string) *ReceiptAnomalyCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *ReceiptAnomalyCreate) SetScore(v float64) *ReceiptAnomalyCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReceiptAnomalyCreate) SetCreatedAt(v time.Time) *ReceiptAnomalyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReceiptAnomalyCreate) SetNillableCreatedAt(v *time.Time) *ReceiptAnomalyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReceiptAnomalyCreate) SetID(v uuid.UUID) *ReceiptAnomalyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReceiptAnomalyCreate) SetNillableID(v *uuid.UUID) *ReceiptAnomalyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetReceipt sets the "receipt" edge to the Receipt entity.
+func (_c *ReceiptAnomalyCreate) SetReceipt(v *Receipt) *ReceiptAnomalyCreate {
+	return _c.SetReceiptID(v.ID)
+}
+
+// Mutation returns the ReceiptAnomalyMutation object of the builder.
+func (_c *ReceiptAnomalyCreate) Mutation() *ReceiptAnomalyMutation {
+	return _c.mutation
+}
+
+// Save creates the ReceiptAnomaly in the database.
+func (_c *ReceiptAnomalyCreate) Save(ctx context.Context) (*ReceiptAnomaly, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReceiptAnomalyCreate) SaveX(ctx context.Context) *ReceiptAnomaly {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptAnomalyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptAnomalyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReceiptAnomalyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := receiptanomaly.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := receiptanomaly.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReceiptAnomalyCreate) check() error {
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "ReceiptAnomaly.receipt_id"`)}
+	}
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "ReceiptAnomaly.profile_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ReceiptAnomaly.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := receiptanomaly.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ReceiptAnomaly.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := receiptanomaly.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ReceiptAnomaly.score"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReceiptAnomaly.created_at"`)}
+	}
+	if len(_c.mutation.ReceiptIDs()) == 0 {
+		return &ValidationError{Name: "receipt", err: errors.New(`ent: missing required edge "ReceiptAnomaly.receipt"`)}
+	}
+	return nil
+}
+
+func (_c *ReceiptAnomalyCreate) sqlSave(ctx context.Context) (*ReceiptAnomaly, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReceiptAnomalyCreate) createSpec() (*ReceiptAnomaly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReceiptAnomaly{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(receiptanomaly.Table, sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ProfileID(); ok {
+		_spec.SetField(receiptanomaly.FieldProfileID, field.TypeUUID, value)
+		_node.ProfileID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(receiptanomaly.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(receiptanomaly.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(receiptanomaly.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(receiptanomaly.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiptanomaly.ReceiptTable,
+			Columns: []string{receiptanomaly.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReceiptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptAnomaly.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptAnomalyUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptAnomalyCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptAnomalyUpsertOne {
+	_c.conflict = opts
+	return &ReceiptAnomalyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptAnomalyCreate) OnConflictColumns(columns ...string) *ReceiptAnomalyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptAnomalyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReceiptAnomalyUpsertOne is the builder for "upsert"-ing
+	//  one ReceiptAnomaly node.
+	ReceiptAnomalyUpsertOne struct {
+		create *ReceiptAnomalyCreate
+	}
+
+	// ReceiptAnomalyUpsert is the "OnConflict" setter.
+	ReceiptAnomalyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ReceiptAnomalyUpsert) SetReceiptID(v uuid.UUID) *ReceiptAnomalyUpsert {
+	u.Set(receiptanomaly.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsert) UpdateReceiptID() *ReceiptAnomalyUpsert {
+	u.SetExcluded(receiptanomaly.FieldReceiptID)
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptAnomalyUpsert) SetProfileID(v uuid.UUID) *ReceiptAnomalyUpsert {
+	u.Set(receiptanomaly.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsert) UpdateProfileID() *ReceiptAnomalyUpsert {
+	u.SetExcluded(receiptanomaly.FieldProfileID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ReceiptAnomalyUpsert) SetKind(v string) *ReceiptAnomalyUpsert {
+	u.Set(receiptanomaly.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsert) UpdateKind() *ReceiptAnomalyUpsert {
+	u.SetExcluded(receiptanomaly.FieldKind)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ReceiptAnomalyUpsert) SetReason(v string) *ReceiptAnomalyUpsert {
+	u.Set(receiptanomaly.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsert) UpdateReason() *ReceiptAnomalyUpsert {
+	u.SetExcluded(receiptanomaly.FieldReason)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *ReceiptAnomalyUpsert) SetScore(v float64) *ReceiptAnomalyUpsert {
+	u.Set(receiptanomaly.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsert) UpdateScore() *ReceiptAnomalyUpsert {
+	u.SetExcluded(receiptanomaly.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReceiptAnomalyUpsert) AddScore(v float64) *ReceiptAnomalyUpsert {
+	u.Add(receiptanomaly.FieldScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptanomaly.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptAnomalyUpsertOne) UpdateNewValues() *ReceiptAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receiptanomaly.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(receiptanomaly.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptAnomalyUpsertOne) Ignore() *ReceiptAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptAnomalyUpsertOne) DoNothing() *ReceiptAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptAnomalyCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptAnomalyUpsertOne) Update(set func(*ReceiptAnomalyUpsert)) *ReceiptAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptAnomalyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ReceiptAnomalyUpsertOne) SetReceiptID(v uuid.UUID) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertOne) UpdateReceiptID() *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptAnomalyUpsertOne) SetProfileID(v uuid.UUID) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertOne) UpdateProfileID() *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReceiptAnomalyUpsertOne) SetKind(v string) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertOne) UpdateKind() *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReceiptAnomalyUpsertOne) SetReason(v string) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertOne) UpdateReason() *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ReceiptAnomalyUpsertOne) SetScore(v float64) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReceiptAnomalyUpsertOne) AddScore(v float64) *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertOne) UpdateScore() *ReceiptAnomalyUpsertOne {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptAnomalyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptAnomalyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptAnomalyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptAnomalyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptAnomalyUpsertOne.ID is not supported by MySQL driver. Use ReceiptAnomalyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptAnomalyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReceiptAnomalyCreateBulk is the builder for creating many ReceiptAnomaly entities in bulk.
+type ReceiptAnomalyCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptAnomalyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReceiptAnomaly entities in the database.
+func (_c *ReceiptAnomalyCreateBulk) Save(ctx context.Context) ([]*ReceiptAnomaly, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReceiptAnomaly, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptAnomalyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReceiptAnomalyCreateBulk) SaveX(ctx context.Context) []*ReceiptAnomaly {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptAnomalyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptAnomalyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptAnomaly.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptAnomalyUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptAnomalyCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptAnomalyUpsertBulk {
+	_c.conflict = opts
+	return &ReceiptAnomalyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptAnomalyCreateBulk) OnConflictColumns(columns ...string) *ReceiptAnomalyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptAnomalyUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReceiptAnomalyUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReceiptAnomaly nodes.
+type ReceiptAnomalyUpsertBulk struct {
+	create *ReceiptAnomalyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptanomaly.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptAnomalyUpsertBulk) UpdateNewValues() *ReceiptAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receiptanomaly.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(receiptanomaly.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptAnomaly.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptAnomalyUpsertBulk) Ignore() *ReceiptAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptAnomalyUpsertBulk) DoNothing() *ReceiptAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptAnomalyCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptAnomalyUpsertBulk) Update(set func(*ReceiptAnomalyUpsert)) *ReceiptAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptAnomalyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *ReceiptAnomalyUpsertBulk) SetReceiptID(v uuid.UUID) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertBulk) UpdateReceiptID() *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptAnomalyUpsertBulk) SetProfileID(v uuid.UUID) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertBulk) UpdateProfileID() *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReceiptAnomalyUpsertBulk) SetKind(v string) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertBulk) UpdateKind() *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReceiptAnomalyUpsertBulk) SetReason(v string) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertBulk) UpdateReason() *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ReceiptAnomalyUpsertBulk) SetScore(v float64) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReceiptAnomalyUpsertBulk) AddScore(v float64) *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReceiptAnomalyUpsertBulk) UpdateScore() *ReceiptAnomalyUpsertBulk {
+	return u.Update(func(s *ReceiptAnomalyUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptAnomalyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptAnomalyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptAnomalyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptAnomalyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
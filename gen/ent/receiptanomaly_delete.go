@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+)
+
+// ReceiptAnomalyDelete is the builder for deleting a ReceiptAnomaly entity.
+type ReceiptAnomalyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReceiptAnomalyMutation
+}
+
+// Where appends a list predicates to the ReceiptAnomalyDelete builder.
+func (_d *ReceiptAnomalyDelete) Where(ps ...predicate.ReceiptAnomaly) *ReceiptAnomalyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ReceiptAnomalyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReceiptAnomalyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ReceiptAnomalyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(receiptanomaly.Table, sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ReceiptAnomalyDeleteOne is the builder for deleting a single ReceiptAnomaly entity.
+type ReceiptAnomalyDeleteOne struct {
+	_d *ReceiptAnomalyDelete
+}
+
+// Where appends a list predicates to the ReceiptAnomalyDelete builder.
+func (_d *ReceiptAnomalyDeleteOne) Where(ps ...predicate.ReceiptAnomaly) *ReceiptAnomalyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ReceiptAnomalyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{receiptanomaly.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReceiptAnomalyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+)
+
+// ReceiptAnomalyQuery is the builder for querying ReceiptAnomaly entities.
+type ReceiptAnomalyQuery struct {
+	config
+	ctx         *QueryContext
+	order       []receiptanomaly.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ReceiptAnomaly
+	withReceipt *ReceiptQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReceiptAnomalyQuery builder.
+func (_q *ReceiptAnomalyQuery) Where(ps ...predicate.ReceiptAnomaly) *ReceiptAnomalyQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ReceiptAnomalyQuery) Limit(limit int) *ReceiptAnomalyQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ReceiptAnomalyQuery) Offset(offset int) *ReceiptAnomalyQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ReceiptAnomalyQuery) Unique(unique bool) *ReceiptAnomalyQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ReceiptAnomalyQuery) Order(o ...receiptanomaly.OrderOption) *ReceiptAnomalyQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryReceipt chains the current query on the "receipt" edge.
+func (_q *ReceiptAnomalyQuery) QueryReceipt() *ReceiptQuery {
+	query := (&ReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receiptanomaly.Table, receiptanomaly.FieldID, selector),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receiptanomaly.ReceiptTable, receiptanomaly.ReceiptColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReceiptAnomaly entity from the query.
+// Returns a *NotFoundError when no ReceiptAnomaly was found.
+func (_q *ReceiptAnomalyQuery) First(ctx context.Context) (*ReceiptAnomaly, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{receiptanomaly.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) FirstX(ctx context.Context) *ReceiptAnomaly {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReceiptAnomaly ID from the query.
+// Returns a *NotFoundError when no ReceiptAnomaly ID was found.
+func (_q *ReceiptAnomalyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{receiptanomaly.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReceiptAnomaly entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReceiptAnomaly entity is found.
+// Returns a *NotFoundError when no ReceiptAnomaly entities are found.
+func (_q *ReceiptAnomalyQuery) Only(ctx context.Context) (*ReceiptAnomaly, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{receiptanomaly.Label}
+	default:
+		return nil, &NotSingularError{receiptanomaly.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) OnlyX(ctx context.Context) *ReceiptAnomaly {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReceiptAnomaly ID in the query.
+// Returns a *NotSingularError when more than one ReceiptAnomaly ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ReceiptAnomalyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{receiptanomaly.Label}
+	default:
+		err = &NotSingularError{receiptanomaly.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReceiptAnomalies.
+func (_q *ReceiptAnomalyQuery) All(ctx context.Context) ([]*ReceiptAnomaly, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReceiptAnomaly, *ReceiptAnomalyQuery]()
+	return withInterceptors[[]*ReceiptAnomaly](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) AllX(ctx context.Context) []*ReceiptAnomaly {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReceiptAnomaly IDs.
+func (_q *ReceiptAnomalyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(receiptanomaly.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ReceiptAnomalyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ReceiptAnomalyQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ReceiptAnomalyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ReceiptAnomalyQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReceiptAnomalyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ReceiptAnomalyQuery) Clone() *ReceiptAnomalyQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ReceiptAnomalyQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]receiptanomaly.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.ReceiptAnomaly{}, _q.predicates...),
+		withReceipt: _q.withReceipt.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithReceipt tells the query-builder to eager-load the nodes that are connected to
+// the "receipt" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReceiptAnomalyQuery) WithReceipt(opts ...func(*ReceiptQuery)) *ReceiptAnomalyQuery {
+	query := (&ReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceipt = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ReceiptID uuid.UUID `json:"receipt_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReceiptAnomaly.Query().
+//		GroupBy(receiptanomaly.FieldReceiptID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ReceiptAnomalyQuery) GroupBy(field string, fields ...string) *ReceiptAnomalyGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReceiptAnomalyGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = receiptanomaly.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ReceiptID uuid.UUID `json:"receipt_id,omitempty"`
+//	}
+//
+//	client.ReceiptAnomaly.Query().
+//		Select(receiptanomaly.FieldReceiptID).
+//		Scan(ctx, &v)
+func (_q *ReceiptAnomalyQuery) Select(fields ...string) *ReceiptAnomalySelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ReceiptAnomalySelect{ReceiptAnomalyQuery: _q}
+	sbuild.label = receiptanomaly.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReceiptAnomalySelect configured with the given aggregations.
+func (_q *ReceiptAnomalyQuery) Aggregate(fns ...AggregateFunc) *ReceiptAnomalySelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ReceiptAnomalyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !receiptanomaly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ReceiptAnomalyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReceiptAnomaly, error) {
+	var (
+		nodes       = []*ReceiptAnomaly{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withReceipt != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReceiptAnomaly).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReceiptAnomaly{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withReceipt; query != nil {
+		if err := _q.loadReceipt(ctx, query, nodes, nil,
+			func(n *ReceiptAnomaly, e *Receipt) { n.Edges.Receipt = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ReceiptAnomalyQuery) loadReceipt(ctx context.Context, query *ReceiptQuery, nodes []*ReceiptAnomaly, init func(*ReceiptAnomaly), assign func(*ReceiptAnomaly, *Receipt)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReceiptAnomaly)
+	for i := range nodes {
+		fk := nodes[i].ReceiptID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(receipt.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "receipt_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *ReceiptAnomalyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ReceiptAnomalyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(receiptanomaly.Table, receiptanomaly.Columns, sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiptanomaly.FieldID)
+		for i := range fields {
+			if fields[i] != receiptanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withReceipt != nil {
+			_spec.Node.AddColumnOnce(receiptanomaly.FieldReceiptID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ReceiptAnomalyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(receiptanomaly.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = receiptanomaly.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ReceiptAnomalyQuery) Modify(modifiers ...func(s *sql.Selector)) *ReceiptAnomalySelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// ReceiptAnomalyGroupBy is the group-by builder for ReceiptAnomaly entities.
+type ReceiptAnomalyGroupBy struct {
+	selector
+	build *ReceiptAnomalyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ReceiptAnomalyGroupBy) Aggregate(fns ...AggregateFunc) *ReceiptAnomalyGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ReceiptAnomalyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiptAnomalyQuery, *ReceiptAnomalyGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ReceiptAnomalyGroupBy) sqlScan(ctx context.Context, root *ReceiptAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReceiptAnomalySelect is the builder for selecting fields of ReceiptAnomaly entities.
+type ReceiptAnomalySelect struct {
+	*ReceiptAnomalyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ReceiptAnomalySelect) Aggregate(fns ...AggregateFunc) *ReceiptAnomalySelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ReceiptAnomalySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReceiptAnomalyQuery, *ReceiptAnomalySelect](ctx, _s.ReceiptAnomalyQuery, _s, _s.inters, v)
+}
+
+func (_s *ReceiptAnomalySelect) sqlScan(ctx context.Context, root *ReceiptAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ReceiptAnomalySelect) Modify(modifiers ...func(s *sql.Selector)) *ReceiptAnomalySelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
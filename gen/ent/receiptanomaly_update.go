@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+)
+
+// ReceiptAnomalyUpdate is the builder for updating ReceiptAnomaly entities.
+type ReceiptAnomalyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReceiptAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReceiptAnomalyUpdate builder.
+func (_u *ReceiptAnomalyUpdate) Where(ps ...predicate.ReceiptAnomaly) *ReceiptAnomalyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *ReceiptAnomalyUpdate) SetReceiptID(v uuid.UUID) *ReceiptAnomalyUpdate {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdate) SetNillableReceiptID(v *uuid.UUID) *ReceiptAnomalyUpdate {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *ReceiptAnomalyUpdate) SetProfileID(v uuid.UUID) *ReceiptAnomalyUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdate) SetNillableProfileID(v *uuid.UUID) *ReceiptAnomalyUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReceiptAnomalyUpdate) SetKind(v string) *ReceiptAnomalyUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdate) SetNillableKind(v *string) *ReceiptAnomalyUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReceiptAnomalyUpdate) SetReason(v string) *ReceiptAnomalyUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdate) SetNillableReason(v *string) *ReceiptAnomalyUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ReceiptAnomalyUpdate) SetScore(v float64) *ReceiptAnomalyUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdate) SetNillableScore(v *float64) *ReceiptAnomalyUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ReceiptAnomalyUpdate) AddScore(v float64) *ReceiptAnomalyUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the Receipt entity.
+func (_u *ReceiptAnomalyUpdate) SetReceipt(v *Receipt) *ReceiptAnomalyUpdate {
+	return _u.SetReceiptID(v.ID)
+}
+
+// Mutation returns the ReceiptAnomalyMutation object of the builder.
+func (_u *ReceiptAnomalyUpdate) Mutation() *ReceiptAnomalyMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the Receipt entity.
+func (_u *ReceiptAnomalyUpdate) ClearReceipt() *ReceiptAnomalyUpdate {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReceiptAnomalyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptAnomalyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReceiptAnomalyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptAnomalyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReceiptAnomalyUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := receiptanomaly.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := receiptanomaly.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReceiptAnomaly.receipt"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptAnomalyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptAnomalyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReceiptAnomalyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiptanomaly.Table, receiptanomaly.Columns, sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProfileID(); ok {
+		_spec.SetField(receiptanomaly.FieldProfileID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(receiptanomaly.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(receiptanomaly.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(receiptanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(receiptanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiptanomaly.ReceiptTable,
+			Columns: []string{receiptanomaly.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiptanomaly.ReceiptTable,
+			Columns: []string{receiptanomaly.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReceiptAnomalyUpdateOne is the builder for updating a single ReceiptAnomaly entity.
+type ReceiptAnomalyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReceiptAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *ReceiptAnomalyUpdateOne) SetReceiptID(v uuid.UUID) *ReceiptAnomalyUpdateOne {
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdateOne) SetNillableReceiptID(v *uuid.UUID) *ReceiptAnomalyUpdateOne {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *ReceiptAnomalyUpdateOne) SetProfileID(v uuid.UUID) *ReceiptAnomalyUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdateOne) SetNillableProfileID(v *uuid.UUID) *ReceiptAnomalyUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReceiptAnomalyUpdateOne) SetKind(v string) *ReceiptAnomalyUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdateOne) SetNillableKind(v *string) *ReceiptAnomalyUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ReceiptAnomalyUpdateOne) SetReason(v string) *ReceiptAnomalyUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdateOne) SetNillableReason(v *string) *ReceiptAnomalyUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ReceiptAnomalyUpdateOne) SetScore(v float64) *ReceiptAnomalyUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ReceiptAnomalyUpdateOne) SetNillableScore(v *float64) *ReceiptAnomalyUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ReceiptAnomalyUpdateOne) AddScore(v float64) *ReceiptAnomalyUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetReceipt sets the "receipt" edge to the Receipt entity.
+func (_u *ReceiptAnomalyUpdateOne) SetReceipt(v *Receipt) *ReceiptAnomalyUpdateOne {
+	return _u.SetReceiptID(v.ID)
+}
+
+// Mutation returns the ReceiptAnomalyMutation object of the builder.
+func (_u *ReceiptAnomalyUpdateOne) Mutation() *ReceiptAnomalyMutation {
+	return _u.mutation
+}
+
+// ClearReceipt clears the "receipt" edge to the Receipt entity.
+func (_u *ReceiptAnomalyUpdateOne) ClearReceipt() *ReceiptAnomalyUpdateOne {
+	_u.mutation.ClearReceipt()
+	return _u
+}
+
+// Where appends a list predicates to the ReceiptAnomalyUpdate builder.
+func (_u *ReceiptAnomalyUpdateOne) Where(ps ...predicate.ReceiptAnomaly) *ReceiptAnomalyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReceiptAnomalyUpdateOne) Select(field string, fields ...string) *ReceiptAnomalyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReceiptAnomaly entity.
+func (_u *ReceiptAnomalyUpdateOne) Save(ctx context.Context) (*ReceiptAnomaly, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptAnomalyUpdateOne) SaveX(ctx context.Context) *ReceiptAnomaly {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReceiptAnomalyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptAnomalyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReceiptAnomalyUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := receiptanomaly.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := receiptanomaly.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ReceiptAnomaly.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ReceiptCleared() && len(_u.mutation.ReceiptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReceiptAnomaly.receipt"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptAnomalyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptAnomalyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReceiptAnomalyUpdateOne) sqlSave(ctx context.Context) (_node *ReceiptAnomaly, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receiptanomaly.Table, receiptanomaly.Columns, sqlgraph.NewFieldSpec(receiptanomaly.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReceiptAnomaly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receiptanomaly.FieldID)
+		for _, f := range fields {
+			if !receiptanomaly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receiptanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProfileID(); ok {
+		_spec.SetField(receiptanomaly.FieldProfileID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(receiptanomaly.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(receiptanomaly.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(receiptanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(receiptanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.ReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiptanomaly.ReceiptTable,
+			Columns: []string{receiptanomaly.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receiptanomaly.ReceiptTable,
+			Columns: []string{receiptanomaly.ReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReceiptAnomaly{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receiptanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type ReceiptFileCreate struct {
 	config
 	mutation *ReceiptFileMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProfileID sets the "profile_id" field.
@@ -234,6 +237,7 @@ func (_c *ReceiptFileCreate) createSpec() (*ReceiptFile, *sqlgraph.CreateSpec) {
 		_node = &ReceiptFile{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(receiptfile.Table, sqlgraph.NewFieldSpec(receiptfile.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -298,11 +302,342 @@ func (_c *ReceiptFileCreate) createSpec() (*ReceiptFile, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptFile.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptFileUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptFileCreate) OnConflict(opts ...sql.ConflictOption) *ReceiptFileUpsertOne {
+	_c.conflict = opts
+	return &ReceiptFileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptFileCreate) OnConflictColumns(columns ...string) *ReceiptFileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptFileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReceiptFileUpsertOne is the builder for "upsert"-ing
+	//  one ReceiptFile node.
+	ReceiptFileUpsertOne struct {
+		create *ReceiptFileCreate
+	}
+
+	// ReceiptFileUpsert is the "OnConflict" setter.
+	ReceiptFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptFileUpsert) SetProfileID(v uuid.UUID) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateProfileID() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldProfileID)
+	return u
+}
+
+// SetSourcePath sets the "source_path" field.
+func (u *ReceiptFileUpsert) SetSourcePath(v string) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldSourcePath, v)
+	return u
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateSourcePath() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldSourcePath)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ReceiptFileUpsert) SetContentHash(v []byte) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateContentHash() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldContentHash)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *ReceiptFileUpsert) SetFilename(v string) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateFilename() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldFilename)
+	return u
+}
+
+// SetFileExt sets the "file_ext" field.
+func (u *ReceiptFileUpsert) SetFileExt(v string) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldFileExt, v)
+	return u
+}
+
+// UpdateFileExt sets the "file_ext" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateFileExt() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldFileExt)
+	return u
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptFileUpsert) SetFileSize(v int) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldFileSize, v)
+	return u
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateFileSize() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldFileSize)
+	return u
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptFileUpsert) AddFileSize(v int) *ReceiptFileUpsert {
+	u.Add(receiptfile.FieldFileSize, v)
+	return u
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (u *ReceiptFileUpsert) SetUploadedAt(v time.Time) *ReceiptFileUpsert {
+	u.Set(receiptfile.FieldUploadedAt, v)
+	return u
+}
+
+// UpdateUploadedAt sets the "uploaded_at" field to the value that was provided on create.
+func (u *ReceiptFileUpsert) UpdateUploadedAt() *ReceiptFileUpsert {
+	u.SetExcluded(receiptfile.FieldUploadedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptFileUpsertOne) UpdateNewValues() *ReceiptFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(receiptfile.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReceiptFileUpsertOne) Ignore() *ReceiptFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptFileUpsertOne) DoNothing() *ReceiptFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptFileCreate.OnConflict
+// documentation for more info.
+func (u *ReceiptFileUpsertOne) Update(set func(*ReceiptFileUpsert)) *ReceiptFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptFileUpsertOne) SetProfileID(v uuid.UUID) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateProfileID() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetSourcePath sets the "source_path" field.
+func (u *ReceiptFileUpsertOne) SetSourcePath(v string) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetSourcePath(v)
+	})
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateSourcePath() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateSourcePath()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ReceiptFileUpsertOne) SetContentHash(v []byte) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateContentHash() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ReceiptFileUpsertOne) SetFilename(v string) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateFilename() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetFileExt sets the "file_ext" field.
+func (u *ReceiptFileUpsertOne) SetFileExt(v string) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFileExt(v)
+	})
+}
+
+// UpdateFileExt sets the "file_ext" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateFileExt() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFileExt()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptFileUpsertOne) SetFileSize(v int) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptFileUpsertOne) AddFileSize(v int) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateFileSize() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (u *ReceiptFileUpsertOne) SetUploadedAt(v time.Time) *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetUploadedAt(v)
+	})
+}
+
+// UpdateUploadedAt sets the "uploaded_at" field to the value that was provided on create.
+func (u *ReceiptFileUpsertOne) UpdateUploadedAt() *ReceiptFileUpsertOne {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateUploadedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReceiptFileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReceiptFileUpsertOne.ID is not supported by MySQL driver. Use ReceiptFileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReceiptFileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ReceiptFileCreateBulk is the builder for creating many ReceiptFile entities in bulk.
 type ReceiptFileCreateBulk struct {
 	config
 	err      error
 	builders []*ReceiptFileCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ReceiptFile entities in the database.
@@ -332,6 +667,7 @@ func (_c *ReceiptFileCreateBulk) Save(ctx context.Context) ([]*ReceiptFile, erro
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -381,3 +717,222 @@ func (_c *ReceiptFileCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReceiptFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReceiptFileUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReceiptFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReceiptFileUpsertBulk {
+	_c.conflict = opts
+	return &ReceiptFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReceiptFileCreateBulk) OnConflictColumns(columns ...string) *ReceiptFileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReceiptFileUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReceiptFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReceiptFile nodes.
+type ReceiptFileUpsertBulk struct {
+	create *ReceiptFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(receiptfile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReceiptFileUpsertBulk) UpdateNewValues() *ReceiptFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(receiptfile.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReceiptFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReceiptFileUpsertBulk) Ignore() *ReceiptFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReceiptFileUpsertBulk) DoNothing() *ReceiptFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReceiptFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReceiptFileUpsertBulk) Update(set func(*ReceiptFileUpsert)) *ReceiptFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReceiptFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *ReceiptFileUpsertBulk) SetProfileID(v uuid.UUID) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateProfileID() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetSourcePath sets the "source_path" field.
+func (u *ReceiptFileUpsertBulk) SetSourcePath(v string) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetSourcePath(v)
+	})
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateSourcePath() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateSourcePath()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *ReceiptFileUpsertBulk) SetContentHash(v []byte) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateContentHash() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ReceiptFileUpsertBulk) SetFilename(v string) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateFilename() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetFileExt sets the "file_ext" field.
+func (u *ReceiptFileUpsertBulk) SetFileExt(v string) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFileExt(v)
+	})
+}
+
+// UpdateFileExt sets the "file_ext" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateFileExt() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFileExt()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ReceiptFileUpsertBulk) SetFileSize(v int) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ReceiptFileUpsertBulk) AddFileSize(v int) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateFileSize() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (u *ReceiptFileUpsertBulk) SetUploadedAt(v time.Time) *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.SetUploadedAt(v)
+	})
+}
+
+// UpdateUploadedAt sets the "uploaded_at" field to the value that was provided on create.
+func (u *ReceiptFileUpsertBulk) UpdateUploadedAt() *ReceiptFileUpsertBulk {
+	return u.Update(func(s *ReceiptFileUpsert) {
+		s.UpdateUploadedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReceiptFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReceiptFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReceiptFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReceiptFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
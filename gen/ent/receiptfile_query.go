@@ -29,6 +29,7 @@ type ReceiptFileQuery struct {
 	withProfile *ProfileQuery
 	withJobs    *ExtractJobQuery
 	withFKs     bool
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -304,8 +305,9 @@ func (_q *ReceiptFileQuery) Clone() *ReceiptFileQuery {
 		withProfile: _q.withProfile.Clone(),
 		withJobs:    _q.withJobs.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -427,6 +429,9 @@ func (_q *ReceiptFileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -514,6 +519,9 @@ func (_q *ReceiptFileQuery) loadJobs(ctx context.Context, query *ExtractJobQuery
 
 func (_q *ReceiptFileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -579,6 +587,9 @@ func (_q *ReceiptFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -596,6 +607,12 @@ func (_q *ReceiptFileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ReceiptFileQuery) Modify(modifiers ...func(s *sql.Selector)) *ReceiptFileSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ReceiptFileGroupBy is the group-by builder for ReceiptFile entities.
 type ReceiptFileGroupBy struct {
 	selector
@@ -685,3 +702,9 @@ func (_s *ReceiptFileSelect) sqlScan(ctx context.Context, root *ReceiptFileQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ReceiptFileSelect) Modify(modifiers ...func(s *sql.Selector)) *ReceiptFileSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -21,8 +21,9 @@ import (
 // ReceiptFileUpdate is the builder for updating ReceiptFile entities.
 type ReceiptFileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ReceiptFileMutation
+	hooks     []Hook
+	mutation  *ReceiptFileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ReceiptFileUpdate builder.
@@ -240,6 +241,12 @@ func (_u *ReceiptFileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptFileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptFileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReceiptFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -347,6 +354,7 @@ func (_u *ReceiptFileUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{receiptfile.Label}
@@ -362,9 +370,10 @@ func (_u *ReceiptFileUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // ReceiptFileUpdateOne is the builder for updating a single ReceiptFile entity.
 type ReceiptFileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ReceiptFileMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ReceiptFileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetProfileID sets the "profile_id" field.
@@ -589,6 +598,12 @@ func (_u *ReceiptFileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReceiptFileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReceiptFileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReceiptFileUpdateOne) sqlSave(ctx context.Context) (_node *ReceiptFile, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -713,6 +728,7 @@ func (_u *ReceiptFileUpdateOne) sqlSave(ctx context.Context) (_node *ReceiptFile
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &ReceiptFile{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
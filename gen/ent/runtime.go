@@ -7,16 +7,150 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/db/ent/schema"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	budgetFields := schema.Budget{}.Fields()
+	_ = budgetFields
+	// budgetDescCategoryName is the schema descriptor for category_name field.
+	budgetDescCategoryName := budgetFields[2].Descriptor()
+	// budget.CategoryNameValidator is a validator for the "category_name" field. It is called by the builders before save.
+	budget.CategoryNameValidator = budgetDescCategoryName.Validators[0].(func(string) error)
+	// budgetDescPeriod is the schema descriptor for period field.
+	budgetDescPeriod := budgetFields[3].Descriptor()
+	// budget.PeriodValidator is a validator for the "period" field. It is called by the builders before save.
+	budget.PeriodValidator = budgetDescPeriod.Validators[0].(func(string) error)
+	// budgetDescCreatedAt is the schema descriptor for created_at field.
+	budgetDescCreatedAt := budgetFields[5].Descriptor()
+	// budget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budget.DefaultCreatedAt = budgetDescCreatedAt.Default.(func() time.Time)
+	// budgetDescUpdatedAt is the schema descriptor for updated_at field.
+	budgetDescUpdatedAt := budgetFields[6].Descriptor()
+	// budget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	budget.DefaultUpdatedAt = budgetDescUpdatedAt.Default.(func() time.Time)
+	// budget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	budget.UpdateDefaultUpdatedAt = budgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// budgetDescID is the schema descriptor for id field.
+	budgetDescID := budgetFields[0].Descriptor()
+	// budget.DefaultID holds the default value on creation for the id field.
+	budget.DefaultID = budgetDescID.Default.(func() uuid.UUID)
+	budgetalertFields := schema.BudgetAlert{}.Fields()
+	_ = budgetalertFields
+	// budgetalertDescCreatedAt is the schema descriptor for created_at field.
+	budgetalertDescCreatedAt := budgetalertFields[8].Descriptor()
+	// budgetalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budgetalert.DefaultCreatedAt = budgetalertDescCreatedAt.Default.(func() time.Time)
+	// budgetalertDescID is the schema descriptor for id field.
+	budgetalertDescID := budgetalertFields[0].Descriptor()
+	// budgetalert.DefaultID holds the default value on creation for the id field.
+	budgetalert.DefaultID = budgetalertDescID.Default.(func() uuid.UUID)
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[2].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescCreatedAt is the schema descriptor for created_at field.
+	categoryDescCreatedAt := categoryFields[7].Descriptor()
+	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
+	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
+	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryDescUpdatedAt := categoryFields[8].Descriptor()
+	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
+	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryDescID is the schema descriptor for id field.
+	categoryDescID := categoryFields[0].Descriptor()
+	// category.DefaultID holds the default value on creation for the id field.
+	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	categorycorrectionFields := schema.CategoryCorrection{}.Fields()
+	_ = categorycorrectionFields
+	// categorycorrectionDescFromCategory is the schema descriptor for from_category field.
+	categorycorrectionDescFromCategory := categorycorrectionFields[5].Descriptor()
+	// categorycorrection.FromCategoryValidator is a validator for the "from_category" field. It is called by the builders before save.
+	categorycorrection.FromCategoryValidator = categorycorrectionDescFromCategory.Validators[0].(func(string) error)
+	// categorycorrectionDescToCategory is the schema descriptor for to_category field.
+	categorycorrectionDescToCategory := categorycorrectionFields[6].Descriptor()
+	// categorycorrection.ToCategoryValidator is a validator for the "to_category" field. It is called by the builders before save.
+	categorycorrection.ToCategoryValidator = categorycorrectionDescToCategory.Validators[0].(func(string) error)
+	// categorycorrectionDescCreatedAt is the schema descriptor for created_at field.
+	categorycorrectionDescCreatedAt := categorycorrectionFields[7].Descriptor()
+	// categorycorrection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categorycorrection.DefaultCreatedAt = categorycorrectionDescCreatedAt.Default.(func() time.Time)
+	// categorycorrectionDescID is the schema descriptor for id field.
+	categorycorrectionDescID := categorycorrectionFields[0].Descriptor()
+	// categorycorrection.DefaultID holds the default value on creation for the id field.
+	categorycorrection.DefaultID = categorycorrectionDescID.Default.(func() uuid.UUID)
+	categoryruleFields := schema.CategoryRule{}.Fields()
+	_ = categoryruleFields
+	// categoryruleDescName is the schema descriptor for name field.
+	categoryruleDescName := categoryruleFields[2].Descriptor()
+	// categoryrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	categoryrule.NameValidator = categoryruleDescName.Validators[0].(func(string) error)
+	// categoryruleDescPriority is the schema descriptor for priority field.
+	categoryruleDescPriority := categoryruleFields[3].Descriptor()
+	// categoryrule.DefaultPriority holds the default value on creation for the priority field.
+	categoryrule.DefaultPriority = categoryruleDescPriority.Default.(int)
+	// categoryruleDescEnabled is the schema descriptor for enabled field.
+	categoryruleDescEnabled := categoryruleFields[4].Descriptor()
+	// categoryrule.DefaultEnabled holds the default value on creation for the enabled field.
+	categoryrule.DefaultEnabled = categoryruleDescEnabled.Default.(bool)
+	// categoryruleDescForceReview is the schema descriptor for force_review field.
+	categoryruleDescForceReview := categoryruleFields[11].Descriptor()
+	// categoryrule.DefaultForceReview holds the default value on creation for the force_review field.
+	categoryrule.DefaultForceReview = categoryruleDescForceReview.Default.(bool)
+	// categoryruleDescCreatedAt is the schema descriptor for created_at field.
+	categoryruleDescCreatedAt := categoryruleFields[13].Descriptor()
+	// categoryrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	categoryrule.DefaultCreatedAt = categoryruleDescCreatedAt.Default.(func() time.Time)
+	// categoryruleDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryruleDescUpdatedAt := categoryruleFields[14].Descriptor()
+	// categoryrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	categoryrule.DefaultUpdatedAt = categoryruleDescUpdatedAt.Default.(func() time.Time)
+	// categoryrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	categoryrule.UpdateDefaultUpdatedAt = categoryruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryruleDescID is the schema descriptor for id field.
+	categoryruleDescID := categoryruleFields[0].Descriptor()
+	// categoryrule.DefaultID holds the default value on creation for the id field.
+	categoryrule.DefaultID = categoryruleDescID.Default.(func() uuid.UUID)
+	exporttemplateFields := schema.ExportTemplate{}.Fields()
+	_ = exporttemplateFields
+	// exporttemplateDescName is the schema descriptor for name field.
+	exporttemplateDescName := exporttemplateFields[2].Descriptor()
+	// exporttemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	exporttemplate.NameValidator = exporttemplateDescName.Validators[0].(func(string) error)
+	// exporttemplateDescCreatedAt is the schema descriptor for created_at field.
+	exporttemplateDescCreatedAt := exporttemplateFields[4].Descriptor()
+	// exporttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exporttemplate.DefaultCreatedAt = exporttemplateDescCreatedAt.Default.(func() time.Time)
+	// exporttemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	exporttemplateDescUpdatedAt := exporttemplateFields[5].Descriptor()
+	// exporttemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exporttemplate.DefaultUpdatedAt = exporttemplateDescUpdatedAt.Default.(func() time.Time)
+	// exporttemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exporttemplate.UpdateDefaultUpdatedAt = exporttemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exporttemplateDescID is the schema descriptor for id field.
+	exporttemplateDescID := exporttemplateFields[0].Descriptor()
+	// exporttemplate.DefaultID holds the default value on creation for the id field.
+	exporttemplate.DefaultID = exporttemplateDescID.Default.(func() uuid.UUID)
 	extractjobFields := schema.ExtractJob{}.Fields()
 	_ = extractjobFields
 	// extractjobDescFormat is the schema descriptor for format field.
@@ -31,10 +165,90 @@ func init() {
 	extractjobDescNeedsReview := extractjobFields[10].Descriptor()
 	// extractjob.DefaultNeedsReview holds the default value on creation for the needs_review field.
 	extractjob.DefaultNeedsReview = extractjobDescNeedsReview.Default.(bool)
+	// extractjobDescLlmCacheHit is the schema descriptor for llm_cache_hit field.
+	extractjobDescLlmCacheHit := extractjobFields[19].Descriptor()
+	// extractjob.DefaultLlmCacheHit holds the default value on creation for the llm_cache_hit field.
+	extractjob.DefaultLlmCacheHit = extractjobDescLlmCacheHit.Default.(bool)
 	// extractjobDescID is the schema descriptor for id field.
 	extractjobDescID := extractjobFields[0].Descriptor()
 	// extractjob.DefaultID holds the default value on creation for the id field.
 	extractjob.DefaultID = extractjobDescID.Default.(func() uuid.UUID)
+	fxrateFields := schema.FxRate{}.Fields()
+	_ = fxrateFields
+	// fxrateDescBase is the schema descriptor for base field.
+	fxrateDescBase := fxrateFields[1].Descriptor()
+	// fxrate.BaseValidator is a validator for the "base" field. It is called by the builders before save.
+	fxrate.BaseValidator = func() func(string) error {
+		validators := fxrateDescBase.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(base string) error {
+			for _, fn := range fns {
+				if err := fn(base); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fxrateDescQuote is the schema descriptor for quote field.
+	fxrateDescQuote := fxrateFields[2].Descriptor()
+	// fxrate.QuoteValidator is a validator for the "quote" field. It is called by the builders before save.
+	fxrate.QuoteValidator = func() func(string) error {
+		validators := fxrateDescQuote.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(quote string) error {
+			for _, fn := range fns {
+				if err := fn(quote); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fxrateDescRate is the schema descriptor for rate field.
+	fxrateDescRate := fxrateFields[4].Descriptor()
+	// fxrate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	fxrate.RateValidator = fxrateDescRate.Validators[0].(func(float64) error)
+	// fxrateDescSource is the schema descriptor for source field.
+	fxrateDescSource := fxrateFields[5].Descriptor()
+	// fxrate.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	fxrate.SourceValidator = fxrateDescSource.Validators[0].(func(string) error)
+	// fxrateDescCreatedAt is the schema descriptor for created_at field.
+	fxrateDescCreatedAt := fxrateFields[6].Descriptor()
+	// fxrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fxrate.DefaultCreatedAt = fxrateDescCreatedAt.Default.(func() time.Time)
+	// fxrateDescID is the schema descriptor for id field.
+	fxrateDescID := fxrateFields[0].Descriptor()
+	// fxrate.DefaultID holds the default value on creation for the id field.
+	fxrate.DefaultID = fxrateDescID.Default.(func() uuid.UUID)
+	merchantFields := schema.Merchant{}.Fields()
+	_ = merchantFields
+	// merchantDescName is the schema descriptor for name field.
+	merchantDescName := merchantFields[2].Descriptor()
+	// merchant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	merchant.NameValidator = merchantDescName.Validators[0].(func(string) error)
+	// merchantDescCreatedAt is the schema descriptor for created_at field.
+	merchantDescCreatedAt := merchantFields[6].Descriptor()
+	// merchant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	merchant.DefaultCreatedAt = merchantDescCreatedAt.Default.(func() time.Time)
+	// merchantDescUpdatedAt is the schema descriptor for updated_at field.
+	merchantDescUpdatedAt := merchantFields[7].Descriptor()
+	// merchant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	merchant.DefaultUpdatedAt = merchantDescUpdatedAt.Default.(func() time.Time)
+	// merchant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	merchant.UpdateDefaultUpdatedAt = merchantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// merchantDescID is the schema descriptor for id field.
+	merchantDescID := merchantFields[0].Descriptor()
+	// merchant.DefaultID holds the default value on creation for the id field.
+	merchant.DefaultID = merchantDescID.Default.(func() uuid.UUID)
 	profileFields := schema.Profile{}.Fields()
 	_ = profileFields
 	// profileDescName is the schema descriptor for name field.
@@ -81,7 +295,7 @@ func init() {
 	// receipt.MerchantNameValidator is a validator for the "merchant_name" field. It is called by the builders before save.
 	receipt.MerchantNameValidator = receiptDescMerchantName.Validators[0].(func(string) error)
 	// receiptDescCurrencyCode is the schema descriptor for currency_code field.
-	receiptDescCurrencyCode := receiptFields[8].Descriptor()
+	receiptDescCurrencyCode := receiptFields[13].Descriptor()
 	// receipt.CurrencyCodeValidator is a validator for the "currency_code" field. It is called by the builders before save.
 	receipt.CurrencyCodeValidator = func() func(string) error {
 		validators := receiptDescCurrencyCode.Validators
@@ -100,19 +314,19 @@ func init() {
 		}
 	}()
 	// receiptDescCategoryName is the schema descriptor for category_name field.
-	receiptDescCategoryName := receiptFields[9].Descriptor()
+	receiptDescCategoryName := receiptFields[19].Descriptor()
 	// receipt.CategoryNameValidator is a validator for the "category_name" field. It is called by the builders before save.
 	receipt.CategoryNameValidator = receiptDescCategoryName.Validators[0].(func(string) error)
 	// receiptDescIsCurrent is the schema descriptor for is_current field.
-	receiptDescIsCurrent := receiptFields[12].Descriptor()
+	receiptDescIsCurrent := receiptFields[22].Descriptor()
 	// receipt.DefaultIsCurrent holds the default value on creation for the is_current field.
 	receipt.DefaultIsCurrent = receiptDescIsCurrent.Default.(bool)
 	// receiptDescCreatedAt is the schema descriptor for created_at field.
-	receiptDescCreatedAt := receiptFields[13].Descriptor()
+	receiptDescCreatedAt := receiptFields[23].Descriptor()
 	// receipt.DefaultCreatedAt holds the default value on creation for the created_at field.
 	receipt.DefaultCreatedAt = receiptDescCreatedAt.Default.(func() time.Time)
 	// receiptDescUpdatedAt is the schema descriptor for updated_at field.
-	receiptDescUpdatedAt := receiptFields[14].Descriptor()
+	receiptDescUpdatedAt := receiptFields[24].Descriptor()
 	// receipt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	receipt.DefaultUpdatedAt = receiptDescUpdatedAt.Default.(func() time.Time)
 	// receipt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -121,6 +335,24 @@ func init() {
 	receiptDescID := receiptFields[0].Descriptor()
 	// receipt.DefaultID holds the default value on creation for the id field.
 	receipt.DefaultID = receiptDescID.Default.(func() uuid.UUID)
+	receiptanomalyFields := schema.ReceiptAnomaly{}.Fields()
+	_ = receiptanomalyFields
+	// receiptanomalyDescKind is the schema descriptor for kind field.
+	receiptanomalyDescKind := receiptanomalyFields[3].Descriptor()
+	// receiptanomaly.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	receiptanomaly.KindValidator = receiptanomalyDescKind.Validators[0].(func(string) error)
+	// receiptanomalyDescReason is the schema descriptor for reason field.
+	receiptanomalyDescReason := receiptanomalyFields[4].Descriptor()
+	// receiptanomaly.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	receiptanomaly.ReasonValidator = receiptanomalyDescReason.Validators[0].(func(string) error)
+	// receiptanomalyDescCreatedAt is the schema descriptor for created_at field.
+	receiptanomalyDescCreatedAt := receiptanomalyFields[6].Descriptor()
+	// receiptanomaly.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receiptanomaly.DefaultCreatedAt = receiptanomalyDescCreatedAt.Default.(func() time.Time)
+	// receiptanomalyDescID is the schema descriptor for id field.
+	receiptanomalyDescID := receiptanomalyFields[0].Descriptor()
+	// receiptanomaly.DefaultID holds the default value on creation for the id field.
+	receiptanomaly.DefaultID = receiptanomalyDescID.Default.(func() uuid.UUID)
 	receiptfileFields := schema.ReceiptFile{}.Fields()
 	_ = receiptfileFields
 	// receiptfileDescSourcePath is the schema descriptor for source_path field.
@@ -151,4 +383,45 @@ func init() {
 	receiptfileDescID := receiptfileFields[0].Descriptor()
 	// receiptfile.DefaultID holds the default value on creation for the id field.
 	receiptfile.DefaultID = receiptfileDescID.Default.(func() uuid.UUID)
+	statementtransactionFields := schema.StatementTransaction{}.Fields()
+	_ = statementtransactionFields
+	// statementtransactionDescAccount is the schema descriptor for account field.
+	statementtransactionDescAccount := statementtransactionFields[2].Descriptor()
+	// statementtransaction.DefaultAccount holds the default value on creation for the account field.
+	statementtransaction.DefaultAccount = statementtransactionDescAccount.Default.(string)
+	// statementtransactionDescDescription is the schema descriptor for description field.
+	statementtransactionDescDescription := statementtransactionFields[4].Descriptor()
+	// statementtransaction.DefaultDescription holds the default value on creation for the description field.
+	statementtransaction.DefaultDescription = statementtransactionDescDescription.Default.(string)
+	// statementtransactionDescCurrencyCode is the schema descriptor for currency_code field.
+	statementtransactionDescCurrencyCode := statementtransactionFields[6].Descriptor()
+	// statementtransaction.CurrencyCodeValidator is a validator for the "currency_code" field. It is called by the builders before save.
+	statementtransaction.CurrencyCodeValidator = func() func(string) error {
+		validators := statementtransactionDescCurrencyCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(currency_code string) error {
+			for _, fn := range fns {
+				if err := fn(currency_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// statementtransactionDescExternalID is the schema descriptor for external_id field.
+	statementtransactionDescExternalID := statementtransactionFields[7].Descriptor()
+	// statementtransaction.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	statementtransaction.ExternalIDValidator = statementtransactionDescExternalID.Validators[0].(func(string) error)
+	// statementtransactionDescCreatedAt is the schema descriptor for created_at field.
+	statementtransactionDescCreatedAt := statementtransactionFields[8].Descriptor()
+	// statementtransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	statementtransaction.DefaultCreatedAt = statementtransactionDescCreatedAt.Default.(func() time.Time)
+	// statementtransactionDescID is the schema descriptor for id field.
+	statementtransactionDescID := statementtransactionFields[0].Descriptor()
+	// statementtransaction.DefaultID holds the default value on creation for the id field.
+	statementtransaction.DefaultID = statementtransactionDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statementtransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// ProfileID applies equality check predicate on the "profile_id" field. It's identical to ProfileIDEQ.
+func ProfileID(v uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldProfileID, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldAccount, v))
+}
+
+// PostedDate applies equality check predicate on the "posted_date" field. It's identical to PostedDateEQ.
+func PostedDate(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldPostedDate, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// CurrencyCode applies equality check predicate on the "currency_code" field. It's identical to CurrencyCodeEQ.
+func CurrencyCode(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldCurrencyCode, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldExternalID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProfileIDEQ applies the EQ predicate on the "profile_id" field.
+func ProfileIDEQ(v uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldProfileID, v))
+}
+
+// ProfileIDNEQ applies the NEQ predicate on the "profile_id" field.
+func ProfileIDNEQ(v uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldProfileID, v))
+}
+
+// ProfileIDIn applies the In predicate on the "profile_id" field.
+func ProfileIDIn(vs ...uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldProfileID, vs...))
+}
+
+// ProfileIDNotIn applies the NotIn predicate on the "profile_id" field.
+func ProfileIDNotIn(vs ...uuid.UUID) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldProfileID, vs...))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// PostedDateEQ applies the EQ predicate on the "posted_date" field.
+func PostedDateEQ(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldPostedDate, v))
+}
+
+// PostedDateNEQ applies the NEQ predicate on the "posted_date" field.
+func PostedDateNEQ(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldPostedDate, v))
+}
+
+// PostedDateIn applies the In predicate on the "posted_date" field.
+func PostedDateIn(vs ...time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldPostedDate, vs...))
+}
+
+// PostedDateNotIn applies the NotIn predicate on the "posted_date" field.
+func PostedDateNotIn(vs ...time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldPostedDate, vs...))
+}
+
+// PostedDateGT applies the GT predicate on the "posted_date" field.
+func PostedDateGT(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldPostedDate, v))
+}
+
+// PostedDateGTE applies the GTE predicate on the "posted_date" field.
+func PostedDateGTE(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldPostedDate, v))
+}
+
+// PostedDateLT applies the LT predicate on the "posted_date" field.
+func PostedDateLT(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldPostedDate, v))
+}
+
+// PostedDateLTE applies the LTE predicate on the "posted_date" field.
+func PostedDateLTE(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldPostedDate, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyCodeEQ applies the EQ predicate on the "currency_code" field.
+func CurrencyCodeEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeNEQ applies the NEQ predicate on the "currency_code" field.
+func CurrencyCodeNEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeIn applies the In predicate on the "currency_code" field.
+func CurrencyCodeIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldCurrencyCode, vs...))
+}
+
+// CurrencyCodeNotIn applies the NotIn predicate on the "currency_code" field.
+func CurrencyCodeNotIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldCurrencyCode, vs...))
+}
+
+// CurrencyCodeGT applies the GT predicate on the "currency_code" field.
+func CurrencyCodeGT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeGTE applies the GTE predicate on the "currency_code" field.
+func CurrencyCodeGTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeLT applies the LT predicate on the "currency_code" field.
+func CurrencyCodeLT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeLTE applies the LTE predicate on the "currency_code" field.
+func CurrencyCodeLTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeContains applies the Contains predicate on the "currency_code" field.
+func CurrencyCodeContains(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContains(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeHasPrefix applies the HasPrefix predicate on the "currency_code" field.
+func CurrencyCodeHasPrefix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasPrefix(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeHasSuffix applies the HasSuffix predicate on the "currency_code" field.
+func CurrencyCodeHasSuffix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasSuffix(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeEqualFold applies the EqualFold predicate on the "currency_code" field.
+func CurrencyCodeEqualFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEqualFold(FieldCurrencyCode, v))
+}
+
+// CurrencyCodeContainsFold applies the ContainsFold predicate on the "currency_code" field.
+func CurrencyCodeContainsFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContainsFold(FieldCurrencyCode, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProfile applies the HasEdge predicate on the "profile" edge.
+func HasProfile() predicate.StatementTransaction {
+	return predicate.StatementTransaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProfileTable, ProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileWith applies the HasEdge predicate on the "profile" edge with a given conditions (other predicates).
+func HasProfileWith(preds ...predicate.Profile) predicate.StatementTransaction {
+	return predicate.StatementTransaction(func(s *sql.Selector) {
+		step := newProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatementTransaction) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatementTransaction) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatementTransaction) predicate.StatementTransaction {
+	return predicate.StatementTransaction(sql.NotPredicates(p))
+}
@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// StatementTransactionCreate is the builder for creating a StatementTransaction entity.
+type StatementTransactionCreate struct {
+	config
+	mutation *StatementTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_c *StatementTransactionCreate) SetProfileID(v uuid.UUID) *StatementTransactionCreate {
+	_c.mutation.SetProfileID(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *StatementTransactionCreate) SetAccount(v string) *StatementTransactionCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_c *StatementTransactionCreate) SetNillableAccount(v *string) *StatementTransactionCreate {
+	if v != nil {
+		_c.SetAccount(*v)
+	}
+	return _c
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (_c *StatementTransactionCreate) SetPostedDate(v time.Time) *StatementTransactionCreate {
+	_c.mutation.SetPostedDate(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *StatementTransactionCreate) SetDescription(v string) *StatementTransactionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *StatementTransactionCreate) SetNillableDescription(v *string) *StatementTransactionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *StatementTransactionCreate) SetAmount(v money.Amount) *StatementTransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (_c *StatementTransactionCreate) SetCurrencyCode(v string) *StatementTransactionCreate {
+	_c.mutation.SetCurrencyCode(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *StatementTransactionCreate) SetExternalID(v string) *StatementTransactionCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StatementTransactionCreate) SetCreatedAt(v time.Time) *StatementTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StatementTransactionCreate) SetNillableCreatedAt(v *time.Time) *StatementTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StatementTransactionCreate) SetID(v uuid.UUID) *StatementTransactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *StatementTransactionCreate) SetNillableID(v *uuid.UUID) *StatementTransactionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_c *StatementTransactionCreate) SetProfile(v *Profile) *StatementTransactionCreate {
+	return _c.SetProfileID(v.ID)
+}
+
+// Mutation returns the StatementTransactionMutation object of the builder.
+func (_c *StatementTransactionCreate) Mutation() *StatementTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the StatementTransaction in the database.
+func (_c *StatementTransactionCreate) Save(ctx context.Context) (*StatementTransaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StatementTransactionCreate) SaveX(ctx context.Context) *StatementTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatementTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatementTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StatementTransactionCreate) defaults() {
+	if _, ok := _c.mutation.Account(); !ok {
+		v := statementtransaction.DefaultAccount
+		_c.mutation.SetAccount(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := statementtransaction.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := statementtransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := statementtransaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StatementTransactionCreate) check() error {
+	if _, ok := _c.mutation.ProfileID(); !ok {
+		return &ValidationError{Name: "profile_id", err: errors.New(`ent: missing required field "StatementTransaction.profile_id"`)}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "StatementTransaction.account"`)}
+	}
+	if _, ok := _c.mutation.PostedDate(); !ok {
+		return &ValidationError{Name: "posted_date", err: errors.New(`ent: missing required field "StatementTransaction.posted_date"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "StatementTransaction.description"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "StatementTransaction.amount"`)}
+	}
+	if _, ok := _c.mutation.CurrencyCode(); !ok {
+		return &ValidationError{Name: "currency_code", err: errors.New(`ent: missing required field "StatementTransaction.currency_code"`)}
+	}
+	if v, ok := _c.mutation.CurrencyCode(); ok {
+		if err := statementtransaction.CurrencyCodeValidator(v); err != nil {
+			return &ValidationError{Name: "currency_code", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.currency_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "StatementTransaction.external_id"`)}
+	}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := statementtransaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.external_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StatementTransaction.created_at"`)}
+	}
+	if len(_c.mutation.ProfileIDs()) == 0 {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required edge "StatementTransaction.profile"`)}
+	}
+	return nil
+}
+
+func (_c *StatementTransactionCreate) sqlSave(ctx context.Context) (*StatementTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StatementTransactionCreate) createSpec() (*StatementTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatementTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(statementtransaction.Table, sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(statementtransaction.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.PostedDate(); ok {
+		_spec.SetField(statementtransaction.FieldPostedDate, field.TypeTime, value)
+		_node.PostedDate = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(statementtransaction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(statementtransaction.FieldAmount, field.TypeOther, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CurrencyCode(); ok {
+		_spec.SetField(statementtransaction.FieldCurrencyCode, field.TypeString, value)
+		_node.CurrencyCode = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(statementtransaction.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(statementtransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementtransaction.ProfileTable,
+			Columns: []string{statementtransaction.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProfileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StatementTransaction.Create().
+//		SetProfileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StatementTransactionUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StatementTransactionCreate) OnConflict(opts ...sql.ConflictOption) *StatementTransactionUpsertOne {
+	_c.conflict = opts
+	return &StatementTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StatementTransactionCreate) OnConflictColumns(columns ...string) *StatementTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StatementTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StatementTransactionUpsertOne is the builder for "upsert"-ing
+	//  one StatementTransaction node.
+	StatementTransactionUpsertOne struct {
+		create *StatementTransactionCreate
+	}
+
+	// StatementTransactionUpsert is the "OnConflict" setter.
+	StatementTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProfileID sets the "profile_id" field.
+func (u *StatementTransactionUpsert) SetProfileID(v uuid.UUID) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldProfileID, v)
+	return u
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateProfileID() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldProfileID)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *StatementTransactionUpsert) SetAccount(v string) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateAccount() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldAccount)
+	return u
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (u *StatementTransactionUpsert) SetPostedDate(v time.Time) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldPostedDate, v)
+	return u
+}
+
+// UpdatePostedDate sets the "posted_date" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdatePostedDate() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldPostedDate)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *StatementTransactionUpsert) SetDescription(v string) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateDescription() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldDescription)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *StatementTransactionUpsert) SetAmount(v money.Amount) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateAmount() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldAmount)
+	return u
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *StatementTransactionUpsert) SetCurrencyCode(v string) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldCurrencyCode, v)
+	return u
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateCurrencyCode() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldCurrencyCode)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *StatementTransactionUpsert) SetExternalID(v string) *StatementTransactionUpsert {
+	u.Set(statementtransaction.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsert) UpdateExternalID() *StatementTransactionUpsert {
+	u.SetExcluded(statementtransaction.FieldExternalID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(statementtransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StatementTransactionUpsertOne) UpdateNewValues() *StatementTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(statementtransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(statementtransaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StatementTransactionUpsertOne) Ignore() *StatementTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StatementTransactionUpsertOne) DoNothing() *StatementTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StatementTransactionCreate.OnConflict
+// documentation for more info.
+func (u *StatementTransactionUpsertOne) Update(set func(*StatementTransactionUpsert)) *StatementTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StatementTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *StatementTransactionUpsertOne) SetProfileID(v uuid.UUID) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateProfileID() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StatementTransactionUpsertOne) SetAccount(v string) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateAccount() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (u *StatementTransactionUpsertOne) SetPostedDate(v time.Time) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetPostedDate(v)
+	})
+}
+
+// UpdatePostedDate sets the "posted_date" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdatePostedDate() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdatePostedDate()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *StatementTransactionUpsertOne) SetDescription(v string) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateDescription() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *StatementTransactionUpsertOne) SetAmount(v money.Amount) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateAmount() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *StatementTransactionUpsertOne) SetCurrencyCode(v string) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetCurrencyCode(v)
+	})
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateCurrencyCode() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateCurrencyCode()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *StatementTransactionUpsertOne) SetExternalID(v string) *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsertOne) UpdateExternalID() *StatementTransactionUpsertOne {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// Exec executes the query.
+func (u *StatementTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StatementTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StatementTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StatementTransactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: StatementTransactionUpsertOne.ID is not supported by MySQL driver. Use StatementTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StatementTransactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StatementTransactionCreateBulk is the builder for creating many StatementTransaction entities in bulk.
+type StatementTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*StatementTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StatementTransaction entities in the database.
+func (_c *StatementTransactionCreateBulk) Save(ctx context.Context) ([]*StatementTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StatementTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatementTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StatementTransactionCreateBulk) SaveX(ctx context.Context) []*StatementTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StatementTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StatementTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StatementTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StatementTransactionUpsert) {
+//			SetProfileID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StatementTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *StatementTransactionUpsertBulk {
+	_c.conflict = opts
+	return &StatementTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StatementTransactionCreateBulk) OnConflictColumns(columns ...string) *StatementTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StatementTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// StatementTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of StatementTransaction nodes.
+type StatementTransactionUpsertBulk struct {
+	create *StatementTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(statementtransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StatementTransactionUpsertBulk) UpdateNewValues() *StatementTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(statementtransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(statementtransaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StatementTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StatementTransactionUpsertBulk) Ignore() *StatementTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StatementTransactionUpsertBulk) DoNothing() *StatementTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StatementTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *StatementTransactionUpsertBulk) Update(set func(*StatementTransactionUpsert)) *StatementTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StatementTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (u *StatementTransactionUpsertBulk) SetProfileID(v uuid.UUID) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetProfileID(v)
+	})
+}
+
+// UpdateProfileID sets the "profile_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateProfileID() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateProfileID()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StatementTransactionUpsertBulk) SetAccount(v string) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateAccount() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (u *StatementTransactionUpsertBulk) SetPostedDate(v time.Time) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetPostedDate(v)
+	})
+}
+
+// UpdatePostedDate sets the "posted_date" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdatePostedDate() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdatePostedDate()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *StatementTransactionUpsertBulk) SetDescription(v string) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateDescription() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *StatementTransactionUpsertBulk) SetAmount(v money.Amount) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateAmount() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (u *StatementTransactionUpsertBulk) SetCurrencyCode(v string) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetCurrencyCode(v)
+	})
+}
+
+// UpdateCurrencyCode sets the "currency_code" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateCurrencyCode() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateCurrencyCode()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *StatementTransactionUpsertBulk) SetExternalID(v string) *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *StatementTransactionUpsertBulk) UpdateExternalID() *StatementTransactionUpsertBulk {
+	return u.Update(func(s *StatementTransactionUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// Exec executes the query.
+func (u *StatementTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StatementTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StatementTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StatementTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
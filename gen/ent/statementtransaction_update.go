@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// StatementTransactionUpdate is the builder for updating StatementTransaction entities.
+type StatementTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StatementTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StatementTransactionUpdate builder.
+func (_u *StatementTransactionUpdate) Where(ps ...predicate.StatementTransaction) *StatementTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *StatementTransactionUpdate) SetProfileID(v uuid.UUID) *StatementTransactionUpdate {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableProfileID(v *uuid.UUID) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *StatementTransactionUpdate) SetAccount(v string) *StatementTransactionUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableAccount(v *string) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (_u *StatementTransactionUpdate) SetPostedDate(v time.Time) *StatementTransactionUpdate {
+	_u.mutation.SetPostedDate(v)
+	return _u
+}
+
+// SetNillablePostedDate sets the "posted_date" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillablePostedDate(v *time.Time) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetPostedDate(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StatementTransactionUpdate) SetDescription(v string) *StatementTransactionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableDescription(v *string) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *StatementTransactionUpdate) SetAmount(v money.Amount) *StatementTransactionUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableAmount(v *money.Amount) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (_u *StatementTransactionUpdate) SetCurrencyCode(v string) *StatementTransactionUpdate {
+	_u.mutation.SetCurrencyCode(v)
+	return _u
+}
+
+// SetNillableCurrencyCode sets the "currency_code" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableCurrencyCode(v *string) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetCurrencyCode(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *StatementTransactionUpdate) SetExternalID(v string) *StatementTransactionUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *StatementTransactionUpdate) SetNillableExternalID(v *string) *StatementTransactionUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *StatementTransactionUpdate) SetProfile(v *Profile) *StatementTransactionUpdate {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the StatementTransactionMutation object of the builder.
+func (_u *StatementTransactionUpdate) Mutation() *StatementTransactionMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *StatementTransactionUpdate) ClearProfile() *StatementTransactionUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StatementTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatementTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StatementTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatementTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StatementTransactionUpdate) check() error {
+	if v, ok := _u.mutation.CurrencyCode(); ok {
+		if err := statementtransaction.CurrencyCodeValidator(v); err != nil {
+			return &ValidationError{Name: "currency_code", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.currency_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := statementtransaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.external_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementTransaction.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatementTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatementTransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatementTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statementtransaction.Table, statementtransaction.Columns, sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(statementtransaction.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PostedDate(); ok {
+		_spec.SetField(statementtransaction.FieldPostedDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(statementtransaction.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(statementtransaction.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CurrencyCode(); ok {
+		_spec.SetField(statementtransaction.FieldCurrencyCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(statementtransaction.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementtransaction.ProfileTable,
+			Columns: []string{statementtransaction.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementtransaction.ProfileTable,
+			Columns: []string{statementtransaction.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statementtransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StatementTransactionUpdateOne is the builder for updating a single StatementTransaction entity.
+type StatementTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StatementTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProfileID sets the "profile_id" field.
+func (_u *StatementTransactionUpdateOne) SetProfileID(v uuid.UUID) *StatementTransactionUpdateOne {
+	_u.mutation.SetProfileID(v)
+	return _u
+}
+
+// SetNillableProfileID sets the "profile_id" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableProfileID(v *uuid.UUID) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetProfileID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *StatementTransactionUpdateOne) SetAccount(v string) *StatementTransactionUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableAccount(v *string) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetPostedDate sets the "posted_date" field.
+func (_u *StatementTransactionUpdateOne) SetPostedDate(v time.Time) *StatementTransactionUpdateOne {
+	_u.mutation.SetPostedDate(v)
+	return _u
+}
+
+// SetNillablePostedDate sets the "posted_date" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillablePostedDate(v *time.Time) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetPostedDate(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StatementTransactionUpdateOne) SetDescription(v string) *StatementTransactionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableDescription(v *string) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *StatementTransactionUpdateOne) SetAmount(v money.Amount) *StatementTransactionUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableAmount(v *money.Amount) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetCurrencyCode sets the "currency_code" field.
+func (_u *StatementTransactionUpdateOne) SetCurrencyCode(v string) *StatementTransactionUpdateOne {
+	_u.mutation.SetCurrencyCode(v)
+	return _u
+}
+
+// SetNillableCurrencyCode sets the "currency_code" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableCurrencyCode(v *string) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrencyCode(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *StatementTransactionUpdateOne) SetExternalID(v string) *StatementTransactionUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *StatementTransactionUpdateOne) SetNillableExternalID(v *string) *StatementTransactionUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" edge to the Profile entity.
+func (_u *StatementTransactionUpdateOne) SetProfile(v *Profile) *StatementTransactionUpdateOne {
+	return _u.SetProfileID(v.ID)
+}
+
+// Mutation returns the StatementTransactionMutation object of the builder.
+func (_u *StatementTransactionUpdateOne) Mutation() *StatementTransactionMutation {
+	return _u.mutation
+}
+
+// ClearProfile clears the "profile" edge to the Profile entity.
+func (_u *StatementTransactionUpdateOne) ClearProfile() *StatementTransactionUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// Where appends a list predicates to the StatementTransactionUpdate builder.
+func (_u *StatementTransactionUpdateOne) Where(ps ...predicate.StatementTransaction) *StatementTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StatementTransactionUpdateOne) Select(field string, fields ...string) *StatementTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StatementTransaction entity.
+func (_u *StatementTransactionUpdateOne) Save(ctx context.Context) (*StatementTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StatementTransactionUpdateOne) SaveX(ctx context.Context) *StatementTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StatementTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StatementTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StatementTransactionUpdateOne) check() error {
+	if v, ok := _u.mutation.CurrencyCode(); ok {
+		if err := statementtransaction.CurrencyCodeValidator(v); err != nil {
+			return &ValidationError{Name: "currency_code", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.currency_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := statementtransaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "StatementTransaction.external_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ProfileCleared() && len(_u.mutation.ProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatementTransaction.profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *StatementTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StatementTransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *StatementTransactionUpdateOne) sqlSave(ctx context.Context) (_node *StatementTransaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statementtransaction.Table, statementtransaction.Columns, sqlgraph.NewFieldSpec(statementtransaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatementTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statementtransaction.FieldID)
+		for _, f := range fields {
+			if !statementtransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statementtransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(statementtransaction.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PostedDate(); ok {
+		_spec.SetField(statementtransaction.FieldPostedDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(statementtransaction.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(statementtransaction.FieldAmount, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CurrencyCode(); ok {
+		_spec.SetField(statementtransaction.FieldCurrencyCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(statementtransaction.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementtransaction.ProfileTable,
+			Columns: []string{statementtransaction.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statementtransaction.ProfileTable,
+			Columns: []string{statementtransaction.ProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(profile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &StatementTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statementtransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,14 +14,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// CategoryCorrection is the client for interacting with the CategoryCorrection builders.
+	CategoryCorrection *CategoryCorrectionClient
+	// CategoryRule is the client for interacting with the CategoryRule builders.
+	CategoryRule *CategoryRuleClient
+	// ExportTemplate is the client for interacting with the ExportTemplate builders.
+	ExportTemplate *ExportTemplateClient
 	// ExtractJob is the client for interacting with the ExtractJob builders.
 	ExtractJob *ExtractJobClient
+	// FxRate is the client for interacting with the FxRate builders.
+	FxRate *FxRateClient
+	// Merchant is the client for interacting with the Merchant builders.
+	Merchant *MerchantClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
 	// Receipt is the client for interacting with the Receipt builders.
 	Receipt *ReceiptClient
+	// ReceiptAnomaly is the client for interacting with the ReceiptAnomaly builders.
+	ReceiptAnomaly *ReceiptAnomalyClient
 	// ReceiptFile is the client for interacting with the ReceiptFile builders.
 	ReceiptFile *ReceiptFileClient
+	// StatementTransaction is the client for interacting with the StatementTransaction builders.
+	StatementTransaction *StatementTransactionClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +173,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Budget = NewBudgetClient(tx.config)
+	tx.BudgetAlert = NewBudgetAlertClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
+	tx.CategoryCorrection = NewCategoryCorrectionClient(tx.config)
+	tx.CategoryRule = NewCategoryRuleClient(tx.config)
+	tx.ExportTemplate = NewExportTemplateClient(tx.config)
 	tx.ExtractJob = NewExtractJobClient(tx.config)
+	tx.FxRate = NewFxRateClient(tx.config)
+	tx.Merchant = NewMerchantClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
 	tx.Receipt = NewReceiptClient(tx.config)
+	tx.ReceiptAnomaly = NewReceiptAnomalyClient(tx.config)
 	tx.ReceiptFile = NewReceiptFileClient(tx.config)
+	tx.StatementTransaction = NewStatementTransactionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +196,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ExtractJob.QueryXXX(), the query will be executed
+// applies a query, for example: Budget.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -217,3 +249,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
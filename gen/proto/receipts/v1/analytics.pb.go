@@ -0,0 +1,942 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v6.32.1
+// source: api/receipts/v1/analytics.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpendGroup int32
+
+const (
+	SpendGroup_SPEND_GROUP_UNSPECIFIED SpendGroup = 0 // no grouping: one total for the range
+	SpendGroup_SPEND_GROUP_CATEGORY    SpendGroup = 1
+	SpendGroup_SPEND_GROUP_MERCHANT    SpendGroup = 2 // merchant directory name, else the extracted name
+	SpendGroup_SPEND_GROUP_MONTH       SpendGroup = 3 // YYYY-MM
+	SpendGroup_SPEND_GROUP_QUARTER     SpendGroup = 4 // YYYY-Qn
+	SpendGroup_SPEND_GROUP_YEAR        SpendGroup = 5 // YYYY
+)
+
+// Enum value maps for SpendGroup.
+var (
+	SpendGroup_name = map[int32]string{
+		0: "SPEND_GROUP_UNSPECIFIED",
+		1: "SPEND_GROUP_CATEGORY",
+		2: "SPEND_GROUP_MERCHANT",
+		3: "SPEND_GROUP_MONTH",
+		4: "SPEND_GROUP_QUARTER",
+		5: "SPEND_GROUP_YEAR",
+	}
+	SpendGroup_value = map[string]int32{
+		"SPEND_GROUP_UNSPECIFIED": 0,
+		"SPEND_GROUP_CATEGORY":    1,
+		"SPEND_GROUP_MERCHANT":    2,
+		"SPEND_GROUP_MONTH":       3,
+		"SPEND_GROUP_QUARTER":     4,
+		"SPEND_GROUP_YEAR":        5,
+	}
+)
+
+func (x SpendGroup) Enum() *SpendGroup {
+	p := new(SpendGroup)
+	*p = x
+	return p
+}
+
+func (x SpendGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpendGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_receipts_v1_analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (SpendGroup) Type() protoreflect.EnumType {
+	return &file_api_receipts_v1_analytics_proto_enumTypes[0]
+}
+
+func (x SpendGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpendGroup.Descriptor instead.
+func (SpendGroup) EnumDescriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+// SpendTotal aggregates current receipts. Amounts are in the profile currency; receipts
+// without a converted amount are counted in receipts but left out of the amounts.
+type SpendTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // group key; empty for an ungrouped total
+	Receipts      int32  `protobuf:"varint,2,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	Unconverted   int32  `protobuf:"varint,3,opt,name=unconverted,proto3" json:"unconverted,omitempty"`                         // receipts left out of total
+	Total         string `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`                                      // decimal string
+	AverageTicket string `protobuf:"bytes,5,opt,name=average_ticket,json=averageTicket,proto3" json:"average_ticket,omitempty"` // decimal string, total per converted receipt
+}
+
+func (x *SpendTotal) Reset() {
+	*x = SpendTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpendTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendTotal) ProtoMessage() {}
+
+func (x *SpendTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendTotal.ProtoReflect.Descriptor instead.
+func (*SpendTotal) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpendTotal) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SpendTotal) GetReceipts() int32 {
+	if x != nil {
+		return x.Receipts
+	}
+	return 0
+}
+
+func (x *SpendTotal) GetUnconverted() int32 {
+	if x != nil {
+		return x.Unconverted
+	}
+	return 0
+}
+
+func (x *SpendTotal) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *SpendTotal) GetAverageTicket() string {
+	if x != nil {
+		return x.AverageTicket
+	}
+	return ""
+}
+
+type GetSpendingTotalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string     `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	FromDate  string     `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // optional YYYY-MM-DD (tx_date)
+	ToDate    string     `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // optional YYYY-MM-DD (tx_date)
+	GroupBy   SpendGroup `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=receipts.v1.SpendGroup" json:"group_by,omitempty"`
+}
+
+func (x *GetSpendingTotalsRequest) Reset() {
+	*x = GetSpendingTotalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingTotalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingTotalsRequest) ProtoMessage() {}
+
+func (x *GetSpendingTotalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingTotalsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingTotalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSpendingTotalsRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *GetSpendingTotalsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetSpendingTotalsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetSpendingTotalsRequest) GetGroupBy() SpendGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return SpendGroup_SPEND_GROUP_UNSPECIFIED
+}
+
+type GetSpendingTotalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string        `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // profile currency
+	Rows         []*SpendTotal `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`                                     // ordered by key
+	Overall      *SpendTotal   `protobuf:"bytes,3,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *GetSpendingTotalsResponse) Reset() {
+	*x = GetSpendingTotalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpendingTotalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingTotalsResponse) ProtoMessage() {}
+
+func (x *GetSpendingTotalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingTotalsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingTotalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSpendingTotalsResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetSpendingTotalsResponse) GetRows() []*SpendTotal {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetSpendingTotalsResponse) GetOverall() *SpendTotal {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+type GetTopMerchantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	FromDate  string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // optional YYYY-MM-DD (tx_date)
+	ToDate    string `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // optional YYYY-MM-DD (tx_date)
+	Limit     int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                      // default 10, at most 100
+}
+
+func (x *GetTopMerchantsRequest) Reset() {
+	*x = GetTopMerchantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopMerchantsRequest) ProtoMessage() {}
+
+func (x *GetTopMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTopMerchantsRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopMerchantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string        `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Merchants    []*SpendTotal `protobuf:"bytes,2,rep,name=merchants,proto3" json:"merchants,omitempty"` // largest total first
+	Overall      *SpendTotal   `protobuf:"bytes,3,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *GetTopMerchantsResponse) Reset() {
+	*x = GetTopMerchantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopMerchantsResponse) ProtoMessage() {}
+
+func (x *GetTopMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTopMerchantsResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetTopMerchantsResponse) GetMerchants() []*SpendTotal {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+func (x *GetTopMerchantsResponse) GetOverall() *SpendTotal {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+// Compares a calendar year with the one before it.
+type CompareYearsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string     `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Year      int32      `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`                                                  // default the current year
+	GroupBy   SpendGroup `protobuf:"varint,3,opt,name=group_by,json=groupBy,proto3,enum=receipts.v1.SpendGroup" json:"group_by,omitempty"` // category, merchant, month or quarter
+}
+
+func (x *CompareYearsRequest) Reset() {
+	*x = CompareYearsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareYearsRequest) ProtoMessage() {}
+
+func (x *CompareYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareYearsRequest.ProtoReflect.Descriptor instead.
+func (*CompareYearsRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompareYearsRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *CompareYearsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CompareYearsRequest) GetGroupBy() SpendGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return SpendGroup_SPEND_GROUP_UNSPECIFIED
+}
+
+type YearComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key           string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // category or merchant; "MM" or "Qn" for periods
+	Current       *SpendTotal `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      *SpendTotal `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	Change        string      `protobuf:"bytes,4,opt,name=change,proto3" json:"change,omitempty"`                                            // decimal string, current minus previous
+	ChangePercent *float64    `protobuf:"fixed64,5,opt,name=change_percent,json=changePercent,proto3,oneof" json:"change_percent,omitempty"` // unset when the previous total is zero
+}
+
+func (x *YearComparison) Reset() {
+	*x = YearComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YearComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YearComparison) ProtoMessage() {}
+
+func (x *YearComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YearComparison.ProtoReflect.Descriptor instead.
+func (*YearComparison) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *YearComparison) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *YearComparison) GetCurrent() *SpendTotal {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *YearComparison) GetPrevious() *SpendTotal {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *YearComparison) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *YearComparison) GetChangePercent() float64 {
+	if x != nil && x.ChangePercent != nil {
+		return *x.ChangePercent
+	}
+	return 0
+}
+
+type CompareYearsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string            `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Year         int32             `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Rows         []*YearComparison `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"` // ordered by key
+	Overall      *YearComparison   `protobuf:"bytes,4,opt,name=overall,proto3" json:"overall,omitempty"`
+}
+
+func (x *CompareYearsResponse) Reset() {
+	*x = CompareYearsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_analytics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareYearsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareYearsResponse) ProtoMessage() {}
+
+func (x *CompareYearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_analytics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareYearsResponse.ProtoReflect.Descriptor instead.
+func (*CompareYearsResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_analytics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompareYearsResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CompareYearsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CompareYearsResponse) GetRows() []*YearComparison {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *CompareYearsResponse) GetOverall() *YearComparison {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+var File_api_receipts_v1_analytics_proto protoreflect.FileDescriptor
+
+var file_api_receipts_v1_analytics_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x99,
+	0x01, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x6e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x22, 0xa0, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x31, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65,
+	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x65,
+	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x07, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x22, 0x7c, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x59,
+	0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x32,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x0e, 0x59, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c,
+	0x2a, 0xa3, 0x01, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x52, 0x43, 0x48, 0x41, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x50, 0x45, 0x4e, 0x44,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x51, 0x55, 0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x04,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x59, 0x45, 0x41, 0x52, 0x10, 0x05, 0x32, 0xa9, 0x02, 0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x12, 0x25, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4d, 0x65, 0x72, 0x63,
+	0x68, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x59, 0x65, 0x61, 0x72, 0x73, 0x12, 0x20, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x59, 0x65, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6a, 0x6f, 0x73, 0x65, 0x70, 0x68, 0x2d, 0x61, 0x79, 0x6f, 0x64, 0x65, 0x6c, 0x65, 0x2f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_api_receipts_v1_analytics_proto_rawDescOnce sync.Once
+	file_api_receipts_v1_analytics_proto_rawDescData = file_api_receipts_v1_analytics_proto_rawDesc
+)
+
+func file_api_receipts_v1_analytics_proto_rawDescGZIP() []byte {
+	file_api_receipts_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_api_receipts_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_receipts_v1_analytics_proto_rawDescData)
+	})
+	return file_api_receipts_v1_analytics_proto_rawDescData
+}
+
+var file_api_receipts_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_receipts_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_receipts_v1_analytics_proto_goTypes = []any{
+	(SpendGroup)(0),                   // 0: receipts.v1.SpendGroup
+	(*SpendTotal)(nil),                // 1: receipts.v1.SpendTotal
+	(*GetSpendingTotalsRequest)(nil),  // 2: receipts.v1.GetSpendingTotalsRequest
+	(*GetSpendingTotalsResponse)(nil), // 3: receipts.v1.GetSpendingTotalsResponse
+	(*GetTopMerchantsRequest)(nil),    // 4: receipts.v1.GetTopMerchantsRequest
+	(*GetTopMerchantsResponse)(nil),   // 5: receipts.v1.GetTopMerchantsResponse
+	(*CompareYearsRequest)(nil),       // 6: receipts.v1.CompareYearsRequest
+	(*YearComparison)(nil),            // 7: receipts.v1.YearComparison
+	(*CompareYearsResponse)(nil),      // 8: receipts.v1.CompareYearsResponse
+}
+var file_api_receipts_v1_analytics_proto_depIdxs = []int32{
+	0,  // 0: receipts.v1.GetSpendingTotalsRequest.group_by:type_name -> receipts.v1.SpendGroup
+	1,  // 1: receipts.v1.GetSpendingTotalsResponse.rows:type_name -> receipts.v1.SpendTotal
+	1,  // 2: receipts.v1.GetSpendingTotalsResponse.overall:type_name -> receipts.v1.SpendTotal
+	1,  // 3: receipts.v1.GetTopMerchantsResponse.merchants:type_name -> receipts.v1.SpendTotal
+	1,  // 4: receipts.v1.GetTopMerchantsResponse.overall:type_name -> receipts.v1.SpendTotal
+	0,  // 5: receipts.v1.CompareYearsRequest.group_by:type_name -> receipts.v1.SpendGroup
+	1,  // 6: receipts.v1.YearComparison.current:type_name -> receipts.v1.SpendTotal
+	1,  // 7: receipts.v1.YearComparison.previous:type_name -> receipts.v1.SpendTotal
+	7,  // 8: receipts.v1.CompareYearsResponse.rows:type_name -> receipts.v1.YearComparison
+	7,  // 9: receipts.v1.CompareYearsResponse.overall:type_name -> receipts.v1.YearComparison
+	2,  // 10: receipts.v1.AnalyticsService.GetSpendingTotals:input_type -> receipts.v1.GetSpendingTotalsRequest
+	4,  // 11: receipts.v1.AnalyticsService.GetTopMerchants:input_type -> receipts.v1.GetTopMerchantsRequest
+	6,  // 12: receipts.v1.AnalyticsService.CompareYears:input_type -> receipts.v1.CompareYearsRequest
+	3,  // 13: receipts.v1.AnalyticsService.GetSpendingTotals:output_type -> receipts.v1.GetSpendingTotalsResponse
+	5,  // 14: receipts.v1.AnalyticsService.GetTopMerchants:output_type -> receipts.v1.GetTopMerchantsResponse
+	8,  // 15: receipts.v1.AnalyticsService.CompareYears:output_type -> receipts.v1.CompareYearsResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_receipts_v1_analytics_proto_init() }
+func file_api_receipts_v1_analytics_proto_init() {
+	if File_api_receipts_v1_analytics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_receipts_v1_analytics_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SpendTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSpendingTotalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSpendingTotalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopMerchantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTopMerchantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareYearsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*YearComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_analytics_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareYearsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_receipts_v1_analytics_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_receipts_v1_analytics_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_receipts_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_api_receipts_v1_analytics_proto_depIdxs,
+		EnumInfos:         file_api_receipts_v1_analytics_proto_enumTypes,
+		MessageInfos:      file_api_receipts_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_api_receipts_v1_analytics_proto = out.File
+	file_api_receipts_v1_analytics_proto_rawDesc = nil
+	file_api_receipts_v1_analytics_proto_goTypes = nil
+	file_api_receipts_v1_analytics_proto_depIdxs = nil
+}
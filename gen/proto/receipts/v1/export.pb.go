@@ -25,20 +25,61 @@ type ExportFormat int32
 const (
 	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
 	ExportFormat_EXPORT_FORMAT_XLSX        ExportFormat = 1
-	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 2
+	// The Receipts sheet of the XLSX workbook, without its totals row.
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 2
+	// Per-year IRS Schedule C summary (deductible amount per line, meals at 50%) with
+	// drill-down rows, as XLSX. Lines come from each category's tax_line.
+	ExportFormat_EXPORT_FORMAT_SCHEDULE_C ExportFormat = 3
+	// ZIP with the receipts workbook and CSV, every receipt file renamed to
+	// <date>_<merchant>_<total>.<ext> in a folder per category, and a manifest.csv
+	// mapping workbook rows to files.
+	ExportFormat_EXPORT_FORMAT_BUNDLE ExportFormat = 4
+	// PDF reimbursement report: cover summary with totals by category, a receipts
+	// table and an appendix with an image of every receipt.
+	ExportFormat_EXPORT_FORMAT_PDF ExportFormat = 5
+	// Transactions for accounting software. The merchant is the payee, the description
+	// the memo and the receipt file path is kept as metadata. Categories post to their
+	// account (see ExportReceiptsRequest.accounts), balanced by payment_account.
+	ExportFormat_EXPORT_FORMAT_QIF       ExportFormat = 6
+	ExportFormat_EXPORT_FORMAT_OFX       ExportFormat = 7
+	ExportFormat_EXPORT_FORMAT_LEDGER    ExportFormat = 8
+	ExportFormat_EXPORT_FORMAT_BEANCOUNT ExportFormat = 9
+	// Every receipt with its money components, category, file hash and path, extract
+	// job history (OCR method, confidence, model name and params, review flags) and
+	// superseded versions: one JSON document, or one record per line for NDJSON.
+	ExportFormat_EXPORT_FORMAT_JSON   ExportFormat = 10
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 11
 )
 
 // Enum value maps for ExportFormat.
 var (
 	ExportFormat_name = map[int32]string{
-		0: "EXPORT_FORMAT_UNSPECIFIED",
-		1: "EXPORT_FORMAT_XLSX",
-		2: "EXPORT_FORMAT_CSV",
+		0:  "EXPORT_FORMAT_UNSPECIFIED",
+		1:  "EXPORT_FORMAT_XLSX",
+		2:  "EXPORT_FORMAT_CSV",
+		3:  "EXPORT_FORMAT_SCHEDULE_C",
+		4:  "EXPORT_FORMAT_BUNDLE",
+		5:  "EXPORT_FORMAT_PDF",
+		6:  "EXPORT_FORMAT_QIF",
+		7:  "EXPORT_FORMAT_OFX",
+		8:  "EXPORT_FORMAT_LEDGER",
+		9:  "EXPORT_FORMAT_BEANCOUNT",
+		10: "EXPORT_FORMAT_JSON",
+		11: "EXPORT_FORMAT_NDJSON",
 	}
 	ExportFormat_value = map[string]int32{
 		"EXPORT_FORMAT_UNSPECIFIED": 0,
 		"EXPORT_FORMAT_XLSX":        1,
 		"EXPORT_FORMAT_CSV":         2,
+		"EXPORT_FORMAT_SCHEDULE_C":  3,
+		"EXPORT_FORMAT_BUNDLE":      4,
+		"EXPORT_FORMAT_PDF":         5,
+		"EXPORT_FORMAT_QIF":         6,
+		"EXPORT_FORMAT_OFX":         7,
+		"EXPORT_FORMAT_LEDGER":      8,
+		"EXPORT_FORMAT_BEANCOUNT":   9,
+		"EXPORT_FORMAT_JSON":        10,
+		"EXPORT_FORMAT_NDJSON":      11,
 	}
 )
 
@@ -78,6 +119,17 @@ type ExportReceiptsRequest struct {
 	FromDate  string       `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
 	ToDate    string       `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
 	Format    ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=receipts.v1.ExportFormat" json:"format,omitempty"`
+	// Accounting formats only: category name -> account, overriding the category's
+	// own account. Categories without one post to "Expenses:<category>".
+	Accounts map[string]string `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Accounting formats only: the account receipts are paid from; defaults to
+	// "Liabilities:CreditCard".
+	PaymentAccount string `protobuf:"bytes,6,opt,name=payment_account,json=paymentAccount,proto3" json:"payment_account,omitempty"`
+	// JSON formats only: keep the OCR text of every extract job.
+	IncludeOcrText bool `protobuf:"varint,7,opt,name=include_ocr_text,json=includeOcrText,proto3" json:"include_ocr_text,omitempty"`
+	// XLSX, CSV and bundle formats: name of the profile's ExportTemplate laying out the
+	// Receipts sheet; empty for the default layout.
+	Template string `protobuf:"bytes,8,opt,name=template,proto3" json:"template,omitempty"`
 }
 
 func (x *ExportReceiptsRequest) Reset() {
@@ -140,12 +192,44 @@ func (x *ExportReceiptsRequest) GetFormat() ExportFormat {
 	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
 }
 
+func (x *ExportReceiptsRequest) GetAccounts() map[string]string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ExportReceiptsRequest) GetPaymentAccount() string {
+	if x != nil {
+		return x.PaymentAccount
+	}
+	return ""
+}
+
+func (x *ExportReceiptsRequest) GetIncludeOcrText() bool {
+	if x != nil {
+		return x.IncludeOcrText
+	}
+	return false
+}
+
+func (x *ExportReceiptsRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+// One chunk of an export; concatenate the chunks in order to get the file.
 type ExportReceiptsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Xlsx []byte `protobuf:"bytes,1,opt,name=xlsx,proto3" json:"xlsx,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Set on the first chunk only.
+	FileName    string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 }
 
 func (x *ExportReceiptsResponse) Reset() {
@@ -180,19 +264,566 @@ func (*ExportReceiptsResponse) Descriptor() ([]byte, []int) {
 	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ExportReceiptsResponse) GetXlsx() []byte {
+func (x *ExportReceiptsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportReceiptsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportReceiptsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// ExportColumn is one column of the Receipts sheet.
+type ExportColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"` // defaults to the field name
+	// A receipt field, optionally piped through text filters (upper, lower,
+	// truncate:N), e.g. "description | truncate:140". Fields: receipt_id, tx_date,
+	// merchant, category, item, description, subtotal, tax, discount, other_fees, tip,
+	// total, currency, converted_total, fx_rate, fx_source, file_path, needs_review.
+	Field  string  `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Format string  `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // Excel number format for dates and amounts, e.g. "dd/mm/yyyy"
+	Width  float64 `protobuf:"fixed64,4,opt,name=width,proto3" json:"width,omitempty"` // 0 -> the field's default width
+}
+
+func (x *ExportColumn) Reset() {
+	*x = ExportColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportColumn) ProtoMessage() {}
+
+func (x *ExportColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportColumn.ProtoReflect.Descriptor instead.
+func (*ExportColumn) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportColumn) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *ExportColumn) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ExportColumn) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportColumn) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+// ExportTemplate is a named, per-profile layout of the Receipts sheet.
+type ExportTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProfileId string          `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	Name      string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Columns   []*ExportColumn `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`                      // in sheet order
+	CreatedAt string          `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	UpdatedAt string          `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+}
+
+func (x *ExportTemplate) Reset() {
+	*x = ExportTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTemplate) ProtoMessage() {}
+
+func (x *ExportTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTemplate.ProtoReflect.Descriptor instead.
+func (*ExportTemplate) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportTemplate) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *ExportTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportTemplate) GetColumns() []*ExportColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ExportTemplate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListExportTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+}
+
+func (x *ListExportTemplatesRequest) Reset() {
+	*x = ListExportTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportTemplatesRequest) ProtoMessage() {}
+
+func (x *ListExportTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExportTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListExportTemplatesRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type ListExportTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*ExportTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListExportTemplatesResponse) Reset() {
+	*x = ListExportTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportTemplatesResponse) ProtoMessage() {}
+
+func (x *ListExportTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExportTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListExportTemplatesResponse) GetTemplates() []*ExportTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateExportTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *ExportTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // profile_id required; id ignored
+}
+
+func (x *CreateExportTemplateRequest) Reset() {
+	*x = CreateExportTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportTemplateRequest) ProtoMessage() {}
+
+func (x *CreateExportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateExportTemplateRequest) GetTemplate() *ExportTemplate {
 	if x != nil {
-		return x.Xlsx
+		return x.Template
 	}
 	return nil
 }
 
+type CreateExportTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *ExportTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateExportTemplateResponse) Reset() {
+	*x = CreateExportTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExportTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportTemplateResponse) ProtoMessage() {}
+
+func (x *CreateExportTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateExportTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateExportTemplateResponse) GetTemplate() *ExportTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// UpdateExportTemplateRequest replaces the name and columns of the template identified
+// by template.id.
+type UpdateExportTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *ExportTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateExportTemplateRequest) Reset() {
+	*x = UpdateExportTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateExportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateExportTemplateRequest) GetTemplate() *ExportTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateExportTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *ExportTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpdateExportTemplateResponse) Reset() {
+	*x = UpdateExportTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExportTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExportTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateExportTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExportTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExportTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateExportTemplateResponse) GetTemplate() *ExportTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteExportTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteExportTemplateRequest) Reset() {
+	*x = DeleteExportTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExportTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteExportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteExportTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteExportTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteExportTemplateResponse) Reset() {
+	*x = DeleteExportTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_receipts_v1_export_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExportTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExportTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteExportTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_receipts_v1_export_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExportTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExportTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_receipts_v1_export_proto_rawDescGZIP(), []int{11}
+}
+
 var File_api_receipts_v1_export_proto protoreflect.FileDescriptor
 
 var file_api_receipts_v1_export_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x76,
 	0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x9f, 0x01, 0x0a, 0x15,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x99, 0x03, 0x0a, 0x15,
 	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69,
@@ -202,28 +833,145 @@ var file_api_receipts_v1_export_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f,
 	0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63,
 	0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x2c, 0x0a,
-	0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x6c, 0x73, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x78, 0x6c, 0x73, 0x78, 0x2a, 0x5c, 0x0a, 0x0c, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45,
-	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58,
-	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
-	0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x02, 0x32, 0x6a, 0x0a, 0x0d, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x4c, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x6f, 0x63, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x63, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x22, 0xc6, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x56, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x22, 0x56, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x57, 0x0a, 0x1c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72,
 	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x6f, 0x73, 0x65, 0x70, 0x68, 0x2d, 0x61, 0x79, 0x6f, 0x64, 0x65,
-	0x6c, 0x65, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2d, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0xc2, 0x02, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x05,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x51, 0x49, 0x46, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x46, 0x58, 0x10, 0x07, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x42, 0x45, 0x41, 0x4e, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e,
+	0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x0b, 0x32, 0x9d, 0x04, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x2e,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x28, 0x2e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x6f, 0x73, 0x65, 0x70, 0x68, 0x2d, 0x61, 0x79, 0x6f,
+	0x64, 0x65, 0x6c, 0x65, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2d, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -239,21 +987,47 @@ func file_api_receipts_v1_export_proto_rawDescGZIP() []byte {
 }
 
 var file_api_receipts_v1_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_receipts_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_receipts_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_receipts_v1_export_proto_goTypes = []any{
-	(ExportFormat)(0),              // 0: receipts.v1.ExportFormat
-	(*ExportReceiptsRequest)(nil),  // 1: receipts.v1.ExportReceiptsRequest
-	(*ExportReceiptsResponse)(nil), // 2: receipts.v1.ExportReceiptsResponse
+	(ExportFormat)(0),                    // 0: receipts.v1.ExportFormat
+	(*ExportReceiptsRequest)(nil),        // 1: receipts.v1.ExportReceiptsRequest
+	(*ExportReceiptsResponse)(nil),       // 2: receipts.v1.ExportReceiptsResponse
+	(*ExportColumn)(nil),                 // 3: receipts.v1.ExportColumn
+	(*ExportTemplate)(nil),               // 4: receipts.v1.ExportTemplate
+	(*ListExportTemplatesRequest)(nil),   // 5: receipts.v1.ListExportTemplatesRequest
+	(*ListExportTemplatesResponse)(nil),  // 6: receipts.v1.ListExportTemplatesResponse
+	(*CreateExportTemplateRequest)(nil),  // 7: receipts.v1.CreateExportTemplateRequest
+	(*CreateExportTemplateResponse)(nil), // 8: receipts.v1.CreateExportTemplateResponse
+	(*UpdateExportTemplateRequest)(nil),  // 9: receipts.v1.UpdateExportTemplateRequest
+	(*UpdateExportTemplateResponse)(nil), // 10: receipts.v1.UpdateExportTemplateResponse
+	(*DeleteExportTemplateRequest)(nil),  // 11: receipts.v1.DeleteExportTemplateRequest
+	(*DeleteExportTemplateResponse)(nil), // 12: receipts.v1.DeleteExportTemplateResponse
+	nil,                                  // 13: receipts.v1.ExportReceiptsRequest.AccountsEntry
 }
 var file_api_receipts_v1_export_proto_depIdxs = []int32{
-	0, // 0: receipts.v1.ExportReceiptsRequest.format:type_name -> receipts.v1.ExportFormat
-	1, // 1: receipts.v1.ExportService.ExportReceipts:input_type -> receipts.v1.ExportReceiptsRequest
-	2, // 2: receipts.v1.ExportService.ExportReceipts:output_type -> receipts.v1.ExportReceiptsResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: receipts.v1.ExportReceiptsRequest.format:type_name -> receipts.v1.ExportFormat
+	13, // 1: receipts.v1.ExportReceiptsRequest.accounts:type_name -> receipts.v1.ExportReceiptsRequest.AccountsEntry
+	3,  // 2: receipts.v1.ExportTemplate.columns:type_name -> receipts.v1.ExportColumn
+	4,  // 3: receipts.v1.ListExportTemplatesResponse.templates:type_name -> receipts.v1.ExportTemplate
+	4,  // 4: receipts.v1.CreateExportTemplateRequest.template:type_name -> receipts.v1.ExportTemplate
+	4,  // 5: receipts.v1.CreateExportTemplateResponse.template:type_name -> receipts.v1.ExportTemplate
+	4,  // 6: receipts.v1.UpdateExportTemplateRequest.template:type_name -> receipts.v1.ExportTemplate
+	4,  // 7: receipts.v1.UpdateExportTemplateResponse.template:type_name -> receipts.v1.ExportTemplate
+	1,  // 8: receipts.v1.ExportService.ExportReceipts:input_type -> receipts.v1.ExportReceiptsRequest
+	5,  // 9: receipts.v1.ExportService.ListExportTemplates:input_type -> receipts.v1.ListExportTemplatesRequest
+	7,  // 10: receipts.v1.ExportService.CreateExportTemplate:input_type -> receipts.v1.CreateExportTemplateRequest
+	9,  // 11: receipts.v1.ExportService.UpdateExportTemplate:input_type -> receipts.v1.UpdateExportTemplateRequest
+	11, // 12: receipts.v1.ExportService.DeleteExportTemplate:input_type -> receipts.v1.DeleteExportTemplateRequest
+	2,  // 13: receipts.v1.ExportService.ExportReceipts:output_type -> receipts.v1.ExportReceiptsResponse
+	6,  // 14: receipts.v1.ExportService.ListExportTemplates:output_type -> receipts.v1.ListExportTemplatesResponse
+	8,  // 15: receipts.v1.ExportService.CreateExportTemplate:output_type -> receipts.v1.CreateExportTemplateResponse
+	10, // 16: receipts.v1.ExportService.UpdateExportTemplate:output_type -> receipts.v1.UpdateExportTemplateResponse
+	12, // 17: receipts.v1.ExportService.DeleteExportTemplate:output_type -> receipts.v1.DeleteExportTemplateResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_receipts_v1_export_proto_init() }
@@ -286,6 +1060,126 @@ func file_api_receipts_v1_export_proto_init() {
 				return nil
 			}
 		}
+		file_api_receipts_v1_export_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportColumn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExportTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExportTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExportTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExportTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateExportTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateExportTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteExportTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_receipts_v1_export_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteExportTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -293,7 +1187,7 @@ func file_api_receipts_v1_export_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_receipts_v1_export_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExportService_ExportReceipts_FullMethodName = "/receipts.v1.ExportService/ExportReceipts"
+	ExportService_ExportReceipts_FullMethodName       = "/receipts.v1.ExportService/ExportReceipts"
+	ExportService_ListExportTemplates_FullMethodName  = "/receipts.v1.ExportService/ListExportTemplates"
+	ExportService_CreateExportTemplate_FullMethodName = "/receipts.v1.ExportService/CreateExportTemplate"
+	ExportService_UpdateExportTemplate_FullMethodName = "/receipts.v1.ExportService/UpdateExportTemplate"
+	ExportService_DeleteExportTemplate_FullMethodName = "/receipts.v1.ExportService/DeleteExportTemplate"
 )
 
 // ExportServiceClient is the client API for ExportService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ExportServiceClient interface {
-	ExportReceipts(ctx context.Context, in *ExportReceiptsRequest, opts ...grpc.CallOption) (*ExportReceiptsResponse, error)
+	ExportReceipts(ctx context.Context, in *ExportReceiptsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportReceiptsResponse], error)
+	ListExportTemplates(ctx context.Context, in *ListExportTemplatesRequest, opts ...grpc.CallOption) (*ListExportTemplatesResponse, error)
+	CreateExportTemplate(ctx context.Context, in *CreateExportTemplateRequest, opts ...grpc.CallOption) (*CreateExportTemplateResponse, error)
+	UpdateExportTemplate(ctx context.Context, in *UpdateExportTemplateRequest, opts ...grpc.CallOption) (*UpdateExportTemplateResponse, error)
+	DeleteExportTemplate(ctx context.Context, in *DeleteExportTemplateRequest, opts ...grpc.CallOption) (*DeleteExportTemplateResponse, error)
 }
 
 type exportServiceClient struct {
@@ -37,10 +45,59 @@ func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
 	return &exportServiceClient{cc}
 }
 
-func (c *exportServiceClient) ExportReceipts(ctx context.Context, in *ExportReceiptsRequest, opts ...grpc.CallOption) (*ExportReceiptsResponse, error) {
+func (c *exportServiceClient) ExportReceipts(ctx context.Context, in *ExportReceiptsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportReceiptsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ExportReceiptsResponse)
-	err := c.cc.Invoke(ctx, ExportService_ExportReceipts_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[0], ExportService_ExportReceipts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReceiptsRequest, ExportReceiptsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportReceiptsClient = grpc.ServerStreamingClient[ExportReceiptsResponse]
+
+func (c *exportServiceClient) ListExportTemplates(ctx context.Context, in *ListExportTemplatesRequest, opts ...grpc.CallOption) (*ListExportTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExportTemplatesResponse)
+	err := c.cc.Invoke(ctx, ExportService_ListExportTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) CreateExportTemplate(ctx context.Context, in *CreateExportTemplateRequest, opts ...grpc.CallOption) (*CreateExportTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportTemplateResponse)
+	err := c.cc.Invoke(ctx, ExportService_CreateExportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) UpdateExportTemplate(ctx context.Context, in *UpdateExportTemplateRequest, opts ...grpc.CallOption) (*UpdateExportTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExportTemplateResponse)
+	err := c.cc.Invoke(ctx, ExportService_UpdateExportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) DeleteExportTemplate(ctx context.Context, in *DeleteExportTemplateRequest, opts ...grpc.CallOption) (*DeleteExportTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExportTemplateResponse)
+	err := c.cc.Invoke(ctx, ExportService_DeleteExportTemplate_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +108,11 @@ func (c *exportServiceClient) ExportReceipts(ctx context.Context, in *ExportRece
 // All implementations must embed UnimplementedExportServiceServer
 // for forward compatibility.
 type ExportServiceServer interface {
-	ExportReceipts(context.Context, *ExportReceiptsRequest) (*ExportReceiptsResponse, error)
+	ExportReceipts(*ExportReceiptsRequest, grpc.ServerStreamingServer[ExportReceiptsResponse]) error
+	ListExportTemplates(context.Context, *ListExportTemplatesRequest) (*ListExportTemplatesResponse, error)
+	CreateExportTemplate(context.Context, *CreateExportTemplateRequest) (*CreateExportTemplateResponse, error)
+	UpdateExportTemplate(context.Context, *UpdateExportTemplateRequest) (*UpdateExportTemplateResponse, error)
+	DeleteExportTemplate(context.Context, *DeleteExportTemplateRequest) (*DeleteExportTemplateResponse, error)
 	mustEmbedUnimplementedExportServiceServer()
 }
 
@@ -62,8 +123,20 @@ type ExportServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedExportServiceServer struct{}
 
-func (UnimplementedExportServiceServer) ExportReceipts(context.Context, *ExportReceiptsRequest) (*ExportReceiptsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportReceipts not implemented")
+func (UnimplementedExportServiceServer) ExportReceipts(*ExportReceiptsRequest, grpc.ServerStreamingServer[ExportReceiptsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportReceipts not implemented")
+}
+func (UnimplementedExportServiceServer) ListExportTemplates(context.Context, *ListExportTemplatesRequest) (*ListExportTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportTemplates not implemented")
+}
+func (UnimplementedExportServiceServer) CreateExportTemplate(context.Context, *CreateExportTemplateRequest) (*CreateExportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportTemplate not implemented")
+}
+func (UnimplementedExportServiceServer) UpdateExportTemplate(context.Context, *UpdateExportTemplateRequest) (*UpdateExportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExportTemplate not implemented")
+}
+func (UnimplementedExportServiceServer) DeleteExportTemplate(context.Context, *DeleteExportTemplateRequest) (*DeleteExportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExportTemplate not implemented")
 }
 func (UnimplementedExportServiceServer) mustEmbedUnimplementedExportServiceServer() {}
 func (UnimplementedExportServiceServer) testEmbeddedByValue()                       {}
@@ -86,20 +159,85 @@ func RegisterExportServiceServer(s grpc.ServiceRegistrar, srv ExportServiceServe
 	s.RegisterService(&ExportService_ServiceDesc, srv)
 }
 
-func _ExportService_ExportReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportReceiptsRequest)
+func _ExportService_ExportReceipts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReceiptsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).ExportReceipts(m, &grpc.GenericServerStream[ExportReceiptsRequest, ExportReceiptsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExportService_ExportReceiptsServer = grpc.ServerStreamingServer[ExportReceiptsResponse]
+
+func _ExportService_ListExportTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).ListExportTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_ListExportTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).ListExportTemplates(ctx, req.(*ListExportTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_CreateExportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).CreateExportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_CreateExportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).CreateExportTemplate(ctx, req.(*CreateExportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_UpdateExportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExportTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExportServiceServer).ExportReceipts(ctx, in)
+		return srv.(ExportServiceServer).UpdateExportTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ExportService_ExportReceipts_FullMethodName,
+		FullMethod: ExportService_UpdateExportTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExportServiceServer).ExportReceipts(ctx, req.(*ExportReceiptsRequest))
+		return srv.(ExportServiceServer).UpdateExportTemplate(ctx, req.(*UpdateExportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_DeleteExportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).DeleteExportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_DeleteExportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).DeleteExportTemplate(ctx, req.(*DeleteExportTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -112,10 +250,28 @@ var ExportService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*ExportServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ExportReceipts",
-			Handler:    _ExportService_ExportReceipts_Handler,
+			MethodName: "ListExportTemplates",
+			Handler:    _ExportService_ListExportTemplates_Handler,
+		},
+		{
+			MethodName: "CreateExportTemplate",
+			Handler:    _ExportService_CreateExportTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateExportTemplate",
+			Handler:    _ExportService_UpdateExportTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteExportTemplate",
+			Handler:    _ExportService_DeleteExportTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportReceipts",
+			Handler:       _ExportService_ExportReceipts_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/receipts/v1/export.proto",
 }
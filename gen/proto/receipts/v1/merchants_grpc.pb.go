@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: api/receipts/v1/merchants.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MerchantsService_ListMerchants_FullMethodName  = "/receipts.v1.MerchantsService/ListMerchants"
+	MerchantsService_CreateMerchant_FullMethodName = "/receipts.v1.MerchantsService/CreateMerchant"
+	MerchantsService_UpdateMerchant_FullMethodName = "/receipts.v1.MerchantsService/UpdateMerchant"
+	MerchantsService_MergeMerchants_FullMethodName = "/receipts.v1.MerchantsService/MergeMerchants"
+	MerchantsService_SplitMerchant_FullMethodName  = "/receipts.v1.MerchantsService/SplitMerchant"
+)
+
+// MerchantsServiceClient is the client API for MerchantsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MerchantsServiceClient interface {
+	ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*ListMerchantsResponse, error)
+	CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*CreateMerchantResponse, error)
+	UpdateMerchant(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*UpdateMerchantResponse, error)
+	MergeMerchants(ctx context.Context, in *MergeMerchantsRequest, opts ...grpc.CallOption) (*MergeMerchantsResponse, error)
+	SplitMerchant(ctx context.Context, in *SplitMerchantRequest, opts ...grpc.CallOption) (*SplitMerchantResponse, error)
+}
+
+type merchantsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMerchantsServiceClient(cc grpc.ClientConnInterface) MerchantsServiceClient {
+	return &merchantsServiceClient{cc}
+}
+
+func (c *merchantsServiceClient) ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*ListMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchantsResponse)
+	err := c.cc.Invoke(ctx, MerchantsService_ListMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantsServiceClient) CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*CreateMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantsService_CreateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantsServiceClient) UpdateMerchant(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*UpdateMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantsService_UpdateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantsServiceClient) MergeMerchants(ctx context.Context, in *MergeMerchantsRequest, opts ...grpc.CallOption) (*MergeMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeMerchantsResponse)
+	err := c.cc.Invoke(ctx, MerchantsService_MergeMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantsServiceClient) SplitMerchant(ctx context.Context, in *SplitMerchantRequest, opts ...grpc.CallOption) (*SplitMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantsService_SplitMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchantsServiceServer is the server API for MerchantsService service.
+// All implementations must embed UnimplementedMerchantsServiceServer
+// for forward compatibility.
+type MerchantsServiceServer interface {
+	ListMerchants(context.Context, *ListMerchantsRequest) (*ListMerchantsResponse, error)
+	CreateMerchant(context.Context, *CreateMerchantRequest) (*CreateMerchantResponse, error)
+	UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error)
+	MergeMerchants(context.Context, *MergeMerchantsRequest) (*MergeMerchantsResponse, error)
+	SplitMerchant(context.Context, *SplitMerchantRequest) (*SplitMerchantResponse, error)
+	mustEmbedUnimplementedMerchantsServiceServer()
+}
+
+// UnimplementedMerchantsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchantsServiceServer struct{}
+
+func (UnimplementedMerchantsServiceServer) ListMerchants(context.Context, *ListMerchantsRequest) (*ListMerchantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchants not implemented")
+}
+func (UnimplementedMerchantsServiceServer) CreateMerchant(context.Context, *CreateMerchantRequest) (*CreateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerchant not implemented")
+}
+func (UnimplementedMerchantsServiceServer) UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+}
+func (UnimplementedMerchantsServiceServer) MergeMerchants(context.Context, *MergeMerchantsRequest) (*MergeMerchantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeMerchants not implemented")
+}
+func (UnimplementedMerchantsServiceServer) SplitMerchant(context.Context, *SplitMerchantRequest) (*SplitMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitMerchant not implemented")
+}
+func (UnimplementedMerchantsServiceServer) mustEmbedUnimplementedMerchantsServiceServer() {}
+func (UnimplementedMerchantsServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMerchantsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchantsServiceServer will
+// result in compilation errors.
+type UnsafeMerchantsServiceServer interface {
+	mustEmbedUnimplementedMerchantsServiceServer()
+}
+
+func RegisterMerchantsServiceServer(s grpc.ServiceRegistrar, srv MerchantsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMerchantsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchantsService_ServiceDesc, srv)
+}
+
+func _MerchantsService_ListMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantsServiceServer).ListMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantsService_ListMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantsServiceServer).ListMerchants(ctx, req.(*ListMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantsService_CreateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantsServiceServer).CreateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantsService_CreateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantsServiceServer).CreateMerchant(ctx, req.(*CreateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantsService_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantsServiceServer).UpdateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantsService_UpdateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantsServiceServer).UpdateMerchant(ctx, req.(*UpdateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantsService_MergeMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantsServiceServer).MergeMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantsService_MergeMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantsServiceServer).MergeMerchants(ctx, req.(*MergeMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantsService_SplitMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantsServiceServer).SplitMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantsService_SplitMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantsServiceServer).SplitMerchant(ctx, req.(*SplitMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MerchantsService_ServiceDesc is the grpc.ServiceDesc for MerchantsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MerchantsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "receipts.v1.MerchantsService",
+	HandlerType: (*MerchantsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMerchants",
+			Handler:    _MerchantsService_ListMerchants_Handler,
+		},
+		{
+			MethodName: "CreateMerchant",
+			Handler:    _MerchantsService_CreateMerchant_Handler,
+		},
+		{
+			MethodName: "UpdateMerchant",
+			Handler:    _MerchantsService_UpdateMerchant_Handler,
+		},
+		{
+			MethodName: "MergeMerchants",
+			Handler:    _MerchantsService_MergeMerchants_Handler,
+		},
+		{
+			MethodName: "SplitMerchant",
+			Handler:    _MerchantsService_SplitMerchant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/receipts/v1/merchants.proto",
+}
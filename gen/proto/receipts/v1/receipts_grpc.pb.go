@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReceiptsService_ListReceipts_FullMethodName = "/receipts.v1.ReceiptsService/ListReceipts"
+	ReceiptsService_ListReceipts_FullMethodName          = "/receipts.v1.ReceiptsService/ListReceipts"
+	ReceiptsService_UpdateReceiptCategory_FullMethodName = "/receipts.v1.ReceiptsService/UpdateReceiptCategory"
+	ReceiptsService_GetCorrectionReport_FullMethodName   = "/receipts.v1.ReceiptsService/GetCorrectionReport"
+	ReceiptsService_ListRecurringExpenses_FullMethodName = "/receipts.v1.ReceiptsService/ListRecurringExpenses"
+	ReceiptsService_ListReceiptAnomalies_FullMethodName  = "/receipts.v1.ReceiptsService/ListReceiptAnomalies"
+	ReceiptsService_SearchReceipts_FullMethodName        = "/receipts.v1.ReceiptsService/SearchReceipts"
 )
 
 // ReceiptsServiceClient is the client API for ReceiptsService service.
@@ -27,6 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ReceiptsServiceClient interface {
 	ListReceipts(ctx context.Context, in *ListReceiptsRequest, opts ...grpc.CallOption) (*ListReceiptsResponse, error)
+	UpdateReceiptCategory(ctx context.Context, in *UpdateReceiptCategoryRequest, opts ...grpc.CallOption) (*UpdateReceiptCategoryResponse, error)
+	GetCorrectionReport(ctx context.Context, in *GetCorrectionReportRequest, opts ...grpc.CallOption) (*GetCorrectionReportResponse, error)
+	ListRecurringExpenses(ctx context.Context, in *ListRecurringExpensesRequest, opts ...grpc.CallOption) (*ListRecurringExpensesResponse, error)
+	ListReceiptAnomalies(ctx context.Context, in *ListReceiptAnomaliesRequest, opts ...grpc.CallOption) (*ListReceiptAnomaliesResponse, error)
+	SearchReceipts(ctx context.Context, in *SearchReceiptsRequest, opts ...grpc.CallOption) (*SearchReceiptsResponse, error)
 }
 
 type receiptsServiceClient struct {
@@ -47,11 +57,66 @@ func (c *receiptsServiceClient) ListReceipts(ctx context.Context, in *ListReceip
 	return out, nil
 }
 
+func (c *receiptsServiceClient) UpdateReceiptCategory(ctx context.Context, in *UpdateReceiptCategoryRequest, opts ...grpc.CallOption) (*UpdateReceiptCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReceiptCategoryResponse)
+	err := c.cc.Invoke(ctx, ReceiptsService_UpdateReceiptCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptsServiceClient) GetCorrectionReport(ctx context.Context, in *GetCorrectionReportRequest, opts ...grpc.CallOption) (*GetCorrectionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCorrectionReportResponse)
+	err := c.cc.Invoke(ctx, ReceiptsService_GetCorrectionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptsServiceClient) ListRecurringExpenses(ctx context.Context, in *ListRecurringExpensesRequest, opts ...grpc.CallOption) (*ListRecurringExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringExpensesResponse)
+	err := c.cc.Invoke(ctx, ReceiptsService_ListRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptsServiceClient) ListReceiptAnomalies(ctx context.Context, in *ListReceiptAnomaliesRequest, opts ...grpc.CallOption) (*ListReceiptAnomaliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceiptAnomaliesResponse)
+	err := c.cc.Invoke(ctx, ReceiptsService_ListReceiptAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receiptsServiceClient) SearchReceipts(ctx context.Context, in *SearchReceiptsRequest, opts ...grpc.CallOption) (*SearchReceiptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReceiptsResponse)
+	err := c.cc.Invoke(ctx, ReceiptsService_SearchReceipts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReceiptsServiceServer is the server API for ReceiptsService service.
 // All implementations must embed UnimplementedReceiptsServiceServer
 // for forward compatibility.
 type ReceiptsServiceServer interface {
 	ListReceipts(context.Context, *ListReceiptsRequest) (*ListReceiptsResponse, error)
+	UpdateReceiptCategory(context.Context, *UpdateReceiptCategoryRequest) (*UpdateReceiptCategoryResponse, error)
+	GetCorrectionReport(context.Context, *GetCorrectionReportRequest) (*GetCorrectionReportResponse, error)
+	ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error)
+	ListReceiptAnomalies(context.Context, *ListReceiptAnomaliesRequest) (*ListReceiptAnomaliesResponse, error)
+	SearchReceipts(context.Context, *SearchReceiptsRequest) (*SearchReceiptsResponse, error)
 	mustEmbedUnimplementedReceiptsServiceServer()
 }
 
@@ -65,6 +130,21 @@ type UnimplementedReceiptsServiceServer struct{}
 func (UnimplementedReceiptsServiceServer) ListReceipts(context.Context, *ListReceiptsRequest) (*ListReceiptsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReceipts not implemented")
 }
+func (UnimplementedReceiptsServiceServer) UpdateReceiptCategory(context.Context, *UpdateReceiptCategoryRequest) (*UpdateReceiptCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReceiptCategory not implemented")
+}
+func (UnimplementedReceiptsServiceServer) GetCorrectionReport(context.Context, *GetCorrectionReportRequest) (*GetCorrectionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCorrectionReport not implemented")
+}
+func (UnimplementedReceiptsServiceServer) ListRecurringExpenses(context.Context, *ListRecurringExpensesRequest) (*ListRecurringExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringExpenses not implemented")
+}
+func (UnimplementedReceiptsServiceServer) ListReceiptAnomalies(context.Context, *ListReceiptAnomaliesRequest) (*ListReceiptAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceiptAnomalies not implemented")
+}
+func (UnimplementedReceiptsServiceServer) SearchReceipts(context.Context, *SearchReceiptsRequest) (*SearchReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReceipts not implemented")
+}
 func (UnimplementedReceiptsServiceServer) mustEmbedUnimplementedReceiptsServiceServer() {}
 func (UnimplementedReceiptsServiceServer) testEmbeddedByValue()                         {}
 
@@ -104,6 +184,96 @@ func _ReceiptsService_ListReceipts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReceiptsService_UpdateReceiptCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReceiptCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptsServiceServer).UpdateReceiptCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptsService_UpdateReceiptCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptsServiceServer).UpdateReceiptCategory(ctx, req.(*UpdateReceiptCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptsService_GetCorrectionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCorrectionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptsServiceServer).GetCorrectionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptsService_GetCorrectionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptsServiceServer).GetCorrectionReport(ctx, req.(*GetCorrectionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptsService_ListRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptsServiceServer).ListRecurringExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptsService_ListRecurringExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptsServiceServer).ListRecurringExpenses(ctx, req.(*ListRecurringExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptsService_ListReceiptAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceiptAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptsServiceServer).ListReceiptAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptsService_ListReceiptAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptsServiceServer).ListReceiptAnomalies(ctx, req.(*ListReceiptAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceiptsService_SearchReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptsServiceServer).SearchReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptsService_SearchReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptsServiceServer).SearchReceipts(ctx, req.(*SearchReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReceiptsService_ServiceDesc is the grpc.ServiceDesc for ReceiptsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +285,26 @@ var ReceiptsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReceipts",
 			Handler:    _ReceiptsService_ListReceipts_Handler,
 		},
+		{
+			MethodName: "UpdateReceiptCategory",
+			Handler:    _ReceiptsService_UpdateReceiptCategory_Handler,
+		},
+		{
+			MethodName: "GetCorrectionReport",
+			Handler:    _ReceiptsService_GetCorrectionReport_Handler,
+		},
+		{
+			MethodName: "ListRecurringExpenses",
+			Handler:    _ReceiptsService_ListRecurringExpenses_Handler,
+		},
+		{
+			MethodName: "ListReceiptAnomalies",
+			Handler:    _ReceiptsService_ListReceiptAnomalies_Handler,
+		},
+		{
+			MethodName: "SearchReceipts",
+			Handler:    _ReceiptsService_SearchReceipts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/receipts/v1/receipts.proto",
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: api/receipts/v1/statements.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StatementsService_ImportStatement_FullMethodName    = "/receipts.v1.StatementsService/ImportStatement"
+	StatementsService_ReconcileStatement_FullMethodName = "/receipts.v1.StatementsService/ReconcileStatement"
+)
+
+// StatementsServiceClient is the client API for StatementsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatementsServiceClient interface {
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error)
+	ReconcileStatement(ctx context.Context, in *ReconcileStatementRequest, opts ...grpc.CallOption) (*ReconcileStatementResponse, error)
+}
+
+type statementsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatementsServiceClient(cc grpc.ClientConnInterface) StatementsServiceClient {
+	return &statementsServiceClient{cc}
+}
+
+func (c *statementsServiceClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportStatementResponse)
+	err := c.cc.Invoke(ctx, StatementsService_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statementsServiceClient) ReconcileStatement(ctx context.Context, in *ReconcileStatementRequest, opts ...grpc.CallOption) (*ReconcileStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileStatementResponse)
+	err := c.cc.Invoke(ctx, StatementsService_ReconcileStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatementsServiceServer is the server API for StatementsService service.
+// All implementations must embed UnimplementedStatementsServiceServer
+// for forward compatibility.
+type StatementsServiceServer interface {
+	ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error)
+	ReconcileStatement(context.Context, *ReconcileStatementRequest) (*ReconcileStatementResponse, error)
+	mustEmbedUnimplementedStatementsServiceServer()
+}
+
+// UnimplementedStatementsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStatementsServiceServer struct{}
+
+func (UnimplementedStatementsServiceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedStatementsServiceServer) ReconcileStatement(context.Context, *ReconcileStatementRequest) (*ReconcileStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileStatement not implemented")
+}
+func (UnimplementedStatementsServiceServer) mustEmbedUnimplementedStatementsServiceServer() {}
+func (UnimplementedStatementsServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeStatementsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatementsServiceServer will
+// result in compilation errors.
+type UnsafeStatementsServiceServer interface {
+	mustEmbedUnimplementedStatementsServiceServer()
+}
+
+func RegisterStatementsServiceServer(s grpc.ServiceRegistrar, srv StatementsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStatementsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StatementsService_ServiceDesc, srv)
+}
+
+func _StatementsService_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatementsServiceServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatementsService_ImportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatementsServiceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatementsService_ReconcileStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatementsServiceServer).ReconcileStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatementsService_ReconcileStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatementsServiceServer).ReconcileStatement(ctx, req.(*ReconcileStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatementsService_ServiceDesc is the grpc.ServiceDesc for StatementsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatementsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "receipts.v1.StatementsService",
+	HandlerType: (*StatementsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImportStatement",
+			Handler:    _StatementsService_ImportStatement_Handler,
+		},
+		{
+			MethodName: "ReconcileStatement",
+			Handler:    _StatementsService_ReconcileStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/receipts/v1/statements.proto",
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
@@ -17,6 +18,8 @@ type Config struct {
 	Server   ServerConfig
 	OCR      OCRConfig
 	LLM      LLMConfig
+	FX       FXConfig
+	Notify   NotifyConfig
 }
 
 // DatabaseConfig holds database-related configuration
@@ -42,6 +45,22 @@ type OCRConfig struct {
 	ArtifactCacheDir string
 }
 
+// FXConfig holds currency-conversion configuration
+type FXConfig struct {
+	RatesFile string // optional ECB XML or CSV file of daily rates imported at startup
+}
+
+// NotifyConfig holds budget alert delivery configuration. Alerts are always logged; the
+// webhook and email are used when configured.
+type NotifyConfig struct {
+	WebhookURL   string
+	SMTPAddr     string // host:port
+	SMTPFrom     string
+	SMTPTo       []string
+	SMTPUsername string
+	SMTPPassword string
+}
+
 // LLMConfig holds LLM-related configuration
 type LLMConfig struct {
 	Model       string
@@ -49,6 +68,14 @@ type LLMConfig struct {
 	Temperature float32
 	Timeout     time.Duration
 	Retries     int
+	CacheDir    string        // on-disk response cache; empty disables caching
+	CacheTTL    time.Duration // 0 = cached responses never expire
+	CacheBypass bool          // skip cache lookups (responses are still refreshed)
+	PriceTable  string        // optional JSON file of per-model prices; merged over built-in defaults
+	// ResponseFormat selects "json_schema" (strict structured outputs), "json_object", or
+	// "auto" to use json_schema whenever the model supports it.
+	ResponseFormat string
+	MaxRepairs     int // self-correction retries when the answer fails validation or arithmetic checks
 }
 
 // LoadConfig loads configuration from environment variables
@@ -72,11 +99,28 @@ func LoadConfig() *Config {
 			ArtifactCacheDir: getEnv("ARTIFACT_CACHE_DIR", "./tmp"),
 		},
 		LLM: LLMConfig{
-			Model:       getEnv("OPENAI_MODEL", "gpt-4o-mini"),
-			APIKey:      getEnv("OPENAI_API_KEY", ""),
-			Temperature: getEnvAsFloat32("OPENAI_TEMPERATURE", 0.0),
-			Timeout:     getEnvAsDuration("OPENAI_TIMEOUT", 45*time.Second),
-			Retries:     int(getEnvAsInt32("OPENAI_RETRIES", 5)),
+			Model:          getEnv("OPENAI_MODEL", "gpt-4o-mini"),
+			APIKey:         getEnv("OPENAI_API_KEY", ""),
+			Temperature:    getEnvAsFloat32("OPENAI_TEMPERATURE", 0.0),
+			Timeout:        getEnvAsDuration("OPENAI_TIMEOUT", 45*time.Second),
+			Retries:        int(getEnvAsInt32("OPENAI_RETRIES", 5)),
+			CacheDir:       getEnv("LLM_CACHE_DIR", "./tmp/llm-cache"),
+			CacheTTL:       getEnvAsDuration("LLM_CACHE_TTL", 30*24*time.Hour),
+			CacheBypass:    getEnvAsBool("LLM_CACHE_BYPASS", false),
+			PriceTable:     getEnv("LLM_PRICE_TABLE", ""),
+			ResponseFormat: getEnv("OPENAI_RESPONSE_FORMAT", "auto"),
+			MaxRepairs:     int(getEnvAsInt32("LLM_MAX_REPAIRS", 2)),
+		},
+		FX: FXConfig{
+			RatesFile: getEnv("FX_RATES_FILE", ""),
+		},
+		Notify: NotifyConfig{
+			WebhookURL:   getEnv("BUDGET_WEBHOOK_URL", ""),
+			SMTPAddr:     getEnv("SMTP_ADDR", ""),
+			SMTPFrom:     getEnv("SMTP_FROM", ""),
+			SMTPTo:       getEnvAsList("BUDGET_ALERT_EMAILS"),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 		},
 	}
 }
@@ -89,6 +133,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvAsList splits a comma-separated variable, dropping empty entries.
+func getEnvAsList(key string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 func getEnvAsInt32(key string, defaultValue int32) int32 {
 	if value := os.Getenv(key); value != "" {
 		if intVal, err := strconv.ParseInt(value, 10, 32); err == nil {
@@ -107,6 +162,15 @@ func getEnvAsFloat32(key string, defaultValue float32) float32 {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
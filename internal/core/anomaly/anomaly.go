@@ -0,0 +1,235 @@
+// Package anomaly flags parsed receipts that look like extraction errors: a total far above
+// the profile's history for the merchant or category, or a date in the future or before
+// the profile existed.
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+// Kind names a check.
+type Kind string
+
+const (
+	MerchantAmount Kind = "merchant_amount" // total far above the merchant's usual amount
+	CategoryAmount Kind = "category_amount" // total far above the category's usual amount
+	FutureDate     Kind = "future_date"
+	BeforeProfile  Kind = "before_profile" // tx_date before the profile was created
+)
+
+// Defaults for Options.
+const (
+	DefaultFactor             = 10.0
+	DefaultMinMerchantHistory = 3
+	DefaultMinCategoryHistory = 5
+	// HistoryLimit is how many of the most recent comparable receipts are loaded.
+	HistoryLimit = 500
+)
+
+// Options tune detection.
+type Options struct {
+	// Now is the current time; dates more than a day after it are in the future.
+	Now time.Time
+	// ProfileCreated is when the profile was created; zero skips the check.
+	ProfileCreated time.Time
+	// Factor is how many times the usual (median) amount is anomalous. 0 means DefaultFactor.
+	Factor float64
+	// MinMerchantHistory and MinCategoryHistory are the receipts needed before amounts are
+	// compared. 0 means the defaults.
+	MinMerchantHistory int
+	MinCategoryHistory int
+}
+
+// Anomaly is one reason a receipt looks wrong. Score is how many times the usual amount
+// the total is, or how many days off the date is.
+type Anomaly struct {
+	Kind   Kind
+	Reason string
+	Score  float64
+}
+
+// Detect checks rec against history, the profile's other receipts. The category is only
+// compared when the merchant has too little history, so a merchant that always charges
+// large amounts is not flagged for being expensive in its category. Amounts are compared
+// in the profile currency when converted, else only with receipts in the same currency.
+func Detect(rec *entity.Receipt, history []*entity.Receipt, opts Options) []Anomaly {
+	if opts.Factor <= 0 {
+		opts.Factor = DefaultFactor
+	}
+	if opts.MinMerchantHistory <= 0 {
+		opts.MinMerchantHistory = DefaultMinMerchantHistory
+	}
+	if opts.MinCategoryHistory <= 0 {
+		opts.MinCategoryHistory = DefaultMinCategoryHistory
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var out []Anomaly
+	if !rec.TxDate.IsZero() {
+		today := day(opts.Now)
+		if days := daysBetween(today, rec.TxDate); days > 1 {
+			out = append(out, Anomaly{Kind: FutureDate, Score: float64(days),
+				Reason: fmt.Sprintf("date %s is %d days in the future", rec.TxDate.Format("2006-01-02"), days)})
+		}
+		if !opts.ProfileCreated.IsZero() {
+			created := day(opts.ProfileCreated)
+			if days := daysBetween(rec.TxDate, created); days > 0 {
+				out = append(out, Anomaly{Kind: BeforeProfile, Score: float64(days),
+					Reason: fmt.Sprintf("date %s is before the profile was created on %s", rec.TxDate.Format("2006-01-02"), created.Format("2006-01-02"))})
+			}
+		}
+	}
+
+	amount, currency := value(rec)
+	if amount <= 0 {
+		return out
+	}
+	key := merchantname.Key(rec.MerchantName)
+	var byMerchant, byCategory []money.Amount
+	for _, h := range history {
+		if h.ID == rec.ID || (h.FileID != nil && rec.FileID != nil && *h.FileID == *rec.FileID) {
+			continue
+		}
+		a, c := value(h)
+		if c != currency || a <= 0 {
+			continue
+		}
+		if sameMerchant(h, rec, key) {
+			byMerchant = append(byMerchant, a)
+		}
+		if h.CategoryName == rec.CategoryName {
+			byCategory = append(byCategory, a)
+		}
+	}
+
+	if len(byMerchant) >= opts.MinMerchantHistory {
+		if a, ok := compare(MerchantAmount, amount, currency, byMerchant, opts.Factor, "at "+merchantname.Canonical(rec.MerchantName)); ok {
+			out = append(out, a)
+		}
+	} else if len(byCategory) >= opts.MinCategoryHistory {
+		if a, ok := compare(CategoryAmount, amount, currency, byCategory, opts.Factor, "in "+rec.CategoryName); ok {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+func compare(kind Kind, amount money.Amount, currency string, history []money.Amount, factor float64, where string) (Anomaly, bool) {
+	usual := median(history)
+	if usual <= 0 {
+		return Anomaly{}, false
+	}
+	ratio := float64(amount) / float64(usual)
+	if ratio < factor {
+		return Anomaly{}, false
+	}
+	return Anomaly{Kind: kind, Score: math.Round(ratio*10) / 10,
+		Reason: fmt.Sprintf("total %s %s is %.1f× the usual %s %s (%d receipts)", amount, currency, ratio, usual, where, len(history))}, true
+}
+
+// Checker runs Detect on stored receipts and records what it finds.
+type Checker struct {
+	receiptRepo repository.ReceiptRepository
+	profileRepo repository.ProfileRepository
+	anomalyRepo repository.AnomalyRepository
+	opts        Options
+	logger      *slog.Logger
+}
+
+// NewChecker creates a checker; opts.Now is ignored and the current time used.
+func NewChecker(receiptRepo repository.ReceiptRepository, profileRepo repository.ProfileRepository, anomalyRepo repository.AnomalyRepository, opts Options, logger *slog.Logger) *Checker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Checker{
+		receiptRepo: receiptRepo,
+		profileRepo: profileRepo,
+		anomalyRepo: anomalyRepo,
+		opts:        opts,
+		logger:      logger,
+	}
+}
+
+// Check scores a stored receipt against the profile's history and records the anomalies
+// found, replacing earlier ones for the receipt.
+func (c *Checker) Check(ctx context.Context, rec *entity.Receipt) ([]Anomaly, error) {
+	prof, err := c.profileRepo.GetByID(ctx, rec.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("get profile: %w", err)
+	}
+	history, err := c.receiptRepo.ListComparable(ctx, rec.ProfileID, rec.MerchantID, rec.MerchantName, rec.CategoryName, HistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("list history: %w", err)
+	}
+	opts := c.opts
+	opts.Now = time.Now()
+	opts.ProfileCreated = prof.CreatedAt
+	found := Detect(rec, history, opts)
+
+	records := make([]*entity.ReceiptAnomaly, len(found))
+	for i, a := range found {
+		records[i] = &entity.ReceiptAnomaly{Kind: string(a.Kind), Reason: a.Reason, Score: a.Score}
+	}
+	if err := c.anomalyRepo.Record(ctx, rec.ID, rec.ProfileID, records); err != nil {
+		return nil, fmt.Errorf("record anomalies: %w", err)
+	}
+	if len(found) > 0 {
+		c.logger.Info("receipt anomalies found", "receipt_id", rec.ID, "profile_id", rec.ProfileID, "anomalies", Reasons(found))
+	}
+	return found, nil
+}
+
+// Reasons lists the anomalies' reasons.
+func Reasons(found []Anomaly) []string {
+	out := make([]string, len(found))
+	for i, a := range found {
+		out[i] = a.Reason
+	}
+	return out
+}
+
+// value is the receipt's total in the profile currency when converted, else as printed.
+func value(r *entity.Receipt) (money.Amount, string) {
+	if r.ConvertedTotal != nil && r.ConvertedCurrency != nil {
+		return *r.ConvertedTotal, *r.ConvertedCurrency
+	}
+	return r.Total, r.CurrencyCode
+}
+
+func sameMerchant(a, b *entity.Receipt, key string) bool {
+	if a.MerchantID != nil && b.MerchantID != nil {
+		return *a.MerchantID == *b.MerchantID
+	}
+	return key != "" && merchantname.Key(a.MerchantName) == key
+}
+
+func median(amounts []money.Amount) money.Amount {
+	sorted := append([]money.Amount(nil), amounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween counts calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	return int(math.Round(day(b).Sub(day(a)).Hours() / 24))
+}
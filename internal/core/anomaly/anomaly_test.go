@@ -0,0 +1,90 @@
+package anomaly
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func rec(merchant, category, d, total string) *entity.Receipt {
+	return &entity.Receipt{ID: uuid.New(), MerchantName: merchant, CategoryName: category, TxDate: date(d), Total: money.MustParse(total), CurrencyCode: "USD"}
+}
+
+func kinds(found []Anomaly) string {
+	var out []string
+	for _, a := range found {
+		out = append(out, string(a.Kind))
+	}
+	return strings.Join(out, ",")
+}
+
+func TestDetect(t *testing.T) {
+	history := []*entity.Receipt{
+		rec("Blue Bottle Coffee", "Meals", "2025-05-02", "4.50"),
+		rec("BLUE BOTTLE COFFEE", "Meals", "2025-05-09", "5.25"),
+		rec("Blue Bottle Coffee", "Meals", "2025-05-16", "4.75"),
+		rec("Chipotle", "Meals", "2025-05-03", "12.40"),
+		rec("Chipotle", "Meals", "2025-05-20", "13.10"),
+		rec("Apple", "Office Expense", "2025-01-10", "1999.00"),
+		rec("Apple", "Office Expense", "2025-03-10", "2499.00"),
+		rec("Apple", "Office Expense", "2025-04-10", "1299.00"),
+		rec("Staples", "Office Expense", "2025-02-01", "24.00"),
+		rec("Staples", "Office Expense", "2025-02-11", "31.00"),
+		rec("Staples", "Office Expense", "2025-03-01", "18.00"),
+	}
+	opts := Options{Now: date("2025-06-01"), ProfileCreated: date("2025-01-01")}
+
+	tests := []struct {
+		name     string
+		receipt  *entity.Receipt
+		expected string
+	}{
+		{name: "Usual coffee", receipt: rec("Blue Bottle Coffee", "Meals", "2025-05-30", "5.00"), expected: ""},
+		{name: "A $4,999 coffee", receipt: rec("Blue Bottle Coffee", "Meals", "2025-05-30", "4999.00"), expected: "merchant_amount"},
+		{name: "New merchant far above the category", receipt: rec("Nobu", "Meals", "2025-05-30", "950.00"), expected: "category_amount"},
+		{name: "Expensive merchant in a cheap category", receipt: rec("Apple", "Office Expense", "2025-05-30", "1899.00"), expected: ""},
+		{name: "Year 2052", receipt: rec("Chipotle", "Meals", "2052-05-30", "12.90"), expected: "future_date"},
+		{name: "Tomorrow in another time zone", receipt: rec("Chipotle", "Meals", "2025-06-02", "12.90"), expected: ""},
+		{name: "Before the profile", receipt: rec("Chipotle", "Meals", "2024-12-30", "12.90"), expected: "before_profile"},
+		{name: "Too little history", receipt: rec("Chipotle", "Travel", "2025-05-30", "500.00"), expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := Detect(tt.receipt, history, opts)
+			if got := kinds(found); got != tt.expected {
+				t.Errorf("Expected %q, got %q (%v)", tt.expected, got, Reasons(found))
+			}
+		})
+	}
+}
+
+func TestDetectReason(t *testing.T) {
+	history := []*entity.Receipt{
+		rec("Blue Bottle Coffee", "Meals", "2025-05-02", "4.50"),
+		rec("Blue Bottle Coffee", "Meals", "2025-05-09", "4.55"),
+		rec("Blue Bottle Coffee", "Meals", "2025-05-16", "4.75"),
+	}
+	r := rec("BLUE BOTTLE COFFEE", "Meals", "2025-05-30", "4999.00")
+	// the receipt itself is skipped when it is already in the history
+	found := Detect(r, append(history, r), Options{Now: date("2025-06-01")})
+	if len(found) != 1 {
+		t.Fatalf("Expected 1 anomaly, got %v", Reasons(found))
+	}
+	expected := "total 4999.00 USD is 1098.7× the usual 4.55 at Blue Bottle Coffee (3 receipts)"
+	if found[0].Reason != expected {
+		t.Errorf("Expected %q, got %q", expected, found[0].Reason)
+	}
+	if found[0].Score != 1098.7 {
+		t.Errorf("Expected score 1098.7, got %v", found[0].Score)
+	}
+}
@@ -0,0 +1,134 @@
+// Package budget checks category spending against a profile's budgets and sends an alert
+// the first time a period's spending crosses each threshold.
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+// Thresholds are the percentages of a budget that raise an alert.
+var Thresholds = []int{80, 100}
+
+// PeriodRange returns the first and last day of the budget period containing t.
+func PeriodRange(period string, t time.Time) (time.Time, time.Time, error) {
+	switch period {
+	case entity.BudgetMonthly:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, -1), nil
+	case entity.BudgetAnnual:
+		start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, -1), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("unknown budget period %q", period)
+}
+
+// Checker compares spending with budgets after a receipt is stored.
+type Checker struct {
+	budgetRepo    repository.BudgetRepository
+	analyticsRepo repository.AnalyticsRepository
+	notifier      Notifier
+	logger        *slog.Logger
+}
+
+// NewChecker creates a checker that delivers alerts through notifier.
+func NewChecker(budgetRepo repository.BudgetRepository, analyticsRepo repository.AnalyticsRepository, notifier Notifier, logger *slog.Logger) *Checker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Checker{
+		budgetRepo:    budgetRepo,
+		analyticsRepo: analyticsRepo,
+		notifier:      notifier,
+		logger:        logger,
+	}
+}
+
+// Spent totals a category's current receipts in the budget period containing t, in the
+// profile currency. Receipts in another currency without a converted total are not counted.
+func (c *Checker) Spent(ctx context.Context, b *entity.Budget, t time.Time) (money.Amount, time.Time, error) {
+	from, to, err := PeriodRange(b.Period, t)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	rows, err := c.analyticsRepo.SpendBy(ctx, b.ProfileID, repository.SpendByCategory, &from, &to, 0)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	for _, r := range rows {
+		if r.Key == b.CategoryName {
+			return r.Total, from, nil
+		}
+	}
+	return 0, from, nil
+}
+
+// Check evaluates the budgets of the receipt's category for the period of its date. Each
+// threshold crossed is recorded once per budget period and announced until a notifier
+// accepts it, so an alert whose delivery failed is sent again on the next check. A
+// notifier failure is returned after every threshold has been processed.
+func (c *Checker) Check(ctx context.Context, rec *entity.Receipt) error {
+	budgets, err := c.budgetRepo.ListByCategory(ctx, rec.ProfileID, rec.CategoryName)
+	if err != nil {
+		return fmt.Errorf("list budgets: %w", err)
+	}
+	var notifyErr error
+	for _, b := range budgets {
+		if b.Amount <= 0 {
+			continue
+		}
+		spent, start, err := c.Spent(ctx, b, rec.TxDate)
+		if err != nil {
+			return fmt.Errorf("budget %s: %w", b.ID, err)
+		}
+		for _, threshold := range Thresholds {
+			if spent*100 < b.Amount*money.Amount(threshold) {
+				break
+			}
+			receiptID := rec.ID
+			alert := &entity.BudgetAlert{
+				BudgetID:     b.ID,
+				ProfileID:    b.ProfileID,
+				CategoryName: b.CategoryName,
+				Period:       b.Period,
+				PeriodStart:  start,
+				Threshold:    threshold,
+				Spent:        spent,
+				BudgetAmount: b.Amount,
+				ReceiptID:    &receiptID,
+				CreatedAt:    time.Now().UTC(),
+			}
+			stored, created, err := c.budgetRepo.RecordAlert(ctx, alert)
+			if err != nil {
+				return fmt.Errorf("record budget alert: %w", err)
+			}
+			if created {
+				c.logger.Info("budget threshold crossed", "budget_id", b.ID, "category", b.CategoryName, "period", b.Period,
+					"period_start", start.Format("2006-01-02"), "threshold", threshold, "spent", spent, "budget", b.Amount, "receipt_id", rec.ID)
+			}
+			if stored.DeliveredAt != nil || c.notifier == nil {
+				continue
+			}
+			if err := c.notifier.Notify(ctx, stored); err != nil {
+				var partial *PartialDeliveryError
+				if !errors.As(err, &partial) {
+					c.logger.Error("failed to deliver budget alert", "budget_id", b.ID, "threshold", threshold, "error", err)
+					notifyErr = fmt.Errorf("notify: %w", err)
+					continue
+				}
+				c.logger.Warn("budget alert not sent to every notifier", "budget_id", b.ID, "threshold", threshold, "error", err)
+			}
+			if err := c.budgetRepo.MarkAlertDelivered(ctx, stored.ID); err != nil {
+				return fmt.Errorf("mark budget alert delivered: %w", err)
+			}
+		}
+	}
+	return notifyErr
+}
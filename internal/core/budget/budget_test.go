@@ -0,0 +1,323 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+type fakeBudgets struct {
+	repository.BudgetRepository
+	budgets []*entity.Budget
+	alerts  map[string]*entity.BudgetAlert
+}
+
+func (f *fakeBudgets) ListByCategory(_ context.Context, _ uuid.UUID, category string) ([]*entity.Budget, error) {
+	var out []*entity.Budget
+	for _, b := range f.budgets {
+		if b.CategoryName == category {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeBudgets) RecordAlert(_ context.Context, a *entity.BudgetAlert) (*entity.BudgetAlert, bool, error) {
+	key := fmt.Sprintf("%s/%s/%d", a.BudgetID, a.PeriodStart.Format("2006-01-02"), a.Threshold)
+	if stored, ok := f.alerts[key]; ok {
+		return stored, false, nil
+	}
+	stored := *a
+	stored.ID = uuid.New()
+	f.alerts[key] = &stored
+	return &stored, true, nil
+}
+
+func (f *fakeBudgets) MarkAlertDelivered(_ context.Context, id uuid.UUID) error {
+	for _, a := range f.alerts {
+		if a.ID == id {
+			now := time.Now()
+			a.DeliveredAt = &now
+			return nil
+		}
+	}
+	return errors.New("alert not found")
+}
+
+// fakeAnalytics returns the category total of the receipts in the requested range.
+type fakeAnalytics struct {
+	receipts []*entity.Receipt
+}
+
+func (f *fakeAnalytics) SpendBy(_ context.Context, _ uuid.UUID, _ repository.SpendGroup, from, to *time.Time, _ int) ([]repository.SpendRow, error) {
+	totals := map[string]money.Amount{}
+	for _, r := range f.receipts {
+		if !r.TxDate.Before(*from) && !r.TxDate.After(*to) {
+			totals[r.CategoryName] += r.Total
+		}
+	}
+	var rows []repository.SpendRow
+	for k, v := range totals {
+		rows = append(rows, repository.SpendRow{Key: k, Total: v})
+	}
+	return rows, nil
+}
+
+type fakeNotifier struct {
+	sent []*entity.BudgetAlert
+	err  error
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, a *entity.BudgetAlert) error {
+	f.sent = append(f.sent, a)
+	return f.err
+}
+
+func TestCheck(t *testing.T) {
+	pid := uuid.New()
+	budgets := &fakeBudgets{
+		budgets: []*entity.Budget{
+			{ID: uuid.New(), ProfileID: pid, CategoryName: "Meals", Period: entity.BudgetMonthly, Amount: money.MustParse("500.00")},
+			{ID: uuid.New(), ProfileID: pid, CategoryName: "Meals", Period: entity.BudgetAnnual, Amount: money.MustParse("5000.00")},
+		},
+		alerts: map[string]*entity.BudgetAlert{},
+	}
+	analytics := &fakeAnalytics{}
+	notifier := &fakeNotifier{}
+	checker := NewChecker(budgets, analytics, notifier, nil)
+
+	add := func(d, total string) {
+		tx, _ := time.Parse("2006-01-02", d)
+		r := &entity.Receipt{ID: uuid.New(), ProfileID: pid, CategoryName: "Meals", TxDate: tx, Total: money.MustParse(total)}
+		analytics.receipts = append(analytics.receipts, r)
+		if err := checker.Check(context.Background(), r); err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+	}
+
+	tests := []struct {
+		date, total string
+		expected    []string
+	}{
+		{date: "2025-07-02", total: "300.00"},
+		{date: "2025-07-10", total: "120.00", expected: []string{"Meals monthly budget 80% used: 420.00 of 500.00 (period starting 2025-07-01)"}},
+		{date: "2025-07-11", total: "10.00"}, // already announced
+		{date: "2025-07-20", total: "90.00", expected: []string{"Meals monthly budget 100% reached: 520.00 of 500.00 (period starting 2025-07-01)"}},
+		{date: "2025-08-01", total: "450.00", expected: []string{"Meals monthly budget 80% used: 450.00 of 500.00 (period starting 2025-08-01)"}},
+	}
+	for _, tt := range tests {
+		notifier.sent = nil
+		add(tt.date, tt.total)
+		var got []string
+		for _, a := range notifier.sent {
+			got = append(got, Message(a))
+		}
+		if len(got) != len(tt.expected) {
+			t.Fatalf("%s: Expected %q, got %q", tt.date, tt.expected, got)
+		}
+		for i := range got {
+			if got[i] != tt.expected[i] {
+				t.Errorf("%s: Expected %q, got %q", tt.date, tt.expected[i], got[i])
+			}
+		}
+	}
+}
+
+func TestCheckNotifierError(t *testing.T) {
+	pid := uuid.New()
+	budgets := &fakeBudgets{
+		budgets: []*entity.Budget{{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", Period: entity.BudgetAnnual, Amount: money.MustParse("100.00")}},
+		alerts:  map[string]*entity.BudgetAlert{},
+	}
+	r := &entity.Receipt{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", TxDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Total: money.MustParse("150.00")}
+	notifier := &fakeNotifier{err: errors.New("unreachable")}
+	checker := NewChecker(budgets, &fakeAnalytics{receipts: []*entity.Receipt{r}}, notifier, nil)
+
+	if err := checker.Check(context.Background(), r); err == nil {
+		t.Error("Expected the notifier error to be returned")
+	}
+	if len(notifier.sent) != 2 {
+		t.Errorf("Expected both thresholds to be attempted, got %d", len(notifier.sent))
+	}
+}
+
+func TestCheckRetriesUndelivered(t *testing.T) {
+	pid := uuid.New()
+	budgets := &fakeBudgets{
+		budgets: []*entity.Budget{{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", Period: entity.BudgetMonthly, Amount: money.MustParse("100.00")}},
+		alerts:  map[string]*entity.BudgetAlert{},
+	}
+	analytics := &fakeAnalytics{}
+	notifier := &fakeNotifier{err: errors.New("unreachable")}
+	checker := NewChecker(budgets, analytics, notifier, nil)
+	check := func(d, total string) error {
+		tx, _ := time.Parse("2006-01-02", d)
+		r := &entity.Receipt{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", TxDate: tx, Total: money.MustParse(total)}
+		analytics.receipts = append(analytics.receipts, r)
+		notifier.sent = nil
+		return checker.Check(context.Background(), r)
+	}
+
+	// the 80% alert is recorded but cannot be delivered
+	if err := check("2025-03-01", "85.00"); err == nil || len(notifier.sent) != 1 {
+		t.Fatalf("Expected one failed delivery, got %d sent and error %v", len(notifier.sent), err)
+	}
+
+	// the next check sends it again, along with the newly crossed 100%
+	notifier.err = nil
+	if err := check("2025-03-05", "20.00"); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	var got []int
+	for _, a := range notifier.sent {
+		got = append(got, a.Threshold)
+	}
+	if fmt.Sprint(got) != "[80 100]" {
+		t.Errorf("Expected the 80%% alert retried and the 100%% one sent, got %v", got)
+	}
+	if notifier.sent[0].Spent.String() != "85.00" {
+		t.Errorf("Expected the retried alert as recorded, got spent %s", notifier.sent[0].Spent)
+	}
+	for _, a := range budgets.alerts {
+		if a.DeliveredAt == nil {
+			t.Errorf("Expected the %d%% alert to be marked delivered", a.Threshold)
+		}
+	}
+
+	// delivered alerts are not sent again
+	if err := check("2025-03-09", "5.00"); err != nil || len(notifier.sent) != 0 {
+		t.Errorf("Expected nothing sent, got %d and error %v", len(notifier.sent), err)
+	}
+}
+
+func TestCheckPartialDelivery(t *testing.T) {
+	pid := uuid.New()
+	budgets := &fakeBudgets{
+		budgets: []*entity.Budget{{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", Period: entity.BudgetMonthly, Amount: money.MustParse("100.00")}},
+		alerts:  map[string]*entity.BudgetAlert{},
+	}
+	analytics := &fakeAnalytics{}
+	webhook := &fakeNotifier{}
+	smtp := &fakeNotifier{err: errors.New("unreachable")}
+	checker := NewChecker(budgets, analytics, Notifiers{LogNotifier{}, webhook, smtp}, nil)
+	check := func(d, total string) error {
+		tx, _ := time.Parse("2006-01-02", d)
+		r := &entity.Receipt{ID: uuid.New(), ProfileID: pid, CategoryName: "Travel", TxDate: tx, Total: money.MustParse(total)}
+		analytics.receipts = append(analytics.receipts, r)
+		return checker.Check(context.Background(), r)
+	}
+
+	// the webhook accepts the 80% alert while SMTP is down: it counts as delivered
+	if err := check("2025-03-01", "85.00"); err != nil {
+		t.Fatalf("Expected a partial delivery to succeed, got %v", err)
+	}
+	if err := check("2025-03-02", "5.00"); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if len(webhook.sent) != 1 {
+		t.Errorf("Expected the webhook to get the alert once, got %d", len(webhook.sent))
+	}
+
+	// with only the log and a failing channel, nothing is delivered
+	checker = NewChecker(budgets, analytics, Notifiers{LogNotifier{}, smtp}, nil)
+	if err := check("2025-03-03", "20.00"); err == nil {
+		t.Error("Expected the 100% alert to stay undelivered")
+	}
+	for _, a := range budgets.alerts {
+		if delivered := a.DeliveredAt != nil; delivered != (a.Threshold == 80) {
+			t.Errorf("Expected only the 80%% alert delivered, got %d%% delivered=%v", a.Threshold, delivered)
+		}
+	}
+}
+
+func TestSpentOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	client, db, err := repository.OpenSQLiteInMemory(slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := repository.MigrateSQLite(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	p, err := client.Profile.Create().SetName("Budgets").SetDefaultCurrency("USD").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	budgetRepo := repository.NewBudgetRepository(client, slog.Default())
+	b, err := budgetRepo.Create(ctx, &entity.Budget{ProfileID: p.ID, CategoryName: "Meals", Period: entity.BudgetMonthly, Amount: money.MustParse("100.00")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notifier := &fakeNotifier{}
+	checker := NewChecker(budgetRepo, repository.NewAnalyticsRepository(client, slog.Default()), notifier, nil)
+
+	add := func(total, currency, converted string) *entity.Receipt {
+		t.Helper()
+		c := client.Receipt.Create().
+			SetProfileID(p.ID).
+			SetMerchantName("Blue Bottle").
+			SetTxDate(time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC)).
+			SetTotal(money.MustParse(total)).
+			SetCurrencyCode(currency).
+			SetCategoryName("Meals").
+			SetDescription("coffee")
+		if converted != "" {
+			c.SetConvertedTotal(money.MustParse(converted))
+		}
+		r, err := c.Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &entity.Receipt{ID: r.ID, ProfileID: p.ID, CategoryName: "Meals", TxDate: r.TxDate, Total: r.Total}
+	}
+	add("60.00", "USD", "")      // profile currency, never converted
+	add("25.00", "EUR", "30.00") // converted
+	r := add("90.00", "EUR", "") // no rate: left out
+	spent, _, err := checker.Spent(ctx, b, r.TxDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent.String() != "90.00" {
+		t.Errorf("Expected 90.00 spent, got %s", spent)
+	}
+
+	if err := checker.Check(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].Threshold != 80 {
+		t.Errorf("Expected the 80%% alert, got %d alerts", len(notifier.sent))
+	}
+}
+
+func TestPeriodRange(t *testing.T) {
+	tx := time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		period   string
+		expected string
+	}{
+		{period: entity.BudgetMonthly, expected: "2024-02-01..2024-02-29"},
+		{period: entity.BudgetAnnual, expected: "2024-01-01..2024-12-31"},
+	}
+	for _, tt := range tests {
+		from, to, err := PeriodRange(tt.period, tx)
+		if err != nil {
+			t.Fatalf("PeriodRange(%q): %v", tt.period, err)
+		}
+		if got := from.Format("2006-01-02") + ".." + to.Format("2006-01-02"); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+	if _, _, err := PeriodRange("weekly", tx); err == nil {
+		t.Error("Expected an error for an unknown period")
+	}
+}
@@ -0,0 +1,208 @@
+package budget
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// Notifier delivers budget alerts.
+type Notifier interface {
+	Notify(ctx context.Context, alert *entity.BudgetAlert) error
+}
+
+// Message is the one-line text of an alert, e.g.
+// "Groceries monthly budget 80% used: 412.50 of 500.00 (period starting 2025-07-01)".
+func Message(a *entity.BudgetAlert) string {
+	verb := "used"
+	if a.Threshold >= 100 {
+		verb = "reached"
+	}
+	return fmt.Sprintf("%s %s budget %d%% %s: %s of %s (period starting %s)",
+		a.CategoryName, a.Period, a.Threshold, verb, a.Spent, a.BudgetAmount, a.PeriodStart.Format("2006-01-02"))
+}
+
+// LogNotifier writes alerts to the log.
+type LogNotifier struct {
+	Logger *slog.Logger
+}
+
+func (n LogNotifier) Notify(_ context.Context, a *entity.BudgetAlert) error {
+	logger := n.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Warn("budget alert", "message", Message(a), "profile_id", a.ProfileID, "budget_id", a.BudgetID, "threshold", a.Threshold)
+	return nil
+}
+
+// WebhookNotifier POSTs each alert as JSON, with its message in a "text" field so chat
+// webhooks (Slack, Mattermost) display it as is.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client // nil = 10s timeout
+}
+
+func (n WebhookNotifier) Notify(ctx context.Context, a *entity.BudgetAlert) error {
+	body, err := json.Marshal(struct {
+		*entity.BudgetAlert
+		Text string `json:"text"`
+	}{a, Message(a)})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SMTPNotifier emails each alert, upgrading to TLS when the server offers STARTTLS.
+// Username empty = no authentication.
+type SMTPNotifier struct {
+	Addr     string // host:port
+	From     string
+	To       []string
+	Username string
+	Password string
+	Timeout  time.Duration // for the whole exchange; 0 = 10s
+}
+
+// Notify sends the email, giving up when ctx is done or the timeout passes so a slow mail
+// server can't hold up the caller.
+func (n SMTPNotifier) Notify(ctx context.Context, a *entity.BudgetAlert) error {
+	if len(n.To) == 0 {
+		return errors.New("smtp: no recipients")
+	}
+	timeout := n.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", n.Addr)
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+	// A cancelled ctx interrupts a read or write in progress.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	host, _, err := net.SplitHostPort(n.Addr)
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if n.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", n.Username, n.Password, host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(n.From); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	for _, to := range n.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("smtp: %w", err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	msg := "From: " + n.From + "\r\n" +
+		"To: " + strings.Join(n.To, ", ") + "\r\n" +
+		"Subject: " + subject(a) + "\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" + Message(a) + "\r\n"
+	if _, err := w.Write([]byte(msg)); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return c.Quit()
+}
+
+// subject is the encoded Subject header of an alert email. Category names are user input,
+// so line breaks are dropped to keep them from adding headers.
+func subject(a *entity.BudgetAlert) string {
+	name := strings.NewReplacer("\r", "", "\n", "").Replace(a.CategoryName)
+	return mime.QEncoding.Encode("utf-8", "Budget alert: "+name)
+}
+
+// Notifiers sends each alert through every notifier. The alert is delivered once a
+// notifier that reaches someone (anything but a LogNotifier) accepts it, so a channel
+// that is down does not make the others receive it again on every later check; the
+// failures are then returned as a *PartialDeliveryError. Otherwise their errors are
+// joined.
+type Notifiers []Notifier
+
+func (ns Notifiers) Notify(ctx context.Context, a *entity.BudgetAlert) error {
+	var errs []error
+	delivered := false
+	for _, n := range ns {
+		if err := n.Notify(ctx, a); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, ok := n.(LogNotifier); !ok {
+			delivered = true
+		}
+	}
+	if delivered && len(errs) > 0 {
+		return &PartialDeliveryError{Err: errors.Join(errs...)}
+	}
+	return errors.Join(errs...)
+}
+
+// PartialDeliveryError reports notifiers that failed for an alert another one delivered.
+type PartialDeliveryError struct {
+	Err error
+}
+
+func (e *PartialDeliveryError) Error() string {
+	return "partially delivered: " + e.Err.Error()
+}
+
+func (e *PartialDeliveryError) Unwrap() error { return e.Err }
@@ -0,0 +1,133 @@
+package budget
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// fakeSMTP accepts one connection and, unless silent, answers it as a mail server that
+// takes any message, sending the DATA it received on data.
+func fakeSMTP(t *testing.T, silent bool) (addr string, data <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	out := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if silent {
+			_, _ = bufio.NewReader(conn).ReadString('\n') // blocks until the client gives up
+			return
+		}
+		r := bufio.NewReader(conn)
+		reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+		reply("220 localhost ESMTP")
+		var body strings.Builder
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					body.WriteString(l)
+				}
+				out <- body.String()
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return ln.Addr().String(), out
+}
+
+func testAlert() *entity.BudgetAlert {
+	return &entity.BudgetAlert{CategoryName: "Groceries", Period: entity.BudgetMonthly, Threshold: 80,
+		Spent: money.MustParse("412.50"), BudgetAmount: money.MustParse("500.00"), PeriodStart: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestSMTPNotifierSends(t *testing.T) {
+	addr, data := fakeSMTP(t, false)
+	n := SMTPNotifier{Addr: addr, From: "alerts@example.com", To: []string{"me@example.com"}}
+	if err := n.Notify(context.Background(), testAlert()); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	body := <-data
+	for _, expected := range []string{"Subject: Budget alert: Groceries", Message(testAlert())} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected the email to contain %q, got %q", expected, body)
+		}
+	}
+}
+
+func TestSubject(t *testing.T) {
+	tests := []struct {
+		category string
+		expected string
+	}{
+		{"Groceries", "Budget alert: Groceries"},
+		{"Meals\r\nBcc: everyone@example.com", "Budget alert: MealsBcc: everyone@example.com"},
+		{"Café", "=?utf-8?q?Budget_alert:_Caf=C3=A9?="},
+	}
+	for _, tt := range tests {
+		a := testAlert()
+		a.CategoryName = tt.category
+		if got := subject(a); got != tt.expected {
+			t.Errorf("subject(%q): expected %q, got %q", tt.category, tt.expected, got)
+		}
+	}
+}
+
+func TestSMTPNotifierGivesUp(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		cancel  time.Duration // cancel the context after this long; 0 = never
+	}{
+		{name: "Timeout", timeout: 100 * time.Millisecond},
+		{name: "Context cancelled", timeout: time.Minute, cancel: 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, _ := fakeSMTP(t, true)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel > 0 {
+				time.AfterFunc(tt.cancel, cancel)
+			}
+			start := time.Now()
+			err := SMTPNotifier{Addr: addr, From: "alerts@example.com", To: []string{"me@example.com"}, Timeout: tt.timeout}.Notify(ctx, testAlert())
+			if err == nil {
+				t.Fatal("Expected an error from a server that never answers")
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("Expected Notify to give up quickly, took %s", elapsed)
+			}
+		})
+	}
+}
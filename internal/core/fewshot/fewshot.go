@@ -0,0 +1,126 @@
+// Package fewshot picks past reviewer corrections that resemble a new receipt, so they can
+// be shown to the LLM as examples.
+package fewshot
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// MinScore is the similarity below which a correction is not considered relevant. A full
+// merchant-name match alone scores 2.
+const MinScore = 1.0
+
+const maxItemsLen = 120
+
+// Select returns up to k corrections most similar to text (OCR text plus filename hints),
+// best first. corrections must be ordered newest first; only the newest correction per
+// merchant and item list is considered, and ties keep that order. Corrections into
+// categories not in allowed are skipped.
+func Select(text string, corrections []*entity.CategoryCorrection, allowed []string, k int) []llm.CategoryExample {
+	if k <= 0 || len(corrections) == 0 {
+		return nil
+	}
+	haystack := tokenSet(text)
+	if len(haystack) == 0 {
+		return nil
+	}
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, a := range allowed {
+		allowedSet[a] = true
+	}
+
+	type scored struct {
+		ex    llm.CategoryExample
+		score float64
+	}
+	var out []scored
+	seen := map[string]bool{}
+	for _, c := range corrections {
+		items := ""
+		if c.Description != nil {
+			items = strings.TrimSpace(*c.Description)
+		}
+		key := strings.ToLower(strings.TrimSpace(c.MerchantName)) + "|" + strings.ToLower(items)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if len(allowedSet) > 0 && !allowedSet[c.ToCategory] {
+			continue
+		}
+
+		score := 2*overlap(tokens(c.MerchantName), haystack) + overlap(tokens(items), haystack)
+		if score < MinScore {
+			continue
+		}
+		if len(items) > maxItemsLen {
+			items = items[:maxItemsLen] + "…"
+		}
+		out = append(out, scored{
+			ex:    llm.CategoryExample{Merchant: c.MerchantName, Items: items, Category: c.ToCategory},
+			score: score,
+		})
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].score > out[j].score })
+	if len(out) > k {
+		out = out[:k]
+	}
+	examples := make([]llm.CategoryExample, len(out))
+	for i, s := range out {
+		examples[i] = s.ex
+	}
+	return examples
+}
+
+// overlap is the fraction of needle tokens present in haystack.
+func overlap(needle []string, haystack map[string]bool) float64 {
+	if len(needle) == 0 {
+		return 0
+	}
+	hits := 0
+	for _, t := range needle {
+		if haystack[t] {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(needle))
+}
+
+func tokenSet(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, t := range tokens(s) {
+		set[t] = true
+	}
+	return set
+}
+
+// tokens splits s into lower-cased alphanumeric words of at least three characters,
+// dropping words that are too common on receipts to say anything about the vendor.
+func tokens(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var out []string
+	for _, f := range fields {
+		if len([]rune(f)) < 3 || stopwords[f] {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+var stopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "inc": true, "llc": true, "com": true,
+	"www": true, "total": true, "subtotal": true, "tax": true, "receipt": true, "order": true,
+	"store": true, "thank": true, "you": true, "usd": true,
+}
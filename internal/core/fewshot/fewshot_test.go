@@ -0,0 +1,78 @@
+package fewshot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+func correction(merchant, items, to string) *entity.CategoryCorrection {
+	c := &entity.CategoryCorrection{MerchantName: merchant, FromCategory: "Other", ToCategory: to}
+	if items != "" {
+		c.Description = &items
+	}
+	return c
+}
+
+func TestSelect(t *testing.T) {
+	// newest first
+	corrections := []*entity.CategoryCorrection{
+		correction("Staples", "toner cartridge", "Office Equipment"),
+		correction("B&H Photo", "camera lens", "Camera Gear"),
+		correction("Staples", "toner cartridge", "Office Supplies"), // older duplicate, ignored
+		correction("Comcast", "internet service", "Internet"),
+		correction("Blue Bottle Coffee", "latte", "Meals"),
+	}
+	allowed := []string{"Office Equipment", "Office Supplies", "Internet", "Meals"}
+
+	tests := []struct {
+		name     string
+		text     string
+		k        int
+		expected []llm.CategoryExample
+	}{
+		{
+			name:     "Merchant match",
+			text:     "STAPLES STORE #1234\nHP 64 Toner Cartridge 39.99\nTOTAL 43.19",
+			k:        3,
+			expected: []llm.CategoryExample{{Merchant: "Staples", Items: "toner cartridge", Category: "Office Equipment"}},
+		},
+		{
+			name:     "Item match without merchant",
+			text:     "Corner cafe latte 4.50",
+			k:        3,
+			expected: []llm.CategoryExample{{Merchant: "Blue Bottle Coffee", Items: "latte", Category: "Meals"}},
+		},
+		{
+			name:     "Category not allowed is skipped",
+			text:     "B&H Photo camera lens",
+			k:        3,
+			expected: nil,
+		},
+		{
+			name: "Best first, limited to k",
+			text: "Comcast internet service; Staples toner",
+			k:    1,
+			expected: []llm.CategoryExample{
+				{Merchant: "Comcast", Items: "internet service", Category: "Internet"},
+			},
+		},
+		{
+			name:     "No overlap",
+			text:     "Shell gas station unleaded",
+			k:        3,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Select(tt.text, corrections, allowed, tt.k)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package fx converts receipt amounts between currencies using imported daily rates.
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Pivot is the currency cross rates are computed through (ECB publishes EUR-based rates).
+const Pivot = "EUR"
+
+// MaxRateAge is how far before tx_date we look for a rate (weekends, bank holidays).
+const MaxRateAge = 7 * 24 * time.Hour
+
+// SourceIdentity marks conversions between the same currency.
+const SourceIdentity = "identity"
+
+// ErrNoRate is returned when no rate within MaxRateAge exists for a pair.
+var ErrNoRate = errors.New("fx: no rate available")
+
+// Rate is one daily exchange rate: 1 Base = Rate Quote.
+type Rate struct {
+	Date   time.Time
+	Base   string
+	Quote  string
+	Rate   float64
+	Source string
+}
+
+// Conversion is the result of converting an amount into a target currency.
+type Conversion struct {
+	Amount   money.Amount
+	Currency string
+	Rate     float64   // multiply the original amount by this
+	RateDate time.Time // date of the rate actually used (on or before tx_date)
+	Source   string
+}
+
+// RateStore looks up the latest rate for a pair on or before date, no older than minDate.
+// It returns (nil, nil) when there is none.
+type RateStore interface {
+	RateOnOrBefore(ctx context.Context, base, quote string, date, minDate time.Time) (*Rate, error)
+}
+
+// Converter resolves direct, inverse and EUR-cross rates, caching rates found for the exact
+// pair and date. Misses and older rates found by the lookback are not cached, so rates
+// imported later (e.g. by a receipt-batch run against the same database) are picked up
+// without a restart.
+type Converter struct {
+	store  RateStore
+	logger *slog.Logger
+
+	mu    sync.Mutex
+	cache map[string]*Rate
+}
+
+func NewConverter(store RateStore, logger *slog.Logger) *Converter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Converter{store: store, logger: logger, cache: map[string]*Rate{}}
+}
+
+// Convert converts amount from one currency into another using the rate for date.
+func (c *Converter) Convert(ctx context.Context, amount money.Amount, from, to string, date time.Time) (Conversion, error) {
+	from, to = strings.ToUpper(strings.TrimSpace(from)), strings.ToUpper(strings.TrimSpace(to))
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if from == to {
+		return Conversion{Amount: amount, Currency: to, Rate: 1, RateDate: day, Source: SourceIdentity}, nil
+	}
+
+	r, err := c.resolve(ctx, from, to, day)
+	if err != nil {
+		return Conversion{}, err
+	}
+	return Conversion{
+		Amount:   money.Amount(math.Round(float64(amount) * r.Rate)),
+		Currency: to,
+		Rate:     r.Rate,
+		RateDate: r.Date,
+		Source:   r.Source,
+	}, nil
+}
+
+// resolve tries the direct pair, its inverse, then a cross through Pivot.
+func (c *Converter) resolve(ctx context.Context, from, to string, day time.Time) (*Rate, error) {
+	if r, err := c.pair(ctx, from, to, day); r != nil || err != nil {
+		return r, err
+	}
+	if from != Pivot && to != Pivot {
+		a, err := c.pair(ctx, from, Pivot, day)
+		if err != nil {
+			return nil, err
+		}
+		b, err := c.pair(ctx, Pivot, to, day)
+		if err != nil {
+			return nil, err
+		}
+		if a != nil && b != nil {
+			rateDate := a.Date
+			if b.Date.Before(rateDate) {
+				rateDate = b.Date
+			}
+			source := a.Source
+			if b.Source != a.Source {
+				source = a.Source + "+" + b.Source
+			}
+			return &Rate{Date: rateDate, Base: from, Quote: to, Rate: a.Rate * b.Rate, Source: source}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s->%s on %s", ErrNoRate, from, to, day.Format("2006-01-02"))
+}
+
+// pair returns the direct or inverted rate for base->quote, or nil when neither exists.
+func (c *Converter) pair(ctx context.Context, base, quote string, day time.Time) (*Rate, error) {
+	if r, err := c.lookup(ctx, base, quote, day); r != nil || err != nil {
+		return r, err
+	}
+	inv, err := c.lookup(ctx, quote, base, day)
+	if inv == nil || err != nil {
+		return nil, err
+	}
+	return &Rate{Date: inv.Date, Base: base, Quote: quote, Rate: 1 / inv.Rate, Source: inv.Source}, nil
+}
+
+func (c *Converter) lookup(ctx context.Context, base, quote string, day time.Time) (*Rate, error) {
+	date := day.Format("20060102")
+	key := base + quote + date
+	c.mu.Lock()
+	r, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok {
+		return r, nil
+	}
+	r, err := c.store.RateOnOrBefore(ctx, base, quote, day, day.Add(-MaxRateAge))
+	if err != nil {
+		c.logger.Error("fx rate lookup failed", "base", base, "quote", quote, "date", day, "error", err)
+		return nil, err
+	}
+	if r != nil && r.Date.Format("20060102") == date {
+		c.mu.Lock()
+		c.cache[key] = r
+		c.mu.Unlock()
+	}
+	return r, nil
+}
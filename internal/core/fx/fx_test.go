@@ -0,0 +1,160 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// memStore is a RateStore over a fixed list of rates.
+type memStore []Rate
+
+func (s memStore) RateOnOrBefore(_ context.Context, base, quote string, date, minDate time.Time) (*Rate, error) {
+	var best *Rate
+	for i := range s {
+		r := s[i]
+		if r.Base != base || r.Quote != quote || r.Date.After(date) || r.Date.Before(minDate) {
+			continue
+		}
+		if best == nil || r.Date.After(best.Date) {
+			best = &r
+		}
+	}
+	return best, nil
+}
+
+func day(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+func TestConvert(t *testing.T) {
+	store := memStore{
+		{Date: day("2025-03-14"), Base: "EUR", Quote: "USD", Rate: 1.25, Source: SourceECB},
+		{Date: day("2025-03-14"), Base: "EUR", Quote: "GBP", Rate: 0.8, Source: SourceECB},
+		{Date: day("2025-03-01"), Base: "EUR", Quote: "JPY", Rate: 160, Source: SourceECB},
+	}
+	conv := NewConverter(store, nil)
+
+	tests := []struct {
+		name     string
+		from, to string
+		date     string
+		amount   string
+		expected string
+		rateDate string
+		wantErr  bool
+	}{
+		{name: "Identity", from: "USD", to: "usd", date: "2025-03-16", amount: "19.99", expected: "19.99", rateDate: "2025-03-16"},
+		{name: "Direct", from: "EUR", to: "USD", date: "2025-03-14", amount: "10.00", expected: "12.50", rateDate: "2025-03-14"},
+		{name: "Inverse", from: "USD", to: "EUR", date: "2025-03-14", amount: "12.50", expected: "10.00", rateDate: "2025-03-14"},
+		{name: "Weekend uses prior rate", from: "EUR", to: "USD", date: "2025-03-16", amount: "10.00", expected: "12.50", rateDate: "2025-03-14"},
+		{name: "Cross via EUR", from: "GBP", to: "USD", date: "2025-03-14", amount: "8.00", expected: "12.50", rateDate: "2025-03-14"},
+		{name: "Rate too old", from: "EUR", to: "JPY", date: "2025-03-14", amount: "1.00", wantErr: true},
+		{name: "Before first rate", from: "EUR", to: "USD", date: "2025-03-13", amount: "1.00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := conv.Convert(context.Background(), money.MustParse(tt.amount), tt.from, tt.to, day(tt.date))
+			if tt.wantErr {
+				if !errors.Is(err, ErrNoRate) {
+					t.Errorf("Expected ErrNoRate, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got.Amount.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got.Amount.String())
+			}
+			if got.Currency != strings.ToUpper(tt.to) {
+				t.Errorf("Expected currency %q, got %q", strings.ToUpper(tt.to), got.Currency)
+			}
+			if d := got.RateDate.Format("2006-01-02"); d != tt.rateDate {
+				t.Errorf("Expected rate date %q, got %q", tt.rateDate, d)
+			}
+		})
+	}
+}
+
+func TestParseCSVWide(t *testing.T) {
+	in := "Date, USD, JPY, BGN,\n2025-03-14, 1.0882, 161.48, N/A,\n"
+	rates, err := ParseCSV(strings.NewReader(in), "test")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("Expected 2 rates, got %d", len(rates))
+	}
+	if rates[0].Base != "EUR" || rates[0].Quote != "USD" || rates[0].Rate != 1.0882 || rates[0].Source != SourceECB {
+		t.Errorf("Unexpected rate %+v", rates[0])
+	}
+}
+
+// growingStore is a RateStore whose rates can be added between lookups.
+type growingStore struct {
+	rates   memStore
+	lookups int
+}
+
+func (s *growingStore) RateOnOrBefore(ctx context.Context, base, quote string, date, minDate time.Time) (*Rate, error) {
+	s.lookups++
+	return s.rates.RateOnOrBefore(ctx, base, quote, date, minDate)
+}
+
+func TestConvertAfterRatesImported(t *testing.T) {
+	store := &growingStore{}
+	conv := NewConverter(store, nil)
+	ctx := context.Background()
+
+	if _, err := conv.Convert(ctx, money.MustParse("10.00"), "EUR", "USD", day("2025-03-14")); !errors.Is(err, ErrNoRate) {
+		t.Fatalf("Expected ErrNoRate before the import, got %v", err)
+	}
+
+	store.rates = memStore{{Date: day("2025-03-14"), Base: "EUR", Quote: "USD", Rate: 1.25, Source: SourceECB}}
+	got, err := conv.Convert(ctx, money.MustParse("10.00"), "EUR", "USD", day("2025-03-14"))
+	if err != nil {
+		t.Fatalf("Expected the imported rate to be used, got %v", err)
+	}
+	if got.Amount.String() != "12.50" {
+		t.Errorf("Expected %q, got %q", "12.50", got.Amount.String())
+	}
+
+	// found rates are cached
+	n := store.lookups
+	if _, err := conv.Convert(ctx, money.MustParse("20.00"), "EUR", "USD", day("2025-03-14")); err != nil {
+		t.Fatal(err)
+	}
+	if store.lookups != n {
+		t.Errorf("Expected a cached rate, got %d more lookups", store.lookups-n)
+	}
+}
+
+func TestConvertPrefersLaterExactRate(t *testing.T) {
+	store := &growingStore{rates: memStore{{Date: day("2025-03-13"), Base: "EUR", Quote: "USD", Rate: 1.2, Source: SourceECB}}}
+	conv := NewConverter(store, nil)
+	ctx := context.Background()
+
+	got, err := conv.Convert(ctx, money.MustParse("10.00"), "EUR", "USD", day("2025-03-14"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Amount.String() != "12.00" {
+		t.Errorf("Expected the previous day's rate, got %q", got.Amount.String())
+	}
+
+	store.rates = append(store.rates, Rate{Date: day("2025-03-14"), Base: "EUR", Quote: "USD", Rate: 1.25, Source: SourceECB})
+	got, err = conv.Convert(ctx, money.MustParse("10.00"), "EUR", "USD", day("2025-03-14"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Amount.String() != "12.50" || !got.RateDate.Equal(day("2025-03-14")) {
+		t.Errorf("Expected the imported same-day rate, got %q on %s", got.Amount.String(), got.RateDate.Format("2006-01-02"))
+	}
+}
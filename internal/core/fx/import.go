@@ -0,0 +1,161 @@
+package fx
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SourceECB tags rates that came from an ECB reference-rate file.
+const SourceECB = "ecb"
+
+// LoadFile reads rates from disk. ".xml" files are parsed as ECB eurofxref XML; anything
+// else as CSV (see ParseCSV).
+func LoadFile(path string) ([]Rate, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		return ParseECBXML(f)
+	}
+	return ParseCSV(f, "csv:"+filepath.Base(path))
+}
+
+// ParseCSV accepts two layouts:
+//   - long:  date,base,quote,rate   (one pair per row; header names are case-insensitive)
+//   - wide:  Date,USD,JPY,...        (ECB eurofxref-hist.csv: EUR-based, one day per row)
+func ParseCSV(r io.Reader, source string) ([]Rate, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("fx csv: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	_, hasQuote := col["quote"]
+	_, hasRate := col["rate"]
+	if hasQuote && hasRate {
+		return parseLongCSV(rows, col, source)
+	}
+	if _, ok := col["date"]; ok {
+		return parseWideCSV(rows)
+	}
+	return nil, fmt.Errorf("fx csv: header must be date,base,quote,rate or Date,<currency>...")
+}
+
+func parseLongCSV(rows [][]string, col map[string]int, source string) ([]Rate, error) {
+	get := func(row []string, name string) string {
+		i, ok := col[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+	var out []Rate
+	for n, row := range rows[1:] {
+		d, err := time.Parse("2006-01-02", get(row, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("fx csv row %d: date: %w", n+2, err)
+		}
+		base := strings.ToUpper(get(row, "base"))
+		if base == "" {
+			base = Pivot
+		}
+		rate, err := strconv.ParseFloat(get(row, "rate"), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("fx csv row %d: invalid rate %q", n+2, get(row, "rate"))
+		}
+		out = append(out, Rate{Date: d, Base: base, Quote: strings.ToUpper(get(row, "quote")), Rate: rate, Source: source})
+	}
+	return out, nil
+}
+
+func parseWideCSV(rows [][]string) ([]Rate, error) {
+	header := rows[0]
+	var out []Rate
+	for n, row := range rows[1:] {
+		if len(row) == 0 || strings.TrimSpace(row[0]) == "" {
+			continue
+		}
+		d, err := time.Parse("2006-01-02", strings.TrimSpace(row[0]))
+		if err != nil {
+			return nil, fmt.Errorf("fx csv row %d: date: %w", n+2, err)
+		}
+		for i := 1; i < len(row) && i < len(header); i++ {
+			quote := strings.ToUpper(strings.TrimSpace(header[i]))
+			rate, err := strconv.ParseFloat(strings.TrimSpace(row[i]), 64)
+			if len(quote) != 3 || err != nil || rate <= 0 {
+				continue // ECB marks missing values as "N/A" and ends rows with an empty column
+			}
+			out = append(out, Rate{Date: d, Base: Pivot, Quote: quote, Rate: rate, Source: SourceECB})
+		}
+	}
+	return out, nil
+}
+
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// ParseECBXML reads the ECB eurofxref daily/90d/hist XML format.
+func ParseECBXML(r io.Reader) ([]Rate, error) {
+	var env ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&env); err != nil {
+		return nil, fmt.Errorf("fx ecb xml: %w", err)
+	}
+	var out []Rate
+	for _, day := range env.Cube.Days {
+		d, err := time.Parse("2006-01-02", day.Time)
+		if err != nil {
+			return nil, fmt.Errorf("fx ecb xml: date %q: %w", day.Time, err)
+		}
+		for _, c := range day.Rates {
+			rate, err := strconv.ParseFloat(c.Rate, 64)
+			if err != nil || rate <= 0 {
+				continue
+			}
+			out = append(out, Rate{Date: d, Base: Pivot, Quote: strings.ToUpper(c.Currency), Rate: rate, Source: SourceECB})
+		}
+	}
+	return out, nil
+}
+
+// RateWriter persists imported rates.
+type RateWriter interface {
+	UpsertRates(ctx context.Context, rates []Rate) (int, error)
+}
+
+// ImportFile loads rates from path and stores them.
+func ImportFile(ctx context.Context, path string, w RateWriter) (int, error) {
+	rates, err := LoadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return w.UpsertRates(ctx, rates)
+}
@@ -0,0 +1,164 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CacheConfig configures a CachingExtractor.
+type CacheConfig struct {
+	Dir            string        // cache root; caching is disabled when empty
+	TTL            time.Duration // 0 = entries never expire
+	Bypass         bool          // skip lookups but still refresh entries (forces a paid call)
+	Model          string        // model of the wrapped extractor; part of the key
+	Temperature    float32       // temperature of the wrapped extractor; part of the key
+	ResponseFormat string        // of the wrapped extractor, resolved against Model; part of the key
+}
+
+// CachingExtractor wraps a FieldExtractor and memoizes successful responses on disk.
+// The key covers everything that influences the model output: file content hash,
+// rendered prompts, schema, response format, model and temperature.
+type CachingExtractor struct {
+	next   FieldExtractor
+	cfg    CacheConfig
+	logger *slog.Logger
+}
+
+type cacheEntry struct {
+	CreatedAt time.Time     `json:"created_at"`
+	Model     string        `json:"model"`
+	Fields    ReceiptFields `json:"fields"`
+	Raw       []byte        `json:"raw"`
+	Usage     Usage         `json:"usage"` // what the original call cost; not charged again on hits
+	Path      string        `json:"path,omitempty"`
+}
+
+// NewCachingExtractor returns next unchanged when cfg.Dir is empty.
+func NewCachingExtractor(next FieldExtractor, cfg CacheConfig, logger *slog.Logger) FieldExtractor {
+	if cfg.Dir == "" || next == nil {
+		return next
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &CachingExtractor{next: next, cfg: cfg, logger: logger}
+}
+
+// ExtractFields implements FieldExtractor.
+func (c *CachingExtractor) ExtractFields(ctx context.Context, req ExtractRequest) (ExtractResult, error) {
+	key, err := c.key(req)
+	if err != nil {
+		c.logger.Warn("llm cache key failed; calling through", "error", err)
+		return c.next.ExtractFields(ctx, req)
+	}
+	path := filepath.Join(c.cfg.Dir, key[:2], key+".json")
+
+	if !c.cfg.Bypass {
+		if e, ok := c.load(path); ok {
+			c.logger.Info("llm cache hit", "key", key, "model", e.Model, "age_s", int(time.Since(e.CreatedAt).Seconds()))
+			return ExtractResult{Fields: e.Fields, Raw: e.Raw, Model: e.Model, CacheHit: true, Path: e.Path}, nil
+		}
+	}
+
+	res, err := c.next.ExtractFields(ctx, req)
+	if err != nil {
+		return res, err
+	}
+	model := res.Model
+	if model == "" {
+		model = c.cfg.Model
+	}
+	if err := c.store(path, cacheEntry{CreatedAt: time.Now().UTC(), Model: model, Fields: res.Fields, Raw: res.Raw, Usage: res.Usage, Path: res.Path}); err != nil {
+		c.logger.Warn("llm cache write failed", "key", key, "error", err)
+	} else {
+		c.logger.Debug("llm cache stored", "key", key)
+	}
+	return res, nil
+}
+
+// key mirrors what the provider client sends: prompts depend on whether a vision
+// attachment is made, so resolve that the same way the client does.
+func (c *CachingExtractor) key(req ExtractRequest) (string, error) {
+	attached := len(ResolveVisionContent(req)) > 0
+	schema, err := json.Marshal(BuildReceiptJSONSchema(req.AllowedCategories))
+	if err != nil {
+		return "", fmt.Errorf("marshal schema: %w", err)
+	}
+	material, err := json.Marshal(struct {
+		ContentHash string       `json:"content_hash"`
+		System      string       `json:"system"`
+		User        string       `json:"user"`
+		Schema      string       `json:"schema"`
+		Format      string       `json:"format"`
+		Model       string       `json:"model"`
+		Temperature float32      `json:"temperature"`
+		Corrections []Correction `json:"corrections,omitempty"`
+	}{
+		ContentHash: req.ContentHashHex,
+		System:      BuildSystemPrompt(req),
+		User:        BuildUserPrompt(req, attached),
+		Schema:      string(schema),
+		Format:      ResolveResponseFormat(c.cfg.ResponseFormat, c.cfg.Model),
+		Model:       c.cfg.Model,
+		Temperature: c.cfg.Temperature,
+		Corrections: req.Corrections,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(material)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *CachingExtractor) load(path string) (cacheEntry, bool) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			c.logger.Warn("llm cache read failed", "path", path, "error", err)
+		}
+		return cacheEntry{}, false
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(b, &e); err != nil {
+		c.logger.Warn("llm cache entry corrupt; ignoring", "path", path, "error", err)
+		return cacheEntry{}, false
+	}
+	if c.cfg.TTL > 0 && time.Since(e.CreatedAt) > c.cfg.TTL {
+		c.logger.Debug("llm cache entry expired", "path", path, "created_at", e.CreatedAt)
+		return cacheEntry{}, false
+	}
+	return e, true
+}
+
+// store writes via a temp file + rename so concurrent workers never read a partial entry.
+func (c *CachingExtractor) store(path string, e cacheEntry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,183 @@
+package llm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// countingExtractor answers every request with the same fields and counts the calls.
+type countingExtractor struct {
+	calls int
+}
+
+func (e *countingExtractor) ExtractFields(_ context.Context, _ ExtractRequest) (ExtractResult, error) {
+	e.calls++
+	return ExtractResult{
+		Fields: ReceiptFields{MerchantName: "Staples", TxDate: "2025-03-14", Total: "42.18", CurrencyCode: "USD"},
+		Raw:    []byte(`{"merchant_name":"Staples"}`),
+		Model:  "gpt-4o-mini",
+		Usage:  Usage{PromptTokens: 900, CompletionTokens: 60},
+	}, nil
+}
+
+func cacheReq() ExtractRequest {
+	return ExtractRequest{
+		OCRText:           "STAPLES #1234\nTOTAL 42.18",
+		FilenameHint:      "staples.pdf",
+		AllowedCategories: []string{"Office Supplies", "Meals"},
+		DefaultCurrency:   "USD",
+		ContentHashHex:    "ab12",
+	}
+}
+
+func TestCachingExtractorKey(t *testing.T) {
+	cfg := CacheConfig{Model: "gpt-4o-mini", Temperature: 0}
+	tests := []struct {
+		name     string
+		req      func(r *ExtractRequest)
+		cfg      func(c *CacheConfig)
+		expected bool // served from the cache
+	}{
+		{name: "Same request", expected: true},
+		{name: "Content hash", req: func(r *ExtractRequest) { r.ContentHashHex = "cd34" }},
+		{name: "User prompt", req: func(r *ExtractRequest) { r.OCRText += "\nTHANK YOU" }},
+		{name: "System prompt", req: func(r *ExtractRequest) { r.Profile.JobTitle = "Consultant" }},
+		{name: "Schema", req: func(r *ExtractRequest) { r.AllowedCategories = []string{"Office Supplies"} }},
+		{name: "Corrections", req: func(r *ExtractRequest) {
+			r.Corrections = []Correction{{PreviousJSON: "{}", Problem: "total is required"}}
+		}},
+		{name: "Model", cfg: func(c *CacheConfig) { c.Model = "gpt-4o" }},
+		{name: "Temperature", cfg: func(c *CacheConfig) { c.Temperature = 0.2 }},
+		{name: "Response format", cfg: func(c *CacheConfig) { c.ResponseFormat = PathJSONObject }},
+		{name: "Same resolved format", cfg: func(c *CacheConfig) { c.ResponseFormat = PathJSONSchema }, expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			next := &countingExtractor{}
+			c := cfg
+			c.Dir = dir
+			if _, err := NewCachingExtractor(next, c, nil).ExtractFields(context.Background(), cacheReq()); err != nil {
+				t.Fatalf("first call: %v", err)
+			}
+
+			req := cacheReq()
+			if tt.req != nil {
+				tt.req(&req)
+			}
+			if tt.cfg != nil {
+				tt.cfg(&c)
+			}
+			res, err := NewCachingExtractor(next, c, nil).ExtractFields(context.Background(), req)
+			if err != nil {
+				t.Fatalf("second call: %v", err)
+			}
+			if res.CacheHit != tt.expected {
+				t.Errorf("Expected cache hit %v, got %v", tt.expected, res.CacheHit)
+			}
+			if expectedCalls := map[bool]int{true: 1, false: 2}[tt.expected]; next.calls != expectedCalls {
+				t.Errorf("Expected %d calls, got %d", expectedCalls, next.calls)
+			}
+		})
+	}
+}
+
+func TestCachingExtractorHit(t *testing.T) {
+	next := &countingExtractor{}
+	ex := NewCachingExtractor(next, CacheConfig{Dir: t.TempDir(), Model: "gpt-4o-mini"}, nil)
+	if _, err := ex.ExtractFields(context.Background(), cacheReq()); err != nil {
+		t.Fatal(err)
+	}
+	res, err := ex.ExtractFields(context.Background(), cacheReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.CacheHit || res.Fields.Total != "42.18" || res.Model != "gpt-4o-mini" || string(res.Raw) != `{"merchant_name":"Staples"}` {
+		t.Errorf("Expected the stored response, got %+v", res)
+	}
+	if res.Usage != (Usage{}) {
+		t.Errorf("Expected no usage charged on a hit, got %+v", res.Usage)
+	}
+}
+
+func TestCachingExtractorTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		age      time.Duration
+		expected bool
+	}{
+		{name: "No TTL", ttl: 0, age: 365 * 24 * time.Hour, expected: true},
+		{name: "Fresh", ttl: time.Hour, age: time.Minute, expected: true},
+		{name: "Expired", ttl: time.Hour, age: 2 * time.Hour, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &countingExtractor{}
+			ex := NewCachingExtractor(next, CacheConfig{Dir: t.TempDir(), TTL: tt.ttl, Model: "gpt-4o-mini"}, nil).(*CachingExtractor)
+			if _, err := ex.ExtractFields(context.Background(), cacheReq()); err != nil {
+				t.Fatal(err)
+			}
+
+			// backdate the stored entry
+			key, err := ex.key(cacheReq())
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join(ex.cfg.Dir, key[:2], key+".json")
+			e, ok := ex.load(path)
+			if !ok {
+				t.Fatalf("Expected an entry at %s", path)
+			}
+			e.CreatedAt = time.Now().UTC().Add(-tt.age)
+			if err := ex.store(path, e); err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := ex.ExtractFields(context.Background(), cacheReq())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.CacheHit != tt.expected {
+				t.Errorf("Expected cache hit %v, got %v", tt.expected, res.CacheHit)
+			}
+		})
+	}
+}
+
+func TestCachingExtractorBypass(t *testing.T) {
+	dir := t.TempDir()
+	next := &countingExtractor{}
+	cfg := CacheConfig{Dir: dir, Model: "gpt-4o-mini"}
+	if _, err := NewCachingExtractor(next, cfg, nil).ExtractFields(context.Background(), cacheReq()); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Bypass = true
+	res, err := NewCachingExtractor(next, cfg, nil).ExtractFields(context.Background(), cacheReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.CacheHit || next.calls != 2 {
+		t.Errorf("Expected bypass to call through, got hit=%v calls=%d", res.CacheHit, next.calls)
+	}
+
+	// the bypassed call refreshed the entry, so a normal lookup still hits
+	cfg.Bypass = false
+	res, err = NewCachingExtractor(next, cfg, nil).ExtractFields(context.Background(), cacheReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.CacheHit || next.calls != 2 {
+		t.Errorf("Expected a hit after bypass, got hit=%v calls=%d", res.CacheHit, next.calls)
+	}
+}
+
+func TestNewCachingExtractorDisabled(t *testing.T) {
+	next := &countingExtractor{}
+	if ex := NewCachingExtractor(next, CacheConfig{}, nil); ex != FieldExtractor(next) {
+		t.Errorf("Expected the wrapped extractor when no dir is set, got %T", ex)
+	}
+}
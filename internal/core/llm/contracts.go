@@ -15,17 +15,19 @@ type FeeItem struct {
 
 // ReceiptFields is the normalized shape we want from the LLM.
 type ReceiptFields struct {
-	MerchantName    string  `json:"merchant_name"`
-	TxDate          string  `json:"tx_date"`               // YYYY-MM-DD
-	Subtotal        string  `json:"subtotal,omitempty"`    // decimal
-	Discount        string  `json:"discount,omitempty"`    // decimal NEGATIVE or POSITIVE total reduction
-	OtherFees       string  `json:"other_fees,omitempty"`  // decimal
-	Tax             string  `json:"tax,omitempty"`         // decimal
-	Total           string  `json:"total"`                 // decimal
-	CurrencyCode    string  `json:"currency_code"`         // ISO 4217
-	Category        string  `json:"category,omitempty"`    // must match AllowedCategories if provided
-	Description     string  `json:"description,omitempty"` // business need (tax-friendly)
-	ModelConfidence float32 `json:"confidence,omitempty"`  // optional (0..1)
+	MerchantName    string    `json:"merchant_name"`
+	TxDate          string    `json:"tx_date"`               // YYYY-MM-DD
+	Subtotal        string    `json:"subtotal,omitempty"`    // decimal
+	Discount        string    `json:"discount,omitempty"`    // decimal NEGATIVE or POSITIVE total reduction
+	OtherFees       string    `json:"other_fees,omitempty"`  // decimal; sum of Fees when itemized
+	Fees            []FeeItem `json:"fees,omitempty"`        // itemized non-tax, non-tip surcharges
+	Tip             string    `json:"tip,omitempty"`         // decimal
+	Tax             string    `json:"tax,omitempty"`         // decimal
+	Total           string    `json:"total"`                 // decimal
+	CurrencyCode    string    `json:"currency_code"`         // ISO 4217
+	Category        string    `json:"category,omitempty"`    // must match AllowedCategories if provided
+	Description     string    `json:"description,omitempty"` // business need (tax-friendly)
+	ModelConfidence float32   `json:"confidence,omitempty"`  // optional (0..1)
 }
 
 type ExtractRequest struct {
@@ -36,6 +38,12 @@ type ExtractRequest struct {
 	DefaultCurrency   string
 	Timezone          string
 
+	// CategoryDescriptions are the rubric entries for AllowedCategories, keyed by name.
+	// When nil the built-in descriptions are used.
+	CategoryDescriptions map[string]string
+	// Examples are reviewer corrections on similar past receipts, shown as few-shot hints.
+	Examples []CategoryExample
+
 	PrepConfidence float32
 	FilePath       string
 
@@ -49,9 +57,55 @@ type ExtractRequest struct {
 	VisionImagePaths []string
 
 	Profile ProfileContext
+
+	// Corrections are follow-up turns for a repair attempt: each replays a previous
+	// answer and tells the model what was wrong with it.
+	Corrections []Correction
+}
+
+// CategoryExample is a past receipt and the category a reviewer filed it under.
+type CategoryExample struct {
+	Merchant string
+	Items    string
+	Category string
+}
+
+// Correction is one rejected answer plus the problem found with it.
+type Correction struct {
+	PreviousJSON string `json:"previous_json"`
+	Problem      string `json:"problem"`
+}
+
+// ValidationError means the model answered but its JSON failed schema validation even
+// after repair. Callers may retry with a Correction.
+type ValidationError struct {
+	Err error
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Usage is the token accounting for one extraction. ImageTokens is an estimate and
+// is already included in PromptTokens (the API does not report it separately).
+type Usage struct {
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	ImageTokens      int     `json:"image_tokens"`
+	CostUSD          float64 `json:"cost_usd"`
+}
+
+// ExtractResult is what a FieldExtractor returns. Raw is the (possibly repaired) model
+// JSON and is populated on failure too, so callers can persist it for debugging.
+type ExtractResult struct {
+	Fields   ReceiptFields
+	Raw      []byte
+	Model    string
+	Usage    Usage
+	CacheHit bool   // served from the response cache; Usage is zero
+	Path     string // response path taken, e.g. "json_schema" or "json_object+normalized"
 }
 
 // FieldExtractor is the interface our pipeline depends on.
 type FieldExtractor interface {
-	ExtractFields(ctx context.Context, req ExtractRequest) (ReceiptFields, []byte /*rawJSON*/, error)
+	ExtractFields(ctx context.Context, req ExtractRequest) (ExtractResult, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -23,7 +24,7 @@ func isRetriable(status int, err error) bool {
 // ExtractFields implements llm.FieldExtractor using text-only chat/completions.
 // If PrepConfidence is low and FilePath is provided, we LOG that a vision path
 // would be preferable, but we DO NOT switch behavior yet (future step).
-func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm.ReceiptFields, []byte, error) {
+func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm.ExtractResult, error) {
 	reqID := uuid.New().String()
 	start := time.Now()
 
@@ -35,6 +36,7 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 		"has_file_path", req.FilePath != "",
 		"prep_confidence", req.PrepConfidence,
 		"allowed_categories", len(req.AllowedCategories),
+		"corrections", len(req.Corrections),
 		"default_currency", req.DefaultCurrency,
 		"timezone", req.Timezone,
 	)
@@ -49,7 +51,6 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 	user := llm.BuildUserPrompt(req, attached)
 
 	// 3) build messages for /chat/completions
-	//    - we keep the schema as a separate system message (your pattern)
 	var userContent any
 	if attached {
 		parts := []map[string]any{{"type": "text", "text": user}}
@@ -64,62 +65,29 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 		userContent = user
 	}
 
-	// GPT-5 family only supports temperature=1 (the default); omit the field
-	// entirely for those models so the API uses its default.
-	isGPT5 := strings.HasPrefix(c.cfg.Model, "gpt-5")
-	body := map[string]any{
-		"model":           c.cfg.Model,
-		"response_format": map[string]any{"type": "json_object"},
-		"messages": []map[string]any{
-			{"role": "system", "content": sys},
-			{"role": "system", "content": "JSON Schema:\n" + mustJSON(schema)},
-			{"role": "user", "content": userContent},
-		},
-	}
-	if !isGPT5 {
-		body["temperature"] = c.cfg.Temperature
+	// 4) POST: strict structured outputs when supported; if the API rejects the schema,
+	//    fall back to JSON mode with the schema as a system message.
+	path := llm.PathJSONObject
+	if c.useStructured() {
+		path = llm.PathJSONSchema
 	}
 	c.logger.Debug("openai request payload", "attached", attached, "vision_images", len(visionURLs), "ocr_conf", req.PrepConfidence,
-		"model", c.cfg.Model)
+		"model", c.cfg.Model, "response_format", path)
 
-	// 4) POST with retry
-	endpoint := strings.TrimRight(c.cfg.BaseURL, "/") + "/chat/completions"
-	headers := map[string]string{
-		"Authorization": "Bearer " + c.cfg.APIKey,
-		"Content-Type":  "application/json",
-	}
-
-	maxAttempts := c.cfg.MaxRetries + 1
-	var raw []byte
-	var status int
-	var httpErr error
-	for attempt := 0; attempt < maxAttempts; attempt++ {
-		if attempt > 0 {
-			backoff := time.Duration(1<<uint(attempt-1)) * time.Second // 1s, 2s, 4s, 8s …
-			if backoff > 30*time.Second {
-				backoff = 30 * time.Second
-			}
-			c.logger.Warn("llm extract retrying",
-				"req_id", reqID, "attempt", attempt+1, "max", maxAttempts,
-				"backoff_s", backoff.Seconds(), "last_status", status, "last_err", httpErr,
-			)
-			select {
-			case <-ctx.Done():
-				return llm.ReceiptFields{}, nil, ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
-		raw, status, httpErr = llm.SendJSON(ctx, c.http, endpoint, body, headers, c.logger)
-		if httpErr == nil || !isRetriable(status, httpErr) {
-			break
-		}
+	raw, status, httpErr := c.post(ctx, reqID, c.buildBody(path, sys, schema, req, userContent))
+	if httpErr != nil && status == http.StatusBadRequest && path == llm.PathJSONSchema && schemaRejected(raw) {
+		c.logger.Warn("llm structured output rejected; falling back to json_object",
+			"req_id", reqID, "model", c.cfg.Model, "error", httpErr,
+		)
+		path = llm.PathJSONObject
+		raw, status, httpErr = c.post(ctx, reqID, c.buildBody(path, sys, schema, req, userContent))
 	}
 	if httpErr != nil {
 		c.logger.Error("llm extract http_error",
 			"req_id", reqID, "status", status, "error", httpErr,
 			"elapsed_ms", time.Since(start).Milliseconds(),
 		)
-		return llm.ReceiptFields{}, nil, httpErr
+		return llm.ExtractResult{Model: c.cfg.Model, Path: path}, httpErr
 	}
 
 	// 5) decode response
@@ -129,23 +97,44 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 				Content string `json:"content"`
 			} `json:"message"`
 		} `json:"choices"`
+		Usage struct {
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+		} `json:"usage"`
 	}
 	if err := json.Unmarshal(raw, &cc); err != nil {
 		c.logger.Error("llm extract decode_error",
 			"req_id", reqID, "error", err, "raw_bytes", len(raw),
 			"elapsed_ms", time.Since(start).Milliseconds(),
 		)
-		return llm.ReceiptFields{}, raw, fmt.Errorf("decode openai response: %w", err)
+		return llm.ExtractResult{Raw: raw, Model: c.cfg.Model, Path: path}, fmt.Errorf("decode openai response: %w", err)
+	}
+
+	// Usage is billed whether or not the content turns out to be valid.
+	usage := llm.Usage{
+		PromptTokens:     cc.Usage.PromptTokens,
+		CompletionTokens: cc.Usage.CompletionTokens,
+		CostUSD:          c.cfg.Prices.Cost(c.cfg.Model, cc.Usage.PromptTokens, cc.Usage.CompletionTokens),
+	}
+	for _, u := range visionURLs {
+		usage.ImageTokens += llm.EstimateImageTokens(u)
+	}
+	result := func(raw []byte) llm.ExtractResult {
+		return llm.ExtractResult{Raw: raw, Model: c.cfg.Model, Usage: usage, Path: path}
 	}
 	if len(cc.Choices) == 0 {
 		c.logger.Error("llm extract no_choices",
 			"req_id", reqID, "raw", string(raw),
 			"elapsed_ms", time.Since(start).Milliseconds(),
 		)
-		return llm.ReceiptFields{}, raw, fmt.Errorf("no choices in openai response")
+		return result(raw), fmt.Errorf("no choices in openai response")
 	}
 	content := strings.TrimSpace(cc.Choices[0].Message.Content)
 	rawContent := []byte(content)
+	if path == llm.PathJSONSchema {
+		// strict mode emits null for unknown optional fields; the full schema expects them omitted
+		rawContent = llm.StripNulls(rawContent)
+	}
 
 	// 6) validate strictly → optional lenient sanitize → numeric normalization retry
 	if err := llm.ValidateJSONAgainstSchema(schema, rawContent); err != nil {
@@ -159,12 +148,13 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 					"fields", []string{"subtotal", "tax", "discount", "other_fees", "tip", "total"},
 				)
 				rawContent = normalized
+				path += "+" + llm.RepairNormalized
 			} else {
 				c.logger.Error("llm extract schema_validation_failed_after_normalize",
 					"req_id", reqID, "error", err2, "content", string(rawContent),
 					"elapsed_ms", time.Since(start).Milliseconds(),
 				)
-				return llm.ReceiptFields{}, rawContent, fmt.Errorf("schema validation failed after normalize: %w", err2)
+				return result(rawContent), &llm.ValidationError{Err: fmt.Errorf("schema validation failed after normalize: %w", err2)}
 			}
 		} else if c.cfg.LenientOptional {
 			// Second try: lenient sanitize for other validation errors
@@ -176,26 +166,27 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 						"elapsed_ms", time.Since(start).Milliseconds(),
 					)
 					rawContent = cleaned
+					path += "+" + llm.RepairSanitized
 				} else {
 					c.logger.Error("llm extract schema_validation_failed",
 						"req_id", reqID, "error", vErr, "content", string(rawContent),
 						"elapsed_ms", time.Since(start).Milliseconds(),
 					)
-					return llm.ReceiptFields{}, rawContent, fmt.Errorf("schema validation failed: %w", vErr)
+					return result(rawContent), &llm.ValidationError{Err: fmt.Errorf("schema validation failed: %w", vErr)}
 				}
 			} else {
 				c.logger.Error("llm extract sanitize_failed",
 					"req_id", reqID, "error", sErr,
 					"elapsed_ms", time.Since(start).Milliseconds(),
 				)
-				return llm.ReceiptFields{}, rawContent, fmt.Errorf("sanitize failed: %w", sErr)
+				return result(rawContent), &llm.ValidationError{Err: fmt.Errorf("sanitize failed: %w", sErr)}
 			}
 		} else {
 			c.logger.Error("llm extract schema_validation_failed",
 				"req_id", reqID, "error", err, "content", string(rawContent),
 				"elapsed_ms", time.Since(start).Milliseconds(),
 			)
-			return llm.ReceiptFields{}, rawContent, fmt.Errorf("schema validation failed: %w", err)
+			return result(rawContent), &llm.ValidationError{Err: fmt.Errorf("schema validation failed: %w", err)}
 		}
 	}
 
@@ -206,7 +197,7 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 			"req_id", reqID, "error", err,
 			"elapsed_ms", time.Since(start).Milliseconds(),
 		)
-		return llm.ReceiptFields{}, rawContent, fmt.Errorf("unmarshal fields: %w", err)
+		return result(rawContent), &llm.ValidationError{Err: fmt.Errorf("unmarshal fields: %w", err)}
 	}
 
 	c.logger.Info("llm extract successful",
@@ -216,9 +207,115 @@ func (c *Client) ExtractFields(ctx context.Context, req llm.ExtractRequest) (llm
 		"total", out.Total,
 		"currency", out.CurrencyCode,
 		"category", out.Category,
+		"prompt_tokens", usage.PromptTokens,
+		"completion_tokens", usage.CompletionTokens,
+		"cost_usd", usage.CostUSD,
+		"path", path,
 		"elapsed_ms", time.Since(start).Milliseconds(),
 	)
-	return out, rawContent, nil
+	res := result(rawContent)
+	res.Fields = out
+	return res, nil
+}
+
+// buildBody renders the chat/completions payload for the given response path.
+func (c *Client) buildBody(path, sys string, schema map[string]any, req llm.ExtractRequest, userContent any) map[string]any {
+	messages := []map[string]any{{"role": "system", "content": sys}}
+	var responseFormat map[string]any
+	if path == llm.PathJSONSchema {
+		responseFormat = map[string]any{
+			"type": "json_schema",
+			"json_schema": map[string]any{
+				"name":   "receipt_fields",
+				"strict": true,
+				"schema": llm.BuildStrictReceiptSchema(req.AllowedCategories),
+			},
+		}
+	} else {
+		// JSON mode does not take a schema, so send it as a separate system message
+		responseFormat = map[string]any{"type": "json_object"}
+		messages = append(messages, map[string]any{"role": "system", "content": "JSON Schema:\n" + mustJSON(schema)})
+	}
+	messages = append(messages, map[string]any{"role": "user", "content": userContent})
+	for _, corr := range req.Corrections {
+		messages = append(messages,
+			map[string]any{"role": "assistant", "content": corr.PreviousJSON},
+			map[string]any{"role": "user", "content": llm.BuildCorrectionPrompt(corr)},
+		)
+	}
+
+	body := map[string]any{
+		"model":           c.cfg.Model,
+		"response_format": responseFormat,
+		"messages":        messages,
+	}
+	// GPT-5 family only supports temperature=1 (the default); omit the field
+	// entirely for those models so the API uses its default.
+	if !strings.HasPrefix(c.cfg.Model, "gpt-5") {
+		body["temperature"] = c.cfg.Temperature
+	}
+	return body
+}
+
+// post sends body to /chat/completions, retrying transient failures with exponential backoff.
+func (c *Client) post(ctx context.Context, reqID string, body map[string]any) ([]byte, int, error) {
+	endpoint := strings.TrimRight(c.cfg.BaseURL, "/") + "/chat/completions"
+	headers := map[string]string{
+		"Authorization": "Bearer " + c.cfg.APIKey,
+		"Content-Type":  "application/json",
+	}
+
+	maxAttempts := c.cfg.MaxRetries + 1
+	var raw []byte
+	var status int
+	var httpErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(1<<uint(attempt-1)) * time.Second // 1s, 2s, 4s, 8s …
+			if backoff > 30*time.Second {
+				backoff = 30 * time.Second
+			}
+			c.logger.Warn("llm extract retrying",
+				"req_id", reqID, "attempt", attempt+1, "max", maxAttempts,
+				"backoff_s", backoff.Seconds(), "last_status", status, "last_err", httpErr,
+			)
+			select {
+			case <-ctx.Done():
+				return nil, status, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+		raw, status, httpErr = llm.SendJSON(ctx, c.http, endpoint, body, headers, c.logger)
+		if httpErr == nil || !isRetriable(status, httpErr) {
+			break
+		}
+	}
+	return raw, status, httpErr
+}
+
+// schemaRejected reports whether a 400 body says the model does not accept the strict
+// schema or response_format. Other 400s (context length, bad image) would fail the same
+// way in JSON mode, so they are not retried there.
+func schemaRejected(raw []byte) bool {
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+			Param   string `json:"param"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return false
+	}
+	if strings.HasPrefix(body.Error.Param, "response_format") {
+		return true
+	}
+	msg := strings.ToLower(body.Error.Message)
+	for _, k := range []string{"response_format", "json_schema", "structured output"} {
+		if strings.Contains(msg, k) {
+			return true
+		}
+	}
+	return false
 }
 
 func mustJSON(v any) string {
@@ -252,6 +349,15 @@ func normalizeMoneyFields(raw []byte) []byte {
 			}
 		}
 	}
+	if fees, ok := obj["fees"].([]any); ok {
+		for _, it := range fees {
+			if fm, ok := it.(map[string]any); ok {
+				if str, ok := fm["amount"].(string); ok && str != "" {
+					fm["amount"] = norm(str)
+				}
+			}
+		}
+	}
 	out, err := json.Marshal(obj)
 	if err != nil {
 		return raw
@@ -265,7 +371,7 @@ func isPatternFailureOnMoney(err error) bool {
 	if !strings.Contains(msg, "pattern") {
 		return false
 	}
-	for _, k := range []string{"/subtotal", "/tax", "/discount", "/other_fees", "/tip", "/total"} {
+	for _, k := range []string{"/subtotal", "/tax", "/discount", "/other_fees", "/fees", "/tip", "/total"} {
 		if strings.Contains(msg, k) {
 			return true
 		}
@@ -0,0 +1,137 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+)
+
+const receiptContent = `{"merchant_name":"Staples","tx_date":"2025-03-14","subtotal":"39.00","discount":null,"other_fees":null,"fees":null,"tip":null,"tax":"3.18","total":"42.18","currency_code":"USD","category":"Office Supplies","description":"printer paper","confidence":0.9}`
+
+// fakeAPI answers /chat/completions: with status and body while the request's
+// response_format type is in reject, else with receiptContent.
+type fakeAPI struct {
+	mu      sync.Mutex
+	formats []string // response_format type of each request
+	reject  map[string]bool
+	status  int
+	body    string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ResponseFormat struct {
+			Type string `json:"type"`
+		} `json:"response_format"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	f.mu.Lock()
+	f.formats = append(f.formats, req.ResponseFormat.Type)
+	f.mu.Unlock()
+
+	if f.reject[req.ResponseFormat.Type] {
+		w.WriteHeader(f.status)
+		_, _ = w.Write([]byte(f.body))
+		return
+	}
+	resp := map[string]any{
+		"choices": []any{map[string]any{"message": map[string]any{"content": receiptContent}}},
+		"usage":   map[string]any{"prompt_tokens": 1000, "completion_tokens": 100},
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestExtractFieldsResponsePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		api      *fakeAPI
+		formats  []string
+		expected string // path on the result
+		wantErr  bool
+	}{
+		{
+			name:     "Strict schema accepted",
+			api:      &fakeAPI{},
+			formats:  []string{"json_schema"},
+			expected: llm.PathJSONSchema,
+		},
+		{
+			name: "Schema rejected falls back to json_object",
+			api: &fakeAPI{reject: map[string]bool{"json_schema": true}, status: http.StatusBadRequest,
+				body: `{"error":{"message":"Invalid parameter: 'response_format' of type 'json_schema' is not supported with this model.","type":"invalid_request_error","param":"response_format","code":null}}`},
+			formats:  []string{"json_schema", "json_object"},
+			expected: llm.PathJSONObject + "+" + llm.RepairSanitized, // the nulls in the JSON-mode answer are sanitized
+		},
+		{
+			name: "Context length error is not resent",
+			api: &fakeAPI{reject: map[string]bool{"json_schema": true}, status: http.StatusBadRequest,
+				body: `{"error":{"message":"This model's maximum context length is 128000 tokens. However, your messages resulted in 131072 tokens.","type":"invalid_request_error","param":"messages","code":"context_length_exceeded"}}`},
+			formats:  []string{"json_schema"},
+			expected: llm.PathJSONSchema,
+			wantErr:  true,
+		},
+		{
+			name: "Bad image is not resent",
+			api: &fakeAPI{reject: map[string]bool{"json_schema": true}, status: http.StatusBadRequest,
+				body: `{"error":{"message":"You uploaded an unsupported image. Please make sure your image is valid.","type":"invalid_request_error","param":null,"code":"invalid_image_format"}}`},
+			formats:  []string{"json_schema"},
+			expected: llm.PathJSONSchema,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.api)
+			defer srv.Close()
+			c := NewClient(Config{APIKey: "test", BaseURL: srv.URL, Model: "gpt-4o-mini"}, nil)
+
+			res, err := c.ExtractFields(context.Background(), llm.ExtractRequest{
+				OCRText:           "STAPLES\nTOTAL 42.18",
+				AllowedCategories: []string{"Office Supplies", "Other"},
+				DefaultCurrency:   "USD",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if res.Path != tt.expected {
+				t.Errorf("Expected path %q, got %q", tt.expected, res.Path)
+			}
+			if len(tt.api.formats) != len(tt.formats) {
+				t.Fatalf("Expected requests %v, got %v", tt.formats, tt.api.formats)
+			}
+			for i := range tt.formats {
+				if tt.api.formats[i] != tt.formats[i] {
+					t.Errorf("Expected requests %v, got %v", tt.formats, tt.api.formats)
+				}
+			}
+			if !tt.wantErr {
+				if res.Fields.Total != "42.18" || res.Fields.Discount != "" {
+					t.Errorf("Expected total 42.18 and no discount, got %+v", res.Fields)
+				}
+				if res.Usage.PromptTokens != 1000 || res.Usage.CompletionTokens != 100 {
+					t.Errorf("Expected the reported usage, got %+v", res.Usage)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractFieldsJSONObjectModel(t *testing.T) {
+	api := &fakeAPI{}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c := NewClient(Config{APIKey: "test", BaseURL: srv.URL, Model: "gpt-4o-2024-05-13"}, nil)
+
+	res, err := c.ExtractFields(context.Background(), llm.ExtractRequest{OCRText: "STAPLES", AllowedCategories: []string{"Office Supplies"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Path != llm.PathJSONObject+"+"+llm.RepairSanitized || len(api.formats) != 1 || api.formats[0] != "json_object" {
+		t.Errorf("Expected a single json_object request, got path %q and requests %v", res.Path, api.formats)
+	}
+}
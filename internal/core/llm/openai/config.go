@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"os"
 	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 )
 
 // Config for the OpenAI client.
@@ -17,6 +19,8 @@ type Config struct {
 	MaxRetries      int           // total attempts = 1 + MaxRetries; default 5
 	LenientOptional bool
 	MaxVisionMB     int
+	Prices          llm.PriceTable // per-model prices for cost estimates; default llm.DefaultPrices
+	ResponseFormat  string         // "auto" (default: json_schema when the model supports it) | "json_schema" | "json_object"
 }
 
 type Client struct {
@@ -50,9 +54,20 @@ func NewClient(cfg Config, logger *slog.Logger) *Client {
 	if cfg.MaxVisionMB <= 0 {
 		cfg.MaxVisionMB = 10
 	}
+	if cfg.ResponseFormat == "" {
+		cfg.ResponseFormat = "auto"
+	}
+	if cfg.Prices == nil {
+		cfg.Prices = llm.DefaultPrices
+	}
 	return &Client{
 		cfg:    cfg,
 		http:   &http.Client{Timeout: cfg.Timeout},
 		logger: logger,
 	}
 }
+
+// useStructured decides between strict json_schema and json_object for this client.
+func (c *Client) useStructured() bool {
+	return llm.ResolveResponseFormat(c.cfg.ResponseFormat, c.cfg.Model) == llm.PathJSONSchema
+}
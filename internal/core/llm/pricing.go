@@ -0,0 +1,113 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"os"
+	"strings"
+)
+
+// Price is the list price of a model in USD per one million tokens.
+type Price struct {
+	InputPerMTok  float64 `json:"input_per_mtok"`
+	OutputPerMTok float64 `json:"output_per_mtok"`
+}
+
+// PriceTable maps a model name (or model-name prefix) to its price.
+type PriceTable map[string]Price
+
+// DefaultPrices are list prices at the time of writing; override with LoadPriceTable.
+var DefaultPrices = PriceTable{
+	"gpt-4o":       {InputPerMTok: 2.50, OutputPerMTok: 10.00},
+	"gpt-4o-mini":  {InputPerMTok: 0.15, OutputPerMTok: 0.60},
+	"gpt-4.1":      {InputPerMTok: 2.00, OutputPerMTok: 8.00},
+	"gpt-4.1-mini": {InputPerMTok: 0.40, OutputPerMTok: 1.60},
+	"gpt-4.1-nano": {InputPerMTok: 0.10, OutputPerMTok: 0.40},
+	"gpt-5":        {InputPerMTok: 1.25, OutputPerMTok: 10.00},
+	"gpt-5-mini":   {InputPerMTok: 0.25, OutputPerMTok: 2.00},
+	"gpt-5-nano":   {InputPerMTok: 0.05, OutputPerMTok: 0.40},
+}
+
+// LoadPriceTable reads a JSON object of model -> Price and merges it over DefaultPrices.
+// An empty path returns the defaults.
+func LoadPriceTable(path string) (PriceTable, error) {
+	out := make(PriceTable, len(DefaultPrices))
+	for k, v := range DefaultPrices {
+		out[k] = v
+	}
+	if strings.TrimSpace(path) == "" {
+		return out, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var overrides PriceTable
+	if err := json.Unmarshal(b, &overrides); err != nil {
+		return nil, fmt.Errorf("decode price table %s: %w", path, err)
+	}
+	for k, v := range overrides {
+		out[k] = v
+	}
+	return out, nil
+}
+
+// Lookup finds the price for model by exact name, then by the longest matching
+// prefix (so dated snapshots like "gpt-4o-2024-08-06" resolve to "gpt-4o").
+func (t PriceTable) Lookup(model string) (Price, bool) {
+	if p, ok := t[model]; ok {
+		return p, true
+	}
+	best := ""
+	for k := range t {
+		if strings.HasPrefix(model, k+"-") && len(k) > len(best) {
+			best = k
+		}
+	}
+	if best == "" {
+		return Price{}, false
+	}
+	return t[best], true
+}
+
+// Cost estimates the USD cost of a call; unknown models cost 0.
+func (t PriceTable) Cost(model string, promptTokens, completionTokens int) float64 {
+	p, ok := t.Lookup(model)
+	if !ok {
+		return 0
+	}
+	return (float64(promptTokens)*p.InputPerMTok + float64(completionTokens)*p.OutputPerMTok) / 1e6
+}
+
+// EstimateImageTokens applies OpenAI's published tiling rule for detail=auto/high:
+// fit within 2048x2048, scale the short side to 768, then 85 + 170 per 512px tile.
+// Returns 0 when the data URL cannot be decoded.
+func EstimateImageTokens(dataURL string) int {
+	i := strings.Index(dataURL, ";base64,")
+	if i < 0 {
+		return 0
+	}
+	b, err := base64.StdEncoding.DecodeString(dataURL[i+len(";base64,"):])
+	if err != nil {
+		return 0
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return 0
+	}
+	w, h := float64(cfg.Width), float64(cfg.Height)
+	if m := math.Max(w, h); m > 2048 {
+		w, h = w*2048/m, h*2048/m
+	}
+	if s := math.Min(w, h); s > 768 {
+		w, h = w*768/s, h*768/s
+	}
+	tiles := math.Ceil(w/512) * math.Ceil(h/512)
+	return 85 + 170*int(tiles)
+}
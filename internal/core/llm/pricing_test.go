@@ -0,0 +1,60 @@
+package llm
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPriceTableCost(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      string
+		prompt     int
+		completion int
+		expected   float64
+	}{
+		{name: "Exact model", model: "gpt-4o-mini", prompt: 1_000_000, completion: 1_000_000, expected: 0.75},
+		{name: "Dated snapshot", model: "gpt-4o-2024-08-06", prompt: 2000, completion: 500, expected: 0.01},
+		{name: "Longest prefix wins", model: "gpt-4.1-mini-2025-04-14", prompt: 1_000_000, completion: 0, expected: 0.40},
+		{name: "Unknown model", model: "claude-local", prompt: 5000, completion: 5000, expected: 0},
+		{name: "Prefix needs a dash", model: "gpt-4oo", prompt: 5000, completion: 5000, expected: 0},
+		{name: "No tokens", model: "gpt-4o", expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultPrices.Cost(tt.model, tt.prompt, tt.completion)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoadPriceTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	if err := os.WriteFile(path, []byte(`{"gpt-4o":{"input_per_mtok":5,"output_per_mtok":15},"local-model":{"input_per_mtok":0.01}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	table, err := LoadPriceTable(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := table.Lookup("gpt-4o"); p.InputPerMTok != 5 || p.OutputPerMTok != 15 {
+		t.Errorf("Expected the override for gpt-4o, got %+v", p)
+	}
+	if _, ok := table.Lookup("local-model"); !ok {
+		t.Error("Expected the added model to be priced")
+	}
+	if p, _ := table.Lookup("gpt-4o-mini"); p != DefaultPrices["gpt-4o-mini"] {
+		t.Errorf("Expected the default for gpt-4o-mini, got %+v", p)
+	}
+	if DefaultPrices["gpt-4o"].InputPerMTok != 2.50 {
+		t.Error("Expected LoadPriceTable to leave DefaultPrices unchanged")
+	}
+
+	if _, err := LoadPriceTable(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -2,6 +2,8 @@ package llm
 
 import (
 	"strings"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
 )
 
 // BuildSystemPrompt composes the system message with currency defaults, allowed categories,
@@ -15,7 +17,7 @@ func BuildSystemPrompt(req ExtractRequest) string {
 	} else {
 		catLine = "You MUST include a 'category' that is a short, sensible label. If uncertain, use 'Other'. "
 	}
-	rubric := buildCategoryRubric(req.AllowedCategories)
+	rubric := buildCategoryRubric(req.AllowedCategories, req.CategoryDescriptions)
 
 	// Currency fallback
 	defCur := strings.TrimSpace(req.DefaultCurrency)
@@ -64,6 +66,7 @@ func BuildSystemPrompt(req ExtractRequest) string {
 		// Numeric formatting and fee aggregation:
 		"For all numeric fields (subtotal, tax, discount, other_fees, tip, total), output plain digits with optional decimal point — no currency symbols, no commas, no spaces, no parentheses.",
 		"If multiple fee lines appear (e.g., Cleaning fee, Service fee, Resort/Booking/Host/Processing fees), sum them into 'other_fees' and output the sum as a single decimal string.",
+		"Also list each fee line in 'fees' as {\"name\", \"amount\"} using the label printed on the receipt; 'other_fees' must equal the sum of 'fees'.",
 
 		// Money rules: separate cost of goods from payment tender application.
 		"'total' represents the cost of goods/services purchased, NOT the amount charged to any single payment method.",
@@ -102,6 +105,23 @@ func BuildUserPrompt(req ExtractRequest, imageAttached bool) string {
 		b.WriteString("\n")
 	}
 
+	if len(req.Examples) > 0 {
+		b.WriteString("\nReviewer corrections on similar past receipts (use the same category when the merchant or items match):\n")
+		for _, ex := range req.Examples {
+			b.WriteString("- \"")
+			b.WriteString(ex.Merchant)
+			b.WriteString("\"")
+			if items := strings.TrimSpace(ex.Items); items != "" {
+				b.WriteString(" (items: ")
+				b.WriteString(items)
+				b.WriteString(")")
+			}
+			b.WriteString(" → ")
+			b.WriteString(ex.Category)
+			b.WriteString("\n")
+		}
+	}
+
 	// Only include OCR text when no image is attached (useful for non-vision runs).
 	if !imageAttached {
 		ocr := strings.TrimSpace(req.OCRText)
@@ -123,6 +143,17 @@ func BuildUserPrompt(req ExtractRequest, imageAttached bool) string {
 	return b.String()
 }
 
+// BuildCorrectionPrompt is the follow-up turn for a repair attempt. The previous answer is
+// replayed as an assistant message just before it.
+func BuildCorrectionPrompt(c Correction) string {
+	var b strings.Builder
+	b.WriteString("Your previous JSON was rejected:\n")
+	b.WriteString(strings.TrimSpace(c.Problem))
+	b.WriteString("\nRe-read the receipt and return the complete corrected JSON object only. ")
+	b.WriteString("Fix the reported fields; do not invent values to make numbers balance — if a component is not on the receipt, omit it.\n")
+	return b.String()
+}
+
 // BuildReceiptJSONSchema returns a JSON-Schema (draft 2020-12 subset) as a generic map.
 // We REQUIRE 'category' and ensure it is non-empty; when a taxonomy is provided,
 // category is restricted to the enum values.
@@ -133,6 +164,18 @@ func BuildReceiptJSONSchema(allowedCategories []string) map[string]any {
 		"subtotal":      decimalProp(),
 		"discount":      decimalProp(),
 		"other_fees":    decimalProp(),
+		"fees": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"name":   map[string]any{"type": "string", "minLength": 1},
+					"amount": decimalProp(),
+				},
+				"required": []string{"name", "amount"},
+			},
+		},
 		"tip":           decimalProp(),
 		"tax":           decimalProp(),
 		"total":         decimalProp(),
@@ -170,7 +213,7 @@ func decimalProp() map[string]any {
 
 // buildCategoryRubric emits short, high-precision rules only for categories present in the enum.
 // It includes tie-breakers to avoid oscillating between similar buckets.
-func buildCategoryRubric(allowed []string) string {
+func buildCategoryRubric(allowed []string, descriptions map[string]string) string {
 	if len(allowed) == 0 {
 		// generic rubric
 		return "Use item names to decide: consumables and stationery → 'Office Supplies'; " +
@@ -180,24 +223,18 @@ func buildCategoryRubric(allowed []string) string {
 			"transport/lodging → 'Travel Expenses'; otherwise → 'Other'. When torn between two, choose the narrower, more specific one; if still unsure, choose 'Other'."
 	}
 
-	// guidance for common categories — include only those present in the enum
-	defs := map[string]string{
-		"Office Supplies":          "Consumables & small accessories used up in daily work (pens, paper, cleaning wipes, duster, cables/chargers, batteries).",
-		"Office Equipment":         "Durable hardware/devices or peripherals (printers, monitors, keyboards, headsets, routers).",
-		"Home Office":              "Furniture/fixtures or workspace improvements for a home office (desk, chair, lamp, shelving). Not consumables.",
-		"Software Subscription":    "Recurring SaaS/apps/licenses (monthly/annual software fees).",
-		"Cell Phone Service":       "Mobile plan charges; physical accessories go to Supplies or Equipment.",
-		"Internet":                 "ISP service fees and modem/router rental; purchasing a router is Equipment.",
-		"Meals":                    "Food or drink purchases tied to business purposes.",
-		"Shipping Expenses":        "Postage, courier, shipping labels, packaging for shipments.",
-		"Professional Development": "Courses, training, certifications, conference fees (not travel/lodging/meals).",
-		"Travel Expenses":          "Transportation, lodging, baggage, tolls, parking, ride-share, airfare.",
-		"Other":                    "Use only when nothing else applies unambiguously.",
+	// guidance per category — include only those present in the enum
+	defs := descriptions
+	if defs == nil {
+		defs = make(map[string]string)
+		for _, d := range constants.DefaultCategoryDefs() {
+			defs[d.Name] = d.Description
+		}
 	}
 
 	var parts []string
 	for _, c := range allowed {
-		if d, ok := defs[c]; ok {
+		if d, ok := defs[c]; ok && strings.TrimSpace(d) != "" {
 			parts = append(parts, c+": "+d)
 		}
 	}
@@ -38,7 +38,9 @@ func NormalizeAndSanitizeJSON(raw []byte, logger *slog.Logger) ([]byte, []string
 	// 1) rename synonyms to your schema
 	renamed("shipping_fee", "other_fees")
 	renamed("shipping_fees", "other_fees")
-	renamed("fees", "other_fees")
+	if _, isList := m["fees"].([]any); !isList {
+		renamed("fees", "other_fees") // a scalar "fees" is the aggregate, not the itemized list
+	}
 
 	// 2) drop null / "" for optionals; coerce money fields to strings
 	moneyFields := []string{"subtotal", "tax", "total", "tip", "other_fees", "discount"}
@@ -71,11 +73,41 @@ func NormalizeAndSanitizeJSON(raw []byte, logger *slog.Logger) ([]byte, []string
 		coerceMoney(k)
 	}
 
+	// 3) itemized fees: coerce amounts, drop malformed lines
+	if list, ok := m["fees"].([]any); ok {
+		kept := make([]any, 0, len(list))
+		for _, it := range list {
+			fm, ok := it.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _ := fm["name"].(string)
+			name = strings.TrimSpace(name)
+			var amount string
+			switch a := fm["amount"].(type) {
+			case float64:
+				amount = fmt.Sprintf("%.2f", a)
+			case string:
+				amount = strings.TrimSpace(a)
+			}
+			if name == "" || amount == "" {
+				dropped = append(dropped, "fees[](invalid)")
+				continue
+			}
+			kept = append(kept, map[string]any{"name": name, "amount": amount})
+		}
+		if len(kept) == 0 {
+			delete(m, "fees")
+		} else {
+			m["fees"] = kept
+		}
+	}
+
 	// 4) remove unknown keys (everything not in the schema set below)
 	allowed := map[string]struct{}{
 		"merchant_name": {}, "tx_date": {}, "subtotal": {}, "tax": {}, "total": {},
 		"currency_code": {}, "category": {},
-		"description": {}, "tip": {}, "other_fees": {}, "discount": {},
+		"description": {}, "tip": {}, "other_fees": {}, "fees": {}, "discount": {},
 		"confidence": {}, // harmless if model added it; your validator can ignore or allow
 	}
 	for k := range maps.Clone(m) {
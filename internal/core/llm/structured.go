@@ -0,0 +1,148 @@
+package llm
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Response paths recorded on the job so we can tell how a result was obtained.
+// Repairs applied afterwards are appended with "+", e.g. "json_object+normalized".
+const (
+	PathJSONSchema   = "json_schema" // native structured outputs (strict)
+	PathJSONObject   = "json_object" // JSON mode; schema sent as a system message
+	RepairNormalized = "normalized"
+	RepairSanitized  = "sanitized"
+)
+
+// strictUnsupported are JSON Schema keywords that strict structured outputs reject.
+var strictUnsupported = []string{"minLength", "maxLength"}
+
+// BuildStrictReceiptSchema adapts BuildReceiptJSONSchema to the strict structured-output
+// subset: every property is listed in "required", optional ones become nullable, and
+// unsupported keywords are dropped. Dropped constraints are still enforced afterwards by
+// validating against the full schema (see StripNulls).
+func BuildStrictReceiptSchema(allowedCategories []string) map[string]any {
+	return toStrict(BuildReceiptJSONSchema(allowedCategories))
+}
+
+func toStrict(s map[string]any) map[string]any {
+	out := make(map[string]any, len(s))
+	for k, v := range s {
+		out[k] = v
+	}
+	for _, k := range strictUnsupported {
+		delete(out, k)
+	}
+	if items, ok := out["items"].(map[string]any); ok {
+		out["items"] = toStrict(items)
+	}
+	props, ok := out["properties"].(map[string]any)
+	if !ok {
+		return out
+	}
+	required := map[string]bool{}
+	if req, ok := out["required"].([]string); ok {
+		for _, r := range req {
+			required[r] = true
+		}
+	}
+	names := make([]string, 0, len(props))
+	strictProps := make(map[string]any, len(props))
+	for name, p := range props {
+		names = append(names, name)
+		pm, ok := p.(map[string]any)
+		if !ok {
+			strictProps[name] = p
+			continue
+		}
+		pm = toStrict(pm)
+		if !required[name] {
+			pm = nullable(pm)
+		}
+		strictProps[name] = pm
+	}
+	sort.Strings(names)
+	out["properties"] = strictProps
+	out["required"] = names
+	out["additionalProperties"] = false
+	return out
+}
+
+// nullable widens a property's type to also accept null.
+func nullable(p map[string]any) map[string]any {
+	switch t := p["type"].(type) {
+	case string:
+		p["type"] = []string{t, "null"}
+	case []string:
+		p["type"] = append(append([]string{}, t...), "null")
+	}
+	if enum, ok := p["enum"].([]string); ok {
+		vals := make([]any, 0, len(enum)+1)
+		for _, e := range enum {
+			vals = append(vals, e)
+		}
+		p["enum"] = append(vals, nil)
+	}
+	return p
+}
+
+// StripNulls removes top-level and nested object keys whose value is null, turning a
+// strict-mode response back into the "omit when unknown" shape the full schema expects.
+func StripNulls(raw []byte) []byte {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return raw
+	}
+	out, err := json.Marshal(stripNulls(v))
+	if err != nil {
+		return raw
+	}
+	return out
+}
+
+func stripNulls(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if val == nil {
+				delete(t, k)
+				continue
+			}
+			t[k] = stripNulls(val)
+		}
+	case []any:
+		for i := range t {
+			t[i] = stripNulls(t[i])
+		}
+	}
+	return v
+}
+
+// ResolveResponseFormat maps a configured response format ("auto", "json_schema" or
+// "json_object") to the path requested from the model: PathJSONSchema or PathJSONObject.
+func ResolveResponseFormat(format, model string) string {
+	switch format {
+	case PathJSONSchema, PathJSONObject:
+		return format
+	}
+	if SupportsStructuredOutputs(model) {
+		return PathJSONSchema
+	}
+	return PathJSONObject
+}
+
+// SupportsStructuredOutputs reports whether model accepts response_format json_schema
+// with strict=true. Unknown models fall back to JSON mode.
+func SupportsStructuredOutputs(model string) bool {
+	m := strings.ToLower(model)
+	if m == "gpt-4o-2024-05-13" {
+		return false // first gpt-4o snapshot predates structured outputs
+	}
+	for _, p := range []string{"gpt-4o", "gpt-4.1", "gpt-5", "o3", "o4"} {
+		if m == p || strings.HasPrefix(m, p+"-") {
+			return true
+		}
+	}
+	return false
+}
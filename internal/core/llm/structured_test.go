@@ -0,0 +1,86 @@
+package llm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildStrictReceiptSchema(t *testing.T) {
+	s := BuildStrictReceiptSchema([]string{"Meals", "Other"})
+	props := s["properties"].(map[string]any)
+
+	required := s["required"].([]string)
+	if len(required) != len(props) {
+		t.Errorf("Expected every property required, got %v", required)
+	}
+	if s["additionalProperties"] != false {
+		t.Error("Expected additionalProperties false")
+	}
+
+	total := props["total"].(map[string]any)
+	if total["type"] != "string" {
+		t.Errorf("Expected total to stay non-null, got %v", total["type"])
+	}
+	tip := props["tip"].(map[string]any)
+	if types, ok := tip["type"].([]string); !ok || len(types) != 2 || types[1] != "null" {
+		t.Errorf("Expected tip to be nullable, got %v", tip["type"])
+	}
+	if _, ok := props["merchant_name"].(map[string]any)["minLength"]; ok {
+		t.Error("Expected minLength to be dropped")
+	}
+	fee := props["fees"].(map[string]any)["items"].(map[string]any)
+	if fee["additionalProperties"] != false || len(fee["required"].([]string)) != 2 {
+		t.Errorf("Expected fee items to be strict, got %v", fee)
+	}
+
+	// the full schema is left untouched
+	full := BuildReceiptJSONSchema([]string{"Meals", "Other"})
+	if _, ok := full["properties"].(map[string]any)["merchant_name"].(map[string]any)["minLength"]; !ok {
+		t.Error("Expected the full schema to keep minLength")
+	}
+}
+
+func TestStripNulls(t *testing.T) {
+	raw := []byte(`{"merchant_name":"Staples","tip":null,"fees":[{"name":"Bag fee","amount":"0.10","note":null}],"total":"42.18"}`)
+	var got map[string]any
+	if err := json.Unmarshal(StripNulls(raw), &got); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got["tip"]; ok {
+		t.Error("Expected tip to be removed")
+	}
+	fee := got["fees"].([]any)[0].(map[string]any)
+	if _, ok := fee["note"]; ok || fee["amount"] != "0.10" {
+		t.Errorf("Expected nested nulls removed, got %v", fee)
+	}
+	if err := ValidateJSONAgainstSchema(BuildReceiptJSONSchema(nil), StripNulls([]byte(`{"merchant_name":"Staples","description":"","tx_date":"2025-03-14","total":"1.00","currency_code":"USD","category":"Meals","tip":null}`))); err != nil {
+		t.Errorf("Expected a stripped strict answer to pass the full schema, got %v", err)
+	}
+	if string(StripNulls([]byte("not json"))) != "not json" {
+		t.Error("Expected invalid JSON to be returned unchanged")
+	}
+}
+
+func TestSupportsStructuredOutputs(t *testing.T) {
+	tests := []struct {
+		model    string
+		expected bool
+	}{
+		{"gpt-4o-mini", true},
+		{"gpt-4o-2024-08-06", true},
+		{"gpt-4o-2024-05-13", false},
+		{"GPT-4.1", true},
+		{"gpt-5-nano", true},
+		{"o4-mini", true},
+		{"gpt-4-turbo", false},
+		{"gpt-3.5-turbo", false},
+		{"llama3", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			if got := SupportsStructuredOutputs(tt.model); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Package merchantname normalizes raw merchant names from receipts so the variants of one
+// vendor ("AMZN Mktp US", "Amazon.com", "Amazon Marketplace") resolve to the same merchant.
+package merchantname
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// MinScore is the similarity at or above which two names are the same merchant.
+const MinScore = 0.85
+
+// processorPrefix matches card-processor prefixes such as "SQ *" (Square) or "TST* " (Toast).
+var processorPrefix = regexp.MustCompile(`(?i)^\s*(sq|tst|sp|pp|paypal|ppl)\s*\*\s*`)
+
+// domainSuffix matches a trailing web domain such as ".com" in "Amazon.com".
+var domainSuffix = regexp.MustCompile(`(?i)\.(com|net|org|co|io|us)$`)
+
+// abbreviations are expanded before comparing.
+var abbreviations = map[string]string{
+	"amzn": "amazon",
+	"wm":   "walmart",
+	"wal":  "walmart",
+	"mcd":  "mcdonalds",
+	"intl": "international",
+	"svc":  "service",
+	"svcs": "services",
+}
+
+// noise words say nothing about which vendor it is.
+var noise = map[string]bool{
+	"the": true, "inc": true, "llc": true, "ltd": true, "co": true, "corp": true, "com": true,
+	"net": true, "org": true, "www": true, "us": true, "usa": true, "mktp": true, "mktplace": true,
+	"marketplace": true, "store": true, "stores": true, "online": true, "purchase": true,
+	"payment": true, "pmts": true, "retail": true,
+}
+
+// Clean trims a raw name for display: processor prefixes are dropped and whitespace collapsed.
+func Clean(raw string) string {
+	s := processorPrefix.ReplaceAllString(raw, "")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Canonical derives a display name for a new merchant from a raw receipt name:
+// abbreviations are spelled out, noise words, store numbers and domain suffixes are
+// dropped, and shouted words are title-cased ("AMZN Mktp US" -> "Amazon",
+// "STARBUCKS #123" -> "Starbucks"). Names that are only noise are returned cleaned.
+func Canonical(raw string) string {
+	var kept []string
+	for _, w := range strings.Fields(Clean(raw)) {
+		if i := strings.IndexByte(w, '/'); i > 0 {
+			w = w[:i]
+		}
+		w = domainSuffix.ReplaceAllString(w, "")
+		bare := strings.ToLower(strings.TrimFunc(w, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}))
+		if full, ok := abbreviations[bare]; ok {
+			kept = append(kept, strings.ToUpper(full[:1])+full[1:])
+			continue
+		}
+		if bare == "" || noise[bare] || isNumber(bare) {
+			continue
+		}
+		if len([]rune(w)) > 3 && strings.ToUpper(w) == w {
+			w = string([]rune(w)[:1]) + strings.ToLower(string([]rune(w)[1:]))
+		}
+		kept = append(kept, w)
+	}
+	if len(kept) == 0 {
+		return Clean(raw)
+	}
+	return strings.Join(kept, " ")
+}
+
+// Key is the comparison form of a name: lower-case words with abbreviations expanded and
+// noise words, store numbers and domain suffixes removed. Names that are only noise keep
+// all their words.
+func Key(raw string) string {
+	words := strings.FieldsFunc(strings.ToLower(Clean(raw)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	var kept, all []string
+	for _, w := range words {
+		w = strings.ReplaceAll(w, "'", "")
+		if w == "" {
+			continue
+		}
+		if full, ok := abbreviations[w]; ok {
+			w = full
+		}
+		all = append(all, w)
+		if noise[w] || isNumber(w) {
+			continue
+		}
+		kept = append(kept, w)
+	}
+	if len(kept) == 0 {
+		kept = all
+	}
+	return strings.Join(kept, " ")
+}
+
+// Similarity scores two keys from 0 to 1. Equal keys score 1; a key whose words all
+// appear in the other ("walmart" vs "walmart supercenter") scores 0.9; otherwise the
+// edit-distance ratio is used so small OCR slips ("starbuck" vs "starbucks") still match.
+func Similarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	if contains(a, b) || contains(b, a) {
+		return 0.9
+	}
+	longest := len([]rune(a))
+	if n := len([]rune(b)); n > longest {
+		longest = n
+	}
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+// Match returns the merchant whose name or aliases are most similar to raw, or nil when
+// none reaches MinScore.
+func Match(raw string, merchants []*entity.Merchant) (*entity.Merchant, float64) {
+	key := Key(raw)
+	var best *entity.Merchant
+	bestScore := 0.0
+	for _, m := range merchants {
+		for _, name := range append([]string{m.Name}, m.Aliases...) {
+			if s := Similarity(key, Key(name)); s > bestScore {
+				best, bestScore = m, s
+			}
+		}
+	}
+	if bestScore < MinScore {
+		return nil, bestScore
+	}
+	return best, bestScore
+}
+
+// HasName reports whether raw is already the merchant's name or one of its aliases,
+// ignoring case and surrounding whitespace.
+func HasName(m *entity.Merchant, raw string) bool {
+	raw = Clean(raw)
+	if strings.EqualFold(Clean(m.Name), raw) {
+		return true
+	}
+	for _, a := range m.Aliases {
+		if strings.EqualFold(Clean(a), raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether every word of short appears in long, and short has at least
+// one word long enough to be distinctive.
+func contains(short, long string) bool {
+	words := strings.Fields(short)
+	set := map[string]bool{}
+	for _, w := range strings.Fields(long) {
+		set[w] = true
+	}
+	distinctive := false
+	for _, w := range words {
+		if !set[w] {
+			return false
+		}
+		if len([]rune(w)) >= 4 {
+			distinctive = true
+		}
+	}
+	return distinctive
+}
+
+func isNumber(w string) bool {
+	for _, r := range w {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
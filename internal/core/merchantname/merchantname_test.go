@@ -0,0 +1,87 @@
+package merchantname
+
+import (
+	"testing"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "Marketplace abbreviation", raw: "AMZN Mktp US", expected: "amazon"},
+		{name: "Domain suffix", raw: "Amazon.com", expected: "amazon"},
+		{name: "Noise word", raw: "Amazon Marketplace", expected: "amazon"},
+		{name: "Processor prefix and store number", raw: "SQ *BLUE BOTTLE COFFEE #042", expected: "blue bottle coffee"},
+		{name: "Apostrophe", raw: "Trader Joe's", expected: "trader joes"},
+		{name: "Only noise keeps words", raw: "The Store", expected: "the store"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Key(tt.raw); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "Abbreviation and noise", raw: "AMZN Mktp US", expected: "Amazon"},
+		{name: "Domain and path", raw: "amzn.com/bill", expected: "Amazon"},
+		{name: "Shouted with store number", raw: "STARBUCKS #123", expected: "Starbucks"},
+		{name: "Processor prefix", raw: "SQ *BLUE BOTTLE COFFEE", expected: "Blue Bottle Coffee"},
+		{name: "Short acronym kept", raw: "CVS Pharmacy", expected: "CVS Pharmacy"},
+		{name: "Already clean", raw: "Trader Joe's", expected: "Trader Joe's"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Canonical(tt.raw); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	merchants := []*entity.Merchant{
+		{Name: "Amazon", Aliases: []string{"AMZN Mktp US"}},
+		{Name: "Starbucks"},
+		{Name: "Walmart"},
+		{Name: "Apple"},
+	}
+
+	tests := []struct {
+		name     string
+		raw      string
+		expected string // "" = no match
+	}{
+		{name: "Alias variant", raw: "Amazon.com", expected: "Amazon"},
+		{name: "OCR slip", raw: "STARBUCK", expected: "Starbucks"},
+		{name: "Extra words", raw: "Walmart Supercenter #5521", expected: "Walmart"},
+		{name: "Different vendor sharing a prefix", raw: "Applebee's", expected: ""},
+		{name: "Unknown vendor", raw: "Comcast", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := Match(tt.raw, merchants)
+			got := ""
+			if m != nil {
+				got = m.Name
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
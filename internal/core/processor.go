@@ -3,24 +3,34 @@ package core
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
-	"math"
 	"path/filepath"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/anomaly"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fewshot"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/ocr"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/rules"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
 	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
 )
 
+const (
+	maxCorrectionsScanned = 200 // most recent corrections considered per parse
+	maxCorrectionExamples = 3
+)
+
 // Processor coordinates OCR (text extract) then LLM parse (fields).
 type Processor struct {
 	logger           *slog.Logger
@@ -34,6 +44,71 @@ type Processor struct {
 	minConfidence    float32
 	artifactCacheDir string
 	visionDirect     bool // skip OCR; send files directly to LLM as vision input
+	maxRepairs       int  // self-correction retries after the first LLM call
+	fx               *fx.Converter
+	categoriesRepo   repository.CategoryRepository
+	rulesRepo        repository.CategoryRuleRepository
+	correctionsRepo  repository.CategoryCorrectionRepository
+	budgets          *budget.Checker
+	anomalies        *anomaly.Checker
+}
+
+// Option configures optional Processor behaviour.
+type Option func(*Processor)
+
+// WithMaxRepairAttempts bounds how many times a schema or arithmetic failure is sent
+// back to the model for correction (default 2; 0 disables the repair loop).
+func WithMaxRepairAttempts(n int) Option {
+	return func(p *Processor) {
+		if n >= 0 {
+			p.maxRepairs = n
+		}
+	}
+}
+
+// WithFXConverter enables converting each receipt total into the profile's default currency.
+func WithFXConverter(c *fx.Converter) Option {
+	return func(p *Processor) {
+		p.fx = c
+	}
+}
+
+// WithCategoryRepository makes parsing use each profile's own categories instead of the
+// built-in list.
+func WithCategoryRepository(r repository.CategoryRepository) Option {
+	return func(p *Processor) {
+		p.categoriesRepo = r
+	}
+}
+
+// WithRuleRepository applies each profile's categorization rules around the LLM call.
+func WithRuleRepository(r repository.CategoryRuleRepository) Option {
+	return func(p *Processor) {
+		p.rulesRepo = r
+	}
+}
+
+// WithCorrectionRepository shows the model past reviewer re-categorizations of similar
+// receipts as examples.
+func WithCorrectionRepository(r repository.CategoryCorrectionRepository) Option {
+	return func(p *Processor) {
+		p.correctionsRepo = r
+	}
+}
+
+// WithBudgetChecker checks the category's budgets after each receipt is stored.
+func WithBudgetChecker(c *budget.Checker) Option {
+	return func(p *Processor) {
+		p.budgets = c
+	}
+}
+
+// WithAnomalyChecker flags receipts whose total or date looks wrong against the profile's
+// history for review.
+func WithAnomalyChecker(c *anomaly.Checker) Option {
+	return func(p *Processor) {
+		p.anomalies = c
+	}
 }
 
 func NewProcessor(
@@ -48,6 +123,7 @@ func NewProcessor(
 	minConfidence float32,
 	artifactCacheDir string,
 	visionDirect bool,
+	opts ...Option,
 ) *Processor {
 	if logger == nil {
 		logger = slog.Default()
@@ -58,7 +134,7 @@ func NewProcessor(
 	if artifactCacheDir == "" {
 		artifactCacheDir = "./tmp"
 	}
-	return &Processor{
+	p := &Processor{
 		logger:           logger,
 		ocrExtractor:     ocrExtractor,
 		llmExtractor:     llmExtractor,
@@ -70,7 +146,12 @@ func NewProcessor(
 		minConfidence:    minConfidence,
 		artifactCacheDir: artifactCacheDir,
 		visionDirect:     visionDirect,
+		maxRepairs:       2,
+	}
+	for _, o := range opts {
+		o(p)
 	}
+	return p
 }
 
 // ProcessFile runs OCR for a fileID (creating/advancing extract_job),
@@ -199,7 +280,13 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 	if err != nil {
 		return job.ID, fmt.Errorf("load profile: %w", err)
 	}
-	allowed := constants.AsStringSlice()
+	categories := p.categoryDefs(ctx, prof.ID)
+	allowed := make([]string, 0, len(categories))
+	descriptions := make(map[string]string, len(categories))
+	for _, c := range categories {
+		allowed = append(allowed, c.Name)
+		descriptions[c.Name] = c.Description
+	}
 
 	// Build LLM request
 	req := llm.ExtractRequest{
@@ -218,7 +305,16 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 			JobTitle:       tools.StrOrEmpty(prof.JobTitle),
 			JobDescription: tools.StrOrEmpty(prof.JobDescription),
 		},
+		CategoryDescriptions: descriptions,
+	}
+
+	// Path-only rules decide the category up front; the model then only fills the other fields.
+	engine := p.ruleEngine(ctx, prof.ID)
+	if cat, rule, ok := engine.CategoryForPath(file.SourcePath); ok {
+		req.AllowedCategories = []string{cat}
+		p.logger.Info("category fixed by path rule before llm", "job_id", job.ID, "rule", rule, "category", cat)
 	}
+	req.Examples = p.correctionExamples(ctx, prof.ID, req)
 
 	// Vision-direct: for IMAGE files, attach as vision input instead of relying on OCR text.
 	// PDFs use pdftotext OCR text (deterministic); no vision rasterization for PDFs.
@@ -244,19 +340,39 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 		"ocr_bytes", len(*job.OcrText), "allowed_categories", len(allowed),
 	)
 
-	fields, raw, err := p.llmExtractor.ExtractFields(ctx, req)
+	res, attempts, err := p.extractWithRepair(ctx, job.ID, req, *job.OcrText)
+	outcome := repository.ParseOutcome{
+		Raw:       res.Raw,
+		ModelName: res.Model,
+		Usage:     res.Usage,
+		CacheHit:  res.CacheHit,
+		Attempts:  attempts,
+	}
 	if err != nil {
-		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, err.Error(), raw)
+		outcome.ErrorMessage = err.Error()
+		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, outcome)
 		return job.ID, fmt.Errorf("llm extract: %w", err)
 	}
+	fields := res.Fields
 
 	// sanitize item list
 	fields.Description = sanitizeDescription(fields.Description)
 
+	// If other_fees missing/zero but fee lines were itemized, use their sum
+	if parseDecimal(fields.OtherFees) == 0 && len(fields.Fees) > 0 {
+		var sum money.Amount
+		for _, fee := range fields.Fees {
+			sum += parseDecimal(fee.Amount)
+		}
+		if sum > 0 {
+			fields.OtherFees = sum.String()
+		}
+	}
+
 	// If other_fees missing/zero but OCR shows fee lines, aggregate them
 	if parseDecimal(fields.OtherFees) == 0 && strings.Contains(strings.ToLower(*job.OcrText), "fee") {
 		if fees, ok := aggregateFeesFromOCR(*job.OcrText); ok && fees > 0 {
-			fields.OtherFees = fmt.Sprintf("%.2f", fees)
+			fields.OtherFees = fees.String()
 			p.logger.Info("post LLM adjustment applied",
 				"stage", "post_llm_adjust",
 				"reason", "fees_aggregated",
@@ -268,21 +384,32 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 	// Reconcile totals deterministically
 	reconcileTotals(*job.OcrText, &fields, p.logger)
 
-	// Resolve category using canonical mapping
-	needsReview := false
-	canon, ok := constants.Canonicalize(fields.Category)
-	if !ok {
-		needsReview = true
+	// Resolve category against the profile's categories (names + synonyms)
+	canon, categoryKnown := constants.Match(fields.Category, categories)
+	if !categoryKnown {
 		p.logger.Warn("category unknown", "label", fields.Category)
-		canon = constants.Other
 	}
 
-	// Heuristic needs_review
-	if fields.MerchantName == "" || fields.TxDate == "" || fields.Total == "" {
-		needsReview = true
+	// Profile rules override the model deterministically
+	var total *money.Amount
+	if t, err := money.Parse(fields.Total); err == nil {
+		total = &t
 	}
-	if fields.ModelConfidence > 0 && fields.ModelConfidence < p.minConfidence {
-		needsReview = true
+	ruled := engine.Apply(rules.Input{
+		Merchant:    fields.MerchantName,
+		Description: fields.Description,
+		Path:        file.SourcePath,
+		Total:       total,
+	})
+	if ruled.Category != "" {
+		canon = ruled.Category
+		categoryKnown = true
+	}
+	if ruled.Description != "" {
+		fields.Description = ruled.Description
+	}
+	if len(ruled.Fired) > 0 {
+		p.logger.Info("category rules applied", "job_id", job.ID, "rules", ruled.Fired, "category", canon)
 	}
 
 	// Upsert receipt and link file
@@ -290,24 +417,85 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 		File:          file,
 		JobID:         job.ID,
 		ReceiptFields: fields,
-		CategoryName:  string(canon),
+		CategoryName:  canon,
+		CategoryKnown: categoryKnown,
+		Conversion:    p.convertTotal(ctx, job.ID, fields, prof.DefaultCurrency),
 	}
 	rec, err := p.receiptsRepo.UpsertFromFields(ctx, request)
 	if err != nil {
-		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, err.Error(), raw)
+		outcome.ErrorMessage = err.Error()
+		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, outcome)
 		return job.ID, fmt.Errorf("upsert receipt: %w", err)
 	}
+	if rec.CategoryName != canon {
+		p.logger.Info("merchant default category applied", "job_id", job.ID, "merchant_id", rec.MerchantID, "from", canon, "to", rec.CategoryName)
+		canon = rec.CategoryName
+		categoryKnown = true
+	}
+	// Budget alerts are best effort; a failure does not fail the parse.
+	if p.budgets != nil {
+		if err := p.budgets.Check(ctx, rec); err != nil {
+			p.logger.Error("budget check failed", "job_id", job.ID, "receipt_id", rec.ID, "error", err)
+		}
+	}
+
+	// Heuristic needs_review
+	needsReview := !categoryKnown
+	if fields.MerchantName == "" || fields.TxDate == "" || fields.Total == "" {
+		needsReview = true
+	}
+	if fields.ModelConfidence > 0 && fields.ModelConfidence < p.minConfidence {
+		needsReview = true
+	}
+	if ruled.ForceReview {
+		needsReview = true
+	}
+	// Amounts in three-decimal currencies were limited to two places by the schema.
+	inexact := !money.Exact(fields.CurrencyCode)
+	if inexact {
+		p.logger.Warn("currency has more decimal places than amounts keep", "job_id", job.ID, "currency", fields.CurrencyCode)
+		needsReview = true
+	}
+	// Outliers against the profile's history are usually extraction errors.
+	var anomalies []string
+	if p.anomalies != nil {
+		found, err := p.anomalies.Check(ctx, rec)
+		if err != nil {
+			p.logger.Error("anomaly check failed", "job_id", job.ID, "receipt_id", rec.ID, "error", err)
+		} else if len(found) > 0 {
+			needsReview = true
+			anomalies = anomaly.Reasons(found)
+		}
+	}
 	// Ensure job -> receipt link is set (idempotent)
 	if err := p.extractJobRepo.SetReceiptID(ctx, job.ID, rec.ID); err != nil {
-		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, fmt.Sprintf("link job->receipt: %v", err), raw)
+		outcome.ErrorMessage = fmt.Sprintf("link job->receipt: %v", err)
+		_ = p.jobsRepo.FinishParseFailure(ctx, job.ID, outcome)
 		return job.ID, err
 	}
 
 	// Persist parse success on job
-	if err := p.jobsRepo.FinishParseSuccess(ctx, job.ID, fields, needsReview, raw, map[string]any{
-		"model":      "openai", // your client sets more detail if you prefer
+	outcome.Fields = fields
+	outcome.NeedsReview = needsReview
+	outcome.ModelParams = map[string]any{
+		"model":      res.Model,
+		"cache_hit":  res.CacheHit,
+		"path":       res.Path,
+		"attempts":   len(attempts),
+		"rules":      ruled.Fired,
 		"updated_at": time.Now().UTC().Format(time.RFC3339),
-	}); err != nil {
+	}
+	if len(anomalies) > 0 {
+		outcome.ModelParams["anomalies"] = anomalies
+	}
+	if inexact {
+		outcome.ModelParams["inexact_currency"] = fields.CurrencyCode
+	}
+	// model_name and model_params are overwritten below; keep how the text was read.
+	if method := tools.OCRMethod(tools.ToExtractJob(job)); method != "" {
+		outcome.ModelParams["ocr_method"] = method
+	}
+	if err := p.jobsRepo.FinishParseSuccess(ctx, job.ID, outcome); err != nil {
 		return job.ID, err
 	}
 
@@ -315,12 +503,179 @@ func (p *Processor) runLLMParse(ctx context.Context, jobID uuid.UUID) (uuid.UUID
 		"job_id", job.ID, "receipt_id", rec.ID,
 		"merchant", fields.MerchantName,
 		"date", fields.TxDate, "total", fields.Total,
-		"category", string(canon), "needs_review", needsReview,
+		"category", canon, "needs_review", needsReview,
 		"confidence", fields.ModelConfidence,
+		"cost_usd", res.Usage.CostUSD, "cache_hit", res.CacheHit,
 	)
 	return job.ID, nil
 }
 
+// categoryDefs returns the profile's categories, or the built-in set when the profile has
+// none (or they cannot be loaded). "Other" is always included as the fallback.
+func (p *Processor) categoryDefs(ctx context.Context, profileID uuid.UUID) []constants.CategoryDef {
+	if p.categoriesRepo == nil {
+		return constants.DefaultCategoryDefs()
+	}
+	cats, err := p.categoriesRepo.ListByProfile(ctx, profileID)
+	if err != nil {
+		p.logger.Warn("load profile categories failed; using built-in list", "profile_id", profileID, "error", err)
+		return constants.DefaultCategoryDefs()
+	}
+	if len(cats) == 0 {
+		return constants.DefaultCategoryDefs()
+	}
+	defs := tools.ToCategoryDefs(cats)
+	for _, d := range defs {
+		if d.Name == string(constants.Other) {
+			return defs
+		}
+	}
+	for _, d := range constants.DefaultCategoryDefs() {
+		if d.Name == string(constants.Other) {
+			defs = append(defs, d)
+		}
+	}
+	return defs
+}
+
+// ruleEngine compiles the profile's enabled rules. Rules that cannot be loaded or compiled
+// are skipped (nil engine) rather than failing the parse.
+func (p *Processor) ruleEngine(ctx context.Context, profileID uuid.UUID) *rules.Engine {
+	if p.rulesRepo == nil {
+		return nil
+	}
+	list, err := p.rulesRepo.ListByProfile(ctx, profileID, true)
+	if err != nil {
+		p.logger.Warn("load category rules failed", "profile_id", profileID, "error", err)
+		return nil
+	}
+	engine, err := rules.Compile(list)
+	if err != nil {
+		p.logger.Warn("compile category rules failed", "profile_id", profileID, "error", err)
+		return nil
+	}
+	return engine
+}
+
+// correctionExamples picks past reviewer corrections that resemble this receipt. Failures
+// only cost the examples, never the parse.
+func (p *Processor) correctionExamples(ctx context.Context, profileID uuid.UUID, req llm.ExtractRequest) []llm.CategoryExample {
+	if p.correctionsRepo == nil {
+		return nil
+	}
+	list, err := p.correctionsRepo.ListRecent(ctx, profileID, maxCorrectionsScanned)
+	if err != nil {
+		p.logger.Warn("load category corrections failed", "profile_id", profileID, "error", err)
+		return nil
+	}
+	text := strings.Join([]string{req.OCRText, req.FilenameHint, req.FolderHint}, "\n")
+	examples := fewshot.Select(text, list, req.AllowedCategories, maxCorrectionExamples)
+	p.logger.Debug("correction examples selected", "profile_id", profileID, "scanned", len(list), "selected", len(examples))
+	return examples
+}
+
+// convertTotal converts the receipt total into the profile currency at the tx_date rate.
+// A missing rate is not fatal: the receipt is stored unconverted and can be re-processed
+// once rates are imported.
+func (p *Processor) convertTotal(ctx context.Context, jobID uuid.UUID, f llm.ReceiptFields, target string) *fx.Conversion {
+	if p.fx == nil || target == "" || f.CurrencyCode == "" {
+		return nil
+	}
+	total, err := money.Parse(f.Total)
+	if err != nil {
+		return nil
+	}
+	txDate, err := time.Parse("2006-01-02", f.TxDate)
+	if err != nil {
+		return nil
+	}
+	conv, err := p.fx.Convert(ctx, total, f.CurrencyCode, target, txDate)
+	if err != nil {
+		p.logger.Warn("fx conversion unavailable", "job_id", jobID, "from", f.CurrencyCode, "to", target, "tx_date", f.TxDate, "error", err)
+		return nil
+	}
+	if conv.Source != fx.SourceIdentity {
+		p.logger.Info("fx conversion applied", "job_id", jobID, "from", f.CurrencyCode, "to", target,
+			"rate", conv.Rate, "rate_date", conv.RateDate.Format("2006-01-02"), "source", conv.Source, "converted_total", conv.Amount.String())
+	}
+	return &conv
+}
+
+// extractWithRepair calls the LLM and, while the answer fails schema validation or its
+// components do not add up to the total, replays it with the problem as a follow-up turn.
+// At most p.maxRepairs corrections are attempted. A persisting arithmetic mismatch is not
+// an error: reconcileTotals handles it deterministically afterwards. The returned result
+// carries usage summed over all attempts.
+func (p *Processor) extractWithRepair(ctx context.Context, jobID uuid.UUID, req llm.ExtractRequest, ocrText string) (llm.ExtractResult, []repository.ParseAttempt, error) {
+	var attempts []repository.ParseAttempt
+	var usage llm.Usage
+	for i := 0; ; i++ {
+		res, err := p.llmExtractor.ExtractFields(ctx, req)
+		usage.PromptTokens += res.Usage.PromptTokens
+		usage.CompletionTokens += res.Usage.CompletionTokens
+		usage.ImageTokens += res.Usage.ImageTokens
+		usage.CostUSD += res.Usage.CostUSD
+
+		a := repository.ParseAttempt{
+			Attempt:  i + 1,
+			Path:     res.Path,
+			Raw:      string(res.Raw),
+			Usage:    res.Usage,
+			CacheHit: res.CacheHit,
+		}
+		var vErr *llm.ValidationError
+		problem := ""
+		switch {
+		case errors.As(err, &vErr):
+			a.Error = err.Error()
+			problem = vErr.Error()
+		case err != nil:
+			// transport/provider failure: nothing for the model to correct
+			a.Error = err.Error()
+		default:
+			problem = arithmeticProblem(ocrText, &res.Fields)
+		}
+		a.Problem = problem
+		attempts = append(attempts, a)
+		res.Usage = usage
+
+		transportErr := err != nil && vErr == nil
+		if problem == "" || transportErr || i >= p.maxRepairs || len(res.Raw) == 0 {
+			if problem != "" && err == nil {
+				p.logger.Warn("arithmetic mismatch persisted after repair attempts", "job_id", jobID, "attempts", len(attempts), "problem", problem)
+			}
+			return res, attempts, err
+		}
+		p.logger.Info("llm repair attempt",
+			"job_id", jobID, "attempt", i+2, "max", p.maxRepairs+1, "problem", problem,
+		)
+		req.Corrections = append(req.Corrections, llm.Correction{PreviousJSON: string(res.Raw), Problem: problem})
+	}
+}
+
+// arithmeticProblem describes a subtotal + tax + fees + tip − discount ≠ total mismatch in a form
+// the model can act on. Receipts paid partly by gift card or store credit are skipped:
+// their charged total legitimately differs and reconcileTotals handles them.
+func arithmeticProblem(ocrText string, f *llm.ReceiptFields) string {
+	arith, ok := computeArithmeticTotal(f)
+	if !ok || containsAnyLower(ocrText, tenderKeywords...) {
+		return ""
+	}
+	total := parseDecimal(f.Total)
+	if (arith - total).Abs() <= money.Amount(1) {
+		return ""
+	}
+	return fmt.Sprintf("Arithmetic mismatch: subtotal (%s) + tax (%s) + other_fees (%s) + tip (%s) - discount (%s) = %s, but total is %s.",
+		orZero(f.Subtotal), orZero(f.Tax), orZero(f.OtherFees), orZero(f.Tip), orZero(f.Discount), arith, orZero(f.Total))
+}
+
+func orZero(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "0"
+	}
+	return s
+}
+
 // Tender offset keywords for detecting payment offsets (gift cards, store credit, etc.).
 // Intentionally narrow — "payment" and "installment" are too generic and cause false
 // positives on any receipt that mentions a payment method.
@@ -332,7 +687,9 @@ var moneyRe = regexp.MustCompile(`(?i)[$\s]*(-?\d{1,3}(?:,\d{3})+(?:\.\d{1,2})?|
 
 var feeLineRe = regexp.MustCompile(`(?i)\b(Cleaning|Service|Resort|Booking|Host|Processing)\s+fee\b.*?([$(]?-?\s*\d[\d,]*(?:\.\d{1,2})?\)?)`)
 
-func parseDecimal(s string) float64 {
+// parseDecimal extracts the first amount from s (which may be an OCR line) exactly;
+// anything unparseable is 0.
+func parseDecimal(s string) money.Amount {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return 0
@@ -341,24 +698,20 @@ func parseDecimal(s string) float64 {
 	if len(m) < 2 {
 		return 0
 	}
-	clean := strings.ReplaceAll(m[1], ",", "")
-	v, err := strconv.ParseFloat(clean, 64)
-	if err != nil {
-		return 0
-	}
-	return v
+	return money.ParseOrZero(m[1])
 }
 
-func computeArithmeticTotal(f *llm.ReceiptFields) (float64, bool) {
+func computeArithmeticTotal(f *llm.ReceiptFields) (money.Amount, bool) {
 	if f == nil {
 		return 0, false
 	}
 	sub := parseDecimal(f.Subtotal)
 	tax := parseDecimal(f.Tax)
 	fees := parseDecimal(f.OtherFees)
-	disc := parseDecimal(f.Discount)
+	tip := parseDecimal(f.Tip)
+	disc := parseDecimal(f.Discount).Abs() // models emit discounts with either sign; always a reduction
 	known := 0
-	for _, v := range []float64{sub, tax, fees, disc} {
+	for _, v := range []money.Amount{sub, tax, fees, tip, disc} {
 		if v != 0 {
 			known++
 		}
@@ -366,12 +719,7 @@ func computeArithmeticTotal(f *llm.ReceiptFields) (float64, bool) {
 	if known == 0 {
 		return 0, false
 	}
-	total := sub + tax + fees - disc
-	// clamp tiny float noise
-	if math.Abs(total) < 0.005 {
-		total = 0
-	}
-	return total, true
+	return sub + tax + fees + tip - disc, true
 }
 
 func containsAnyLower(s string, needles ...string) bool {
@@ -384,8 +732,8 @@ func containsAnyLower(s string, needles ...string) bool {
 	return false
 }
 
-func aggregateFeesFromOCR(ocr string) (float64, bool) {
-	var sum float64
+func aggregateFeesFromOCR(ocr string) (money.Amount, bool) {
+	var sum money.Amount
 	var found bool
 	for _, m := range feeLineRe.FindAllStringSubmatch(ocr, -1) {
 		amt := parseDecimal(m[2])
@@ -394,8 +742,7 @@ func aggregateFeesFromOCR(ocr string) (float64, bool) {
 			found = true
 		}
 	}
-	// clamp tiny noise
-	if math.Abs(sum) < 0.005 {
+	if sum == 0 {
 		return 0, false
 	}
 	return sum, found
@@ -414,7 +761,7 @@ func reconcileTotals(ocrText string, f *llm.ReceiptFields, log *slog.Logger) {
 	// Only override when arithmetic > model: the LLM under-reported total (e.g. anchored
 	// on a $0 gift-card-reduced charge). When arith < model the LLM likely read the true
 	// total correctly but mis-extracted a component — trust the model total in that case.
-	if ok && arith > model+0.01 {
+	if ok && arith > model+money.Amount(1) {
 		shouldOverride = true
 		reason = "component_mismatch"
 	}
@@ -427,7 +774,7 @@ func reconcileTotals(ocrText string, f *llm.ReceiptFields, log *slog.Logger) {
 	}
 
 	if shouldOverride && arith > 0 {
-		f.Total = fmt.Sprintf("%.2f", arith)
+		f.Total = arith.String()
 		if f.CurrencyCode == "" {
 			f.CurrencyCode = "USD"
 		}
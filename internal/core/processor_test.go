@@ -0,0 +1,255 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+// step is one scripted answer of a fakeExtractor.
+type step struct {
+	res llm.ExtractResult
+	err error
+}
+
+// fakeExtractor answers with its steps in order and keeps the requests it was sent.
+type fakeExtractor struct {
+	steps []step
+	reqs  []llm.ExtractRequest
+}
+
+func (f *fakeExtractor) ExtractFields(_ context.Context, req llm.ExtractRequest) (llm.ExtractResult, error) {
+	f.reqs = append(f.reqs, req)
+	s := f.steps[len(f.reqs)-1]
+	return s.res, s.err
+}
+
+func TestExtractWithRepairRecordsPath(t *testing.T) {
+	ex := &fakeExtractor{steps: []step{{res: llm.ExtractResult{
+		Fields: llm.ReceiptFields{Subtotal: "39.00", Tax: "3.18", Total: "42.18"},
+		Raw:    []byte(`{"total":"42.18"}`),
+		Path:   llm.PathJSONObject + "+" + llm.RepairSanitized,
+		Usage:  llm.Usage{PromptTokens: 900, CompletionTokens: 80},
+	}}}}
+	p := &Processor{logger: slog.Default(), llmExtractor: ex, maxRepairs: 2}
+
+	res, attempts, err := p.extractWithRepair(context.Background(), uuid.New(), llm.ExtractRequest{}, "TOTAL 42.18")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || attempts[0].Attempt != 1 || attempts[0].Path != "json_object+sanitized" || attempts[0].Problem != "" {
+		t.Fatalf("Expected one clean attempt on json_object+sanitized, got %+v", attempts)
+	}
+	if res.Path != "json_object+sanitized" || attempts[0].Usage.PromptTokens != 900 {
+		t.Errorf("Expected the path and usage of the call, got %q and %+v", res.Path, attempts[0].Usage)
+	}
+}
+
+func TestExtractWithRepair(t *testing.T) {
+	good := llm.ExtractResult{
+		Fields: llm.ReceiptFields{Subtotal: "39.00", Tax: "3.18", Total: "42.18"},
+		Raw:    []byte(`{"subtotal":"39.00","tax":"3.18","total":"42.18"}`),
+		Path:   llm.PathJSONSchema,
+		Usage:  llm.Usage{PromptTokens: 1000, CompletionTokens: 100, CostUSD: 0.01},
+	}
+	mismatch := llm.ExtractResult{
+		Fields: llm.ReceiptFields{Subtotal: "39.00", Tax: "3.18", Total: "24.18"},
+		Raw:    []byte(`{"subtotal":"39.00","tax":"3.18","total":"24.18"}`),
+		Path:   llm.PathJSONSchema,
+		Usage:  llm.Usage{PromptTokens: 1000, CompletionTokens: 100, CostUSD: 0.01},
+	}
+	invalid := llm.ExtractResult{
+		Raw:   []byte(`{"total":"forty two"}`),
+		Path:  llm.PathJSONObject,
+		Usage: llm.Usage{PromptTokens: 1000, CompletionTokens: 100, CostUSD: 0.01},
+	}
+	invalidErr := &llm.ValidationError{Err: errors.New("/total does not match pattern")}
+
+	tests := []struct {
+		name       string
+		maxRepairs int
+		ocr        string
+		steps      []step
+		expected   []string // problem recorded on each attempt
+		wantErr    bool
+	}{
+		{
+			name:       "First answer is accepted",
+			maxRepairs: 2,
+			steps:      []step{{res: good}},
+			expected:   []string{""},
+		},
+		{
+			name:       "Arithmetic mismatch is corrected",
+			maxRepairs: 2,
+			steps:      []step{{res: mismatch}, {res: good}},
+			expected:   []string{mismatchProblem, ""},
+		},
+		{
+			name:       "Schema failure is corrected",
+			maxRepairs: 2,
+			steps:      []step{{res: invalid, err: invalidErr}, {res: good}},
+			expected:   []string{"/total does not match pattern", ""},
+		},
+		{
+			name:       "Persisting mismatch stops after the bound",
+			maxRepairs: 2,
+			steps:      []step{{res: mismatch}, {res: mismatch}, {res: mismatch}},
+			expected:   []string{mismatchProblem, mismatchProblem, mismatchProblem},
+		},
+		{
+			name:       "Persisting schema failure is an error",
+			maxRepairs: 1,
+			steps:      []step{{res: invalid, err: invalidErr}, {res: invalid, err: invalidErr}},
+			expected:   []string{"/total does not match pattern", "/total does not match pattern"},
+			wantErr:    true,
+		},
+		{
+			name:       "Repairs disabled",
+			maxRepairs: 0,
+			steps:      []step{{res: mismatch}},
+			expected:   []string{mismatchProblem},
+		},
+		{
+			name:       "Transport failure is not repaired",
+			maxRepairs: 2,
+			steps:      []step{{res: llm.ExtractResult{Path: llm.PathJSONSchema}, err: errors.New("non-2xx status: 500")}},
+			expected:   []string{""},
+			wantErr:    true,
+		},
+		{
+			name:       "Gift card receipts are not arithmetic problems",
+			maxRepairs: 2,
+			ocr:        "SUBTOTAL 39.00\nTAX 3.18\nGIFT CARD -18.00\nTOTAL 24.18",
+			steps:      []step{{res: mismatch}},
+			expected:   []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex := &fakeExtractor{steps: tt.steps}
+			p := &Processor{logger: slog.Default(), llmExtractor: ex, maxRepairs: tt.maxRepairs}
+
+			res, attempts, err := p.extractWithRepair(context.Background(), uuid.New(), llm.ExtractRequest{OCRText: tt.ocr}, tt.ocr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if len(ex.reqs) != len(tt.expected) || len(attempts) != len(tt.expected) {
+				t.Fatalf("Expected %d calls and attempts, got %d and %d", len(tt.expected), len(ex.reqs), len(attempts))
+			}
+			for i, a := range attempts {
+				if a.Attempt != i+1 || a.Problem != tt.expected[i] || a.Path != tt.steps[i].res.Path || a.Raw != string(tt.steps[i].res.Raw) {
+					t.Errorf("Attempt %d: expected problem %q on %q, got %+v", i+1, tt.expected[i], tt.steps[i].res.Path, a)
+				}
+				if (a.Error != "") != (tt.steps[i].err != nil) {
+					t.Errorf("Attempt %d: expected error %v, got %q", i+1, tt.steps[i].err, a.Error)
+				}
+			}
+
+			// each follow-up turn replays the previous answers with their problems
+			for i, req := range ex.reqs {
+				if len(req.Corrections) != i {
+					t.Fatalf("Call %d: expected %d corrections, got %d", i+1, i, len(req.Corrections))
+				}
+				for j, c := range req.Corrections {
+					if c.PreviousJSON != string(tt.steps[j].res.Raw) || c.Problem != tt.expected[j] {
+						t.Errorf("Call %d correction %d: expected %q for %s, got %+v", i+1, j+1, tt.expected[j], tt.steps[j].res.Raw, c)
+					}
+				}
+			}
+
+			var usage llm.Usage
+			for _, s := range tt.steps {
+				usage.PromptTokens += s.res.Usage.PromptTokens
+				usage.CompletionTokens += s.res.Usage.CompletionTokens
+				usage.CostUSD += s.res.Usage.CostUSD
+			}
+			if res.Usage.PromptTokens != usage.PromptTokens || res.Usage.CompletionTokens != usage.CompletionTokens {
+				t.Errorf("Expected usage summed over attempts %+v, got %+v", usage, res.Usage)
+			}
+		})
+	}
+}
+
+const mismatchProblem = "Arithmetic mismatch: subtotal (39.00) + tax (3.18) + other_fees (0) + tip (0) - discount (0) = 42.18, but total is 24.18."
+
+func TestComputeArithmeticTotal(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   llm.ReceiptFields
+		expected string
+		ok       bool
+	}{
+		{name: "Components", fields: llm.ReceiptFields{Subtotal: "39.00", Tax: "3.18", OtherFees: "2.00", Tip: "5.00"}, expected: "49.18", ok: true},
+		{name: "Positive discount", fields: llm.ReceiptFields{Subtotal: "40.00", Discount: "5.00"}, expected: "35.00", ok: true},
+		{name: "Negative discount also reduces", fields: llm.ReceiptFields{Subtotal: "40.00", Discount: "-5.00"}, expected: "35.00", ok: true},
+		{name: "Nothing known", fields: llm.ReceiptFields{Total: "42.18"}, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := computeArithmeticTotal(&tt.fields)
+			if ok != tt.ok || (ok && got.String() != tt.expected) {
+				t.Errorf("Expected %s (%v), got %s (%v)", tt.expected, tt.ok, got, ok)
+			}
+		})
+	}
+}
+
+// fakeCategories is a CategoryRepository that only lists.
+type fakeCategories struct {
+	repository.CategoryRepository
+	list []*entity.Category
+	err  error
+}
+
+func (f *fakeCategories) ListByProfile(context.Context, uuid.UUID) ([]*entity.Category, error) {
+	return f.list, f.err
+}
+
+func TestCategoryDefs(t *testing.T) {
+	builtIn := len(constants.DefaultCategoryDefs())
+	rent := &entity.Category{Name: "Rent", Synonyms: []string{"coworking"}}
+	other := &entity.Category{Name: "Other"}
+	tests := []struct {
+		name     string
+		repo     repository.CategoryRepository
+		expected []string // nil = the built-in list
+	}{
+		{name: "No repository", repo: nil},
+		{name: "Load fails", repo: &fakeCategories{err: errors.New("connection refused")}},
+		{name: "No categories yet", repo: &fakeCategories{}},
+		{name: "Profile categories get Other", repo: &fakeCategories{list: []*entity.Category{rent}}, expected: []string{"Rent", "Other"}},
+		{name: "Profile Other kept", repo: &fakeCategories{list: []*entity.Category{other, rent}}, expected: []string{"Other", "Rent"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Processor{logger: slog.Default(), categoriesRepo: tt.repo}
+			defs := p.categoryDefs(context.Background(), uuid.New())
+			if tt.expected == nil {
+				if len(defs) != builtIn {
+					t.Errorf("Expected the %d built-in categories, got %d", builtIn, len(defs))
+				}
+				return
+			}
+			var names []string
+			for _, d := range defs {
+				names = append(names, d.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected %v, got %v", tt.expected, names)
+			}
+			if got, ok := constants.Match("Coworking", defs); got != "Rent" || !ok {
+				t.Errorf("Expected the profile synonym to match Rent, got %q (%v)", got, ok)
+			}
+		})
+	}
+}
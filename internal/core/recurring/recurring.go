@@ -0,0 +1,328 @@
+// Package recurring finds recurring expenses (subscriptions, phone and internet bills) in a
+// profile's receipts and flags the periods with no receipt and the price changes.
+package recurring
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Defaults for Options.
+const (
+	DefaultMinOccurrences       = 3
+	DefaultPriceChangeThreshold = 0.05
+	// MaxTrailingMisses is how many periods may pass without a receipt before a series is
+	// considered ended rather than missing receipts.
+	MaxTrailingMisses = 2
+)
+
+// Cadence is how often a recurring expense is charged.
+type Cadence string
+
+const (
+	Weekly    Cadence = "weekly"
+	Monthly   Cadence = "monthly"
+	Quarterly Cadence = "quarterly"
+	Yearly    Cadence = "yearly"
+)
+
+type cadenceDef struct {
+	cadence Cadence
+	days    float64 // average period length
+	grace   int     // days a receipt may come late before its period counts as missing
+	// step is the expected date n periods after the series' first receipt
+	step func(anchor time.Time, n int) time.Time
+}
+
+var cadences = []cadenceDef{
+	{Weekly, 7, 3, func(t time.Time, n int) time.Time { return t.AddDate(0, 0, 7*n) }},
+	{Monthly, 30.44, 7, func(t time.Time, n int) time.Time { return addMonths(t, n) }},
+	{Quarterly, 91.31, 14, func(t time.Time, n int) time.Time { return addMonths(t, 3*n) }},
+	{Yearly, 365.25, 30, func(t time.Time, n int) time.Time { return addMonths(t, 12*n) }},
+}
+
+// addMonths moves t by n whole months, keeping its day of the month or, in shorter
+// months, using the last day: Jan 31 steps to Feb 28, then Mar 31. time.AddDate would
+// roll Jan 31 + 1 month over to Mar 3.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+// Period labels the period of t: the date for weekly series, else "2025-07", "2025-Q3"
+// or "2025".
+func (c Cadence) Period(t time.Time) string {
+	switch c {
+	case Monthly:
+		return t.Format("2006-01")
+	case Quarterly:
+		return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())+2)/3)
+	case Yearly:
+		return t.Format("2006")
+	}
+	return t.Format("2006-01-02")
+}
+
+// Options tune detection.
+type Options struct {
+	// AsOf is the day the analysis is run for; periods expected after it are not missing.
+	// Zero means the date of the latest receipt.
+	AsOf time.Time
+	// MinOccurrences is the number of periods with a receipt needed to call a series
+	// recurring (yearly series need two). 0 means DefaultMinOccurrences.
+	MinOccurrences int
+	// PriceChangeThreshold is the relative change between periods reported as a price
+	// change. 0 means DefaultPriceChangeThreshold.
+	PriceChangeThreshold float64
+}
+
+// PriceChange is a period whose amount differs from the one before.
+type PriceChange struct {
+	ReceiptID uuid.UUID
+	Date      time.Time
+	From, To  money.Amount
+}
+
+// Series is a recurring expense at one merchant.
+type Series struct {
+	MerchantID    *uuid.UUID // nil for receipts not in the merchant directory
+	Merchant      string
+	Category      string // of the latest receipt
+	Cadence       Cadence
+	Currency      string
+	TypicalAmount money.Amount // median per period
+	LastAmount    money.Amount
+	Receipts      []*entity.Receipt // oldest first
+	// NextExpected is when the next receipt is due; zero when the series has ended.
+	NextExpected time.Time
+	// Missing are the expected dates of periods with no receipt.
+	Missing      []time.Time
+	PriceChanges []PriceChange
+	// Ended is set when more than MaxTrailingMisses periods passed since the last receipt.
+	Ended bool
+}
+
+// Detect groups receipts by merchant (the merchant directory entry, else the normalized
+// name) and returns the groups charged on a regular cadence at a similar amount, ordered
+// by merchant. Receipts in a currency other than a merchant's usual one are ignored.
+func Detect(recs []*entity.Receipt, opts Options) []Series {
+	if opts.MinOccurrences <= 0 {
+		opts.MinOccurrences = DefaultMinOccurrences
+	}
+	if opts.PriceChangeThreshold <= 0 {
+		opts.PriceChangeThreshold = DefaultPriceChangeThreshold
+	}
+	if opts.AsOf.IsZero() {
+		for _, r := range recs {
+			if r.TxDate.After(opts.AsOf) {
+				opts.AsOf = r.TxDate
+			}
+		}
+	}
+
+	groups := map[string][]*entity.Receipt{}
+	var keys []string
+	for _, r := range recs {
+		key := "name:" + merchantname.Key(r.MerchantName)
+		if r.MerchantID != nil {
+			key = "id:" + r.MerchantID.String()
+		} else if key == "name:" {
+			continue
+		}
+		if groups[key] == nil {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+
+	var out []Series
+	for _, key := range keys {
+		if s, ok := detectSeries(groups[key], opts); ok {
+			out = append(out, s)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Merchant < out[j].Merchant })
+	return out
+}
+
+// detectSeries checks one merchant's receipts for a cadence.
+func detectSeries(group []*entity.Receipt, opts Options) (Series, bool) {
+	currency := usualCurrency(group)
+	var recs []*entity.Receipt
+	for _, r := range group {
+		if r.CurrencyCode == currency {
+			recs = append(recs, r)
+		}
+	}
+	sort.SliceStable(recs, func(i, j int) bool { return recs[i].TxDate.Before(recs[j].TxDate) })
+	if len(recs) < 2 {
+		return Series{}, false
+	}
+
+	var gaps []float64
+	for i := 1; i < len(recs); i++ {
+		gaps = append(gaps, days(recs[i-1].TxDate, recs[i].TxDate))
+	}
+	def, ok := pickCadence(gaps)
+	if !ok {
+		return Series{}, false
+	}
+
+	// A period may hold several receipts (a charge and a correction); the first counts.
+	slots := []*entity.Receipt{recs[0]}
+	var slotGaps []int // periods between consecutive slots
+	regular := 0
+	for i := 1; i < len(recs); i++ {
+		gap := days(slots[len(slots)-1].TxDate, recs[i].TxDate)
+		k := int(math.Round(gap / def.days))
+		if k == 0 {
+			continue
+		}
+		if math.Abs(gap-float64(k)*def.days) <= float64(def.grace*k) {
+			regular++
+		}
+		slots = append(slots, recs[i])
+		slotGaps = append(slotGaps, k)
+	}
+	minOccurrences := opts.MinOccurrences
+	if def.cadence == Yearly {
+		minOccurrences = min(minOccurrences, 2)
+	}
+	if len(slots) < minOccurrences || float64(regular) < 0.75*float64(len(slotGaps)) {
+		return Series{}, false
+	}
+
+	amounts := make([]money.Amount, len(slots))
+	for i, r := range slots {
+		amounts[i] = r.Total
+	}
+	typical := median(amounts)
+	similar := 0
+	for _, a := range amounts {
+		if relDiff(a, typical) <= 0.25 {
+			similar++
+		}
+	}
+	if similar*2 < len(amounts) {
+		return Series{}, false
+	}
+
+	last := recs[len(recs)-1]
+	s := Series{
+		MerchantID:    last.MerchantID,
+		Merchant:      merchantname.Canonical(last.MerchantName),
+		Category:      last.CategoryName,
+		Cadence:       def.cadence,
+		Currency:      currency,
+		TypicalAmount: typical,
+		LastAmount:    slots[len(slots)-1].Total,
+		Receipts:      recs,
+	}
+
+	// Expected dates count whole periods from the first receipt, so month-end dates don't
+	// drift from one step to the next.
+	anchor := slots[0].TxDate
+	n := 0 // periods from the anchor to the current slot
+	for _, k := range slotGaps {
+		for j := 1; j < k; j++ {
+			s.Missing = append(s.Missing, def.step(anchor, n+j))
+		}
+		n += k
+	}
+	var trailing []time.Time
+	for j := 1; ; j++ {
+		due := def.step(anchor, n+j)
+		if due.AddDate(0, 0, def.grace).After(opts.AsOf) {
+			s.NextExpected = due
+			break
+		}
+		trailing = append(trailing, due)
+	}
+	if len(trailing) > MaxTrailingMisses {
+		s.Ended = true
+		s.NextExpected = time.Time{}
+	} else {
+		s.Missing = append(s.Missing, trailing...)
+	}
+
+	// A change from the last settled amount counts when the next period confirms it, or
+	// when it is the latest period; this skips one-off swings in metered bills.
+	settled := amounts[0]
+	for i := 1; i < len(slots); i++ {
+		cur := amounts[i]
+		if relDiff(cur, settled) <= opts.PriceChangeThreshold {
+			continue
+		}
+		if i+1 < len(slots) && relDiff(amounts[i+1], cur) > opts.PriceChangeThreshold {
+			continue
+		}
+		s.PriceChanges = append(s.PriceChanges, PriceChange{ReceiptID: slots[i].ID, Date: slots[i].TxDate, From: settled, To: cur})
+		settled = cur
+	}
+	return s, true
+}
+
+// pickCadence matches the median gap between receipts to a cadence.
+func pickCadence(gaps []float64) (cadenceDef, bool) {
+	sorted := append([]float64(nil), gaps...)
+	sort.Float64s(sorted)
+	m := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		m = (sorted[len(sorted)/2-1] + m) / 2
+	}
+	for _, c := range cadences {
+		if m >= 0.75*c.days && m <= 1.25*c.days {
+			return c, true
+		}
+	}
+	return cadenceDef{}, false
+}
+
+func usualCurrency(recs []*entity.Receipt) string {
+	counts := map[string]int{}
+	best := ""
+	for _, r := range recs {
+		counts[r.CurrencyCode]++
+		if counts[r.CurrencyCode] > counts[best] || (counts[r.CurrencyCode] == counts[best] && r.CurrencyCode < best) {
+			best = r.CurrencyCode
+		}
+	}
+	return best
+}
+
+func median(amounts []money.Amount) money.Amount {
+	sorted := append([]money.Amount(nil), amounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// relDiff is |a-b| relative to b.
+func relDiff(a, b money.Amount) float64 {
+	if b == 0 {
+		if a == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return math.Abs(float64(a-b)) / math.Abs(float64(b))
+}
+
+func days(a, b time.Time) float64 {
+	return b.Sub(a).Hours() / 24
+}
@@ -0,0 +1,198 @@
+package recurring
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func rec(merchant, d, total string) *entity.Receipt {
+	return &entity.Receipt{ID: uuid.New(), MerchantName: merchant, TxDate: date(d), Total: money.MustParse(total), CurrencyCode: "USD", CategoryName: "Internet"}
+}
+
+func periods(c Cadence, ts []time.Time) string {
+	var out []string
+	for _, t := range ts {
+		out = append(out, c.Period(t))
+	}
+	return strings.Join(out, ",")
+}
+
+func TestDetect(t *testing.T) {
+	recs := []*entity.Receipt{
+		// monthly, July missing, price change in June
+		rec("COMCAST CABLE", "2025-01-15", "80.00"),
+		rec("Comcast Cable", "2025-02-14", "80.00"),
+		rec("COMCAST CABLE", "2025-03-15", "80.00"),
+		rec("COMCAST CABLE", "2025-04-16", "80.00"),
+		rec("COMCAST CABLE", "2025-05-15", "80.00"),
+		rec("COMCAST CABLE", "2025-06-15", "85.00"),
+		rec("COMCAST CABLE", "2025-08-15", "85.00"),
+		// yearly
+		rec("Adobe", "2024-03-01", "239.88"),
+		rec("Adobe", "2025-03-02", "239.88"),
+		// cancelled after March
+		rec("Netflix", "2025-01-03", "15.49"),
+		rec("Netflix", "2025-02-03", "15.49"),
+		rec("Netflix", "2025-03-03", "15.49"),
+		// irregular
+		rec("Staples", "2025-01-04", "42.18"),
+		rec("Staples", "2025-01-09", "12.00"),
+		rec("Staples", "2025-04-20", "99.00"),
+		rec("Staples", "2025-05-01", "7.50"),
+	}
+	series := Detect(recs, Options{AsOf: date("2025-08-31")})
+
+	var names []string
+	for _, s := range series {
+		names = append(names, s.Merchant+":"+string(s.Cadence))
+	}
+	if got := strings.Join(names, ","); got != "Adobe:yearly,Comcast Cable:monthly,Netflix:monthly" {
+		t.Fatalf("Expected %q, got %q", "Adobe:yearly,Comcast Cable:monthly,Netflix:monthly", got)
+	}
+
+	comcast := series[1]
+	if got := periods(Monthly, comcast.Missing); got != "2025-07" {
+		t.Errorf("Expected %q, got %q", "2025-07", got)
+	}
+	if len(comcast.PriceChanges) != 1 || comcast.PriceChanges[0].From.String() != "80.00" || comcast.PriceChanges[0].To.String() != "85.00" {
+		t.Errorf("Expected one change from 80.00 to 85.00, got %+v", comcast.PriceChanges)
+	}
+	if comcast.TypicalAmount.String() != "80.00" || comcast.LastAmount.String() != "85.00" {
+		t.Errorf("Expected typical 80.00 and last 85.00, got %s and %s", comcast.TypicalAmount, comcast.LastAmount)
+	}
+	if got := comcast.NextExpected.Format("2006-01-02"); got != "2025-09-15" {
+		t.Errorf("Expected %q, got %q", "2025-09-15", got)
+	}
+
+	adobe := series[0]
+	if adobe.Ended || len(adobe.Missing) != 0 {
+		t.Errorf("Expected an active yearly series, got ended=%v missing=%v", adobe.Ended, adobe.Missing)
+	}
+
+	netflix := series[2]
+	if !netflix.Ended || len(netflix.Missing) != 0 || !netflix.NextExpected.IsZero() {
+		t.Errorf("Expected Netflix to have ended, got ended=%v missing=%v", netflix.Ended, netflix.Missing)
+	}
+}
+
+func TestDetectTrailingMiss(t *testing.T) {
+	recs := []*entity.Receipt{
+		rec("Verizon Wireless", "2025-01-20", "65.00"),
+		rec("Verizon Wireless", "2025-02-20", "71.30"),
+		rec("Verizon Wireless", "2025-03-20", "64.10"),
+		rec("Verizon Wireless", "2025-04-21", "66.00"),
+	}
+	tests := []struct {
+		name     string
+		asOf     string
+		expected string
+	}{
+		{name: "Within the grace period", asOf: "2025-05-25", expected: ""},
+		{name: "Past the grace period", asOf: "2025-05-29", expected: "2025-05"},
+		{name: "Two periods", asOf: "2025-06-30", expected: "2025-05,2025-06"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series := Detect(recs, Options{AsOf: date(tt.asOf)})
+			if len(series) != 1 {
+				t.Fatalf("Expected 1 series, got %d", len(series))
+			}
+			if got := periods(Monthly, series[0].Missing); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+			// metered bill: the February swing is not a price change
+			if len(series[0].PriceChanges) != 0 {
+				t.Errorf("Expected no price changes, got %+v", series[0].PriceChanges)
+			}
+		})
+	}
+}
+
+func TestDetectMonthEnd(t *testing.T) {
+	dates := func(ts []time.Time) string {
+		var out []string
+		for _, t := range ts {
+			out = append(out, t.Format("2006-01-02"))
+		}
+		return strings.Join(out, ",")
+	}
+	tests := []struct {
+		name     string
+		dates    []string
+		asOf     string
+		missing  string
+		expected string // next expected date
+	}{
+		{
+			name:     "Short month missing",
+			dates:    []string{"2025-01-31", "2025-03-31", "2025-04-30", "2025-05-31", "2025-06-30"},
+			asOf:     "2025-06-30",
+			missing:  "2025-02-28",
+			expected: "2025-07-31",
+		},
+		{
+			name:     "Trailing miss after a short month",
+			dates:    []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30"},
+			asOf:     "2025-06-15",
+			missing:  "2025-05-31",
+			expected: "2025-06-30",
+		},
+		{
+			name:     "Leap year",
+			dates:    []string{"2023-12-31", "2024-01-31", "2024-03-31", "2024-04-30"},
+			asOf:     "2024-04-30",
+			missing:  "2024-02-29",
+			expected: "2024-05-31",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recs []*entity.Receipt
+			for _, d := range tt.dates {
+				recs = append(recs, rec("Spotify", d, "11.99"))
+			}
+			series := Detect(recs, Options{AsOf: date(tt.asOf)})
+			if len(series) != 1 || series[0].Cadence != Monthly {
+				t.Fatalf("Expected 1 monthly series, got %+v", series)
+			}
+			if got := dates(series[0].Missing); got != tt.missing {
+				t.Errorf("Expected missing %q, got %q", tt.missing, got)
+			}
+			if got := series[0].NextExpected.Format("2006-01-02"); got != tt.expected {
+				t.Errorf("Expected next %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		from     string
+		n        int
+		expected string
+	}{
+		{"2025-01-31", 1, "2025-02-28"},
+		{"2025-01-31", 2, "2025-03-31"},
+		{"2025-01-31", 3, "2025-04-30"},
+		{"2024-01-30", 1, "2024-02-29"},
+		{"2024-02-29", 12, "2025-02-28"},
+		{"2025-11-30", 3, "2026-02-28"},
+		{"2025-03-15", -1, "2025-02-15"},
+	}
+	for _, tt := range tests {
+		if got := addMonths(date(tt.from), tt.n).Format("2006-01-02"); got != tt.expected {
+			t.Errorf("%s + %d months: expected %q, got %q", tt.from, tt.n, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package rules applies a profile's deterministic categorization rules to parsed receipts.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Input is what rules are matched against.
+type Input struct {
+	Merchant    string
+	Description string
+	Path        string        // source file path (filename + folders)
+	Total       *money.Amount // nil when the total is unknown
+}
+
+// Outcome is the combined effect of every rule that fired.
+type Outcome struct {
+	Category    string // empty = no rule set a category
+	Description string // empty = no rule overrode the description
+	ForceReview bool
+	Fired       []string // rule names in evaluation order
+}
+
+// Engine evaluates compiled rules in priority order.
+type Engine struct {
+	rules []compiled
+}
+
+type compiled struct {
+	rule     *entity.CategoryRule
+	merchant *regexp.Regexp
+	path     *regexp.Regexp
+	keywords []string
+}
+
+// Validate checks that a rule has at least one condition and one action and that its
+// patterns compile.
+func Validate(r *entity.CategoryRule) error {
+	_, err := compile(r)
+	return err
+}
+
+// Compile builds an Engine from a profile's rules. Disabled rules are skipped. Rules run
+// by ascending priority, then name.
+func Compile(list []*entity.CategoryRule) (*Engine, error) {
+	e := &Engine{}
+	for _, r := range list {
+		if !r.Enabled {
+			continue
+		}
+		c, err := compile(r)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Name, err)
+		}
+		e.rules = append(e.rules, c)
+	}
+	sort.SliceStable(e.rules, func(i, j int) bool {
+		a, b := e.rules[i].rule, e.rules[j].rule
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.Name < b.Name
+	})
+	return e, nil
+}
+
+func compile(r *entity.CategoryRule) (compiled, error) {
+	c := compiled{rule: r}
+	var err error
+	if p := strOrEmpty(r.MerchantPattern); p != "" {
+		if c.merchant, err = regexp.Compile("(?i)" + p); err != nil {
+			return c, fmt.Errorf("merchant_pattern: %w", err)
+		}
+	}
+	if p := strOrEmpty(r.PathPattern); p != "" {
+		if c.path, err = regexp.Compile("(?i)" + p); err != nil {
+			return c, fmt.Errorf("path_pattern: %w", err)
+		}
+	}
+	for _, k := range r.Keywords {
+		if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+			c.keywords = append(c.keywords, k)
+		}
+	}
+	if r.MinTotal != nil && r.MaxTotal != nil && *r.MinTotal > *r.MaxTotal {
+		return c, errors.New("min_total is greater than max_total")
+	}
+	if c.merchant == nil && c.path == nil && len(c.keywords) == 0 && r.MinTotal == nil && r.MaxTotal == nil {
+		return c, errors.New("at least one condition is required")
+	}
+	if strOrEmpty(r.SetCategory) == "" && strOrEmpty(r.SetDescription) == "" && !r.ForceReview {
+		return c, errors.New("at least one action is required")
+	}
+	return c, nil
+}
+
+// Apply runs every rule against in. The first rule to set a category (or description)
+// wins that field; force_review from any fired rule sticks.
+func (e *Engine) Apply(in Input) Outcome {
+	var out Outcome
+	if e == nil {
+		return out
+	}
+	for _, c := range e.rules {
+		if !c.matches(in) {
+			continue
+		}
+		out.Fired = append(out.Fired, c.rule.Name)
+		if out.Category == "" {
+			out.Category = strOrEmpty(c.rule.SetCategory)
+		}
+		if out.Description == "" {
+			out.Description = strOrEmpty(c.rule.SetDescription)
+		}
+		out.ForceReview = out.ForceReview || c.rule.ForceReview
+	}
+	return out
+}
+
+// CategoryForPath returns the category of the first rule that depends only on the file
+// path, so it can be decided before the LLM runs.
+func (e *Engine) CategoryForPath(path string) (category, rule string, ok bool) {
+	if e == nil {
+		return "", "", false
+	}
+	for _, c := range e.rules {
+		pathOnly := c.path != nil && c.merchant == nil && len(c.keywords) == 0 && c.rule.MinTotal == nil && c.rule.MaxTotal == nil
+		if !pathOnly || strOrEmpty(c.rule.SetCategory) == "" {
+			continue
+		}
+		if c.path.MatchString(path) {
+			return *c.rule.SetCategory, c.rule.Name, true
+		}
+	}
+	return "", "", false
+}
+
+func (c compiled) matches(in Input) bool {
+	if c.merchant != nil && !c.merchant.MatchString(in.Merchant) {
+		return false
+	}
+	if c.path != nil && !c.path.MatchString(in.Path) {
+		return false
+	}
+	if c.rule.MinTotal != nil && (in.Total == nil || *in.Total < *c.rule.MinTotal) {
+		return false
+	}
+	if c.rule.MaxTotal != nil && (in.Total == nil || *in.Total > *c.rule.MaxTotal) {
+		return false
+	}
+	if len(c.keywords) > 0 {
+		text := strings.ToLower(in.Merchant + " " + in.Description)
+		found := false
+		for _, k := range c.keywords {
+			if strings.Contains(text, k) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func strOrEmpty(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return strings.TrimSpace(*p)
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestApply(t *testing.T) {
+	engine, err := Compile([]*entity.CategoryRule{
+		{Name: "staples", Priority: 10, Enabled: true, MerchantPattern: ptr(`^staples\b`), SetCategory: ptr("Office Supplies")},
+		{Name: "comcast", Priority: 10, Enabled: true, MerchantPattern: ptr(`comcast|xfinity`), SetCategory: ptr("Internet"), SetDescription: ptr("Home internet service")},
+		{Name: "big ticket", Priority: 50, Enabled: true, MinTotal: ptr(money.MustParse("500")), ForceReview: true},
+		{Name: "gear folder", Priority: 20, Enabled: true, PathPattern: ptr(`/gear/`), SetCategory: ptr("Office Equipment")},
+		{Name: "toner", Priority: 30, Enabled: true, Keywords: []string{"Toner"}, SetCategory: ptr("Office Equipment")},
+		{Name: "disabled", Priority: 1, Enabled: false, MerchantPattern: ptr(`.*`), SetCategory: ptr("Other")},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		input    Input
+		expected Outcome
+	}{
+		{
+			name:     "Merchant regex is case-insensitive",
+			input:    Input{Merchant: "STAPLES #123", Total: ptr(money.MustParse("42.18"))},
+			expected: Outcome{Category: "Office Supplies", Fired: []string{"staples"}},
+		},
+		{
+			name:     "Description override",
+			input:    Input{Merchant: "Xfinity", Total: ptr(money.MustParse("80"))},
+			expected: Outcome{Category: "Internet", Description: "Home internet service", Fired: []string{"comcast"}},
+		},
+		{
+			name:     "Higher priority category wins; review still forced",
+			input:    Input{Merchant: "Staples", Description: "toner", Total: ptr(money.MustParse("612.00"))},
+			expected: Outcome{Category: "Office Supplies", ForceReview: true, Fired: []string{"staples", "toner", "big ticket"}},
+		},
+		{
+			name:     "Amount condition needs a total",
+			input:    Input{Merchant: "Unknown"},
+			expected: Outcome{},
+		},
+		{
+			name:     "Path pattern",
+			input:    Input{Merchant: "B&H", Path: "/receipts/2025/gear/bh.pdf"},
+			expected: Outcome{Category: "Office Equipment", Fired: []string{"gear folder"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := engine.Apply(tt.input)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+
+	if cat, rule, ok := engine.CategoryForPath("/receipts/gear/x.jpg"); !ok || cat != "Office Equipment" || rule != "gear folder" {
+		t.Errorf("Expected path-only rule to match, got %q %q %v", cat, rule, ok)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    entity.CategoryRule
+		wantErr bool
+	}{
+		{name: "Valid", rule: entity.CategoryRule{MerchantPattern: ptr("amzn"), SetCategory: ptr("Office Supplies")}},
+		{name: "No condition", rule: entity.CategoryRule{SetCategory: ptr("Meals")}, wantErr: true},
+		{name: "No action", rule: entity.CategoryRule{MerchantPattern: ptr("amzn")}, wantErr: true},
+		{name: "Bad regex", rule: entity.CategoryRule{MerchantPattern: ptr("("), ForceReview: true}, wantErr: true},
+		{name: "Inverted range", rule: entity.CategoryRule{MinTotal: ptr(money.Amount(500)), MaxTotal: ptr(money.Amount(100)), ForceReview: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package statement
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// Defaults for Options.
+const (
+	DefaultDateWindow  = 5
+	DefaultFXTolerance = 0.03
+)
+
+// WeakMerchantScore is the merchant score below which a match (same amount, close date)
+// is flagged for a manual check.
+const WeakMerchantScore = 0.5
+
+// Options tune matching.
+type Options struct {
+	// DateWindow is how many days after the receipt date a charge may post (it may also
+	// post a day early, for time zones). 0 means DefaultDateWindow.
+	DateWindow int
+	// FXTolerance is the relative difference allowed between a charge and the converted
+	// total of a receipt in another currency. 0 means DefaultFXTolerance.
+	FXTolerance float64
+}
+
+func (o Options) withDefaults() Options {
+	if o.DateWindow <= 0 {
+		o.DateWindow = DefaultDateWindow
+	}
+	if o.FXTolerance <= 0 {
+		o.FXTolerance = DefaultFXTolerance
+	}
+	return o
+}
+
+// Match pairs a statement charge with the receipt for it.
+type Match struct {
+	Transaction   *entity.StatementTransaction
+	Receipt       *entity.Receipt
+	MerchantScore float64 // 0..1, statement description vs receipt merchant
+	DaysApart     int     // posted date minus receipt date
+	Weak          bool    // merchant names disagree; check by hand
+}
+
+// Result is a reconciliation of statement lines against receipts.
+type Result struct {
+	Matches []Match
+	// UnmatchedTransactions are charges with no receipt.
+	UnmatchedTransactions []*entity.StatementTransaction
+	// UnmatchedReceipts are receipts with no charge.
+	UnmatchedReceipts []*entity.Receipt
+	// Credits counts payments and refunds, which are not matched.
+	Credits int
+}
+
+// Reconcile matches each charge to at most one receipt and each receipt to at most one
+// charge. A pair qualifies when the amounts agree (exactly in the same currency, within
+// FXTolerance against a receipt's converted total otherwise) and the charge posted within
+// the date window; among qualifying pairs the best merchant-name match wins, then the
+// closest date.
+func Reconcile(txs []*entity.StatementTransaction, recs []*entity.Receipt, opts Options) Result {
+	opts = opts.withDefaults()
+
+	type candidate struct {
+		tx, rec int
+		score   float64
+		match   Match
+	}
+	var res Result
+	var cands []candidate
+	for i, t := range txs {
+		if t.Amount <= 0 {
+			res.Credits++
+			continue
+		}
+		for j, r := range recs {
+			days := daysBetween(r.TxDate, t.PostedDate)
+			if days < -1 || days > opts.DateWindow {
+				continue
+			}
+			exact, ok := amountsAgree(t, r, opts.FXTolerance)
+			if !ok {
+				continue
+			}
+			ms := merchantScore(t.Description, r.MerchantName)
+			score := ms - 0.02*math.Abs(float64(days))
+			if exact {
+				score += 0.5
+			}
+			cands = append(cands, candidate{tx: i, rec: j, score: score, match: Match{
+				Transaction:   t,
+				Receipt:       r,
+				MerchantScore: ms,
+				DaysApart:     days,
+				Weak:          ms < WeakMerchantScore,
+			}})
+		}
+	}
+	sort.SliceStable(cands, func(a, b int) bool { return cands[a].score > cands[b].score })
+
+	txDone, recDone := make([]bool, len(txs)), make([]bool, len(recs))
+	for _, c := range cands {
+		if txDone[c.tx] || recDone[c.rec] {
+			continue
+		}
+		txDone[c.tx], recDone[c.rec] = true, true
+		res.Matches = append(res.Matches, c.match)
+	}
+	sort.SliceStable(res.Matches, func(a, b int) bool {
+		return res.Matches[a].Transaction.PostedDate.Before(res.Matches[b].Transaction.PostedDate)
+	})
+	for i, t := range txs {
+		if !txDone[i] && t.Amount > 0 {
+			res.UnmatchedTransactions = append(res.UnmatchedTransactions, t)
+		}
+	}
+	for j, r := range recs {
+		if !recDone[j] {
+			res.UnmatchedReceipts = append(res.UnmatchedReceipts, r)
+		}
+	}
+	return res
+}
+
+// amountsAgree compares a charge with a receipt: the printed total when the currencies
+// match, else the converted total when it is in the charge's currency. exact reports an
+// equal amount.
+func amountsAgree(t *entity.StatementTransaction, r *entity.Receipt, tolerance float64) (exact, ok bool) {
+	if strings.EqualFold(r.CurrencyCode, t.CurrencyCode) {
+		return r.Total == t.Amount, r.Total == t.Amount
+	}
+	if r.ConvertedTotal == nil || r.ConvertedCurrency == nil || !strings.EqualFold(*r.ConvertedCurrency, t.CurrencyCode) {
+		return false, false
+	}
+	diff := math.Abs(float64(*r.ConvertedTotal - t.Amount))
+	return diff == 0, diff <= tolerance*math.Abs(float64(*r.ConvertedTotal))
+}
+
+// merchantScore compares a statement description with a receipt merchant. Descriptors
+// are cut short and padded with locations ("BLUE BOTTLE COF OAKLAND CA"), so besides the
+// merchantname similarity it scores the share of merchant words found at the start of a
+// description word or vice versa.
+func merchantScore(description, merchant string) float64 {
+	d, m := merchantname.Key(description), merchantname.Key(merchant)
+	best := merchantname.Similarity(d, m)
+	words := strings.Fields(m)
+	if len(words) == 0 {
+		return best
+	}
+	hits := 0
+	for _, w := range words {
+		for _, s := range strings.Fields(d) {
+			if w == s || (len(s) >= 3 && strings.HasPrefix(w, s)) || (len(w) >= 3 && strings.HasPrefix(s, w)) {
+				hits++
+				break
+			}
+		}
+	}
+	return max(best, float64(hits)/float64(len(words)))
+}
+
+// daysBetween counts calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(db.Sub(da).Hours() / 24))
+}
@@ -0,0 +1,330 @@
+// Package statement reads bank and credit-card statements (CSV or OFX) and reconciles
+// their charges against receipts.
+package statement
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Statement is a parsed statement file.
+type Statement struct {
+	Account      string // OFX account id; empty for CSV
+	Currency     string // OFX CURDEF; empty when the file doesn't say
+	Transactions []Transaction
+}
+
+// Transaction is one statement line. Charges are positive; payments, refunds and other
+// credits are negative.
+type Transaction struct {
+	PostedDate  time.Time
+	Description string
+	Amount      money.Amount
+	Currency    string // empty unless the line has its own currency
+	// ExternalID identifies the line across imports of the same statement: the OFX FITID,
+	// or for CSV a hash of the line and its occurrence in the file.
+	ExternalID string
+}
+
+// Format selects a parser.
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	FormatOFX Format = "ofx"
+)
+
+// DetectFormat picks the parser for a file by extension, then by content.
+func DetectFormat(name string, content []byte) Format {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".ofx", ".qfx":
+		return FormatOFX
+	case ".csv":
+		return FormatCSV
+	}
+	head := bytes.ToUpper(content[:min(len(content), 4096)])
+	if bytes.Contains(head, []byte("OFXHEADER")) || bytes.Contains(head, []byte("<OFX>")) {
+		return FormatOFX
+	}
+	return FormatCSV
+}
+
+// Parse reads a statement in the given format.
+func Parse(r io.Reader, format Format) (*Statement, error) {
+	switch format {
+	case FormatOFX:
+		return ParseOFX(r)
+	case FormatCSV:
+		return ParseCSV(r)
+	}
+	return nil, fmt.Errorf("unknown statement format %q", format)
+}
+
+// CSV header names, most specific first. The transaction date is preferred over the
+// posting date since receipts carry the former.
+var (
+	dateHeaders        = []string{"transaction date", "trans. date", "trans date", "date", "posting date", "posted date", "post date"}
+	descriptionHeaders = []string{"description", "payee", "merchant", "name", "details", "memo"}
+	amountHeaders      = []string{"amount", "transaction amount"}
+	debitHeaders       = []string{"debit", "withdrawal", "withdrawals", "charge", "charges"}
+	creditHeaders      = []string{"credit", "deposit", "deposits", "payment", "payments"}
+	currencyHeaders    = []string{"currency"}
+)
+
+// dateLayouts are tried in order; month-first wins for ambiguous dates such as 03/04/2025.
+var dateLayouts = []string{
+	"2006-01-02", "01/02/2006", "1/2/2006", "01/02/06", "1/2/06", "2006/01/02",
+	"02.01.2006", "Jan 2, 2006", "02 Jan 2006", "2-Jan-2006", "02-Jan-06",
+}
+
+// ParseCSV reads a CSV export. The header row is found among the first rows (banks often
+// put account details above it) by its date, description and amount columns. Amounts come
+// from a single amount column or from debit/credit columns. With a single column the sign
+// convention is inferred: when most lines are negative, charges are negative (bank style)
+// and the signs are flipped.
+func ParseCSV(r io.Reader) (*Statement, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("statement csv: %w", err)
+	}
+
+	headerRow, col := -1, csvColumns{}
+	for i := 0; i < len(rows) && i < 20; i++ {
+		if c, ok := findColumns(rows[i]); ok {
+			headerRow, col = i, c
+			break
+		}
+	}
+	if headerRow < 0 {
+		return nil, fmt.Errorf("statement csv: no header with date, description and amount (or debit/credit) columns")
+	}
+
+	get := func(row []string, i int) string {
+		if i < 0 || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+	st := &Statement{}
+	seen := map[string]int{}
+	negatives := 0
+	for n, row := range rows[headerRow+1:] {
+		line := headerRow + n + 2
+		rawDate := get(row, col.date)
+		if rawDate == "" {
+			continue // blank or trailer line
+		}
+		d, err := parseDate(rawDate)
+		if err != nil {
+			return nil, fmt.Errorf("statement csv line %d: %w", line, err)
+		}
+		var amount money.Amount
+		if col.amount >= 0 {
+			if amount, err = money.Parse(get(row, col.amount)); err != nil {
+				return nil, fmt.Errorf("statement csv line %d: amount: %w", line, err)
+			}
+			if amount < 0 {
+				negatives++
+			}
+		} else {
+			debit, credit := get(row, col.debit), get(row, col.credit)
+			if debit == "" && credit == "" {
+				continue
+			}
+			if debit != "" {
+				if amount, err = money.Parse(debit); err != nil {
+					return nil, fmt.Errorf("statement csv line %d: debit: %w", line, err)
+				}
+				amount = amount.Abs()
+			}
+			if credit != "" {
+				c, err := money.Parse(credit)
+				if err != nil {
+					return nil, fmt.Errorf("statement csv line %d: credit: %w", line, err)
+				}
+				amount -= c.Abs()
+			}
+		}
+		desc := strings.Join(strings.Fields(get(row, col.description)), " ")
+		t := Transaction{PostedDate: d, Description: desc, Amount: amount, Currency: strings.ToUpper(get(row, col.currency))}
+		key := d.Format("2006-01-02") + "|" + desc + "|" + amount.String()
+		seen[key]++
+		t.ExternalID = lineID(key, seen[key])
+		st.Transactions = append(st.Transactions, t)
+	}
+	if col.amount >= 0 && negatives*2 > len(st.Transactions) {
+		for i := range st.Transactions {
+			st.Transactions[i].Amount = -st.Transactions[i].Amount
+		}
+	}
+	return st, nil
+}
+
+type csvColumns struct {
+	date, description, amount, debit, credit, currency int
+}
+
+func findColumns(header []string) (csvColumns, bool) {
+	index := map[string]int{}
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if _, ok := index[h]; !ok {
+			index[h] = i
+		}
+	}
+	pick := func(names []string) int {
+		for _, n := range names {
+			if i, ok := index[n]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+	c := csvColumns{
+		date:        pick(dateHeaders),
+		description: pick(descriptionHeaders),
+		amount:      pick(amountHeaders),
+		debit:       pick(debitHeaders),
+		credit:      pick(creditHeaders),
+		currency:    pick(currencyHeaders),
+	}
+	ok := c.date >= 0 && c.description >= 0 && (c.amount >= 0 || c.debit >= 0 || c.credit >= 0)
+	return c, ok
+}
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", s)
+}
+
+// lineID hashes a line key with its occurrence so identical lines (two coffees on one
+// day) stay distinct while re-importing the same file yields the same ids.
+func lineID(key string, occurrence int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrence)))
+	return "line:" + hex.EncodeToString(sum[:12])
+}
+
+// ofxTag matches an OFX element and its value. SGML OFX (1.x) leaves most elements
+// unclosed, so values run to the next tag or line end; OFX 2 XML parses the same way.
+var ofxTag = regexp.MustCompile(`(?i)<([A-Z0-9.]+)>([^<\r\n]*)`)
+
+// ParseOFX reads the transactions of an OFX or QFX file, bank or credit-card. OFX amounts
+// are signed from the account holder's view (charges negative), so they are flipped.
+func ParseOFX(r io.Reader) (*Statement, error) {
+	st := &Statement{}
+	var cur *Transaction
+	// lineCurrency is set inside a transaction's CURRENCY aggregate, whose CURSYM is the
+	// currency TRNAMT is in. ORIGCURRENCY only names what was charged abroad; TRNAMT is
+	// then already in CURDEF.
+	lineCurrency := false
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		if cur.PostedDate.IsZero() {
+			return fmt.Errorf("statement ofx: transaction %q has no DTPOSTED", cur.ExternalID)
+		}
+		st.Transactions = append(st.Transactions, *cur)
+		cur = nil
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64<<10), 4<<20)
+	for sc.Scan() {
+		for _, m := range ofxTag.FindAllStringSubmatch(sc.Text(), -1) {
+			tag, value := strings.ToUpper(m[1]), strings.TrimSpace(unescapeOFX(m[2]))
+			switch tag {
+			case "STMTTRN":
+				if err := flush(); err != nil {
+					return nil, err
+				}
+				cur = &Transaction{}
+				lineCurrency = false
+			case "CURRENCY":
+				lineCurrency = true
+			case "ORIGCURRENCY":
+				lineCurrency = false
+			case "CURDEF":
+				st.Currency = strings.ToUpper(value)
+			case "ACCTID":
+				if cur == nil { // inside STMTTRN it is a transfer's BANKACCTTO / CCACCTTO
+					st.Account = value
+				}
+			}
+			if cur == nil || value == "" {
+				continue
+			}
+			switch tag {
+			case "DTPOSTED":
+				if len(value) < 8 {
+					return nil, fmt.Errorf("statement ofx: bad DTPOSTED %q", value)
+				}
+				d, err := time.Parse("20060102", value[:8])
+				if err != nil {
+					return nil, fmt.Errorf("statement ofx: bad DTPOSTED %q", value)
+				}
+				cur.PostedDate = d
+			case "TRNAMT":
+				a, err := money.Parse(value)
+				if err != nil {
+					return nil, fmt.Errorf("statement ofx: TRNAMT: %w", err)
+				}
+				cur.Amount = -a
+			case "FITID":
+				cur.ExternalID = value
+			case "NAME":
+				cur.Description = value
+			case "MEMO":
+				if cur.Description == "" {
+					cur.Description = value
+				}
+			case "CURSYM":
+				if lineCurrency {
+					cur.Currency = strings.ToUpper(value)
+				}
+			}
+		}
+		if cur != nil && strings.Contains(strings.ToUpper(sc.Text()), "</STMTTRN>") {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("statement ofx: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	for i, t := range st.Transactions {
+		if t.ExternalID == "" {
+			key := t.PostedDate.Format("2006-01-02") + "|" + t.Description + "|" + t.Amount.String()
+			st.Transactions[i].ExternalID = lineID(key, i)
+		}
+	}
+	return st, nil
+}
+
+func unescapeOFX(s string) string {
+	return strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'").Replace(s)
+}
@@ -0,0 +1,223 @@
+package statement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string // "date|description|amount"
+	}{
+		{
+			name: "Card export, charges positive",
+			input: "Transaction Date,Post Date,Description,Category,Type,Amount\n" +
+				"01/05/2025,01/06/2025,STAPLES 00123,Shopping,Sale,42.18\n" +
+				"01/07/2025,01/07/2025,Payment Thank You,,Payment,-500.00\n",
+			expected: []string{"2025-01-05|STAPLES 00123|42.18", "2025-01-07|Payment Thank You|-500.00"},
+		},
+		{
+			name: "Bank export, charges negative, preamble above the header",
+			input: "Account,Checking ****1234\n\n" +
+				"Date,Description,Amount\n" +
+				"2025-02-01,BLUE BOTTLE COF OAKLAND CA,-6.50\n" +
+				"2025-02-02,ADOBE *CREATIVE CLD,-54.99\n" +
+				"2025-02-03,REFUND ADOBE,54.99\n",
+			expected: []string{"2025-02-01|BLUE BOTTLE COF OAKLAND CA|6.50", "2025-02-02|ADOBE *CREATIVE CLD|54.99", "2025-02-03|REFUND ADOBE|-54.99"},
+		},
+		{
+			name: "Debit and credit columns",
+			input: "Date,Payee,Debit,Credit\n" +
+				"3/4/2025,UBER TRIP,18.20,\n" +
+				"3/5/2025,UBER REFUND,,5.00\n",
+			expected: []string{"2025-03-04|UBER TRIP|18.20", "2025-03-05|UBER REFUND|-5.00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := ParseCSV(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(st.Transactions) != len(tt.expected) {
+				t.Fatalf("Expected %d transactions, got %d", len(tt.expected), len(st.Transactions))
+			}
+			for i, tx := range st.Transactions {
+				got := tx.PostedDate.Format("2006-01-02") + "|" + tx.Description + "|" + tx.Amount.String()
+				if got != tt.expected[i] {
+					t.Errorf("Expected %q, got %q", tt.expected[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCSVExternalIDs(t *testing.T) {
+	input := "Date,Description,Amount\n2025-01-05,COFFEE,4.50\n2025-01-05,COFFEE,4.50\n"
+	first, err := ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	again, _ := ParseCSV(strings.NewReader(input))
+	if first.Transactions[0].ExternalID == first.Transactions[1].ExternalID {
+		t.Errorf("Expected identical lines to get distinct ids, got %q twice", first.Transactions[0].ExternalID)
+	}
+	if first.Transactions[1].ExternalID != again.Transactions[1].ExternalID {
+		t.Errorf("Expected stable ids across imports, got %q and %q", first.Transactions[1].ExternalID, again.Transactions[1].ExternalID)
+	}
+}
+
+func TestParseOFX(t *testing.T) {
+	input := `OFXHEADER:100
+DATA:OFXSGML
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+<CURDEF>USD
+<CCACCTFROM><ACCTID>4111222233334444</CCACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250105120000[-5:EST]<TRNAMT>-42.18<FITID>2025010501<NAME>STAPLES 00123</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250107
+<TRNAMT>500.00
+<FITID>2025010702
+<NAME>PAYMENT - THANK YOU
+<MEMO>AUTOPAY
+<BANKACCTTO><BANKID>121000248<ACCTID>9876543210<ACCTTYPE>CHECKING</BANKACCTTO>
+</STMTTRN>
+</BANKTRANLIST></CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>
+`
+	if f := DetectFormat("statement.txt", []byte(input)); f != FormatOFX {
+		t.Errorf("Expected %q, got %q", FormatOFX, f)
+	}
+	st, err := ParseOFX(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if st.Currency != "USD" || st.Account != "4111222233334444" {
+		t.Errorf("Expected USD account 4111222233334444, got %q account %q", st.Currency, st.Account)
+	}
+	expected := []string{"2025-01-05|STAPLES 00123|42.18|2025010501", "2025-01-07|PAYMENT - THANK YOU|-500.00|2025010702"}
+	if len(st.Transactions) != len(expected) {
+		t.Fatalf("Expected %d transactions, got %d", len(expected), len(st.Transactions))
+	}
+	for i, tx := range st.Transactions {
+		got := tx.PostedDate.Format("2006-01-02") + "|" + tx.Description + "|" + tx.Amount.String() + "|" + tx.ExternalID
+		if got != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], got)
+		}
+	}
+}
+
+func TestParseOFXLineCurrency(t *testing.T) {
+	input := `OFXHEADER:100
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+<CURDEF>USD
+<CCACCTFROM><ACCTID>4111222233334444</CCACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><DTPOSTED>20250110<TRNAMT>-8.00<FITID>1<NAME>PRET A MANGER<CURRENCY><CURRATE>1.265<CURSYM>GBP</CURRENCY></STMTTRN>
+<STMTTRN><DTPOSTED>20250111<TRNAMT>-10.12<FITID>2<NAME>PRET A MANGER<ORIGCURRENCY><CURRATE>1.265<CURSYM>GBP</ORIGCURRENCY></STMTTRN>
+</BANKTRANLIST></CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>
+`
+	st, err := ParseOFX(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(st.Transactions) != 2 {
+		t.Fatalf("Expected 2 transactions, got %d", len(st.Transactions))
+	}
+	if got := st.Transactions[0].Currency; got != "GBP" {
+		t.Errorf("Expected CURRENCY to set the line currency to GBP, got %q", got)
+	}
+	if got := st.Transactions[1].Currency; got != "" {
+		t.Errorf("Expected ORIGCURRENCY to leave the amount in CURDEF, got %q", got)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tx := func(d, desc, amount, currency string) *entity.StatementTransaction {
+		return &entity.StatementTransaction{ID: uuid.New(), PostedDate: date(d), Description: desc, Amount: money.MustParse(amount), CurrencyCode: currency}
+	}
+	rec := func(d, merchant, total, currency string) *entity.Receipt {
+		return &entity.Receipt{ID: uuid.New(), TxDate: date(d), MerchantName: merchant, Total: money.MustParse(total), CurrencyCode: currency}
+	}
+
+	staples := rec("2025-01-04", "Staples", "42.18", "USD")
+	coffeeA := rec("2025-01-10", "Blue Bottle Coffee", "6.50", "USD")
+	coffeeB := rec("2025-01-10", "Philz Coffee", "6.50", "USD")
+	london := rec("2025-01-12", "Pret A Manger", "8.00", "GBP")
+	london.ConvertedTotal, london.ConvertedCurrency = ptr(money.MustParse("10.12")), ptr("USD")
+	orphan := rec("2025-01-15", "Home Depot", "88.00", "USD")
+	late := rec("2025-01-01", "Amazon", "19.99", "USD")
+
+	txs := []*entity.StatementTransaction{
+		tx("2025-01-06", "STAPLES 00123 SAN JOSE CA", "42.18", "USD"),
+		tx("2025-01-11", "PHILZ COFFEE - SF", "6.50", "USD"),
+		tx("2025-01-11", "BLUE BOTTLE COF OAKLAND CA", "6.50", "USD"),
+		tx("2025-01-13", "PRET A MANGER LONDON", "10.30", "USD"),
+		tx("2025-01-14", "PAYMENT THANK YOU", "-500.00", "USD"),
+		tx("2025-01-15", "NETFLIX.COM", "15.49", "USD"),
+		tx("2025-01-20", "AMAZON MKTPLACE", "19.99", "USD"),
+	}
+	res := Reconcile(txs, []*entity.Receipt{staples, coffeeA, coffeeB, london, orphan, late}, Options{})
+
+	matched := map[string]*entity.Receipt{}
+	for _, m := range res.Matches {
+		matched[m.Transaction.Description] = m.Receipt
+	}
+	expected := map[string]*entity.Receipt{
+		"STAPLES 00123 SAN JOSE CA":  staples,
+		"PHILZ COFFEE - SF":          coffeeB,
+		"BLUE BOTTLE COF OAKLAND CA": coffeeA,
+		"PRET A MANGER LONDON":       london,
+	}
+	if len(matched) != len(expected) {
+		t.Errorf("Expected %d matches, got %d", len(expected), len(matched))
+	}
+	for desc, want := range expected {
+		if got := matched[desc]; got != want {
+			t.Errorf("Expected %q to match %q, got %v", desc, want.MerchantName, got)
+		}
+	}
+
+	var unmatched []string
+	for _, t := range res.UnmatchedTransactions {
+		unmatched = append(unmatched, t.Description)
+	}
+	if got := strings.Join(unmatched, ","); got != "NETFLIX.COM,AMAZON MKTPLACE" {
+		t.Errorf("Expected %q, got %q", "NETFLIX.COM,AMAZON MKTPLACE", got)
+	}
+	if len(res.UnmatchedReceipts) != 2 || res.UnmatchedReceipts[0] != orphan || res.UnmatchedReceipts[1] != late {
+		t.Errorf("Expected Home Depot and Amazon receipts unmatched, got %d receipts", len(res.UnmatchedReceipts))
+	}
+	if res.Credits != 1 {
+		t.Errorf("Expected 1 credit, got %d", res.Credits)
+	}
+}
+
+func TestReconcileWeakMatch(t *testing.T) {
+	txs := []*entity.StatementTransaction{{PostedDate: date("2025-03-02"), Description: "SQ *JOES", Amount: money.MustParse("12.00"), CurrencyCode: "USD"}}
+	recs := []*entity.Receipt{{TxDate: date("2025-03-01"), MerchantName: "Corner Bakery", Total: money.MustParse("12.00"), CurrencyCode: "USD"}}
+	res := Reconcile(txs, recs, Options{})
+	if len(res.Matches) != 1 {
+		t.Fatalf("Expected 1 match, got %d", len(res.Matches))
+	}
+	if !res.Matches[0].Weak || res.Matches[0].DaysApart != 1 {
+		t.Errorf("Expected a weak match 1 day apart, got weak=%v days=%d", res.Matches[0].Weak, res.Matches[0].DaysApart)
+	}
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReceiptAnomaly represents a reason a parsed receipt was flagged for review.
+type ReceiptAnomaly struct {
+	ID        uuid.UUID `json:"id"`
+	ReceiptID uuid.UUID `json:"receipt_id"`
+	ProfileID uuid.UUID `json:"profile_id"`
+	Kind      string    `json:"kind"`
+	Reason    string    `json:"reason"`
+	Score     float64   `json:"score"` // times the usual amount, or days off for dates
+	CreatedAt time.Time `json:"created_at"`
+	// Receipt is the flagged receipt version, when loaded.
+	Receipt *Receipt `json:"receipt,omitempty"`
+}
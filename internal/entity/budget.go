@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Budget periods.
+const (
+	BudgetMonthly = "monthly"
+	BudgetAnnual  = "annual"
+)
+
+// Budget represents a spending cap for one category for data transfer between layers.
+type Budget struct {
+	ID           uuid.UUID    `json:"id"`
+	ProfileID    uuid.UUID    `json:"profile_id"`
+	CategoryName string       `json:"category_name"`
+	Period       string       `json:"period"` // BudgetMonthly or BudgetAnnual
+	Amount       money.Amount `json:"amount"` // in the profile currency
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// BudgetAlert represents a budget threshold crossed in one period.
+type BudgetAlert struct {
+	ID           uuid.UUID    `json:"id"`
+	BudgetID     uuid.UUID    `json:"budget_id"`
+	ProfileID    uuid.UUID    `json:"profile_id"`
+	CategoryName string       `json:"category_name"`
+	Period       string       `json:"period"`
+	PeriodStart  time.Time    `json:"period_start"`
+	Threshold    int          `json:"threshold"` // percent of the budget
+	Spent        money.Amount `json:"spent"`
+	BudgetAmount money.Amount `json:"budget_amount"`
+	ReceiptID    *uuid.UUID   `json:"receipt_id,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+	DeliveredAt  *time.Time   `json:"delivered_at,omitempty"` // nil until a notifier accepted it
+}
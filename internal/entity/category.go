@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category represents a per-profile expense category for data transfer between layers.
+type Category struct {
+	ID          uuid.UUID `json:"id"`
+	ProfileID   uuid.UUID `json:"profile_id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	Synonyms    []string  `json:"synonyms,omitempty"`
+	TaxLine     *string   `json:"tax_line,omitempty"`
+	Account     *string   `json:"account,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
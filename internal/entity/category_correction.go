@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CategoryCorrection represents a reviewer re-categorization for data transfer between layers.
+type CategoryCorrection struct {
+	ID           uuid.UUID `json:"id"`
+	ProfileID    uuid.UUID `json:"profile_id"`
+	ReceiptID    uuid.UUID `json:"receipt_id"`
+	MerchantName string    `json:"merchant_name"`
+	Description  *string   `json:"description,omitempty"`
+	FromCategory string    `json:"from_category"`
+	ToCategory   string    `json:"to_category"`
+	CreatedAt    time.Time `json:"created_at"`
+}
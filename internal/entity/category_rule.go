@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// CategoryRule represents a deterministic categorization rule for data transfer between layers.
+type CategoryRule struct {
+	ID        uuid.UUID `json:"id"`
+	ProfileID uuid.UUID `json:"profile_id"`
+	Name      string    `json:"name"`
+	Priority  int       `json:"priority"`
+	Enabled   bool      `json:"enabled"`
+
+	MerchantPattern *string       `json:"merchant_pattern,omitempty"`
+	PathPattern     *string       `json:"path_pattern,omitempty"`
+	Keywords        []string      `json:"keywords,omitempty"`
+	MinTotal        *money.Amount `json:"min_total,omitempty"`
+	MaxTotal        *money.Amount `json:"max_total,omitempty"`
+
+	SetCategory    *string `json:"set_category,omitempty"`
+	ForceReview    bool    `json:"force_review"`
+	SetDescription *string `json:"set_description,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportTemplate represents a named receipts spreadsheet layout for data transfer between layers.
+type ExportTemplate struct {
+	ID        uuid.UUID      `json:"id"`
+	ProfileID uuid.UUID      `json:"profile_id"`
+	Name      string         `json:"name"`
+	Columns   []ExportColumn `json:"columns"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// ExportColumn is one column of an export template.
+type ExportColumn struct {
+	Header string `json:"header"`
+	// Field is a receipt field, optionally piped through filters, e.g. "description | truncate:140".
+	Field string `json:"field"`
+	// Format is an Excel number format for date and amount fields, e.g. "dd/mm/yyyy".
+	Format string  `json:"format,omitempty"`
+	Width  float64 `json:"width,omitempty"`
+}
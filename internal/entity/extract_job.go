@@ -24,4 +24,10 @@ type ExtractJob struct {
 	ExtractedJSON        json.RawMessage `json:"extracted_json,omitempty"`
 	ModelName            *string         `json:"model_name,omitempty"`
 	ModelParams          json.RawMessage `json:"model_params,omitempty"`
+	PromptTokens         *int            `json:"prompt_tokens,omitempty"`
+	CompletionTokens     *int            `json:"completion_tokens,omitempty"`
+	ImageTokens          *int            `json:"image_tokens,omitempty"`
+	CostUSD              *float64        `json:"cost_usd,omitempty"`
+	LLMCacheHit          bool            `json:"llm_cache_hit"`
+	ParseAttempts        json.RawMessage `json:"parse_attempts,omitempty"`
 }
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Merchant represents a merchant directory entry for data transfer between layers.
+type Merchant struct {
+	ID              uuid.UUID `json:"id"`
+	ProfileID       uuid.UUID `json:"profile_id"`
+	Name            string    `json:"name"`
+	Aliases         []string  `json:"aliases,omitempty"`
+	DefaultCategory *string   `json:"default_category,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -4,24 +4,51 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 // Receipt represents a receipt for data transfer between layers.
 type Receipt struct {
-	ID           uuid.UUID  `json:"id"`
-	ProfileID    uuid.UUID  `json:"profile_id"`
-	FileID       *uuid.UUID `json:"file_id,omitempty"`
-	MerchantName string     `json:"merchant_name"`
-	TxDate       time.Time  `json:"tx_date"`
-	Subtotal     *float64   `json:"subtotal,omitempty"`
-	Tax          *float64   `json:"tax,omitempty"`
-	Total        float64    `json:"total"`
-	CurrencyCode string     `json:"currency_code"`
-	CategoryName string     `json:"category_name"`
-	Description  string     `json:"description"`
-	FilePath     *string    `json:"file_path,omitempty"`
-	NeedsReview  bool       `json:"needs_review"`
-	IsCurrent    bool       `json:"is_current"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID           uuid.UUID     `json:"id"`
+	ProfileID    uuid.UUID     `json:"profile_id"`
+	FileID       *uuid.UUID    `json:"file_id,omitempty"`
+	MerchantName string        `json:"merchant_name"` // as extracted
+	MerchantID   *uuid.UUID    `json:"merchant_id,omitempty"`
+	TxDate       time.Time     `json:"tx_date"`
+	Subtotal     *money.Amount `json:"subtotal,omitempty"`
+	Tax          *money.Amount `json:"tax,omitempty"`
+	Discount     *money.Amount `json:"discount,omitempty"`
+	OtherFees    *money.Amount `json:"other_fees,omitempty"`
+	Tip          *money.Amount `json:"tip,omitempty"`
+	Fees         []FeeLine     `json:"fees,omitempty"`
+	Total        money.Amount  `json:"total"`
+	CurrencyCode string        `json:"currency_code"`
+	// Total converted to the profile's default currency; nil when no rate was available.
+	ConvertedTotal    *money.Amount `json:"converted_total,omitempty"`
+	ConvertedCurrency *string       `json:"converted_currency,omitempty"`
+	FxRate            *float64      `json:"fx_rate,omitempty"`
+	FxRateDate        *time.Time    `json:"fx_rate_date,omitempty"`
+	FxSource          *string       `json:"fx_source,omitempty"`
+	CategoryName      string        `json:"category_name"`
+	Description       string        `json:"description"`
+	FilePath          *string       `json:"file_path,omitempty"`
+	NeedsReview       bool          `json:"needs_review"`
+	IsCurrent         bool          `json:"is_current"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// ReceiptProvenance is a current receipt with the file it was read from, the extract jobs
+// run on that file and the receipt versions they replaced.
+type ReceiptProvenance struct {
+	Receipt  *Receipt
+	File     *ReceiptFile
+	Jobs     []*ExtractJob // oldest first
+	Versions []*Receipt    // superseded versions, oldest first
+}
+
+// FeeLine is one itemized non-tax, non-tip surcharge on a receipt.
+type FeeLine struct {
+	Name   string       `json:"name"`
+	Amount money.Amount `json:"amount"`
 }
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// StatementTransaction represents an imported bank or card statement line for data transfer
+// between layers. Charges are positive; payments and refunds are negative.
+type StatementTransaction struct {
+	ID           uuid.UUID    `json:"id"`
+	ProfileID    uuid.UUID    `json:"profile_id"`
+	Account      string       `json:"account"`
+	PostedDate   time.Time    `json:"posted_date"`
+	Description  string       `json:"description"`
+	Amount       money.Amount `json:"amount"`
+	CurrencyCode string       `json:"currency_code"`
+	ExternalID   string       `json:"external_id"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
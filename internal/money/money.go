@@ -0,0 +1,225 @@
+// Package money is an exact decimal amount type for receipt values.
+//
+// Amounts are stored as int64 minor units with a fixed scale of two decimal places,
+// matching the numeric(12,2) columns they are persisted in. Parsing never goes
+// through float64, so "19.99" stays 1999 end to end.
+//
+// Amounts that need more than two decimal places are rejected rather than rounded, and
+// receipts in three-decimal currencies (KWD, BHD, OMR, ...) are flagged for review since
+// their totals cannot be stored exactly (see Exact). Zero-decimal currencies such as JPY
+// carry ".00". The currency code always travels as a separate field.
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places an Amount carries.
+const Scale = 2
+
+const unit = 100 // 10^Scale
+
+// Amount is a signed decimal value in minor units (cents).
+type Amount int64
+
+// ErrInvalid is returned for strings that are not plain decimals.
+var ErrInvalid = errors.New("money: invalid decimal")
+
+// ErrInexact is returned for decimals with non-zero digits past Scale.
+var ErrInexact = errors.New("money: more than two decimal places")
+
+// threeDecimal are ISO 4217 currencies whose minor unit is a thousandth.
+var threeDecimal = map[string]bool{
+	"BHD": true, "IQD": true, "JOD": true, "KWD": true, "LYD": true, "OMR": true, "TND": true,
+}
+
+// Exact reports whether amounts in the currency fit in Scale decimal places. It is false
+// for three-decimal currencies, whose amounts an Amount can only approximate.
+func Exact(currency string) bool {
+	return !threeDecimal[strings.ToUpper(strings.TrimSpace(currency))]
+}
+
+// Parse reads a plain decimal such as "19.99", "-5", "1,302.4" or "(3.50)".
+// Commas, a leading "$" and surrounding spaces are ignored; parentheses mean negative.
+// Digits past two decimal places must be zeros: "1.230" is 1.23, "1.234" is ErrInexact.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		neg = true
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+	if strings.HasPrefix(s, "-") {
+		neg = !neg
+		s = strings.TrimSpace(s[1:])
+	} else if strings.HasPrefix(s, "+") {
+		s = strings.TrimSpace(s[1:])
+	}
+	s = strings.TrimPrefix(s, "$")
+	s = strings.ReplaceAll(s, ",", "")
+	if s == "" {
+		return 0, ErrInvalid
+	}
+
+	intPart, frac, _ := strings.Cut(s, ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if !isDigits(intPart) || (frac != "" && !isDigits(frac)) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+	whole, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || whole > math.MaxInt64/unit-1 {
+		return 0, fmt.Errorf("%w: %q out of range", ErrInvalid, s)
+	}
+
+	var minor int64
+	for i := 0; i < len(frac); i++ {
+		d := int64(frac[i] - '0')
+		if i >= Scale {
+			if d != 0 {
+				return 0, fmt.Errorf("%w: %q", ErrInexact, s)
+			}
+			continue
+		}
+		minor = minor*10 + d
+	}
+	for i := len(frac); i < Scale; i++ {
+		minor *= 10
+	}
+	v := whole*unit + minor
+	if neg {
+		v = -v
+	}
+	return Amount(v), nil
+}
+
+// MustParse is Parse for constants and tests; it panics on invalid input.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseOrZero returns 0 for empty or invalid input.
+func ParseOrZero(s string) Amount {
+	a, _ := Parse(s)
+	return a
+}
+
+// FromFloat converts a float, rounding to the nearest minor unit. Only for boundaries
+// that hand us floats (spreadsheets, SQLite REAL affinity); never for arithmetic.
+func FromFloat(f float64) Amount {
+	return Amount(math.Round(f * unit))
+}
+
+// String formats the amount with exactly two decimals, e.g. "19.99", "-0.50".
+func (a Amount) String() string {
+	v := int64(a)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/unit, v%unit)
+}
+
+// Float64 is for presentation layers that need a number (e.g. spreadsheet cells).
+func (a Amount) Float64() float64 {
+	return float64(a) / unit
+}
+
+// Abs returns the absolute value.
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Percent returns pct percent of the amount, rounded half away from zero to the minor unit.
+func (a Amount) Percent(pct int) Amount {
+	n := int64(a) * int64(pct)
+	q, r := n/100, n%100
+	switch {
+	case r*2 >= 100:
+		q++
+	case r*2 <= -100:
+		q--
+	}
+	return Amount(q)
+}
+
+// Minor returns the amount in minor units.
+func (a Amount) Minor() int64 { return int64(a) }
+
+// Value implements driver.Valuer. Amounts are written as decimal text so Postgres
+// numeric stores them exactly; SQLite's numeric affinity converts them on insert.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan implements sql.Scanner for numeric columns across drivers.
+func (a *Amount) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case int64:
+		*a = Amount(v * unit)
+		return nil
+	case float64:
+		*a = FromFloat(v)
+		return nil
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+}
+
+func (a *Amount) scanString(s string) error {
+	p, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = p
+	return nil
+}
+
+// MarshalJSON writes the amount as a decimal string to avoid float round-trips.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + a.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts either a decimal string or a JSON number.
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" {
+		return nil
+	}
+	p, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = p
+	return nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,164 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Amount
+		wantErr  bool
+	}{
+		{name: "Plain", input: "19.99", expected: 1999},
+		{name: "Whole", input: "42", expected: 4200},
+		{name: "One decimal", input: "42.1", expected: 4210},
+		{name: "Negative", input: "-5.00", expected: -500},
+		{name: "Parentheses negative", input: "(3.50)", expected: -350},
+		{name: "Commas and dollar", input: "$1,302.41", expected: 130241},
+		{name: "Leading dot", input: ".5", expected: 50},
+		{name: "Trailing zeros past two places", input: "12.500", expected: 1250},
+		{name: "Three decimals are not rounded", input: "0.125", wantErr: true},
+		{name: "Three-decimal currency amount", input: "1.234", wantErr: true},
+		{name: "Empty", input: "", wantErr: true},
+		{name: "Garbage", input: "12a.00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %q, got %v", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error for %q: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseInexact(t *testing.T) {
+	if _, err := Parse("12.345"); !errors.Is(err, ErrInexact) {
+		t.Errorf("Expected ErrInexact, got %v", err)
+	}
+}
+
+func TestExact(t *testing.T) {
+	tests := []struct {
+		currency string
+		expected bool
+	}{
+		{"USD", true},
+		{"JPY", true},
+		{"kwd", false},
+		{"BHD", false},
+		{"OMR", false},
+		{"", true},
+	}
+	for _, tt := range tests {
+		if got := Exact(tt.currency); got != tt.expected {
+			t.Errorf("Exact(%q): expected %v, got %v", tt.currency, tt.expected, got)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		amount   Amount
+		expected string
+	}{
+		{1999, "19.99"},
+		{5, "0.05"},
+		{-50, "-0.50"},
+		{0, "0.00"},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+func TestSumIsExact(t *testing.T) {
+	// 0.1 + 0.2 style drift is what float64 totals produced in exports.
+	var sum Amount
+	for _, s := range []string{"9.99", "9.99", "0.01", "0.01"} {
+		sum += MustParse(s)
+	}
+	if got := sum.String(); got != "20.00" {
+		t.Errorf("Expected %q, got %q", "20.00", got)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount   string
+		pct      int
+		expected string
+	}{
+		{"42.18", 50, "21.09"},
+		{"10.01", 50, "5.01"}, // half a cent rounds away from zero
+		{"-10.01", 50, "-5.01"},
+		{"19.99", 100, "19.99"},
+		{"19.99", 0, "0.00"},
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.amount).Percent(tt.pct).String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      any
+		expected Amount
+	}{
+		{name: "Postgres numeric text", src: []byte("19.99"), expected: 1999},
+		{name: "String", src: "0.30", expected: 30},
+		{name: "SQLite real", src: 19.990000000000002, expected: 1999},
+		{name: "SQLite integer", src: int64(20), expected: 2000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Amount
+			if err := a.Scan(tt.src); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if a != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, a)
+			}
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type line struct {
+		Amount   Amount `json:"amount"`
+		Currency string `json:"currency"`
+	}
+	b, err := json.Marshal(line{Amount: 1999, Currency: "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount":"19.99","currency":"USD"}` {
+		t.Errorf("Unexpected JSON %s", b)
+	}
+	var l line
+	if err := json.Unmarshal([]byte(`{"amount":19.99,"currency":"USD"}`), &l); err != nil {
+		t.Fatal(err)
+	}
+	if l.Amount != 1999 {
+		t.Errorf("Expected %d, got %d", 1999, l.Amount)
+	}
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// SpendGroup selects what spending totals are grouped by.
+type SpendGroup string
+
+const (
+	SpendByNone     SpendGroup = ""         // one row for the whole range
+	SpendByCategory SpendGroup = "category" // category name
+	SpendByMerchant SpendGroup = "merchant" // directory name, else the extracted name
+	SpendByMonth    SpendGroup = "month"    // YYYY-MM
+	SpendByQuarter  SpendGroup = "quarter"  // YYYY-Qn
+	SpendByYear     SpendGroup = "year"     // YYYY
+)
+
+// SpendRow is one group of current receipts. Total sums the amounts in the profile
+// currency: the converted total, else the total of receipts already in that currency.
+// Receipts with neither are counted in Receipts but not in Converted.
+type SpendRow struct {
+	Key       string       `sql:"key"`
+	Receipts  int          `sql:"receipts"`
+	Converted int          `sql:"converted"`
+	Total     money.Amount `sql:"total"`
+}
+
+type AnalyticsRepository interface {
+	// SpendBy aggregates a profile's current receipts in the tx_date range (inclusive) in
+	// the database. Rows are ordered by key, or by total (largest first) when limit > 0.
+	SpendBy(ctx context.Context, profileID uuid.UUID, group SpendGroup, from, to *time.Time, limit int) ([]SpendRow, error)
+}
+
+type analyticsRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewAnalyticsRepository(client *ent.Client, logger *slog.Logger) AnalyticsRepository {
+	return &analyticsRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *analyticsRepository) SpendBy(ctx context.Context, profileID uuid.UUID, group SpendGroup, from, to *time.Time, limit int) ([]SpendRow, error) {
+	q := r.client.Receipt.Query().
+		Where(receipt.ProfileID(profileID), receipt.IsCurrent(true))
+	if from != nil {
+		q = q.Where(receipt.TxDateGTE(*from))
+	}
+	if to != nil {
+		q = q.Where(receipt.TxDateLTE(*to))
+	}
+
+	var keyErr error
+	var rows []SpendRow
+	err := q.Modify(func(s *sql.Selector) {
+		key, err := spendKey(s, group)
+		if err != nil {
+			keyErr = err
+			return
+		}
+		p := sql.Table(profile.Table)
+		s.Join(p).On(s.C(receipt.FieldProfileID), p.C(profile.FieldID))
+		converted := fmt.Sprintf("COALESCE(%s, CASE WHEN %s = %s THEN %s END)",
+			s.C(receipt.FieldConvertedTotal), s.C(receipt.FieldCurrencyCode), p.C(profile.FieldDefaultCurrency), s.C(receipt.FieldTotal))
+		s.Select().
+			AppendSelectExprAs(sql.Expr(key), "key").
+			AppendSelectExprAs(sql.Expr("COUNT(*)"), "receipts").
+			AppendSelectExprAs(sql.Expr(fmt.Sprintf("COUNT(%s)", converted)), "converted").
+			AppendSelectExprAs(sql.Expr(fmt.Sprintf("COALESCE(SUM(%s), 0)", converted)), "total")
+		if group != SpendByNone {
+			s.GroupBy(key)
+		}
+		if limit > 0 {
+			s.OrderExpr(sql.Expr(fmt.Sprintf("COALESCE(SUM(%s), 0) DESC", converted)), sql.Expr(key)).Limit(limit)
+		} else {
+			s.OrderExpr(sql.Expr(key))
+		}
+	}).Scan(ctx, &rows)
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	if err != nil {
+		r.logger.Error("failed to aggregate spending", "profile_id", profileID, "group", group, "error", err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// spendKey is the SQL expression a group is keyed by, in the selector's dialect. SQLite
+// keeps dates as text starting with YYYY-MM-DD but not always in a form its date
+// functions parse, so the periods are cut from the text.
+func spendKey(s *sql.Selector, group SpendGroup) (string, error) {
+	date := s.C(receipt.FieldTxDate)
+	postgres := s.Dialect() == dialect.Postgres
+	switch group {
+	case SpendByNone:
+		return "''", nil
+	case SpendByCategory:
+		return s.C(receipt.FieldCategoryName), nil
+	case SpendByMerchant:
+		m := sql.Table(merchant.Table)
+		s.LeftJoin(m).On(s.C(receipt.FieldMerchantID), m.C(merchant.FieldID))
+		return fmt.Sprintf("COALESCE(%s, %s)", m.C(merchant.FieldName), s.C(receipt.FieldMerchantName)), nil
+	case SpendByMonth:
+		if postgres {
+			return fmt.Sprintf("to_char(%s, 'YYYY-MM')", date), nil
+		}
+		return fmt.Sprintf("substr(%s, 1, 7)", date), nil
+	case SpendByQuarter:
+		if postgres {
+			return fmt.Sprintf(`to_char(%s, 'YYYY-"Q"Q')`, date), nil
+		}
+		return fmt.Sprintf("substr(%[1]s, 1, 4) || '-Q' || ((CAST(substr(%[1]s, 6, 2) AS INTEGER) + 2) / 3)", date), nil
+	case SpendByYear:
+		if postgres {
+			return fmt.Sprintf("to_char(%s, 'YYYY')", date), nil
+		}
+		return fmt.Sprintf("substr(%s, 1, 4)", date), nil
+	}
+	return "", fmt.Errorf("unknown spend group %q", group)
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestSpendBy(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewAnalyticsRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Analytics")
+	other := createTestProfile(t, client, "Other")
+	blueBottle, err := client.Merchant.Create().SetProfileID(p.ID).SetName("Blue Bottle Coffee").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	add := func(profileID uuid.UUID, date, merchant string, merchantID *uuid.UUID, category, currency, converted string, current bool) {
+		t.Helper()
+		d, _ := time.Parse("2006-01-02", date)
+		c := client.Receipt.Create().
+			SetProfileID(profileID).
+			SetMerchantName(merchant).
+			SetNillableMerchantID(merchantID).
+			SetTxDate(d).
+			SetTotal(money.MustParse("1.00")).
+			SetCurrencyCode(currency).
+			SetCategoryName(category).
+			SetDescription(merchant).
+			SetIsCurrent(current)
+		if converted != "" {
+			c.SetConvertedTotal(money.MustParse(converted))
+		}
+		if _, err := c.Save(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(p.ID, "2024-12-31", "Blue Bottle", &blueBottle.ID, "Meals", "USD", "5.00", true) // before the range
+	add(p.ID, "2025-01-10", "Blue Bottle", &blueBottle.ID, "Meals", "USD", "10.00", true)
+	add(p.ID, "2025-01-20", "STAPLES #12", nil, "Office Supplies", "USD", "42.18", true)
+	add(p.ID, "2025-02-05", "Blue Bottle", &blueBottle.ID, "Meals", "USD", "6.50", true)
+	add(p.ID, "2025-02-20", "Blue Bottle", &blueBottle.ID, "Meals", "USD", "", true)        // profile currency, not converted: total summed
+	add(p.ID, "2025-04-01", "Air France", nil, "Travel", "EUR", "", true)                   // no rate: counted, not summed
+	add(p.ID, "2025-03-15", "Blue Bottle", &blueBottle.ID, "Meals", "USD", "100.00", false) // superseded
+	add(other.ID, "2025-01-15", "Blue Bottle", nil, "Meals", "USD", "999.00", true)
+
+	from, _ := time.Parse("2006-01-02", "2025-01-01")
+	to, _ := time.Parse("2006-01-02", "2025-04-01")
+	tests := []struct {
+		name     string
+		group    SpendGroup
+		from, to *time.Time
+		limit    int
+		expected string // key:receipts/converted/total, in order
+	}{
+		{name: "Whole range", group: SpendByNone, from: &from, to: &to,
+			expected: ":5/4/59.68"},
+		{name: "Category", group: SpendByCategory, from: &from, to: &to,
+			expected: "Meals:3/3/17.50,Office Supplies:1/1/42.18,Travel:1/0/0.00"},
+		{name: "Merchant uses the directory name", group: SpendByMerchant, from: &from, to: &to,
+			expected: "Air France:1/0/0.00,Blue Bottle Coffee:3/3/17.50,STAPLES #12:1/1/42.18"},
+		{name: "Month", group: SpendByMonth, from: &from, to: &to,
+			expected: "2025-01:2/2/52.18,2025-02:2/2/7.50,2025-04:1/0/0.00"},
+		{name: "Quarter", group: SpendByQuarter, from: &from, to: &to,
+			expected: "2025-Q1:4/4/59.68,2025-Q2:1/0/0.00"},
+		{name: "Year without a range", group: SpendByYear,
+			expected: "2024:1/1/5.00,2025:5/4/59.68"},
+		{name: "Only from", group: SpendByYear, from: &to,
+			expected: "2025:1/0/0.00"},
+		{name: "Only to", group: SpendByMonth, to: &from,
+			expected: "2024-12:1/1/5.00"},
+		{name: "Top by total", group: SpendByCategory, from: &from, to: &to, limit: 2,
+			expected: "Office Supplies:1/1/42.18,Meals:3/3/17.50"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := repo.SpendBy(ctx, p.ID, tt.group, tt.from, tt.to, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := spendRows(rows); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if _, err := repo.SpendBy(ctx, p.ID, SpendGroup("week"), nil, nil, 0); err == nil {
+		t.Error("Expected an error for an unknown group")
+	}
+	rows, err := repo.SpendBy(ctx, createTestProfile(t, client, "Empty").ID, SpendByCategory, nil, nil, 0)
+	if err != nil || len(rows) != 0 {
+		t.Errorf("Expected no rows for a profile without receipts, got %v (%v)", rows, err)
+	}
+}
+
+func spendRows(rows []SpendRow) string {
+	out := make([]string, len(rows))
+	for i, r := range rows {
+		out[i] = fmt.Sprintf("%s:%d/%d/%s", r.Key, r.Receipts, r.Converted, r.Total)
+	}
+	return strings.Join(out, ",")
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptanomaly"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type AnomalyRepository interface {
+	// Record stores a receipt's anomalies, replacing any recorded for it before.
+	Record(ctx context.Context, receiptID, profileID uuid.UUID, anomalies []*entity.ReceiptAnomaly) error
+	// ListByProfile returns the anomalies of the profile's current receipts with tx_date
+	// in the range (inclusive), newest first, with their receipts loaded.
+	ListByProfile(ctx context.Context, profileID uuid.UUID, fromDate, toDate *time.Time, limit int) ([]*entity.ReceiptAnomaly, error)
+}
+
+type anomalyRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewAnomalyRepository(client *ent.Client, logger *slog.Logger) AnomalyRepository {
+	return &anomalyRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *anomalyRepository) Record(ctx context.Context, receiptID, profileID uuid.UUID, anomalies []*entity.ReceiptAnomaly) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ReceiptAnomaly.Delete().Where(receiptanomaly.ReceiptID(receiptID)).Exec(ctx); err != nil {
+		r.logger.Error("failed to clear receipt anomalies", "receipt_id", receiptID, "error", err)
+		return err
+	}
+	builders := make([]*ent.ReceiptAnomalyCreate, len(anomalies))
+	for i, a := range anomalies {
+		builders[i] = tx.ReceiptAnomaly.Create().
+			SetReceiptID(receiptID).
+			SetProfileID(profileID).
+			SetKind(a.Kind).
+			SetReason(a.Reason).
+			SetScore(a.Score)
+	}
+	if len(builders) > 0 {
+		if err := tx.ReceiptAnomaly.CreateBulk(builders...).Exec(ctx); err != nil {
+			r.logger.Error("failed to record receipt anomalies", "receipt_id", receiptID, "error", err)
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *anomalyRepository) ListByProfile(ctx context.Context, profileID uuid.UUID, fromDate, toDate *time.Time, limit int) ([]*entity.ReceiptAnomaly, error) {
+	current := []predicate.Receipt{receipt.IsCurrent(true)}
+	if fromDate != nil {
+		current = append(current, receipt.TxDateGTE(*fromDate))
+	}
+	if toDate != nil {
+		current = append(current, receipt.TxDateLTE(*toDate))
+	}
+	q := r.client.ReceiptAnomaly.Query().
+		Where(receiptanomaly.ProfileID(profileID), receiptanomaly.HasReceiptWith(current...)).
+		WithReceipt().
+		Order(ent.Desc(receiptanomaly.FieldCreatedAt), ent.Asc(receiptanomaly.FieldKind))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list receipt anomalies", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.ReceiptAnomaly, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToReceiptAnomaly(row)
+	}
+	return out, nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budgetalert"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type BudgetRepository interface {
+	ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Budget, error)
+	// ListByCategory returns the profile's budgets for one category (at most one per period).
+	ListByCategory(ctx context.Context, profileID uuid.UUID, categoryName string) ([]*entity.Budget, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error)
+	Create(ctx context.Context, b *entity.Budget) (*entity.Budget, error)
+	Update(ctx context.Context, b *entity.Budget) (*entity.Budget, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RecordAlert stores an alert unless the threshold was already recorded for the budget
+	// period. It returns the stored alert, new or earlier, and whether it was new.
+	RecordAlert(ctx context.Context, a *entity.BudgetAlert) (*entity.BudgetAlert, bool, error)
+	// MarkAlertDelivered records that a notifier accepted the alert.
+	MarkAlertDelivered(ctx context.Context, id uuid.UUID) error
+	// ListAlerts returns a profile's alerts, newest first.
+	ListAlerts(ctx context.Context, profileID uuid.UUID, limit int) ([]*entity.BudgetAlert, error)
+}
+
+type budgetRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewBudgetRepository(client *ent.Client, logger *slog.Logger) BudgetRepository {
+	return &budgetRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *budgetRepository) ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Budget, error) {
+	rows, err := r.client.Budget.Query().
+		Where(budget.ProfileID(profileID)).
+		Order(budget.ByCategoryName(), budget.ByPeriod()).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list budgets", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	return toBudgets(rows), nil
+}
+
+func (r *budgetRepository) ListByCategory(ctx context.Context, profileID uuid.UUID, categoryName string) ([]*entity.Budget, error) {
+	rows, err := r.client.Budget.Query().
+		Where(budget.ProfileID(profileID), budget.CategoryName(categoryName)).
+		Order(budget.ByPeriod()).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list budgets", "profile_id", profileID, "category", categoryName, "error", err)
+		return nil, err
+	}
+	return toBudgets(rows), nil
+}
+
+func (r *budgetRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Budget, error) {
+	row, err := r.client.Budget.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToBudget(row), nil
+}
+
+func (r *budgetRepository) Create(ctx context.Context, b *entity.Budget) (*entity.Budget, error) {
+	row, err := r.client.Budget.Create().
+		SetProfileID(b.ProfileID).
+		SetCategoryName(b.CategoryName).
+		SetPeriod(b.Period).
+		SetAmount(b.Amount).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create budget", "profile_id", b.ProfileID, "category", b.CategoryName, "period", b.Period, "error", err)
+		return nil, err
+	}
+	return tools.ToBudget(row), nil
+}
+
+func (r *budgetRepository) Update(ctx context.Context, b *entity.Budget) (*entity.Budget, error) {
+	row, err := r.client.Budget.UpdateOneID(b.ID).
+		SetCategoryName(b.CategoryName).
+		SetPeriod(b.Period).
+		SetAmount(b.Amount).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to update budget", "budget_id", b.ID, "error", err)
+		return nil, err
+	}
+	return tools.ToBudget(row), nil
+}
+
+func (r *budgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.Budget.DeleteOneID(id).Exec(ctx); err != nil {
+		r.logger.Error("failed to delete budget", "budget_id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (r *budgetRepository) RecordAlert(ctx context.Context, a *entity.BudgetAlert) (*entity.BudgetAlert, bool, error) {
+	row, err := r.client.BudgetAlert.Create().
+		SetBudgetID(a.BudgetID).
+		SetProfileID(a.ProfileID).
+		SetPeriodStart(a.PeriodStart).
+		SetThreshold(a.Threshold).
+		SetSpent(a.Spent).
+		SetBudgetAmount(a.BudgetAmount).
+		SetNillableReceiptID(a.ReceiptID).
+		Save(ctx)
+	if err != nil {
+		if !ent.IsConstraintError(err) {
+			r.logger.Error("failed to record budget alert", "budget_id", a.BudgetID, "threshold", a.Threshold, "error", err)
+			return nil, false, err
+		}
+		existing, err := r.client.BudgetAlert.Query().
+			Where(
+				budgetalert.BudgetID(a.BudgetID),
+				budgetalert.PeriodStart(a.PeriodStart),
+				budgetalert.Threshold(a.Threshold),
+			).
+			WithBudget().
+			Only(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		return tools.ToBudgetAlert(existing), false, nil
+	}
+	stored := tools.ToBudgetAlert(row)
+	stored.CategoryName, stored.Period = a.CategoryName, a.Period
+	return stored, true, nil
+}
+
+func (r *budgetRepository) MarkAlertDelivered(ctx context.Context, id uuid.UUID) error {
+	return r.client.BudgetAlert.UpdateOneID(id).
+		SetDeliveredAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *budgetRepository) ListAlerts(ctx context.Context, profileID uuid.UUID, limit int) ([]*entity.BudgetAlert, error) {
+	q := r.client.BudgetAlert.Query().
+		Where(budgetalert.ProfileID(profileID)).
+		WithBudget().
+		Order(ent.Desc(budgetalert.FieldCreatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list budget alerts", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.BudgetAlert, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToBudgetAlert(row)
+	}
+	return out, nil
+}
+
+func toBudgets(rows []*ent.Budget) []*entity.Budget {
+	out := make([]*entity.Budget, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToBudget(row)
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestRecordAlert(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewBudgetRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Budgets")
+	b, err := repo.Create(ctx, &entity.Budget{ProfileID: p.ID, CategoryName: "Meals", Period: entity.BudgetMonthly, Amount: money.MustParse("500.00")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alert := func(spent string) *entity.BudgetAlert {
+		return &entity.BudgetAlert{BudgetID: b.ID, ProfileID: p.ID, CategoryName: "Meals", Period: entity.BudgetMonthly,
+			PeriodStart: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Threshold: 80, Spent: money.MustParse(spent), BudgetAmount: b.Amount}
+	}
+
+	first, created, err := repo.RecordAlert(ctx, alert("420.00"))
+	if err != nil || !created {
+		t.Fatalf("Expected a new alert, got created=%v error=%v", created, err)
+	}
+	if first.DeliveredAt != nil || first.CategoryName != "Meals" {
+		t.Errorf("Expected an undelivered Meals alert, got %+v", first)
+	}
+
+	// the same threshold in the same period returns the recorded alert
+	again, created, err := repo.RecordAlert(ctx, alert("430.00"))
+	if err != nil || created {
+		t.Fatalf("Expected the existing alert, got created=%v error=%v", created, err)
+	}
+	if again.ID != first.ID || again.Spent.String() != "420.00" || again.Period != entity.BudgetMonthly || again.DeliveredAt != nil {
+		t.Errorf("Expected the recorded alert, got %+v", again)
+	}
+
+	if err := repo.MarkAlertDelivered(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	delivered, _, err := repo.RecordAlert(ctx, alert("440.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivered.DeliveredAt == nil {
+		t.Error("Expected the alert to be marked delivered")
+	}
+}
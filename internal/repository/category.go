@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/budget"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/category"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type CategoryRepository interface {
+	ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Category, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Category, error)
+	Create(ctx context.Context, c *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, c *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// EnsureDefaults seeds the built-in categories for a profile that has none yet.
+	EnsureDefaults(ctx context.Context, profileID uuid.UUID) error
+}
+
+type categoryRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewCategoryRepository(client *ent.Client, logger *slog.Logger) CategoryRepository {
+	return &categoryRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *categoryRepository) ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Category, error) {
+	rows, err := r.client.Category.Query().
+		Where(category.ProfileID(profileID)).
+		Order(category.ByName()).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list categories", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.Category, len(rows))
+	for i, c := range rows {
+		out[i] = tools.ToCategory(c)
+	}
+	return out, nil
+}
+
+func (r *categoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Category, error) {
+	c, err := r.client.Category.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToCategory(c), nil
+}
+
+func (r *categoryRepository) Create(ctx context.Context, c *entity.Category) (*entity.Category, error) {
+	builder := r.client.Category.Create().
+		SetProfileID(c.ProfileID).
+		SetName(c.Name).
+		SetNillableDescription(c.Description).
+		SetNillableTaxLine(c.TaxLine).
+		SetNillableAccount(c.Account)
+	if len(c.Synonyms) > 0 {
+		builder = builder.SetSynonyms(c.Synonyms)
+	}
+	created, err := builder.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create category", "profile_id", c.ProfileID, "name", c.Name, "error", err)
+		return nil, err
+	}
+	return tools.ToCategory(created), nil
+}
+
+// Update replaces the category's attributes. A rename is applied to the profile's
+// receipts, rules, merchants and budgets in the same transaction so existing rows stay in the renamed bucket.
+func (r *categoryRepository) Update(ctx context.Context, c *entity.Category) (*entity.Category, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	existing, err := tx.Category.Get(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	upd := tx.Category.UpdateOne(existing).
+		SetName(c.Name).
+		SetNillableDescription(c.Description).
+		SetNillableTaxLine(c.TaxLine).
+		SetNillableAccount(c.Account)
+	if c.Description == nil {
+		upd = upd.ClearDescription()
+	}
+	if c.TaxLine == nil {
+		upd = upd.ClearTaxLine()
+	}
+	if c.Account == nil {
+		upd = upd.ClearAccount()
+	}
+	if len(c.Synonyms) > 0 {
+		upd = upd.SetSynonyms(c.Synonyms)
+	} else {
+		upd = upd.ClearSynonyms()
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to update category", "category_id", c.ID, "error", err)
+		return nil, err
+	}
+
+	if existing.Name != updated.Name {
+		n, err := tx.Receipt.Update().
+			Where(receipt.ProfileID(existing.ProfileID), receipt.CategoryName(existing.Name)).
+			SetCategoryName(updated.Name).
+			Save(ctx)
+		if err != nil {
+			r.logger.Error("failed to relabel receipts for renamed category", "category_id", c.ID, "error", err)
+			return nil, fmt.Errorf("relabel receipts: %w", err)
+		}
+		if _, err := tx.CategoryRule.Update().
+			Where(categoryrule.ProfileID(existing.ProfileID), categoryrule.SetCategory(existing.Name)).
+			SetSetCategory(updated.Name).
+			Save(ctx); err != nil {
+			r.logger.Error("failed to retarget rules for renamed category", "category_id", c.ID, "error", err)
+			return nil, fmt.Errorf("retarget rules: %w", err)
+		}
+		if _, err := tx.Merchant.Update().
+			Where(merchant.ProfileID(existing.ProfileID), merchant.DefaultCategory(existing.Name)).
+			SetDefaultCategory(updated.Name).
+			Save(ctx); err != nil {
+			r.logger.Error("failed to retarget merchants for renamed category", "category_id", c.ID, "error", err)
+			return nil, fmt.Errorf("retarget merchants: %w", err)
+		}
+		if _, err := tx.Budget.Update().
+			Where(budget.ProfileID(existing.ProfileID), budget.CategoryName(existing.Name)).
+			SetCategoryName(updated.Name).
+			Save(ctx); err != nil {
+			r.logger.Error("failed to retarget budgets for renamed category", "category_id", c.ID, "error", err)
+			return nil, fmt.Errorf("retarget budgets: %w", err)
+		}
+		r.logger.Info("category renamed", "category_id", c.ID, "from", existing.Name, "to", updated.Name, "receipts", n)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tools.ToCategory(updated), nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.Category.DeleteOneID(id).Exec(ctx); err != nil {
+		r.logger.Error("failed to delete category", "category_id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (r *categoryRepository) EnsureDefaults(ctx context.Context, profileID uuid.UUID) error {
+	exists, err := r.client.Category.Query().Where(category.ProfileID(profileID)).Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	defs := constants.DefaultCategoryDefs()
+	builders := make([]*ent.CategoryCreate, 0, len(defs))
+	for _, d := range defs {
+		b := r.client.Category.Create().
+			SetProfileID(profileID).
+			SetName(d.Name).
+			SetDescription(d.Description).
+			SetTaxLine(d.TaxLine)
+		if len(d.Synonyms) > 0 {
+			b = b.SetSynonyms(d.Synonyms)
+		}
+		builders = append(builders, b)
+	}
+	if err := r.client.Category.CreateBulk(builders...).
+		OnConflict(sql.ConflictColumns(category.FieldProfileID, category.FieldName)).
+		DoNothing().
+		Exec(ctx); err != nil {
+		r.logger.Error("failed to seed default categories", "profile_id", profileID, "error", err)
+		return err
+	}
+	r.logger.Info("default categories seeded", "profile_id", profileID, "count", len(builders))
+	return nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categorycorrection"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type CategoryCorrectionRepository interface {
+	// ListRecent returns the profile's corrections, newest first; limit <= 0 means all.
+	ListRecent(ctx context.Context, profileID uuid.UUID, limit int) ([]*entity.CategoryCorrection, error)
+}
+
+type categoryCorrectionRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewCategoryCorrectionRepository(client *ent.Client, logger *slog.Logger) CategoryCorrectionRepository {
+	return &categoryCorrectionRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *categoryCorrectionRepository) ListRecent(ctx context.Context, profileID uuid.UUID, limit int) ([]*entity.CategoryCorrection, error) {
+	q := r.client.CategoryCorrection.Query().
+		Where(categorycorrection.ProfileID(profileID)).
+		Order(ent.Desc(categorycorrection.FieldCreatedAt))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list category corrections", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.CategoryCorrection, len(rows))
+	for i, c := range rows {
+		out[i] = tools.ToCategoryCorrection(c)
+	}
+	return out, nil
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/categoryrule"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type CategoryRuleRepository interface {
+	ListByProfile(ctx context.Context, profileID uuid.UUID, enabledOnly bool) ([]*entity.CategoryRule, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CategoryRule, error)
+	Create(ctx context.Context, r *entity.CategoryRule) (*entity.CategoryRule, error)
+	Update(ctx context.Context, r *entity.CategoryRule) (*entity.CategoryRule, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type categoryRuleRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewCategoryRuleRepository(client *ent.Client, logger *slog.Logger) CategoryRuleRepository {
+	return &categoryRuleRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *categoryRuleRepository) ListByProfile(ctx context.Context, profileID uuid.UUID, enabledOnly bool) ([]*entity.CategoryRule, error) {
+	q := r.client.CategoryRule.Query().Where(categoryrule.ProfileID(profileID))
+	if enabledOnly {
+		q = q.Where(categoryrule.Enabled(true))
+	}
+	rows, err := q.Order(categoryrule.ByPriority(), categoryrule.ByName()).All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list category rules", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.CategoryRule, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToCategoryRule(row)
+	}
+	return out, nil
+}
+
+func (r *categoryRuleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.CategoryRule, error) {
+	row, err := r.client.CategoryRule.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToCategoryRule(row), nil
+}
+
+func (r *categoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) (*entity.CategoryRule, error) {
+	builder := r.client.CategoryRule.Create().
+		SetProfileID(rule.ProfileID).
+		SetName(rule.Name).
+		SetPriority(rule.Priority).
+		SetEnabled(rule.Enabled).
+		SetNillableMerchantPattern(rule.MerchantPattern).
+		SetNillablePathPattern(rule.PathPattern).
+		SetNillableMinTotal(rule.MinTotal).
+		SetNillableMaxTotal(rule.MaxTotal).
+		SetNillableSetCategory(rule.SetCategory).
+		SetForceReview(rule.ForceReview).
+		SetNillableSetDescription(rule.SetDescription)
+	if len(rule.Keywords) > 0 {
+		builder = builder.SetKeywords(rule.Keywords)
+	}
+	row, err := builder.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create category rule", "profile_id", rule.ProfileID, "name", rule.Name, "error", err)
+		return nil, err
+	}
+	return tools.ToCategoryRule(row), nil
+}
+
+// Update replaces every attribute of the rule; nil optionals are cleared.
+func (r *categoryRuleRepository) Update(ctx context.Context, rule *entity.CategoryRule) (*entity.CategoryRule, error) {
+	upd := r.client.CategoryRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetPriority(rule.Priority).
+		SetEnabled(rule.Enabled).
+		SetForceReview(rule.ForceReview)
+
+	if rule.MerchantPattern != nil {
+		upd = upd.SetMerchantPattern(*rule.MerchantPattern)
+	} else {
+		upd = upd.ClearMerchantPattern()
+	}
+	if rule.PathPattern != nil {
+		upd = upd.SetPathPattern(*rule.PathPattern)
+	} else {
+		upd = upd.ClearPathPattern()
+	}
+	if len(rule.Keywords) > 0 {
+		upd = upd.SetKeywords(rule.Keywords)
+	} else {
+		upd = upd.ClearKeywords()
+	}
+	if rule.MinTotal != nil {
+		upd = upd.SetMinTotal(*rule.MinTotal)
+	} else {
+		upd = upd.ClearMinTotal()
+	}
+	if rule.MaxTotal != nil {
+		upd = upd.SetMaxTotal(*rule.MaxTotal)
+	} else {
+		upd = upd.ClearMaxTotal()
+	}
+	if rule.SetCategory != nil {
+		upd = upd.SetSetCategory(*rule.SetCategory)
+	} else {
+		upd = upd.ClearSetCategory()
+	}
+	if rule.SetDescription != nil {
+		upd = upd.SetSetDescription(*rule.SetDescription)
+	} else {
+		upd = upd.ClearSetDescription()
+	}
+
+	row, err := upd.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to update category rule", "rule_id", rule.ID, "error", err)
+		return nil, err
+	}
+	return tools.ToCategoryRule(row), nil
+}
+
+func (r *categoryRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.CategoryRule.DeleteOneID(id).Exec(ctx); err != nil {
+		r.logger.Error("failed to delete category rule", "rule_id", id, "error", err)
+		return err
+	}
+	return nil
+}
@@ -128,10 +128,42 @@ func OpenSQLiteInMemory(log *slog.Logger) (*ent.Client, *sql.DB, error) {
 	return client, db, nil
 }
 
-// MigrateSQLite runs auto-migration with Ent for SQLite (create all tables/indices).
+// MigrateSQLite runs auto-migration with Ent for SQLite (create all tables/indices), then
+// creates the full-text index Ent does not manage.
 func MigrateSQLite(ctx context.Context, c *ent.Client) error {
 	if err := c.Schema.Create(ctx); err != nil {
 		return err
 	}
+	for _, stmt := range sqliteSearchSchema {
+		if _, err := c.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// sqliteSearchSchema is the FTS5 counterpart of the receipts.search_vector column in
+// db/sql/init.sql: one row per receipt with its merchant, description and the OCR text of
+// its file, kept up to date by triggers.
+var sqliteSearchSchema = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS receipts_fts USING fts5(
+		receipt_id UNINDEXED, merchant_name, description, ocr_text, tokenize = 'porter unicode61')`,
+	`CREATE TRIGGER IF NOT EXISTS receipts_fts_insert AFTER INSERT ON receipts BEGIN
+		INSERT INTO receipts_fts (receipt_id, merchant_name, description, ocr_text)
+		VALUES (new.id, new.merchant_name, new.description,
+			(SELECT ocr_text FROM extract_job
+			 WHERE file_id = new.file_id AND ocr_text IS NOT NULL
+			 ORDER BY started_at DESC LIMIT 1));
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS receipts_fts_update AFTER UPDATE OF merchant_name, description ON receipts BEGIN
+		UPDATE receipts_fts SET merchant_name = new.merchant_name, description = new.description
+		WHERE receipt_id = new.id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS receipts_fts_delete AFTER DELETE ON receipts BEGIN
+		DELETE FROM receipts_fts WHERE receipt_id = old.id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS extract_job_fts_ocr AFTER UPDATE OF ocr_text ON extract_job BEGIN
+		UPDATE receipts_fts SET ocr_text = new.ocr_text
+		WHERE receipt_id IN (SELECT id FROM receipts WHERE file_id = new.file_id);
+	END`,
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/exporttemplate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type ExportTemplateRepository interface {
+	ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.ExportTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ExportTemplate, error)
+	GetByName(ctx context.Context, profileID uuid.UUID, name string) (*entity.ExportTemplate, error)
+	Create(ctx context.Context, t *entity.ExportTemplate) (*entity.ExportTemplate, error)
+	Update(ctx context.Context, t *entity.ExportTemplate) (*entity.ExportTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type exportTemplateRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewExportTemplateRepository(client *ent.Client, logger *slog.Logger) ExportTemplateRepository {
+	return &exportTemplateRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *exportTemplateRepository) ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.ExportTemplate, error) {
+	rows, err := r.client.ExportTemplate.Query().
+		Where(exporttemplate.ProfileID(profileID)).
+		Order(exporttemplate.ByName()).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list export templates", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.ExportTemplate, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToExportTemplate(row)
+	}
+	return out, nil
+}
+
+func (r *exportTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ExportTemplate, error) {
+	row, err := r.client.ExportTemplate.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToExportTemplate(row), nil
+}
+
+func (r *exportTemplateRepository) GetByName(ctx context.Context, profileID uuid.UUID, name string) (*entity.ExportTemplate, error) {
+	row, err := r.client.ExportTemplate.Query().
+		Where(exporttemplate.ProfileID(profileID), exporttemplate.Name(name)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToExportTemplate(row), nil
+}
+
+func (r *exportTemplateRepository) Create(ctx context.Context, t *entity.ExportTemplate) (*entity.ExportTemplate, error) {
+	row, err := r.client.ExportTemplate.Create().
+		SetProfileID(t.ProfileID).
+		SetName(t.Name).
+		SetColumns(t.Columns).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create export template", "profile_id", t.ProfileID, "name", t.Name, "error", err)
+		return nil, err
+	}
+	return tools.ToExportTemplate(row), nil
+}
+
+// Update replaces the name and columns of the template.
+func (r *exportTemplateRepository) Update(ctx context.Context, t *entity.ExportTemplate) (*entity.ExportTemplate, error) {
+	row, err := r.client.ExportTemplate.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetColumns(t.Columns).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to update export template", "template_id", t.ID, "error", err)
+		return nil, err
+	}
+	return tools.ToExportTemplate(row), nil
+}
+
+func (r *exportTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.ExportTemplate.DeleteOneID(id).Exec(ctx); err != nil {
+		r.logger.Error("failed to delete export template", "template_id", id, "error", err)
+		return err
+	}
+	return nil
+}
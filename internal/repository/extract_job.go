@@ -22,14 +22,51 @@ type OCROutcome struct {
 	ModelParams  map[string]any // e.g., {"lang":"eng"}
 }
 
+type ParseOutcome struct {
+	ErrorMessage string
+	Fields       llm.ReceiptFields
+	NeedsReview  bool
+	Raw          []byte         // raw model JSON; persisted on failure for debugging
+	ModelName    string         // e.g., "gpt-4o-mini"
+	ModelParams  map[string]any // e.g., {"temperature":0}
+	Usage        llm.Usage      // summed over all attempts
+	CacheHit     bool
+	Attempts     []ParseAttempt
+}
+
+// ParseAttempt records one LLM call of the parse stage. Problem is what was sent back
+// to the model for the next attempt (schema errors or an arithmetic mismatch).
+type ParseAttempt struct {
+	Attempt  int       `json:"attempt"`
+	Path     string    `json:"path,omitempty"`
+	Problem  string    `json:"problem,omitempty"`
+	Error    string    `json:"error,omitempty"`
+	Raw      string    `json:"raw,omitempty"`
+	Usage    llm.Usage `json:"usage"`
+	CacheHit bool      `json:"cache_hit,omitempty"`
+}
+
+// UsageRow is the accounting slice of a parse job used for spend reports.
+type UsageRow struct {
+	ProfileID        uuid.UUID
+	StartedAt        time.Time
+	ModelName        string
+	PromptTokens     int
+	CompletionTokens int
+	ImageTokens      int
+	CostUSD          float64
+	CacheHit         bool
+}
+
 type ExtractJobRepository interface {
 	GetByID(ctx context.Context, jobID uuid.UUID) (*ent.ExtractJob, error)
 	Start(ctx context.Context, fileID, profileID uuid.UUID, format, status string) (*ent.ExtractJob, error)
 	FinishOCR(ctx context.Context, jobID uuid.UUID, outcome OCROutcome) error
 	GetWithFile(ctx context.Context, jobID uuid.UUID) (*ent.ExtractJob, *ent.ReceiptFile, error)
 	SetReceiptID(ctx context.Context, jobID, receiptID uuid.UUID) error
-	FinishParseSuccess(ctx context.Context, jobID uuid.UUID, fields llm.ReceiptFields, needsReview bool, raw []byte, modelParams map[string]any) error
-	FinishParseFailure(ctx context.Context, jobID uuid.UUID, errMsg string, raw []byte) error
+	FinishParseSuccess(ctx context.Context, jobID uuid.UUID, outcome ParseOutcome) error
+	FinishParseFailure(ctx context.Context, jobID uuid.UUID, outcome ParseOutcome) error
+	ListUsage(ctx context.Context, profileID *uuid.UUID, from, to *time.Time) ([]UsageRow, error)
 }
 
 type extractJobRepo struct {
@@ -102,27 +139,90 @@ func (r *extractJobRepo) GetWithFile(ctx context.Context, jobID uuid.UUID) (*ent
 	return job, file, nil
 }
 
-func (r *extractJobRepo) FinishParseSuccess(ctx context.Context, jobID uuid.UUID, fields llm.ReceiptFields, needsReview bool, _ []byte, modelParams map[string]any) error {
-	mp, _ := json.Marshal(modelParams)
-	fb, _ := json.Marshal(fields)
-	return r.ent.ExtractJob.
+func (r *extractJobRepo) FinishParseSuccess(ctx context.Context, jobID uuid.UUID, outcome ParseOutcome) error {
+	mp, _ := json.Marshal(outcome.ModelParams)
+	fb, _ := json.Marshal(outcome.Fields)
+	u := r.ent.ExtractJob.
 		UpdateOneID(jobID).
 		SetStatus("PARSE_OK").
-		SetNeedsReview(needsReview).
-		SetExtractionConfidence(fields.ModelConfidence).
+		SetNeedsReview(outcome.NeedsReview).
+		SetExtractionConfidence(outcome.Fields.ModelConfidence).
 		SetExtractedJSON(fb).
-		SetModelName("openai").
-		SetModelParams(mp).
-		Exec(ctx)
+		SetModelParams(mp)
+	if outcome.ModelName != "" {
+		u.SetModelName(outcome.ModelName)
+	}
+	return setUsage(u, outcome).Exec(ctx)
 }
 
-func (r *extractJobRepo) FinishParseFailure(ctx context.Context, jobID uuid.UUID, errMsg string, raw []byte) error {
-	return r.ent.ExtractJob.
+func (r *extractJobRepo) FinishParseFailure(ctx context.Context, jobID uuid.UUID, outcome ParseOutcome) error {
+	u := r.ent.ExtractJob.
 		UpdateOneID(jobID).
 		SetStatus("PARSE_ERR").
-		SetErrorMessage(errMsg).
-		SetExtractedJSON(raw).
-		Exec(ctx)
+		SetErrorMessage(outcome.ErrorMessage).
+		SetExtractedJSON(outcome.Raw)
+	if outcome.ModelName != "" {
+		u.SetModelName(outcome.ModelName)
+	}
+	return setUsage(u, outcome).Exec(ctx)
+}
+
+// setUsage records token counts, cost and the attempt log. Failed calls are billed
+// too, so this runs on both outcomes; cache hits record zero spend.
+func setUsage(u *ent.ExtractJobUpdateOne, outcome ParseOutcome) *ent.ExtractJobUpdateOne {
+	if len(outcome.Attempts) > 0 {
+		if b, err := json.Marshal(outcome.Attempts); err == nil {
+			u.SetParseAttempts(b)
+		}
+	}
+	return u.
+		SetPromptTokens(outcome.Usage.PromptTokens).
+		SetCompletionTokens(outcome.Usage.CompletionTokens).
+		SetImageTokens(outcome.Usage.ImageTokens).
+		SetCostUsd(outcome.Usage.CostUSD).
+		SetLlmCacheHit(outcome.CacheHit)
+}
+
+// ListUsage returns accounting rows for jobs that reached the parse stage,
+// optionally filtered by profile and by started_at day range (inclusive).
+func (r *extractJobRepo) ListUsage(ctx context.Context, profileID *uuid.UUID, from, to *time.Time) ([]UsageRow, error) {
+	q := r.ent.ExtractJob.Query().
+		Where(extractjob.Or(extractjob.PromptTokensNotNil(), extractjob.LlmCacheHit(true)))
+	if profileID != nil {
+		q = q.Where(extractjob.ProfileID(*profileID))
+	}
+	if from != nil {
+		q = q.Where(extractjob.StartedAtGTE(*from))
+	}
+	if to != nil {
+		q = q.Where(extractjob.StartedAtLT(to.AddDate(0, 0, 1)))
+	}
+	jobs, err := q.Order(ent.Asc(extractjob.FieldStartedAt)).All(ctx)
+	if err != nil {
+		r.logger.Error("list usage failed", "profile_id", profileID, "err", err)
+		return nil, err
+	}
+	out := make([]UsageRow, 0, len(jobs))
+	for _, j := range jobs {
+		out = append(out, UsageRow{
+			ProfileID:        j.ProfileID,
+			StartedAt:        j.StartedAt,
+			ModelName:        derefOr(j.ModelName, ""),
+			PromptTokens:     derefOr(j.PromptTokens, 0),
+			CompletionTokens: derefOr(j.CompletionTokens, 0),
+			ImageTokens:      derefOr(j.ImageTokens, 0),
+			CostUSD:          derefOr(j.CostUsd, 0),
+			CacheHit:         j.LlmCacheHit,
+		})
+	}
+	return out, nil
+}
+
+func derefOr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
 }
 
 func (r *extractJobRepo) SetReceiptID(ctx context.Context, jobID, receiptID uuid.UUID) error {
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/fxrate"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
+)
+
+// fxUpsertBatch keeps bulk inserts well under driver parameter limits.
+const fxUpsertBatch = 500
+
+type FxRateRepository interface {
+	// UpsertRates inserts rates, replacing any existing rate for the same pair and date.
+	UpsertRates(ctx context.Context, rates []fx.Rate) (int, error)
+	// RateOnOrBefore implements fx.RateStore.
+	RateOnOrBefore(ctx context.Context, base, quote string, date, minDate time.Time) (*fx.Rate, error)
+}
+
+type fxRateRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewFxRateRepository(client *ent.Client, logger *slog.Logger) FxRateRepository {
+	return &fxRateRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *fxRateRepository) UpsertRates(ctx context.Context, rates []fx.Rate) (int, error) {
+	for start := 0; start < len(rates); start += fxUpsertBatch {
+		end := min(start+fxUpsertBatch, len(rates))
+		builders := make([]*ent.FxRateCreate, 0, end-start)
+		for _, rt := range rates[start:end] {
+			builders = append(builders, r.client.FxRate.Create().
+				SetBase(rt.Base).
+				SetQuote(rt.Quote).
+				SetRateDate(dateOnly(rt.Date)).
+				SetRate(rt.Rate).
+				SetSource(rt.Source))
+		}
+		err := r.client.FxRate.CreateBulk(builders...).
+			OnConflict(sql.ConflictColumns(fxrate.FieldBase, fxrate.FieldQuote, fxrate.FieldRateDate)).
+			Update(func(u *ent.FxRateUpsert) {
+				u.UpdateRate()
+				u.UpdateSource()
+			}).
+			Exec(ctx)
+		if err != nil {
+			r.logger.Error("failed to upsert fx rates", "batch_start", start, "error", err)
+			return start, err
+		}
+	}
+	r.logger.Info("fx rates upserted", "count", len(rates))
+	return len(rates), nil
+}
+
+func (r *fxRateRepository) RateOnOrBefore(ctx context.Context, base, quote string, date, minDate time.Time) (*fx.Rate, error) {
+	row, err := r.client.FxRate.Query().
+		Where(
+			fxrate.Base(base),
+			fxrate.Quote(quote),
+			fxrate.RateDateLTE(dateOnly(date)),
+			fxrate.RateDateGTE(dateOnly(minDate)),
+		).
+		Order(ent.Desc(fxrate.FieldRateDate)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &fx.Rate{Date: row.RateDate, Base: row.Base, Quote: row.Quote, Rate: row.Rate, Source: row.Source}, nil
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,383 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type MerchantRepository interface {
+	ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Merchant, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Merchant, error)
+	Create(ctx context.Context, m *entity.Merchant) (*entity.Merchant, error)
+	// Update replaces name, aliases and default category.
+	Update(ctx context.Context, m *entity.Merchant) (*entity.Merchant, error)
+	// Merge moves the sources' receipts and names onto the target and deletes the sources.
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*entity.Merchant, int, error)
+	// Split moves aliases of a merchant, and the receipts extracted under those names, to a
+	// new merchant.
+	Split(ctx context.Context, id uuid.UUID, aliases []string, created *entity.Merchant) (*entity.Merchant, *entity.Merchant, int, error)
+	// Backfill links the profile's receipts stored without a merchant to the directory and
+	// fills in lookup keys missing on older merchants. It returns the receipts linked.
+	Backfill(ctx context.Context, profileID uuid.UUID) (int, error)
+}
+
+type merchantRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewMerchantRepository(client *ent.Client, logger *slog.Logger) MerchantRepository {
+	return &merchantRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *merchantRepository) ListByProfile(ctx context.Context, profileID uuid.UUID) ([]*entity.Merchant, error) {
+	rows, err := r.client.Merchant.Query().
+		Where(merchant.ProfileID(profileID)).
+		Order(merchant.ByName()).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list merchants", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.Merchant, len(rows))
+	for i, m := range rows {
+		out[i] = tools.ToMerchant(m)
+	}
+	return out, nil
+}
+
+func (r *merchantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Merchant, error) {
+	m, err := r.client.Merchant.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return tools.ToMerchant(m), nil
+}
+
+func (r *merchantRepository) Create(ctx context.Context, m *entity.Merchant) (*entity.Merchant, error) {
+	aliases := mergeAliases(m.Name, m.Aliases)
+	created, err := r.client.Merchant.Create().
+		SetProfileID(m.ProfileID).
+		SetName(m.Name).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(m.Name, aliases)).
+		SetNillableDefaultCategory(m.DefaultCategory).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create merchant", "profile_id", m.ProfileID, "name", m.Name, "error", err)
+		return nil, err
+	}
+	return tools.ToMerchant(created), nil
+}
+
+func (r *merchantRepository) Update(ctx context.Context, m *entity.Merchant) (*entity.Merchant, error) {
+	aliases := mergeAliases(m.Name, m.Aliases)
+	upd := r.client.Merchant.UpdateOneID(m.ID).
+		SetName(m.Name).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(m.Name, aliases)).
+		SetNillableDefaultCategory(m.DefaultCategory)
+	if m.DefaultCategory == nil {
+		upd = upd.ClearDefaultCategory()
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to update merchant", "merchant_id", m.ID, "error", err)
+		return nil, err
+	}
+	return tools.ToMerchant(updated), nil
+}
+
+func (r *merchantRepository) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) (*entity.Merchant, int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	target, err := tx.Merchant.Get(ctx, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sources, err := tx.Merchant.Query().
+		Where(merchant.IDIn(sourceIDs...), merchant.ProfileID(target.ProfileID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(sources) != len(sourceIDs) {
+		return nil, 0, fmt.Errorf("merge: %d of %d source merchants not found in profile", len(sourceIDs)-len(sources), len(sourceIDs))
+	}
+
+	lists := [][]string{target.Aliases}
+	for _, s := range sources {
+		lists = append(lists, []string{s.Name}, s.Aliases)
+	}
+	moved, err := tx.Receipt.Update().
+		Where(receipt.MerchantIDIn(sourceIDs...)).
+		SetMerchantID(target.ID).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to move receipts to merged merchant", "merchant_id", targetID, "error", err)
+		return nil, 0, fmt.Errorf("move receipts: %w", err)
+	}
+	if _, err := tx.Merchant.Delete().Where(merchant.IDIn(sourceIDs...)).Exec(ctx); err != nil {
+		return nil, 0, fmt.Errorf("delete merged merchants: %w", err)
+	}
+	aliases := mergeAliases(target.Name, lists...)
+	updated, err := tx.Merchant.UpdateOne(target).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(target.Name, aliases)).
+		Save(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	r.logger.Info("merchants merged", "merchant_id", targetID, "sources", len(sources), "receipts_moved", moved)
+	return tools.ToMerchant(updated), moved, nil
+}
+
+func (r *merchantRepository) Split(ctx context.Context, id uuid.UUID, aliases []string, created *entity.Merchant) (*entity.Merchant, *entity.Merchant, int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	original, err := tx.Merchant.Get(ctx, id)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	split := map[string]bool{}
+	for _, a := range aliases {
+		split[merchantname.Key(a)] = true
+	}
+	var kept []string
+	for _, a := range original.Aliases {
+		if !split[merchantname.Key(a)] {
+			kept = append(kept, a)
+		}
+	}
+
+	kept = mergeAliases(original.Name, kept)
+	updated, err := tx.Merchant.UpdateOne(original).
+		SetAliases(kept).
+		SetKeys(merchantKeys(original.Name, kept)).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	aliases = mergeAliases(created.Name, aliases)
+	newMerchant, err := tx.Merchant.Create().
+		SetProfileID(original.ProfileID).
+		SetName(created.Name).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(created.Name, aliases)).
+		SetNillableDefaultCategory(created.DefaultCategory).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to create split merchant", "merchant_id", id, "name", created.Name, "error", err)
+		return nil, nil, 0, err
+	}
+
+	recs, err := tx.Receipt.Query().
+		Where(receipt.MerchantID(original.ID)).
+		Select(receipt.FieldID, receipt.FieldMerchantName).
+		All(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	var ids []uuid.UUID
+	for _, rec := range recs {
+		if split[merchantname.Key(rec.MerchantName)] {
+			ids = append(ids, rec.ID)
+		}
+	}
+	moved := 0
+	if len(ids) > 0 {
+		if moved, err = tx.Receipt.Update().
+			Where(receipt.IDIn(ids...)).
+			SetMerchantID(newMerchant.ID).
+			Save(ctx); err != nil {
+			return nil, nil, 0, fmt.Errorf("move receipts: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, 0, err
+	}
+	r.logger.Info("merchant split", "merchant_id", id, "new_merchant_id", newMerchant.ID, "receipts_moved", moved)
+	return tools.ToMerchant(updated), tools.ToMerchant(newMerchant), moved, nil
+}
+
+func (r *merchantRepository) Backfill(ctx context.Context, profileID uuid.UUID) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stale, err := tx.Merchant.Query().
+		Where(merchant.ProfileID(profileID), merchant.KeysIsNil()).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range stale {
+		if _, err := tx.Merchant.UpdateOne(m).SetKeys(merchantKeys(m.Name, m.Aliases)).Save(ctx); err != nil {
+			return 0, fmt.Errorf("set keys of merchant %s: %w", m.ID, err)
+		}
+	}
+
+	recs, err := tx.Receipt.Query().
+		Where(receipt.ProfileID(profileID), receipt.MerchantIDIsNil(), receipt.MerchantNameNEQ("")).
+		Select(receipt.FieldID, receipt.FieldMerchantName).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var names []string
+	byName := map[string][]uuid.UUID{}
+	for _, rec := range recs {
+		if _, ok := byName[rec.MerchantName]; !ok {
+			names = append(names, rec.MerchantName)
+		}
+		byName[rec.MerchantName] = append(byName[rec.MerchantName], rec.ID)
+	}
+	linked := 0
+	for _, name := range names {
+		m, err := resolveMerchant(ctx, tx, profileID, name)
+		if err != nil {
+			return 0, fmt.Errorf("resolve merchant %q: %w", name, err)
+		}
+		n, err := tx.Receipt.Update().
+			Where(receipt.IDIn(byName[name]...)).
+			SetMerchantID(m.ID).
+			Save(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("link receipts: %w", err)
+		}
+		linked += n
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	r.logger.Info("merchants backfilled", "profile_id", profileID, "merchants_keyed", len(stale), "receipts_linked", linked)
+	return linked, nil
+}
+
+// resolveMerchant finds the profile's merchant for a raw receipt name, recording the name
+// as a new alias when it is a new variant, or creates a merchant for it. A name whose
+// normalized key is already known is found without loading the directory; the fuzzy match
+// only runs on a miss.
+func resolveMerchant(ctx context.Context, tx *ent.Tx, profileID uuid.UUID, raw string) (*ent.Merchant, error) {
+	if key := merchantname.Key(raw); key != "" {
+		m, err := tx.Merchant.Query().
+			Where(merchant.ProfileID(profileID), func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(merchant.FieldKeys, key))
+			}).
+			First(ctx)
+		if err == nil {
+			return withAlias(ctx, tx, m, raw)
+		}
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	rows, err := tx.Merchant.Query().Where(merchant.ProfileID(profileID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*entity.Merchant, len(rows))
+	byID := make(map[uuid.UUID]*ent.Merchant, len(rows))
+	for i, m := range rows {
+		list[i] = tools.ToMerchant(m)
+		byID[m.ID] = m
+	}
+	if match, _ := merchantname.Match(raw, list); match != nil {
+		return withAlias(ctx, tx, byID[match.ID], raw)
+	}
+
+	// Parallel workers may create the same merchant; the loser reuses the winner's row.
+	name := merchantname.Canonical(raw)
+	aliases := mergeAliases(name, []string{raw})
+	id, err := tx.Merchant.Create().
+		SetProfileID(profileID).
+		SetName(name).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(name, aliases)).
+		OnConflictColumns(merchant.FieldProfileID, merchant.FieldName).
+		Ignore().
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Merchant.Get(ctx, id)
+}
+
+// withAlias records raw as an alias of m when it is a new variant, and fills in the lookup
+// keys of merchants stored before keys existed.
+func withAlias(ctx context.Context, tx *ent.Tx, m *ent.Merchant, raw string) (*ent.Merchant, error) {
+	if len(m.Keys) > 0 && merchantname.HasName(tools.ToMerchant(m), raw) {
+		return m, nil
+	}
+	aliases := mergeAliases(m.Name, m.Aliases, []string{merchantname.Clean(raw)})
+	return tx.Merchant.UpdateOne(m).
+		SetAliases(aliases).
+		SetKeys(merchantKeys(m.Name, aliases)).
+		Save(ctx)
+}
+
+// merchantKeys returns the distinct normalized keys of a name and its aliases.
+func merchantKeys(name string, aliases []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, n := range append([]string{name}, aliases...) {
+		k := merchantname.Key(n)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		out = append(out, k)
+	}
+	return out
+}
+
+// mergeAliases concatenates alias lists, dropping blanks, case-insensitive duplicates and
+// the canonical name itself.
+func mergeAliases(name string, lists ...[]string) []string {
+	seen := map[string]bool{strings.ToLower(merchantname.Clean(name)): true}
+	var out []string
+	for _, l := range lists {
+		for _, a := range l {
+			a = merchantname.Clean(a)
+			if a == "" || seen[strings.ToLower(a)] {
+				continue
+			}
+			seen[strings.ToLower(a)] = true
+			out = append(out, a)
+		}
+	}
+	return out
+}
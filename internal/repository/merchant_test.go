@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// resolve runs resolveMerchant in its own transaction.
+func resolve(t *testing.T, client *ent.Client, profileID uuid.UUID, raw string) *ent.Merchant {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := resolveMerchant(ctx, tx, profileID, raw)
+	if err != nil {
+		_ = tx.Rollback()
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestResolveMerchant(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	p := createTestProfile(t, client, "Merchants")
+	repo := NewMerchantRepository(client, slog.Default())
+
+	starbucks, err := repo.Create(ctx, &entity.Merchant{ProfileID: p.ID, Name: "Starbucks"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a key no fuzzy match would find proves the lookup goes by key
+	keyed, err := client.Merchant.Create().SetProfileID(p.ID).SetName("Acme").SetKeys([]string{"zzq"}).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stored before keys existed
+	legacy, err := client.Merchant.Create().SetProfileID(p.ID).SetName("Blue Bottle").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		raw      string
+		expected uuid.UUID // uuid.Nil = a new merchant
+		key      string    // expected among the merchant's keys afterwards
+	}{
+		{name: "Key hit", raw: "ZZQ", expected: keyed.ID, key: "zzq"},
+		{name: "Key hit on the name", raw: "STARBUCKS", expected: starbucks.ID, key: "starbucks"},
+		{name: "Fuzzy match on a miss", raw: "Starbuks", expected: starbucks.ID, key: "starbuks"},
+		{name: "Older merchant gets keys", raw: "Blue Bottle", expected: legacy.ID, key: "blue bottle"},
+		{name: "No match creates a merchant", raw: "Home Depot", expected: uuid.Nil, key: "home depot"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := resolve(t, client, p.ID, tt.raw)
+			if tt.expected != uuid.Nil && m.ID != tt.expected {
+				t.Errorf("Expected merchant %s, got %s (%q)", tt.expected, m.ID, m.Name)
+			}
+			if tt.expected == uuid.Nil && (m.ID == starbucks.ID || m.ID == keyed.ID || m.ID == legacy.ID) {
+				t.Errorf("Expected a new merchant, got %q", m.Name)
+			}
+			found := false
+			for _, k := range m.Keys {
+				found = found || k == tt.key
+			}
+			if !found {
+				t.Errorf("Expected key %q, got %v", tt.key, m.Keys)
+			}
+		})
+	}
+
+	// a new variant found by fuzzy match is recorded, so the next lookup is a key hit
+	again := resolve(t, client, p.ID, "Starbuks")
+	if len(again.Aliases) != 1 || again.Aliases[0] != "Starbuks" {
+		t.Errorf("Expected the variant recorded as an alias, got %v", again.Aliases)
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	receipts := NewReceiptRepository(client, slog.Default())
+	merchants := NewMerchantRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Backfill")
+	other := createTestProfile(t, client, "Untouched")
+
+	for i, name := range []string{"AMZN Mktp US", "Amazon.com", "Blue Bottle"} {
+		file := createTestFile(t, client, p.ID, name+".pdf")
+		if _, err := receipts.UpsertFromFields(ctx, &CreateReceiptRequest{
+			File:          file,
+			JobID:         uuid.New(),
+			ReceiptFields: llm.ReceiptFields{MerchantName: name, TxDate: fmt.Sprintf("2025-03-%02d", 10+i), Total: "10.00", CurrencyCode: "USD", Description: "Supplies"},
+			CategoryName:  "Office Supplies",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	otherFile := createTestFile(t, client, other.ID, "other.pdf")
+	if _, err := receipts.UpsertFromFields(ctx, &CreateReceiptRequest{
+		File:          otherFile,
+		JobID:         uuid.New(),
+		ReceiptFields: llm.ReceiptFields{MerchantName: "Amazon", TxDate: "2025-03-10", Total: "10.00", CurrencyCode: "USD", Description: "Supplies"},
+		CategoryName:  "Office Supplies",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// back to the state before the directory: no links, one merchant without keys
+	if _, err := client.Receipt.Update().ClearMerchantID().Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Merchant.Delete().Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	amazon, err := client.Merchant.Create().SetProfileID(p.ID).SetName("Amazon").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	linked, err := merchants.Backfill(ctx, p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if linked != 3 {
+		t.Errorf("Expected 3 receipts linked, got %d", linked)
+	}
+
+	recs, err := client.Receipt.Query().Where(receipt.ProfileID(p.ID)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range recs {
+		if rec.MerchantID == nil {
+			t.Fatalf("Expected %q linked, got no merchant", rec.MerchantName)
+		}
+		if (rec.MerchantName == "Blue Bottle") == (*rec.MerchantID == amazon.ID) {
+			t.Errorf("Expected %q linked to its merchant, got %s", rec.MerchantName, *rec.MerchantID)
+		}
+	}
+	amazon, err = client.Merchant.Get(ctx, amazon.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(amazon.Keys) == 0 {
+		t.Error("Expected the older merchant to get keys")
+	}
+
+	// other profiles are left alone, and a second pass has nothing to do
+	if n, err := client.Receipt.Query().Where(receipt.ProfileID(other.ID), receipt.MerchantIDIsNil()).Count(ctx); err != nil || n != 1 {
+		t.Errorf("Expected the other profile's receipt untouched, got %d (%v)", n, err)
+	}
+	if linked, err := merchants.Backfill(ctx, p.ID); err != nil || linked != 0 {
+		t.Errorf("Expected nothing left to link, got %d (%v)", linked, err)
+	}
+}
+
+func TestUpsertFromFieldsMerchantDefaultCategory(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewReceiptRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Defaults")
+	office := "Office Supplies"
+	if _, err := NewMerchantRepository(client, slog.Default()).Create(ctx, &entity.Merchant{ProfileID: p.ID, Name: "Staples", DefaultCategory: &office}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		known    bool
+		expected string
+	}{
+		{name: "Known category is kept", category: "Meals", known: true, expected: "Meals"},
+		{name: "Unknown category gets the default", category: "Other", known: false, expected: office},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := createTestFile(t, client, p.ID, tt.name+".pdf")
+			rec, err := repo.UpsertFromFields(ctx, &CreateReceiptRequest{
+				File:          file,
+				JobID:         uuid.New(),
+				ReceiptFields: llm.ReceiptFields{MerchantName: "STAPLES #123", TxDate: fmt.Sprintf("2025-03-%02d", 10+i), Total: "12.00", CurrencyCode: "USD", Description: "Paper"},
+				CategoryName:  tt.category,
+				CategoryKnown: tt.known,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.CategoryName != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, rec.CategoryName)
+			}
+		})
+	}
+}
@@ -2,15 +2,22 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
-	"strconv"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/predicate"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receiptfile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
 	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
 	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
 )
 
@@ -20,6 +27,8 @@ type CreateReceiptRequest struct {
 	JobID         uuid.UUID
 	ReceiptFields llm.ReceiptFields
 	CategoryName  string
+	CategoryKnown bool           // a profile category or set by a rule; otherwise the merchant's default category is used
+	Conversion    *fx.Conversion // total in the profile currency; nil when no rate was available
 }
 
 type ReceiptRepository interface {
@@ -27,6 +36,19 @@ type ReceiptRepository interface {
 	UpsertFromFields(ctx context.Context, request *CreateReceiptRequest) (*entity.Receipt, error)
 	// GetCurrentByFileID fetches the current receipt by file_id
 	GetCurrentByFileID(ctx context.Context, fileID uuid.UUID) (*entity.Receipt, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Receipt, error)
+	// ListComparable returns up to limit of the profile's current receipts, newest first,
+	// at the merchant (by directory entry or name, case-insensitively) or in the category.
+	ListComparable(ctx context.Context, profileID uuid.UUID, merchantID *uuid.UUID, merchantName, categoryName string, limit int) ([]*entity.Receipt, error)
+	// ListProvenance pages through the current receipts like ListReceipts (ordered by
+	// tx_date, then id) and loads each one's file, extract jobs and superseded versions.
+	ListProvenance(ctx context.Context, profileID uuid.UUID, fromDate, toDate *time.Time, offset, limit int) ([]*entity.ReceiptProvenance, error)
+	// Search ranks the profile's current receipts against a full-text query over merchant
+	// names, descriptions and OCR text; see receipt_search.go.
+	Search(ctx context.Context, profileID uuid.UUID, filter SearchFilter) ([]SearchHit, error)
+	// Recategorize moves a receipt to another category and records the correction.
+	// The correction is nil when the category did not change.
+	Recategorize(ctx context.Context, id uuid.UUID, categoryName string) (*entity.Receipt, *entity.CategoryCorrection, error)
 }
 
 type receiptRepository struct {
@@ -75,6 +97,94 @@ func (r *receiptRepository) ListReceipts(ctx context.Context, profileID uuid.UUI
 	return result, nil
 }
 
+func (r *receiptRepository) ListProvenance(ctx context.Context, profileID uuid.UUID, fromDate, toDate *time.Time, offset, limit int) ([]*entity.ReceiptProvenance, error) {
+	q := r.client.Receipt.Query().
+		Where(
+			receipt.ProfileID(profileID),
+			receipt.IsCurrent(true),
+		).
+		WithJobs()
+	if fromDate != nil {
+		q = q.Where(receipt.TxDateGTE(*fromDate))
+	}
+	if toDate != nil {
+		q = q.Where(receipt.TxDateLTE(*toDate))
+	}
+	recs, err := q.Order(receipt.ByTxDate(), receipt.ByID()).Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list receipts", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+
+	var fileIDs []uuid.UUID
+	for _, rec := range recs {
+		if rec.FileID != nil {
+			fileIDs = append(fileIDs, *rec.FileID)
+		}
+	}
+
+	files := map[uuid.UUID]*entity.ReceiptFile{}
+	jobs := map[uuid.UUID][]*entity.ExtractJob{}
+	versions := map[uuid.UUID][]*entity.Receipt{}
+	if len(fileIDs) > 0 {
+		fs, err := r.client.ReceiptFile.Query().Where(receiptfile.IDIn(fileIDs...)).All(ctx)
+		if err != nil {
+			r.logger.Error("failed to load receipt files", "profile_id", profileID, "error", err)
+			return nil, err
+		}
+		for _, f := range fs {
+			files[f.ID] = tools.ToReceiptFile(f)
+		}
+
+		js, err := r.client.ExtractJob.Query().
+			Where(extractjob.FileIDIn(fileIDs...)).
+			Order(extractjob.ByStartedAt(), extractjob.ByID()).
+			All(ctx)
+		if err != nil {
+			r.logger.Error("failed to load extract jobs", "profile_id", profileID, "error", err)
+			return nil, err
+		}
+		for _, j := range js {
+			jobs[j.FileID] = append(jobs[j.FileID], tools.ToExtractJob(j))
+		}
+
+		vs, err := r.client.Receipt.Query().
+			Where(receipt.FileIDIn(fileIDs...), receipt.IsCurrent(false)).
+			Order(receipt.ByCreatedAt(), receipt.ByID()).
+			All(ctx)
+		if err != nil {
+			r.logger.Error("failed to load receipt versions", "profile_id", profileID, "error", err)
+			return nil, err
+		}
+		for _, v := range vs {
+			versions[*v.FileID] = append(versions[*v.FileID], tools.ToReceipt(v))
+		}
+	}
+
+	result := make([]*entity.ReceiptProvenance, len(recs))
+	for i, rec := range recs {
+		p := &entity.ReceiptProvenance{Receipt: tools.ToReceipt(rec)}
+		if rec.FileID != nil {
+			p.File = files[*rec.FileID]
+			p.Jobs = jobs[*rec.FileID]
+			p.Versions = versions[*rec.FileID]
+		} else {
+			// Receipts created without a file only know the jobs that wrote them.
+			for _, j := range rec.Edges.Jobs {
+				p.Jobs = append(p.Jobs, tools.ToExtractJob(j))
+			}
+		}
+		for _, j := range rec.Edges.Jobs {
+			if j.NeedsReview {
+				p.Receipt.NeedsReview = true
+				break
+			}
+		}
+		result[i] = p
+	}
+	return result, nil
+}
+
 func (r *receiptRepository) GetCurrentByFileID(ctx context.Context, fileID uuid.UUID) (*entity.Receipt, error) {
 	rec, err := r.client.Receipt.Query().
 		Where(
@@ -91,6 +201,84 @@ func (r *receiptRepository) GetCurrentByFileID(ctx context.Context, fileID uuid.
 	return tools.ToReceipt(rec), nil
 }
 
+func (r *receiptRepository) ListComparable(ctx context.Context, profileID uuid.UUID, merchantID *uuid.UUID, merchantName, categoryName string, limit int) ([]*entity.Receipt, error) {
+	match := []predicate.Receipt{receipt.CategoryName(categoryName)}
+	if merchantName != "" {
+		match = append(match, receipt.MerchantNameEqualFold(merchantName))
+	}
+	if merchantID != nil {
+		match = append(match, receipt.MerchantID(*merchantID))
+	}
+	q := r.client.Receipt.Query().
+		Where(receipt.ProfileID(profileID), receipt.IsCurrent(true), receipt.Or(match...)).
+		Order(receipt.ByTxDate(sql.OrderDesc()), receipt.ByID())
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	recs, err := q.All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list comparable receipts", "profile_id", profileID, "merchant", merchantName, "category", categoryName, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.Receipt, len(recs))
+	for i, rec := range recs {
+		out[i] = tools.ToReceipt(rec)
+	}
+	return out, nil
+}
+
+func (r *receiptRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Receipt, error) {
+	rec, err := r.client.Receipt.Get(ctx, id)
+	if err != nil {
+		r.logger.Error("failed to fetch receipt", "receipt_id", id, "error", err)
+		return nil, err
+	}
+	return tools.ToReceipt(rec), nil
+}
+
+func (r *receiptRepository) Recategorize(ctx context.Context, id uuid.UUID, categoryName string) (*entity.Receipt, *entity.CategoryCorrection, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rec, err := tx.Receipt.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rec.CategoryName == categoryName {
+		return tools.ToReceipt(rec), nil, nil
+	}
+
+	updated, err := tx.Receipt.UpdateOne(rec).
+		SetCategoryName(categoryName).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to recategorize receipt", "receipt_id", id, "error", err)
+		return nil, nil, err
+	}
+	create := tx.CategoryCorrection.Create().
+		SetProfileID(rec.ProfileID).
+		SetReceiptID(rec.ID).
+		SetMerchantName(rec.MerchantName).
+		SetFromCategory(rec.CategoryName).
+		SetToCategory(categoryName)
+	if rec.Description != "" {
+		create = create.SetDescription(rec.Description)
+	}
+	corr, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("failed to record category correction", "receipt_id", id, "error", err)
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return tools.ToReceipt(updated), tools.ToCategoryCorrection(corr), nil
+}
+
 // UpsertFromFields creates a new receipt version, demoting any existing current receipts
 // for the same logical receipt within a transaction for atomic de-duplication
 func (r *receiptRepository) UpsertFromFields(ctx context.Context, request *CreateReceiptRequest) (*entity.Receipt, error) {
@@ -104,18 +292,28 @@ func (r *receiptRepository) UpsertFromFields(ctx context.Context, request *Creat
 	}
 
 	// convert money fields
-	dec := func(s string) *float64 {
+	dec := func(s string) *money.Amount {
 		if s == "" {
 			return nil
 		}
-		v, err := strconv.ParseFloat(s, 64)
+		v, err := money.Parse(s)
 		if err != nil {
 			return nil
 		}
 		return &v
 	}
 
-	total := *dec(f.Total)
+	total, err := money.Parse(f.Total)
+	if err != nil {
+		return nil, fmt.Errorf("parse total %q: %w", f.Total, err)
+	}
+	absPtr := func(v *money.Amount) *money.Amount {
+		if v != nil && *v < 0 {
+			a := -*v
+			return &a
+		}
+		return v
+	}
 
 	// Transaction to ensure atomic de-dupe
 	tx, err := r.client.Tx(ctx)
@@ -172,23 +370,50 @@ func (r *receiptRepository) UpsertFromFields(ctx context.Context, request *Creat
 			"demoted_count", demotedCount)
 	}
 
+	// Match the raw name against the merchant directory
+	m, err := resolveMerchant(ctx, tx, file.ProfileID, f.MerchantName)
+	if err != nil {
+		return nil, fmt.Errorf("resolve merchant %q: %w", f.MerchantName, err)
+	}
+	categoryName := request.CategoryName
+	if m.DefaultCategory != nil && !request.CategoryKnown {
+		categoryName = *m.DefaultCategory
+	}
+
 	// Create new current version
 	builder := tx.Receipt.Create().
 		SetProfileID(file.ProfileID).
 		SetNillableFileID(&file.ID).
 		SetFilePath(file.SourcePath).
 		SetMerchantName(f.MerchantName).
+		SetMerchantID(m.ID).
 		SetTxDate(txDate).
 		SetCurrencyCode(f.CurrencyCode).
-		SetCategoryName(request.CategoryName).
+		SetCategoryName(categoryName).
 		SetTotal(total).
 		SetNillableSubtotal(dec(f.Subtotal)).
 		SetNillableTax(dec(f.Tax)).
+		SetNillableDiscount(absPtr(dec(f.Discount))). // stored as a positive reduction
+		SetNillableOtherFees(dec(f.OtherFees)).
+		SetNillableTip(dec(f.Tip)).
 		SetIsCurrent(true)
 
 	if f.Description != "" {
 		builder = builder.SetDescription(f.Description)
 	}
+	if c := request.Conversion; c != nil {
+		builder = builder.
+			SetConvertedTotal(c.Amount).
+			SetConvertedCurrency(c.Currency).
+			SetFxRate(c.Rate).
+			SetFxRateDate(c.RateDate).
+			SetFxSource(c.Source)
+	}
+	if len(f.Fees) > 0 {
+		if fb, err := json.Marshal(f.Fees); err == nil {
+			builder = builder.SetFees(fb)
+		}
+	}
 
 	rec, err := builder.Save(ctx)
 	if err != nil {
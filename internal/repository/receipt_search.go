@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/receipt"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+// SearchFilter narrows a receipt search. Every set field must match.
+type SearchFilter struct {
+	// Query is free text; receipts must contain every word, stemmed ("drills" matches
+	// "drill"). Empty lists the filtered receipts, newest first.
+	Query    string
+	FromDate *time.Time // tx_date, inclusive
+	ToDate   *time.Time
+	Category string        // case-insensitive
+	MinTotal *money.Amount // total as printed, inclusive
+	MaxTotal *money.Amount
+	Limit    int
+}
+
+// SearchHit is a matching receipt. Rank is higher for better matches: merchant names
+// weigh more than descriptions, and descriptions more than OCR text.
+type SearchHit struct {
+	Receipt *entity.Receipt
+	Rank    float64
+}
+
+// Search uses the receipts.search_vector GIN index on Postgres and the receipts_fts FTS5
+// table on SQLite.
+func (r *receiptRepository) Search(ctx context.Context, profileID uuid.UUID, f SearchFilter) ([]SearchHit, error) {
+	q := r.client.Receipt.Query().
+		Where(receipt.ProfileID(profileID), receipt.IsCurrent(true))
+	if f.FromDate != nil {
+		q = q.Where(receipt.TxDateGTE(*f.FromDate))
+	}
+	if f.ToDate != nil {
+		q = q.Where(receipt.TxDateLTE(*f.ToDate))
+	}
+	if f.Category != "" {
+		q = q.Where(receipt.CategoryNameEqualFold(f.Category))
+	}
+	if f.MinTotal != nil {
+		q = q.Where(receipt.TotalGTE(*f.MinTotal))
+	}
+	if f.MaxTotal != nil {
+		q = q.Where(receipt.TotalLTE(*f.MaxTotal))
+	}
+
+	terms := searchTerms(f.Query)
+	var rows []struct {
+		ID   uuid.UUID `sql:"id"`
+		Rank float64   `sql:"rank"`
+	}
+	err := q.Modify(func(s *sql.Selector) {
+		s.Select(s.C(receipt.FieldID))
+		if len(terms) == 0 {
+			s.AppendSelectExprAs(sql.Expr("0"), "rank").
+				OrderExpr(sql.Expr(s.C(receipt.FieldTxDate)+" DESC"), sql.Expr(s.C(receipt.FieldID)))
+		} else if s.Dialect() == dialect.Postgres {
+			tsquery := func(b *sql.Builder) {
+				b.WriteString("plainto_tsquery('english', ").Arg(strings.Join(terms, " ")).WriteString(")")
+			}
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C("search_vector") + " @@ ")
+				tsquery(b)
+			}))
+			s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("ts_rank(" + s.C("search_vector") + ", ")
+				tsquery(b)
+				b.WriteString(")")
+			}), "rank")
+			s.OrderExpr(sql.Expr("rank DESC"), sql.Expr(s.C(receipt.FieldTxDate)+" DESC"))
+		} else {
+			// bm25 is lower for better matches; weights follow the column order of receipts_fts.
+			fts := sql.Table("receipts_fts")
+			s.Join(fts).On(s.C(receipt.FieldID), fts.C("receipt_id"))
+			s.Where(sql.ExprP("receipts_fts MATCH ?", ftsQuery(terms)))
+			s.AppendSelectExprAs(sql.Expr("-bm25(receipts_fts, 0.0, 10.0, 4.0, 1.0)"), "rank")
+			s.OrderExpr(sql.Expr("rank DESC"), sql.Expr(s.C(receipt.FieldTxDate)+" DESC"))
+		}
+		if f.Limit > 0 {
+			s.Limit(f.Limit)
+		}
+	}).Scan(ctx, &rows)
+	if err != nil {
+		r.logger.Error("failed to search receipts", "profile_id", profileID, "query", f.Query, "error", err)
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	recs, err := r.client.Receipt.Query().Where(receipt.IDIn(ids...)).WithJobs().All(ctx)
+	if err != nil {
+		r.logger.Error("failed to load searched receipts", "profile_id", profileID, "error", err)
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entity.Receipt, len(recs))
+	for _, rec := range recs {
+		e := tools.ToReceipt(rec)
+		for _, j := range rec.Edges.Jobs {
+			if j.NeedsReview {
+				e.NeedsReview = true
+				break
+			}
+		}
+		byID[rec.ID] = e
+	}
+	hits := make([]SearchHit, 0, len(rows))
+	for _, row := range rows {
+		if rec := byID[row.ID]; rec != nil {
+			hits = append(hits, SearchHit{Receipt: rec, Rank: row.Rank})
+		}
+	}
+	return hits, nil
+}
+
+// searchTerms splits a query into lower-case words, dropping punctuation so user input
+// cannot reach the query syntax of either database.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// ftsQuery quotes each term for FTS5; space-separated strings must all match.
+func ftsQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = fmt.Sprintf("%q", t)
+	}
+	return strings.Join(quoted, " ")
+}
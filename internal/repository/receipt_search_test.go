@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/extractjob"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewReceiptRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Search")
+	other := createTestProfile(t, client, "Other")
+
+	// the OCR text is stored before its receipt, as the processor does
+	ocr := func(profileID uuid.UUID, path, text string) uuid.UUID {
+		t.Helper()
+		f := createTestFile(t, client, profileID, path)
+		if _, err := client.ExtractJob.Create().
+			SetFileID(f.ID).
+			SetProfileID(profileID).
+			SetFormat("pdf").
+			SetOcrText(text).
+			Save(ctx); err != nil {
+			t.Fatal(err)
+		}
+		return f.ID
+	}
+	add := func(profileID, fileID uuid.UUID, date, merchant, description, category, total string, current bool) {
+		t.Helper()
+		d, _ := time.Parse("2006-01-02", date)
+		if _, err := client.Receipt.Create().
+			SetProfileID(profileID).
+			SetFileID(fileID).
+			SetMerchantName(merchant).
+			SetTxDate(d).
+			SetTotal(money.MustParse(total)).
+			SetCurrencyCode("USD").
+			SetCategoryName(category).
+			SetDescription(description).
+			SetIsCurrent(current).
+			Save(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(p.ID, ocr(p.ID, "drill.pdf", "thank you for shopping"), "2025-01-10", "Drill Depot", "Hardware", "Supplies", "120.00", true)
+	add(p.ID, ocr(p.ID, "hardware.pdf", "store 42 register 3"), "2025-02-10", "Ace Hardware", "Cordless drills and bits", "Supplies", "89.99", true)
+	add(p.ID, ocr(p.ID, "lowes.pdf", "1 DRILL PRESS 249.00"), "2025-03-10", "Lowe's", "Workshop tools", "Equipment", "249.00", true)
+	paint := ocr(p.ID, "paint.pdf", "2 GAL INTERIOR PAINT")
+	add(p.ID, paint, "2025-04-10", "Sherwin-Williams", "Wall paint", "supplies", "64.50", true)
+	add(p.ID, ocr(p.ID, "old.pdf", "DRILL"), "2025-05-10", "Drill Depot", "Superseded drill", "Supplies", "10.00", false)
+	add(other.ID, ocr(other.ID, "other.pdf", "DRILL"), "2025-01-15", "Drill Depot", "Drill", "Supplies", "99.00", true)
+
+	// a re-run replaces the OCR text of a file that already has a receipt
+	if _, err := client.ExtractJob.Update().
+		Where(extractjob.FileID(paint)).
+		SetOcrText("1 DRILL MIXER PADDLE 19.99 TOTAL 19.99").
+		Save(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	from, _ := time.Parse("2006-01-02", "2025-02-01")
+	to, _ := time.Parse("2006-01-02", "2025-03-31")
+	minTotal, maxTotal := money.MustParse("80.00"), money.MustParse("130.00")
+	tests := []struct {
+		name     string
+		filter   SearchFilter
+		expected string // merchants, in order
+	}{
+		{name: "Merchant, then description, then OCR text",
+			filter:   SearchFilter{Query: "drill"},
+			expected: "Drill Depot,Ace Hardware,Lowe's,Sherwin-Williams"},
+		{name: "Updated OCR text replaces the old",
+			filter:   SearchFilter{Query: "paint interior"},
+			expected: ""},
+		{name: "Every word must match",
+			filter:   SearchFilter{Query: "drill press"},
+			expected: "Lowe's"},
+		{name: "Punctuation is ignored",
+			filter:   SearchFilter{Query: `"drill" (mixer*)`},
+			expected: "Sherwin-Williams"},
+		{name: "Empty query lists newest first",
+			filter:   SearchFilter{},
+			expected: "Sherwin-Williams,Lowe's,Ace Hardware,Drill Depot"},
+		{name: "Date range",
+			filter:   SearchFilter{Query: "drill", FromDate: &from, ToDate: &to},
+			expected: "Ace Hardware,Lowe's"},
+		{name: "Category is case-insensitive",
+			filter:   SearchFilter{Query: "drill", Category: "SUPPLIES"},
+			expected: "Drill Depot,Ace Hardware,Sherwin-Williams"},
+		{name: "Total range",
+			filter:   SearchFilter{Query: "drill", MinTotal: &minTotal, MaxTotal: &maxTotal},
+			expected: "Drill Depot,Ace Hardware"},
+		{name: "Limit",
+			filter:   SearchFilter{Query: "drill", Limit: 2},
+			expected: "Drill Depot,Ace Hardware"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := repo.Search(ctx, p.ID, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			merchants := make([]string, len(hits))
+			for i, h := range hits {
+				merchants[i] = h.Receipt.MerchantName
+				if i > 0 && tt.filter.Query != "" && h.Rank > hits[i-1].Rank {
+					t.Errorf("Expected ranks to decrease, got %v after %v", h.Rank, hits[i-1].Rank)
+				}
+			}
+			if got := strings.Join(merchants, ","); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/llm"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+// openTestDB returns a migrated in-memory SQLite client, dropped when the test ends.
+func openTestDB(t *testing.T) *ent.Client {
+	t.Helper()
+	client, db, err := OpenSQLiteInMemory(slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := MigrateSQLite(context.Background(), client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func createTestProfile(t *testing.T, client *ent.Client, name string) *ent.Profile {
+	t.Helper()
+	p, err := client.Profile.Create().SetName(name).SetDefaultCurrency("USD").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func createTestFile(t *testing.T, client *ent.Client, profileID uuid.UUID, path string) *ent.ReceiptFile {
+	t.Helper()
+	sum := sha256.Sum256([]byte(path))
+	f, err := client.ReceiptFile.Create().
+		SetProfileID(profileID).
+		SetSourcePath(path).
+		SetFilename(path).
+		SetFileExt("pdf").
+		SetFileSize(1024).
+		SetContentHash(sum[:]).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestUpsertFromFieldsBreakdown(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewReceiptRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Fees")
+	file := createTestFile(t, client, p.ID, "airbnb.pdf")
+
+	saved, err := repo.UpsertFromFields(ctx, &CreateReceiptRequest{
+		File:  file,
+		JobID: uuid.New(),
+		ReceiptFields: llm.ReceiptFields{
+			MerchantName: "Airbnb",
+			TxDate:       "2025-03-14",
+			Subtotal:     "400.00",
+			Discount:     "-40.00",
+			OtherFees:    "95.50",
+			Fees: []llm.FeeItem{
+				{Name: "Cleaning Fee", Amount: "75.00"},
+				{Name: "Service Fee", Amount: "20.50"},
+			},
+			Tip:          "10.00",
+			Tax:          "32.40",
+			Total:        "497.90",
+			CurrencyCode: "USD",
+			Description:  "Lodging for client workshop",
+		},
+		CategoryName: "Travel",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByID(ctx, saved.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb := tools.ToPBReceiptFromEntity(got)
+
+	checks := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"subtotal", pb.Subtotal, "400.00"},
+		{"discount", pb.Discount, "40.00"}, // stored as a positive reduction
+		{"other_fees", pb.OtherFees, "95.50"},
+		{"tip", pb.Tip, "10.00"},
+		{"tax", pb.Tax, "32.40"},
+		{"total", pb.Total, "497.90"},
+	}
+	for _, c := range checks {
+		if c.got != c.expected {
+			t.Errorf("Expected %s %q, got %q", c.name, c.expected, c.got)
+		}
+	}
+	if len(pb.Fees) != 2 || pb.Fees[0].Name != "Cleaning Fee" || pb.Fees[0].Amount != "75.00" || pb.Fees[1].Name != "Service Fee" || pb.Fees[1].Amount != "20.50" {
+		t.Errorf("Expected both fee lines, got %v", pb.Fees)
+	}
+}
+
+func TestUpsertFromFieldsWithoutBreakdown(t *testing.T) {
+	ctx := context.Background()
+	client := openTestDB(t)
+	repo := NewReceiptRepository(client, slog.Default())
+	p := createTestProfile(t, client, "Plain")
+	file := createTestFile(t, client, p.ID, "coffee.pdf")
+
+	saved, err := repo.UpsertFromFields(ctx, &CreateReceiptRequest{
+		File:          file,
+		JobID:         uuid.New(),
+		ReceiptFields: llm.ReceiptFields{MerchantName: "Blue Bottle", TxDate: "2025-03-14", Total: "6.50", CurrencyCode: "USD", Description: "Coffee"},
+		CategoryName:  "Meals",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := repo.GetByID(ctx, saved.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb := tools.ToPBReceiptFromEntity(got)
+	if pb.Discount != "" || pb.OtherFees != "" || pb.Tip != "" || len(pb.Fees) != 0 {
+		t.Errorf("Expected no breakdown, got discount=%q other_fees=%q tip=%q fees=%v", pb.Discount, pb.OtherFees, pb.Tip, pb.Fees)
+	}
+}
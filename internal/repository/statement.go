@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/statementtransaction"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type StatementRepository interface {
+	// Import stores the statement lines not already stored for the profile and account
+	// (by external id) and reports how many were new.
+	Import(ctx context.Context, profileID uuid.UUID, account string, txs []*entity.StatementTransaction) (imported, duplicates int, err error)
+	// ListByProfile returns a profile's statement lines ordered by posted date. An empty
+	// account means every account.
+	ListByProfile(ctx context.Context, profileID uuid.UUID, account string, fromDate, toDate *time.Time) ([]*entity.StatementTransaction, error)
+}
+
+type statementRepository struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+func NewStatementRepository(client *ent.Client, logger *slog.Logger) StatementRepository {
+	return &statementRepository{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r *statementRepository) Import(ctx context.Context, profileID uuid.UUID, account string, txs []*entity.StatementTransaction) (int, int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	existing, err := tx.StatementTransaction.Query().
+		Where(statementtransaction.ProfileID(profileID), statementtransaction.Account(account)).
+		Select(statementtransaction.FieldExternalID).
+		Strings(ctx)
+	if err != nil {
+		r.logger.Error("failed to list statement lines", "profile_id", profileID, "account", account, "error", err)
+		return 0, 0, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		seen[id] = true
+	}
+
+	var builders []*ent.StatementTransactionCreate
+	duplicates := 0
+	for _, t := range txs {
+		if seen[t.ExternalID] {
+			duplicates++
+			continue
+		}
+		seen[t.ExternalID] = true
+		builders = append(builders, tx.StatementTransaction.Create().
+			SetProfileID(profileID).
+			SetAccount(account).
+			SetPostedDate(t.PostedDate).
+			SetDescription(t.Description).
+			SetAmount(t.Amount).
+			SetCurrencyCode(t.CurrencyCode).
+			SetExternalID(t.ExternalID))
+	}
+	for start := 0; start < len(builders); start += 500 {
+		end := min(start+500, len(builders))
+		if err := tx.StatementTransaction.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			r.logger.Error("failed to store statement lines", "profile_id", profileID, "account", account, "error", err)
+			return 0, 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("commit statement import: %w", err)
+	}
+	return len(builders), duplicates, nil
+}
+
+func (r *statementRepository) ListByProfile(ctx context.Context, profileID uuid.UUID, account string, fromDate, toDate *time.Time) ([]*entity.StatementTransaction, error) {
+	q := r.client.StatementTransaction.Query().
+		Where(statementtransaction.ProfileID(profileID))
+	if account != "" {
+		q = q.Where(statementtransaction.Account(account))
+	}
+	if fromDate != nil {
+		q = q.Where(statementtransaction.PostedDateGTE(*fromDate))
+	}
+	if toDate != nil {
+		q = q.Where(statementtransaction.PostedDateLTE(*toDate))
+	}
+	rows, err := q.Order(statementtransaction.ByPostedDate(), statementtransaction.ByCreatedAt()).All(ctx)
+	if err != nil {
+		r.logger.Error("failed to list statement lines", "profile_id", profileID, "account", account, "error", err)
+		return nil, err
+	}
+	out := make([]*entity.StatementTransaction, len(rows))
+	for i, row := range rows {
+		out[i] = tools.ToStatementTransaction(row)
+	}
+	return out, nil
+}
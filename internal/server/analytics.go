@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/analytics"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+)
+
+type AnalyticsServer struct {
+	receiptspb.UnimplementedAnalyticsServiceServer
+	svc    *analytics.Service
+	logger *slog.Logger
+}
+
+func NewAnalyticsServer(svc *analytics.Service, logger *slog.Logger) *AnalyticsServer {
+	return &AnalyticsServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// GetSpendingTotals totals spending by category, merchant or period.
+func (s *AnalyticsServer) GetSpendingTotals(ctx context.Context, req *receiptspb.GetSpendingTotalsRequest) (*receiptspb.GetSpendingTotalsResponse, error) {
+	fromDate, toDate, err := parseDateRange(req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+	group, err := toSpendGroup(req.GetGroupBy())
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.svc.GetSpendingTotals(ctx, analytics.TotalsRequest{
+		ProfileID: req.GetProfileId(),
+		GroupBy:   group,
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.GetSpendingTotalsResponse{CurrencyCode: report.Currency, Overall: toPBSpendTotal(report.Overall)}
+	for _, t := range report.Rows {
+		out.Rows = append(out.Rows, toPBSpendTotal(t))
+	}
+	return out, nil
+}
+
+// GetTopMerchants returns the merchants with the largest totals.
+func (s *AnalyticsServer) GetTopMerchants(ctx context.Context, req *receiptspb.GetTopMerchantsRequest) (*receiptspb.GetTopMerchantsResponse, error) {
+	fromDate, toDate, err := parseDateRange(req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.svc.GetTopMerchants(ctx, analytics.TotalsRequest{
+		ProfileID: req.GetProfileId(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	}, int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.GetTopMerchantsResponse{CurrencyCode: report.Currency, Overall: toPBSpendTotal(report.Overall)}
+	for _, t := range report.Rows {
+		out.Merchants = append(out.Merchants, toPBSpendTotal(t))
+	}
+	return out, nil
+}
+
+// CompareYears compares a year's spending with the year before.
+func (s *AnalyticsServer) CompareYears(ctx context.Context, req *receiptspb.CompareYearsRequest) (*receiptspb.CompareYearsResponse, error) {
+	group, err := toSpendGroup(req.GetGroupBy())
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.svc.CompareYears(ctx, req.GetProfileId(), int(req.GetYear()), group)
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.CompareYearsResponse{
+		CurrencyCode: report.Currency,
+		Year:         int32(report.Year),
+		Overall:      toPBYearComparison(report.Overall),
+	}
+	for _, c := range report.Rows {
+		out.Rows = append(out.Rows, toPBYearComparison(c))
+	}
+	return out, nil
+}
+
+func toSpendGroup(g receiptspb.SpendGroup) (repository.SpendGroup, error) {
+	switch g {
+	case receiptspb.SpendGroup_SPEND_GROUP_UNSPECIFIED:
+		return repository.SpendByNone, nil
+	case receiptspb.SpendGroup_SPEND_GROUP_CATEGORY:
+		return repository.SpendByCategory, nil
+	case receiptspb.SpendGroup_SPEND_GROUP_MERCHANT:
+		return repository.SpendByMerchant, nil
+	case receiptspb.SpendGroup_SPEND_GROUP_MONTH:
+		return repository.SpendByMonth, nil
+	case receiptspb.SpendGroup_SPEND_GROUP_QUARTER:
+		return repository.SpendByQuarter, nil
+	case receiptspb.SpendGroup_SPEND_GROUP_YEAR:
+		return repository.SpendByYear, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported group_by %v", g)
+}
+
+func toPBSpendTotal(t analytics.Total) *receiptspb.SpendTotal {
+	return &receiptspb.SpendTotal{
+		Key:           t.Key,
+		Receipts:      int32(t.Receipts),
+		Unconverted:   int32(t.Unconverted),
+		Total:         t.Total.String(),
+		AverageTicket: t.AverageTicket.String(),
+	}
+}
+
+func toPBYearComparison(c analytics.Comparison) *receiptspb.YearComparison {
+	return &receiptspb.YearComparison{
+		Key:           c.Key,
+		Current:       toPBSpendTotal(c.Current),
+		Previous:      toPBSpendTotal(c.Previous),
+		Change:        c.Change.String(),
+		ChangePercent: c.ChangePercent,
+	}
+}
+
+// parseDateRange parses optional YYYY-MM-DD from and to dates.
+func parseDateRange(from, to string) (*time.Time, *time.Time, error) {
+	var fromDate, toDate *time.Time
+	if fd := strings.TrimSpace(from); fd != "" {
+		d, err := tools.ParseYMD(fd)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "from_date invalid (YYYY-MM-DD): %v", err)
+		}
+		fromDate = &d
+	}
+	if td := strings.TrimSpace(to); td != "" {
+		d, err := tools.ParseYMD(td)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "to_date invalid (YYYY-MM-DD): %v", err)
+		}
+		toDate = &d
+	}
+	return fromDate, toDate, nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+)
+
+type BudgetsServer struct {
+	receiptspb.UnimplementedBudgetsServiceServer
+	svc    *budget.Service
+	logger *slog.Logger
+}
+
+func NewBudgetsServer(svc *budget.Service, logger *slog.Logger) *BudgetsServer {
+	return &BudgetsServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// ListBudgets returns the profile's budgets with the spending of the current periods.
+func (s *BudgetsServer) ListBudgets(ctx context.Context, req *receiptspb.ListBudgetsRequest) (*receiptspb.ListBudgetsResponse, error) {
+	var asOf time.Time
+	if d := strings.TrimSpace(req.GetAsOf()); d != "" {
+		t, err := time.Parse("2006-01-02", d)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "as_of must be YYYY-MM-DD")
+		}
+		asOf = t
+	}
+	list, currency, err := s.svc.ListBudgets(ctx, req.GetProfileId(), asOf)
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.ListBudgetsResponse{CurrencyCode: currency}
+	for _, st := range list {
+		out.Budgets = append(out.Budgets, &receiptspb.BudgetStatus{
+			Budget:      tools.ToPBBudgetFromEntity(st.Budget),
+			PeriodStart: st.PeriodStart.Format("2006-01-02"),
+			PeriodEnd:   st.PeriodEnd.Format("2006-01-02"),
+			Spent:       st.Spent.String(),
+			Remaining:   st.Remaining.String(),
+			PercentUsed: st.PercentUsed,
+		})
+	}
+	return out, nil
+}
+
+// CreateBudget adds a monthly or annual budget for a category.
+func (s *BudgetsServer) CreateBudget(ctx context.Context, req *receiptspb.CreateBudgetRequest) (*receiptspb.CreateBudgetResponse, error) {
+	b, err := s.svc.CreateBudget(ctx, budget.BudgetRequest{
+		ProfileID:    req.GetProfileId(),
+		CategoryName: req.GetCategoryName(),
+		Period:       tools.FromPBBudgetPeriod(req.GetPeriod()),
+		Amount:       req.GetAmount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.CreateBudgetResponse{Budget: tools.ToPBBudgetFromEntity(b)}, nil
+}
+
+// UpdateBudget changes a budget's category, period or amount.
+func (s *BudgetsServer) UpdateBudget(ctx context.Context, req *receiptspb.UpdateBudgetRequest) (*receiptspb.UpdateBudgetResponse, error) {
+	b, err := s.svc.UpdateBudget(ctx, budget.BudgetRequest{
+		ID:           req.GetId(),
+		CategoryName: req.GetCategoryName(),
+		Period:       tools.FromPBBudgetPeriod(req.GetPeriod()),
+		Amount:       req.GetAmount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.UpdateBudgetResponse{Budget: tools.ToPBBudgetFromEntity(b)}, nil
+}
+
+// DeleteBudget removes a budget and its alerts.
+func (s *BudgetsServer) DeleteBudget(ctx context.Context, req *receiptspb.DeleteBudgetRequest) (*receiptspb.DeleteBudgetResponse, error) {
+	if err := s.svc.DeleteBudget(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &receiptspb.DeleteBudgetResponse{}, nil
+}
+
+// ListBudgetAlerts returns the thresholds crossed, newest first.
+func (s *BudgetsServer) ListBudgetAlerts(ctx context.Context, req *receiptspb.ListBudgetAlertsRequest) (*receiptspb.ListBudgetAlertsResponse, error) {
+	alerts, err := s.svc.ListBudgetAlerts(ctx, req.GetProfileId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.ListBudgetAlertsResponse{}
+	for _, a := range alerts {
+		out.Alerts = append(out.Alerts, tools.ToPBBudgetAlertFromEntity(a))
+	}
+	return out, nil
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/category"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type CategoryServer struct {
+	receiptspb.UnimplementedCategoriesServiceServer
+	svc    *category.Service
+	logger *slog.Logger
+}
+
+func NewCategoryServer(svc *category.Service, logger *slog.Logger) *CategoryServer {
+	return &CategoryServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// ListCategories lists a profile's expense categories.
+func (s *CategoryServer) ListCategories(ctx context.Context, req *receiptspb.ListCategoriesRequest) (*receiptspb.ListCategoriesResponse, error) {
+	cats, err := s.svc.ListCategories(ctx, req.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*receiptspb.Category, 0, len(cats))
+	for _, c := range cats {
+		out = append(out, tools.ToPBCategoryFromEntity(c))
+	}
+	return &receiptspb.ListCategoriesResponse{Categories: out}, nil
+}
+
+// CreateCategory adds a category to a profile.
+func (s *CategoryServer) CreateCategory(ctx context.Context, req *receiptspb.CreateCategoryRequest) (*receiptspb.CreateCategoryResponse, error) {
+	c, err := s.svc.CreateCategory(ctx, category.CategoryRequest{
+		ProfileID:   req.GetProfileId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Synonyms:    req.GetSynonyms(),
+		TaxLine:     req.GetTaxLine(),
+		Account:     req.GetAccount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.CreateCategoryResponse{Category: tools.ToPBCategoryFromEntity(c)}, nil
+}
+
+// UpdateCategory replaces a category's attributes.
+func (s *CategoryServer) UpdateCategory(ctx context.Context, req *receiptspb.UpdateCategoryRequest) (*receiptspb.UpdateCategoryResponse, error) {
+	c, err := s.svc.UpdateCategory(ctx, category.CategoryRequest{
+		ID:          req.GetId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Synonyms:    req.GetSynonyms(),
+		TaxLine:     req.GetTaxLine(),
+		Account:     req.GetAccount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.UpdateCategoryResponse{Category: tools.ToPBCategoryFromEntity(c)}, nil
+}
+
+// DeleteCategory removes a category.
+func (s *CategoryServer) DeleteCategory(ctx context.Context, req *receiptspb.DeleteCategoryRequest) (*receiptspb.DeleteCategoryResponse, error) {
+	if err := s.svc.DeleteCategory(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &receiptspb.DeleteCategoryResponse{}, nil
+}
+
+// ListCategoryRules lists a profile's categorization rules in evaluation order.
+func (s *CategoryServer) ListCategoryRules(ctx context.Context, req *receiptspb.ListCategoryRulesRequest) (*receiptspb.ListCategoryRulesResponse, error) {
+	list, err := s.svc.ListRules(ctx, req.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*receiptspb.CategoryRule, 0, len(list))
+	for _, r := range list {
+		out = append(out, tools.ToPBCategoryRuleFromEntity(r))
+	}
+	return &receiptspb.ListCategoryRulesResponse{Rules: out}, nil
+}
+
+// CreateCategoryRule adds a categorization rule to a profile.
+func (s *CategoryServer) CreateCategoryRule(ctx context.Context, req *receiptspb.CreateCategoryRuleRequest) (*receiptspb.CreateCategoryRuleResponse, error) {
+	r, err := s.svc.CreateRule(ctx, toRuleRequest(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.CreateCategoryRuleResponse{Rule: tools.ToPBCategoryRuleFromEntity(r)}, nil
+}
+
+// UpdateCategoryRule replaces a categorization rule.
+func (s *CategoryServer) UpdateCategoryRule(ctx context.Context, req *receiptspb.UpdateCategoryRuleRequest) (*receiptspb.UpdateCategoryRuleResponse, error) {
+	r, err := s.svc.UpdateRule(ctx, toRuleRequest(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.UpdateCategoryRuleResponse{Rule: tools.ToPBCategoryRuleFromEntity(r)}, nil
+}
+
+// DeleteCategoryRule removes a categorization rule.
+func (s *CategoryServer) DeleteCategoryRule(ctx context.Context, req *receiptspb.DeleteCategoryRuleRequest) (*receiptspb.DeleteCategoryRuleResponse, error) {
+	if err := s.svc.DeleteRule(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &receiptspb.DeleteCategoryRuleResponse{}, nil
+}
+
+func toRuleRequest(r *receiptspb.CategoryRule) category.RuleRequest {
+	if r == nil {
+		r = &receiptspb.CategoryRule{}
+	}
+	return category.RuleRequest{
+		ID:              r.GetId(),
+		ProfileID:       r.GetProfileId(),
+		Name:            r.GetName(),
+		Priority:        int(r.GetPriority()),
+		Enabled:         r.Enabled,
+		MerchantPattern: r.GetMerchantPattern(),
+		PathPattern:     r.GetPathPattern(),
+		Keywords:        r.GetKeywords(),
+		MinTotal:        r.GetMinTotal(),
+		MaxTotal:        r.GetMaxTotal(),
+		SetCategory:     r.GetSetCategory(),
+		ForceReview:     r.GetForceReview(),
+		SetDescription:  r.GetSetDescription(),
+	}
+}
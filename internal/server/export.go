@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bufio"
 	"context"
 	"log/slog"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/joseph-ayodele/receipts-tracker/internal/services/export"
 
 	v1 "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
 )
 
 type ExportServer struct {
@@ -25,11 +28,17 @@ func NewExportServer(svc *export.Service, logger *slog.Logger) *ExportServer {
 	return &ExportServer{svc: svc, logger: logger}
 }
 
-func (s *ExportServer) ExportReceipts(ctx context.Context, req *v1.ExportReceiptsRequest) (*v1.ExportReceiptsResponse, error) {
+// exportChunkSize is the payload size of each streamed ExportReceiptsResponse.
+const exportChunkSize = 64 << 10
+
+// ExportReceipts streams the export in chunks; the first chunk carries the file name and
+// content type.
+func (s *ExportServer) ExportReceipts(req *v1.ExportReceiptsRequest, stream v1.ExportService_ExportReceiptsServer) error {
+	ctx := stream.Context()
 	pid := strings.TrimSpace(req.GetProfileId())
 	profileID, err := uuid.Parse(pid)
 	if err != nil || pid == "" {
-		return nil, errInvalidArg("profile_id must be a UUID")
+		return errInvalidArg("profile_id must be a UUID")
 	}
 
 	// Parse optional dates (YYYY-MM-DD). See user’s semantics:
@@ -40,14 +49,14 @@ func (s *ExportServer) ExportReceipts(ctx context.Context, req *v1.ExportReceipt
 	if fd := strings.TrimSpace(req.GetFromDate()); fd != "" {
 		t, err := time.Parse("2006-01-02", fd)
 		if err != nil {
-			return nil, errInvalidArg("from_date must be YYYY-MM-DD")
+			return errInvalidArg("from_date must be YYYY-MM-DD")
 		}
 		fromPtr = &t
 	}
 	if td := strings.TrimSpace(req.GetToDate()); td != "" {
 		t, err := time.Parse("2006-01-02", td)
 		if err != nil {
-			return nil, errInvalidArg("to_date must be YYYY-MM-DD")
+			return errInvalidArg("to_date must be YYYY-MM-DD")
 		}
 		toPtr = &t
 	}
@@ -60,14 +69,162 @@ func (s *ExportServer) ExportReceipts(ctx context.Context, req *v1.ExportReceipt
 		toPtr = &to
 	}
 
+	var columns []entity.ExportColumn
+	switch req.GetFormat() {
+	case v1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, v1.ExportFormat_EXPORT_FORMAT_XLSX,
+		v1.ExportFormat_EXPORT_FORMAT_CSV, v1.ExportFormat_EXPORT_FORMAT_BUNDLE:
+		if columns, err = s.svc.TemplateColumns(ctx, profileID, req.GetTemplate()); err != nil {
+			return err
+		}
+	}
+
 	// Call service
-	xlsx, err := s.svc.ExportReceiptsXLSX(ctx, profileID, fromPtr, toPtr)
+	cw := &chunkWriter{stream: stream}
+	bw := bufio.NewWriterSize(cw, exportChunkSize)
+	switch req.GetFormat() {
+	case v1.ExportFormat_EXPORT_FORMAT_SCHEDULE_C:
+		cw.fileName, cw.contentType = "schedule-c.xlsx", xlsxContentType
+		var xlsx []byte
+		if xlsx, err = s.svc.ExportScheduleCXLSX(ctx, profileID, fromPtr, toPtr); err == nil {
+			_, err = bw.Write(xlsx)
+		}
+	case v1.ExportFormat_EXPORT_FORMAT_PDF:
+		cw.fileName, cw.contentType = "expense-report.pdf", "application/pdf"
+		err = s.svc.WritePDFReport(ctx, bw, profileID, fromPtr, toPtr)
+	case v1.ExportFormat_EXPORT_FORMAT_QIF, v1.ExportFormat_EXPORT_FORMAT_OFX,
+		v1.ExportFormat_EXPORT_FORMAT_LEDGER, v1.ExportFormat_EXPORT_FORMAT_BEANCOUNT:
+		format := ledgerFormats[req.GetFormat()]
+		cw.fileName, cw.contentType = "receipts."+string(format), ledgerContentTypes[format]
+		err = s.svc.WriteLedger(ctx, bw, format, profileID, fromPtr, toPtr, export.LedgerOptions{
+			Accounts:       req.GetAccounts(),
+			PaymentAccount: req.GetPaymentAccount(),
+		})
+	case v1.ExportFormat_EXPORT_FORMAT_JSON, v1.ExportFormat_EXPORT_FORMAT_NDJSON:
+		format := export.FormatJSON
+		cw.contentType = "application/json"
+		if req.GetFormat() == v1.ExportFormat_EXPORT_FORMAT_NDJSON {
+			format, cw.contentType = export.FormatNDJSON, "application/x-ndjson"
+		}
+		cw.fileName = "receipts." + string(format)
+		err = s.svc.WriteProvenance(ctx, bw, format, profileID, fromPtr, toPtr, export.ProvenanceOptions{
+			IncludeOCRText: req.GetIncludeOcrText(),
+		})
+	case v1.ExportFormat_EXPORT_FORMAT_CSV:
+		cw.fileName, cw.contentType = "receipts.csv", "text/csv"
+		err = s.svc.WriteReceiptsCSV(ctx, bw, profileID, fromPtr, toPtr, columns)
+	case v1.ExportFormat_EXPORT_FORMAT_BUNDLE:
+		cw.fileName, cw.contentType = "receipts-bundle.zip", "application/zip"
+		err = s.svc.WriteBundle(ctx, bw, profileID, fromPtr, toPtr, columns)
+	default:
+		cw.fileName, cw.contentType = "receipts.xlsx", xlsxContentType
+		err = s.svc.WriteReceiptsXLSX(ctx, bw, profileID, fromPtr, toPtr, columns)
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		s.logger.Error("export.failed", "profile_id", pid, "format", req.GetFormat().String(), "err", err)
+		return errInternal(err.Error())
+	}
+	return nil
+}
+
+// ListExportTemplates lists a profile's export templates.
+func (s *ExportServer) ListExportTemplates(ctx context.Context, req *v1.ListExportTemplatesRequest) (*v1.ListExportTemplatesResponse, error) {
+	list, err := s.svc.ListTemplates(ctx, req.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*v1.ExportTemplate, 0, len(list))
+	for _, t := range list {
+		out = append(out, tools.ToPBExportTemplateFromEntity(t))
+	}
+	return &v1.ListExportTemplatesResponse{Templates: out}, nil
+}
+
+// CreateExportTemplate adds an export template to a profile.
+func (s *ExportServer) CreateExportTemplate(ctx context.Context, req *v1.CreateExportTemplateRequest) (*v1.CreateExportTemplateResponse, error) {
+	t, err := s.svc.CreateTemplate(ctx, toTemplateRequest(req.GetTemplate()))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateExportTemplateResponse{Template: tools.ToPBExportTemplateFromEntity(t)}, nil
+}
+
+// UpdateExportTemplate replaces an export template.
+func (s *ExportServer) UpdateExportTemplate(ctx context.Context, req *v1.UpdateExportTemplateRequest) (*v1.UpdateExportTemplateResponse, error) {
+	t, err := s.svc.UpdateTemplate(ctx, toTemplateRequest(req.GetTemplate()))
 	if err != nil {
-		s.logger.Error("export.xlsx.failed", "profile_id", pid, "err", err)
-		return nil, errInternal(err.Error())
+		return nil, err
+	}
+	return &v1.UpdateExportTemplateResponse{Template: tools.ToPBExportTemplateFromEntity(t)}, nil
+}
+
+// DeleteExportTemplate removes an export template.
+func (s *ExportServer) DeleteExportTemplate(ctx context.Context, req *v1.DeleteExportTemplateRequest) (*v1.DeleteExportTemplateResponse, error) {
+	if err := s.svc.DeleteTemplate(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteExportTemplateResponse{}, nil
+}
+
+func toTemplateRequest(t *v1.ExportTemplate) export.TemplateRequest {
+	if t == nil {
+		t = &v1.ExportTemplate{}
+	}
+	cols := make([]entity.ExportColumn, 0, len(t.GetColumns()))
+	for _, c := range t.GetColumns() {
+		cols = append(cols, entity.ExportColumn{Header: c.GetHeader(), Field: c.GetField(), Format: c.GetFormat(), Width: c.GetWidth()})
 	}
+	return export.TemplateRequest{
+		ID:        t.GetId(),
+		ProfileID: t.GetProfileId(),
+		Name:      t.GetName(),
+		Columns:   cols,
+	}
+}
 
-	return &v1.ExportReceiptsResponse{Xlsx: xlsx}, nil
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+var ledgerFormats = map[v1.ExportFormat]export.LedgerFormat{
+	v1.ExportFormat_EXPORT_FORMAT_QIF:       export.FormatQIF,
+	v1.ExportFormat_EXPORT_FORMAT_OFX:       export.FormatOFX,
+	v1.ExportFormat_EXPORT_FORMAT_LEDGER:    export.FormatLedger,
+	v1.ExportFormat_EXPORT_FORMAT_BEANCOUNT: export.FormatBeancount,
+}
+
+var ledgerContentTypes = map[export.LedgerFormat]string{
+	export.FormatQIF:       "application/qif",
+	export.FormatOFX:       "application/x-ofx",
+	export.FormatLedger:    "text/plain; charset=utf-8",
+	export.FormatBeancount: "text/plain; charset=utf-8",
+}
+
+// chunkWriter sends everything written to it as ExportReceiptsResponse messages of at
+// most exportChunkSize bytes.
+type chunkWriter struct {
+	stream      v1.ExportService_ExportReceiptsServer
+	fileName    string
+	contentType string
+	sent        bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		size := min(len(p), exportChunkSize)
+		msg := &v1.ExportReceiptsResponse{Data: p[:size]}
+		if !w.sent {
+			msg.FileName, msg.ContentType = w.fileName, w.contentType
+			w.sent = true
+		}
+		if err := w.stream.Send(msg); err != nil {
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+	return n, nil
 }
 
 // --- minimal internal error helpers consistent with your gRPC style:
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/merchant"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+type MerchantServer struct {
+	receiptspb.UnimplementedMerchantsServiceServer
+	svc    *merchant.Service
+	logger *slog.Logger
+}
+
+func NewMerchantServer(svc *merchant.Service, logger *slog.Logger) *MerchantServer {
+	return &MerchantServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// ListMerchants lists a profile's merchant directory.
+func (s *MerchantServer) ListMerchants(ctx context.Context, req *receiptspb.ListMerchantsRequest) (*receiptspb.ListMerchantsResponse, error) {
+	list, err := s.svc.ListMerchants(ctx, req.GetProfileId())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*receiptspb.Merchant, 0, len(list))
+	for _, m := range list {
+		out = append(out, tools.ToPBMerchantFromEntity(m))
+	}
+	return &receiptspb.ListMerchantsResponse{Merchants: out}, nil
+}
+
+// CreateMerchant adds a merchant to a profile's directory.
+func (s *MerchantServer) CreateMerchant(ctx context.Context, req *receiptspb.CreateMerchantRequest) (*receiptspb.CreateMerchantResponse, error) {
+	m, err := s.svc.CreateMerchant(ctx, merchant.MerchantRequest{
+		ProfileID:       req.GetProfileId(),
+		Name:            req.GetName(),
+		Aliases:         req.GetAliases(),
+		DefaultCategory: req.GetDefaultCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.CreateMerchantResponse{Merchant: tools.ToPBMerchantFromEntity(m)}, nil
+}
+
+// UpdateMerchant replaces a merchant's name, aliases and default category.
+func (s *MerchantServer) UpdateMerchant(ctx context.Context, req *receiptspb.UpdateMerchantRequest) (*receiptspb.UpdateMerchantResponse, error) {
+	m, err := s.svc.UpdateMerchant(ctx, merchant.MerchantRequest{
+		ID:              req.GetId(),
+		Name:            req.GetName(),
+		Aliases:         req.GetAliases(),
+		DefaultCategory: req.GetDefaultCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.UpdateMerchantResponse{Merchant: tools.ToPBMerchantFromEntity(m)}, nil
+}
+
+// MergeMerchants folds duplicate merchants into one.
+func (s *MerchantServer) MergeMerchants(ctx context.Context, req *receiptspb.MergeMerchantsRequest) (*receiptspb.MergeMerchantsResponse, error) {
+	m, moved, err := s.svc.MergeMerchants(ctx, req.GetTargetId(), req.GetSourceIds())
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.MergeMerchantsResponse{
+		Merchant:      tools.ToPBMerchantFromEntity(m),
+		ReceiptsMoved: int32(moved),
+	}, nil
+}
+
+// SplitMerchant moves aliases that were wrongly matched to a merchant into a new one.
+func (s *MerchantServer) SplitMerchant(ctx context.Context, req *receiptspb.SplitMerchantRequest) (*receiptspb.SplitMerchantResponse, error) {
+	original, created, moved, err := s.svc.SplitMerchant(ctx, req.GetMerchantId(), req.GetAliases(), req.GetNewName(), req.GetDefaultCategory())
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.SplitMerchantResponse{
+		Original:      tools.ToPBMerchantFromEntity(original),
+		Created:       tools.ToPBMerchantFromEntity(created),
+		ReceiptsMoved: int32(moved),
+	}, nil
+}
@@ -68,6 +68,196 @@ func (s *ReceiptServer) ListReceipts(ctx context.Context, req *receiptspb.ListRe
 	return &receiptspb.ListReceiptsResponse{Receipts: out}, nil
 }
 
+// UpdateReceiptCategory re-categorizes a receipt and records the correction.
+func (s *ReceiptServer) UpdateReceiptCategory(ctx context.Context, req *receiptspb.UpdateReceiptCategoryRequest) (*receiptspb.UpdateReceiptCategoryResponse, error) {
+	rec, changed, err := s.svc.UpdateReceiptCategory(ctx, req.GetReceiptId(), req.GetCategory())
+	if err != nil {
+		return nil, err
+	}
+	return &receiptspb.UpdateReceiptCategoryResponse{
+		Receipt: tools.ToPBReceiptFromEntity(rec),
+		Changed: changed,
+	}, nil
+}
+
+// GetCorrectionReport reports how often reviewers re-categorized parsed receipts.
+func (s *ReceiptServer) GetCorrectionReport(ctx context.Context, req *receiptspb.GetCorrectionReportRequest) (*receiptspb.GetCorrectionReportResponse, error) {
+	var fromDate, toDate *time.Time
+	if fd := strings.TrimSpace(req.GetFromDate()); fd != "" {
+		from, err := tools.ParseYMD(fd)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from_date invalid (YYYY-MM-DD): %v", err)
+		}
+		fromDate = &from
+	}
+	if td := strings.TrimSpace(req.GetToDate()); td != "" {
+		to, err := tools.ParseYMD(td)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "to_date invalid (YYYY-MM-DD): %v", err)
+		}
+		toDate = &to
+	}
+
+	report, err := s.svc.GetCorrectionReport(ctx, receipt.ListReceiptsRequest{
+		ProfileID: req.GetProfileId(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &receiptspb.GetCorrectionReportResponse{
+		Receipts:       int32(report.Receipts),
+		Corrected:      int32(report.Corrected),
+		CorrectionRate: report.CorrectionRate,
+	}
+	for _, c := range report.Categories {
+		out.Categories = append(out.Categories, &receiptspb.CategoryCorrectionStats{
+			Category:       c.Category,
+			Predicted:      int32(c.Predicted),
+			CorrectedAway:  int32(c.CorrectedAway),
+			CorrectedInto:  int32(c.CorrectedInto),
+			CorrectionRate: c.CorrectionRate,
+		})
+	}
+	for _, m := range report.Months {
+		out.Months = append(out.Months, &receiptspb.MonthlyCorrectionStats{
+			Month:          m.Month,
+			Receipts:       int32(m.Receipts),
+			Corrected:      int32(m.Corrected),
+			CorrectionRate: m.CorrectionRate,
+		})
+	}
+	return out, nil
+}
+
+// ListRecurringExpenses finds subscriptions and bills with missing periods and price changes.
+func (s *ReceiptServer) ListRecurringExpenses(ctx context.Context, req *receiptspb.ListRecurringExpensesRequest) (*receiptspb.ListRecurringExpensesResponse, error) {
+	var fromDate, toDate *time.Time
+	if fd := strings.TrimSpace(req.GetFromDate()); fd != "" {
+		from, err := tools.ParseYMD(fd)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from_date invalid (YYYY-MM-DD): %v", err)
+		}
+		fromDate = &from
+	}
+	if td := strings.TrimSpace(req.GetToDate()); td != "" {
+		to, err := tools.ParseYMD(td)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "to_date invalid (YYYY-MM-DD): %v", err)
+		}
+		toDate = &to
+	}
+
+	series, err := s.svc.ListRecurringExpenses(ctx, receipt.ListReceiptsRequest{
+		ProfileID: req.GetProfileId(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &receiptspb.ListRecurringExpensesResponse{}
+	for _, sr := range series {
+		e := &receiptspb.RecurringExpense{
+			Merchant:      sr.Merchant,
+			Category:      sr.Category,
+			Cadence:       string(sr.Cadence),
+			CurrencyCode:  sr.Currency,
+			TypicalAmount: sr.TypicalAmount.String(),
+			LastAmount:    sr.LastAmount.String(),
+			FirstDate:     sr.Receipts[0].TxDate.Format("2006-01-02"),
+			LastDate:      sr.Receipts[len(sr.Receipts)-1].TxDate.Format("2006-01-02"),
+			Ended:         sr.Ended,
+		}
+		if sr.MerchantID != nil {
+			e.MerchantId = sr.MerchantID.String()
+		}
+		if !sr.NextExpected.IsZero() {
+			e.NextExpected = sr.NextExpected.Format("2006-01-02")
+		}
+		for _, r := range sr.Receipts {
+			e.ReceiptIds = append(e.ReceiptIds, r.ID.String())
+		}
+		for _, m := range sr.Missing {
+			e.MissingPeriods = append(e.MissingPeriods, sr.Cadence.Period(m))
+		}
+		for _, pc := range sr.PriceChanges {
+			e.PriceChanges = append(e.PriceChanges, &receiptspb.PriceChange{
+				ReceiptId:  pc.ReceiptID.String(),
+				Date:       pc.Date.Format("2006-01-02"),
+				FromAmount: pc.From.String(),
+				ToAmount:   pc.To.String(),
+			})
+		}
+		out.Expenses = append(out.Expenses, e)
+	}
+	return out, nil
+}
+
+// ListReceiptAnomalies lists why receipts were flagged as likely extraction errors.
+func (s *ReceiptServer) ListReceiptAnomalies(ctx context.Context, req *receiptspb.ListReceiptAnomaliesRequest) (*receiptspb.ListReceiptAnomaliesResponse, error) {
+	fromDate, toDate, err := parseDateRange(req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.svc.ListReceiptAnomalies(ctx, receipt.ListReceiptsRequest{
+		ProfileID: req.GetProfileId(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	}, int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.ListReceiptAnomaliesResponse{}
+	for _, a := range list {
+		pb := &receiptspb.ReceiptAnomaly{
+			Id:        a.ID.String(),
+			Kind:      a.Kind,
+			Reason:    a.Reason,
+			Score:     a.Score,
+			CreatedAt: a.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		if a.Receipt != nil {
+			pb.Receipt = tools.ToPBReceiptFromEntity(a.Receipt)
+			pb.Receipt.NeedsReview = true
+		}
+		out.Anomalies = append(out.Anomalies, pb)
+	}
+	return out, nil
+}
+
+// SearchReceipts runs a ranked full-text search over receipts and their OCR text.
+func (s *ReceiptServer) SearchReceipts(ctx context.Context, req *receiptspb.SearchReceiptsRequest) (*receiptspb.SearchReceiptsResponse, error) {
+	fromDate, toDate, err := parseDateRange(req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+	hits, err := s.svc.SearchReceipts(ctx, receipt.SearchRequest{
+		ProfileID: req.GetProfileId(),
+		Query:     req.GetQuery(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+		Category:  req.GetCategory(),
+		MinTotal:  req.GetMinTotal(),
+		MaxTotal:  req.GetMaxTotal(),
+		Limit:     int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.SearchReceiptsResponse{}
+	for _, h := range hits {
+		out.Results = append(out.Results, &receiptspb.SearchResult{
+			Receipt: tools.ToPBReceiptFromEntity(h.Receipt),
+			Rank:    h.Rank,
+		})
+	}
+	return out, nil
+}
+
 func (s *ReceiptServer) ExportReceipts(context.Context, *receiptspb.ExportReceiptsRequest) (*receiptspb.ExportReceiptsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "ExportReceipts not implemented yet (Step 8)")
 }
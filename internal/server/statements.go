@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/statement"
+	statementsvc "github.com/joseph-ayodele/receipts-tracker/internal/services/statement"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+)
+
+type StatementServer struct {
+	receiptspb.UnimplementedStatementsServiceServer
+	svc    *statementsvc.Service
+	logger *slog.Logger
+}
+
+func NewStatementServer(svc *statementsvc.Service, logger *slog.Logger) *StatementServer {
+	return &StatementServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// ImportStatement stores the lines of a CSV or OFX statement.
+func (s *StatementServer) ImportStatement(ctx context.Context, req *receiptspb.ImportStatementRequest) (*receiptspb.ImportStatementResponse, error) {
+	var format statement.Format
+	switch req.GetFormat() {
+	case receiptspb.StatementFormat_STATEMENT_FORMAT_CSV:
+		format = statement.FormatCSV
+	case receiptspb.StatementFormat_STATEMENT_FORMAT_OFX:
+		format = statement.FormatOFX
+	case receiptspb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported statement format %v", req.GetFormat())
+	}
+
+	res, err := s.svc.ImportStatement(ctx, statementsvc.ImportRequest{
+		ProfileID:    req.GetProfileId(),
+		Account:      req.GetAccount(),
+		FileName:     req.GetFileName(),
+		Content:      req.GetContent(),
+		Format:       format,
+		CurrencyCode: req.GetCurrencyCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &receiptspb.ImportStatementResponse{
+		Imported:   int32(res.Imported),
+		Duplicates: int32(res.Duplicates),
+		Account:    res.Account,
+	}
+	if res.FromDate != nil {
+		out.FromDate = res.FromDate.Format("2006-01-02")
+		out.ToDate = res.ToDate.Format("2006-01-02")
+	}
+	return out, nil
+}
+
+// ReconcileStatement matches imported statement charges to receipts.
+func (s *StatementServer) ReconcileStatement(ctx context.Context, req *receiptspb.ReconcileStatementRequest) (*receiptspb.ReconcileStatementResponse, error) {
+	var fromDate, toDate *time.Time
+	if fd := strings.TrimSpace(req.GetFromDate()); fd != "" {
+		from, err := tools.ParseYMD(fd)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from_date invalid (YYYY-MM-DD): %v", err)
+		}
+		fromDate = &from
+	}
+	if td := strings.TrimSpace(req.GetToDate()); td != "" {
+		to, err := tools.ParseYMD(td)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "to_date invalid (YYYY-MM-DD): %v", err)
+		}
+		toDate = &to
+	}
+
+	res, err := s.svc.Reconcile(ctx, statementsvc.ReconcileRequest{
+		ProfileID:  req.GetProfileId(),
+		Account:    req.GetAccount(),
+		FromDate:   fromDate,
+		ToDate:     toDate,
+		DateWindow: int(req.GetDateWindowDays()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &receiptspb.ReconcileStatementResponse{Credits: int32(res.Credits)}
+	for _, m := range res.Matches {
+		out.Matches = append(out.Matches, &receiptspb.StatementMatch{
+			Transaction:   tools.ToPBStatementTransactionFromEntity(m.Transaction),
+			Receipt:       tools.ToPBReceiptFromEntity(m.Receipt),
+			MerchantScore: m.MerchantScore,
+			DaysApart:     int32(m.DaysApart),
+			Weak:          m.Weak,
+		})
+	}
+	for _, t := range res.UnmatchedTransactions {
+		out.UnmatchedTransactions = append(out.UnmatchedTransactions, tools.ToPBStatementTransactionFromEntity(t))
+	}
+	for _, r := range res.UnmatchedReceipts {
+		out.UnmatchedReceipts = append(out.UnmatchedReceipts, tools.ToPBReceiptFromEntity(r))
+	}
+	return out, nil
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/services/usage"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
+)
+
+type UsageServer struct {
+	receiptspb.UnimplementedUsageServiceServer
+	svc    *usage.Service
+	logger *slog.Logger
+}
+
+func NewUsageServer(svc *usage.Service, logger *slog.Logger) *UsageServer {
+	return &UsageServer{
+		svc:    svc,
+		logger: logger,
+	}
+}
+
+// GetUsageReport returns LLM spend per profile, month and model.
+func (s *UsageServer) GetUsageReport(ctx context.Context, req *receiptspb.GetUsageReportRequest) (*receiptspb.GetUsageReportResponse, error) {
+	var fromDate, toDate *time.Time
+	if fd := strings.TrimSpace(req.GetFromDate()); fd != "" {
+		from, err := tools.ParseYMD(fd)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from_date invalid (YYYY-MM-DD): %v", err)
+		}
+		fromDate = &from
+	}
+	if td := strings.TrimSpace(req.GetToDate()); td != "" {
+		to, err := tools.ParseYMD(td)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "to_date invalid (YYYY-MM-DD): %v", err)
+		}
+		toDate = &to
+	}
+
+	rep, err := s.svc.GetUsageReport(ctx, usage.ReportRequest{
+		ProfileID: req.GetProfileId(),
+		FromDate:  fromDate,
+		ToDate:    toDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*receiptspb.UsageRow, 0, len(rep.Rows))
+	for _, r := range rep.Rows {
+		out = append(out, &receiptspb.UsageRow{
+			ProfileId:        r.ProfileID.String(),
+			Month:            r.Month,
+			Model:            r.Model,
+			Jobs:             r.Jobs,
+			CacheHits:        r.CacheHits,
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+			ImageTokens:      r.ImageTokens,
+			CostUsd:          fmt.Sprintf("%.6f", r.CostUSD),
+		})
+	}
+	return &receiptspb.GetUsageReportResponse{
+		Rows:         out,
+		TotalCostUsd: fmt.Sprintf("%.6f", rep.TotalCostUSD),
+	}, nil
+}
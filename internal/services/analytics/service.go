@@ -0,0 +1,254 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits for GetTopMerchants.
+const (
+	DefaultTopMerchants = 10
+	MaxTopMerchants     = 100
+)
+
+// Service answers aggregated spending queries. The sums are computed by the database.
+type Service struct {
+	analyticsRepo repository.AnalyticsRepository
+	profileRepo   repository.ProfileRepository
+	logger        *slog.Logger
+}
+
+// NewService creates a new analytics service.
+func NewService(analyticsRepo repository.AnalyticsRepository, profileRepo repository.ProfileRepository, logger *slog.Logger) *Service {
+	return &Service{
+		analyticsRepo: analyticsRepo,
+		profileRepo:   profileRepo,
+		logger:        logger,
+	}
+}
+
+// TotalsRequest selects the receipts to aggregate.
+type TotalsRequest struct {
+	ProfileID string
+	GroupBy   repository.SpendGroup
+	FromDate  *time.Time
+	ToDate    *time.Time
+}
+
+// Total is an aggregated group in the profile currency.
+type Total struct {
+	Key           string
+	Receipts      int
+	Unconverted   int // receipts with no amount in the profile currency, left out of Total
+	Total         money.Amount
+	AverageTicket money.Amount // Total per converted receipt
+}
+
+// TotalsReport is the result of GetSpendingTotals and GetTopMerchants.
+type TotalsReport struct {
+	Currency string
+	Rows     []Total
+	Overall  Total
+}
+
+// GetSpendingTotals totals the profile's receipts per group, ordered by key.
+func (s *Service) GetSpendingTotals(ctx context.Context, req TotalsRequest) (*TotalsReport, error) {
+	pid, currency, err := s.validate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.analyticsRepo.SpendBy(ctx, pid, req.GroupBy, req.FromDate, req.ToDate, 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "aggregate spending: %v", err)
+	}
+	report, err := s.withOverall(ctx, pid, currency, rows, req)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("spending totals computed", "profile_id", pid, "group_by", req.GroupBy, "groups", len(report.Rows), "receipts", report.Overall.Receipts)
+	return report, nil
+}
+
+// GetTopMerchants returns the merchants with the largest totals.
+func (s *Service) GetTopMerchants(ctx context.Context, req TotalsRequest, limit int) (*TotalsReport, error) {
+	req.GroupBy = repository.SpendByMerchant
+	pid, currency, err := s.validate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 0 || limit > MaxTopMerchants {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", MaxTopMerchants)
+	}
+	if limit == 0 {
+		limit = DefaultTopMerchants
+	}
+	rows, err := s.analyticsRepo.SpendBy(ctx, pid, repository.SpendByMerchant, req.FromDate, req.ToDate, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "aggregate spending: %v", err)
+	}
+	report, err := s.withOverall(ctx, pid, currency, rows, req)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("top merchants computed", "profile_id", pid, "limit", limit, "merchants", len(report.Rows))
+	return report, nil
+}
+
+// Comparison pairs a group's totals in two consecutive years.
+type Comparison struct {
+	Key           string
+	Current       Total
+	Previous      Total
+	Change        money.Amount
+	ChangePercent *float64 // nil when the previous total is zero
+}
+
+// ComparisonReport is the result of CompareYears.
+type ComparisonReport struct {
+	Currency string
+	Year     int
+	Rows     []Comparison
+	Overall  Comparison
+}
+
+// CompareYears compares each group's total in year with the year before. Months and
+// quarters are matched by their position in the year, keyed "MM" and "Qn".
+func (s *Service) CompareYears(ctx context.Context, profileID string, year int, group repository.SpendGroup) (*ComparisonReport, error) {
+	switch group {
+	case repository.SpendByCategory, repository.SpendByMerchant, repository.SpendByMonth, repository.SpendByQuarter:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "group_by must be category, merchant, month or quarter")
+	}
+	if year == 0 {
+		year = time.Now().UTC().Year()
+	}
+	if year < 1900 || year > 9999 {
+		return nil, status.Errorf(codes.InvalidArgument, "year %d out of range", year)
+	}
+	pid, currency, err := s.validate(ctx, TotalsRequest{ProfileID: profileID, GroupBy: group})
+	if err != nil {
+		return nil, err
+	}
+
+	byYear := make([]map[string]Total, 2)
+	overall := make([]Total, 2)
+	for i, y := range []int{year, year - 1} {
+		from := time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(y, 12, 31, 0, 0, 0, 0, time.UTC)
+		rows, err := s.analyticsRepo.SpendBy(ctx, pid, group, &from, &to, 0)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "aggregate spending: %v", err)
+		}
+		byYear[i] = map[string]Total{}
+		for _, r := range rows {
+			t := toTotal(r)
+			t.Key = periodInYear(group, t.Key)
+			byYear[i][t.Key] = t
+			overall[i] = add(overall[i], t)
+		}
+	}
+
+	keys := map[string]bool{}
+	for _, m := range byYear {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+	report := &ComparisonReport{Currency: currency, Year: year, Overall: compare("", overall[0], overall[1])}
+	for k := range keys {
+		report.Rows = append(report.Rows, compare(k, byYear[0][k], byYear[1][k]))
+	}
+	sort.Slice(report.Rows, func(i, j int) bool { return report.Rows[i].Key < report.Rows[j].Key })
+	s.logger.Info("years compared", "profile_id", pid, "year", year, "group_by", group, "groups", len(report.Rows))
+	return report, nil
+}
+
+// withOverall adds the ungrouped total for the request's range.
+func (s *Service) withOverall(ctx context.Context, pid uuid.UUID, currency string, rows []repository.SpendRow, req TotalsRequest) (*TotalsReport, error) {
+	report := &TotalsReport{Currency: currency}
+	for _, r := range rows {
+		report.Rows = append(report.Rows, toTotal(r))
+	}
+	if req.GroupBy == repository.SpendByNone {
+		if len(report.Rows) > 0 {
+			report.Overall = report.Rows[0]
+		}
+		return report, nil
+	}
+	all, err := s.analyticsRepo.SpendBy(ctx, pid, repository.SpendByNone, req.FromDate, req.ToDate, 0)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "aggregate spending: %v", err)
+	}
+	if len(all) > 0 {
+		report.Overall = toTotal(all[0])
+	}
+	return report, nil
+}
+
+// validate checks the request and returns the profile id and currency.
+func (s *Service) validate(ctx context.Context, req TotalsRequest) (uuid.UUID, string, error) {
+	if strings.TrimSpace(req.ProfileID) == "" {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(strings.TrimSpace(req.ProfileID))
+	if err != nil {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	if req.FromDate != nil && req.ToDate != nil && req.ToDate.Before(*req.FromDate) {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+	p, err := s.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, "", status.Error(codes.NotFound, "profile not found")
+		}
+		return uuid.Nil, "", status.Errorf(codes.Internal, "get profile: %v", err)
+	}
+	return pid, p.DefaultCurrency, nil
+}
+
+func toTotal(r repository.SpendRow) Total {
+	t := Total{Key: r.Key, Receipts: r.Receipts, Unconverted: r.Receipts - r.Converted, Total: r.Total}
+	if r.Converted > 0 {
+		t.AverageTicket = money.FromFloat(r.Total.Float64() / float64(r.Converted))
+	}
+	return t
+}
+
+func add(a, b Total) Total {
+	a.Receipts += b.Receipts
+	a.Unconverted += b.Unconverted
+	a.Total += b.Total
+	if n := a.Receipts - a.Unconverted; n > 0 {
+		a.AverageTicket = money.FromFloat(a.Total.Float64() / float64(n))
+	}
+	return a
+}
+
+func compare(key string, current, previous Total) Comparison {
+	current.Key, previous.Key = key, key
+	c := Comparison{Key: key, Current: current, Previous: previous, Change: current.Total - previous.Total}
+	if previous.Total != 0 {
+		pct := float64(c.Change) / float64(previous.Total) * 100
+		c.ChangePercent = &pct
+	}
+	return c
+}
+
+// periodInYear drops the year from month ("2025-03" -> "03") and quarter keys.
+func periodInYear(group repository.SpendGroup, key string) string {
+	if (group == repository.SpendByMonth || group == repository.SpendByQuarter) && len(key) > 5 {
+		return key[5:]
+	}
+	return key
+}
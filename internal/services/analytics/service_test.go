@@ -0,0 +1,52 @@
+package analytics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+func TestCompare(t *testing.T) {
+	total := func(s string) Total { return Total{Receipts: 1, Total: money.MustParse(s)} }
+	tests := []struct {
+		name     string
+		current  Total
+		previous Total
+		change   string
+		percent  string // "" when undefined
+	}{
+		{name: "Increase", current: total("150.00"), previous: total("100.00"), change: "50.00", percent: "50.0"},
+		{name: "Decrease", current: total("75.00"), previous: total("100.00"), change: "-25.00", percent: "-25.0"},
+		{name: "No previous spending", current: total("20.00"), previous: Total{}, change: "20.00", percent: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := compare("Meals", tt.current, tt.previous)
+			if c.Change.String() != tt.change {
+				t.Errorf("Expected %q, got %q", tt.change, c.Change.String())
+			}
+			got := ""
+			if c.ChangePercent != nil {
+				got = fmt.Sprintf("%.1f", *c.ChangePercent)
+			}
+			if got != tt.percent {
+				t.Errorf("Expected %q, got %q", tt.percent, got)
+			}
+		})
+	}
+}
+
+func TestToTotal(t *testing.T) {
+	got := toTotal(repository.SpendRow{Key: "2025-03", Receipts: 4, Converted: 3, Total: money.MustParse("100.00")})
+	if got.Unconverted != 1 || got.AverageTicket.String() != "33.33" {
+		t.Errorf("Expected 1 unconverted and an average of 33.33, got %d and %s", got.Unconverted, got.AverageTicket)
+	}
+	if key := periodInYear(repository.SpendByMonth, got.Key); key != "03" {
+		t.Errorf("Expected %q, got %q", "03", key)
+	}
+	if key := periodInYear(repository.SpendByQuarter, "2025-Q2"); key != "Q2" {
+		t.Errorf("Expected %q, got %q", "Q2", key)
+	}
+}
@@ -0,0 +1,248 @@
+package budget
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	corebudget "github.com/joseph-ayodele/receipts-tracker/internal/core/budget"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits for ListBudgetAlerts.
+const (
+	DefaultAlertLimit = 50
+	MaxAlertLimit     = 500
+)
+
+// Service manages category budgets and reports spending against them.
+type Service struct {
+	budgetRepo   repository.BudgetRepository
+	categoryRepo repository.CategoryRepository
+	profileRepo  repository.ProfileRepository
+	checker      *corebudget.Checker
+	logger       *slog.Logger
+}
+
+// NewService creates a new budget service. The checker computes the spending of each period.
+func NewService(budgetRepo repository.BudgetRepository, categoryRepo repository.CategoryRepository, profileRepo repository.ProfileRepository, checker *corebudget.Checker, logger *slog.Logger) *Service {
+	return &Service{
+		budgetRepo:   budgetRepo,
+		categoryRepo: categoryRepo,
+		profileRepo:  profileRepo,
+		checker:      checker,
+		logger:       logger,
+	}
+}
+
+// BudgetRequest carries the editable attributes of a budget.
+type BudgetRequest struct {
+	ID           string // update only
+	ProfileID    string // create only
+	CategoryName string
+	Period       string // entity.BudgetMonthly or entity.BudgetAnnual
+	Amount       string
+}
+
+// Status is a budget with the spending of the period containing the as-of date.
+type Status struct {
+	Budget      *entity.Budget
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Spent       money.Amount
+	Remaining   money.Amount
+	PercentUsed float64
+}
+
+// ListBudgets returns the profile's budgets with their spending as of asOf (zero = today),
+// and the profile currency.
+func (s *Service) ListBudgets(ctx context.Context, profileID string, asOf time.Time) ([]Status, string, error) {
+	p, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, "", err
+	}
+	if asOf.IsZero() {
+		asOf = time.Now().UTC()
+	}
+	budgets, err := s.budgetRepo.ListByProfile(ctx, p.ID)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "list budgets: %v", err)
+	}
+	out := make([]Status, 0, len(budgets))
+	for _, b := range budgets {
+		spent, start, err := s.checker.Spent(ctx, b, asOf)
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "compute spending: %v", err)
+		}
+		_, end, _ := corebudget.PeriodRange(b.Period, asOf)
+		st := Status{Budget: b, PeriodStart: start, PeriodEnd: end, Spent: spent, Remaining: b.Amount - spent}
+		if b.Amount > 0 {
+			st.PercentUsed = float64(spent) * 100 / float64(b.Amount)
+		}
+		out = append(out, st)
+	}
+	s.logger.Info("budgets listed", "profile_id", p.ID, "count", len(out))
+	return out, p.DefaultCurrency, nil
+}
+
+// CreateBudget validates and stores a new budget.
+func (s *Service) CreateBudget(ctx context.Context, req BudgetRequest) (*entity.Budget, error) {
+	p, err := s.requireProfile(ctx, req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	b := &entity.Budget{ProfileID: p.ID}
+	if err := s.apply(ctx, b, req, true); err != nil {
+		return nil, err
+	}
+	created, err := s.budgetRepo.Create(ctx, b)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "a %s budget for %q already exists", b.Period, b.CategoryName)
+		}
+		return nil, status.Errorf(codes.Internal, "create budget: %v", err)
+	}
+	s.logger.Info("budget created", "profile_id", p.ID, "budget_id", created.ID, "category", created.CategoryName, "period", created.Period, "amount", created.Amount)
+	return created, nil
+}
+
+// UpdateBudget changes a budget; empty fields keep their values.
+func (s *Service) UpdateBudget(ctx context.Context, req BudgetRequest) (*entity.Budget, error) {
+	b, err := s.get(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, b, req, false); err != nil {
+		return nil, err
+	}
+	updated, err := s.budgetRepo.Update(ctx, b)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "a %s budget for %q already exists", b.Period, b.CategoryName)
+		}
+		return nil, status.Errorf(codes.Internal, "update budget: %v", err)
+	}
+	s.logger.Info("budget updated", "budget_id", updated.ID, "category", updated.CategoryName, "period", updated.Period, "amount", updated.Amount)
+	return updated, nil
+}
+
+// DeleteBudget removes a budget and its alerts.
+func (s *Service) DeleteBudget(ctx context.Context, id string) error {
+	b, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.budgetRepo.Delete(ctx, b.ID); err != nil {
+		return status.Errorf(codes.Internal, "delete budget: %v", err)
+	}
+	s.logger.Info("budget deleted", "budget_id", b.ID, "category", b.CategoryName, "period", b.Period)
+	return nil
+}
+
+// ListBudgetAlerts returns the profile's alerts, newest first.
+func (s *Service) ListBudgetAlerts(ctx context.Context, profileID string, limit int) ([]*entity.BudgetAlert, error) {
+	p, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 0 || limit > MaxAlertLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", MaxAlertLimit)
+	}
+	if limit == 0 {
+		limit = DefaultAlertLimit
+	}
+	alerts, err := s.budgetRepo.ListAlerts(ctx, p.ID, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list budget alerts: %v", err)
+	}
+	s.logger.Info("budget alerts listed", "profile_id", p.ID, "count", len(alerts))
+	return alerts, nil
+}
+
+// apply validates the request into b. On create every field is required. The category
+// must be one of the profile's and is stored with its exact spelling.
+func (s *Service) apply(ctx context.Context, b *entity.Budget, req BudgetRequest, create bool) error {
+	if period := strings.ToLower(strings.TrimSpace(req.Period)); period != "" {
+		if period != entity.BudgetMonthly && period != entity.BudgetAnnual {
+			return status.Error(codes.InvalidArgument, "period must be monthly or annual")
+		}
+		b.Period = period
+	} else if create {
+		return status.Error(codes.InvalidArgument, "period is required")
+	}
+
+	if amount := strings.TrimSpace(req.Amount); amount != "" {
+		a, err := money.Parse(amount)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "amount: %v", err)
+		}
+		if a <= 0 {
+			return status.Error(codes.InvalidArgument, "amount must be greater than zero")
+		}
+		b.Amount = a
+	} else if create {
+		return status.Error(codes.InvalidArgument, "amount is required")
+	}
+
+	name := strings.TrimSpace(req.CategoryName)
+	if name == "" {
+		if create {
+			return status.Error(codes.InvalidArgument, "category_name is required")
+		}
+		return nil
+	}
+	if err := s.categoryRepo.EnsureDefaults(ctx, b.ProfileID); err != nil {
+		return status.Errorf(codes.Internal, "seed categories: %v", err)
+	}
+	cats, err := s.categoryRepo.ListByProfile(ctx, b.ProfileID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list categories: %v", err)
+	}
+	for _, c := range cats {
+		if strings.EqualFold(c.Name, name) {
+			b.CategoryName = c.Name
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "category %q is not a category of this profile", name)
+}
+
+func (s *Service) requireProfile(ctx context.Context, profileID string) (*entity.Profile, error) {
+	if strings.TrimSpace(profileID) == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(strings.TrimSpace(profileID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	p, err := s.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "profile not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get profile: %v", err)
+	}
+	return p, nil
+}
+
+func (s *Service) get(ctx context.Context, id string) (*entity.Budget, error) {
+	bid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	b, err := s.budgetRepo.GetByID(ctx, bid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "budget not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get budget: %v", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,190 @@
+package category
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/rules"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultRulePriority = 100
+
+// RuleRequest carries the attributes of a categorization rule.
+type RuleRequest struct {
+	ID              string // update only
+	ProfileID       string // create only
+	Name            string
+	Priority        int
+	Enabled         *bool // nil = true on create, unchanged on update
+	MerchantPattern string
+	PathPattern     string
+	Keywords        []string
+	MinTotal        string
+	MaxTotal        string
+	SetCategory     string
+	ForceReview     bool
+	SetDescription  string
+}
+
+// ListRules returns a profile's rules in evaluation order.
+func (s *Service) ListRules(ctx context.Context, profileID string) ([]*entity.CategoryRule, error) {
+	pid, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.ruleRepo.ListByProfile(ctx, pid, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list rules: %v", err)
+	}
+	s.logger.Info("category rules listed", "profile_id", pid, "count", len(list))
+	return list, nil
+}
+
+// CreateRule validates and stores a new rule.
+func (s *Service) CreateRule(ctx context.Context, req RuleRequest) (*entity.CategoryRule, error) {
+	pid, err := s.requireProfile(ctx, req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := s.buildRule(ctx, pid, req)
+	if err != nil {
+		return nil, err
+	}
+	rule.Enabled = req.Enabled == nil || *req.Enabled
+
+	created, err := s.ruleRepo.Create(ctx, rule)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "rule %q already exists", rule.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "create rule: %v", err)
+	}
+	s.logger.Info("category rule created", "profile_id", pid, "rule_id", created.ID, "name", created.Name)
+	return created, nil
+}
+
+// UpdateRule replaces a rule's attributes.
+func (s *Service) UpdateRule(ctx context.Context, req RuleRequest) (*entity.CategoryRule, error) {
+	existing, err := s.getRule(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := s.buildRule(ctx, existing.ProfileID, req)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = existing.ID
+	rule.Enabled = existing.Enabled
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+
+	updated, err := s.ruleRepo.Update(ctx, rule)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "rule %q already exists", rule.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "update rule: %v", err)
+	}
+	s.logger.Info("category rule updated", "rule_id", updated.ID, "name", updated.Name)
+	return updated, nil
+}
+
+// DeleteRule removes a rule.
+func (s *Service) DeleteRule(ctx context.Context, id string) error {
+	existing, err := s.getRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.ruleRepo.Delete(ctx, existing.ID); err != nil {
+		return status.Errorf(codes.Internal, "delete rule: %v", err)
+	}
+	s.logger.Info("category rule deleted", "rule_id", existing.ID, "name", existing.Name)
+	return nil
+}
+
+// buildRule converts a request into a validated rule. set_category must name one of the
+// profile's categories; it is stored with the category's exact spelling.
+func (s *Service) buildRule(ctx context.Context, profileID uuid.UUID, req RuleRequest) (*entity.CategoryRule, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	rule := &entity.CategoryRule{
+		ProfileID:       profileID,
+		Name:            name,
+		Priority:        req.Priority,
+		MerchantPattern: optional(req.MerchantPattern),
+		PathPattern:     optional(req.PathPattern),
+		Keywords:        normalizeSynonyms(req.Keywords),
+		ForceReview:     req.ForceReview,
+		SetDescription:  optional(req.SetDescription),
+	}
+	if rule.Priority == 0 {
+		rule.Priority = defaultRulePriority
+	}
+
+	var err error
+	if rule.MinTotal, err = optionalAmount(req.MinTotal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "min_total: %v", err)
+	}
+	if rule.MaxTotal, err = optionalAmount(req.MaxTotal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "max_total: %v", err)
+	}
+
+	if cat := strings.TrimSpace(req.SetCategory); cat != "" {
+		if err := s.categoryRepo.EnsureDefaults(ctx, profileID); err != nil {
+			return nil, status.Errorf(codes.Internal, "seed categories: %v", err)
+		}
+		cats, err := s.categoryRepo.ListByProfile(ctx, profileID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list categories: %v", err)
+		}
+		for _, c := range cats {
+			if strings.EqualFold(c.Name, cat) {
+				rule.SetCategory = &c.Name
+				break
+			}
+		}
+		if rule.SetCategory == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "set_category %q is not a category of this profile", cat)
+		}
+	}
+
+	if err := rules.Validate(rule); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule: %v", err)
+	}
+	return rule, nil
+}
+
+func (s *Service) getRule(ctx context.Context, id string) (*entity.CategoryRule, error) {
+	rid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	r, err := s.ruleRepo.GetByID(ctx, rid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "rule not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get rule: %v", err)
+	}
+	return r, nil
+}
+
+func optionalAmount(s string) (*money.Amount, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	a, err := money.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
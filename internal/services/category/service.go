@@ -0,0 +1,248 @@
+package category
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service handles per-profile expense category business logic.
+type Service struct {
+	categoryRepo repository.CategoryRepository
+	ruleRepo     repository.CategoryRuleRepository
+	profileRepo  repository.ProfileRepository
+	logger       *slog.Logger
+}
+
+// NewService creates a new category service.
+func NewService(categoryRepo repository.CategoryRepository, ruleRepo repository.CategoryRuleRepository, profileRepo repository.ProfileRepository, logger *slog.Logger) *Service {
+	return &Service{
+		categoryRepo: categoryRepo,
+		ruleRepo:     ruleRepo,
+		profileRepo:  profileRepo,
+		logger:       logger,
+	}
+}
+
+// CategoryRequest carries the editable attributes of a category.
+type CategoryRequest struct {
+	ID          string // update only
+	ProfileID   string // create only
+	Name        string
+	Description string
+	Synonyms    []string
+	TaxLine     string
+	Account     string
+}
+
+// ListCategories returns the profile's categories, seeding the built-in set on first use.
+func (s *Service) ListCategories(ctx context.Context, profileID string) ([]*entity.Category, error) {
+	pid, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.categoryRepo.EnsureDefaults(ctx, pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "seed categories: %v", err)
+	}
+	cats, err := s.categoryRepo.ListByProfile(ctx, pid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list categories: %v", err)
+	}
+	s.logger.Info("categories listed", "profile_id", pid, "count", len(cats))
+	return cats, nil
+}
+
+// CreateCategory adds a category to a profile.
+func (s *Service) CreateCategory(ctx context.Context, req CategoryRequest) (*entity.Category, error) {
+	pid, err := s.requireProfile(ctx, req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	taxLine, err := normalizeTaxLine(req.TaxLine)
+	if err != nil {
+		return nil, err
+	}
+	account, err := normalizeAccount(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	// Seed first so a profile's first custom category adds to the built-ins rather than replacing them.
+	if err := s.categoryRepo.EnsureDefaults(ctx, pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "seed categories: %v", err)
+	}
+
+	c, err := s.categoryRepo.Create(ctx, &entity.Category{
+		ProfileID:   pid,
+		Name:        name,
+		Description: optional(req.Description),
+		Synonyms:    normalizeSynonyms(req.Synonyms),
+		TaxLine:     taxLine,
+		Account:     account,
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "create category: %v", err)
+	}
+	s.logger.Info("category created", "profile_id", pid, "category_id", c.ID, "name", c.Name)
+	return c, nil
+}
+
+// UpdateCategory replaces a category's attributes; an empty name keeps the current one.
+func (s *Service) UpdateCategory(ctx context.Context, req CategoryRequest) (*entity.Category, error) {
+	existing, err := s.get(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = existing.Name
+	}
+	if existing.Name == string(constants.Other) && name != existing.Name {
+		return nil, status.Error(codes.FailedPrecondition, "'Other' is the fallback category and cannot be renamed")
+	}
+	taxLine, err := normalizeTaxLine(req.TaxLine)
+	if err != nil {
+		return nil, err
+	}
+	account, err := normalizeAccount(req.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.categoryRepo.Update(ctx, &entity.Category{
+		ID:          existing.ID,
+		ProfileID:   existing.ProfileID,
+		Name:        name,
+		Description: optional(req.Description),
+		Synonyms:    normalizeSynonyms(req.Synonyms),
+		TaxLine:     taxLine,
+		Account:     account,
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "update category: %v", err)
+	}
+	s.logger.Info("category updated", "category_id", c.ID, "name", c.Name)
+	return c, nil
+}
+
+// DeleteCategory removes a category. Receipts already filed under it keep their label.
+func (s *Service) DeleteCategory(ctx context.Context, id string) error {
+	existing, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.Name == string(constants.Other) {
+		return status.Error(codes.FailedPrecondition, "'Other' is the fallback category and cannot be deleted")
+	}
+	if err := s.categoryRepo.Delete(ctx, existing.ID); err != nil {
+		return status.Errorf(codes.Internal, "delete category: %v", err)
+	}
+	s.logger.Info("category deleted", "category_id", existing.ID, "name", existing.Name)
+	return nil
+}
+
+func (s *Service) requireProfile(ctx context.Context, profileID string) (uuid.UUID, error) {
+	if strings.TrimSpace(profileID) == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	ok, err := s.profileRepo.Exists(ctx, pid)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Internal, "check profile: %v", err)
+	}
+	if !ok {
+		return uuid.Nil, status.Error(codes.NotFound, "profile not found")
+	}
+	return pid, nil
+}
+
+func (s *Service) get(ctx context.Context, id string) (*entity.Category, error) {
+	cid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	c, err := s.categoryRepo.GetByID(ctx, cid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get category: %v", err)
+	}
+	return c, nil
+}
+
+func optional(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// normalizeTaxLine checks that an optional tax line is a Schedule C expense line and
+// returns it in canonical form ("Line 24B" -> "24b").
+func normalizeTaxLine(line string) (*string, error) {
+	if strings.TrimSpace(line) == "" {
+		return nil, nil
+	}
+	tl, ok := constants.LookupTaxLine(line)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "tax_line %q is not a Schedule C expense line", line)
+	}
+	return &tl.Line, nil
+}
+
+// normalizeAccount checks that an optional account is a colon-separated hierarchy such as
+// "Expenses:Office:Supplies" and trims each part.
+func normalizeAccount(account string) (*string, error) {
+	if strings.TrimSpace(account) == "" {
+		return nil, nil
+	}
+	parts := strings.Split(account, ":")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+		if parts[i] == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "account %q has an empty component", account)
+		}
+	}
+	if len(parts) < 2 {
+		return nil, status.Errorf(codes.InvalidArgument, "account %q needs a parent, e.g. \"Expenses:%s\"", account, parts[0])
+	}
+	out := strings.Join(parts, ":")
+	return &out, nil
+}
+
+// normalizeSynonyms lower-cases, trims and de-duplicates synonyms.
+func normalizeSynonyms(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	var out []string
+	for _, s := range in {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,170 @@
+package category
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+// newTestService returns a service over an in-memory SQLite and a profile in it.
+func newTestService(t *testing.T) (*Service, string) {
+	t.Helper()
+	ctx := context.Background()
+	client, db, err := repository.OpenSQLiteInMemory(slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := repository.MigrateSQLite(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	profiles := repository.NewProfileRepository(client, slog.Default())
+	p, err := profiles.CreateProfile(ctx, &entity.Profile{Name: "Consulting", DefaultCurrency: "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewService(repository.NewCategoryRepository(client, slog.Default()), repository.NewCategoryRuleRepository(client, slog.Default()), profiles, slog.Default())
+	return svc, p.ID.String()
+}
+
+func TestCreateCategory(t *testing.T) {
+	ctx := context.Background()
+	svc, pid := newTestService(t)
+
+	c, err := svc.CreateCategory(ctx, CategoryRequest{
+		ProfileID: pid,
+		Name:      "  Rent ",
+		Synonyms:  []string{"Coworking", "coworking ", ""},
+		TaxLine:   "Line 20B",
+		Account:   " Expenses : Rent ",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Name != "Rent" || len(c.Synonyms) != 1 || c.Synonyms[0] != "coworking" || *c.TaxLine != "20b" || *c.Account != "Expenses:Rent" {
+		t.Errorf("Expected normalized attributes, got name=%q synonyms=%v tax_line=%v account=%v", c.Name, c.Synonyms, *c.TaxLine, *c.Account)
+	}
+
+	// the first custom category is added to the built-in set
+	list, err := svc.ListCategories(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := len(constants.DefaultCategoryDefs()) + 1; len(list) != expected {
+		t.Errorf("Expected %d categories, got %d", expected, len(list))
+	}
+}
+
+func TestCategoryValidation(t *testing.T) {
+	ctx := context.Background()
+	svc, pid := newTestService(t)
+	list, err := svc.ListCategories(ctx, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := map[string]string{}
+	for _, c := range list {
+		ids[c.Name] = c.ID.String()
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		expected codes.Code
+	}{
+		{name: "Missing profile", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{Name: "Rent"})
+			return err
+		}, expected: codes.InvalidArgument},
+		{name: "Malformed profile", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: "nope", Name: "Rent"})
+			return err
+		}, expected: codes.InvalidArgument},
+		{name: "Unknown profile", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: uuid.NewString(), Name: "Rent"})
+			return err
+		}, expected: codes.NotFound},
+		{name: "Empty name", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: pid, Name: " "})
+			return err
+		}, expected: codes.InvalidArgument},
+		{name: "Unknown tax line", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: pid, Name: "Rent", TaxLine: "99"})
+			return err
+		}, expected: codes.InvalidArgument},
+		{name: "Account without parent", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: pid, Name: "Rent", Account: "Rent"})
+			return err
+		}, expected: codes.InvalidArgument},
+		{name: "Duplicate name", call: func() error {
+			_, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: pid, Name: string(constants.Meals)})
+			return err
+		}, expected: codes.AlreadyExists},
+		{name: "Rename onto another category", call: func() error {
+			_, err := svc.UpdateCategory(ctx, CategoryRequest{ID: ids[string(constants.Meals)], Name: string(constants.Internet)})
+			return err
+		}, expected: codes.AlreadyExists},
+		{name: "Rename Other", call: func() error {
+			_, err := svc.UpdateCategory(ctx, CategoryRequest{ID: ids[string(constants.Other)], Name: "Misc"})
+			return err
+		}, expected: codes.FailedPrecondition},
+		{name: "Delete Other", call: func() error {
+			return svc.DeleteCategory(ctx, ids[string(constants.Other)])
+		}, expected: codes.FailedPrecondition},
+		{name: "Malformed id", call: func() error {
+			return svc.DeleteCategory(ctx, "nope")
+		}, expected: codes.InvalidArgument},
+		{name: "Unknown id", call: func() error {
+			_, err := svc.UpdateCategory(ctx, CategoryRequest{ID: uuid.NewString(), Name: "Rent"})
+			return err
+		}, expected: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.call()); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUpdateAndDeleteCategory(t *testing.T) {
+	ctx := context.Background()
+	svc, pid := newTestService(t)
+	c, err := svc.CreateCategory(ctx, CategoryRequest{ProfileID: pid, Name: "Rent", Synonyms: []string{"coworking"}, TaxLine: "20b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an empty name keeps the current one; omitted attributes are cleared
+	updated, err := svc.UpdateCategory(ctx, CategoryRequest{ID: c.ID.String(), Description: "Desk and office rent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Rent" || updated.Description == nil || *updated.Description != "Desk and office rent" || len(updated.Synonyms) != 0 || updated.TaxLine != nil {
+		t.Errorf("Expected the name kept and the rest replaced, got %+v", updated)
+	}
+
+	renamed, err := svc.UpdateCategory(ctx, CategoryRequest{ID: c.ID.String(), Name: "Office Rent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed.Name != "Office Rent" {
+		t.Errorf("Expected %q, got %q", "Office Rent", renamed.Name)
+	}
+
+	if err := svc.DeleteCategory(ctx, c.ID.String()); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DeleteCategory(ctx, c.ID.String()); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound after delete, got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// FieldDelta is the accuracy change of one field versus the baseline.
+type FieldDelta struct {
+	Field    string  `json:"field"`
+	Baseline float64 `json:"baseline"`
+	Current  float64 `json:"current"`
+	Delta    float64 `json:"delta"`
+}
+
+// Regression is a labeled field that was correct in the baseline but is wrong now.
+type Regression struct {
+	Path     string `json:"path"`
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Got      string `json:"got"`
+}
+
+// Comparison summarizes a run against a saved baseline.
+type Comparison struct {
+	Fields      []FieldDelta `json:"fields"`
+	Regressions []Regression `json:"regressions"`
+	Fixes       int          `json:"fixes"` // fields wrong in the baseline and correct now
+}
+
+// LoadReport reads a report previously written by SaveReport.
+func LoadReport(path string) (*Report, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, fmt.Errorf("decode report %s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// SaveReport writes r as indented JSON.
+func SaveReport(path string, r *Report) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+// Diff compares current against baseline. Files are matched by relative path;
+// files present in only one of the reports are ignored for regressions.
+func Diff(baseline, current *Report) Comparison {
+	var c Comparison
+	for _, f := range allFields {
+		b, cur := baseline.Fields[f], current.Fields[f]
+		c.Fields = append(c.Fields, FieldDelta{
+			Field:    f,
+			Baseline: b.Accuracy,
+			Current:  cur.Accuracy,
+			Delta:    cur.Accuracy - b.Accuracy,
+		})
+	}
+
+	prev := make(map[string]FileResult, len(baseline.Files))
+	for _, fr := range baseline.Files {
+		prev[fr.Path] = fr
+	}
+	for _, fr := range current.Files {
+		old, ok := prev[fr.Path]
+		if !ok {
+			continue
+		}
+		for _, f := range allFields {
+			was, wasLabeled := old.Matches[f]
+			now, nowLabeled := fr.Matches[f]
+			if !wasLabeled || !nowLabeled {
+				continue
+			}
+			switch {
+			case was && !now:
+				c.Regressions = append(c.Regressions, Regression{
+					Path:     fr.Path,
+					Field:    f,
+					Expected: truthValue(fr.Truth, f),
+					Got:      predictionValue(fr.Prediction, f),
+				})
+			case !was && now:
+				c.Fixes++
+			}
+		}
+	}
+	sort.Slice(c.Regressions, func(i, j int) bool {
+		if c.Regressions[i].Path != c.Regressions[j].Path {
+			return c.Regressions[i].Path < c.Regressions[j].Path
+		}
+		return c.Regressions[i].Field < c.Regressions[j].Field
+	})
+	return c
+}
+
+func truthValue(t Truth, field string) string {
+	return fieldValue(field, t.MerchantName, t.TxDate, t.Total, t.Tax, t.Category)
+}
+
+func predictionValue(p Prediction, field string) string {
+	return fieldValue(field, p.MerchantName, p.TxDate, p.Total, p.Tax, p.Category)
+}
+
+func fieldValue(field, merchant, date, total, tax, category string) string {
+	switch field {
+	case FieldMerchant:
+		return merchant
+	case FieldDate:
+		return date
+	case FieldTotal:
+		return total
+	case FieldTax:
+		return tax
+	case FieldCategory:
+		return category
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+package eval
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// Scored fields, in report order.
+const (
+	FieldMerchant = "merchant"
+	FieldDate     = "date"
+	FieldTotal    = "total"
+	FieldTax      = "tax"
+	FieldCategory = "category"
+)
+
+var allFields = []string{FieldMerchant, FieldDate, FieldTotal, FieldTax, FieldCategory}
+
+// Prediction is what the pipeline produced for one file (after post-LLM adjustments).
+type Prediction struct {
+	MerchantName string `json:"merchant_name,omitempty"`
+	TxDate       string `json:"tx_date,omitempty"`
+	Total        string `json:"total,omitempty"`
+	Tax          string `json:"tax,omitempty"`
+	Category     string `json:"category,omitempty"`
+}
+
+// FileResult is the per-file outcome. Matches only contains labeled fields.
+type FileResult struct {
+	Path       string          `json:"path"` // relative to the corpus root
+	Truth      Truth           `json:"truth"`
+	Prediction Prediction      `json:"prediction"`
+	Matches    map[string]bool `json:"matches"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// FieldScore aggregates one field across the corpus.
+type FieldScore struct {
+	Correct  int     `json:"correct"`
+	Labeled  int     `json:"labeled"`
+	Accuracy float64 `json:"accuracy"`
+}
+
+// Report is the full evaluation output; it doubles as the saved baseline format.
+type Report struct {
+	GeneratedAt time.Time             `json:"generated_at"`
+	Label       string                `json:"label,omitempty"` // free-form, e.g. prompt version or model
+	Files       []FileResult          `json:"files"`
+	Fields      map[string]FieldScore `json:"fields"`
+	// CategoryConfusion[truth][predicted] = count, for labeled files only.
+	CategoryConfusion map[string]map[string]int `json:"category_confusion"`
+	// CategoryConfusionRate is the share of labeled categories that were predicted as a different category.
+	CategoryConfusionRate float64 `json:"category_confusion_rate"`
+}
+
+// Compare scores a prediction against the truth. Unlabeled fields are omitted.
+func Compare(t Truth, p Prediction) map[string]bool {
+	m := make(map[string]bool, len(allFields))
+	if t.MerchantName != "" {
+		m[FieldMerchant] = merchantMatches(t.MerchantName, p.MerchantName)
+	}
+	if t.TxDate != "" {
+		m[FieldDate] = strings.TrimSpace(t.TxDate) == strings.TrimSpace(p.TxDate)
+	}
+	if t.Total != "" {
+		m[FieldTotal] = amountMatches(t.Total, p.Total)
+	}
+	if t.Tax != "" {
+		// a missing tax prediction is equivalent to 0.00
+		m[FieldTax] = amountMatches(t.Tax, p.Tax)
+	}
+	if t.Category != "" {
+		m[FieldCategory] = strings.EqualFold(strings.TrimSpace(t.Category), strings.TrimSpace(p.Category))
+	}
+	return m
+}
+
+// BuildReport aggregates per-file results into field accuracy and category confusion.
+func BuildReport(label string, files []FileResult) *Report {
+	r := &Report{
+		GeneratedAt:       time.Now().UTC(),
+		Label:             label,
+		Files:             files,
+		Fields:            make(map[string]FieldScore, len(allFields)),
+		CategoryConfusion: map[string]map[string]int{},
+	}
+	sort.Slice(r.Files, func(i, j int) bool { return r.Files[i].Path < r.Files[j].Path })
+
+	for _, f := range allFields {
+		var s FieldScore
+		for _, fr := range files {
+			ok, labeled := fr.Matches[f]
+			if !labeled {
+				continue
+			}
+			s.Labeled++
+			if ok {
+				s.Correct++
+			}
+		}
+		if s.Labeled > 0 {
+			s.Accuracy = float64(s.Correct) / float64(s.Labeled)
+		}
+		r.Fields[f] = s
+	}
+
+	var labeled, confused int
+	for _, fr := range files {
+		if fr.Truth.Category == "" {
+			continue
+		}
+		pred := fr.Prediction.Category
+		if pred == "" {
+			pred = "(none)"
+		}
+		row := r.CategoryConfusion[fr.Truth.Category]
+		if row == nil {
+			row = map[string]int{}
+			r.CategoryConfusion[fr.Truth.Category] = row
+		}
+		row[pred]++
+		labeled++
+		if !fr.Matches[FieldCategory] {
+			confused++
+		}
+	}
+	if labeled > 0 {
+		r.CategoryConfusionRate = float64(confused) / float64(labeled)
+	}
+	return r
+}
+
+func merchantMatches(truth, pred string) bool {
+	t, p := nameWords(truth), nameWords(pred)
+	if len(t) == 0 || len(p) == 0 {
+		return false
+	}
+	if strings.Join(t, "") == strings.Join(p, "") { // "Wal-Mart" vs "WALMART"
+		return true
+	}
+	// "Staples" vs "Staples Inc #1234" should count as a match, but only on whole words:
+	// "Tar" is not "Target" and "Staplesfoo" is not "Staples".
+	if len(p) < len(t) {
+		t, p = p, t
+	}
+	for i, w := range t {
+		if p[i] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// nameWords lower-cases s and splits it into words of letters and digits.
+func nameWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// amountMatches compares exactly in cents; "42.1" and "42.10" are equal.
+func amountMatches(truth, pred string) bool {
+	t, err := money.Parse(truth)
+	if err != nil {
+		return false
+	}
+	var p money.Amount
+	if s := strings.TrimSpace(pred); s != "" {
+		if p, err = money.Parse(s); err != nil {
+			return false
+		}
+	}
+	return t == p
+}
@@ -0,0 +1,84 @@
+package eval
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	truth := Truth{MerchantName: "Staples", TxDate: "2025-03-14", Total: "42.18", Tax: "0", Category: "Office Supplies"}
+
+	tests := []struct {
+		name     string
+		pred     Prediction
+		expected map[string]bool
+	}{
+		{
+			name: "All fields match",
+			pred: Prediction{MerchantName: "STAPLES #1234", TxDate: "2025-03-14", Total: "42.180", Category: "office supplies"},
+			expected: map[string]bool{
+				FieldMerchant: true, FieldDate: true, FieldTotal: true, FieldTax: true, FieldCategory: true,
+			},
+		},
+		{
+			name: "Wrong total and category",
+			pred: Prediction{MerchantName: "Staples", TxDate: "2025-03-14", Total: "41.18", Tax: "0.00", Category: "Office Equipment"},
+			expected: map[string]bool{
+				FieldMerchant: true, FieldDate: true, FieldTotal: false, FieldTax: true, FieldCategory: false,
+			},
+		},
+		{
+			name: "Empty prediction",
+			pred: Prediction{},
+			expected: map[string]bool{
+				FieldMerchant: false, FieldDate: false, FieldTotal: false, FieldTax: true, FieldCategory: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compare(truth, tt.pred)
+			for f, want := range tt.expected {
+				if got[f] != want {
+					t.Errorf("field %s: expected %v, got %v", f, want, got[f])
+				}
+			}
+		})
+	}
+}
+
+func TestMerchantMatches(t *testing.T) {
+	tests := []struct {
+		truth, pred string
+		expected    bool
+	}{
+		{"Staples", "STAPLES #1234", true},
+		{"Staples Inc #1234", "Staples", true},
+		{"Wal-Mart", "WALMART", true},
+		{"Target", "T", false},
+		{"Target", "Tar", false},
+		{"Staples", "Staplesfoo", false},
+		{"Staples", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := merchantMatches(tt.truth, tt.pred); got != tt.expected {
+			t.Errorf("merchantMatches(%q, %q): expected %v, got %v", tt.truth, tt.pred, tt.expected, got)
+		}
+	}
+}
+
+func TestDiffReportsRegressions(t *testing.T) {
+	truth := Truth{Total: "10.00", Category: "Meals"}
+	good := Prediction{Total: "10.00", Category: "Meals"}
+	bad := Prediction{Total: "10.00", Category: "Other"}
+
+	baseline := BuildReport("v1", []FileResult{{Path: "a.pdf", Truth: truth, Prediction: good, Matches: Compare(truth, good)}})
+	current := BuildReport("v2", []FileResult{{Path: "a.pdf", Truth: truth, Prediction: bad, Matches: Compare(truth, bad)}})
+
+	cmp := Diff(baseline, current)
+	if len(cmp.Regressions) != 1 || cmp.Regressions[0].Field != FieldCategory {
+		t.Fatalf("Expected one category regression, got %+v", cmp.Regressions)
+	}
+	if current.CategoryConfusionRate != 1 {
+		t.Errorf("Expected confusion rate 1, got %v", current.CategoryConfusionRate)
+	}
+}
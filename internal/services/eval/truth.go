@@ -0,0 +1,36 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// TruthSuffix is appended to a receipt file name to locate its ground-truth sidecar,
+// e.g. "staples-2025-03-14.pdf" -> "staples-2025-03-14.pdf.json".
+const TruthSuffix = ".json"
+
+// Truth is the hand-labeled ground truth for one receipt file.
+// Empty fields are treated as "not labeled" and are skipped when scoring.
+type Truth struct {
+	MerchantName string `json:"merchant_name,omitempty"`
+	TxDate       string `json:"tx_date,omitempty"` // YYYY-MM-DD
+	Total        string `json:"total,omitempty"`   // decimal
+	Tax          string `json:"tax,omitempty"`     // decimal
+	Category     string `json:"category,omitempty"`
+}
+
+// LoadTruth reads the sidecar for receiptPath. ok is false when no sidecar exists.
+func LoadTruth(receiptPath string) (truth Truth, ok bool, err error) {
+	b, err := os.ReadFile(receiptPath + TruthSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Truth{}, false, nil
+		}
+		return Truth{}, false, err
+	}
+	if err := json.Unmarshal(b, &truth); err != nil {
+		return Truth{}, false, fmt.Errorf("decode truth %s: %w", receiptPath+TruthSuffix, err)
+	}
+	return truth, true, nil
+}
@@ -0,0 +1,251 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+const (
+	bundleWorkbook = "receipts.xlsx"
+	bundleTable    = "receipts.csv"
+	bundleManifest = "manifest.csv"
+	bundleFilesDir = "receipts"
+)
+
+// bundleEntry ties a row of the bundled workbook to the receipt file copied for it.
+type bundleEntry struct {
+	receipt *entity.Receipt
+	source  string // path of the stored receipt file; empty when the receipt has none
+	name    string // path inside the bundle; empty when the file is not bundled
+	note    string
+	first   bool // the first row referencing the file, which copies it
+}
+
+// WriteBundle streams a ZIP archive to w with the receipts workbook and its Receipts
+// sheet as CSV, a copy of every receipt file in a folder per category, and a
+// manifest.csv mapping workbook rows to the bundled files. Files are renamed to "<date>_<merchant>_<total>.<ext>" and the
+// workbook links to the copies, so the archive can be handed over as is. Dates behave
+// as in ExportReceiptsXLSX; columns lay out the workbook as in WriteReceiptsXLSX.
+func (s *Service) WriteBundle(ctx context.Context, w io.Writer, profileID uuid.UUID, from, to *time.Time, columns []entity.ExportColumn) error {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
+
+	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
+	if err != nil {
+		return fmt.Errorf("query receipts: %w", err)
+	}
+	profileCurrency := ""
+	if prof, err := s.ent.Profile.Get(ctx, profileID); err == nil {
+		profileCurrency = prof.DefaultCurrency
+	}
+
+	entries := s.bundleEntries(ctx, recs)
+	zw := zip.NewWriter(w)
+
+	fw, err := zw.Create(bundleWorkbook)
+	if err != nil {
+		return fmt.Errorf("zip workbook: %w", err)
+	}
+	nameOf := make(map[*entity.Receipt]string, len(entries))
+	for _, e := range entries {
+		nameOf[e.receipt] = e.name
+	}
+	bundledPath := func(r *entity.Receipt) string { return nameOf[r] }
+	if err := WriteReceiptsWorkbook(fw, recs, columns, profileCurrency, bundledPath); err != nil {
+		return err
+	}
+	tw, err := zw.Create(bundleTable)
+	if err != nil {
+		return fmt.Errorf("zip csv: %w", err)
+	}
+	if err := WriteReceiptsSheetCSV(tw, recs, columns, profileCurrency, bundledPath); err != nil {
+		return fmt.Errorf("csv: %w", err)
+	}
+
+	copied := 0
+	for i := range entries {
+		e := &entries[i]
+		if e.name == "" || !e.first {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := copyIntoZip(zw, e.name, e.source); err != nil {
+			return fmt.Errorf("bundle %s: %w", e.source, err)
+		}
+		copied++
+	}
+
+	mw, err := zw.Create(bundleManifest)
+	if err != nil {
+		return fmt.Errorf("zip manifest: %w", err)
+	}
+	if err := writeManifest(mw, entries); err != nil {
+		return fmt.Errorf("manifest: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("zip close: %w", err)
+	}
+
+	s.logger.Info("export.bundle.ok",
+		"profile_id", profileID.String(),
+		"rows", len(recs),
+		"files", copied,
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return nil
+}
+
+// bundleEntries resolves the receipt files and names their copies, one per file even
+// when several receipts share it. Files that can no longer be read are left out and
+// noted in the manifest.
+func (s *Service) bundleEntries(ctx context.Context, recs []*entity.Receipt) []bundleEntry {
+	entries := make([]bundleEntry, 0, len(recs))
+	byFile := map[uuid.UUID]string{}
+	used := map[string]bool{}
+	for _, r := range recs {
+		e := bundleEntry{receipt: r}
+		if r.FileID == nil || *r.FileID == uuid.Nil {
+			e.note = "no receipt file"
+			entries = append(entries, e)
+			continue
+		}
+		file, err := s.filesRepo.GetByID(ctx, *r.FileID)
+		if err != nil {
+			s.logger.Warn("export.bundle.file_lookup_failed", "receipt_id", r.ID, "file_id", r.FileID.String(), "err", err)
+			e.note = "receipt file not found"
+			entries = append(entries, e)
+			continue
+		}
+		e.source = file.SourcePath
+		if name, ok := byFile[file.ID]; ok {
+			e.name = name
+			entries = append(entries, e)
+			continue
+		}
+		if _, err := os.Stat(file.SourcePath); err != nil {
+			s.logger.Warn("export.bundle.file_missing", "receipt_id", r.ID, "path", file.SourcePath, "err", err)
+			e.note = "receipt file missing on disk"
+			entries = append(entries, e)
+			continue
+		}
+		ext := file.FileExt
+		if ext == "" {
+			ext = strings.TrimPrefix(path.Ext(file.SourcePath), ".")
+		}
+		e.name = uniqueName(BundleFileName(r, ext), used)
+		e.first = true
+		byFile[file.ID] = e.name
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// BundleFileName is the stable path of a receipt file inside a bundle:
+// "<category>/<date>_<merchant>_<total>.<ext>", e.g.
+// "receipts/Office Supplies/2025-03-14_Staples_42.18.pdf".
+func BundleFileName(r *entity.Receipt, ext string) string {
+	category := safeSegment(r.CategoryName, " ")
+	if category == "" {
+		category = "Uncategorized"
+	}
+	merchant := safeSegment(merchantname.Canonical(r.MerchantName), "-")
+	if merchant == "" {
+		merchant = "Unknown"
+	}
+	name := r.TxDate.Format("2006-01-02") + "_" + merchant + "_" + r.Total.String()
+	if ext = strings.ToLower(strings.TrimPrefix(ext, ".")); ext != "" {
+		name += "." + ext
+	}
+	return path.Join(bundleFilesDir, category, name)
+}
+
+// uniqueName appends "_2", "_3", ... before the extension until name is unused.
+func uniqueName(name string, used map[string]bool) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	candidate := name
+	for i := 2; used[strings.ToLower(candidate)]; i++ {
+		candidate = base + "_" + strconv.Itoa(i) + ext
+	}
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+// safeSegment keeps letters, digits and a few punctuation marks so s is a portable
+// file or folder name; runs of anything else become sep.
+func safeSegment(s, sep string) string {
+	var b strings.Builder
+	pending := false
+	for _, r := range strings.TrimSpace(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '&' || r == '\'' || r == '-' || r == '.' {
+			if pending && b.Len() > 0 {
+				b.WriteString(sep)
+			}
+			pending = false
+			b.WriteRune(r)
+			continue
+		}
+		pending = true
+	}
+	return strings.Trim(b.String(), ".")
+}
+
+func copyIntoZip(zw *zip.Writer, name, source string) error {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = src.Close() }()
+
+	hdr := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	if info, err := src.Stat(); err == nil {
+		hdr.Modified = info.ModTime()
+	}
+	dst, err := zw.CreateHeader(hdr)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	return err
+}
+
+// writeManifest lists one line per workbook row; Row matches the row in the Receipts sheet
+// and the line in receipts.csv.
+func writeManifest(w io.Writer, entries []bundleEntry) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"Row", "Receipt ID", "Transaction Date", "Merchant", "Expense Category",
+		"Amount", "Currency", "Bundled File", "Original Path", "Note"})
+	for i, e := range entries {
+		r := e.receipt
+		_ = cw.Write([]string{
+			strconv.Itoa(i + 2),
+			r.ID.String(),
+			r.TxDate.Format("2006-01-02"),
+			r.MerchantName,
+			r.CategoryName,
+			r.Total.String(),
+			r.CurrencyCode,
+			e.name,
+			e.source,
+			e.note,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
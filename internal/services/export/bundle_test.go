@@ -0,0 +1,58 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestBundleFileName(t *testing.T) {
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		merchant string
+		category string
+		ext      string
+		expected string
+	}{
+		{
+			name:     "Store number dropped",
+			merchant: "STAPLES #1234",
+			category: "Office Supplies",
+			ext:      "PDF",
+			expected: "receipts/Office Supplies/2025-03-14_Staples_42.18.pdf",
+		},
+		{
+			name:     "Spaces and slashes",
+			merchant: "Home Depot / Pro Desk",
+			category: "Gear/Tools",
+			ext:      ".jpg",
+			expected: "receipts/Gear Tools/2025-03-14_Home-Depot-Pro-Desk_42.18.jpg",
+		},
+		{
+			name:     "Missing merchant and category",
+			expected: "receipts/Uncategorized/2025-03-14_Unknown_42.18",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &entity.Receipt{TxDate: date, MerchantName: tt.merchant, CategoryName: tt.category, Total: money.MustParse("42.18")}
+			if got := BundleFileName(r, tt.ext); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	used := map[string]bool{}
+	names := []string{"a/x.pdf", "a/X.pdf", "a/x.pdf", "a/y"}
+	expected := []string{"a/x.pdf", "a/X_2.pdf", "a/x_3.pdf", "a/y"}
+	for i, n := range names {
+		if got := uniqueName(n, used); got != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], got)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// WriteReceiptsSheetCSV writes the Receipts sheet of WriteReceiptsWorkbook as CSV: the
+// same columns and rows, without the totals row. Dates are written as YYYY-MM-DD and
+// amounts as plain decimals whatever the column's format, so the file imports cleanly.
+func WriteReceiptsSheetCSV(w io.Writer, recs []*entity.Receipt, columns []entity.ExportColumn, profileCurrency string, pathOf func(*entity.Receipt) string) error {
+	layout, err := newSheetLayout(columns)
+	if err != nil {
+		return fmt.Errorf("template: %w", err)
+	}
+	cw := csv.NewWriter(w)
+	header := make([]string, len(layout.columns))
+	for i, c := range layout.columns {
+		header[i] = c.headerIn(profileCurrency)
+	}
+	_ = cw.Write(header)
+	for _, r := range recs {
+		row := make([]string, len(layout.columns))
+		for j, c := range layout.columns {
+			row[j] = csvValue(c.value(r, pathOf, profileCurrency))
+		}
+		_ = cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case time.Time:
+		if !v.IsZero() {
+			return v.Format("2006-01-02")
+		}
+	case *money.Amount:
+		if v != nil {
+			return v.String()
+		}
+	case *float64:
+		if v != nil {
+			return strconv.FormatFloat(*v, 'f', -1, 64)
+		}
+	case bool:
+		if v {
+			return "Yes"
+		}
+	}
+	return ""
+}
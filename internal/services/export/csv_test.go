@@ -0,0 +1,83 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestWriteReceiptsSheetCSV(t *testing.T) {
+	d, _ := time.Parse("2006-01-02", "2025-03-14")
+	tip, converted, rate, source := money.MustParse("5.00"), money.MustParse("32.40"), 1.08, "ECB"
+	recs := []*entity.Receipt{
+		{TxDate: d, MerchantName: "Staples", CategoryName: "Office Supplies", Total: money.MustParse("42.18"), CurrencyCode: "USD",
+			Description: "Printer paper, toner", NeedsReview: true},
+		{TxDate: d, MerchantName: "Café de Flore", CategoryName: "Meals", Total: money.MustParse("30.00"), CurrencyCode: "EUR", Tip: &tip,
+			ConvertedTotal: &converted, FxRate: &rate, FxSource: &source},
+	}
+	pathOf := func(r *entity.Receipt) string { return "receipts/" + r.MerchantName + ".pdf" }
+
+	t.Run("Default columns", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WriteReceiptsSheetCSV(&buf, recs, nil, "USD", pathOf); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 3 {
+			t.Fatalf("Expected a header and 2 rows, got %d", len(rows))
+		}
+		tests := []struct {
+			name     string
+			got      string
+			expected string
+		}{
+			{"Converted header", rows[0][5], "Converted Amount (USD)"},
+			{"Date", rows[1][0], "2025-03-14"},
+			{"Amount", rows[1][3], "42.18"},
+			{"Converted amount", rows[2][5], "32.40"},
+			{"Rate", rows[2][6], "1.08"},
+			{"Empty amount", rows[1][10], ""},
+			{"Tip", rows[2][10], "5.00"},
+			{"Text with comma", rows[1][11], "Printer paper, toner"},
+			{"File path", rows[2][12], "receipts/Café de Flore.pdf"},
+			{"Needs review", rows[1][13], "Yes"},
+		}
+		for _, tt := range tests {
+			if tt.got != tt.expected {
+				t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, tt.got)
+			}
+		}
+	})
+
+	t.Run("Template columns", func(t *testing.T) {
+		var buf bytes.Buffer
+		columns := []entity.ExportColumn{
+			{Header: "Merchant", Field: "merchant | upper"},
+			{Header: "Date", Field: "tx_date", Format: "dd/mm/yyyy"},
+			{Field: "total"},
+		}
+		if err := WriteReceiptsSheetCSV(&buf, recs, columns, "USD", pathOf); err != nil {
+			t.Fatal(err)
+		}
+		// the display format is not applied; dates stay sortable
+		expected := "Merchant,Date,total\nSTAPLES,2025-03-14,42.18\nCAFÉ DE FLORE,2025-03-14,30.00\n"
+		if got := buf.String(); got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Invalid template", func(t *testing.T) {
+		err := WriteReceiptsSheetCSV(&bytes.Buffer{}, recs, []entity.ExportColumn{{Field: "nope"}}, "USD", pathOf)
+		if err == nil || !strings.Contains(err.Error(), "unknown field") {
+			t.Errorf("Expected an unknown field error, got %v", err)
+		}
+	})
+}
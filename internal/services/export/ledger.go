@@ -0,0 +1,465 @@
+package export
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// LedgerFormat selects an accounting export.
+type LedgerFormat string
+
+const (
+	FormatQIF       LedgerFormat = "qif"
+	FormatOFX       LedgerFormat = "ofx"
+	FormatLedger    LedgerFormat = "ledger"
+	FormatBeancount LedgerFormat = "beancount"
+)
+
+// DefaultPaymentAccount is the account receipts are paid from unless LedgerOptions says otherwise.
+const DefaultPaymentAccount = "Liabilities:CreditCard"
+
+// LedgerOptions configures the accounting exports.
+type LedgerOptions struct {
+	// Accounts maps category names to accounts, overriding the categories' own account.
+	// Categories without either post to "Expenses:<category>".
+	Accounts map[string]string
+	// PaymentAccount balances every transaction; DefaultPaymentAccount when empty.
+	PaymentAccount string
+}
+
+// ledgerEntry is one receipt as a two-legged accounting transaction.
+type ledgerEntry struct {
+	ID        uuid.UUID
+	Date      time.Time
+	Payee     string
+	Memo      string
+	Account   string
+	Payment   string
+	Amount    money.Amount // as printed on the receipt
+	Currency  string
+	Converted *money.Amount // in the profile currency; nil when unconverted
+	FilePath  string
+}
+
+// WriteLedger writes the receipts as transactions for accounting software: QIF or OFX for
+// importers such as GnuCash, or ledger-cli / beancount journal entries. The merchant is the
+// payee, the description the memo and the receipt file path is kept as metadata (in the memo
+// for QIF and OFX, which have no metadata). QIF and OFX are single-currency, so receipts
+// without an amount in the profile currency are left out of them. Dates behave as in
+// ExportReceiptsXLSX.
+func (s *Service) WriteLedger(ctx context.Context, w io.Writer, format LedgerFormat, profileID uuid.UUID, from, to *time.Time, opts LedgerOptions) error {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
+
+	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
+	if err != nil {
+		return fmt.Errorf("query receipts: %w", err)
+	}
+	profileCurrency := ""
+	if prof, err := s.ent.Profile.Get(ctx, profileID); err == nil {
+		profileCurrency = prof.DefaultCurrency
+	}
+	cats, err := s.categoryRepo.ListByProfile(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("list categories: %w", err)
+	}
+
+	entries := buildLedgerEntries(recs, accountResolver(cats, opts.Accounts), opts.PaymentAccount, profileCurrency, s.filePath(ctx))
+	var skipped int
+	switch format {
+	case FormatQIF:
+		skipped, err = writeQIF(w, entries)
+	case FormatOFX:
+		skipped, err = writeOFX(w, entries, profileCurrency, time.Now())
+	case FormatLedger:
+		err = writeLedgerJournal(w, entries, profileCurrency)
+	case FormatBeancount:
+		err = writeBeancount(w, entries, profileCurrency)
+	default:
+		return fmt.Errorf("unknown ledger format %q", format)
+	}
+	if err != nil {
+		return fmt.Errorf("%s write: %w", format, err)
+	}
+	if skipped > 0 {
+		s.logger.Warn("export.ledger.unconverted_skipped", "profile_id", profileID.String(), "format", string(format), "count", skipped)
+	}
+
+	s.logger.Info("export.ledger.ok",
+		"profile_id", profileID.String(),
+		"format", string(format),
+		"rows", len(entries)-skipped,
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return nil
+}
+
+// accountResolver maps a category name to its account: the override, then the
+// category's account, then "Expenses:<category>".
+func accountResolver(cats []*entity.Category, overrides map[string]string) func(string) string {
+	accounts := map[string]string{}
+	for _, c := range cats {
+		if c.Account != nil && *c.Account != "" {
+			accounts[c.Name] = *c.Account
+		}
+	}
+	for name, account := range overrides {
+		if account = strings.TrimSpace(account); account != "" {
+			accounts[name] = account
+		}
+	}
+	return func(category string) string {
+		if a, ok := accounts[category]; ok {
+			return a
+		}
+		if category == "" {
+			category = "Uncategorized"
+		}
+		return "Expenses:" + category
+	}
+}
+
+func buildLedgerEntries(recs []*entity.Receipt, accountOf func(string) string, payment, profileCurrency string, pathOf func(*entity.Receipt) string) []ledgerEntry {
+	if payment = strings.TrimSpace(payment); payment == "" {
+		payment = DefaultPaymentAccount
+	}
+	entries := make([]ledgerEntry, 0, len(recs))
+	for _, r := range recs {
+		e := ledgerEntry{
+			ID:       r.ID,
+			Date:     r.TxDate,
+			Payee:    oneLine(r.MerchantName),
+			Memo:     oneLine(r.Description),
+			Account:  accountOf(r.CategoryName),
+			Payment:  payment,
+			Amount:   r.Total,
+			Currency: r.CurrencyCode,
+			FilePath: pathOf(r),
+		}
+		if profileCurrency != "" {
+			e.Converted = amountInCurrency(r, profileCurrency)
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	return entries
+}
+
+// writeQIF writes a QIF file for the payment account; the mapped account is the
+// transaction category. It returns the number of unconverted receipts left out.
+func writeQIF(w io.Writer, entries []ledgerEntry) (int, error) {
+	var b strings.Builder
+	payment := DefaultPaymentAccount
+	if len(entries) > 0 {
+		payment = entries[0].Payment
+	}
+	qifType := qifAccountType(payment)
+	fmt.Fprintf(&b, "!Account\nN%s\nT%s\n^\n!Type:%s\n", payment, qifType, qifType)
+	skipped := 0
+	for _, e := range entries {
+		if e.Converted == nil {
+			skipped++
+			continue
+		}
+		fmt.Fprintf(&b, "D%s\nT%s\nP%s\n", e.Date.Format("01/02/2006"), (-*e.Converted).String(), e.Payee)
+		if memo := memoWithFile(e.Memo, e.FilePath); memo != "" {
+			fmt.Fprintf(&b, "M%s\n", memo)
+		}
+		fmt.Fprintf(&b, "L%s\n^\n", e.Account)
+	}
+	_, err := io.WriteString(w, b.String())
+	return skipped, err
+}
+
+func qifAccountType(account string) string {
+	switch root := strings.ToLower(strings.SplitN(account, ":", 2)[0]); {
+	case root == "liabilities" || root == "liability":
+		return "CCard"
+	case strings.Contains(strings.ToLower(account), "cash"):
+		return "Cash"
+	default:
+		return "Bank"
+	}
+}
+
+// OFX 2.2 documents; only the elements a statement import needs.
+type (
+	ofxDoc struct {
+		XMLName xml.Name  `xml:"OFX"`
+		Signon  ofxSignon `xml:"SIGNONMSGSRSV1>SONRS"`
+		Msgs    ofxMsgs
+	}
+	ofxSignon struct {
+		Status   ofxStatus `xml:"STATUS"`
+		DTServer string    `xml:"DTSERVER"`
+		Language string    `xml:"LANGUAGE"`
+	}
+	ofxStatus struct {
+		Code     int    `xml:"CODE"`
+		Severity string `xml:"SEVERITY"`
+	}
+	ofxMsgs struct {
+		XMLName xml.Name // BANKMSGSRSV1 or CREDITCARDMSGSRSV1
+		TrnRs   ofxTrnRs
+	}
+	ofxTrnRs struct {
+		XMLName xml.Name  // STMTTRNRS or CCSTMTTRNRS
+		TrnUID  string    `xml:"TRNUID"`
+		Status  ofxStatus `xml:"STATUS"`
+		StmtRs  ofxStmtRs
+	}
+	ofxStmtRs struct {
+		XMLName   xml.Name     // STMTRS or CCSTMTRS
+		CurDef    string       `xml:"CURDEF"`
+		BankAcct  *ofxBankAcct `xml:"BANKACCTFROM,omitempty"`
+		CCAcct    *ofxCCAcct   `xml:"CCACCTFROM,omitempty"`
+		TranList  ofxTranList  `xml:"BANKTRANLIST"`
+		LedgerBal ofxBalance   `xml:"LEDGERBAL"`
+	}
+	ofxBankAcct struct {
+		BankID   string `xml:"BANKID"`
+		AcctID   string `xml:"ACCTID"`
+		AcctType string `xml:"ACCTTYPE"`
+	}
+	ofxCCAcct struct {
+		AcctID string `xml:"ACCTID"`
+	}
+	ofxTranList struct {
+		DTStart string   `xml:"DTSTART"`
+		DTEnd   string   `xml:"DTEND"`
+		Txns    []ofxTxn `xml:"STMTTRN"`
+	}
+	ofxTxn struct {
+		TrnType  string `xml:"TRNTYPE"`
+		DTPosted string `xml:"DTPOSTED"`
+		TrnAmt   string `xml:"TRNAMT"`
+		FitID    string `xml:"FITID"`
+		Name     string `xml:"NAME"`
+		Memo     string `xml:"MEMO,omitempty"`
+	}
+	ofxBalance struct {
+		BalAmt string `xml:"BALAMT"`
+		DTAsOf string `xml:"DTASOF"`
+	}
+)
+
+// ofxNameMax and ofxMemoMax are the OFX limits on NAME and MEMO.
+const (
+	ofxNameMax = 32
+	ofxMemoMax = 255
+)
+
+// writeOFX writes an OFX 2.2 statement of the payment account: a credit card statement
+// for liabilities, a checking account otherwise. OFX has no category, so the mapped
+// account leads the memo. It returns the number of unconverted receipts left out.
+func writeOFX(w io.Writer, entries []ledgerEntry, currency string, now time.Time) (int, error) {
+	payment := DefaultPaymentAccount
+	if len(entries) > 0 {
+		payment = entries[0].Payment
+	}
+	stmt := ofxStmtRs{CurDef: currency}
+	msgs := ofxMsgs{TrnRs: ofxTrnRs{TrnUID: "0", Status: ofxStatus{Severity: "INFO"}}}
+	if qifAccountType(payment) == "CCard" {
+		msgs.XMLName.Local, msgs.TrnRs.XMLName.Local, stmt.XMLName.Local = "CREDITCARDMSGSRSV1", "CCSTMTTRNRS", "CCSTMTRS"
+		stmt.CCAcct = &ofxCCAcct{AcctID: payment}
+	} else {
+		msgs.XMLName.Local, msgs.TrnRs.XMLName.Local, stmt.XMLName.Local = "BANKMSGSRSV1", "STMTTRNRS", "STMTRS"
+		stmt.BankAcct = &ofxBankAcct{BankID: "0", AcctID: payment, AcctType: "CHECKING"}
+	}
+
+	var balance money.Amount
+	var first, last time.Time
+	skipped := 0
+	for _, e := range entries {
+		if e.Converted == nil {
+			skipped++
+			continue
+		}
+		amount := -*e.Converted
+		balance += amount
+		if first.IsZero() || e.Date.Before(first) {
+			first = e.Date
+		}
+		if e.Date.After(last) {
+			last = e.Date
+		}
+		trnType := "DEBIT"
+		if amount > 0 {
+			trnType = "CREDIT"
+		}
+		stmt.TranList.Txns = append(stmt.TranList.Txns, ofxTxn{
+			TrnType:  trnType,
+			DTPosted: e.Date.Format("20060102"),
+			TrnAmt:   amount.String(),
+			FitID:    e.ID.String(),
+			Name:     truncateRunes(e.Payee, ofxNameMax),
+			Memo:     truncateRunes(memoWithFile(joinNonEmpty(" · ", e.Account, e.Memo), e.FilePath), ofxMemoMax),
+		})
+	}
+	if first.IsZero() {
+		first, last = now, now
+	}
+	stmt.TranList.DTStart, stmt.TranList.DTEnd = first.Format("20060102"), last.Format("20060102")
+	stmt.LedgerBal = ofxBalance{BalAmt: balance.String(), DTAsOf: now.UTC().Format("20060102150405")}
+	msgs.TrnRs.StmtRs = stmt
+
+	doc := ofxDoc{
+		Signon: ofxSignon{Status: ofxStatus{Severity: "INFO"}, DTServer: now.UTC().Format("20060102150405"), Language: "ENG"},
+		Msgs:   msgs,
+	}
+	if _, err := io.WriteString(w, xml.Header+`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>`+"\n"); err != nil {
+		return skipped, err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return skipped, err
+	}
+	_, err := io.WriteString(w, "\n")
+	return skipped, err
+}
+
+// writeLedgerJournal writes ledger-cli transactions. Foreign-currency receipts keep their
+// printed amount and carry the converted total as a cost ("@@").
+func writeLedgerJournal(w io.Writer, entries []ledgerEntry, profileCurrency string) error {
+	var b strings.Builder
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s * %s\n", e.Date.Format("2006-01-02"), e.Payee)
+		if e.Memo != "" {
+			fmt.Fprintf(&b, "    ; %s\n", e.Memo)
+		}
+		if e.FilePath != "" {
+			fmt.Fprintf(&b, "    ; receipt: %s\n", e.FilePath)
+		}
+		fmt.Fprintf(&b, "    ; receipt_id: %s\n", e.ID)
+		fmt.Fprintf(&b, "    %s  %s\n", ledgerAccount(e.Account), postingAmount(e, profileCurrency))
+		fmt.Fprintf(&b, "    %s\n", ledgerAccount(e.Payment))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeBeancount writes beancount entries, opening every account used on the date of the
+// first entry so the file checks on its own.
+func writeBeancount(w io.Writer, entries []ledgerEntry, profileCurrency string) error {
+	var b strings.Builder
+	if profileCurrency != "" {
+		fmt.Fprintf(&b, "option \"operating_currency\" \"%s\"\n\n", profileCurrency)
+	}
+	if len(entries) > 0 {
+		opened := map[string]bool{}
+		var accounts []string
+		for _, e := range entries {
+			for _, a := range []string{beancountAccount(e.Account, "Expenses"), beancountAccount(e.Payment, "Liabilities")} {
+				if !opened[a] {
+					opened[a] = true
+					accounts = append(accounts, a)
+				}
+			}
+		}
+		sort.Strings(accounts)
+		opening := entries[0].Date.Format("2006-01-02")
+		for _, a := range accounts {
+			fmt.Fprintf(&b, "%s open %s\n", opening, a)
+		}
+	}
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\n%s * %s %s\n", e.Date.Format("2006-01-02"), beancountString(e.Payee), beancountString(e.Memo))
+		if e.FilePath != "" {
+			fmt.Fprintf(&b, "  receipt: %s\n", beancountString(e.FilePath))
+		}
+		fmt.Fprintf(&b, "  receipt_id: %s\n", beancountString(e.ID.String()))
+		fmt.Fprintf(&b, "  %s  %s\n", beancountAccount(e.Account, "Expenses"), postingAmount(e, profileCurrency))
+		fmt.Fprintf(&b, "  %s\n", beancountAccount(e.Payment, "Liabilities"))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// postingAmount is "42.18 USD", or "100.00 EUR @@ 108.50 USD" when converted.
+func postingAmount(e ledgerEntry, profileCurrency string) string {
+	out := e.Amount.String() + " " + e.Currency
+	if e.Converted != nil && e.Currency != profileCurrency {
+		out += " @@ " + e.Converted.Abs().String() + " " + profileCurrency
+	}
+	return out
+}
+
+// ledgerAccount collapses runs of whitespace, since two spaces end an account name in ledger.
+func ledgerAccount(account string) string {
+	return strings.Join(strings.Fields(account), " ")
+}
+
+// beancountRoots are the only top-level accounts beancount accepts.
+var beancountRoots = map[string]bool{"Assets": true, "Liabilities": true, "Equity": true, "Income": true, "Expenses": true}
+
+// beancountAccount rewrites an account into beancount syntax: components are capitalized
+// and hyphenated ("Expenses:office supplies" -> "Expenses:Office-Supplies") and accounts
+// outside the five roots are placed under root.
+func beancountAccount(account, root string) string {
+	var parts []string
+	for _, p := range strings.Split(account, ":") {
+		words := strings.FieldsFunc(p, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		for i, w := range words {
+			r := []rune(w)
+			r[0] = unicode.ToUpper(r[0])
+			words[i] = string(r)
+		}
+		if len(words) > 0 {
+			parts = append(parts, strings.Join(words, "-"))
+		}
+	}
+	if len(parts) == 0 || !beancountRoots[parts[0]] {
+		parts = append([]string{root}, parts...)
+	}
+	if len(parts) == 1 {
+		parts = append(parts, "Uncategorized")
+	}
+	return strings.Join(parts, ":")
+}
+
+func beancountString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func memoWithFile(memo, path string) string {
+	if path == "" {
+		return memo
+	}
+	return joinNonEmpty(" ", memo, "[receipt: "+path+"]")
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	var kept []string
+	for _, p := range parts {
+		if p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, sep)
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func truncateRunes(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,146 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func ledgerFixture() []ledgerEntry {
+	cats := []*entity.Category{
+		{Name: "Office Supplies", Account: strPtr("Expenses:Office:Supplies")},
+		{Name: "Meals"},
+	}
+	accountOf := accountResolver(cats, map[string]string{"Travel Expenses": "Expenses:Travel"})
+	id := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	rec := func(date, merchant, category, total, currency, desc string) *entity.Receipt {
+		d, _ := time.Parse("2006-01-02", date)
+		return &entity.Receipt{ID: id, TxDate: d, MerchantName: merchant, CategoryName: category,
+			Total: money.MustParse(total), CurrencyCode: currency, Description: desc}
+	}
+	recs := []*entity.Receipt{
+		rec("2025-03-14", "Staples", "Office Supplies", "42.18", "USD", "Printer paper"),
+		rec("2025-03-02", "Café \"Zoë\"", "Meals", "100.00", "EUR", "Team\nlunch"),
+		rec("2025-03-20", "Air France", "Travel Expenses", "250.00", "EUR", ""),
+	}
+	converted := money.MustParse("108.50")
+	recs[1].ConvertedTotal = &converted
+	pathOf := func(r *entity.Receipt) string {
+		if r.MerchantName == "Staples" {
+			return "/receipts/staples.pdf"
+		}
+		return ""
+	}
+	return buildLedgerEntries(recs, accountOf, "", "USD", pathOf)
+}
+
+func TestWriteLedgerJournal(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeLedgerJournal(&buf, ledgerFixture(), "USD"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `2025-03-02 * Café "Zoë"
+    ; Team lunch
+    ; receipt_id: 11111111-1111-1111-1111-111111111111
+    Expenses:Meals  100.00 EUR @@ 108.50 USD
+    Liabilities:CreditCard
+
+2025-03-14 * Staples
+    ; Printer paper
+    ; receipt: /receipts/staples.pdf
+    ; receipt_id: 11111111-1111-1111-1111-111111111111
+    Expenses:Office:Supplies  42.18 USD
+    Liabilities:CreditCard
+
+2025-03-20 * Air France
+    ; receipt_id: 11111111-1111-1111-1111-111111111111
+    Expenses:Travel  250.00 EUR
+    Liabilities:CreditCard
+`
+	if got := buf.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestWriteBeancount(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeBeancount(&buf, ledgerFixture(), "USD"); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"option \"operating_currency\" \"USD\"\n",
+		"2025-03-02 open Expenses:Meals\n",
+		"2025-03-02 open Liabilities:CreditCard\n",
+		"2025-03-02 * \"Café \\\"Zoë\\\"\" \"Team lunch\"\n",
+		"  receipt: \"/receipts/staples.pdf\"\n",
+		"  Expenses:Meals  100.00 EUR @@ 108.50 USD\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected output to contain %q, got %q", want, got)
+		}
+	}
+}
+
+func TestWriteQIF(t *testing.T) {
+	var buf bytes.Buffer
+	skipped, err := writeQIF(&buf, ledgerFixture())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skipped != 1 {
+		t.Errorf("Expected %d skipped, got %d", 1, skipped)
+	}
+	expected := "!Account\nNLiabilities:CreditCard\nTCCard\n^\n!Type:CCard\n" +
+		"D03/02/2025\nT-108.50\nPCafé \"Zoë\"\nMTeam lunch\nLExpenses:Meals\n^\n" +
+		"D03/14/2025\nT-42.18\nPStaples\nMPrinter paper [receipt: /receipts/staples.pdf]\nLExpenses:Office:Supplies\n^\n"
+	if got := buf.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestWriteOFX(t *testing.T) {
+	var buf bytes.Buffer
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := writeOFX(&buf, ledgerFixture(), "USD", now); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		`<?OFX OFXHEADER="200" VERSION="220"`,
+		"<CREDITCARDMSGSRSV1>",
+		"<CCACCTFROM>",
+		"<TRNAMT>-42.18</TRNAMT>",
+		"<NAME>Café &#34;Zoë&#34;</NAME>",
+		"<MEMO>Expenses:Office:Supplies · Printer paper [receipt: /receipts/staples.pdf]</MEMO>",
+		"<BALAMT>-150.68</BALAMT>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected output to contain %q, got %q", want, got)
+		}
+	}
+}
+
+func TestBeancountAccount(t *testing.T) {
+	tests := []struct {
+		account  string
+		root     string
+		expected string
+	}{
+		{"Expenses:office supplies", "Expenses", "Expenses:Office-Supplies"},
+		{"Meals & Entertainment", "Expenses", "Expenses:Meals-Entertainment"},
+		{"Liabilities:Amex (Gold)", "Liabilities", "Liabilities:Amex-Gold"},
+		{"Expenses", "Expenses", "Expenses:Uncategorized"},
+	}
+	for _, tt := range tests {
+		if got := beancountAccount(tt.account, tt.root); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
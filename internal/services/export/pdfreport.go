@@ -0,0 +1,398 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // decode PNG receipts and rendered PDF pages
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+const (
+	// reportMaxPages caps how many pages of a PDF receipt go into the appendix.
+	reportMaxPages = 3
+	// thumbnailMaxPx is the longest side, in pixels, of an appendix image.
+	thumbnailMaxPx = 1400
+
+	reportMargin = 15.0 // mm
+)
+
+// PageRenderer turns receipt files into images for the PDF report. *ocr.Extractor
+// implements it with pdftoppm and the cached HEIC→PNG conversion.
+type PageRenderer interface {
+	RenderPDFPages(ctx context.Context, path string, maxPages int) (pages []string, cleanup func(), err error)
+	ConvertHEICForVision(ctx context.Context, path, hashHex string) (pngPath string, cleanup func(), err error)
+}
+
+// expenseReport is everything the PDF report renders.
+type expenseReport struct {
+	ProfileName string
+	JobTitle    string
+	Currency    string
+	Period      string
+	Receipts    []*entity.Receipt
+	// Images returns the image files to show for a receipt in the appendix.
+	Images func(*entity.Receipt) (paths []string, cleanup func(), err error)
+}
+
+// WritePDFReport writes a reimbursement report to w: a cover page with the profile, the
+// period and totals by category, a table of the receipts, and an appendix with an image
+// of every receipt (the first pages of PDF receipts). Dates behave as in ExportReceiptsXLSX.
+func (s *Service) WritePDFReport(ctx context.Context, w io.Writer, profileID uuid.UUID, from, to *time.Time) error {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
+
+	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
+	if err != nil {
+		return fmt.Errorf("query receipts: %w", err)
+	}
+	prof, err := s.ent.Profile.Get(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("get profile: %w", err)
+	}
+
+	rep := expenseReport{
+		ProfileName: prof.Name,
+		Currency:    prof.DefaultCurrency,
+		Period:      reportPeriod(fromDate, toDate, recs),
+		Receipts:    recs,
+		Images:      func(r *entity.Receipt) ([]string, func(), error) { return s.receiptImages(ctx, r) },
+	}
+	if prof.JobTitle != nil {
+		rep.JobTitle = *prof.JobTitle
+	}
+	if err := renderExpenseReport(w, rep); err != nil {
+		return err
+	}
+
+	s.logger.Info("export.pdf.ok",
+		"profile_id", profileID.String(),
+		"rows", len(recs),
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return nil
+}
+
+// receiptImages resolves the images of a receipt file: images as they are, HEIC through
+// the PNG cache and PDFs rasterized page by page.
+func (s *Service) receiptImages(ctx context.Context, r *entity.Receipt) ([]string, func(), error) {
+	if r.FileID == nil || *r.FileID == uuid.Nil {
+		return nil, nil, fmt.Errorf("no receipt file")
+	}
+	file, err := s.filesRepo.GetByID(ctx, *r.FileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("receipt file not found")
+	}
+	ext := file.FileExt
+	if ext == "" {
+		ext = filepath.Ext(file.SourcePath)
+	}
+	switch {
+	case constants.IsHEICExt(ext) || constants.MapExtToFormat(ext) == constants.PDF:
+		if s.renderer == nil {
+			return nil, nil, fmt.Errorf("no renderer configured for %s files", constants.NormalizeExt(ext))
+		}
+		if constants.IsHEICExt(ext) {
+			png, cleanup, err := s.renderer.ConvertHEICForVision(ctx, file.SourcePath, hex.EncodeToString(file.ContentHash))
+			if err != nil {
+				return nil, nil, err
+			}
+			return []string{png}, cleanup, nil
+		}
+		return s.renderer.RenderPDFPages(ctx, file.SourcePath, reportMaxPages)
+	case constants.MapExtToFormat(ext) == constants.IMAGE:
+		return []string{file.SourcePath}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("%s files have no image", constants.NormalizeExt(ext))
+	}
+}
+
+func renderExpenseReport(w io.Writer, rep expenseReport) error {
+	pdf := fpdf.New("P", "mm", "Letter", "")
+	pdf.SetMargins(reportMargin, reportMargin, reportMargin)
+	pdf.SetAutoPageBreak(true, reportMargin)
+	pdf.AliasNbPages("")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(0, 5, tr(rep.ProfileName+" · "+rep.Period), "", 0, "L", false, 0, "")
+		pdf.SetX(reportMargin)
+		pdf.CellFormat(0, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	writeCover(pdf, tr, rep)
+	writeReceiptTable(pdf, tr, rep)
+	writeAppendix(pdf, tr, rep)
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("pdf write: %w", err)
+	}
+	return nil
+}
+
+func writeCover(pdf *fpdf.Fpdf, tr func(string) string, rep expenseReport) {
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 12, "Expense Report", "", 1, "L", false, 0, "")
+	pdf.Ln(2)
+
+	pdf.SetFont("Helvetica", "", 11)
+	for _, kv := range [][2]string{
+		{"Name", rep.ProfileName},
+		{"Job title", rep.JobTitle},
+		{"Period", rep.Period},
+		{"Receipts", strconv.Itoa(len(rep.Receipts))},
+		{"Generated", time.Now().Format("2006-01-02")},
+	} {
+		if kv[1] == "" {
+			continue
+		}
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(30, 7, kv[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 11)
+		pdf.CellFormat(0, 7, tr(kv[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	sum := summarize(rep.Receipts, rep.Currency)
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.CellFormat(0, 9, "Totals by category", "", 1, "L", false, 0, "")
+
+	widths := []float64{100, 30, 55.9}
+	header(pdf, widths, []string{"Category", "Receipts", totalHeader(rep.Currency)}, []string{"L", "R", "R"})
+	pdf.SetFont("Helvetica", "", 10)
+	for _, c := range sum.categories {
+		b := sum.byCategory[c]
+		row(pdf, tr, widths, []string{c, strconv.Itoa(b.receipts), b.total.String()}, []string{"L", "R", "R"}, false)
+	}
+	pdf.SetFont("Helvetica", "B", 10)
+	row(pdf, tr, widths, []string{"Total", strconv.Itoa(sum.all.receipts), sum.all.total.String()}, []string{"L", "R", "R"}, true)
+
+	if n := unconverted(rep.Receipts, rep.Currency); n > 0 {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "I", 9)
+		pdf.MultiCell(0, 5, fmt.Sprintf("%d receipt(s) without a %s amount are not included in the totals.", n, rep.Currency), "", "L", false)
+	}
+}
+
+var (
+	tableWidths = []float64{10, 21, 38, 32, 39.9, 22, 23}
+	tableAligns = []string{"R", "L", "L", "L", "L", "R", "R"}
+)
+
+func writeReceiptTable(pdf *fpdf.Fpdf, tr func(string) string, rep expenseReport) {
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.CellFormat(0, 9, "Receipts", "", 1, "L", false, 0, "")
+
+	headers := []string{"#", "Date", "Merchant", "Category", "Item", "Amount", totalHeader(rep.Currency)}
+	header(pdf, tableWidths, headers, tableAligns)
+	_, pageH := pdf.GetPageSize()
+	pdf.SetFont("Helvetica", "", 9)
+	for i, r := range rep.Receipts {
+		if pdf.GetY()+7 > pageH-reportMargin {
+			pdf.AddPage()
+			header(pdf, tableWidths, headers, tableAligns)
+			pdf.SetFont("Helvetica", "", 9)
+		}
+		converted := ""
+		if a := amountInCurrency(r, rep.Currency); a != nil {
+			converted = a.String()
+		}
+		row(pdf, tr, tableWidths, []string{
+			strconv.Itoa(i + 1),
+			r.TxDate.Format("2006-01-02"),
+			r.MerchantName,
+			r.CategoryName,
+			derivePrimaryItem(r.Description, r.MerchantName),
+			r.Total.String() + " " + r.CurrencyCode,
+			converted,
+		}, tableAligns, false)
+	}
+}
+
+// writeAppendix gives every receipt a page with its image; the number matches the
+// receipt table.
+func writeAppendix(pdf *fpdf.Fpdf, tr func(string) string, rep expenseReport) {
+	pageW, pageH := pdf.GetPageSize()
+	for i, r := range rep.Receipts {
+		pdf.AddPage()
+		pdf.SetFont("Helvetica", "B", 12)
+		title := fmt.Sprintf("Receipt #%d · %s · %s · %s %s", i+1, r.TxDate.Format("2006-01-02"), r.MerchantName, r.Total.String(), r.CurrencyCode)
+		pdf.CellFormat(0, 8, tr(fit(pdf, title, pageW-2*reportMargin)), "", 1, "L", false, 0, "")
+
+		paths, cleanup, err := rep.Images(r)
+		if err != nil {
+			pdf.SetFont("Helvetica", "I", 10)
+			pdf.MultiCell(0, 6, tr("Receipt image not available: "+err.Error()), "", "L", false)
+			continue
+		}
+		for j, p := range paths {
+			if j > 0 {
+				pdf.AddPage()
+			}
+			if err := placeImage(pdf, fmt.Sprintf("receipt-%d-%d", i, j), p, pageW, pageH); err != nil {
+				pdf.SetFont("Helvetica", "I", 10)
+				pdf.MultiCell(0, 6, tr("Receipt image not available: "+err.Error()), "", "L", false)
+			}
+		}
+		if cleanup != nil {
+			cleanup()
+		}
+	}
+}
+
+// placeImage scales a thumbnail of the image at path to the free area of the page.
+func placeImage(pdf *fpdf.Fpdf, name, path string, pageW, pageH float64) error {
+	data, err := thumbnailJPEG(path)
+	if err != nil {
+		return err
+	}
+	info := pdf.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: "JPG"}, bytes.NewReader(data))
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+	top := pdf.GetY() + 2
+	maxW, maxH := pageW-2*reportMargin, pageH-reportMargin-top-5
+	w, h := info.Width(), info.Height()
+	scale := min(maxW/w, maxH/h)
+	w, h = w*scale, h*scale
+	pdf.ImageOptions(name, reportMargin+(maxW-w)/2, top, w, h, false, fpdf.ImageOptions{ImageType: "JPG"}, 0, "")
+	return nil
+}
+
+// thumbnailJPEG decodes an image, shrinks it to thumbnailMaxPx on its longest side and
+// re-encodes it as JPEG so the report stays small.
+func thumbnailJPEG(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	src, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", filepath.Base(path), err)
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail(src, thumbnailMaxPx), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// thumbnail box-filters src down to at most maxSide pixels on its longest side, flattening
+// transparency onto white.
+func thumbnail(src image.Image, maxSide int) *image.RGBA {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	scale := 1.0
+	if longest := max(sw, sh); longest > maxSide {
+		scale = float64(maxSide) / float64(longest)
+	}
+	dw, dh := max(1, int(float64(sw)*scale)), max(1, int(float64(sh)*scale))
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := b.Min.Y+y*sh/dh, b.Min.Y+max((y+1)*sh/dh, y*sh/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := b.Min.X+x*sw/dw, b.Min.X+max((x+1)*sw/dw, x*sw/dw+1)
+			var r, g, bl, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					white := uint64(0xffff - ca)
+					r += uint64(cr) + white
+					g += uint64(cg) + white
+					bl += uint64(cb) + white
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{R: uint8(r / n >> 8), G: uint8(g / n >> 8), B: uint8(bl / n >> 8), A: 0xff})
+		}
+	}
+	return dst
+}
+
+func header(pdf *fpdf.Fpdf, widths []float64, cols, aligns []string) {
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetFillColor(221, 235, 247)
+	for i, c := range cols {
+		pdf.CellFormat(widths[i], 7, c, "B", 0, aligns[i], true, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+func row(pdf *fpdf.Fpdf, tr func(string) string, widths []float64, cols, aligns []string, top bool) {
+	border := ""
+	if top {
+		border = "T"
+	}
+	for i, c := range cols {
+		pdf.CellFormat(widths[i], 6, tr(fit(pdf, c, widths[i]-2)), border, 0, aligns[i], false, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// fit shortens s with an ellipsis until it fits in width at the current font.
+func fit(pdf *fpdf.Fpdf, s string, width float64) string {
+	if pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 0 && pdf.GetStringWidth(string(r)+"...") > width {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "..."
+}
+
+// reportPeriod describes the date window, falling back to the span of the receipts.
+func reportPeriod(from, to *time.Time, recs []*entity.Receipt) string {
+	var first, last time.Time
+	for _, r := range recs {
+		if r.TxDate.IsZero() {
+			continue
+		}
+		if first.IsZero() || r.TxDate.Before(first) {
+			first = r.TxDate
+		}
+		if r.TxDate.After(last) {
+			last = r.TxDate
+		}
+	}
+	if from != nil {
+		first = *from
+	}
+	if to != nil {
+		last = *to
+	}
+	if first.IsZero() && last.IsZero() {
+		return "All receipts"
+	}
+	return first.Format("2006-01-02") + " – " + last.Format("2006-01-02")
+}
+
+func unconverted(recs []*entity.Receipt, currency string) int {
+	n := 0
+	for _, r := range recs {
+		if amountInCurrency(r, currency) == nil {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestRenderExpenseReport(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 300, 600))
+	for i := range img.Pix {
+		img.Pix[i] = 0x80
+	}
+	path := filepath.Join(t.TempDir(), "receipt.png")
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	recs := []*entity.Receipt{
+		{TxDate: date, MerchantName: "Staples", CategoryName: "Office Supplies", Total: money.MustParse("42.18"), CurrencyCode: "USD"},
+		{TxDate: date, MerchantName: "Café Zoë", CategoryName: "Meals", Total: money.MustParse("12.00"), CurrencyCode: "EUR"},
+	}
+	rep := expenseReport{
+		ProfileName: "Jane Doe",
+		JobTitle:    "Consultant",
+		Currency:    "USD",
+		Period:      reportPeriod(nil, nil, recs),
+		Receipts:    recs,
+		Images: func(r *entity.Receipt) ([]string, func(), error) {
+			if r.MerchantName == "Staples" {
+				return []string{path}, nil, nil
+			}
+			return nil, nil, errors.New("no receipt file")
+		},
+	}
+
+	var out bytes.Buffer
+	if err := renderExpenseReport(&out, rep); err != nil {
+		t.Fatalf("renderExpenseReport: %v", err)
+	}
+	pdf := out.Bytes()
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Fatalf("Expected a PDF, got %q", pdf[:min(len(pdf), 16)])
+	}
+	// cover, table and one appendix page per receipt
+	if got := bytes.Count(pdf, []byte("/Type /Page\n")); got != 4 {
+		t.Errorf("Expected %d pages, got %d", 4, got)
+	}
+	if got := bytes.Count(pdf, []byte("/Subtype /Image")); got != 1 {
+		t.Errorf("Expected %d image, got %d", 1, got)
+	}
+	if rep.Period != "2025-03-14 – 2025-03-14" {
+		t.Errorf("Expected %q, got %q", "2025-03-14 – 2025-03-14", rep.Period)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 4000, 1000)) // fully transparent
+	got := thumbnail(src, 1400)
+	if b := got.Bounds(); b.Dx() != 1400 || b.Dy() != 350 {
+		t.Errorf("Expected 1400x350, got %dx%d", b.Dx(), b.Dy())
+	}
+	if c := got.RGBAAt(10, 10); c != (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}) {
+		t.Errorf("Expected transparent pixels on white, got %v", c)
+	}
+	small := thumbnail(image.NewGray(image.Rect(0, 0, 20, 10)), 1400)
+	if b := small.Bounds(); b.Dx() != 20 || b.Dy() != 10 {
+		t.Errorf("Expected 20x10, got %dx%d", b.Dx(), b.Dy())
+	}
+}
@@ -0,0 +1,194 @@
+package export
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
+)
+
+// ProvenanceFormat selects how WriteProvenance lays out its records.
+type ProvenanceFormat string
+
+const (
+	// FormatJSON is a single document with the export window and a "receipts" array.
+	FormatJSON ProvenanceFormat = "json"
+	// FormatNDJSON is one receipt record per line.
+	FormatNDJSON ProvenanceFormat = "ndjson"
+)
+
+// ProvenanceOptions configures the JSON exports.
+type ProvenanceOptions struct {
+	// IncludeOCRText keeps the OCR text of every extract job; it is left out by default
+	// as it is usually most of the export's size.
+	IncludeOCRText bool
+}
+
+// provenancePageSize is how many receipts are loaded per query while streaming.
+const provenancePageSize = 200
+
+// provenanceRecord is one current receipt with everything known about how it was produced.
+type provenanceRecord struct {
+	*entity.Receipt
+	File     *provenanceFile   `json:"file,omitempty"`
+	Jobs     []provenanceJob   `json:"extract_jobs"`
+	Versions []*entity.Receipt `json:"versions"`
+}
+
+type provenanceFile struct {
+	ID          uuid.UUID `json:"id"`
+	SourcePath  string    `json:"source_path"`
+	ContentHash string    `json:"content_hash"` // hex SHA-256
+	Filename    string    `json:"filename"`
+	FileExt     string    `json:"file_ext"`
+	FileSize    int       `json:"file_size"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
+type provenanceJob struct {
+	*entity.ExtractJob
+	OCRMethod string `json:"ocr_method,omitempty"`
+}
+
+// provenanceHeader is the envelope of a FormatJSON document; "receipts" follows it.
+type provenanceHeader struct {
+	ProfileID  uuid.UUID  `json:"profile_id"`
+	ExportedAt time.Time  `json:"exported_at"`
+	From       *time.Time `json:"from,omitempty"`
+	To         *time.Time `json:"to,omitempty"`
+}
+
+// WriteProvenance writes every current receipt of the profile in the window as JSON with
+// its money components, category, file (hash and path), extract job history (OCR method,
+// confidence, model name and params, review flags) and superseded versions. Receipts are
+// loaded and written a page at a time so large profiles stream. Dates behave as in
+// ExportReceiptsXLSX.
+func (s *Service) WriteProvenance(ctx context.Context, w io.Writer, format ProvenanceFormat, profileID uuid.UUID, from, to *time.Time, opts ProvenanceOptions) error {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
+
+	pw, err := newProvenanceWriter(w, format)
+	if err != nil {
+		return err
+	}
+	header := provenanceHeader{ProfileID: profileID, ExportedAt: start.UTC(), From: fromDate, To: toDate}
+	if err := pw.begin(header); err != nil {
+		return fmt.Errorf("%s write: %w", format, err)
+	}
+
+	rows := 0
+	for offset := 0; ; offset += provenancePageSize {
+		page, err := s.receiptsRepo.ListProvenance(ctx, profileID, fromDate, toDate, offset, provenancePageSize)
+		if err != nil {
+			return fmt.Errorf("query receipts: %w", err)
+		}
+		for _, p := range page {
+			if err := pw.write(toProvenanceRecord(p, opts)); err != nil {
+				return fmt.Errorf("%s write: %w", format, err)
+			}
+		}
+		rows += len(page)
+		if len(page) < provenancePageSize {
+			break
+		}
+	}
+	if err := pw.end(); err != nil {
+		return fmt.Errorf("%s write: %w", format, err)
+	}
+
+	s.logger.Info("export.provenance.ok",
+		"profile_id", profileID.String(),
+		"format", string(format),
+		"rows", rows,
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return nil
+}
+
+func toProvenanceRecord(p *entity.ReceiptProvenance, opts ProvenanceOptions) provenanceRecord {
+	rec := provenanceRecord{
+		Receipt:  p.Receipt,
+		Jobs:     make([]provenanceJob, 0, len(p.Jobs)),
+		Versions: p.Versions,
+	}
+	if rec.Versions == nil {
+		rec.Versions = []*entity.Receipt{}
+	}
+	if f := p.File; f != nil {
+		rec.File = &provenanceFile{
+			ID:          f.ID,
+			SourcePath:  f.SourcePath,
+			ContentHash: hex.EncodeToString(f.ContentHash),
+			Filename:    f.Filename,
+			FileExt:     f.FileExt,
+			FileSize:    f.FileSize,
+			UploadedAt:  f.UploadedAt,
+		}
+	}
+	for _, j := range p.Jobs {
+		job := *j
+		if !opts.IncludeOCRText {
+			job.OCRText = nil
+		}
+		rec.Jobs = append(rec.Jobs, provenanceJob{ExtractJob: &job, OCRMethod: tools.OCRMethod(j)})
+	}
+	return rec
+}
+
+// provenanceWriter streams records as a JSON document or as NDJSON lines.
+type provenanceWriter struct {
+	w      io.Writer
+	enc    *json.Encoder
+	ndjson bool
+	n      int
+}
+
+func newProvenanceWriter(w io.Writer, format ProvenanceFormat) (*provenanceWriter, error) {
+	switch format {
+	case FormatJSON, FormatNDJSON:
+	default:
+		return nil, fmt.Errorf("unknown provenance format %q", format)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &provenanceWriter{w: w, enc: enc, ndjson: format == FormatNDJSON}, nil
+}
+
+func (pw *provenanceWriter) begin(h provenanceHeader) error {
+	if pw.ndjson {
+		return nil
+	}
+	b, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	// Reopen the header object to append the receipts array.
+	_, err = fmt.Fprintf(pw.w, "%s,\"receipts\":[\n", b[:len(b)-1])
+	return err
+}
+
+func (pw *provenanceWriter) write(rec provenanceRecord) error {
+	if !pw.ndjson && pw.n > 0 {
+		if _, err := io.WriteString(pw.w, ","); err != nil {
+			return err
+		}
+	}
+	pw.n++
+	// Encode terminates every record with a newline.
+	return pw.enc.Encode(rec)
+}
+
+func (pw *provenanceWriter) end() error {
+	if pw.ndjson {
+		return nil
+	}
+	_, err := io.WriteString(pw.w, "]}\n")
+	return err
+}
@@ -0,0 +1,136 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func provenanceFixture() []*entity.ReceiptProvenance {
+	fileID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	d, _ := time.Parse("2006-01-02", "2025-03-14")
+	tax := money.MustParse("3.18")
+	ocrOK, parsed := "OCR_OK", "PARSED"
+	vision, model := "vision-direct", "gpt-4o-mini"
+	text := "STAPLES 42.18"
+	return []*entity.ReceiptProvenance{
+		{
+			Receipt: &entity.Receipt{ID: uuid.MustParse("11111111-1111-1111-1111-111111111111"), FileID: &fileID,
+				MerchantName: "Staples", TxDate: d, Tax: &tax, Total: money.MustParse("42.18"), CurrencyCode: "USD",
+				CategoryName: "Office Supplies", Description: "Printer paper", IsCurrent: true},
+			File: &entity.ReceiptFile{ID: fileID, SourcePath: "/receipts/staples.pdf", ContentHash: []byte{0xab, 0xcd}},
+			Jobs: []*entity.ExtractJob{
+				{FileID: fileID, Status: &ocrOK, ModelName: &vision, OCRText: &text},
+				{FileID: fileID, Status: &parsed, ModelName: &model, ModelParams: json.RawMessage(`{"ocr_method":"pdf-text"}`), NeedsReview: true},
+			},
+			Versions: []*entity.Receipt{{MerchantName: "Stapels", Total: money.MustParse("41.18"), CurrencyCode: "USD"}},
+		},
+		{
+			Receipt: &entity.Receipt{MerchantName: "Cash", TxDate: d, Total: money.MustParse("5.00"), CurrencyCode: "USD", IsCurrent: true},
+		},
+	}
+}
+
+func writeProvenanceFixture(t *testing.T, format ProvenanceFormat, opts ProvenanceOptions) string {
+	t.Helper()
+	var buf bytes.Buffer
+	pw, err := newProvenanceWriter(&buf, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pw.begin(provenanceHeader{ProfileID: uuid.Nil}); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range provenanceFixture() {
+		if err := pw.write(toProvenanceRecord(p, opts)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pw.end(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+type provenanceDoc struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	Receipts  []struct {
+		MerchantName string `json:"merchant_name"`
+		Tax          string `json:"tax"`
+		File         *struct {
+			ContentHash string `json:"content_hash"`
+			SourcePath  string `json:"source_path"`
+		} `json:"file"`
+		Jobs []struct {
+			OCRMethod   string  `json:"ocr_method"`
+			OCRText     *string `json:"ocr_text"`
+			NeedsReview bool    `json:"needs_review"`
+		} `json:"extract_jobs"`
+		Versions []struct {
+			MerchantName string `json:"merchant_name"`
+		} `json:"versions"`
+	} `json:"receipts"`
+}
+
+func TestWriteProvenanceJSON(t *testing.T) {
+	var doc provenanceDoc
+	if err := json.Unmarshal([]byte(writeProvenanceFixture(t, FormatJSON, ProvenanceOptions{})), &doc); err != nil {
+		t.Fatalf("Expected a valid JSON document, got %v", err)
+	}
+	if len(doc.Receipts) != 2 {
+		t.Fatalf("Expected 2 receipts, got %d", len(doc.Receipts))
+	}
+	r := doc.Receipts[0]
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"tax", r.Tax, "3.18"},
+		{"content hash", r.File.ContentHash, "abcd"},
+		{"source path", r.File.SourcePath, "/receipts/staples.pdf"},
+		{"ocr method after OCR", r.Jobs[0].OCRMethod, "vision-direct"},
+		{"ocr method after parse", r.Jobs[1].OCRMethod, "pdf-text"},
+		{"version", r.Versions[0].MerchantName, "Stapels"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s: Expected %q, got %q", tt.name, tt.expected, tt.got)
+		}
+	}
+	if r.Jobs[0].OCRText != nil {
+		t.Errorf("Expected OCR text to be left out, got %q", *r.Jobs[0].OCRText)
+	}
+	if !r.Jobs[1].NeedsReview {
+		t.Errorf("Expected the parse job to need review")
+	}
+	if doc.Receipts[1].File != nil || len(doc.Receipts[1].Jobs) != 0 {
+		t.Errorf("Expected no file or jobs for a receipt without a file, got %+v", doc.Receipts[1])
+	}
+}
+
+func TestWriteProvenanceNDJSON(t *testing.T) {
+	out := writeProvenanceFixture(t, FormatNDJSON, ProvenanceOptions{IncludeOCRText: true})
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d: %q", len(lines), out)
+	}
+	var rec struct {
+		Jobs []struct {
+			OCRText *string `json:"ocr_text"`
+		} `json:"extract_jobs"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Jobs[0].OCRText == nil || *rec.Jobs[0].OCRText != "STAPLES 42.18" {
+		t.Errorf("Expected the OCR text to be included, got %v", rec.Jobs[0].OCRText)
+	}
+}
@@ -0,0 +1,160 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+)
+
+// ExportScheduleCXLSX returns a workbook with one Schedule C summary sheet per tax year in
+// the date window and a "Detail" sheet listing the receipts behind every line. Categories
+// are mapped to lines by their per-profile tax_line. Dates behave as in ExportReceiptsXLSX.
+func (s *Service) ExportScheduleCXLSX(ctx context.Context, profileID uuid.UUID, from, to *time.Time) ([]byte, error) {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
+
+	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
+	if err != nil {
+		return nil, fmt.Errorf("query receipts: %w", err)
+	}
+	lineOf, err := s.taxLines(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	profileCurrency := constants.DefaultCurrency
+	if prof, err := s.ent.Profile.Get(ctx, profileID); err == nil {
+		profileCurrency = prof.DefaultCurrency
+	}
+
+	years, details := BuildTaxSummary(recs, lineOf, profileCurrency)
+
+	f := excelize.NewFile()
+	amountStyle, _ := f.NewStyle(&excelize.Style{NumFmt: 4}) // #,##0.00
+	boldStyle, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	boldAmountStyle, _ := f.NewStyle(&excelize.Style{NumFmt: 4, Font: &excelize.Font{Bold: true}})
+
+	for i, y := range years {
+		sheet := fmt.Sprintf("Schedule C %d", y.Year)
+		if i == 0 {
+			_ = f.SetSheetName("Sheet1", sheet)
+		} else if _, err := f.NewSheet(sheet); err != nil {
+			return nil, err
+		}
+
+		_ = f.SetCellValue(sheet, "A1", fmt.Sprintf("Schedule C expenses %d (%s)", y.Year, profileCurrency))
+		_ = f.SetCellStyle(sheet, "A1", "A1", boldStyle)
+		headers := []string{"Line", "Description", "Categories", "Receipts", "Amount", "Deductible %", "Deductible Amount"}
+		for c, h := range headers {
+			_ = f.SetCellValue(sheet, cellName(c+1, 3), h)
+		}
+		_ = f.SetCellStyle(sheet, "A3", "G3", boldStyle)
+
+		row := 4
+		for _, l := range y.Lines {
+			values := []any{l.Line, l.Label, strings.Join(l.Categories, ", "), l.Receipts,
+				l.Amount.Float64(), l.DeductiblePercent, l.Deductible.Float64()}
+			for c, v := range values {
+				_ = f.SetCellValue(sheet, cellName(c+1, row), v)
+			}
+			_ = f.SetCellStyle(sheet, cellName(5, row), cellName(5, row), amountStyle)
+			_ = f.SetCellStyle(sheet, cellName(7, row), cellName(7, row), amountStyle)
+			row++
+		}
+
+		_ = f.SetCellValue(sheet, cellName(1, row), "Total")
+		_ = f.SetCellValue(sheet, cellName(7, row), y.Deductible.Float64())
+		_ = f.SetCellStyle(sheet, cellName(1, row), cellName(7, row), boldAmountStyle)
+		if y.Unconverted > 0 {
+			_ = f.SetCellValue(sheet, cellName(1, row+2),
+				fmt.Sprintf("%d receipt(s) without a %s amount are not included; import FX rates and re-process them.", y.Unconverted, profileCurrency))
+		}
+
+		_ = f.SetColWidth(sheet, "A", "A", 8)
+		_ = f.SetColWidth(sheet, "B", "B", 40)
+		_ = f.SetColWidth(sheet, "C", "C", 40)
+		_ = f.SetColWidth(sheet, "D", "D", 10)
+		_ = f.SetColWidth(sheet, "E", "G", 18)
+	}
+
+	const detail = "Detail"
+	if len(years) == 0 {
+		_ = f.SetSheetName("Sheet1", detail)
+	} else if _, err := f.NewSheet(detail); err != nil {
+		return nil, err
+	}
+	headers := []string{"Year", "Line", "Expense Category", "Transaction Date", "Merchant", "Item/Service",
+		"Amount (" + profileCurrency + ")", "Deductible Amount", "Original Amount", "Receipt/File Path"}
+	for c, h := range headers {
+		_ = f.SetCellValue(detail, cellName(c+1, 1), h)
+	}
+	_ = f.SetCellStyle(detail, "A1", "J1", boldStyle)
+	for i, d := range details {
+		row := i + 2
+		r := d.Receipt
+		values := []any{r.TxDate.Year(), d.Line, r.CategoryName, r.TxDate.Format("2006-01-02"), r.MerchantName,
+			derivePrimaryItem(r.Description, r.MerchantName)}
+		for c, v := range values {
+			_ = f.SetCellValue(detail, cellName(c+1, row), v)
+		}
+		if d.Amount != nil {
+			_ = f.SetCellValue(detail, cellName(7, row), d.Amount.Float64())
+			_ = f.SetCellValue(detail, cellName(8, row), d.Deductible.Float64())
+			_ = f.SetCellStyle(detail, cellName(7, row), cellName(8, row), amountStyle)
+		}
+		_ = f.SetCellValue(detail, cellName(9, row), r.Total.String()+" "+r.CurrencyCode)
+		if r.FilePath != nil {
+			_ = f.SetCellValue(detail, cellName(10, row), *r.FilePath)
+		}
+	}
+	_ = f.SetColWidth(detail, "A", "B", 8)
+	_ = f.SetColWidth(detail, "C", "C", 22)
+	_ = f.SetColWidth(detail, "D", "D", 14)
+	_ = f.SetColWidth(detail, "E", "F", 28)
+	_ = f.SetColWidth(detail, "G", "I", 18)
+	_ = f.SetColWidth(detail, "J", "J", 60)
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("xlsx write: %w", err)
+	}
+	s.logger.Info("export.schedule_c.ok",
+		"profile_id", profileID.String(),
+		"rows", len(recs),
+		"years", len(years),
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return buf.Bytes(), nil
+}
+
+// taxLines maps the profile's category names to their Schedule C lines, falling back to
+// the built-in mapping for profiles without categories.
+func (s *Service) taxLines(ctx context.Context, profileID uuid.UUID) (map[string]string, error) {
+	lineOf := map[string]string{}
+	cats, err := s.categoryRepo.ListByProfile(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("list categories: %w", err)
+	}
+	if len(cats) == 0 {
+		for _, d := range constants.DefaultCategoryDefs() {
+			lineOf[d.Name] = d.TaxLine
+		}
+		return lineOf, nil
+	}
+	for _, c := range cats {
+		if c.TaxLine != nil {
+			lineOf[c.Name] = *c.TaxLine
+		}
+	}
+	return lineOf, nil
+}
+
+func cellName(col, row int) string {
+	cell, _ := excelize.CoordinatesToCellName(col, row)
+	return cell
+}
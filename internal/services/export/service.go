@@ -1,157 +1,145 @@
 package export
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/xuri/excelize/v2"
 
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
 	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
 )
 
-// Service is a tiny façade over repositories that produces XLSX bytes for exports.
+// Service builds the receipt exports from the repositories: the XLSX workbook and its CSV
+// table, bundles, PDF reports, Schedule C, accounting and JSON formats. Writers stream
+// to an io.Writer, which the gRPC server sends on as data chunks.
 type Service struct {
 	ent          *ent.Client
 	receiptsRepo repository.ReceiptRepository
 	filesRepo    repository.ReceiptFileRepository
+	categoryRepo repository.CategoryRepository
+	templateRepo repository.ExportTemplateRepository
+	renderer     PageRenderer // optional; without it PDF and HEIC receipts are left out of PDF reports
 	logger       *slog.Logger
 }
 
-func NewService(entc *ent.Client, repo repository.ReceiptRepository, filesRepo repository.ReceiptFileRepository, logger *slog.Logger) *Service {
+func NewService(entc *ent.Client, repo repository.ReceiptRepository, filesRepo repository.ReceiptFileRepository, categoryRepo repository.CategoryRepository, templateRepo repository.ExportTemplateRepository, renderer PageRenderer, logger *slog.Logger) *Service {
 	if logger == nil {
 		logger = slog.Default()
 	}
-	return &Service{ent: entc, receiptsRepo: repo, filesRepo: filesRepo, logger: logger}
+	return &Service{ent: entc, receiptsRepo: repo, filesRepo: filesRepo, categoryRepo: categoryRepo, templateRepo: templateRepo, renderer: renderer, logger: logger}
 }
 
 // ExportReceiptsXLSX returns an XLSX workbook (as bytes) for the given profile and date window.
 // If only from is provided -> from..today (inclusive).
 // If only to is provided   -> beginning..to (inclusive).
 // If neither is provided   -> all receipts for profile.
+// See WriteReceiptsWorkbook for the sheets it contains.
 func (s *Service) ExportReceiptsXLSX(ctx context.Context, profileID uuid.UUID, from, to *time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := s.WriteReceiptsXLSX(ctx, &buf, profileID, from, to, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteReceiptsXLSX streams the receipts workbook for the profile and date window to w,
+// laying out the Receipts sheet with columns (nil for the default layout).
+func (s *Service) WriteReceiptsXLSX(ctx context.Context, w io.Writer, profileID uuid.UUID, from, to *time.Time, columns []entity.ExportColumn) error {
 	start := time.Now()
 
-	// Normalize dates (date-only, UTC)
-	var fromDate, toDate *time.Time
-	if from != nil {
-		f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
-		fromDate = &f
-	}
-	if to != nil {
-		t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
-		toDate = &t
-	}
-	if fromDate != nil && toDate == nil {
-		today := time.Now().UTC()
-		t := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
-		toDate = &t
-	}
+	fromDate, toDate := normalizeRange(from, to)
 
 	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
 	if err != nil {
-		return nil, fmt.Errorf("query receipts: %w", err)
+		return fmt.Errorf("query receipts: %w", err)
 	}
 
-	f := excelize.NewFile()
-	const sheet = "Receipts"
-	if index, _ := f.GetSheetIndex(sheet); index == -1 {
-		_, err := f.NewSheet(sheet)
-		if err != nil {
-			return nil, err
-		}
+	// Converted amounts are in the profile's default currency.
+	profileCurrency := ""
+	if prof, err := s.ent.Profile.Get(ctx, profileID); err == nil {
+		profileCurrency = prof.DefaultCurrency
 	}
-	activeIndex, _ := f.GetSheetIndex(sheet)
-	f.SetActiveSheet(activeIndex)
-
-	headers := []string{
-		"Transaction Date",
-		"Expense Category",
-		"Item/Service",
-		"Amount",
-		"Purpose/Notes",
-		"Receipt/File Path",
-		"Needs Review",
-	}
-	for i, h := range headers {
-		cell, _ := excelize.CoordinatesToCellName(i+1, 1)
-		_ = f.SetCellValue(sheet, cell, h)
-	}
-
-	row := 2
-	for _, r := range recs {
-		// Resolve file path if we have a link
-		filePath := ""
-		// Prefer a direct field (e.g., r.FileID) if your schema has it; otherwise adapt this block.
-		if r.FileID != nil && *r.FileID != uuid.Nil {
-			fileRow, err := s.filesRepo.GetByID(ctx, *r.FileID)
-			if err == nil && fileRow != nil {
-				filePath = fileRow.SourcePath
-			}
-		}
 
-		// Amount
-		amount := fmt.Sprintf("%v", r.Total)
-
-		write := func(col int, v any) {
-			cell, _ := excelize.CoordinatesToCellName(col, row)
-			_ = f.SetCellValue(sheet, cell, v)
-		}
-
-		// 1) Transaction Date
-		if !r.TxDate.IsZero() {
-			write(1, r.TxDate.Format("2006-01-02"))
-		} else {
-			write(1, "")
-		}
-		// 2) Expense Category (enum string)
-		write(2, r.CategoryName)
-
-		// 3) Item/Service
-		item := derivePrimaryItem(r.Description, r.MerchantName)
-		write(3, item)
-
-		// 4) Amount
-		write(4, amount)
-
-		// 5) Purpose/Notes
-		write(5, truncate(fmt.Sprintf("%v", r.Description), 140))
+	if err := WriteReceiptsWorkbook(w, recs, columns, profileCurrency, s.filePath(ctx)); err != nil {
+		return err
+	}
 
-		// 6) Receipt/File Path
-		write(6, filePath)
+	s.logger.Info("export.xlsx.ok",
+		"profile_id", profileID.String(),
+		"rows", len(recs),
+		"elapsed_ms", time.Since(start).Milliseconds(),
+	)
+	return nil
+}
 
-		// 7) Needs Review
-		if r.NeedsReview {
-			write(7, "Yes")
-		}
+// WriteReceiptsCSV streams the Receipts sheet of the workbook as CSV, with the same
+// date window and columns as WriteReceiptsXLSX.
+func (s *Service) WriteReceiptsCSV(ctx context.Context, w io.Writer, profileID uuid.UUID, from, to *time.Time, columns []entity.ExportColumn) error {
+	start := time.Now()
+	fromDate, toDate := normalizeRange(from, to)
 
-		row++
+	recs, err := s.receiptsRepo.ListReceipts(ctx, profileID, fromDate, toDate)
+	if err != nil {
+		return fmt.Errorf("query receipts: %w", err)
+	}
+	profileCurrency := ""
+	if prof, err := s.ent.Profile.Get(ctx, profileID); err == nil {
+		profileCurrency = prof.DefaultCurrency
 	}
 
-	// Widen a few columns
-	_ = f.SetColWidth(sheet, "A", "A", 14) // date
-	_ = f.SetColWidth(sheet, "B", "B", 22) // category
-	_ = f.SetColWidth(sheet, "C", "C", 28) // item
-	_ = f.SetColWidth(sheet, "D", "D", 14) // amount
-	_ = f.SetColWidth(sheet, "E", "E", 48) // notes
-	_ = f.SetColWidth(sheet, "F", "F", 60) // path
-	_ = f.SetColWidth(sheet, "G", "G", 14) // needs review
-
-	buf, err := f.WriteToBuffer()
-	if err != nil {
-		return nil, fmt.Errorf("xlsx write: %w", err)
+	if err := WriteReceiptsSheetCSV(w, recs, columns, profileCurrency, s.filePath(ctx)); err != nil {
+		return err
 	}
 
-	s.logger.Info("export.xlsx.ok",
+	s.logger.Info("export.csv.ok",
 		"profile_id", profileID.String(),
 		"rows", len(recs),
 		"elapsed_ms", time.Since(start).Milliseconds(),
 	)
-	return buf.Bytes(), nil
+	return nil
+}
+
+// filePath resolves the stored file of a receipt, falling back to the linked file row
+// for receipts without a recorded path.
+func (s *Service) filePath(ctx context.Context) func(*entity.Receipt) string {
+	return func(r *entity.Receipt) string {
+		if r.FilePath != nil && *r.FilePath != "" {
+			return *r.FilePath
+		}
+		if r.FileID != nil && *r.FileID != uuid.Nil {
+			fileRow, err := s.filesRepo.GetByID(ctx, *r.FileID)
+			if err == nil && fileRow != nil {
+				return fileRow.SourcePath
+			}
+		}
+		return ""
+	}
+}
+
+// normalizeRange makes the window date-only UTC; only from means from..today.
+func normalizeRange(from, to *time.Time) (*time.Time, *time.Time) {
+	var fromDate, toDate *time.Time
+	if from != nil {
+		f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+		fromDate = &f
+	}
+	if to != nil {
+		t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+		toDate = &t
+	}
+	if fromDate != nil && toDate == nil {
+		today := time.Now().UTC()
+		t := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+		toDate = &t
+	}
+	return fromDate, toDate
 }
 
 func derivePrimaryItem(desc, fallback string) string {
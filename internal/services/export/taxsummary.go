@@ -0,0 +1,139 @@
+package export
+
+import (
+	"sort"
+
+	"github.com/joseph-ayodele/receipts-tracker/constants"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// UnmappedLine groups receipts whose category has no Schedule C line.
+const UnmappedLine = "—"
+
+// TaxYear is the Schedule C summary of one tax year.
+type TaxYear struct {
+	Year        int
+	Lines       []TaxLineTotal // in form order; unmapped last
+	Deductible  money.Amount
+	Unconverted int // receipts left out because they have no amount in the profile currency
+}
+
+// TaxLineTotal is one Schedule C line of a TaxYear.
+type TaxLineTotal struct {
+	Line              string
+	Label             string
+	DeductiblePercent int
+	Categories        []string
+	Receipts          int
+	Amount            money.Amount
+	Deductible        money.Amount
+}
+
+// TaxDetail is a drill-down row behind a TaxLineTotal.
+type TaxDetail struct {
+	Receipt    *entity.Receipt
+	Line       string
+	Amount     *money.Amount // in the profile currency; nil when unconverted
+	Deductible *money.Amount
+}
+
+// BuildTaxSummary groups receipts by tax year and by the Schedule C line of their
+// category (lineOf maps category name to line number) and applies each line's
+// deduction limit, e.g. 50% for meals. Amounts are in the profile currency: the
+// converted total, or the total when it is already in that currency.
+func BuildTaxSummary(recs []*entity.Receipt, lineOf map[string]string, profileCurrency string) ([]TaxYear, []TaxDetail) {
+	type key struct {
+		year int
+		line string
+	}
+	totals := map[key]*TaxLineTotal{}
+	years := map[int]*TaxYear{}
+	var details []TaxDetail
+
+	for _, r := range recs {
+		year := r.TxDate.Year()
+		if years[year] == nil {
+			years[year] = &TaxYear{Year: year}
+		}
+		ty := years[year]
+
+		tl, ok := constants.LookupTaxLine(lineOf[r.CategoryName])
+		if !ok {
+			tl = constants.TaxLine{Line: UnmappedLine, Label: "Not mapped to a tax line"}
+		}
+		d := TaxDetail{Receipt: r, Line: tl.Line}
+
+		amount := amountInCurrency(r, profileCurrency)
+		if amount == nil {
+			ty.Unconverted++
+			details = append(details, d)
+			continue
+		}
+		deductible := amount.Percent(tl.DeductiblePercent)
+		d.Amount, d.Deductible = amount, &deductible
+		details = append(details, d)
+
+		k := key{year, tl.Line}
+		t := totals[k]
+		if t == nil {
+			t = &TaxLineTotal{Line: tl.Line, Label: tl.Label, DeductiblePercent: tl.DeductiblePercent}
+			totals[k] = t
+		}
+		if !contains(t.Categories, r.CategoryName) {
+			t.Categories = append(t.Categories, r.CategoryName)
+		}
+		t.Receipts++
+		t.Amount += *amount
+		t.Deductible += deductible
+		ty.Deductible += deductible
+	}
+
+	order := map[string]int{UnmappedLine: len(constants.ScheduleCLines())}
+	for i, l := range constants.ScheduleCLines() {
+		order[l.Line] = i
+	}
+	for k, t := range totals {
+		sort.Strings(t.Categories)
+		years[k.year].Lines = append(years[k.year].Lines, *t)
+	}
+
+	out := make([]TaxYear, 0, len(years))
+	for _, ty := range years {
+		sort.Slice(ty.Lines, func(i, j int) bool { return order[ty.Lines[i].Line] < order[ty.Lines[j].Line] })
+		out = append(out, *ty)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Year < out[j].Year })
+	sort.SliceStable(details, func(i, j int) bool {
+		a, b := details[i], details[j]
+		if a.Receipt.TxDate.Year() != b.Receipt.TxDate.Year() {
+			return a.Receipt.TxDate.Year() < b.Receipt.TxDate.Year()
+		}
+		if a.Line != b.Line {
+			return order[a.Line] < order[b.Line]
+		}
+		return a.Receipt.TxDate.Before(b.Receipt.TxDate)
+	})
+	return out, details
+}
+
+// amountInCurrency returns the receipt total in the profile currency, or nil when it has
+// not been converted.
+func amountInCurrency(r *entity.Receipt, profileCurrency string) *money.Amount {
+	if r.ConvertedTotal != nil {
+		return r.ConvertedTotal
+	}
+	if r.CurrencyCode == profileCurrency {
+		return &r.Total
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
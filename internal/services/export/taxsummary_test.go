@@ -0,0 +1,74 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestBuildTaxSummary(t *testing.T) {
+	rec := func(date, category, total, currency string) *entity.Receipt {
+		d, _ := time.Parse("2006-01-02", date)
+		return &entity.Receipt{TxDate: d, CategoryName: category, Total: money.MustParse(total), CurrencyCode: currency}
+	}
+	converted := rec("2025-05-01", "Travel Expenses", "100.00", "EUR")
+	eur := money.MustParse("108.50")
+	converted.ConvertedTotal = &eur
+
+	recs := []*entity.Receipt{
+		rec("2025-03-14", "Meals", "42.18", "USD"),
+		rec("2025-03-20", "Meals", "10.01", "USD"),
+		rec("2025-01-02", "Office Supplies", "19.99", "USD"),
+		rec("2025-02-02", "Software Subscription", "12.00", "USD"),
+		converted,
+		rec("2025-06-01", "Travel Expenses", "50.00", "GBP"), // no rate
+		rec("2025-07-01", "Gear", "300.00", "USD"),           // category without a line
+		rec("2024-12-31", "Meals", "20.00", "USD"),
+	}
+	lineOf := map[string]string{
+		"Meals":                 "24b",
+		"Office Supplies":       "18",
+		"Software Subscription": "18",
+		"Travel Expenses":       "24a",
+	}
+
+	years, details := BuildTaxSummary(recs, lineOf, "USD")
+	if len(years) != 2 || years[0].Year != 2024 || years[1].Year != 2025 {
+		t.Fatalf("Expected years 2024 and 2025, got %+v", years)
+	}
+	if len(details) != len(recs) {
+		t.Errorf("Expected %d detail rows, got %d", len(recs), len(details))
+	}
+
+	y := years[1]
+	type line struct {
+		line, amount, deductible string
+		receipts                 int
+	}
+	expected := []line{
+		{"18", "31.99", "31.99", 2},
+		{"24a", "108.50", "108.50", 1},
+		{"24b", "52.19", "26.10", 2}, // 21.09 + 5.01
+		{UnmappedLine, "300.00", "0.00", 1},
+	}
+	if len(y.Lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %+v", len(expected), y.Lines)
+	}
+	for i, e := range expected {
+		got := y.Lines[i]
+		if got.Line != e.line || got.Amount.String() != e.amount || got.Deductible.String() != e.deductible || got.Receipts != e.receipts {
+			t.Errorf("Expected %+v, got %+v", e, got)
+		}
+	}
+	if y.Deductible.String() != "166.59" {
+		t.Errorf("Expected %q, got %q", "166.59", y.Deductible.String())
+	}
+	if y.Unconverted != 1 {
+		t.Errorf("Expected 1 unconverted receipt, got %d", y.Unconverted)
+	}
+	if got := y.Lines[0].Categories; len(got) != 2 || got[0] != "Office Supplies" || got[1] != "Software Subscription" {
+		t.Errorf("Expected both line 18 categories, got %v", got)
+	}
+}
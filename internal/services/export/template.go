@@ -0,0 +1,255 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/fx"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+// defaultColumns is the layout of the Receipts sheet when no template is selected.
+var defaultColumns = []entity.ExportColumn{
+	{Header: "Transaction Date", Field: "tx_date", Width: 14},
+	{Header: "Expense Category", Field: "category", Width: 22},
+	{Header: "Item/Service", Field: "item", Width: 28},
+	{Header: "Amount", Field: "total", Width: 14},
+	{Header: "Currency", Field: "currency", Width: 10},
+	{Header: "Converted Amount", Field: "converted_total", Width: 24},
+	{Header: "FX Rate", Field: "fx_rate", Width: 12},
+	{Header: "Rate Source", Field: "fx_source", Width: 18},
+	{Header: "Discount", Field: "discount", Width: 12},
+	{Header: "Other Fees", Field: "other_fees", Width: 12},
+	{Header: "Tip", Field: "tip", Width: 12},
+	{Header: "Purpose/Notes", Field: "description | truncate:140", Width: 48},
+	{Header: "Receipt/File Path", Field: "file_path", Width: 60},
+	{Header: "Needs Review", Field: "needs_review", Width: 14},
+}
+
+// DefaultColumns returns a copy of the built-in Receipts sheet layout, a starting point
+// for templates.
+func DefaultColumns() []entity.ExportColumn {
+	return append([]entity.ExportColumn(nil), defaultColumns...)
+}
+
+// fieldKind decides how a field's values are written and which formats apply to it.
+type fieldKind int
+
+const (
+	kindText      fieldKind = iota
+	kindDate                // time.Time
+	kindAmount              // *money.Amount in the receipt currency
+	kindConverted           // *money.Amount in the profile currency
+	kindRate                // *float64
+	kindLink                // file path, written as a hyperlink
+	kindFlag                // bool, written as "Yes" or left empty
+)
+
+// totalRule says whether the totals row sums a column.
+type totalRule int
+
+const (
+	noTotal totalRule = iota
+	totalAlways
+	totalSameCurrency // only when every receipt shares one currency
+)
+
+type templateField struct {
+	kind  fieldKind
+	width float64
+	total totalRule
+	value func(r *entity.Receipt, pathOf func(*entity.Receipt) string, profileCurrency string) any
+}
+
+// amountField is an amount in the receipt's currency. Such amounts only add up when every
+// receipt shares one currency, so that is when the totals row sums them.
+func amountField(get func(r *entity.Receipt) *money.Amount) templateField {
+	return templateField{kind: kindAmount, width: 12, total: totalSameCurrency, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, _ string) any {
+		return get(r)
+	}}
+}
+
+func textField(width float64, get func(r *entity.Receipt) string) templateField {
+	return templateField{kind: kindText, width: width, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, _ string) any {
+		return get(r)
+	}}
+}
+
+// templateFields are the receipt fields a template column can show.
+var templateFields = map[string]templateField{
+	"receipt_id":  textField(38, func(r *entity.Receipt) string { return r.ID.String() }),
+	"merchant":    textField(24, func(r *entity.Receipt) string { return r.MerchantName }),
+	"category":    textField(22, func(r *entity.Receipt) string { return r.CategoryName }),
+	"item":        textField(28, func(r *entity.Receipt) string { return derivePrimaryItem(r.Description, r.MerchantName) }),
+	"description": textField(48, func(r *entity.Receipt) string { return r.Description }),
+	"currency":    textField(10, func(r *entity.Receipt) string { return r.CurrencyCode }),
+	"tx_date": {kind: kindDate, width: 14, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, _ string) any {
+		return r.TxDate
+	}},
+	"subtotal":   amountField(func(r *entity.Receipt) *money.Amount { return r.Subtotal }),
+	"tax":        amountField(func(r *entity.Receipt) *money.Amount { return r.Tax }),
+	"total":      amountField(func(r *entity.Receipt) *money.Amount { return &r.Total }),
+	"discount":   amountField(func(r *entity.Receipt) *money.Amount { return r.Discount }),
+	"other_fees": amountField(func(r *entity.Receipt) *money.Amount { return r.OtherFees }),
+	"tip":        amountField(func(r *entity.Receipt) *money.Amount { return r.Tip }),
+	"converted_total": {kind: kindConverted, width: 24, total: totalAlways, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, profileCurrency string) any {
+		if profileCurrency == "" {
+			return (*money.Amount)(nil)
+		}
+		return amountInCurrency(r, profileCurrency)
+	}},
+	"fx_rate": {kind: kindRate, width: 12, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, _ string) any {
+		if !hasFxRate(r) {
+			return (*float64)(nil)
+		}
+		return r.FxRate
+	}},
+	"fx_source": textField(18, func(r *entity.Receipt) string {
+		if !hasFxRate(r) {
+			return ""
+		}
+		source := *r.FxSource
+		if r.FxRateDate != nil {
+			source += " " + r.FxRateDate.Format("2006-01-02")
+		}
+		return source
+	}),
+	"file_path": {kind: kindLink, width: 60, value: func(r *entity.Receipt, pathOf func(*entity.Receipt) string, _ string) any {
+		return pathOf(r)
+	}},
+	"needs_review": {kind: kindFlag, width: 14, value: func(r *entity.Receipt, _ func(*entity.Receipt) string, _ string) any {
+		return r.NeedsReview
+	}},
+}
+
+// hasFxRate reports whether the receipt was converted at a real (non-identity) rate.
+func hasFxRate(r *entity.Receipt) bool {
+	return r.ConvertedTotal != nil && r.FxRate != nil && r.FxSource != nil && *r.FxSource != fx.SourceIdentity
+}
+
+// templateColumn is a validated ExportColumn.
+type templateColumn struct {
+	header  string
+	field   string // bare field name
+	plain   bool   // no filters; the value is the field itself
+	def     templateField
+	filters []func(string) string
+	format  string
+	width   float64
+}
+
+// headerIn is the column header, naming the currency of converted amounts.
+func (c templateColumn) headerIn(profileCurrency string) string {
+	if c.def.kind == kindConverted && profileCurrency != "" {
+		return c.header + " (" + profileCurrency + ")"
+	}
+	return c.header
+}
+
+func (c templateColumn) value(r *entity.Receipt, pathOf func(*entity.Receipt) string, profileCurrency string) any {
+	v := c.def.value(r, pathOf, profileCurrency)
+	if s, ok := v.(string); ok {
+		for _, f := range c.filters {
+			s = f(s)
+		}
+		return s
+	}
+	return v
+}
+
+// ValidateColumns checks a template's columns: every field must exist, filters only apply
+// to text fields and formats only to dates and amounts.
+func ValidateColumns(cols []entity.ExportColumn) error {
+	_, err := compileColumns(cols)
+	return err
+}
+
+func compileColumns(cols []entity.ExportColumn) ([]templateColumn, error) {
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("a template needs at least one column")
+	}
+	out := make([]templateColumn, len(cols))
+	for i, c := range cols {
+		tc, err := compileColumn(c)
+		if err != nil {
+			return nil, fmt.Errorf("column %d: %w", i+1, err)
+		}
+		out[i] = tc
+	}
+	return out, nil
+}
+
+func compileColumn(c entity.ExportColumn) (templateColumn, error) {
+	parts := strings.Split(c.Field, "|")
+	name := strings.TrimSpace(parts[0])
+	def, ok := templateFields[name]
+	if !ok {
+		return templateColumn{}, fmt.Errorf("unknown field %q (want one of %s)", name, strings.Join(FieldNames(), ", "))
+	}
+	tc := templateColumn{
+		header: strings.TrimSpace(c.Header),
+		field:  name,
+		plain:  len(parts) == 1,
+		def:    def,
+		format: strings.TrimSpace(c.Format),
+		width:  c.Width,
+	}
+	if tc.header == "" {
+		tc.header = name
+	}
+	for _, p := range parts[1:] {
+		f, err := parseFilter(strings.TrimSpace(p))
+		if err != nil {
+			return templateColumn{}, err
+		}
+		if def.kind != kindText {
+			return templateColumn{}, fmt.Errorf("filter %q only applies to text fields, not %q", strings.TrimSpace(p), name)
+		}
+		tc.filters = append(tc.filters, f)
+	}
+	switch def.kind {
+	case kindDate, kindAmount, kindConverted, kindRate:
+	default:
+		if tc.format != "" {
+			return templateColumn{}, fmt.Errorf("format only applies to date and amount fields, not %q", name)
+		}
+	}
+	if tc.width < 0 || tc.width > 255 {
+		return templateColumn{}, fmt.Errorf("width must be between 0 and 255")
+	}
+	if tc.width == 0 {
+		tc.width = def.width
+	}
+	return tc, nil
+}
+
+// parseFilter parses "upper", "lower" or "truncate:N".
+func parseFilter(s string) (func(string) string, error) {
+	name, arg, _ := strings.Cut(s, ":")
+	switch strings.TrimSpace(name) {
+	case "upper":
+		return strings.ToUpper, nil
+	case "lower":
+		return strings.ToLower, nil
+	case "truncate":
+		n, err := strconv.Atoi(strings.TrimSpace(arg))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("truncate needs a positive length, e.g. truncate:140")
+		}
+		return func(v string) string { return truncate(v, n) }, nil
+	}
+	return nil, fmt.Errorf("unknown filter %q (want upper, lower or truncate:N)", s)
+}
+
+// FieldNames lists the fields a template column can show.
+func FieldNames() []string {
+	names := make([]string, 0, len(templateFields))
+	for name := range templateFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,153 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestValidateColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []entity.ExportColumn
+		err     string
+	}{
+		{name: "Default layout", columns: DefaultColumns()},
+		{name: "Filters", columns: []entity.ExportColumn{{Field: "merchant | upper | truncate:20"}}},
+		{name: "Date format", columns: []entity.ExportColumn{{Field: "tx_date", Format: "dd/mm/yyyy"}}},
+		{name: "No columns", err: "at least one column"},
+		{name: "Unknown field", columns: []entity.ExportColumn{{Field: "vendor"}}, err: `column 1: unknown field "vendor"`},
+		{name: "Unknown filter", columns: []entity.ExportColumn{{Field: "merchant | title"}}, err: `unknown filter "title"`},
+		{name: "Bad truncate", columns: []entity.ExportColumn{{Field: "description | truncate:x"}}, err: "positive length"},
+		{name: "Filter on amount", columns: []entity.ExportColumn{{Field: "total | upper"}}, err: "only applies to text fields"},
+		{name: "Format on text", columns: []entity.ExportColumn{{Field: "merchant", Format: "0.00"}}, err: "only applies to date and amount fields"},
+		{name: "Width", columns: []entity.ExportColumn{{Field: "merchant", Width: 300}}, err: "width"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateColumns(tt.columns)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestWriteReceiptsWorkbookTemplate(t *testing.T) {
+	d, _ := time.Parse("2006-01-02", "2025-03-14")
+	subtotal, tax := money.MustParse("39.00"), money.MustParse("3.18")
+	recs := []*entity.Receipt{
+		{TxDate: d, MerchantName: "Staples", CategoryName: "Office Supplies", Subtotal: &subtotal, Tax: &tax,
+			Total: money.MustParse("42.18"), CurrencyCode: "USD", Description: "Printer paper, toner"},
+		{TxDate: d, MerchantName: "Blue Bottle", CategoryName: "Meals", Total: money.MustParse("10.00"), CurrencyCode: "USD"},
+	}
+	columns := []entity.ExportColumn{
+		{Header: "Merchant", Field: "merchant | upper"},
+		{Header: "Date", Field: "tx_date", Format: "dd/mm/yyyy"},
+		{Header: "Subtotal", Field: "subtotal"},
+		{Header: "Tax", Field: "tax"},
+		{Header: "Total", Field: "total"},
+		{Field: "category"},
+	}
+	var buf bytes.Buffer
+	if err := WriteReceiptsWorkbook(&buf, recs, columns, "USD", func(*entity.Receipt) string { return "/r.pdf" }); err != nil {
+		t.Fatalf("WriteReceiptsWorkbook: %v", err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	defer f.Close()
+
+	header, _ := f.GetRows(receiptsSheet)
+	if got := strings.Join(header[0], ","); got != "Merchant,Date,Subtotal,Tax,Total,category" {
+		t.Errorf("Expected %q, got %q", "Merchant,Date,Subtotal,Tax,Total,category", got)
+	}
+	tests := []struct {
+		name    string
+		sheet   string
+		cell    string
+		value   string
+		formula string
+	}{
+		{name: "Filtered text", sheet: receiptsSheet, cell: "A2", value: "STAPLES"},
+		{name: "Custom date format", sheet: receiptsSheet, cell: "B2", value: "14/03/2025"},
+		{name: "Empty amount", sheet: receiptsSheet, cell: "C3", value: ""},
+		{name: "Total label", sheet: receiptsSheet, cell: "A4", value: "Total"},
+		{name: "Subtotal total", sheet: receiptsSheet, cell: "C4", value: "39.00", formula: "SUM(C2:C3)"},
+		{name: "Count follows category column", sheet: byCategorySheet, cell: "B2", value: "1",
+			formula: "COUNTIFS('Receipts'!$F$2:$F$3,$A2)"},
+		// No converted_total column: the value is kept, the formula left out.
+		{name: "Total without converted column", sheet: byCategorySheet, cell: "C3", value: "42.18 USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := f.GetCellValue(tt.sheet, tt.cell)
+			if got != tt.value {
+				t.Errorf("Expected %q, got %q", tt.value, got)
+			}
+			formula, _ := f.GetCellFormula(tt.sheet, tt.cell)
+			if formula != tt.formula {
+				t.Errorf("Expected formula %q, got %q", tt.formula, formula)
+			}
+		})
+	}
+}
+
+func TestWriteReceiptsWorkbookTemplateTotals(t *testing.T) {
+	d, _ := time.Parse("2006-01-02", "2025-03-14")
+	amount := func(s string) *money.Amount { a := money.MustParse(s); return &a }
+	columns := []entity.ExportColumn{
+		{Field: "currency"},
+		{Header: "Discount", Field: "discount"},
+		{Header: "Tip", Field: "tip"},
+		{Header: "Fees", Field: "other_fees"},
+		{Header: "Converted", Field: "converted_total"},
+	}
+	tests := []struct {
+		name       string
+		currencies []string
+		expected   []string // formulas of B4:E4
+	}{
+		{name: "One currency", currencies: []string{"USD", "USD"},
+			expected: []string{"SUM(B2:B3)", "SUM(C2:C3)", "SUM(D2:D3)", "SUM(E2:E3)"}},
+		{name: "Mixed currencies", currencies: []string{"USD", "EUR"},
+			expected: []string{"", "", "", "SUM(E2:E3)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recs []*entity.Receipt
+			for _, c := range tt.currencies {
+				recs = append(recs, &entity.Receipt{TxDate: d, Total: money.MustParse("20.00"), CurrencyCode: c, ConvertedTotal: amount("20.00"),
+					Discount: amount("1.00"), Tip: amount("2.00"), OtherFees: amount("0.50")})
+			}
+			var buf bytes.Buffer
+			if err := WriteReceiptsWorkbook(&buf, recs, columns, "USD", func(*entity.Receipt) string { return "" }); err != nil {
+				t.Fatalf("WriteReceiptsWorkbook: %v", err)
+			}
+			f, err := excelize.OpenReader(&buf)
+			if err != nil {
+				t.Fatalf("open workbook: %v", err)
+			}
+			defer f.Close()
+			for i, expected := range tt.expected {
+				cell := string(rune('B'+i)) + "4"
+				if formula, _ := f.GetCellFormula(receiptsSheet, cell); formula != expected {
+					t.Errorf("%s: expected formula %q, got %q", cell, expected, formula)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent/profile"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// TemplateRequest carries the attributes of an export template.
+type TemplateRequest struct {
+	ID        string // update only
+	ProfileID string // create only
+	Name      string
+	Columns   []entity.ExportColumn
+}
+
+// ListTemplates returns a profile's export templates by name.
+func (s *Service) ListTemplates(ctx context.Context, profileID string) ([]*entity.ExportTemplate, error) {
+	pid, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.templateRepo.ListByProfile(ctx, pid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list templates: %v", err)
+	}
+	s.logger.Info("export templates listed", "profile_id", pid, "count", len(list))
+	return list, nil
+}
+
+// CreateTemplate validates and stores a new template.
+func (s *Service) CreateTemplate(ctx context.Context, req TemplateRequest) (*entity.ExportTemplate, error) {
+	pid, err := s.requireProfile(ctx, req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := buildTemplate(pid, req)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.templateRepo.Create(ctx, t)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "template %q already exists", t.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "create template: %v", err)
+	}
+	s.logger.Info("export template created", "profile_id", pid, "template_id", created.ID, "name", created.Name)
+	return created, nil
+}
+
+// UpdateTemplate replaces a template's name and columns.
+func (s *Service) UpdateTemplate(ctx context.Context, req TemplateRequest) (*entity.ExportTemplate, error) {
+	existing, err := s.getTemplate(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := buildTemplate(existing.ProfileID, req)
+	if err != nil {
+		return nil, err
+	}
+	t.ID = existing.ID
+	updated, err := s.templateRepo.Update(ctx, t)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "template %q already exists", t.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "update template: %v", err)
+	}
+	s.logger.Info("export template updated", "template_id", updated.ID, "name", updated.Name)
+	return updated, nil
+}
+
+// DeleteTemplate removes a template.
+func (s *Service) DeleteTemplate(ctx context.Context, id string) error {
+	existing, err := s.getTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.templateRepo.Delete(ctx, existing.ID); err != nil {
+		return status.Errorf(codes.Internal, "delete template: %v", err)
+	}
+	s.logger.Info("export template deleted", "template_id", existing.ID, "name", existing.Name)
+	return nil
+}
+
+// TemplateColumns returns the columns of the profile's template with the given name, or
+// nil (the default layout) for an empty name.
+func (s *Service) TemplateColumns(ctx context.Context, profileID uuid.UUID, name string) ([]entity.ExportColumn, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	t, err := s.templateRepo.GetByName(ctx, profileID, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "template %q not found", name)
+		}
+		return nil, status.Errorf(codes.Internal, "get template: %v", err)
+	}
+	return t.Columns, nil
+}
+
+func buildTemplate(profileID uuid.UUID, req TemplateRequest) (*entity.ExportTemplate, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := ValidateColumns(req.Columns); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	cols := make([]entity.ExportColumn, len(req.Columns))
+	for i, c := range req.Columns {
+		cols[i] = entity.ExportColumn{
+			Header: strings.TrimSpace(c.Header),
+			Field:  strings.TrimSpace(c.Field),
+			Format: strings.TrimSpace(c.Format),
+			Width:  c.Width,
+		}
+	}
+	return &entity.ExportTemplate{ProfileID: profileID, Name: name, Columns: cols}, nil
+}
+
+func (s *Service) getTemplate(ctx context.Context, id string) (*entity.ExportTemplate, error) {
+	tid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	t, err := s.templateRepo.GetByID(ctx, tid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "template not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get template: %v", err)
+	}
+	return t, nil
+}
+
+func (s *Service) requireProfile(ctx context.Context, profileID string) (uuid.UUID, error) {
+	if strings.TrimSpace(profileID) == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	ok, err := s.ent.Profile.Query().Where(profile.ID(pid)).Exist(ctx)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Internal, "check profile: %v", err)
+	}
+	if !ok {
+		return uuid.Nil, status.Error(codes.NotFound, "profile not found")
+	}
+	return pid, nil
+}
@@ -0,0 +1,636 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/recurring"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+const (
+	receiptsSheet   = "Receipts"
+	byCategorySheet = "By Category"
+	byMonthSheet    = "By Month"
+	recurringSheet  = "Recurring"
+
+	// maxHyperlinkLen is Excel's limit on a string literal inside a formula.
+	maxHyperlinkLen = 255
+)
+
+// sheetLayout is the compiled column layout of the Receipts sheet with the letters of the
+// columns the summary formulas refer to; a letter is empty when the template has no such
+// column, and the formulas needing it are left out (their values are still written).
+type sheetLayout struct {
+	columns                           []templateColumn
+	date, category, converted, review string
+}
+
+func newSheetLayout(cols []entity.ExportColumn) (sheetLayout, error) {
+	if len(cols) == 0 {
+		cols = defaultColumns
+	}
+	compiled, err := compileColumns(cols)
+	if err != nil {
+		return sheetLayout{}, err
+	}
+	l := sheetLayout{columns: compiled}
+	for i, c := range compiled {
+		if !c.plain {
+			continue
+		}
+		letter, _ := excelize.ColumnNumberToName(i + 1)
+		for _, ref := range []struct {
+			field string
+			dst   *string
+		}{{"tx_date", &l.date}, {"category", &l.category}, {"converted_total", &l.converted}, {"needs_review", &l.review}} {
+			if c.field == ref.field && *ref.dst == "" {
+				*ref.dst = letter
+			}
+		}
+	}
+	return l, nil
+}
+
+// formula returns f when every column it refers to is in the layout.
+func (l sheetLayout) formula(f string, cols ...string) string {
+	for _, c := range cols {
+		if c == "" {
+			return ""
+		}
+	}
+	return f
+}
+
+// workbookStyles are the cell styles shared by the sheets of one workbook.
+type workbookStyles struct {
+	header, date, month, amount, converted, rate, link, total, totalAmount, totalConverted int
+}
+
+// WriteReceiptsWorkbook streams the receipts workbook to w: a "Receipts" sheet with a
+// frozen, filterable header, numeric amounts and a totals row, plus "By Category" and
+// "By Month" summaries whose cells are SUMIFS/COUNTIFS formulas over the Receipts sheet,
+// and a "Recurring" sheet of the subscriptions and bills found among the receipts.
+// columns lay out the Receipts sheet (see ValidateColumns); nil means the default layout.
+// pathOf resolves the stored file of a receipt; the path is written as a hyperlink.
+// Converted amounts are in profileCurrency, which may be empty when unknown.
+func WriteReceiptsWorkbook(w io.Writer, recs []*entity.Receipt, columns []entity.ExportColumn, profileCurrency string, pathOf func(*entity.Receipt) string) error {
+	layout, err := newSheetLayout(columns)
+	if err != nil {
+		return fmt.Errorf("template: %w", err)
+	}
+
+	f := excelize.NewFile()
+	defer func() { _ = f.Close() }()
+
+	st, err := newWorkbookStyles(f, profileCurrency)
+	if err != nil {
+		return err
+	}
+	if err := f.SetSheetName("Sheet1", receiptsSheet); err != nil {
+		return err
+	}
+	if err := writeReceiptsSheet(f, st, layout, recs, profileCurrency, pathOf); err != nil {
+		return fmt.Errorf("receipts sheet: %w", err)
+	}
+
+	sum := summarize(recs, profileCurrency)
+	if err := writeByCategorySheet(f, st, layout, sum, len(recs), profileCurrency); err != nil {
+		return fmt.Errorf("category sheet: %w", err)
+	}
+	if err := writeByMonthSheet(f, st, layout, sum, len(recs), profileCurrency); err != nil {
+		return fmt.Errorf("month sheet: %w", err)
+	}
+	if err := writeRecurringSheet(f, st, recurring.Detect(recs, recurring.Options{})); err != nil {
+		return fmt.Errorf("recurring sheet: %w", err)
+	}
+	f.SetActiveSheet(0)
+
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("xlsx write: %w", err)
+	}
+	return nil
+}
+
+func newWorkbookStyles(f *excelize.File, profileCurrency string) (workbookStyles, error) {
+	bold := &excelize.Font{Bold: true}
+	amountFmt := "#,##0.00"
+	convertedFmt := amountFmt
+	if profileCurrency != "" {
+		convertedFmt = `#,##0.00 "` + profileCurrency + `"`
+	}
+	var st workbookStyles
+	for _, s := range []struct {
+		dst   *int
+		style *excelize.Style
+	}{
+		{&st.header, &excelize.Style{Font: bold, Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"DDEBF7"}}}},
+		{&st.date, &excelize.Style{CustomNumFmt: strPtr("yyyy-mm-dd")}},
+		{&st.month, &excelize.Style{CustomNumFmt: strPtr("yyyy-mm"), Font: bold, Alignment: &excelize.Alignment{Horizontal: "left"}}},
+		{&st.amount, &excelize.Style{CustomNumFmt: &amountFmt}},
+		{&st.converted, &excelize.Style{CustomNumFmt: &convertedFmt}},
+		{&st.rate, &excelize.Style{CustomNumFmt: strPtr("0.000000")}},
+		{&st.link, &excelize.Style{Font: &excelize.Font{Color: "0563C1", Underline: "single"}}},
+		{&st.total, &excelize.Style{Font: bold}},
+		{&st.totalAmount, &excelize.Style{Font: bold, CustomNumFmt: &amountFmt}},
+		{&st.totalConverted, &excelize.Style{Font: bold, CustomNumFmt: &convertedFmt}},
+	} {
+		id, err := f.NewStyle(s.style)
+		if err != nil {
+			return st, fmt.Errorf("style: %w", err)
+		}
+		*s.dst = id
+	}
+	return st, nil
+}
+
+func writeReceiptsSheet(f *excelize.File, st workbookStyles, l sheetLayout, recs []*entity.Receipt, profileCurrency string, pathOf func(*entity.Receipt) string) error {
+	cols := l.columns
+	lastRow := len(recs) + 1
+	if len(recs) > 0 {
+		// The filter is stored on the worksheet before streaming so the writer keeps it.
+		if err := f.AutoFilter(receiptsSheet, "A1:"+cellName(len(cols), lastRow), nil); err != nil {
+			return err
+		}
+	}
+	styles, err := newColumnStyles(f, st, cols)
+	if err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(receiptsSheet)
+	if err != nil {
+		return err
+	}
+	for i, c := range cols {
+		if err := sw.SetColWidth(i+1, i+1, c.width); err != nil {
+			return err
+		}
+	}
+	if err := sw.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+
+	header := make([]any, len(cols))
+	for i, c := range cols {
+		header[i] = excelize.Cell{StyleID: st.header, Value: c.headerIn(profileCurrency)}
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	currencies := map[string]bool{}
+	totals := make([]money.Amount, len(cols))
+	for i, r := range recs {
+		currencies[r.CurrencyCode] = true
+		row := make([]any, len(cols))
+		for j, c := range cols {
+			switch v := c.value(r, pathOf, profileCurrency).(type) {
+			case string:
+				if c.def.kind == kindLink {
+					if v != "" {
+						row[j] = fileLink(v, st.link)
+					}
+				} else {
+					row[j] = v
+				}
+			case time.Time:
+				if !v.IsZero() {
+					row[j] = excelize.Cell{StyleID: styles[j].cell, Value: dateOnly(v)}
+				}
+			case *money.Amount:
+				if v != nil {
+					totals[j] += *v
+					row[j] = excelize.Cell{StyleID: styles[j].cell, Value: v.Float64()}
+				}
+			case *float64:
+				if v != nil {
+					row[j] = excelize.Cell{StyleID: styles[j].cell, Value: *v}
+				}
+			case bool:
+				if v {
+					row[j] = "Yes"
+				}
+			}
+		}
+		if err := sw.SetRow(cellName(1, i+2), row); err != nil {
+			return err
+		}
+	}
+
+	if len(recs) > 0 {
+		row := make([]any, len(cols))
+		for j, c := range cols {
+			switch c.def.total {
+			case totalSameCurrency:
+				// Amounts in different currencies don't add up; only total them when they share one.
+				if len(currencies) != 1 {
+					continue
+				}
+			case totalAlways:
+				if c.def.kind == kindConverted && profileCurrency == "" {
+					continue
+				}
+			default:
+				continue
+			}
+			letter, _ := excelize.ColumnNumberToName(j + 1)
+			row[j] = excelize.Cell{StyleID: styles[j].total, Formula: sumFormula(letter, lastRow), Value: totals[j].Float64()}
+		}
+		if row[0] == nil {
+			row[0] = excelize.Cell{StyleID: st.total, Value: "Total"}
+		}
+		if err := sw.SetRow(cellName(1, lastRow+1), row); err != nil {
+			return err
+		}
+	}
+	return sw.Flush()
+}
+
+// columnStyle is the style of a column's data cells and of its totals cell.
+type columnStyle struct{ cell, total int }
+
+// newColumnStyles picks each column's number format: the template's format, or the
+// workbook default for the field's kind.
+func newColumnStyles(f *excelize.File, st workbookStyles, cols []templateColumn) ([]columnStyle, error) {
+	custom := map[string]columnStyle{}
+	out := make([]columnStyle, len(cols))
+	for i, c := range cols {
+		switch {
+		case c.format != "":
+			if cs, ok := custom[c.format]; ok {
+				out[i] = cs
+				continue
+			}
+			cell, err := f.NewStyle(&excelize.Style{CustomNumFmt: strPtr(c.format)})
+			if err != nil {
+				return nil, fmt.Errorf("style: %w", err)
+			}
+			total, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}, CustomNumFmt: strPtr(c.format)})
+			if err != nil {
+				return nil, fmt.Errorf("style: %w", err)
+			}
+			out[i] = columnStyle{cell, total}
+			custom[c.format] = out[i]
+		case c.def.kind == kindDate:
+			out[i] = columnStyle{st.date, st.total}
+		case c.def.kind == kindAmount:
+			out[i] = columnStyle{st.amount, st.totalAmount}
+		case c.def.kind == kindConverted:
+			out[i] = columnStyle{st.converted, st.totalConverted}
+		case c.def.kind == kindRate:
+			out[i] = columnStyle{st.rate, st.total}
+		}
+	}
+	return out, nil
+}
+
+// summary holds the values the summary formulas evaluate to, so the workbook shows them
+// before a spreadsheet application recalculates.
+type summary struct {
+	categories []string
+	months     []time.Time
+	byCategory map[string]*bucket
+	byMonth    map[time.Time]*bucket
+	pivot      map[string]map[time.Time]money.Amount
+	all        bucket
+}
+
+type bucket struct {
+	receipts    int
+	needsReview int
+	total       money.Amount
+}
+
+func (b *bucket) add(r *entity.Receipt, amount *money.Amount) {
+	b.receipts++
+	if r.NeedsReview {
+		b.needsReview++
+	}
+	if amount != nil {
+		b.total += *amount
+	}
+}
+
+func summarize(recs []*entity.Receipt, profileCurrency string) summary {
+	s := summary{
+		byCategory: map[string]*bucket{},
+		byMonth:    map[time.Time]*bucket{},
+		pivot:      map[string]map[time.Time]money.Amount{},
+	}
+	for _, r := range recs {
+		var amount *money.Amount
+		if profileCurrency != "" {
+			amount = amountInCurrency(r, profileCurrency)
+		}
+		if s.byCategory[r.CategoryName] == nil {
+			s.byCategory[r.CategoryName] = &bucket{}
+			s.pivot[r.CategoryName] = map[time.Time]money.Amount{}
+			s.categories = append(s.categories, r.CategoryName)
+		}
+		s.byCategory[r.CategoryName].add(r, amount)
+		s.all.add(r, amount)
+		if r.TxDate.IsZero() {
+			continue
+		}
+		m := monthOf(r.TxDate)
+		if s.byMonth[m] == nil {
+			s.byMonth[m] = &bucket{}
+			s.months = append(s.months, m)
+		}
+		s.byMonth[m].add(r, amount)
+		if amount != nil {
+			s.pivot[r.CategoryName][m] += *amount
+		}
+	}
+	sort.Strings(s.categories)
+	sort.Slice(s.months, func(i, j int) bool { return s.months[i].Before(s.months[j]) })
+	return s
+}
+
+// writeByCategorySheet writes one row per category with its receipt count, converted
+// total and a pivot of the converted total by month.
+func writeByCategorySheet(f *excelize.File, st workbookStyles, l sheetLayout, sum summary, n int, profileCurrency string) error {
+	if _, err := f.NewSheet(byCategorySheet); err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(byCategorySheet)
+	if err != nil {
+		return err
+	}
+	_ = sw.SetColWidth(1, 1, 24)
+	_ = sw.SetColWidth(2, 2, 10)
+	_ = sw.SetColWidth(3, 3+len(sum.months), 16)
+	if err := sw.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+
+	header := []any{
+		excelize.Cell{StyleID: st.header, Value: "Category"},
+		excelize.Cell{StyleID: st.header, Value: "Receipts"},
+		excelize.Cell{StyleID: st.header, Value: totalHeader(profileCurrency)},
+	}
+	for _, m := range sum.months {
+		header = append(header, excelize.Cell{StyleID: st.month, Value: m})
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	lastRow := n + 1
+	cat, conv := rangeOf(l.category, lastRow), rangeOf(l.converted, lastRow)
+	for i, c := range sum.categories {
+		row := i + 2
+		b := sum.byCategory[c]
+		values := []any{
+			c,
+			excelize.Cell{Formula: l.formula(fmt.Sprintf("COUNTIFS(%s,$A%d)", cat, row), l.category), Value: b.receipts},
+			excelize.Cell{StyleID: st.converted, Formula: l.formula(fmt.Sprintf("SUMIFS(%s,%s,$A%d)", conv, cat, row), l.converted, l.category), Value: b.total.Float64()},
+		}
+		for j, m := range sum.months {
+			col, _ := excelize.ColumnNumberToName(4 + j)
+			header := "$" + col + "$1"
+			values = append(values, excelize.Cell{
+				StyleID: st.converted,
+				Formula: l.formula(fmt.Sprintf("SUMIFS(%s,%s,$A%d,%s)", conv, cat, row, l.monthCriteria(header, lastRow)), l.converted, l.category, l.date),
+				Value:   sum.pivot[c][m].Float64(),
+			})
+		}
+		if err := sw.SetRow(cellName(1, row), values); err != nil {
+			return err
+		}
+	}
+	if len(sum.categories) > 0 {
+		if err := writeSummaryTotals(sw, st, len(sum.categories), 3+len(sum.months), sum); err != nil {
+			return err
+		}
+	}
+	return sw.Flush()
+}
+
+// writeByMonthSheet writes one row per month with its receipt count, converted total and
+// the number of receipts still flagged for review.
+func writeByMonthSheet(f *excelize.File, st workbookStyles, l sheetLayout, sum summary, n int, profileCurrency string) error {
+	if _, err := f.NewSheet(byMonthSheet); err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(byMonthSheet)
+	if err != nil {
+		return err
+	}
+	_ = sw.SetColWidth(1, 1, 12)
+	_ = sw.SetColWidth(2, 2, 10)
+	_ = sw.SetColWidth(3, 3, 20)
+	_ = sw.SetColWidth(4, 4, 14)
+	if err := sw.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+	header := []any{
+		excelize.Cell{StyleID: st.header, Value: "Month"},
+		excelize.Cell{StyleID: st.header, Value: "Receipts"},
+		excelize.Cell{StyleID: st.header, Value: totalHeader(profileCurrency)},
+		excelize.Cell{StyleID: st.header, Value: "Needs Review"},
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	lastRow := n + 1
+	for i, m := range sum.months {
+		row := i + 2
+		b := sum.byMonth[m]
+		criteria := l.monthCriteria(fmt.Sprintf("$A%d", row), lastRow)
+		values := []any{
+			excelize.Cell{StyleID: st.month, Value: m},
+			excelize.Cell{Formula: l.formula(fmt.Sprintf("COUNTIFS(%s)", criteria), l.date), Value: b.receipts},
+			excelize.Cell{StyleID: st.converted, Formula: l.formula(fmt.Sprintf("SUMIFS(%s,%s)", rangeOf(l.converted, lastRow), criteria), l.date, l.converted), Value: b.total.Float64()},
+			excelize.Cell{Formula: l.formula(fmt.Sprintf(`COUNTIFS(%s,%s,"Yes")`, criteria, rangeOf(l.review, lastRow)), l.date, l.review), Value: b.needsReview},
+		}
+		if err := sw.SetRow(cellName(1, row), values); err != nil {
+			return err
+		}
+	}
+	if len(sum.months) > 0 {
+		last := len(sum.months) + 1
+		totals := []any{
+			excelize.Cell{StyleID: st.total, Value: "Total"},
+			excelize.Cell{StyleID: st.total, Formula: fmt.Sprintf("SUM(B2:B%d)", last), Value: sum.monthly(func(b *bucket) int { return b.receipts })},
+			excelize.Cell{StyleID: st.totalConverted, Formula: fmt.Sprintf("SUM(C2:C%d)", last), Value: sum.monthlyTotal().Float64()},
+			excelize.Cell{StyleID: st.total, Formula: fmt.Sprintf("SUM(D2:D%d)", last), Value: sum.monthly(func(b *bucket) int { return b.needsReview })},
+		}
+		if err := sw.SetRow(cellName(1, last+1), totals); err != nil {
+			return err
+		}
+	}
+	return sw.Flush()
+}
+
+// writeRecurringSheet lists the recurring expenses, one row per merchant. Periods are
+// checked up to the latest receipt in the export, so a bill missing in the last month of
+// the range shows once a later receipt is exported.
+func writeRecurringSheet(f *excelize.File, st workbookStyles, series []recurring.Series) error {
+	if _, err := f.NewSheet(recurringSheet); err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(recurringSheet)
+	if err != nil {
+		return err
+	}
+	for i, w := range []float64{24, 22, 12, 14, 14, 10, 10, 14, 14, 28, 36, 10} {
+		_ = sw.SetColWidth(i+1, i+1, w)
+	}
+	if err := sw.SetPanes(frozenHeader()); err != nil {
+		return err
+	}
+	var header []any
+	for _, h := range []string{"Merchant", "Category", "Cadence", "Typical Amount", "Last Amount", "Currency", "Receipts", "Last Receipt", "Next Expected", "Missing", "Price Changes", "Status"} {
+		header = append(header, excelize.Cell{StyleID: st.header, Value: h})
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return err
+	}
+
+	for i, sr := range series {
+		var missing, changes []string
+		for _, m := range sr.Missing {
+			missing = append(missing, sr.Cadence.Period(m))
+		}
+		for _, pc := range sr.PriceChanges {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", sr.Cadence.Period(pc.Date), pc.From, pc.To))
+		}
+		next := excelize.Cell{}
+		statusText := "Active"
+		if sr.Ended {
+			statusText = "Ended"
+		} else {
+			next = excelize.Cell{StyleID: st.date, Value: sr.NextExpected}
+		}
+		values := []any{
+			sr.Merchant,
+			sr.Category,
+			string(sr.Cadence),
+			excelize.Cell{StyleID: st.amount, Value: sr.TypicalAmount.Float64()},
+			excelize.Cell{StyleID: st.amount, Value: sr.LastAmount.Float64()},
+			sr.Currency,
+			len(sr.Receipts),
+			excelize.Cell{StyleID: st.date, Value: dateOnly(sr.Receipts[len(sr.Receipts)-1].TxDate)},
+			next,
+			strings.Join(missing, ", "),
+			strings.Join(changes, "; "),
+			statusText,
+		}
+		if err := sw.SetRow(cellName(1, i+2), values); err != nil {
+			return err
+		}
+	}
+	return sw.Flush()
+}
+
+// writeSummaryTotals adds a SUM row under the By Category table.
+func writeSummaryTotals(sw *excelize.StreamWriter, st workbookStyles, rows, cols int, sum summary) error {
+	last := rows + 1
+	totals := []any{excelize.Cell{StyleID: st.total, Value: "Total"}}
+	for col := 2; col <= cols; col++ {
+		letter, _ := excelize.ColumnNumberToName(col)
+		c := excelize.Cell{StyleID: st.totalConverted, Formula: fmt.Sprintf("SUM(%s2:%s%d)", letter, letter, last)}
+		switch {
+		case col == 2:
+			c.StyleID, c.Value = st.total, sum.all.receipts
+		case col == 3:
+			c.Value = sum.all.total.Float64()
+		default:
+			var t money.Amount
+			for _, byMonth := range sum.pivot {
+				t += byMonth[sum.months[col-4]]
+			}
+			c.Value = t.Float64()
+		}
+		totals = append(totals, c)
+	}
+	return sw.SetRow(cellName(1, last+1), totals)
+}
+
+func (s summary) monthly(v func(*bucket) int) int {
+	n := 0
+	for _, b := range s.byMonth {
+		n += v(b)
+	}
+	return n
+}
+
+func (s summary) monthlyTotal() money.Amount {
+	var t money.Amount
+	for _, b := range s.byMonth {
+		t += b.total
+	}
+	return t
+}
+
+// fileLink writes path as a HYPERLINK formula so the link needs no relationship part and
+// stays streamable. Paths too long for a formula literal are written as plain text.
+func fileLink(path string, style int) any {
+	target := path
+	if filepath.IsAbs(path) || filepath.VolumeName(path) != "" {
+		p := filepath.ToSlash(path)
+		if !strings.HasPrefix(p, "/") {
+			p = "/" + p
+		}
+		target = (&url.URL{Scheme: "file", Path: p}).String()
+	}
+	if len(target) > maxHyperlinkLen || len(path) > maxHyperlinkLen {
+		return path
+	}
+	return excelize.Cell{
+		StyleID: style,
+		Formula: fmt.Sprintf("HYPERLINK(%s,%s)", formulaString(target), formulaString(path)),
+		Value:   path,
+	}
+}
+
+// monthCriteria is the SUMIFS/COUNTIFS criteria pair selecting the Receipts rows dated
+// in the month starting at the date in cell.
+func (l sheetLayout) monthCriteria(cell string, lastRow int) string {
+	dates := rangeOf(l.date, lastRow)
+	return fmt.Sprintf(`%s,">="&%s,%s,"<"&EDATE(%s,1)`, dates, cell, dates, cell)
+}
+
+// rangeOf is an absolute reference to the data rows of a Receipts column.
+func rangeOf(col string, lastRow int) string {
+	return fmt.Sprintf("'%s'!$%s$2:$%s$%d", receiptsSheet, col, col, max(lastRow, 2))
+}
+
+func sumFormula(col string, lastRow int) string {
+	return fmt.Sprintf("SUM(%s2:%s%d)", col, col, lastRow)
+}
+
+func totalHeader(profileCurrency string) string {
+	if profileCurrency == "" {
+		return "Total"
+	}
+	return "Total (" + profileCurrency + ")"
+}
+
+func frozenHeader() *excelize.Panes {
+	return &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}
+}
+
+func formulaString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func strPtr(s string) *string { return &s }
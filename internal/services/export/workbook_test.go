@@ -0,0 +1,157 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
+)
+
+func TestWriteReceiptsWorkbook(t *testing.T) {
+	rec := func(date, category, total, currency, path string) *entity.Receipt {
+		d, _ := time.Parse("2006-01-02", date)
+		return &entity.Receipt{TxDate: d, CategoryName: category, Total: money.MustParse(total), CurrencyCode: currency, FilePath: &path}
+	}
+	recs := []*entity.Receipt{
+		rec("2025-03-14", "Office Supplies", "42.18", "USD", "/receipts/staples.pdf"),
+		rec("2025-03-20", "Meals", "10.01", "USD", `/receipts/say "cheese".jpg`),
+		rec("2025-04-02", "Office Supplies", "7.50", "USD", ""),
+	}
+	recs[1].NeedsReview = true
+
+	var buf bytes.Buffer
+	pathOf := func(r *entity.Receipt) string { return *r.FilePath }
+	if err := WriteReceiptsWorkbook(&buf, recs, nil, "USD", pathOf); err != nil {
+		t.Fatalf("WriteReceiptsWorkbook: %v", err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	defer f.Close()
+
+	if got := strings.Join(f.GetSheetList(), ","); got != "Receipts,By Category,By Month,Recurring" {
+		t.Errorf("Expected %q, got %q", "Receipts,By Category,By Month,Recurring", got)
+	}
+
+	tests := []struct {
+		name    string
+		sheet   string
+		cell    string
+		value   string
+		formula string
+	}{
+		{name: "Amount is numeric", sheet: receiptsSheet, cell: "D2", value: "42.18"},
+		{name: "Date is a date", sheet: receiptsSheet, cell: "A2", value: "2025-03-14"},
+		{name: "Path is a hyperlink", sheet: receiptsSheet, cell: "M2", value: "/receipts/staples.pdf",
+			formula: `HYPERLINK("file:///receipts/staples.pdf","/receipts/staples.pdf")`},
+		{name: "Quotes are escaped", sheet: receiptsSheet, cell: "M3", value: `/receipts/say "cheese".jpg`,
+			formula: `HYPERLINK("file:///receipts/say%20%22cheese%22.jpg","/receipts/say ""cheese"".jpg")`},
+		{name: "Totals row", sheet: receiptsSheet, cell: "F5", value: "59.69 USD", formula: "SUM(F2:F4)"},
+		{name: "Category total", sheet: byCategorySheet, cell: "C3", value: "49.68 USD",
+			formula: "SUMIFS('Receipts'!$F$2:$F$4,'Receipts'!$B$2:$B$4,$A3)"},
+		{name: "Category by month", sheet: byCategorySheet, cell: "D3", value: "42.18 USD"},
+		{name: "Month header", sheet: byCategorySheet, cell: "E1", value: "2025-04"},
+		{name: "Month count", sheet: byMonthSheet, cell: "B2", value: "2"},
+		{name: "Month needs review", sheet: byMonthSheet, cell: "D2", value: "1"},
+		{name: "Month totals", sheet: byMonthSheet, cell: "C4", value: "59.69 USD", formula: "SUM(C2:C3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := f.GetCellValue(tt.sheet, tt.cell)
+			if err != nil {
+				t.Fatalf("GetCellValue: %v", err)
+			}
+			if got != tt.value {
+				t.Errorf("Expected %q, got %q", tt.value, got)
+			}
+			if tt.formula == "" {
+				return
+			}
+			formula, _ := f.GetCellFormula(tt.sheet, tt.cell)
+			if formula != tt.formula {
+				t.Errorf("Expected %q, got %q", tt.formula, formula)
+			}
+		})
+	}
+}
+
+func TestWriteReceiptsWorkbookMixedCurrencies(t *testing.T) {
+	d, _ := time.Parse("2006-01-02", "2025-03-14")
+	amount := func(s string) *money.Amount { a := money.MustParse(s); return &a }
+	recs := []*entity.Receipt{
+		{TxDate: d, CategoryName: "Meals", Total: money.MustParse("40.00"), CurrencyCode: "USD",
+			Discount: amount("2.00"), OtherFees: amount("1.00"), Tip: amount("5.00")},
+		{TxDate: d, CategoryName: "Meals", Total: money.MustParse("30.00"), CurrencyCode: "EUR", ConvertedTotal: amount("32.40"),
+			Discount: amount("3.00"), OtherFees: amount("1.50"), Tip: amount("3.00")},
+	}
+	var buf bytes.Buffer
+	if err := WriteReceiptsWorkbook(&buf, recs, nil, "USD", func(*entity.Receipt) string { return "" }); err != nil {
+		t.Fatalf("WriteReceiptsWorkbook: %v", err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	defer f.Close()
+
+	// USD and EUR amounts don't add up; only the converted column is totalled.
+	tests := []struct {
+		name    string
+		cell    string
+		formula string
+	}{
+		{name: "Amount", cell: "D4"},
+		{name: "Converted amount", cell: "F4", formula: "SUM(F2:F3)"},
+		{name: "Discount", cell: "I4"},
+		{name: "Other fees", cell: "J4"},
+		{name: "Tip", cell: "K4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formula, _ := f.GetCellFormula(receiptsSheet, tt.cell)
+			if formula != tt.formula {
+				t.Errorf("Expected formula %q, got %q", tt.formula, formula)
+			}
+			if tt.formula != "" {
+				return
+			}
+			if got, _ := f.GetCellValue(receiptsSheet, tt.cell); got != "" {
+				t.Errorf("Expected no total, got %q", got)
+			}
+		})
+	}
+}
+
+func TestRecurringSheet(t *testing.T) {
+	var recs []*entity.Receipt
+	for _, d := range []string{"2025-01-05", "2025-02-05", "2025-03-05", "2025-05-05", "2025-06-05"} {
+		date, _ := time.Parse("2006-01-02", d)
+		recs = append(recs, &entity.Receipt{TxDate: date, MerchantName: "XFINITY", CategoryName: "Internet", Total: money.MustParse("80.00"), CurrencyCode: "USD"})
+	}
+	recs[4].Total = money.MustParse("90.00")
+
+	var buf bytes.Buffer
+	if err := WriteReceiptsWorkbook(&buf, recs, nil, "USD", func(*entity.Receipt) string { return "" }); err != nil {
+		t.Fatalf("WriteReceiptsWorkbook: %v", err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("open workbook: %v", err)
+	}
+	defer f.Close()
+
+	rows, _ := f.GetRows(recurringSheet)
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	expected := []string{"Xfinity", "Internet", "monthly", "80.00", "90.00", "USD", "5", "2025-06-05", "2025-07-05", "2025-04", "2025-06: 80.00 → 90.00", "Active"}
+	if got := strings.Join(rows[1], "|"); got != strings.Join(expected, "|") {
+		t.Errorf("Expected %q, got %q", strings.Join(expected, "|"), got)
+	}
+}
@@ -0,0 +1,248 @@
+package merchant
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/merchantname"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service handles merchant directory business logic.
+type Service struct {
+	merchantRepo repository.MerchantRepository
+	categoryRepo repository.CategoryRepository
+	profileRepo  repository.ProfileRepository
+	logger       *slog.Logger
+}
+
+// NewService creates a new merchant service.
+func NewService(merchantRepo repository.MerchantRepository, categoryRepo repository.CategoryRepository, profileRepo repository.ProfileRepository, logger *slog.Logger) *Service {
+	return &Service{
+		merchantRepo: merchantRepo,
+		categoryRepo: categoryRepo,
+		profileRepo:  profileRepo,
+		logger:       logger,
+	}
+}
+
+// MerchantRequest carries the editable attributes of a merchant.
+type MerchantRequest struct {
+	ID              string // update only
+	ProfileID       string // create only
+	Name            string
+	Aliases         []string
+	DefaultCategory string
+}
+
+// ListMerchants returns the profile's merchant directory.
+func (s *Service) ListMerchants(ctx context.Context, profileID string) ([]*entity.Merchant, error) {
+	pid, err := s.requireProfile(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.merchantRepo.ListByProfile(ctx, pid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list merchants: %v", err)
+	}
+	s.logger.Info("merchants listed", "profile_id", pid, "count", len(list))
+	return list, nil
+}
+
+// CreateMerchant adds a merchant ahead of ingest, e.g. to give it a default category.
+func (s *Service) CreateMerchant(ctx context.Context, req MerchantRequest) (*entity.Merchant, error) {
+	pid, err := s.requireProfile(ctx, req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	name := merchantname.Clean(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	defaultCategory, err := s.categoryName(ctx, pid, req.DefaultCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.merchantRepo.Create(ctx, &entity.Merchant{
+		ProfileID:       pid,
+		Name:            name,
+		Aliases:         req.Aliases,
+		DefaultCategory: defaultCategory,
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "merchant %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "create merchant: %v", err)
+	}
+	s.logger.Info("merchant created", "profile_id", pid, "merchant_id", m.ID, "name", m.Name)
+	return m, nil
+}
+
+// UpdateMerchant replaces a merchant's name, aliases and default category; an empty name
+// keeps the current one. Receipts keep their extracted merchant name.
+func (s *Service) UpdateMerchant(ctx context.Context, req MerchantRequest) (*entity.Merchant, error) {
+	existing, err := s.get(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	name := merchantname.Clean(req.Name)
+	if name == "" {
+		name = existing.Name
+	}
+	defaultCategory, err := s.categoryName(ctx, existing.ProfileID, req.DefaultCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.merchantRepo.Update(ctx, &entity.Merchant{
+		ID:              existing.ID,
+		Name:            name,
+		Aliases:         req.Aliases,
+		DefaultCategory: defaultCategory,
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "merchant %q already exists", name)
+		}
+		return nil, status.Errorf(codes.Internal, "update merchant: %v", err)
+	}
+	s.logger.Info("merchant updated", "merchant_id", m.ID, "name", m.Name)
+	return m, nil
+}
+
+// MergeMerchants folds the source merchants into the target.
+func (s *Service) MergeMerchants(ctx context.Context, targetID string, sourceIDs []string) (*entity.Merchant, int, error) {
+	target, err := s.get(ctx, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(sourceIDs) == 0 {
+		return nil, 0, status.Error(codes.InvalidArgument, "source_ids is required")
+	}
+	ids := make([]uuid.UUID, 0, len(sourceIDs))
+	seen := map[uuid.UUID]bool{}
+	for _, raw := range sourceIDs {
+		src, err := s.get(ctx, raw)
+		if err != nil {
+			return nil, 0, err
+		}
+		if src.ID == target.ID {
+			return nil, 0, status.Error(codes.InvalidArgument, "a merchant cannot be merged into itself")
+		}
+		if src.ProfileID != target.ProfileID {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "merchant %s belongs to another profile", src.ID)
+		}
+		if !seen[src.ID] {
+			seen[src.ID] = true
+			ids = append(ids, src.ID)
+		}
+	}
+
+	m, moved, err := s.merchantRepo.Merge(ctx, target.ID, ids)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "merge merchants: %v", err)
+	}
+	s.logger.Info("merchants merged", "merchant_id", m.ID, "sources", len(ids), "receipts_moved", moved)
+	return m, moved, nil
+}
+
+// SplitMerchant moves some of a merchant's aliases, and the receipts extracted under
+// them, to a new merchant.
+func (s *Service) SplitMerchant(ctx context.Context, merchantID string, aliases []string, newName, defaultCategory string) (*entity.Merchant, *entity.Merchant, int, error) {
+	original, err := s.get(ctx, merchantID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	name := merchantname.Clean(newName)
+	if name == "" {
+		return nil, nil, 0, status.Error(codes.InvalidArgument, "new_name is required")
+	}
+	if len(aliases) == 0 {
+		return nil, nil, 0, status.Error(codes.InvalidArgument, "aliases is required")
+	}
+	for _, a := range aliases {
+		if strings.EqualFold(merchantname.Clean(a), original.Name) || !merchantname.HasName(original, a) {
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "%q is not an alias of %q", a, original.Name)
+		}
+	}
+	category, err := s.categoryName(ctx, original.ProfileID, defaultCategory)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	updated, created, moved, err := s.merchantRepo.Split(ctx, original.ID, aliases, &entity.Merchant{
+		Name:            name,
+		DefaultCategory: category,
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, nil, 0, status.Errorf(codes.AlreadyExists, "merchant %q already exists", name)
+		}
+		return nil, nil, 0, status.Errorf(codes.Internal, "split merchant: %v", err)
+	}
+	s.logger.Info("merchant split", "merchant_id", updated.ID, "new_merchant_id", created.ID, "receipts_moved", moved)
+	return updated, created, moved, nil
+}
+
+// categoryName resolves an optional category to the exact spelling of one of the
+// profile's categories.
+func (s *Service) categoryName(ctx context.Context, profileID uuid.UUID, name string) (*string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	if err := s.categoryRepo.EnsureDefaults(ctx, profileID); err != nil {
+		return nil, status.Errorf(codes.Internal, "seed categories: %v", err)
+	}
+	cats, err := s.categoryRepo.ListByProfile(ctx, profileID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list categories: %v", err)
+	}
+	for _, c := range cats {
+		if strings.EqualFold(c.Name, name) {
+			return &c.Name, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "default_category %q is not a category of this profile", name)
+}
+
+func (s *Service) requireProfile(ctx context.Context, profileID string) (uuid.UUID, error) {
+	if strings.TrimSpace(profileID) == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	ok, err := s.profileRepo.Exists(ctx, pid)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.Internal, "check profile: %v", err)
+	}
+	if !ok {
+		return uuid.Nil, status.Error(codes.NotFound, "profile not found")
+	}
+	return pid, nil
+}
+
+func (s *Service) get(ctx context.Context, id string) (*entity.Merchant, error) {
+	mid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id must be a UUID")
+	}
+	m, err := s.merchantRepo.GetByID(ctx, mid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "merchant not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get merchant: %v", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,105 @@
+package receipt
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+// CorrectionReport shows how often parsed categories had to be fixed by a reviewer.
+type CorrectionReport struct {
+	Receipts       int
+	Corrected      int
+	CorrectionRate float64
+	Categories     []CategoryCorrectionStats // sorted by category
+	Months         []MonthlyCorrectionStats  // sorted by month
+}
+
+// CategoryCorrectionStats is keyed by the category the parser originally predicted.
+type CategoryCorrectionStats struct {
+	Category       string
+	Predicted      int
+	CorrectedAway  int
+	CorrectedInto  int
+	CorrectionRate float64 // CorrectedAway / Predicted
+}
+
+// MonthlyCorrectionStats is keyed by the month the receipt was parsed (YYYY-MM).
+type MonthlyCorrectionStats struct {
+	Month          string
+	Receipts       int
+	Corrected      int
+	CorrectionRate float64
+}
+
+// BuildCorrectionReport compares each receipt's current category with the category the
+// parser first gave it. corrections may include other receipts; those are ignored. A
+// receipt corrected several times counts once, from its first prediction to its current
+// category, and one corrected back to its prediction counts as not corrected.
+func BuildCorrectionReport(receipts []*entity.Receipt, corrections []*entity.CategoryCorrection) *CorrectionReport {
+	// The oldest correction of a receipt holds the parser's prediction.
+	predicted := map[uuid.UUID]string{}
+	oldest := map[uuid.UUID]*entity.CategoryCorrection{}
+	for _, c := range corrections {
+		if o, ok := oldest[c.ReceiptID]; !ok || c.CreatedAt.Before(o.CreatedAt) {
+			oldest[c.ReceiptID] = c
+			predicted[c.ReceiptID] = c.FromCategory
+		}
+	}
+
+	report := &CorrectionReport{}
+	byCategory := map[string]*CategoryCorrectionStats{}
+	byMonth := map[string]*MonthlyCorrectionStats{}
+	category := func(name string) *CategoryCorrectionStats {
+		if byCategory[name] == nil {
+			byCategory[name] = &CategoryCorrectionStats{Category: name}
+		}
+		return byCategory[name]
+	}
+
+	for _, r := range receipts {
+		pred, ok := predicted[r.ID]
+		if !ok {
+			pred = r.CategoryName
+		}
+		corrected := pred != r.CategoryName
+
+		report.Receipts++
+		category(pred).Predicted++
+		if corrected {
+			report.Corrected++
+			category(pred).CorrectedAway++
+			category(r.CategoryName).CorrectedInto++
+		}
+
+		month := r.CreatedAt.Format("2006-01")
+		if byMonth[month] == nil {
+			byMonth[month] = &MonthlyCorrectionStats{Month: month}
+		}
+		byMonth[month].Receipts++
+		if corrected {
+			byMonth[month].Corrected++
+		}
+	}
+
+	report.CorrectionRate = rate(report.Corrected, report.Receipts)
+	for _, c := range byCategory {
+		c.CorrectionRate = rate(c.CorrectedAway, c.Predicted)
+		report.Categories = append(report.Categories, *c)
+	}
+	sort.Slice(report.Categories, func(i, j int) bool { return report.Categories[i].Category < report.Categories[j].Category })
+	for _, m := range byMonth {
+		m.CorrectionRate = rate(m.Corrected, m.Receipts)
+		report.Months = append(report.Months, *m)
+	}
+	sort.Slice(report.Months, func(i, j int) bool { return report.Months[i].Month < report.Months[j].Month })
+	return report
+}
+
+func rate(n, of int) float64 {
+	if of == 0 {
+		return 0
+	}
+	return float64(n) / float64(of)
+}
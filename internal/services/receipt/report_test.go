@@ -0,0 +1,55 @@
+package receipt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+)
+
+func TestBuildCorrectionReport(t *testing.T) {
+	jan := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	rec := func(category string, created time.Time) *entity.Receipt {
+		return &entity.Receipt{ID: uuid.New(), CategoryName: category, CreatedAt: created}
+	}
+	fix := func(r *entity.Receipt, from, to string, at time.Time) *entity.CategoryCorrection {
+		return &entity.CategoryCorrection{ReceiptID: r.ID, FromCategory: from, ToCategory: to, CreatedAt: at}
+	}
+
+	movedOnce := rec("Office Supplies", jan) // Other -> Office Supplies
+	movedTwice := rec("Meals", jan)          // Other -> Travel Expenses -> Meals
+	movedBack := rec("Internet", feb)        // Internet -> Other -> Internet
+	untouched := rec("Meals", feb)
+	receipts := []*entity.Receipt{movedOnce, movedTwice, movedBack, untouched}
+	corrections := []*entity.CategoryCorrection{ // newest first
+		fix(movedBack, "Other", "Internet", feb.Add(2*time.Hour)),
+		fix(movedBack, "Internet", "Other", feb.Add(time.Hour)),
+		fix(movedTwice, "Travel Expenses", "Meals", jan.Add(2*time.Hour)),
+		fix(movedTwice, "Other", "Travel Expenses", jan.Add(time.Hour)),
+		fix(movedOnce, "Other", "Office Supplies", jan.Add(time.Hour)),
+		fix(rec("Meals", jan), "Other", "Meals", jan), // receipt outside the report
+	}
+
+	got := BuildCorrectionReport(receipts, corrections)
+	expected := &CorrectionReport{
+		Receipts:       4,
+		Corrected:      2,
+		CorrectionRate: 0.5,
+		Categories: []CategoryCorrectionStats{
+			{Category: "Internet", Predicted: 1},
+			{Category: "Meals", Predicted: 1, CorrectedInto: 1},
+			{Category: "Office Supplies", CorrectedInto: 1},
+			{Category: "Other", Predicted: 2, CorrectedAway: 2, CorrectionRate: 1},
+		},
+		Months: []MonthlyCorrectionStats{
+			{Month: "2025-01", Receipts: 2, Corrected: 2, CorrectionRate: 1},
+			{Month: "2025-02", Receipts: 2},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
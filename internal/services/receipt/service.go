@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/recurring"
 	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
 	"github.com/joseph-ayodele/receipts-tracker/internal/tools"
 	"google.golang.org/grpc/codes"
@@ -16,15 +19,27 @@ import (
 
 // Service handles receipt business logic.
 type Service struct {
-	receiptRepo repository.ReceiptRepository
-	logger      *slog.Logger
+	receiptRepo    repository.ReceiptRepository
+	correctionRepo repository.CategoryCorrectionRepository
+	categoryRepo   repository.CategoryRepository
+	anomalyRepo    repository.AnomalyRepository
+	logger         *slog.Logger
 }
 
 // NewService creates a new receipt service.
-func NewService(receiptRepo repository.ReceiptRepository, logger *slog.Logger) *Service {
+func NewService(
+	receiptRepo repository.ReceiptRepository,
+	correctionRepo repository.CategoryCorrectionRepository,
+	categoryRepo repository.CategoryRepository,
+	anomalyRepo repository.AnomalyRepository,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		receiptRepo: receiptRepo,
-		logger:      logger,
+		receiptRepo:    receiptRepo,
+		correctionRepo: correctionRepo,
+		categoryRepo:   categoryRepo,
+		anomalyRepo:    anomalyRepo,
+		logger:         logger,
 	}
 }
 
@@ -76,3 +91,204 @@ func (s *Service) ListReceipts(ctx context.Context, req ListReceiptsRequest) ([]
 	s.logger.Info("receipts listed successfully", "profile_id", profileID, "count", len(recs))
 	return recs, nil
 }
+
+// UpdateReceiptCategory moves a receipt into another of its profile's categories. The
+// returned flag is false when the receipt already had that category.
+func (s *Service) UpdateReceiptCategory(ctx context.Context, receiptID, category string) (*entity.Receipt, bool, error) {
+	id, err := uuid.Parse(strings.TrimSpace(receiptID))
+	if err != nil {
+		return nil, false, status.Error(codes.InvalidArgument, "receipt_id must be a UUID")
+	}
+	category = strings.TrimSpace(category)
+	if category == "" {
+		return nil, false, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	rec, err := s.receiptRepo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, false, status.Error(codes.NotFound, "receipt not found")
+		}
+		return nil, false, status.Errorf(codes.Internal, "get receipt: %v", err)
+	}
+	if err := s.categoryRepo.EnsureDefaults(ctx, rec.ProfileID); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "seed categories: %v", err)
+	}
+	cats, err := s.categoryRepo.ListByProfile(ctx, rec.ProfileID)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "list categories: %v", err)
+	}
+	name := ""
+	for _, c := range cats {
+		if strings.EqualFold(c.Name, category) {
+			name = c.Name
+			break
+		}
+	}
+	if name == "" {
+		return nil, false, status.Errorf(codes.InvalidArgument, "category %q is not a category of this profile", category)
+	}
+
+	updated, corr, err := s.receiptRepo.Recategorize(ctx, id, name)
+	if err != nil {
+		s.logger.Error("failed to recategorize receipt", "receipt_id", id, "error", err)
+		return nil, false, status.Errorf(codes.Internal, "update category: %v", err)
+	}
+	if corr != nil {
+		s.logger.Info("receipt recategorized", "receipt_id", id, "from", corr.FromCategory, "to", corr.ToCategory)
+	}
+	return updated, corr != nil, nil
+}
+
+// GetCorrectionReport summarizes how often reviewers had to re-categorize the profile's
+// receipts in the given tx_date range.
+func (s *Service) GetCorrectionReport(ctx context.Context, req ListReceiptsRequest) (*CorrectionReport, error) {
+	recs, err := s.ListReceipts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	profileID, _ := uuid.Parse(req.ProfileID) // validated by ListReceipts
+	corrections, err := s.correctionRepo.ListRecent(ctx, profileID, 0)
+	if err != nil {
+		s.logger.Error("failed to list category corrections", "profile_id", profileID, "error", err)
+		return nil, status.Errorf(codes.Internal, "list corrections: %v", err)
+	}
+	report := BuildCorrectionReport(recs, corrections)
+	s.logger.Info("correction report built", "profile_id", profileID, "receipts", report.Receipts, "corrected", report.Corrected)
+	return report, nil
+}
+
+// recurringLookback is how far back ListRecurringExpenses looks when no from_date is given.
+const recurringLookback = 2 // years
+
+// ListRecurringExpenses finds the recurring expenses among the profile's current receipts
+// in the given tx_date range. Periods are checked for a receipt up to to_date, or today
+// when it is not set; without from_date the last two years are analyzed.
+func (s *Service) ListRecurringExpenses(ctx context.Context, req ListReceiptsRequest) ([]recurring.Series, error) {
+	asOf := time.Now().UTC()
+	if req.ToDate != nil && req.ToDate.Before(asOf) {
+		asOf = *req.ToDate
+	}
+	asOf = time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	if req.FromDate == nil {
+		from := asOf.AddDate(-recurringLookback, 0, 0)
+		req.FromDate = &from
+	}
+	if req.ToDate == nil {
+		req.ToDate = &asOf
+	}
+
+	recs, err := s.ListReceipts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	series := recurring.Detect(recs, recurring.Options{AsOf: asOf})
+	missing := 0
+	for _, sr := range series {
+		missing += len(sr.Missing)
+	}
+	s.logger.Info("recurring expenses detected", "profile_id", req.ProfileID, "receipts", len(recs), "series", len(series), "missing", missing)
+	return series, nil
+}
+
+// Limits for ListReceiptAnomalies.
+const (
+	DefaultAnomalyLimit = 100
+	MaxAnomalyLimit     = 1000
+)
+
+// ListReceiptAnomalies returns why the profile's current receipts in the tx_date range
+// were flagged for review, newest first.
+func (s *Service) ListReceiptAnomalies(ctx context.Context, req ListReceiptsRequest, limit int) ([]*entity.ReceiptAnomaly, error) {
+	if strings.TrimSpace(req.ProfileID) == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	profileID, err := uuid.Parse(req.ProfileID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	if limit < 0 || limit > MaxAnomalyLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", MaxAnomalyLimit)
+	}
+	if limit == 0 {
+		limit = DefaultAnomalyLimit
+	}
+	list, err := s.anomalyRepo.ListByProfile(ctx, profileID, req.FromDate, req.ToDate, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list anomalies: %v", err)
+	}
+	s.logger.Info("receipt anomalies listed", "profile_id", profileID, "count", len(list))
+	return list, nil
+}
+
+// Limits for SearchReceipts.
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 200
+)
+
+// SearchRequest represents receipt search parameters.
+type SearchRequest struct {
+	ProfileID string
+	Query     string
+	FromDate  *time.Time
+	ToDate    *time.Time
+	Category  string
+	MinTotal  string
+	MaxTotal  string
+	Limit     int
+}
+
+// SearchReceipts finds the profile's current receipts whose merchant, description or OCR
+// text contain every word of the query, best matches first.
+func (s *Service) SearchReceipts(ctx context.Context, req SearchRequest) ([]repository.SearchHit, error) {
+	if strings.TrimSpace(req.ProfileID) == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	profileID, err := uuid.Parse(req.ProfileID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	if req.FromDate != nil && req.ToDate != nil && req.ToDate.Before(*req.FromDate) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+	if req.Limit < 0 || req.Limit > MaxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", MaxSearchLimit)
+	}
+	filter := repository.SearchFilter{
+		Query:    strings.TrimSpace(req.Query),
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+		Category: strings.TrimSpace(req.Category),
+		Limit:    req.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultSearchLimit
+	}
+	if filter.MinTotal, err = optionalAmount(req.MinTotal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "min_total: %v", err)
+	}
+	if filter.MaxTotal, err = optionalAmount(req.MaxTotal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "max_total: %v", err)
+	}
+	if filter.MinTotal != nil && filter.MaxTotal != nil && *filter.MaxTotal < *filter.MinTotal {
+		return nil, status.Error(codes.InvalidArgument, "max_total must not be below min_total")
+	}
+	hits, err := s.receiptRepo.Search(ctx, profileID, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "search receipts: %v", err)
+	}
+	s.logger.Info("receipts searched", "profile_id", profileID, "query", filter.Query, "results", len(hits))
+	return hits, nil
+}
+
+func optionalAmount(s string) (*money.Amount, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	a, err := money.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
@@ -0,0 +1,215 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
+	"github.com/joseph-ayodele/receipts-tracker/internal/core/statement"
+	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStatementSize caps an uploaded statement file.
+const maxStatementSize = 10 << 20
+
+// Service imports bank and card statements and reconciles them against receipts.
+type Service struct {
+	statementRepo repository.StatementRepository
+	receiptRepo   repository.ReceiptRepository
+	profileRepo   repository.ProfileRepository
+	logger        *slog.Logger
+}
+
+// NewService creates a new statement service.
+func NewService(statementRepo repository.StatementRepository, receiptRepo repository.ReceiptRepository, profileRepo repository.ProfileRepository, logger *slog.Logger) *Service {
+	return &Service{
+		statementRepo: statementRepo,
+		receiptRepo:   receiptRepo,
+		profileRepo:   profileRepo,
+		logger:        logger,
+	}
+}
+
+// ImportRequest carries a statement file to import.
+type ImportRequest struct {
+	ProfileID    string
+	Account      string // optional; defaults to the OFX account id
+	FileName     string
+	Content      []byte
+	Format       statement.Format // empty = detect
+	CurrencyCode string           // optional; defaults to the statement's, then the profile's
+}
+
+// ImportResult summarizes an import.
+type ImportResult struct {
+	Imported   int
+	Duplicates int
+	Account    string
+	FromDate   *time.Time
+	ToDate     *time.Time
+}
+
+// ImportStatement parses a statement and stores the lines not imported before.
+func (s *Service) ImportStatement(ctx context.Context, req ImportRequest) (*ImportResult, error) {
+	pid, err := parseProfileID(req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.profileRepo.GetByID(ctx, pid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "profile not found")
+		}
+		return nil, status.Errorf(codes.Internal, "get profile: %v", err)
+	}
+	if len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	if len(req.Content) > maxStatementSize {
+		return nil, status.Errorf(codes.InvalidArgument, "statement is larger than %d bytes", maxStatementSize)
+	}
+
+	format := req.Format
+	if format == "" {
+		format = statement.DetectFormat(req.FileName, req.Content)
+	}
+	st, err := statement.Parse(bytes.NewReader(req.Content), format)
+	if err != nil {
+		s.logger.Warn("failed to parse statement", "profile_id", pid, "file_name", req.FileName, "format", format, "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "parse statement: %v", err)
+	}
+
+	account := strings.TrimSpace(req.Account)
+	if account == "" {
+		account = st.Account
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.CurrencyCode))
+	if currency == "" {
+		currency = st.Currency
+	}
+	if currency == "" {
+		currency = p.DefaultCurrency
+	}
+	if len(currency) != 3 {
+		return nil, status.Errorf(codes.InvalidArgument, "currency_code %q must be a 3-letter code", currency)
+	}
+
+	res := &ImportResult{Account: account}
+	txs := make([]*entity.StatementTransaction, 0, len(st.Transactions))
+	for _, t := range st.Transactions {
+		cur := currency
+		if t.Currency != "" {
+			cur = t.Currency
+		}
+		txs = append(txs, &entity.StatementTransaction{
+			ProfileID:    pid,
+			Account:      account,
+			PostedDate:   t.PostedDate,
+			Description:  t.Description,
+			Amount:       t.Amount,
+			CurrencyCode: cur,
+			ExternalID:   t.ExternalID,
+		})
+		if res.FromDate == nil || t.PostedDate.Before(*res.FromDate) {
+			d := t.PostedDate
+			res.FromDate = &d
+		}
+		if res.ToDate == nil || t.PostedDate.After(*res.ToDate) {
+			d := t.PostedDate
+			res.ToDate = &d
+		}
+	}
+
+	res.Imported, res.Duplicates, err = s.statementRepo.Import(ctx, pid, account, txs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "store statement: %v", err)
+	}
+	s.logger.Info("statement imported", "profile_id", pid, "account", account, "format", format,
+		"lines", len(txs), "imported", res.Imported, "duplicates", res.Duplicates)
+	return res, nil
+}
+
+// ReconcileRequest selects the statement lines to reconcile.
+type ReconcileRequest struct {
+	ProfileID  string
+	Account    string // optional; empty = all accounts
+	FromDate   *time.Time
+	ToDate     *time.Time
+	DateWindow int // optional; statement.DefaultDateWindow when 0
+}
+
+// Reconcile matches the selected statement charges to the profile's receipts. Receipts
+// are matched from the date window before the first charge to a day after the last, but
+// only those dated within the statement's own range are reported as unmatched; the rest
+// may belong to the neighbouring statement.
+func (s *Service) Reconcile(ctx context.Context, req ReconcileRequest) (*statement.Result, error) {
+	pid, err := parseProfileID(req.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.profileRepo.Exists(ctx, pid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check profile: %v", err)
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "profile not found")
+	}
+	if req.FromDate != nil && req.ToDate != nil && req.ToDate.Before(*req.FromDate) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+	if req.DateWindow < 0 {
+		return nil, status.Error(codes.InvalidArgument, "date_window_days must not be negative")
+	}
+	opts := statement.Options{DateWindow: req.DateWindow}
+	window := req.DateWindow
+	if window == 0 {
+		window = statement.DefaultDateWindow
+	}
+
+	txs, err := s.statementRepo.ListByProfile(ctx, pid, strings.TrimSpace(req.Account), req.FromDate, req.ToDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list statement lines: %v", err)
+	}
+	if len(txs) == 0 {
+		return &statement.Result{}, nil
+	}
+
+	from := txs[0].PostedDate.AddDate(0, 0, -window)
+	to := txs[len(txs)-1].PostedDate.AddDate(0, 0, 1)
+	recs, err := s.receiptRepo.ListReceipts(ctx, pid, &from, &to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list receipts: %v", err)
+	}
+
+	res := statement.Reconcile(txs, recs, opts)
+	first, last := txs[0].PostedDate, txs[len(txs)-1].PostedDate
+	unmatched := res.UnmatchedReceipts[:0]
+	for _, r := range res.UnmatchedReceipts {
+		if !r.TxDate.Before(first) && !r.TxDate.After(last) {
+			unmatched = append(unmatched, r)
+		}
+	}
+	res.UnmatchedReceipts = unmatched
+	s.logger.Info("statement reconciled", "profile_id", pid, "account", req.Account,
+		"lines", len(txs), "receipts", len(recs), "matches", len(res.Matches),
+		"unmatched_transactions", len(res.UnmatchedTransactions), "unmatched_receipts", len(res.UnmatchedReceipts))
+	return &res, nil
+}
+
+func parseProfileID(profileID string) (uuid.UUID, error) {
+	if strings.TrimSpace(profileID) == "" {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+	pid, err := uuid.Parse(strings.TrimSpace(profileID))
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+	}
+	return pid, nil
+}
@@ -0,0 +1,122 @@
+package usage
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service reports LLM token usage and estimated spend.
+type Service struct {
+	jobsRepo repository.ExtractJobRepository
+	logger   *slog.Logger
+}
+
+// NewService creates a new usage service.
+func NewService(jobsRepo repository.ExtractJobRepository, logger *slog.Logger) *Service {
+	return &Service{
+		jobsRepo: jobsRepo,
+		logger:   logger,
+	}
+}
+
+// ReportRequest represents usage report parameters.
+type ReportRequest struct {
+	ProfileID string // optional; empty = all profiles
+	FromDate  *time.Time
+	ToDate    *time.Time
+}
+
+// Row aggregates usage for one (profile, month, model) bucket.
+type Row struct {
+	ProfileID        uuid.UUID
+	Month            string // YYYY-MM
+	Model            string
+	Jobs             int64
+	CacheHits        int64
+	PromptTokens     int64
+	CompletionTokens int64
+	ImageTokens      int64
+	CostUSD          float64
+}
+
+// Report is the result of GetUsageReport.
+type Report struct {
+	Rows         []Row
+	TotalCostUSD float64
+}
+
+// GetUsageReport groups parse-stage usage by profile, month and model.
+func (s *Service) GetUsageReport(ctx context.Context, req ReportRequest) (*Report, error) {
+	var profileID *uuid.UUID
+	if pid := strings.TrimSpace(req.ProfileID); pid != "" {
+		id, err := uuid.Parse(pid)
+		if err != nil {
+			s.logger.Error("invalid profile_id format for usage report", "profile_id", pid, "error", err)
+			return nil, status.Error(codes.InvalidArgument, "profile_id must be a UUID")
+		}
+		profileID = &id
+	}
+	if req.FromDate != nil && req.ToDate != nil && req.ToDate.Before(*req.FromDate) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+
+	rows, err := s.jobsRepo.ListUsage(ctx, profileID, req.FromDate, req.ToDate)
+	if err != nil {
+		s.logger.Error("failed to list usage", "profile_id", profileID, "error", err)
+		return nil, status.Errorf(codes.Internal, "list usage: %v", err)
+	}
+
+	rep := Aggregate(rows)
+	s.logger.Info("usage report built", "profile_id", profileID, "jobs", len(rows), "buckets", len(rep.Rows), "total_cost_usd", rep.TotalCostUSD)
+	return rep, nil
+}
+
+// Aggregate buckets job rows by (profile, month, model), ordered by month, profile, model.
+func Aggregate(rows []repository.UsageRow) *Report {
+	type key struct {
+		profile uuid.UUID
+		month   string
+		model   string
+	}
+	buckets := map[key]*Row{}
+	rep := &Report{}
+	for _, r := range rows {
+		k := key{profile: r.ProfileID, month: r.StartedAt.UTC().Format("2006-01"), model: r.ModelName}
+		b, ok := buckets[k]
+		if !ok {
+			b = &Row{ProfileID: k.profile, Month: k.month, Model: k.model}
+			buckets[k] = b
+		}
+		b.Jobs++
+		if r.CacheHit {
+			b.CacheHits++
+		}
+		b.PromptTokens += int64(r.PromptTokens)
+		b.CompletionTokens += int64(r.CompletionTokens)
+		b.ImageTokens += int64(r.ImageTokens)
+		b.CostUSD += r.CostUSD
+		rep.TotalCostUSD += r.CostUSD
+	}
+	for _, b := range buckets {
+		rep.Rows = append(rep.Rows, *b)
+	}
+	sort.Slice(rep.Rows, func(i, j int) bool {
+		a, b := rep.Rows[i], rep.Rows[j]
+		if a.Month != b.Month {
+			return a.Month < b.Month
+		}
+		if a.ProfileID != b.ProfileID {
+			return a.ProfileID.String() < b.ProfileID.String()
+		}
+		return a.Model < b.Model
+	})
+	return rep
+}
@@ -0,0 +1,60 @@
+package usage
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/joseph-ayodele/receipts-tracker/internal/repository"
+)
+
+func at(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+func TestAggregate(t *testing.T) {
+	alice := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	bob := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	rows := []repository.UsageRow{
+		{ProfileID: alice, StartedAt: at("2025-03-01T10:00:00Z"), ModelName: "gpt-4o-mini", PromptTokens: 1000, CompletionTokens: 100, ImageTokens: 255, CostUSD: 0.01},
+		{ProfileID: alice, StartedAt: at("2025-03-31T23:30:00-02:00"), ModelName: "gpt-4o-mini", PromptTokens: 2000, CompletionTokens: 200, CostUSD: 0.02},
+		{ProfileID: alice, StartedAt: at("2025-03-15T09:00:00Z"), ModelName: "gpt-4o-mini", CacheHit: true},
+		{ProfileID: alice, StartedAt: at("2025-03-20T09:00:00Z"), ModelName: "gpt-4o", PromptTokens: 500, CompletionTokens: 50, CostUSD: 0.5},
+		{ProfileID: bob, StartedAt: at("2025-03-02T09:00:00Z"), ModelName: "gpt-4o-mini", PromptTokens: 300, CompletionTokens: 30, CostUSD: 0.003},
+		{ProfileID: bob, StartedAt: at("2025-02-27T09:00:00Z"), ModelName: "local-model", PromptTokens: 400, CompletionTokens: 40},
+	}
+	rep := Aggregate(rows)
+
+	expected := []Row{
+		{ProfileID: bob, Month: "2025-02", Model: "local-model", Jobs: 1, PromptTokens: 400, CompletionTokens: 40},
+		{ProfileID: alice, Month: "2025-03", Model: "gpt-4o", Jobs: 1, PromptTokens: 500, CompletionTokens: 50, CostUSD: 0.5},
+		{ProfileID: alice, Month: "2025-03", Model: "gpt-4o-mini", Jobs: 2, CacheHits: 1, PromptTokens: 1000, CompletionTokens: 100, ImageTokens: 255, CostUSD: 0.01},
+		{ProfileID: bob, Month: "2025-03", Model: "gpt-4o-mini", Jobs: 1, PromptTokens: 300, CompletionTokens: 30, CostUSD: 0.003},
+		{ProfileID: alice, Month: "2025-04", Model: "gpt-4o-mini", Jobs: 1, PromptTokens: 2000, CompletionTokens: 200, CostUSD: 0.02}, // months are UTC
+	}
+
+	if len(rep.Rows) != len(expected) {
+		t.Fatalf("Expected %d rows, got %d: %+v", len(expected), len(rep.Rows), rep.Rows)
+	}
+	for i, e := range expected {
+		got := rep.Rows[i]
+		if got.ProfileID != e.ProfileID || got.Month != e.Month || got.Model != e.Model || got.Jobs != e.Jobs || got.CacheHits != e.CacheHits ||
+			got.PromptTokens != e.PromptTokens || got.CompletionTokens != e.CompletionTokens || got.ImageTokens != e.ImageTokens ||
+			math.Abs(got.CostUSD-e.CostUSD) > 1e-9 {
+			t.Errorf("Row %d: expected %+v, got %+v", i, e, got)
+		}
+	}
+	if math.Abs(rep.TotalCostUSD-0.533) > 1e-9 {
+		t.Errorf("Expected total 0.533, got %v", rep.TotalCostUSD)
+	}
+}
+
+func TestAggregateEmpty(t *testing.T) {
+	rep := Aggregate(nil)
+	if len(rep.Rows) != 0 || rep.TotalCostUSD != 0 {
+		t.Errorf("Expected an empty report, got %+v", rep)
+	}
+}
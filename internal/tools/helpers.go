@@ -1,12 +1,16 @@
 package tools
 
 import (
-	"fmt"
+	"encoding/json"
+	"strconv"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/joseph-ayodele/receipts-tracker/constants"
 	"github.com/joseph-ayodele/receipts-tracker/gen/ent"
 	receiptspb "github.com/joseph-ayodele/receipts-tracker/gen/proto/receipts/v1"
 	"github.com/joseph-ayodele/receipts-tracker/internal/entity"
+	"github.com/joseph-ayodele/receipts-tracker/internal/money"
 )
 
 func StrOrEmpty(p *string) string {
@@ -29,16 +33,7 @@ func ToPBProfile(p *ent.Profile) *receiptspb.Profile {
 }
 
 func ToPBReceipt(r *ent.Receipt) *receiptspb.Receipt {
-	return &receiptspb.Receipt{
-		Id:           r.ID.String(),
-		ProfileId:    r.ProfileID.String(),
-		MerchantName: r.MerchantName,
-		TxDate:       r.TxDate.Format("2006-01-02"),
-		Total:        fmt.Sprintf("%.2f", r.Total),
-		CurrencyCode: r.CurrencyCode,
-		CreatedAt:    r.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:    r.UpdatedAt.UTC().Format(time.RFC3339),
-	}
+	return ToPBReceiptFromEntity(ToReceipt(r))
 }
 
 func ToPBProfileFromEntity(p *entity.Profile) *receiptspb.Profile {
@@ -53,17 +48,202 @@ func ToPBProfileFromEntity(p *entity.Profile) *receiptspb.Profile {
 	}
 }
 
+func ToPBCategoryFromEntity(c *entity.Category) *receiptspb.Category {
+	return &receiptspb.Category{
+		Id:          c.ID.String(),
+		ProfileId:   c.ProfileID.String(),
+		Name:        c.Name,
+		Description: StrOrEmpty(c.Description),
+		Synonyms:    c.Synonyms,
+		TaxLine:     StrOrEmpty(c.TaxLine),
+		Account:     StrOrEmpty(c.Account),
+		CreatedAt:   c.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   c.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func ToPBMerchantFromEntity(m *entity.Merchant) *receiptspb.Merchant {
+	return &receiptspb.Merchant{
+		Id:              m.ID.String(),
+		ProfileId:       m.ProfileID.String(),
+		Name:            m.Name,
+		Aliases:         m.Aliases,
+		DefaultCategory: StrOrEmpty(m.DefaultCategory),
+		CreatedAt:       m.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:       m.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func ToPBCategoryRuleFromEntity(r *entity.CategoryRule) *receiptspb.CategoryRule {
+	enabled := r.Enabled
+	return &receiptspb.CategoryRule{
+		Id:              r.ID.String(),
+		ProfileId:       r.ProfileID.String(),
+		Name:            r.Name,
+		Priority:        int32(r.Priority),
+		Enabled:         &enabled,
+		MerchantPattern: StrOrEmpty(r.MerchantPattern),
+		PathPattern:     StrOrEmpty(r.PathPattern),
+		Keywords:        r.Keywords,
+		MinTotal:        decimalOrEmpty(r.MinTotal),
+		MaxTotal:        decimalOrEmpty(r.MaxTotal),
+		SetCategory:     StrOrEmpty(r.SetCategory),
+		ForceReview:     r.ForceReview,
+		SetDescription:  StrOrEmpty(r.SetDescription),
+		CreatedAt:       r.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:       r.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func ToPBExportTemplateFromEntity(t *entity.ExportTemplate) *receiptspb.ExportTemplate {
+	cols := make([]*receiptspb.ExportColumn, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		cols = append(cols, &receiptspb.ExportColumn{Header: c.Header, Field: c.Field, Format: c.Format, Width: c.Width})
+	}
+	return &receiptspb.ExportTemplate{
+		Id:        t.ID.String(),
+		ProfileId: t.ProfileID.String(),
+		Name:      t.Name,
+		Columns:   cols,
+		CreatedAt: t.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: t.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func ToPBStatementTransactionFromEntity(t *entity.StatementTransaction) *receiptspb.StatementTransaction {
+	return &receiptspb.StatementTransaction{
+		Id:           t.ID.String(),
+		Account:      t.Account,
+		PostedDate:   t.PostedDate.Format("2006-01-02"),
+		Description:  t.Description,
+		Amount:       t.Amount.String(),
+		CurrencyCode: t.CurrencyCode,
+		ExternalId:   t.ExternalID,
+	}
+}
+
+func ToPBBudgetFromEntity(b *entity.Budget) *receiptspb.Budget {
+	return &receiptspb.Budget{
+		Id:           b.ID.String(),
+		ProfileId:    b.ProfileID.String(),
+		CategoryName: b.CategoryName,
+		Period:       ToPBBudgetPeriod(b.Period),
+		Amount:       b.Amount.String(),
+		CreatedAt:    b.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:    b.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func ToPBBudgetAlertFromEntity(a *entity.BudgetAlert) *receiptspb.BudgetAlert {
+	out := &receiptspb.BudgetAlert{
+		Id:           a.ID.String(),
+		BudgetId:     a.BudgetID.String(),
+		ProfileId:    a.ProfileID.String(),
+		CategoryName: a.CategoryName,
+		Period:       ToPBBudgetPeriod(a.Period),
+		PeriodStart:  a.PeriodStart.Format("2006-01-02"),
+		Threshold:    int32(a.Threshold),
+		Spent:        a.Spent.String(),
+		BudgetAmount: a.BudgetAmount.String(),
+		CreatedAt:    a.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if a.ReceiptID != nil {
+		out.ReceiptId = a.ReceiptID.String()
+	}
+	if a.DeliveredAt != nil {
+		out.DeliveredAt = a.DeliveredAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+func ToPBBudgetPeriod(period string) receiptspb.BudgetPeriod {
+	switch period {
+	case entity.BudgetMonthly:
+		return receiptspb.BudgetPeriod_BUDGET_PERIOD_MONTHLY
+	case entity.BudgetAnnual:
+		return receiptspb.BudgetPeriod_BUDGET_PERIOD_ANNUAL
+	}
+	return receiptspb.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+// FromPBBudgetPeriod maps the enum to the stored period; unspecified maps to "".
+func FromPBBudgetPeriod(p receiptspb.BudgetPeriod) string {
+	switch p {
+	case receiptspb.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
+		return entity.BudgetMonthly
+	case receiptspb.BudgetPeriod_BUDGET_PERIOD_ANNUAL:
+		return entity.BudgetAnnual
+	}
+	return ""
+}
+
 func ToPBReceiptFromEntity(r *entity.Receipt) *receiptspb.Receipt {
 	return &receiptspb.Receipt{
 		Id:           r.ID.String(),
 		ProfileId:    r.ProfileID.String(),
 		MerchantName: r.MerchantName,
 		TxDate:       r.TxDate.Format("2006-01-02"),
-		Total:        fmt.Sprintf("%.2f", r.Total),
+		Total:        r.Total.String(),
 		CurrencyCode: r.CurrencyCode,
 		CreatedAt:    r.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:    r.UpdatedAt.UTC().Format(time.RFC3339),
+		Subtotal:     decimalOrEmpty(r.Subtotal),
+		Tax:          decimalOrEmpty(r.Tax),
+		Discount:     decimalOrEmpty(r.Discount),
+		OtherFees:    decimalOrEmpty(r.OtherFees),
+		Tip:          decimalOrEmpty(r.Tip),
+		Fees:         toPBFees(r.Fees),
+
+		ConvertedTotal:    decimalOrEmpty(r.ConvertedTotal),
+		ConvertedCurrency: StrOrEmpty(r.ConvertedCurrency),
+		FxRate:            rateOrEmpty(r.FxRate),
+		FxRateDate:        dateOrEmpty(r.FxRateDate),
+		FxSource:          StrOrEmpty(r.FxSource),
+
+		CategoryName: r.CategoryName,
+		Description:  r.Description,
+		NeedsReview:  r.NeedsReview,
+		MerchantId:   uuidOrEmpty(r.MerchantID),
+	}
+}
+
+func uuidOrEmpty(p *uuid.UUID) string {
+	if p == nil {
+		return ""
+	}
+	return p.String()
+}
+
+func rateOrEmpty(p *float64) string {
+	if p == nil {
+		return ""
 	}
+	return strconv.FormatFloat(*p, 'f', -1, 64)
+}
+
+func dateOrEmpty(p *time.Time) string {
+	if p == nil {
+		return ""
+	}
+	return p.Format("2006-01-02")
+}
+
+func decimalOrEmpty(p *money.Amount) string {
+	if p == nil {
+		return ""
+	}
+	return p.String()
+}
+
+func toPBFees(fees []entity.FeeLine) []*receiptspb.FeeLine {
+	if len(fees) == 0 {
+		return nil
+	}
+	out := make([]*receiptspb.FeeLine, 0, len(fees))
+	for _, f := range fees {
+		out = append(out, &receiptspb.FeeLine{Name: f.Name, Amount: f.Amount.String()})
+	}
+	return out
 }
 
 func ParseYMD(s string) (time.Time, error) {
@@ -87,15 +267,169 @@ func ToProfile(e *ent.Profile) *entity.Profile {
 	}
 }
 
+func ToCategory(e *ent.Category) *entity.Category {
+	return &entity.Category{
+		ID:          e.ID,
+		ProfileID:   e.ProfileID,
+		Name:        e.Name,
+		Description: e.Description,
+		Synonyms:    e.Synonyms,
+		TaxLine:     e.TaxLine,
+		Account:     e.Account,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func ToMerchant(e *ent.Merchant) *entity.Merchant {
+	return &entity.Merchant{
+		ID:              e.ID,
+		ProfileID:       e.ProfileID,
+		Name:            e.Name,
+		Aliases:         e.Aliases,
+		DefaultCategory: e.DefaultCategory,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func ToCategoryRule(e *ent.CategoryRule) *entity.CategoryRule {
+	return &entity.CategoryRule{
+		ID:              e.ID,
+		ProfileID:       e.ProfileID,
+		Name:            e.Name,
+		Priority:        e.Priority,
+		Enabled:         e.Enabled,
+		MerchantPattern: e.MerchantPattern,
+		PathPattern:     e.PathPattern,
+		Keywords:        e.Keywords,
+		MinTotal:        e.MinTotal,
+		MaxTotal:        e.MaxTotal,
+		SetCategory:     e.SetCategory,
+		ForceReview:     e.ForceReview,
+		SetDescription:  e.SetDescription,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func ToExportTemplate(e *ent.ExportTemplate) *entity.ExportTemplate {
+	return &entity.ExportTemplate{
+		ID:        e.ID,
+		ProfileID: e.ProfileID,
+		Name:      e.Name,
+		Columns:   e.Columns,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func ToStatementTransaction(e *ent.StatementTransaction) *entity.StatementTransaction {
+	return &entity.StatementTransaction{
+		ID:           e.ID,
+		ProfileID:    e.ProfileID,
+		Account:      e.Account,
+		PostedDate:   e.PostedDate,
+		Description:  e.Description,
+		Amount:       e.Amount,
+		CurrencyCode: e.CurrencyCode,
+		ExternalID:   e.ExternalID,
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+func ToBudget(e *ent.Budget) *entity.Budget {
+	return &entity.Budget{
+		ID:           e.ID,
+		ProfileID:    e.ProfileID,
+		CategoryName: e.CategoryName,
+		Period:       e.Period,
+		Amount:       e.Amount,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
+
+// ToBudgetAlert converts an alert; the category and period come from its budget edge
+// when loaded.
+func ToBudgetAlert(e *ent.BudgetAlert) *entity.BudgetAlert {
+	a := &entity.BudgetAlert{
+		ID:           e.ID,
+		BudgetID:     e.BudgetID,
+		ProfileID:    e.ProfileID,
+		PeriodStart:  e.PeriodStart,
+		Threshold:    e.Threshold,
+		Spent:        e.Spent,
+		BudgetAmount: e.BudgetAmount,
+		ReceiptID:    e.ReceiptID,
+		CreatedAt:    e.CreatedAt,
+		DeliveredAt:  e.DeliveredAt,
+	}
+	if b := e.Edges.Budget; b != nil {
+		a.CategoryName = b.CategoryName
+		a.Period = b.Period
+	}
+	return a
+}
+
+// ToReceiptAnomaly converts an anomaly, with its receipt when the edge is loaded.
+func ToReceiptAnomaly(e *ent.ReceiptAnomaly) *entity.ReceiptAnomaly {
+	a := &entity.ReceiptAnomaly{
+		ID:        e.ID,
+		ReceiptID: e.ReceiptID,
+		ProfileID: e.ProfileID,
+		Kind:      e.Kind,
+		Reason:    e.Reason,
+		Score:     e.Score,
+		CreatedAt: e.CreatedAt,
+	}
+	if r := e.Edges.Receipt; r != nil {
+		a.Receipt = ToReceipt(r)
+	}
+	return a
+}
+
+func ToCategoryCorrection(e *ent.CategoryCorrection) *entity.CategoryCorrection {
+	return &entity.CategoryCorrection{
+		ID:           e.ID,
+		ProfileID:    e.ProfileID,
+		ReceiptID:    e.ReceiptID,
+		MerchantName: e.MerchantName,
+		Description:  e.Description,
+		FromCategory: e.FromCategory,
+		ToCategory:   e.ToCategory,
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+// ToCategoryDefs converts stored categories into the form used for matching and prompting.
+func ToCategoryDefs(cats []*entity.Category) []constants.CategoryDef {
+	out := make([]constants.CategoryDef, 0, len(cats))
+	for _, c := range cats {
+		out = append(out, constants.CategoryDef{
+			Name:        c.Name,
+			Description: StrOrEmpty(c.Description),
+			Synonyms:    c.Synonyms,
+			TaxLine:     StrOrEmpty(c.TaxLine),
+		})
+	}
+	return out
+}
+
 func ToReceipt(e *ent.Receipt) *entity.Receipt {
 	return &entity.Receipt{
 		ID:           e.ID,
 		ProfileID:    e.ProfileID,
 		FileID:       e.FileID,
 		MerchantName: e.MerchantName,
+		MerchantID:   e.MerchantID,
 		TxDate:       e.TxDate,
 		Subtotal:     e.Subtotal,
 		Tax:          e.Tax,
+		Discount:     e.Discount,
+		OtherFees:    e.OtherFees,
+		Tip:          e.Tip,
+		Fees:         decodeFees(e.Fees),
 		Total:        e.Total,
 		CurrencyCode: e.CurrencyCode,
 		CategoryName: e.CategoryName,
@@ -104,9 +438,38 @@ func ToReceipt(e *ent.Receipt) *entity.Receipt {
 		IsCurrent:    e.IsCurrent,
 		CreatedAt:    e.CreatedAt,
 		UpdatedAt:    e.UpdatedAt,
+
+		ConvertedTotal:    e.ConvertedTotal,
+		ConvertedCurrency: e.ConvertedCurrency,
+		FxRate:            e.FxRate,
+		FxRateDate:        e.FxRateDate,
+		FxSource:          e.FxSource,
 	}
 }
 
+// decodeFees reads the fees column, which stores llm.FeeItem values (decimal-string amounts).
+func decodeFees(raw []byte) []entity.FeeLine {
+	if len(raw) == 0 {
+		return nil
+	}
+	var items []struct {
+		Name   string `json:"name"`
+		Amount string `json:"amount"`
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil
+	}
+	out := make([]entity.FeeLine, 0, len(items))
+	for _, it := range items {
+		amt, err := money.Parse(it.Amount)
+		if err != nil {
+			continue
+		}
+		out = append(out, entity.FeeLine{Name: it.Name, Amount: amt})
+	}
+	return out
+}
+
 func ToReceiptFile(e *ent.ReceiptFile) *entity.ReceiptFile {
 	return &entity.ReceiptFile{
 		ID:          e.ID,
@@ -120,6 +483,19 @@ func ToReceiptFile(e *ent.ReceiptFile) *entity.ReceiptFile {
 	}
 }
 
+// OCRMethod is the OCR method of a job ("pdf-text", "image-ocr", "vision-direct", ...):
+// the model name right after OCR, or the ocr_method a parse recorded in model_params.
+func OCRMethod(j *entity.ExtractJob) string {
+	if j.Status != nil && *j.Status == string(constants.JobStatusOCROK) && j.ModelName != nil {
+		return *j.ModelName
+	}
+	var params struct {
+		OCRMethod string `json:"ocr_method"`
+	}
+	_ = json.Unmarshal(j.ModelParams, &params)
+	return params.OCRMethod
+}
+
 func ToExtractJob(e *ent.ExtractJob) *entity.ExtractJob {
 	return &entity.ExtractJob{
 		ID:                   e.ID,
@@ -137,5 +513,11 @@ func ToExtractJob(e *ent.ExtractJob) *entity.ExtractJob {
 		ExtractedJSON:        e.ExtractedJSON,
 		ModelName:            e.ModelName,
 		ModelParams:          e.ModelParams,
+		PromptTokens:         e.PromptTokens,
+		CompletionTokens:     e.CompletionTokens,
+		ImageTokens:          e.ImageTokens,
+		CostUSD:              e.CostUsd,
+		LLMCacheHit:          e.LlmCacheHit,
+		ParseAttempts:        e.ParseAttempts,
 	}
 }